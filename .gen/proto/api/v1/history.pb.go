@@ -74,6 +74,154 @@ func (EventFilterType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8237ca6511ad6c62, []int{0}
 }
 
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_INVALID                                              EventType = 0
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED                           EventType = 1
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED                         EventType = 2
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_FAILED                            EventType = 3
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT                         EventType = 4
+	EventType_EVENT_TYPE_DECISION_TASK_SCHEDULED                              EventType = 5
+	EventType_EVENT_TYPE_DECISION_TASK_STARTED                                EventType = 6
+	EventType_EVENT_TYPE_DECISION_TASK_COMPLETED                              EventType = 7
+	EventType_EVENT_TYPE_DECISION_TASK_TIMED_OUT                              EventType = 8
+	EventType_EVENT_TYPE_DECISION_TASK_FAILED                                 EventType = 9
+	EventType_EVENT_TYPE_ACTIVITY_TASK_SCHEDULED                              EventType = 10
+	EventType_EVENT_TYPE_ACTIVITY_TASK_STARTED                                EventType = 11
+	EventType_EVENT_TYPE_ACTIVITY_TASK_COMPLETED                              EventType = 12
+	EventType_EVENT_TYPE_ACTIVITY_TASK_FAILED                                 EventType = 13
+	EventType_EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT                              EventType = 14
+	EventType_EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED                       EventType = 15
+	EventType_EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED                  EventType = 16
+	EventType_EVENT_TYPE_ACTIVITY_TASK_CANCELED                               EventType = 17
+	EventType_EVENT_TYPE_TIMER_STARTED                                        EventType = 18
+	EventType_EVENT_TYPE_TIMER_FIRED                                          EventType = 19
+	EventType_EVENT_TYPE_CANCEL_TIMER_FAILED                                  EventType = 20
+	EventType_EVENT_TYPE_TIMER_CANCELED                                       EventType = 21
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED                  EventType = 22
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED                          EventType = 23
+	EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED EventType = 24
+	EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED    EventType = 25
+	EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED         EventType = 26
+	EventType_EVENT_TYPE_MARKER_RECORDED                                      EventType = 27
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED                          EventType = 28
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED                        EventType = 29
+	EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW                  EventType = 30
+	EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED             EventType = 31
+	EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED                EventType = 32
+	EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED                     EventType = 33
+	EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED                   EventType = 34
+	EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED                      EventType = 35
+	EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED                    EventType = 36
+	EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT                   EventType = 37
+	EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED                  EventType = 38
+	EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED         EventType = 39
+	EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED            EventType = 40
+	EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED                 EventType = 41
+	EventType_EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES                    EventType = 42
+)
+
+var EventType_name = map[int32]string{
+	0:  "EVENT_TYPE_INVALID",
+	1:  "EVENT_TYPE_WORKFLOW_EXECUTION_STARTED",
+	2:  "EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED",
+	3:  "EVENT_TYPE_WORKFLOW_EXECUTION_FAILED",
+	4:  "EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT",
+	5:  "EVENT_TYPE_DECISION_TASK_SCHEDULED",
+	6:  "EVENT_TYPE_DECISION_TASK_STARTED",
+	7:  "EVENT_TYPE_DECISION_TASK_COMPLETED",
+	8:  "EVENT_TYPE_DECISION_TASK_TIMED_OUT",
+	9:  "EVENT_TYPE_DECISION_TASK_FAILED",
+	10: "EVENT_TYPE_ACTIVITY_TASK_SCHEDULED",
+	11: "EVENT_TYPE_ACTIVITY_TASK_STARTED",
+	12: "EVENT_TYPE_ACTIVITY_TASK_COMPLETED",
+	13: "EVENT_TYPE_ACTIVITY_TASK_FAILED",
+	14: "EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT",
+	15: "EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED",
+	16: "EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED",
+	17: "EVENT_TYPE_ACTIVITY_TASK_CANCELED",
+	18: "EVENT_TYPE_TIMER_STARTED",
+	19: "EVENT_TYPE_TIMER_FIRED",
+	20: "EVENT_TYPE_CANCEL_TIMER_FAILED",
+	21: "EVENT_TYPE_TIMER_CANCELED",
+	22: "EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED",
+	23: "EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED",
+	24: "EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED",
+	25: "EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED",
+	26: "EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED",
+	27: "EVENT_TYPE_MARKER_RECORDED",
+	28: "EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED",
+	29: "EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED",
+	30: "EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW",
+	31: "EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED",
+	32: "EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED",
+	33: "EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED",
+	34: "EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED",
+	35: "EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED",
+	36: "EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED",
+	37: "EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT",
+	38: "EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED",
+	39: "EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED",
+	40: "EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED",
+	41: "EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED",
+	42: "EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES",
+}
+
+var EventType_value = map[string]int32{
+	"EVENT_TYPE_INVALID":                                              0,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_STARTED":                           1,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED":                         2,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_FAILED":                            3,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT":                         4,
+	"EVENT_TYPE_DECISION_TASK_SCHEDULED":                              5,
+	"EVENT_TYPE_DECISION_TASK_STARTED":                                6,
+	"EVENT_TYPE_DECISION_TASK_COMPLETED":                              7,
+	"EVENT_TYPE_DECISION_TASK_TIMED_OUT":                              8,
+	"EVENT_TYPE_DECISION_TASK_FAILED":                                 9,
+	"EVENT_TYPE_ACTIVITY_TASK_SCHEDULED":                              10,
+	"EVENT_TYPE_ACTIVITY_TASK_STARTED":                                11,
+	"EVENT_TYPE_ACTIVITY_TASK_COMPLETED":                              12,
+	"EVENT_TYPE_ACTIVITY_TASK_FAILED":                                 13,
+	"EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT":                              14,
+	"EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED":                       15,
+	"EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED":                  16,
+	"EVENT_TYPE_ACTIVITY_TASK_CANCELED":                               17,
+	"EVENT_TYPE_TIMER_STARTED":                                        18,
+	"EVENT_TYPE_TIMER_FIRED":                                          19,
+	"EVENT_TYPE_CANCEL_TIMER_FAILED":                                  20,
+	"EVENT_TYPE_TIMER_CANCELED":                                       21,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED":                  22,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED":                          23,
+	"EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED": 24,
+	"EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED":    25,
+	"EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED":         26,
+	"EVENT_TYPE_MARKER_RECORDED":                                      27,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED":                          28,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED":                        29,
+	"EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW":                  30,
+	"EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED":             31,
+	"EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED":                32,
+	"EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED":                     33,
+	"EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED":                   34,
+	"EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED":                      35,
+	"EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED":                    36,
+	"EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT":                   37,
+	"EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED":                  38,
+	"EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED":         39,
+	"EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED":            40,
+	"EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED":                 41,
+	"EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES":                    42,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{1}
+}
+
 type History struct {
 	Events               []*HistoryEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -4331,6 +4479,7 @@ func (m *ChildWorkflowExecutionTerminatedEventAttributes) GetStartedEventId() in
 
 func init() {
 	proto.RegisterEnum("uber.cadence.api.v1.EventFilterType", EventFilterType_name, EventFilterType_value)
+	proto.RegisterEnum("uber.cadence.api.v1.EventType", EventType_name, EventType_value)
 	proto.RegisterType((*History)(nil), "uber.cadence.api.v1.History")
 	proto.RegisterType((*HistoryEvent)(nil), "uber.cadence.api.v1.HistoryEvent")
 	proto.RegisterType((*WorkflowExecutionStartedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionStartedEventAttributes")
@@ -4380,233 +4529,265 @@ func init() {
 func init() { proto.RegisterFile("uber/cadence/api/v1/history.proto", fileDescriptor_8237ca6511ad6c62) }
 
 var fileDescriptor_8237ca6511ad6c62 = []byte{
-	// 3609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-	0xd5, 0xef, 0xdd, 0xb5, 0x77, 0xbd, 0x67, 0x1d, 0xc7, 0xbe, 0x49, 0x1c, 0x3b, 0x7f, 0x9d, 0x49,
-	0x9a, 0xb8, 0x8e, 0xb3, 0x4e, 0x9c, 0x34, 0xf9, 0xd2, 0x7c, 0x6d, 0x3f, 0xc7, 0xb1, 0xd5, 0x95,
-	0xfc, 0x25, 0xd6, 0xc4, 0x49, 0x01, 0x21, 0x2d, 0xe3, 0x9d, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-	0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-	0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x2a, 0x81, 0x4a, 0x9f, 0x78,
-	0xe1, 0x81, 0xaa, 0xe2, 0x81, 0xbe, 0xf1, 0x40, 0x79, 0x43, 0x42, 0x73, 0xe7, 0xce, 0xee, 0xce,
-	0xcc, 0xbd, 0x33, 0x77, 0xd6, 0x4e, 0x0b, 0x6a, 0xde, 0x3c, 0x77, 0xce, 0x3d, 0xf3, 0xbb, 0xe7,
-	0x9e, 0x73, 0xee, 0xb9, 0xe7, 0x9c, 0x35, 0x9c, 0x6a, 0xad, 0x13, 0x6b, 0xae, 0xaa, 0xe9, 0xa4,
-	0x51, 0x25, 0x73, 0x5a, 0xd3, 0x98, 0xdb, 0xbe, 0x34, 0xb7, 0x69, 0xd8, 0x8e, 0x69, 0xed, 0x94,
-	0x9a, 0x96, 0xe9, 0x98, 0xf8, 0x80, 0x4b, 0x52, 0x62, 0x24, 0x25, 0xad, 0x69, 0x94, 0xb6, 0x2f,
-	0x1d, 0x39, 0xf1, 0xc0, 0x34, 0x1f, 0xd4, 0xc8, 0x1c, 0x25, 0x59, 0x6f, 0x6d, 0xcc, 0xe9, 0x2d,
-	0x4b, 0x73, 0x0c, 0xb3, 0xe1, 0x4d, 0x3a, 0x72, 0x32, 0xfc, 0xde, 0x31, 0xea, 0xc4, 0x76, 0xb4,
-	0x7a, 0x93, 0x11, 0x4c, 0xf1, 0x3e, 0x5c, 0x35, 0xeb, 0xf5, 0x0e, 0x0b, 0x85, 0x47, 0xe1, 0x68,
-	0xf6, 0x56, 0xcd, 0xb0, 0x9d, 0x38, 0x9a, 0xb6, 0x69, 0x6d, 0x6d, 0xd4, 0xcc, 0xb6, 0x47, 0xa3,
-	0xdc, 0x82, 0xfc, 0x0b, 0xde, 0x82, 0xf0, 0x75, 0xc8, 0x91, 0x6d, 0xd2, 0x70, 0xec, 0x09, 0x34,
-	0x95, 0x9d, 0x2e, 0xce, 0x9f, 0x2a, 0x71, 0xd6, 0x56, 0x62, 0xd4, 0x4b, 0x2e, 0xa5, 0xca, 0x26,
-	0x28, 0x1f, 0x5c, 0x83, 0xe1, 0xde, 0x17, 0x78, 0x12, 0x86, 0xe8, 0xab, 0x8a, 0xa1, 0x4f, 0xa0,
-	0x29, 0x34, 0x9d, 0x55, 0xf3, 0xf4, 0xb9, 0xac, 0xe3, 0xeb, 0x00, 0xde, 0x2b, 0x77, 0xd1, 0x13,
-	0x99, 0x29, 0x34, 0x5d, 0x9c, 0x3f, 0x52, 0xf2, 0x24, 0x52, 0xf2, 0x25, 0x52, 0x5a, 0xf3, 0x25,
-	0xa2, 0x16, 0x28, 0xb5, 0xfb, 0x8c, 0x27, 0x20, 0xbf, 0x4d, 0x2c, 0xdb, 0x30, 0x1b, 0x13, 0x59,
-	0x8f, 0x29, 0x7b, 0xc4, 0x87, 0x21, 0xef, 0x2e, 0xde, 0xfd, 0xdc, 0x00, 0x7d, 0x93, 0x73, 0x1f,
-	0xcb, 0x3a, 0xfe, 0x26, 0x82, 0xf3, 0xfe, 0x92, 0x2b, 0xe4, 0x21, 0xa9, 0xb6, 0xdc, 0x7d, 0xa8,
-	0xd8, 0x8e, 0x66, 0x39, 0x44, 0xaf, 0x78, 0x48, 0x34, 0xc7, 0xb1, 0x8c, 0xf5, 0x96, 0x43, 0xec,
-	0x89, 0x41, 0x8a, 0xe7, 0x7f, 0xb9, 0x4b, 0x7f, 0x91, 0xf1, 0x59, 0xf2, 0xd9, 0xdc, 0xf5, 0xb8,
-	0xd0, 0x25, 0x2f, 0x74, 0x78, 0xbc, 0xf0, 0x84, 0x7a, 0xae, 0x2d, 0x47, 0x8a, 0xbf, 0x8b, 0xe0,
-	0x02, 0x07, 0x5e, 0xd5, 0xac, 0x37, 0x6b, 0x84, 0x0b, 0x30, 0x47, 0x01, 0x3e, 0x27, 0x07, 0x70,
-	0xd1, 0xe7, 0x13, 0x85, 0xf8, 0x54, 0x5b, 0x96, 0x18, 0xbf, 0x8e, 0x60, 0x86, 0x03, 0x72, 0x43,
-	0x33, 0x6a, 0x3c, 0x84, 0x79, 0x8a, 0xf0, 0x86, 0x1c, 0xc2, 0x65, 0xca, 0x24, 0x0a, 0xef, 0x6c,
-	0x5b, 0x8a, 0x12, 0x7f, 0x87, 0x2f, 0x40, 0x57, 0xb7, 0xf4, 0x8a, 0xd9, 0x72, 0xa2, 0xf0, 0x86,
-	0x28, 0xbc, 0x67, 0xe5, 0xe0, 0xb9, 0x6a, 0xa7, 0xdf, 0x69, 0x39, 0x51, 0x80, 0xd3, 0x6d, 0x49,
-	0x5a, 0xfc, 0x1a, 0x82, 0x69, 0x9d, 0x54, 0x0d, 0x9b, 0x02, 0x73, 0xb5, 0xd4, 0xae, 0x6e, 0x12,
-	0xbd, 0xc5, 0x15, 0x5e, 0x81, 0xa2, 0xbb, 0xce, 0x45, 0x77, 0x8b, 0x31, 0x59, 0xd3, 0xec, 0xad,
-	0xbb, 0x3e, 0x8b, 0x28, 0xb2, 0x33, 0xba, 0x04, 0x1d, 0x7e, 0x05, 0xc1, 0xd9, 0x10, 0x2a, 0x91,
-	0x4d, 0x00, 0xc5, 0x74, 0x2d, 0x19, 0x93, 0xc8, 0x1c, 0x14, 0x3d, 0x91, 0x8a, 0x23, 0xa5, 0x18,
-	0x23, 0x28, 0x4a, 0x4a, 0x29, 0x46, 0xff, 0x03, 0x52, 0x12, 0xaa, 0xfe, 0xab, 0x11, 0x54, 0x31,
-	0x9a, 0x35, 0x4c, 0x51, 0xfd, 0x4f, 0x22, 0x2a, 0xb1, 0x52, 0x9d, 0xd6, 0x93, 0xc9, 0xf0, 0x97,
-	0x11, 0x3c, 0x19, 0xc4, 0x24, 0xb2, 0xc4, 0x7d, 0x14, 0xd0, 0xd5, 0x44, 0x40, 0x22, 0x23, 0x3c,
-	0xa5, 0x27, 0x11, 0xd1, 0x6d, 0xd3, 0xaa, 0x8e, 0xb1, 0x6d, 0x38, 0x3b, 0x89, 0xca, 0x3d, 0x12,
-	0xb3, 0x6d, 0x0b, 0x8c, 0x49, 0x92, 0x72, 0x6b, 0x12, 0x74, 0x54, 0xb9, 0x43, 0xa8, 0x44, 0xca,
-	0xbd, 0x3f, 0x46, 0xb9, 0x03, 0x98, 0x84, 0xca, 0xad, 0x25, 0x52, 0x71, 0xa4, 0x14, 0xa3, 0xdc,
-	0xa3, 0x92, 0x52, 0x8a, 0x53, 0x6e, 0x4d, 0x82, 0x8e, 0x2a, 0x52, 0x10, 0x95, 0x48, 0x91, 0xc6,
-	0x62, 0x14, 0xa9, 0x17, 0x92, 0x50, 0x91, 0xb4, 0x24, 0x22, 0x6a, 0x69, 0x41, 0x30, 0x31, 0x96,
-	0x86, 0x63, 0x2c, 0xad, 0x17, 0x4f, 0x8c, 0xa5, 0x69, 0xc9, 0x64, 0xb8, 0x0d, 0x27, 0x5c, 0x10,
-	0x96, 0x58, 0x7b, 0x0e, 0x50, 0x20, 0x17, 0xb9, 0x40, 0x5c, 0xae, 0x96, 0x50, 0x6d, 0x8e, 0x3a,
-	0xe2, 0xd7, 0xf8, 0x25, 0x38, 0xe6, 0x7d, 0x78, 0xc3, 0xb0, 0x78, 0x9f, 0x3d, 0x48, 0x3f, 0x5b,
-	0x12, 0x7f, 0x76, 0xd9, 0x9d, 0x17, 0xfd, 0xe8, 0xa4, 0x23, 0x7a, 0x89, 0xbf, 0x8f, 0x60, 0x2e,
-	0xa4, 0xa2, 0x5a, 0xa3, 0x4a, 0x6a, 0x15, 0x8b, 0xbc, 0xd4, 0x22, 0x36, 0x77, 0xf5, 0x87, 0x28,
-	0x8c, 0xe7, 0x93, 0x35, 0x95, 0x72, 0x52, 0x7d, 0x46, 0x51, 0x5c, 0x33, 0x9a, 0x34, 0x35, 0xfe,
-	0x09, 0x82, 0x2b, 0x0c, 0x93, 0x0f, 0x51, 0x4e, 0x89, 0xc7, 0x29, 0xda, 0x45, 0x2e, 0x5a, 0xf6,
-	0x35, 0xef, 0xd3, 0x32, 0x1a, 0x5d, 0xb2, 0x52, 0xcd, 0xc0, 0x5f, 0x45, 0x70, 0x8e, 0x27, 0x5e,
-	0x1e, 0xd0, 0xc3, 0x92, 0xda, 0xbd, 0xc8, 0x38, 0x24, 0x68, 0xb7, 0x80, 0x0c, 0x7f, 0x1e, 0x4e,
-	0x7a, 0x4a, 0x26, 0x46, 0x32, 0x41, 0x91, 0x5c, 0x12, 0xeb, 0x99, 0x18, 0x82, 0xa7, 0xc0, 0xa2,
-	0x6f, 0x7f, 0x09, 0xc1, 0x19, 0xb6, 0x79, 0x4c, 0xd1, 0x05, 0x9b, 0x36, 0x49, 0x11, 0x3c, 0xcd,
-	0x45, 0xe0, 0x31, 0xf7, 0xf4, 0x5d, 0xb0, 0x4d, 0x53, 0xd5, 0x04, 0x1a, 0xfc, 0x05, 0x98, 0xaa,
-	0x6b, 0xd6, 0x16, 0xb1, 0x2a, 0x16, 0xa9, 0x9a, 0x96, 0xce, 0x03, 0x71, 0x84, 0x82, 0x98, 0xe7,
-	0x82, 0xf8, 0x7f, 0x3a, 0x59, 0x65, 0x73, 0xa3, 0x08, 0x8e, 0xd7, 0xe3, 0x08, 0xf0, 0xb7, 0x11,
-	0xcc, 0xf2, 0xee, 0x27, 0xc6, 0x83, 0x86, 0xc6, 0x15, 0xc8, 0xd1, 0x34, 0xe1, 0xeb, 0x5d, 0xc6,
-	0x46, 0x26, 0x7c, 0x15, 0xd0, 0xe2, 0xef, 0x21, 0x28, 0xf1, 0x22, 0x6c, 0x62, 0xd5, 0x8d, 0x86,
-	0xc6, 0xf5, 0x0b, 0xc7, 0x62, 0xfc, 0x42, 0x34, 0xc4, 0xee, 0x30, 0xe2, 0xf8, 0x85, 0xb6, 0x34,
-	0x35, 0xfe, 0x29, 0x82, 0x2b, 0xbc, 0xab, 0x54, 0xa2, 0x17, 0x3b, 0x4e, 0xd1, 0xde, 0x92, 0xbc,
-	0x51, 0x25, 0xb9, 0xb2, 0xb9, 0x76, 0xba, 0x29, 0x22, 0x0d, 0x10, 0x1b, 0xe5, 0x89, 0x34, 0x1a,
-	0x20, 0x36, 0xd0, 0xe9, 0xb6, 0x24, 0x2d, 0xfe, 0x2b, 0x82, 0xa5, 0x90, 0xc7, 0x25, 0x0f, 0x1d,
-	0x62, 0x35, 0xb4, 0x5a, 0x85, 0x83, 0xdc, 0x68, 0x18, 0x8e, 0xc1, 0x57, 0x8c, 0x93, 0x14, 0xfa,
-	0xdd, 0x64, 0x17, 0xbc, 0xc4, 0xf8, 0x47, 0xd6, 0x53, 0xf6, 0x99, 0x47, 0x17, 0xf4, 0x9c, 0xb5,
-	0x2b, 0x0e, 0xf8, 0xcf, 0x08, 0x6e, 0xa6, 0x58, 0xa6, 0xc8, 0x63, 0x4d, 0xd1, 0x35, 0xae, 0xee,
-	0x62, 0x8d, 0x22, 0x67, 0x76, 0xc3, 0xea, 0x7f, 0x3a, 0x7e, 0x07, 0xc1, 0xb3, 0x71, 0xcb, 0x49,
-	0xb6, 0x93, 0x53, 0x74, 0x61, 0x2b, 0xdc, 0x85, 0x09, 0xc1, 0x24, 0xda, 0xcb, 0x35, 0xd2, 0xdf,
-	0x54, 0x1a, 0x07, 0x70, 0x53, 0x27, 0x0d, 0xc7, 0x68, 0xb4, 0x88, 0x5e, 0xd1, 0xec, 0x4a, 0x83,
-	0xb4, 0xa3, 0xeb, 0x50, 0x62, 0xe2, 0x00, 0x4e, 0x06, 0x85, 0xb1, 0x5b, 0xb0, 0x6f, 0x93, 0x36,
-	0x27, 0x0e, 0x68, 0xa7, 0x9a, 0x81, 0x7f, 0x83, 0xe0, 0x3a, 0x8d, 0x26, 0x2b, 0xd5, 0x4d, 0xa3,
-	0xa6, 0xa7, 0xb4, 0x9f, 0xd3, 0x14, 0xfa, 0x0b, 0x5c, 0xe8, 0x34, 0x94, 0x5c, 0x74, 0x99, 0xa6,
-	0x31, 0x9a, 0xcb, 0x76, 0xfa, 0x69, 0xf8, 0x17, 0x08, 0xae, 0x26, 0x2c, 0x42, 0x64, 0x1d, 0x67,
-	0xe8, 0x0a, 0x96, 0xd2, 0xae, 0x40, 0x64, 0x12, 0x17, 0xed, 0x94, 0x73, 0xf0, 0x0f, 0x11, 0x5c,
-	0x12, 0xa2, 0x16, 0xc6, 0xf9, 0x4f, 0x52, 0xd8, 0x0b, 0xfc, 0x30, 0x84, 0xfb, 0x75, 0x61, 0xe0,
-	0x3f, 0x5b, 0x4d, 0x41, 0x8f, 0x7f, 0x8c, 0xe0, 0xb2, 0x10, 0x6e, 0xcc, 0x25, 0xf2, 0x6c, 0x8c,
-	0x92, 0xf3, 0x01, 0xc7, 0x5c, 0x27, 0x4b, 0xd5, 0x54, 0x33, 0xf0, 0x9b, 0x08, 0x2e, 0xa6, 0xd6,
-	0x8c, 0x73, 0x14, 0xf1, 0xff, 0xa5, 0x40, 0x2c, 0x52, 0x8a, 0xf3, 0xd5, 0x14, 0xfa, 0xf0, 0x16,
-	0x82, 0x79, 0xb1, 0x80, 0x85, 0x87, 0xf0, 0x34, 0x45, 0x7b, 0x33, 0x8d, 0x7c, 0x85, 0x27, 0xf1,
-	0x85, 0x6a, 0x9a, 0x09, 0xf8, 0x47, 0x71, 0x2a, 0x11, 0x73, 0x69, 0x7e, 0x2a, 0x35, 0x64, 0xf1,
-	0xf5, 0x59, 0x00, 0x59, 0x74, 0x91, 0x76, 0x63, 0x33, 0x31, 0xe4, 0x98, 0x48, 0x72, 0x26, 0x26,
-	0x36, 0x13, 0x60, 0x8e, 0x09, 0x27, 0xe7, 0xaa, 0xe9, 0xa6, 0xd0, 0x43, 0xd3, 0x0b, 0xc5, 0xfb,
-	0x8d, 0x78, 0xce, 0xc7, 0x1c, 0x9a, 0x5e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xee, 0x6f, 0x2a,
-	0xfe, 0x2d, 0x82, 0x67, 0x24, 0x16, 0x24, 0xb2, 0xd1, 0x59, 0xba, 0x9a, 0x72, 0x3f, 0xab, 0x11,
-	0x19, 0xeb, 0x15, 0xbb, 0x8f, 0x79, 0xf8, 0xe7, 0x08, 0x9e, 0x8e, 0x5b, 0x80, 0xf8, 0xfe, 0x74,
-	0x21, 0xe6, 0x00, 0x12, 0x82, 0x10, 0xdf, 0xa3, 0x2e, 0x92, 0x94, 0x73, 0xa8, 0xc3, 0x69, 0x35,
-	0x6d, 0x62, 0x39, 0x5d, 0xe0, 0x36, 0xd1, 0xac, 0xea, 0x66, 0x0f, 0xcc, 0x28, 0xee, 0x52, 0x8c,
-	0xf5, 0xde, 0xa3, 0xec, 0x7c, 0x04, 0x77, 0x29, 0xb3, 0xee, 0x17, 0x39, 0xd6, 0xdb, 0x4a, 0x33,
-	0xe1, 0xe6, 0x30, 0x40, 0x17, 0x88, 0xf2, 0x5e, 0x11, 0xce, 0xc9, 0x9e, 0x5e, 0xcb, 0xb0, 0xaf,
-	0xb3, 0x46, 0x67, 0xa7, 0x49, 0x68, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x99, 0xae, 0xed, 0x34, 0x89,
-	0x3a, 0xdc, 0xee, 0x79, 0xc2, 0x9f, 0x85, 0x43, 0x4d, 0xcd, 0x72, 0x25, 0xd2, 0x6b, 0x74, 0x1b,
-	0x26, 0x2b, 0x1f, 0x4e, 0x73, 0xf9, 0xad, 0xd2, 0x19, 0x3d, 0x36, 0xb1, 0x61, 0xaa, 0x07, 0x9a,
-	0xd1, 0x41, 0xfc, 0x0c, 0x14, 0x68, 0x46, 0xa6, 0x66, 0xd8, 0x0e, 0x2d, 0x2c, 0x16, 0xe7, 0x8f,
-	0xf3, 0x53, 0x1e, 0x9a, 0xbd, 0xb5, 0x62, 0xd8, 0x8e, 0x3a, 0xe4, 0xb0, 0xbf, 0xf0, 0x3c, 0x0c,
-	0x1a, 0x8d, 0x66, 0xcb, 0xa1, 0x65, 0xc7, 0xe2, 0xfc, 0x31, 0x01, 0x92, 0x9d, 0x9a, 0xa9, 0xe9,
-	0xaa, 0x47, 0x8a, 0x35, 0x98, 0x0a, 0x85, 0x1c, 0x15, 0xc7, 0xac, 0x54, 0x6b, 0xa6, 0x4d, 0xa8,
-	0xff, 0x36, 0x5b, 0x0e, 0xab, 0x43, 0x4e, 0x46, 0xea, 0xa2, 0xb7, 0x58, 0x25, 0x59, 0x3d, 0x46,
-	0x02, 0xb2, 0x5f, 0x33, 0x17, 0xdd, 0xf9, 0x6b, 0xde, 0x74, 0xfc, 0x22, 0x1c, 0xed, 0xa6, 0xbd,
-	0xa3, 0xdc, 0x73, 0x49, 0xdc, 0x0f, 0x3b, 0x7e, 0x32, 0x3b, 0xc4, 0xf8, 0x06, 0x1c, 0xe9, 0x46,
-	0xd8, 0xdd, 0x55, 0x58, 0xad, 0x46, 0xc5, 0xd0, 0x69, 0xe9, 0xaf, 0xa0, 0x1e, 0xee, 0x50, 0x74,
-	0xe4, 0xac, 0xb6, 0x1a, 0x65, 0x1d, 0x97, 0xa1, 0xc0, 0x5c, 0xa5, 0x69, 0xd1, 0x3a, 0xdc, 0xc8,
-	0xfc, 0x79, 0xbe, 0x6b, 0x67, 0x0c, 0x68, 0x08, 0x5d, 0xf6, 0xa7, 0xa8, 0xdd, 0xd9, 0xb8, 0x0c,
-	0x63, 0x5d, 0x1c, 0xae, 0xbb, 0x6a, 0x59, 0x84, 0x15, 0xcf, 0xf8, 0x7b, 0xb0, 0xec, 0xd1, 0xa8,
-	0xa3, 0x9d, 0x69, 0x6c, 0x04, 0xab, 0x30, 0x5e, 0xd3, 0xdc, 0x3b, 0x9f, 0x17, 0xce, 0xd0, 0xe5,
-	0x10, 0xbb, 0x55, 0x73, 0x58, 0xe1, 0x2b, 0x7e, 0x4f, 0x0f, 0xba, 0x73, 0x17, 0x3b, 0x53, 0x55,
-	0x3a, 0x13, 0x5f, 0x87, 0x49, 0xd3, 0x32, 0x1e, 0x18, 0x9e, 0xa3, 0x0d, 0x49, 0xa9, 0x48, 0xa5,
-	0x34, 0xee, 0x13, 0x84, 0x84, 0x74, 0x04, 0x86, 0x0c, 0x9d, 0x34, 0x1c, 0xc3, 0xd9, 0xa1, 0x15,
-	0xa5, 0x82, 0xda, 0x79, 0xc6, 0x97, 0x61, 0x7c, 0xc3, 0xb0, 0x6c, 0x27, 0xca, 0x73, 0x1f, 0xa5,
-	0x3c, 0x40, 0xdf, 0x86, 0x18, 0x2e, 0xc2, 0xb0, 0x45, 0x1c, 0x6b, 0xa7, 0xd2, 0x34, 0x6b, 0x46,
-	0x75, 0x87, 0x55, 0x61, 0xa6, 0x04, 0x17, 0x54, 0xc7, 0xda, 0x59, 0xa5, 0x74, 0x6a, 0xd1, 0xea,
-	0x3e, 0xe0, 0x09, 0xc8, 0x6b, 0x8e, 0x43, 0xea, 0x4d, 0x87, 0x56, 0x4c, 0x06, 0x55, 0xff, 0x11,
-	0x2f, 0xc2, 0x7e, 0xf2, 0xb0, 0x69, 0x78, 0x8a, 0xe3, 0x15, 0xf5, 0x47, 0x13, 0x8b, 0xfa, 0x23,
-	0xdd, 0x29, 0xb4, 0xb2, 0x7f, 0x1a, 0xf6, 0x55, 0x2d, 0xd7, 0x1a, 0x58, 0x45, 0x87, 0x56, 0x1c,
-	0x0a, 0xea, 0xb0, 0x3b, 0xe8, 0x57, 0x79, 0xf0, 0xa7, 0xe0, 0xa8, 0xb7, 0xfa, 0x60, 0xf5, 0x6b,
-	0x5d, 0xab, 0x6e, 0x99, 0x1b, 0x1b, 0xac, 0x28, 0x10, 0xa3, 0xd4, 0x13, 0x74, 0x76, 0x6f, 0xe1,
-	0xeb, 0xa6, 0x37, 0x15, 0x5f, 0x80, 0x81, 0x3a, 0xa9, 0x9b, 0x2c, 0x9d, 0x3f, 0xc9, 0x4f, 0xf4,
-	0x91, 0xba, 0xa9, 0x52, 0x32, 0xac, 0xc2, 0x58, 0xc4, 0x63, 0xb3, 0x9c, 0xfc, 0x93, 0xfc, 0xb3,
-	0x31, 0xe4, 0x61, 0xd5, 0x51, 0x3b, 0x34, 0x82, 0xef, 0xc1, 0x78, 0xd3, 0x22, 0xdb, 0x15, 0xad,
-	0xe5, 0x98, 0xae, 0xfe, 0x11, 0xa7, 0xd2, 0x34, 0x8d, 0x86, 0xe3, 0x67, 0xd9, 0x45, 0xfb, 0x65,
-	0x13, 0x67, 0x95, 0xd2, 0xa9, 0x07, 0xdc, 0xf9, 0x0b, 0x2d, 0xc7, 0xec, 0x19, 0xc4, 0x97, 0x21,
-	0xb7, 0x49, 0x34, 0x9d, 0x58, 0x2c, 0xfd, 0x7d, 0x94, 0xdf, 0xd4, 0x41, 0x49, 0x54, 0x46, 0xaa,
-	0xbc, 0x89, 0xe0, 0x29, 0xf9, 0x68, 0xff, 0x0a, 0xe4, 0x98, 0xbd, 0x20, 0x09, 0x7b, 0x61, 0xb4,
-	0x78, 0x19, 0xa6, 0xe2, 0xcb, 0xbd, 0x86, 0x4e, 0xbd, 0x7b, 0x56, 0x3d, 0x26, 0xae, 0xd4, 0x96,
-	0x75, 0xe5, 0x0d, 0x04, 0x67, 0x25, 0x83, 0x86, 0xab, 0x90, 0xf7, 0x3d, 0x05, 0x92, 0xf0, 0x14,
-	0x3e, 0xf1, 0x9e, 0x41, 0x35, 0x61, 0x5a, 0x3a, 0x62, 0x5e, 0x84, 0x61, 0xe6, 0xac, 0xbb, 0x07,
-	0xe7, 0x88, 0x40, 0x09, 0x98, 0x6f, 0xa6, 0xe7, 0x66, 0xd1, 0xe9, 0x3e, 0x28, 0x7f, 0x40, 0x70,
-	0x46, 0xa6, 0x69, 0x20, 0x78, 0x02, 0xa2, 0x74, 0x27, 0xe0, 0x6d, 0x18, 0x17, 0x9c, 0x32, 0x99,
-	0x24, 0x83, 0x3c, 0x60, 0x73, 0x4e, 0x98, 0x1e, 0x4f, 0x93, 0x0d, 0x78, 0x1a, 0xe5, 0x15, 0x04,
-	0x4a, 0x72, 0xbf, 0x01, 0x9e, 0x05, 0x1c, 0xae, 0x41, 0x77, 0xba, 0x90, 0x46, 0xed, 0x80, 0x08,
-	0x42, 0xee, 0x36, 0x13, 0x72, 0xb7, 0xc7, 0x01, 0xfc, 0x84, 0xa0, 0xa1, 0x53, 0x34, 0x05, 0xb5,
-	0xc0, 0x46, 0xca, 0xba, 0xf2, 0xf7, 0x90, 0x78, 0x85, 0x16, 0x92, 0x0e, 0xd1, 0x34, 0x8c, 0x06,
-	0xf3, 0x10, 0x1d, 0xf5, 0x1a, 0xb1, 0x7b, 0x56, 0x1c, 0xc2, 0x9e, 0x0d, 0x61, 0x3f, 0x07, 0xfb,
-	0xd7, 0x8d, 0x86, 0x66, 0xed, 0x54, 0xaa, 0x9b, 0xa4, 0xba, 0x65, 0xb7, 0xea, 0x34, 0x44, 0x29,
-	0xa8, 0x23, 0xde, 0xf0, 0x22, 0x1b, 0xc5, 0xe7, 0x61, 0x2c, 0x98, 0x3d, 0x23, 0x0f, 0xbd, 0xf0,
-	0x63, 0x58, 0x1d, 0x25, 0xbd, 0x49, 0x2d, 0xf2, 0xd0, 0x51, 0x5e, 0xce, 0xc2, 0x69, 0x89, 0x56,
-	0x86, 0x47, 0xb6, 0xe2, 0xb0, 0x59, 0x64, 0xfb, 0x30, 0x0b, 0x7c, 0x02, 0x8a, 0xeb, 0x9a, 0x4d,
-	0xfc, 0xa3, 0xd3, 0x13, 0x4b, 0xc1, 0x1d, 0xf2, 0x0e, 0xcc, 0x63, 0x00, 0x0d, 0xd2, 0xf6, 0x5f,
-	0x0f, 0x7a, 0x82, 0x6d, 0x90, 0xb6, 0xf7, 0x76, 0x16, 0xf0, 0x86, 0x69, 0x6d, 0x31, 0xa4, 0x7e,
-	0x3f, 0x5a, 0xce, 0x5b, 0x9a, 0xfb, 0x86, 0x62, 0xbd, 0xcf, 0x1a, 0xd3, 0xc6, 0x5d, 0xe7, 0xa8,
-	0xd9, 0x66, 0x83, 0xc5, 0x46, 0xec, 0x09, 0xdf, 0x82, 0xc1, 0xaa, 0xd6, 0xb2, 0x09, 0x0b, 0x83,
-	0x4a, 0xd2, 0x4d, 0x23, 0x8b, 0xee, 0x2c, 0xd5, 0x9b, 0xac, 0xbc, 0x91, 0x85, 0x53, 0x89, 0x8d,
-	0x1c, 0x8f, 0x6c, 0x33, 0x6e, 0xfa, 0x6b, 0xf0, 0x76, 0x61, 0x56, 0xb2, 0xcf, 0xa4, 0x77, 0x05,
-	0xbd, 0x3e, 0x79, 0x20, 0x8d, 0x4f, 0xee, 0x55, 0xfd, 0xc1, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17,
-	0xbf, 0xbf, 0x79, 0xa9, 0xfd, 0x1d, 0x12, 0xec, 0x2f, 0xc7, 0xcc, 0x0a, 0x3c, 0x33, 0x53, 0xbe,
-	0x95, 0x83, 0x33, 0x32, 0x3d, 0x2e, 0xf8, 0x24, 0x14, 0x3b, 0x85, 0x62, 0xb6, 0x4d, 0x05, 0x15,
-	0xfc, 0xa1, 0xb2, 0xee, 0x5e, 0xaa, 0xba, 0x95, 0x64, 0xd7, 0x08, 0x32, 0x31, 0x97, 0xaa, 0xce,
-	0x27, 0xe9, 0xa5, 0x4a, 0xeb, 0x79, 0x72, 0x55, 0x53, 0x37, 0xeb, 0x9a, 0xd1, 0x60, 0xbe, 0x83,
-	0x3d, 0x05, 0x0f, 0x83, 0x81, 0x3e, 0xaf, 0x43, 0x39, 0xf9, 0xeb, 0xd0, 0x1a, 0x4c, 0xfa, 0x4a,
-	0x18, 0x3d, 0x43, 0xf2, 0x49, 0x67, 0xc8, 0xb8, 0x3f, 0x37, 0x74, 0x8c, 0x84, 0xb8, 0xb2, 0x23,
-	0x8a, 0x71, 0x1d, 0x4a, 0xc1, 0xd5, 0xbb, 0x05, 0x31, 0xae, 0xe2, 0xc3, 0xae, 0xd0, 0xd7, 0x61,
-	0xb7, 0x0c, 0x63, 0x9b, 0x44, 0xb3, 0x9c, 0x75, 0xa2, 0x75, 0xd1, 0x41, 0x12, 0xab, 0xd1, 0xce,
-	0x9c, 0x2e, 0x9f, 0xe4, 0x10, 0xa5, 0x98, 0x1c, 0xa2, 0x44, 0xee, 0x0a, 0xc3, 0xfd, 0xdc, 0x15,
-	0xba, 0x31, 0xe7, 0x3e, 0xf9, 0x98, 0xf3, 0x6f, 0x08, 0x94, 0xe4, 0x7e, 0xab, 0x8f, 0xec, 0x70,
-	0xef, 0x0d, 0x43, 0x06, 0x82, 0x17, 0x9e, 0xe7, 0x61, 0x98, 0xde, 0x17, 0x7d, 0xbf, 0x35, 0x28,
-	0xe1, 0xb7, 0x8a, 0xee, 0x0c, 0xf6, 0xa0, 0xbc, 0x8b, 0x82, 0xae, 0x60, 0x8f, 0x23, 0x6b, 0xbe,
-	0x88, 0x32, 0x29, 0xdc, 0x7d, 0x36, 0x31, 0xda, 0x18, 0x08, 0x0a, 0x53, 0xf9, 0x23, 0x82, 0x53,
-	0xc9, 0x4d, 0x30, 0xfd, 0x06, 0xe0, 0x1f, 0xc7, 0x8a, 0x7e, 0x99, 0x81, 0xd3, 0x12, 0xad, 0x64,
-	0xee, 0x9a, 0x74, 0xe2, 0x68, 0x46, 0xcd, 0x96, 0xda, 0x24, 0x9f, 0xf8, 0x91, 0xad, 0x29, 0x1c,
-	0x21, 0x0d, 0xf4, 0x13, 0x21, 0xed, 0x5a, 0xc5, 0xbf, 0x86, 0x60, 0x46, 0xbe, 0x03, 0x4c, 0xe6,
-	0xcc, 0xdb, 0x9b, 0x2b, 0xd8, 0x5b, 0x08, 0x52, 0xf6, 0x7a, 0x25, 0x63, 0x3b, 0xe8, 0x87, 0x41,
-	0x9e, 0x87, 0x61, 0x81, 0x8d, 0x0c, 0xe2, 0xac, 0x04, 0xe2, 0xd7, 0x43, 0x7a, 0x28, 0xaa, 0x0a,
-	0xf5, 0xab, 0x87, 0xcb, 0x30, 0x55, 0xd3, 0x9c, 0x9e, 0x9e, 0x87, 0x70, 0x07, 0x40, 0x57, 0xb2,
-	0x1e, 0x1d, 0x6f, 0x2b, 0xbd, 0xb0, 0x89, 0xa3, 0xcf, 0xd9, 0x14, 0xfa, 0x3c, 0x90, 0x68, 0xa3,
-	0xa1, 0x40, 0x4f, 0x79, 0x07, 0xc1, 0xd1, 0x98, 0x2e, 0x4b, 0x3c, 0x09, 0x43, 0x5e, 0x77, 0x59,
-	0x67, 0xdf, 0xf2, 0xf4, 0xb9, 0xac, 0xe3, 0x15, 0x38, 0xd4, 0x39, 0xc8, 0x37, 0x0c, 0x2b, 0xc5,
-	0xa5, 0x15, 0xb3, 0x73, 0x7c, 0xd9, 0xb0, 0x48, 0x9a, 0xe3, 0x57, 0x66, 0xb3, 0x3f, 0x07, 0x93,
-	0xc2, 0xf6, 0xcd, 0xb8, 0xd5, 0x48, 0xc7, 0xec, 0xca, 0xef, 0x10, 0x1c, 0x8b, 0xeb, 0xdc, 0xdb,
-	0x93, 0xaf, 0xec, 0x95, 0x3c, 0x62, 0x1d, 0xf4, 0xcf, 0x10, 0x4c, 0x25, 0x75, 0x00, 0xc6, 0xad,
-	0xe6, 0x91, 0x9a, 0x6d, 0x2c, 0xf2, 0x7f, 0xe5, 0x21, 0x65, 0xa3, 0x09, 0x9e, 0x83, 0x83, 0xb4,
-	0x97, 0x25, 0x9c, 0xf6, 0xf5, 0xd6, 0x34, 0xd6, 0x20, 0xed, 0x50, 0xd2, 0x37, 0x52, 0x79, 0xc9,
-	0xf4, 0x57, 0x79, 0x79, 0x5c, 0x1b, 0x91, 0xaf, 0x8d, 0xc8, 0xe8, 0x4e, 0x5e, 0x42, 0x77, 0xee,
-	0xc0, 0x38, 0xcb, 0x69, 0x33, 0x8c, 0x46, 0xc3, 0x21, 0xd6, 0xb6, 0x56, 0x4b, 0xbe, 0xb7, 0x1c,
-	0x64, 0x13, 0x29, 0xbc, 0x32, 0x9b, 0x16, 0xac, 0xbb, 0x14, 0x76, 0x55, 0x77, 0xe9, 0x09, 0xe1,
-	0x20, 0x4d, 0x08, 0x27, 0x2e, 0xb2, 0x14, 0xfb, 0x2e, 0xb2, 0x74, 0xef, 0x19, 0xc3, 0xd2, 0xf7,
-	0x8c, 0x4e, 0xaa, 0x7f, 0xdf, 0x2e, 0x52, 0xfd, 0x23, 0xbb, 0x4a, 0xf5, 0xbb, 0x3e, 0x78, 0x2e,
-	0x6d, 0xb7, 0x5b, 0xc7, 0x5b, 0xa1, 0x5e, 0x6f, 0x15, 0x77, 0xbf, 0x59, 0x87, 0xc3, 0x9d, 0x0a,
-	0x79, 0xa8, 0x6a, 0xea, 0xd9, 0xf1, 0x4c, 0x6c, 0x0d, 0x3c, 0x58, 0x37, 0x3d, 0x44, 0x78, 0xc3,
-	0xca, 0x0f, 0x10, 0x27, 0xa5, 0x2d, 0x3a, 0x59, 0x64, 0xcc, 0x03, 0x49, 0x98, 0x47, 0x4f, 0xa4,
-	0x93, 0x49, 0x11, 0xe9, 0x28, 0x1f, 0x22, 0x38, 0x1e, 0xdb, 0xad, 0xed, 0x86, 0x7a, 0xac, 0x17,
-	0xbc, 0xa1, 0xd5, 0x7d, 0x51, 0x83, 0x37, 0x74, 0x5b, 0xab, 0x93, 0x7e, 0x3f, 0xbd, 0x67, 0xa7,
-	0x4a, 0x57, 0xe3, 0x07, 0xe4, 0x6f, 0xd6, 0xdf, 0xe0, 0x6d, 0x92, 0xa8, 0x3b, 0xe1, 0x24, 0x14,
-	0x59, 0x7f, 0x48, 0xaf, 0x08, 0xbc, 0x21, 0x2a, 0x82, 0x8e, 0x53, 0xcf, 0xc8, 0x3b, 0xf5, 0x98,
-	0x3c, 0xb5, 0xf2, 0x75, 0x04, 0x33, 0x29, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x14, 0xc8, 0xa7, 0xf6,
-	0xbb, 0x33, 0x71, 0xd0, 0x7e, 0x9d, 0x81, 0xe7, 0x76, 0xd7, 0x95, 0xbc, 0x67, 0x3a, 0xdf, 0xcd,
-	0xd5, 0x65, 0x02, 0xb9, 0xba, 0x7b, 0x80, 0xa3, 0xdd, 0x2f, 0xcc, 0xbe, 0xcf, 0xca, 0x75, 0xb8,
-	0xaa, 0x63, 0x91, 0x16, 0x56, 0x3c, 0x01, 0xf9, 0xaa, 0xd9, 0x70, 0x2c, 0xb3, 0x46, 0x15, 0x6d,
-	0x58, 0xf5, 0x1f, 0x71, 0x09, 0x0e, 0x84, 0x1a, 0xb9, 0xcc, 0x46, 0xcd, 0x8b, 0xcc, 0x87, 0xd4,
-	0xb1, 0x40, 0x7f, 0xd5, 0x9d, 0x46, 0x6d, 0x47, 0x79, 0x2d, 0x0b, 0x37, 0x76, 0xd1, 0xf5, 0x8c,
-	0xef, 0xf5, 0xfa, 0xbd, 0x11, 0xc1, 0x6f, 0x0a, 0xa4, 0x38, 0x07, 0xd2, 0xce, 0x7b, 0x74, 0x9f,
-	0x14, 0xe6, 0x50, 0xf9, 0xfb, 0x32, 0xb0, 0xdb, 0x7d, 0x99, 0x05, 0x1c, 0xee, 0x35, 0x63, 0x15,
-	0x8a, 0xac, 0x3a, 0x6a, 0x04, 0x94, 0xd0, 0x4b, 0x61, 0xf9, 0xbb, 0x98, 0x0b, 0xec, 0xa2, 0xf2,
-	0x27, 0x04, 0xd7, 0xfa, 0x6c, 0xd9, 0x16, 0x60, 0x40, 0x02, 0x0c, 0x1f, 0xad, 0xe2, 0x2a, 0x5f,
-	0xc9, 0xc2, 0xb5, 0x3e, 0xdb, 0xea, 0xfe, 0x5b, 0x6d, 0x35, 0xe4, 0xb1, 0x07, 0xc4, 0x1e, 0x7b,
-	0x50, 0xde, 0x63, 0x0b, 0x55, 0x47, 0xe4, 0x00, 0xf2, 0x22, 0x07, 0xf0, 0x72, 0x16, 0xae, 0xf4,
-	0xd3, 0x1a, 0x28, 0x67, 0xf9, 0x52, 0x9c, 0x1f, 0x5b, 0x7e, 0xd7, 0xf2, 0x3f, 0x40, 0x70, 0x31,
-	0x6d, 0x9b, 0xe3, 0x7f, 0xb4, 0xc9, 0x8b, 0xcf, 0x2a, 0xe5, 0xf7, 0x08, 0x2e, 0xa4, 0x6a, 0x8d,
-	0xdc, 0x33, 0x17, 0xc0, 0xbd, 0x35, 0x64, 0x76, 0x77, 0x6b, 0xf8, 0xcb, 0x10, 0x5c, 0xee, 0xe3,
-	0x37, 0x1e, 0x3d, 0xdb, 0x81, 0x02, 0xdb, 0x71, 0x12, 0x8a, 0x9d, 0xed, 0x60, 0x3a, 0x5f, 0x50,
-	0xc1, 0x1f, 0xe2, 0xa5, 0x10, 0xb2, 0x7b, 0x90, 0x42, 0xe8, 0xb7, 0x9e, 0x38, 0xb8, 0xb7, 0x29,
-	0x84, 0xdc, 0x23, 0x4d, 0x21, 0xe4, 0xfb, 0x4e, 0x21, 0xdc, 0x07, 0xd6, 0xa1, 0xca, 0x38, 0xb2,
-	0x32, 0x9c, 0xd7, 0x24, 0x70, 0x36, 0xa6, 0xcd, 0x95, 0x72, 0x61, 0xc5, 0xb8, 0xb1, 0x66, 0x78,
-	0xa8, 0xd7, 0x48, 0x0a, 0x41, 0x7f, 0x2e, 0xa3, 0xf2, 0x20, 0xa1, 0xf2, 0x55, 0x98, 0xe8, 0x51,
-	0xa7, 0x8a, 0x45, 0x5a, 0x5d, 0xf8, 0x45, 0x0a, 0x7f, 0x26, 0x56, 0x71, 0xca, 0xba, 0xea, 0x4e,
-	0x61, 0x4b, 0x38, 0xd4, 0xe6, 0x0d, 0x47, 0xca, 0x93, 0xfb, 0xfa, 0x29, 0x4f, 0x46, 0x7a, 0x0d,
-	0x47, 0x38, 0xbd, 0x86, 0xdd, 0x9b, 0xd6, 0xfe, 0xf4, 0xb9, 0x85, 0xd1, 0x5d, 0xe4, 0x16, 0xc6,
-	0x76, 0xd7, 0x46, 0xf8, 0x0c, 0x14, 0x75, 0x52, 0xd3, 0x76, 0x3c, 0xd5, 0x4c, 0xee, 0x89, 0x04,
-	0x4a, 0x4d, 0x55, 0x51, 0x79, 0x35, 0x0b, 0x17, 0xd3, 0xfe, 0x06, 0xeb, 0xe3, 0x77, 0x2f, 0x2b,
-	0x7e, 0x9c, 0xe0, 0x55, 0xba, 0xae, 0xa6, 0xfe, 0x01, 0x51, 0x20, 0x3c, 0xe8, 0x31, 0x94, 0xc1,
-	0xa0, 0xa1, 0xf0, 0x0f, 0xc1, 0x9c, 0xe0, 0x10, 0xdc, 0xa3, 0x5c, 0xa0, 0xf2, 0x76, 0x06, 0x66,
-	0xd3, 0xfc, 0xc0, 0x4c, 0xb8, 0x1f, 0xfc, 0xd3, 0x37, 0xb3, 0xdb, 0xd3, 0x77, 0xaf, 0x76, 0x91,
-	0x2f, 0xdd, 0x01, 0x81, 0x74, 0xbb, 0xd6, 0x39, 0x28, 0x9f, 0x07, 0xf9, 0x30, 0x03, 0x29, 0x7f,
-	0xfa, 0xf6, 0xc9, 0x10, 0x26, 0xaf, 0xac, 0x33, 0xc8, 0x2d, 0xeb, 0x74, 0xfb, 0x11, 0x72, 0xf2,
-	0xfd, 0x08, 0xca, 0x3f, 0x32, 0x70, 0x7e, 0x2f, 0x3c, 0xca, 0x27, 0x54, 0xe8, 0x3d, 0x19, 0xf7,
-	0x5c, 0x8a, 0x8c, 0xbb, 0xf2, 0xcf, 0x0c, 0x5c, 0x48, 0xf5, 0x4b, 0xc4, 0xc7, 0x82, 0x8f, 0x08,
-	0xde, 0x4f, 0x29, 0xe6, 0xd2, 0xe4, 0x99, 0xbf, 0x98, 0x15, 0x09, 0x5e, 0xd4, 0x43, 0xf2, 0x58,
-	0xf0, 0xb1, 0x2d, 0x2c, 0xb9, 0x7e, 0x7a, 0xdf, 0x7f, 0x95, 0x81, 0xb9, 0x94, 0xbf, 0x10, 0x7d,
-	0xbc, 0x0f, 0x81, 0x7d, 0x98, 0x71, 0x60, 0x3f, 0xfd, 0x73, 0xd9, 0xa8, 0x39, 0xc4, 0xa2, 0x9f,
-	0x3a, 0x0e, 0x93, 0x4b, 0xf7, 0x97, 0x6e, 0xaf, 0x55, 0x96, 0xcb, 0x2b, 0x6b, 0x4b, 0x6a, 0x65,
-	0xed, 0xd3, 0xab, 0x4b, 0x95, 0xf2, 0xed, 0xfb, 0x0b, 0x2b, 0xe5, 0x5b, 0xa3, 0x4f, 0xe0, 0x93,
-	0x70, 0x34, 0xfa, 0x7a, 0x61, 0x65, 0xa5, 0x42, 0x47, 0x47, 0x11, 0x3e, 0x05, 0xc7, 0xa3, 0x04,
-	0x8b, 0x2b, 0x77, 0xee, 0x2e, 0x31, 0x92, 0xcc, 0xcd, 0xf5, 0xb7, 0xdf, 0x3f, 0x81, 0xde, 0x7d,
-	0xff, 0x04, 0x7a, 0xef, 0xfd, 0x13, 0x08, 0x0e, 0x57, 0xcd, 0x3a, 0x4f, 0x1e, 0x37, 0x87, 0x16,
-	0x9a, 0xc6, 0xaa, 0x1b, 0xce, 0xae, 0xa2, 0xcf, 0xcc, 0x3d, 0x30, 0x9c, 0xcd, 0xd6, 0x7a, 0xa9,
-	0x6a, 0xd6, 0xe7, 0x02, 0xff, 0xf3, 0xb4, 0xf4, 0x80, 0x34, 0xbc, 0xff, 0xb2, 0xca, 0xfe, 0xfd,
-	0xe9, 0x0d, 0xad, 0x69, 0x6c, 0x5f, 0x5a, 0xcf, 0xd1, 0xb1, 0xcb, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0x23, 0x07, 0xbe, 0xe1, 0x55, 0x00, 0x00,
+	// 4120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1b, 0x5d,
+	0x56, 0xff, 0xc6, 0x4e, 0x9c, 0xf8, 0x38, 0x4d, 0x9d, 0xdb, 0x36, 0x4d, 0xda, 0x34, 0x7f, 0xa6,
+	0x69, 0x9a, 0xa6, 0x89, 0xd3, 0xa6, 0xdd, 0xaf, 0xdb, 0xaf, 0xbb, 0xdf, 0xb7, 0xae, 0x3d, 0xa1,
+	0xd6, 0x97, 0x26, 0x61, 0xe2, 0xb4, 0xdf, 0x22, 0x24, 0x33, 0xb1, 0x27, 0xed, 0xa8, 0xb6, 0xc7,
+	0x3b, 0x33, 0xae, 0x1b, 0x24, 0x9e, 0x78, 0x40, 0x42, 0xbb, 0x82, 0xd5, 0x0a, 0x89, 0x15, 0x20,
+	0x10, 0x12, 0x68, 0x17, 0x90, 0x16, 0x81, 0x10, 0x20, 0x5e, 0x00, 0x09, 0xb1, 0x12, 0x68, 0xd9,
+	0x27, 0x5e, 0x78, 0x60, 0xf5, 0x89, 0x07, 0xf6, 0x8d, 0x07, 0x96, 0x37, 0x24, 0x34, 0x77, 0xee,
+	0x78, 0xc6, 0x33, 0xf7, 0xce, 0xdc, 0x71, 0xd2, 0x5d, 0x56, 0x5f, 0xdf, 0x32, 0x33, 0xe7, 0x9c,
+	0xfb, 0x3b, 0xf7, 0x9e, 0x73, 0xee, 0xb9, 0xf7, 0x1c, 0x07, 0x96, 0xba, 0x47, 0xaa, 0xb1, 0x59,
+	0x57, 0x1a, 0x6a, 0xbb, 0xae, 0x6e, 0x2a, 0x1d, 0x6d, 0xf3, 0xf5, 0xdd, 0xcd, 0x97, 0x9a, 0x69,
+	0xe9, 0xc6, 0x49, 0xa1, 0x63, 0xe8, 0x96, 0x8e, 0x2e, 0xd8, 0x24, 0x05, 0x42, 0x52, 0x50, 0x3a,
+	0x5a, 0xe1, 0xf5, 0xdd, 0x2b, 0xf3, 0x2f, 0x74, 0xfd, 0x45, 0x53, 0xdd, 0xc4, 0x24, 0x47, 0xdd,
+	0xe3, 0xcd, 0x46, 0xd7, 0x50, 0x2c, 0x4d, 0x6f, 0x3b, 0x4c, 0x57, 0x16, 0x82, 0xdf, 0x2d, 0xad,
+	0xa5, 0x9a, 0x96, 0xd2, 0xea, 0x10, 0x82, 0x45, 0xda, 0xc0, 0x75, 0xbd, 0xd5, 0xea, 0x8b, 0x10,
+	0x69, 0x14, 0x96, 0x62, 0xbe, 0x6a, 0x6a, 0xa6, 0x15, 0x45, 0xd3, 0xd3, 0x8d, 0x57, 0xc7, 0x4d,
+	0xbd, 0xe7, 0xd0, 0x88, 0x65, 0x18, 0x7b, 0xe2, 0x28, 0x84, 0x1e, 0x42, 0x46, 0x7d, 0xad, 0xb6,
+	0x2d, 0x73, 0x46, 0x58, 0x4c, 0xaf, 0xe6, 0xb6, 0x96, 0x0a, 0x14, 0xdd, 0x0a, 0x84, 0x5a, 0xb2,
+	0x29, 0x65, 0xc2, 0x20, 0xfe, 0xf0, 0x01, 0x4c, 0xf8, 0x3f, 0xa0, 0x59, 0x18, 0xc7, 0x9f, 0x6a,
+	0x5a, 0x63, 0x46, 0x58, 0x14, 0x56, 0xd3, 0xf2, 0x18, 0x7e, 0xae, 0x34, 0xd0, 0x43, 0x00, 0xe7,
+	0x93, 0xad, 0xf4, 0x4c, 0x6a, 0x51, 0x58, 0xcd, 0x6d, 0x5d, 0x29, 0x38, 0x33, 0x52, 0x70, 0x67,
+	0xa4, 0x50, 0x75, 0x67, 0x44, 0xce, 0x62, 0x6a, 0xfb, 0x19, 0xcd, 0xc0, 0xd8, 0x6b, 0xd5, 0x30,
+	0x35, 0xbd, 0x3d, 0x93, 0x76, 0x84, 0x92, 0x47, 0x74, 0x19, 0xc6, 0x6c, 0xe5, 0xed, 0xe1, 0x46,
+	0xf0, 0x97, 0x8c, 0xfd, 0x58, 0x69, 0xa0, 0xdf, 0x16, 0xe0, 0xb6, 0xab, 0x72, 0x4d, 0x7d, 0xa3,
+	0xd6, 0xbb, 0xf6, 0x3a, 0xd4, 0x4c, 0x4b, 0x31, 0x2c, 0xb5, 0x51, 0x73, 0x90, 0x28, 0x96, 0x65,
+	0x68, 0x47, 0x5d, 0x4b, 0x35, 0x67, 0x46, 0x31, 0x9e, 0x2f, 0x50, 0x55, 0x7f, 0x4e, 0xe4, 0x48,
+	0xae, 0x98, 0x03, 0x47, 0x0a, 0x56, 0xb9, 0xd8, 0x97, 0xf1, 0xe4, 0x3d, 0xf9, 0x66, 0x8f, 0x8f,
+	0x14, 0xfd, 0xbe, 0x00, 0x1b, 0x14, 0x78, 0x75, 0xbd, 0xd5, 0x69, 0xaa, 0x54, 0x80, 0x19, 0x0c,
+	0xf0, 0x43, 0x3e, 0x80, 0x25, 0x57, 0x4e, 0x18, 0xe2, 0xad, 0x1e, 0x2f, 0x31, 0xfa, 0xa6, 0x00,
+	0x6b, 0x14, 0x90, 0xc7, 0x8a, 0xd6, 0xa4, 0x21, 0x1c, 0xc3, 0x08, 0x1f, 0xf1, 0x21, 0xdc, 0xc6,
+	0x42, 0xc2, 0xf0, 0x56, 0x7a, 0x5c, 0x94, 0xe8, 0xf7, 0xe8, 0x13, 0x68, 0xdb, 0x56, 0xa3, 0xa6,
+	0x77, 0xad, 0x30, 0xbc, 0x71, 0x0c, 0xef, 0x8b, 0x7c, 0xf0, 0x6c, 0xb3, 0x6b, 0xec, 0x75, 0xad,
+	0x30, 0xc0, 0xd5, 0x1e, 0x27, 0x2d, 0xfa, 0x86, 0x00, 0xab, 0x0d, 0xb5, 0xae, 0x99, 0x18, 0x98,
+	0x6d, 0xa5, 0x66, 0xfd, 0xa5, 0xda, 0xe8, 0x52, 0x27, 0x2f, 0x8b, 0xd1, 0x3d, 0xa4, 0xa2, 0x2b,
+	0x13, 0x21, 0x55, 0xc5, 0x7c, 0x75, 0xe0, 0x8a, 0x08, 0x23, 0x5b, 0x6e, 0x70, 0xd0, 0xa1, 0xaf,
+	0x09, 0xb0, 0x12, 0x40, 0xc5, 0xf2, 0x09, 0xc0, 0x98, 0x1e, 0xc4, 0x63, 0x62, 0xb9, 0x83, 0xd8,
+	0x88, 0xa5, 0xa2, 0xcc, 0x52, 0x84, 0x13, 0xe4, 0x38, 0x67, 0x29, 0xc2, 0xfe, 0x97, 0x1b, 0x1c,
+	0x74, 0xe8, 0xeb, 0x21, 0x54, 0x11, 0x96, 0x35, 0x81, 0x51, 0x7d, 0x3e, 0x16, 0x15, 0xdb, 0xa8,
+	0xae, 0x37, 0xe2, 0xc9, 0xd0, 0xaf, 0x0a, 0x70, 0x63, 0x10, 0x13, 0xcb, 0x13, 0xcf, 0x61, 0x40,
+	0xef, 0xc7, 0x02, 0x62, 0x39, 0xe1, 0x52, 0x23, 0x8e, 0x08, 0x2f, 0x9b, 0x52, 0xb7, 0xb4, 0xd7,
+	0x9a, 0x75, 0x12, 0x6b, 0xdc, 0x93, 0x11, 0xcb, 0x56, 0x24, 0x42, 0xe2, 0x8c, 0x5b, 0xe1, 0xa0,
+	0xc3, 0xc6, 0x1d, 0x40, 0xc5, 0x32, 0xee, 0xf3, 0x11, 0xc6, 0x3d, 0x80, 0x89, 0x69, 0xdc, 0x4a,
+	0x2c, 0x15, 0x65, 0x96, 0x22, 0x8c, 0x3b, 0xcf, 0x39, 0x4b, 0x51, 0xc6, 0xad, 0x70, 0xd0, 0x61,
+	0x43, 0x1a, 0x44, 0xc5, 0x32, 0xa4, 0xa9, 0x08, 0x43, 0xf2, 0x43, 0x62, 0x1a, 0x92, 0x12, 0x47,
+	0x84, 0x3d, 0x6d, 0x10, 0x4c, 0x84, 0xa7, 0xa1, 0x08, 0x4f, 0xf3, 0xe3, 0x89, 0xf0, 0x34, 0x25,
+	0x9e, 0x0c, 0xf5, 0x60, 0xde, 0x06, 0x61, 0xb0, 0xad, 0xe7, 0x02, 0x06, 0x72, 0x87, 0x0a, 0xc4,
+	0x96, 0x6a, 0x30, 0xcd, 0xe6, 0xaa, 0xc5, 0xfe, 0x8c, 0xbe, 0x02, 0x73, 0xce, 0xc0, 0xc7, 0x9a,
+	0x41, 0x1b, 0xf6, 0x22, 0x1e, 0xb6, 0xc0, 0x1e, 0x76, 0x5b, 0x33, 0x42, 0x52, 0x9f, 0xbc, 0x27,
+	0xcf, 0x5a, 0xac, 0x8f, 0xe8, 0x0f, 0x05, 0xd8, 0x0c, 0x98, 0xa8, 0xd2, 0xae, 0xab, 0xcd, 0x9a,
+	0xa1, 0x7e, 0xa5, 0xab, 0x9a, 0x54, 0xed, 0x2f, 0x61, 0x18, 0x1f, 0xc5, 0x5b, 0x2a, 0x96, 0x24,
+	0xbb, 0x82, 0xc2, 0xb8, 0xd6, 0x14, 0x6e, 0x6a, 0xf4, 0xe7, 0x02, 0xdc, 0x27, 0x98, 0x5c, 0x88,
+	0x7c, 0x46, 0x3c, 0x8d, 0xd1, 0x96, 0xa8, 0x68, 0xc9, 0x68, 0xce, 0xd0, 0x3c, 0x16, 0x5d, 0x30,
+	0x12, 0x71, 0xa0, 0x5f, 0x17, 0xe0, 0x26, 0x6d, 0x7a, 0x69, 0x40, 0x2f, 0x73, 0x5a, 0x77, 0x89,
+	0x48, 0x88, 0xb1, 0x6e, 0x06, 0x19, 0xfa, 0x45, 0x58, 0x70, 0x8c, 0x8c, 0x8d, 0x64, 0x06, 0x23,
+	0xb9, 0xcb, 0xb6, 0x33, 0x36, 0x84, 0x39, 0x2b, 0xe2, 0x3b, 0xfa, 0x15, 0x01, 0x96, 0xc9, 0xe2,
+	0x11, 0x43, 0x67, 0x2c, 0xda, 0x2c, 0x46, 0xf0, 0x39, 0x2a, 0x02, 0x47, 0xb8, 0x63, 0xef, 0x8c,
+	0x65, 0x5a, 0xac, 0xc7, 0xd0, 0xa0, 0x5f, 0x82, 0xc5, 0x96, 0x62, 0xbc, 0x52, 0x8d, 0x9a, 0xa1,
+	0xd6, 0x75, 0xa3, 0x41, 0x03, 0x71, 0x05, 0x83, 0xd8, 0xa2, 0x82, 0x78, 0x8a, 0x99, 0x65, 0xc2,
+	0x1b, 0x46, 0x70, 0xad, 0x15, 0x45, 0x80, 0x7e, 0x57, 0x80, 0x75, 0xda, 0xf9, 0x44, 0x7b, 0xd1,
+	0x56, 0xa8, 0x13, 0x72, 0x35, 0x49, 0xfa, 0x7a, 0x40, 0xc4, 0xf0, 0xa4, 0xaf, 0x0c, 0x5a, 0xf4,
+	0x07, 0x02, 0x14, 0x28, 0x08, 0x2d, 0xd5, 0x68, 0x69, 0x6d, 0x85, 0x1a, 0x17, 0xe6, 0x22, 0xe2,
+	0x42, 0x38, 0xc5, 0xee, 0x0b, 0xa2, 0xc4, 0x85, 0x1e, 0x37, 0x35, 0xfa, 0x0b, 0x01, 0xee, 0xd3,
+	0x8e, 0x52, 0xb1, 0x51, 0xec, 0x1a, 0x46, 0x5b, 0xe6, 0x3c, 0x51, 0xc5, 0x85, 0xb2, 0xcd, 0x5e,
+	0x32, 0x16, 0x96, 0x05, 0xb0, 0x9d, 0x72, 0x3e, 0x89, 0x05, 0xb0, 0x1d, 0x74, 0xb5, 0xc7, 0x49,
+	0x8b, 0xfe, 0x43, 0x00, 0x29, 0x10, 0x71, 0xd5, 0x37, 0x96, 0x6a, 0xb4, 0x95, 0x66, 0x8d, 0x82,
+	0x5c, 0x6b, 0x6b, 0x96, 0x46, 0x37, 0x8c, 0x05, 0x0c, 0xfd, 0x20, 0x3e, 0x04, 0x4b, 0x44, 0x7e,
+	0x48, 0x9f, 0x8a, 0x2b, 0x3c, 0xac, 0xd0, 0x87, 0xc6, 0xa9, 0x24, 0xa0, 0x7f, 0x13, 0xe0, 0x71,
+	0x02, 0x35, 0x59, 0x11, 0x6b, 0x11, 0xeb, 0xb8, 0x7f, 0x0a, 0x1d, 0x59, 0xc1, 0xec, 0x91, 0x31,
+	0x3c, 0x3b, 0xfa, 0x9e, 0x00, 0x5f, 0x8c, 0x52, 0x27, 0xde, 0x4f, 0x96, 0xb0, 0x62, 0x3b, 0x54,
+	0xc5, 0x98, 0x60, 0x62, 0xfd, 0xe5, 0x81, 0x3a, 0x1c, 0x2b, 0xce, 0x03, 0x68, 0x7a, 0xe8, 0x6d,
+	0x4b, 0x6b, 0x77, 0xd5, 0x46, 0x4d, 0x31, 0x6b, 0x6d, 0xb5, 0x17, 0xd6, 0x43, 0x8c, 0xc8, 0x03,
+	0xc2, 0x20, 0x5c, 0x71, 0x45, 0x73, 0x57, 0xed, 0x85, 0xe1, 0x17, 0x7a, 0x89, 0x38, 0xd0, 0xdf,
+	0x0b, 0xf0, 0x10, 0x67, 0x93, 0xb5, 0xfa, 0x4b, 0xad, 0xd9, 0x48, 0xe8, 0x3f, 0xd7, 0x31, 0xf4,
+	0x27, 0x54, 0xe8, 0x38, 0x95, 0x2c, 0xd9, 0x42, 0x93, 0x38, 0xcd, 0x3d, 0x33, 0x39, 0x1b, 0xfa,
+	0x6b, 0x01, 0xde, 0x8f, 0x51, 0x82, 0xe5, 0x1d, 0xcb, 0x58, 0x03, 0x29, 0xa9, 0x06, 0x2c, 0x97,
+	0xb8, 0x63, 0x26, 0xe4, 0x41, 0x7f, 0x22, 0xc0, 0x5d, 0x26, 0x6a, 0x66, 0x9e, 0x7f, 0x03, 0xc3,
+	0x2e, 0xd2, 0xd3, 0x10, 0xea, 0xe8, 0xcc, 0xc4, 0x7f, 0xbd, 0x9e, 0x80, 0x1e, 0xfd, 0x99, 0x00,
+	0xf7, 0x98, 0x70, 0x23, 0x0e, 0x91, 0x2b, 0x11, 0x46, 0x4e, 0x07, 0x1c, 0x71, 0x9c, 0x2c, 0xd4,
+	0x13, 0x71, 0xa0, 0x6f, 0x0b, 0x70, 0x27, 0xb1, 0x65, 0xdc, 0xc4, 0x88, 0xbf, 0x94, 0x00, 0x31,
+	0xcb, 0x28, 0x6e, 0xd7, 0x13, 0xd8, 0xc3, 0x77, 0x04, 0xd8, 0x62, 0x4f, 0x30, 0x73, 0x13, 0x5e,
+	0xc5, 0x68, 0x1f, 0x27, 0x99, 0x5f, 0xe6, 0x4e, 0xbc, 0x51, 0x4f, 0xc2, 0x80, 0xfe, 0x34, 0xca,
+	0x24, 0x22, 0x0e, 0xcd, 0xb7, 0x12, 0x43, 0x66, 0x1f, 0x9f, 0x37, 0xea, 0x49, 0x18, 0x70, 0x6e,
+	0xc6, 0x86, 0x1c, 0x91, 0x49, 0xae, 0x45, 0xe4, 0x66, 0x0c, 0xcc, 0x11, 0xe9, 0xe4, 0x66, 0x3d,
+	0x19, 0x0b, 0xde, 0x34, 0x9d, 0x54, 0x7c, 0xd8, 0x8c, 0xe7, 0x76, 0xc4, 0xa6, 0xe9, 0x64, 0xdc,
+	0xc3, 0xa4, 0x3a, 0x0f, 0xcc, 0xe1, 0x58, 0xd1, 0x3f, 0x08, 0xf0, 0x01, 0x87, 0x42, 0x2c, 0x1f,
+	0x5d, 0xc7, 0xda, 0x54, 0x86, 0xd1, 0x86, 0xe5, 0xac, 0xf7, 0xcd, 0x21, 0xf8, 0xd0, 0x5f, 0x09,
+	0xf0, 0xb9, 0x28, 0x05, 0xd8, 0xe7, 0xa7, 0x8d, 0x88, 0x0d, 0x88, 0x09, 0x82, 0x7d, 0x8e, 0xba,
+	0xa3, 0x26, 0xe4, 0xc1, 0x01, 0xa7, 0xdb, 0x31, 0x55, 0xc3, 0xf2, 0x80, 0x9b, 0xaa, 0x62, 0xd4,
+	0x5f, 0xfa, 0x60, 0x86, 0x71, 0x17, 0x22, 0xbc, 0xf7, 0x10, 0x8b, 0x73, 0x11, 0x1c, 0x60, 0x61,
+	0xde, 0x88, 0x14, 0xef, 0xed, 0x26, 0x61, 0x78, 0x3c, 0x01, 0xe0, 0x01, 0x11, 0x7f, 0x90, 0x83,
+	0x9b, 0xbc, 0xbb, 0xd7, 0x36, 0x9c, 0xeb, 0xeb, 0x68, 0x9d, 0x74, 0x54, 0x5c, 0x0b, 0x64, 0x55,
+	0x16, 0x5d, 0xa1, 0xd5, 0x93, 0x8e, 0x2a, 0x4f, 0xf4, 0x7c, 0x4f, 0xe8, 0xe7, 0xe1, 0x52, 0x47,
+	0x31, 0xec, 0x19, 0xf1, 0x3b, 0xdd, 0xb1, 0x4e, 0xca, 0x87, 0xab, 0x54, 0x79, 0xfb, 0x98, 0xc3,
+	0xe7, 0x13, 0xc7, 0xba, 0x7c, 0xa1, 0x13, 0x7e, 0x89, 0x3e, 0x80, 0x2c, 0xbe, 0x91, 0x69, 0x6a,
+	0xa6, 0x85, 0x0b, 0x8b, 0xb9, 0xad, 0x6b, 0x54, 0x89, 0xf6, 0x6d, 0xca, 0x8e, 0x66, 0x5a, 0xf2,
+	0xb8, 0x45, 0xfe, 0x42, 0x5b, 0x30, 0xaa, 0xb5, 0x3b, 0x5d, 0x0b, 0x97, 0x1d, 0x73, 0x5b, 0x73,
+	0x0c, 0x24, 0x27, 0x4d, 0x5d, 0x69, 0xc8, 0x0e, 0x29, 0x52, 0x60, 0x31, 0x90, 0x72, 0xd4, 0x2c,
+	0xbd, 0x56, 0x6f, 0xea, 0xa6, 0x8a, 0xe3, 0xb7, 0xde, 0xb5, 0x48, 0x1d, 0x72, 0x36, 0x54, 0x17,
+	0x2d, 0x93, 0x4a, 0xb2, 0x3c, 0xa7, 0x0e, 0xcc, 0x7d, 0x55, 0x2f, 0xd9, 0xfc, 0x55, 0x87, 0x1d,
+	0x3d, 0x87, 0xab, 0xde, 0xb5, 0x77, 0x58, 0x7a, 0x26, 0x4e, 0xfa, 0x65, 0xcb, 0xbd, 0xcc, 0x0e,
+	0x08, 0x7e, 0x04, 0x57, 0xbc, 0x0c, 0xdb, 0xd3, 0xc2, 0xe8, 0xb6, 0xed, 0xda, 0xab, 0x5d, 0xfa,
+	0xcb, 0xca, 0x97, 0xfb, 0x14, 0xfd, 0x79, 0x96, 0xbb, 0xed, 0x4a, 0x03, 0x55, 0x20, 0x4b, 0x42,
+	0xa5, 0x6e, 0xe0, 0x3a, 0xdc, 0xe4, 0xd6, 0x6d, 0x7a, 0x68, 0x27, 0x02, 0x70, 0x0a, 0x5d, 0x71,
+	0x59, 0x64, 0x8f, 0x1b, 0x55, 0x60, 0xca, 0xc3, 0x61, 0x87, 0xab, 0xae, 0xa1, 0xce, 0x64, 0x23,
+	0xd6, 0x60, 0xdb, 0xa1, 0x91, 0xf3, 0x7d, 0x36, 0xf2, 0x06, 0xc9, 0x30, 0xdd, 0x54, 0xec, 0x33,
+	0x9f, 0x93, 0xce, 0x60, 0x75, 0x54, 0xb3, 0xdb, 0xb4, 0x66, 0x20, 0x42, 0x9e, 0xbb, 0xa6, 0x17,
+	0x6d, 0xde, 0x52, 0x9f, 0x55, 0xc6, 0x9c, 0xe8, 0x21, 0xcc, 0xea, 0x86, 0xf6, 0x42, 0x73, 0x02,
+	0x6d, 0x60, 0x96, 0x72, 0x78, 0x96, 0xa6, 0x5d, 0x82, 0xc0, 0x24, 0x5d, 0x81, 0x71, 0xad, 0xa1,
+	0xb6, 0x2d, 0xcd, 0x3a, 0xc1, 0x15, 0xa5, 0xac, 0xdc, 0x7f, 0x46, 0xf7, 0x60, 0xfa, 0x58, 0x33,
+	0x4c, 0x2b, 0x2c, 0xf3, 0x1c, 0xa6, 0xbc, 0x80, 0xbf, 0x06, 0x04, 0x96, 0x60, 0xc2, 0x50, 0x2d,
+	0xe3, 0xa4, 0xd6, 0xd1, 0x9b, 0x5a, 0xfd, 0x84, 0x54, 0x61, 0x16, 0x19, 0x07, 0x54, 0xcb, 0x38,
+	0xd9, 0xc7, 0x74, 0x72, 0xce, 0xf0, 0x1e, 0xec, 0xd2, 0xbb, 0x62, 0x59, 0x6a, 0xab, 0x63, 0xe1,
+	0x8a, 0xc9, 0xa8, 0xec, 0x3e, 0xa2, 0x12, 0x9c, 0x57, 0xdf, 0x74, 0x34, 0xc7, 0x70, 0x9c, 0xa2,
+	0x7e, 0x3e, 0xb6, 0xa8, 0x3f, 0xe9, 0xb1, 0xd8, 0x2f, 0xd1, 0x75, 0x38, 0x57, 0x37, 0x6c, 0x6f,
+	0x20, 0x15, 0x1d, 0x5c, 0x71, 0xc8, 0xca, 0x13, 0xf6, 0x4b, 0xb7, 0xca, 0x83, 0x3e, 0x81, 0xab,
+	0x8e, 0xf6, 0x83, 0xd5, 0xaf, 0x23, 0xa5, 0xfe, 0x4a, 0x3f, 0x3e, 0x9e, 0x41, 0x71, 0x46, 0x3d,
+	0x83, 0xb9, 0xfd, 0x85, 0xaf, 0xc7, 0x0e, 0x2b, 0xda, 0x80, 0x91, 0x96, 0xda, 0xd2, 0xc9, 0x75,
+	0xfe, 0x2c, 0xfd, 0xa2, 0x4f, 0x6d, 0xe9, 0x32, 0x26, 0x43, 0x32, 0x4c, 0x85, 0x22, 0x36, 0xb9,
+	0x93, 0xbf, 0x41, 0xdf, 0x1b, 0x03, 0x11, 0x56, 0xce, 0x9b, 0x81, 0x37, 0xe8, 0x10, 0xa6, 0x3b,
+	0x86, 0xfa, 0xba, 0xa6, 0x74, 0x2d, 0xdd, 0xb6, 0x3f, 0xd5, 0xaa, 0x75, 0x74, 0xad, 0x6d, 0xb9,
+	0xb7, 0xec, 0xac, 0xf5, 0x32, 0x55, 0x6b, 0x1f, 0xd3, 0xc9, 0x17, 0x6c, 0xfe, 0x62, 0xd7, 0xd2,
+	0x7d, 0x2f, 0xd1, 0x3d, 0xc8, 0xbc, 0x54, 0x95, 0x86, 0x6a, 0x90, 0xeb, 0xef, 0xab, 0xf4, 0xa6,
+	0x0e, 0x4c, 0x22, 0x13, 0x52, 0xf1, 0xdb, 0x02, 0xdc, 0xe2, 0xcf, 0xf6, 0xef, 0x43, 0x86, 0xf8,
+	0x8b, 0xc0, 0xe1, 0x2f, 0x84, 0x16, 0x6d, 0xc3, 0x62, 0x74, 0xb9, 0x57, 0x6b, 0xe0, 0xe8, 0x9e,
+	0x96, 0xe7, 0xd8, 0x95, 0xda, 0x4a, 0x43, 0xfc, 0x96, 0x00, 0x2b, 0x9c, 0x49, 0xc3, 0xfb, 0x30,
+	0xe6, 0x46, 0x0a, 0x81, 0x23, 0x52, 0xb8, 0xc4, 0x67, 0x06, 0x55, 0x87, 0x55, 0xee, 0x8c, 0xb9,
+	0x04, 0x13, 0x24, 0x58, 0x7b, 0x1b, 0xe7, 0x24, 0xc3, 0x08, 0x48, 0x6c, 0xc6, 0xfb, 0x66, 0xce,
+	0xf2, 0x1e, 0xc4, 0x7f, 0x16, 0x60, 0x99, 0xa7, 0x69, 0x60, 0x70, 0x07, 0x14, 0x92, 0xed, 0x80,
+	0xbb, 0x30, 0xcd, 0xd8, 0x65, 0x52, 0x71, 0x0e, 0x79, 0xc1, 0xa4, 0xec, 0x30, 0xbe, 0x48, 0x93,
+	0x1e, 0x88, 0x34, 0xe2, 0xd7, 0x04, 0x10, 0xe3, 0xfb, 0x0d, 0xd0, 0x3a, 0xa0, 0x60, 0x0d, 0xba,
+	0xdf, 0x85, 0x94, 0x37, 0x07, 0xa6, 0x20, 0x10, 0x6e, 0x53, 0x81, 0x70, 0x7b, 0x0d, 0xc0, 0xbd,
+	0x10, 0xd4, 0x1a, 0x18, 0x4d, 0x56, 0xce, 0x92, 0x37, 0x95, 0x86, 0xf8, 0x5f, 0x81, 0xe9, 0x65,
+	0x7a, 0x48, 0x32, 0x44, 0xab, 0x90, 0x1f, 0xbc, 0x87, 0xe8, 0x9b, 0xd7, 0xa4, 0xe9, 0xd3, 0x38,
+	0x80, 0x3d, 0x1d, 0xc0, 0x7e, 0x13, 0xce, 0x1f, 0x69, 0x6d, 0xc5, 0x38, 0xa9, 0xd5, 0x5f, 0xaa,
+	0xf5, 0x57, 0x66, 0xb7, 0x85, 0x53, 0x94, 0xac, 0x3c, 0xe9, 0xbc, 0x2e, 0x91, 0xb7, 0xe8, 0x36,
+	0x4c, 0x0d, 0xde, 0x9e, 0xa9, 0x6f, 0x9c, 0xf4, 0x63, 0x42, 0xce, 0xab, 0xfe, 0x4b, 0x2d, 0xf5,
+	0x8d, 0x25, 0x7e, 0x35, 0x0d, 0xd7, 0x39, 0x5a, 0x19, 0xde, 0x9a, 0xc6, 0x41, 0xb7, 0x48, 0x0f,
+	0xe1, 0x16, 0x68, 0x1e, 0x72, 0x47, 0x8a, 0xa9, 0xba, 0x5b, 0xa7, 0x33, 0x2d, 0x59, 0xfb, 0x95,
+	0xb3, 0x61, 0xce, 0x01, 0xd8, 0x17, 0x87, 0xe4, 0xf3, 0xa8, 0x33, 0xb1, 0x6d, 0xb5, 0xe7, 0x7c,
+	0x5d, 0x07, 0x74, 0xac, 0x1b, 0xaf, 0x08, 0x52, 0xb7, 0x1f, 0x2d, 0xe3, 0xa8, 0x66, 0x7f, 0xc1,
+	0x58, 0x9f, 0x39, 0xef, 0xd1, 0xb4, 0x1d, 0x1c, 0x15, 0x53, 0x6f, 0x93, 0xdc, 0x88, 0x3c, 0xa1,
+	0x32, 0x8c, 0xd6, 0x95, 0xae, 0xa9, 0x92, 0x34, 0xa8, 0xc0, 0xdd, 0x34, 0x52, 0xb2, 0xb9, 0x64,
+	0x87, 0x59, 0xfc, 0x56, 0x1a, 0x96, 0x62, 0x1b, 0x39, 0xde, 0xda, 0x62, 0x3c, 0x76, 0x75, 0x70,
+	0x56, 0x61, 0x9d, 0xb3, 0xcf, 0xc4, 0xaf, 0x81, 0x3f, 0x26, 0x8f, 0x24, 0x89, 0xc9, 0x7e, 0xd3,
+	0x1f, 0x0d, 0x98, 0x7e, 0x60, 0x7d, 0x33, 0xd1, 0xeb, 0x3b, 0xc6, 0xb5, 0xbe, 0xe3, 0x8c, 0xf5,
+	0xa5, 0xb8, 0x59, 0x96, 0xe6, 0x66, 0xe2, 0xef, 0x64, 0x60, 0x99, 0xa7, 0xc7, 0x05, 0x2d, 0x40,
+	0xae, 0x5f, 0x28, 0x26, 0xcb, 0x94, 0x95, 0xc1, 0x7d, 0x55, 0x69, 0xd8, 0x87, 0xaa, 0x3e, 0x01,
+	0x76, 0x82, 0x54, 0xc4, 0xa1, 0xaa, 0x3f, 0x24, 0x3e, 0x54, 0x29, 0xbe, 0x27, 0xdb, 0x34, 0x1b,
+	0x7a, 0x4b, 0xd1, 0xda, 0x24, 0x76, 0x90, 0xa7, 0xc1, 0xcd, 0x60, 0x64, 0xc8, 0xe3, 0x50, 0x86,
+	0xff, 0x38, 0x54, 0x85, 0x59, 0xd7, 0x08, 0xc3, 0x7b, 0xc8, 0x58, 0xdc, 0x1e, 0x32, 0xed, 0xf2,
+	0x06, 0xb6, 0x91, 0x80, 0x54, 0xb2, 0x45, 0x11, 0xa9, 0xe3, 0x09, 0xa4, 0x3a, 0xa7, 0x20, 0x22,
+	0x95, 0xbd, 0xd9, 0x65, 0x87, 0xda, 0xec, 0xb6, 0x61, 0xea, 0xa5, 0xaa, 0x18, 0xd6, 0x91, 0xaa,
+	0x78, 0xe8, 0x20, 0x4e, 0x54, 0xbe, 0xcf, 0xe3, 0xc9, 0x89, 0x4f, 0x51, 0x72, 0xf1, 0x29, 0x4a,
+	0xe8, 0xac, 0x30, 0x31, 0xcc, 0x59, 0xc1, 0xcb, 0x39, 0xcf, 0xf1, 0xe7, 0x9c, 0xff, 0x29, 0x80,
+	0x18, 0xdf, 0x6f, 0xf5, 0x63, 0xdb, 0xdc, 0xfd, 0x69, 0xc8, 0xc8, 0xe0, 0x81, 0xe7, 0x23, 0x98,
+	0xc0, 0xe7, 0x45, 0x37, 0x6e, 0x8d, 0x72, 0xc4, 0xad, 0x9c, 0xcd, 0x41, 0x1e, 0xc4, 0xef, 0x0b,
+	0x83, 0xa1, 0xe0, 0x8c, 0x33, 0x6b, 0xfa, 0x14, 0xa5, 0x12, 0x84, 0xfb, 0x74, 0x6c, 0xb6, 0x31,
+	0x32, 0x38, 0x99, 0xe2, 0xbf, 0x08, 0xb0, 0x14, 0xdf, 0x04, 0x33, 0x6c, 0x02, 0xfe, 0x93, 0xd0,
+	0xe8, 0x6f, 0x52, 0x70, 0x9d, 0xa3, 0x95, 0xcc, 0xd6, 0xa9, 0xa1, 0x5a, 0x8a, 0xd6, 0x34, 0xb9,
+	0x16, 0xc9, 0x25, 0x7e, 0x6b, 0x3a, 0x05, 0x33, 0xa4, 0x91, 0x61, 0x32, 0xa4, 0x53, 0x9b, 0xf8,
+	0x6f, 0x08, 0xb0, 0xc6, 0xdf, 0x01, 0xc6, 0xb3, 0xe7, 0x9d, 0xcd, 0x11, 0xec, 0x3b, 0x02, 0x24,
+	0xec, 0xf5, 0x8a, 0xc7, 0x76, 0xd1, 0x4d, 0x83, 0x9c, 0x08, 0xe3, 0x3c, 0x70, 0x21, 0x4e, 0x73,
+	0x20, 0xfe, 0x66, 0xc0, 0x0e, 0x59, 0x55, 0xa1, 0x61, 0xed, 0x70, 0x1b, 0x16, 0x9b, 0x8a, 0xe5,
+	0xeb, 0x79, 0x08, 0x76, 0x00, 0x78, 0x33, 0xeb, 0xd0, 0xd1, 0x96, 0xd2, 0x49, 0x9b, 0x28, 0xf6,
+	0x9c, 0x4e, 0x60, 0xcf, 0x23, 0xb1, 0x3e, 0x1a, 0x48, 0xf4, 0xc4, 0xef, 0x09, 0x70, 0x35, 0xa2,
+	0xcb, 0xd2, 0xfe, 0x15, 0x8a, 0xd3, 0x5d, 0xd6, 0x5f, 0xb7, 0x31, 0xfc, 0x5c, 0x69, 0xa0, 0x1d,
+	0xb8, 0xd4, 0xdf, 0xc8, 0x8f, 0x35, 0x23, 0xc1, 0xa1, 0x15, 0x91, 0x7d, 0xdc, 0xee, 0xa2, 0x4c,
+	0xb2, 0xfd, 0xf2, 0x2c, 0xf6, 0x2f, 0xc0, 0x2c, 0xb3, 0x7d, 0x33, 0x4a, 0x1b, 0xee, 0x9c, 0x5d,
+	0xfc, 0x47, 0x01, 0xe6, 0xa2, 0x3a, 0xf7, 0xce, 0x64, 0x94, 0xb3, 0x9a, 0x8f, 0xc8, 0x00, 0xfd,
+	0x97, 0x02, 0x2c, 0xc6, 0x75, 0x00, 0x46, 0x69, 0xf3, 0x56, 0xdd, 0x36, 0x12, 0xf9, 0xff, 0x8e,
+	0x41, 0xc2, 0x46, 0x13, 0xb4, 0x09, 0x17, 0x71, 0x2f, 0x4b, 0xf0, 0xda, 0xd7, 0xd1, 0x69, 0xaa,
+	0xad, 0xf6, 0x02, 0x97, 0xbe, 0xa1, 0xca, 0x4b, 0x6a, 0xb8, 0xca, 0xcb, 0xbb, 0xda, 0x08, 0x7f,
+	0x6d, 0x84, 0xc7, 0x76, 0xc6, 0x38, 0x6c, 0x67, 0x0f, 0xa6, 0xc9, 0x9d, 0x36, 0xc1, 0xa8, 0xb5,
+	0x2d, 0xd5, 0x78, 0xad, 0x34, 0xe3, 0xcf, 0x2d, 0x17, 0x09, 0x23, 0x86, 0x57, 0x21, 0x6c, 0x83,
+	0x75, 0x97, 0xec, 0xa9, 0xea, 0x2e, 0xbe, 0x14, 0x0e, 0x92, 0xa4, 0x70, 0xec, 0x22, 0x4b, 0x6e,
+	0xe8, 0x22, 0x8b, 0x77, 0xce, 0x98, 0xe0, 0x3e, 0x67, 0xf4, 0xaf, 0xfa, 0xcf, 0x9d, 0xe2, 0xaa,
+	0x7f, 0xf2, 0x54, 0x57, 0xfd, 0x76, 0x0c, 0xde, 0x4c, 0xda, 0xed, 0xd6, 0x8f, 0x56, 0x82, 0x3f,
+	0x5a, 0x45, 0x9d, 0x6f, 0x8e, 0xe0, 0x72, 0xbf, 0x42, 0x1e, 0xa8, 0x9a, 0x3a, 0x7e, 0xbc, 0x16,
+	0x59, 0x03, 0x1f, 0xac, 0x9b, 0x5e, 0x52, 0x69, 0xaf, 0xc5, 0x3f, 0x12, 0x60, 0x95, 0xa1, 0x09,
+	0xad, 0x18, 0x1c, 0xef, 0x1e, 0x02, 0x87, 0x7b, 0xf8, 0x32, 0x9d, 0x54, 0x82, 0x4c, 0x47, 0xfc,
+	0x91, 0x00, 0xd7, 0x22, 0xbb, 0xb5, 0xed, 0x54, 0x8f, 0xf4, 0x82, 0xb7, 0x95, 0x96, 0x3b, 0xd5,
+	0xe0, 0xbc, 0xda, 0x55, 0x5a, 0xea, 0xb0, 0x43, 0x9f, 0xd9, 0xae, 0xe2, 0x59, 0xfc, 0x08, 0xff,
+	0xc9, 0xfa, 0xb7, 0x68, 0x8b, 0xc4, 0xea, 0x4e, 0x58, 0x80, 0x1c, 0xe9, 0x0f, 0xf1, 0x4f, 0x81,
+	0xf3, 0x0a, 0x4f, 0x41, 0x3f, 0xa8, 0xa7, 0xf8, 0x83, 0x7a, 0xc4, 0x3d, 0xb5, 0xf8, 0x9b, 0x02,
+	0xac, 0x25, 0xe8, 0xc8, 0xf1, 0xee, 0x53, 0x85, 0x81, 0xfb, 0xd4, 0x61, 0x57, 0x26, 0x0a, 0xda,
+	0xdf, 0xa5, 0xe0, 0xc3, 0xd3, 0x75, 0x25, 0x9f, 0x99, 0xcd, 0x7b, 0x77, 0x75, 0xa9, 0x81, 0xbb,
+	0xba, 0x43, 0x40, 0xe1, 0xee, 0x17, 0xe2, 0xdf, 0x2b, 0x7c, 0x1d, 0xae, 0xf2, 0x54, 0xa8, 0x85,
+	0xd5, 0xbe, 0xfc, 0xb0, 0x2b, 0x01, 0x86, 0xde, 0xc4, 0x86, 0x36, 0x21, 0xbb, 0x8f, 0xa8, 0x00,
+	0x17, 0x02, 0x8d, 0x5c, 0x7a, 0xbb, 0xe9, 0x64, 0xe6, 0xe3, 0xf2, 0xd4, 0x40, 0x7f, 0xd5, 0x5e,
+	0xbb, 0x79, 0x22, 0x7e, 0x23, 0x0d, 0x8f, 0x4e, 0xd1, 0xf5, 0x8c, 0x0e, 0xfd, 0x71, 0x6f, 0x92,
+	0xf1, 0x9b, 0x02, 0x2e, 0xc9, 0x03, 0xd7, 0xce, 0x67, 0x74, 0x9e, 0x64, 0xde, 0xa1, 0xd2, 0xd7,
+	0x65, 0xe4, 0xb4, 0xeb, 0xb2, 0x0e, 0x28, 0xd8, 0x6b, 0x46, 0x2a, 0x14, 0x69, 0x39, 0xaf, 0x0d,
+	0x18, 0xa1, 0x73, 0x85, 0xe5, 0xae, 0x62, 0x66, 0x60, 0x15, 0xc5, 0x7f, 0x15, 0xe0, 0xc1, 0x90,
+	0x2d, 0xdb, 0x0c, 0x0c, 0x02, 0x03, 0xc3, 0x8f, 0xd7, 0x70, 0xc5, 0x5f, 0x4b, 0xc3, 0x83, 0x21,
+	0xdb, 0xea, 0x7e, 0x5a, 0x7d, 0x35, 0x10, 0xb1, 0x47, 0xd8, 0x11, 0x7b, 0x94, 0x3f, 0x62, 0x33,
+	0x4d, 0x87, 0x15, 0x00, 0xc6, 0x58, 0x01, 0xe0, 0xab, 0x69, 0xb8, 0x3f, 0x4c, 0x6b, 0x20, 0x9f,
+	0xe7, 0x73, 0x49, 0x7e, 0xe7, 0xf9, 0x9e, 0xe7, 0xff, 0x50, 0x80, 0x3b, 0x49, 0xdb, 0x1c, 0xff,
+	0x5f, 0xbb, 0x3c, 0x7b, 0xaf, 0x12, 0xff, 0x49, 0x80, 0x8d, 0x44, 0xad, 0x91, 0x67, 0x16, 0x02,
+	0xa8, 0xa7, 0x86, 0xd4, 0xe9, 0x4e, 0x0d, 0xff, 0x3e, 0x0e, 0xf7, 0x86, 0xf8, 0x8d, 0x87, 0x6f,
+	0x39, 0x84, 0x81, 0xe5, 0x58, 0x80, 0x5c, 0x7f, 0x39, 0x88, 0xcd, 0x67, 0x65, 0x70, 0x5f, 0xd1,
+	0xae, 0x10, 0xd2, 0x67, 0x70, 0x85, 0x30, 0x6c, 0x3d, 0x71, 0xf4, 0x6c, 0xaf, 0x10, 0x32, 0x6f,
+	0xf5, 0x0a, 0x61, 0x6c, 0xe8, 0x2b, 0x84, 0x67, 0x40, 0x3a, 0x54, 0x89, 0x44, 0x52, 0x86, 0x73,
+	0x9a, 0x04, 0x56, 0x22, 0xda, 0x5c, 0xb1, 0x14, 0x52, 0x8c, 0x9b, 0xea, 0x04, 0x5f, 0xf9, 0x9d,
+	0x24, 0x3b, 0x18, 0xcf, 0x79, 0x4c, 0x1e, 0x38, 0x4c, 0xbe, 0x0e, 0x33, 0x3e, 0x73, 0xaa, 0x19,
+	0x6a, 0xd7, 0x83, 0x9f, 0xc3, 0xf0, 0xd7, 0x22, 0x0d, 0xa7, 0xd2, 0x90, 0xd5, 0xae, 0x8b, 0x57,
+	0xbe, 0xd4, 0xa3, 0xbd, 0x0e, 0x95, 0x27, 0xcf, 0x0d, 0x53, 0x9e, 0x0c, 0xf5, 0x1a, 0x4e, 0x52,
+	0x7a, 0x0d, 0xbd, 0x93, 0xd6, 0xf9, 0xe4, 0x77, 0x0b, 0xf9, 0x53, 0xdc, 0x2d, 0x4c, 0x9d, 0xae,
+	0x8d, 0xf0, 0x03, 0xc8, 0x35, 0xd4, 0xa6, 0x72, 0xe2, 0x98, 0x66, 0x7c, 0x4f, 0x24, 0x60, 0x6a,
+	0x6c, 0x8a, 0xe2, 0xd7, 0xd3, 0x70, 0x27, 0xe9, 0x6f, 0xb0, 0x7e, 0xf2, 0xe1, 0x65, 0xc7, 0xcd,
+	0x13, 0x9c, 0x4a, 0xd7, 0xfb, 0x89, 0x7f, 0x40, 0x34, 0x90, 0x1e, 0xf8, 0x1c, 0x65, 0x74, 0xd0,
+	0x51, 0xe8, 0x9b, 0x60, 0x86, 0xb1, 0x09, 0x9e, 0xd1, 0x5d, 0xa0, 0xf8, 0xdd, 0x14, 0xac, 0x27,
+	0xf9, 0x81, 0x19, 0x73, 0x3d, 0xe8, 0xbb, 0x6f, 0xea, 0xb4, 0xbb, 0xef, 0x59, 0xad, 0x22, 0x7d,
+	0x76, 0x47, 0x18, 0xb3, 0xeb, 0x79, 0xe7, 0x28, 0xff, 0x3d, 0xc8, 0x8f, 0x52, 0x90, 0xf0, 0xa7,
+	0x6f, 0x9f, 0x8d, 0xc9, 0xa4, 0x95, 0x75, 0x46, 0xa9, 0x65, 0x1d, 0xaf, 0x1f, 0x21, 0xc3, 0xdf,
+	0x8f, 0x20, 0xfe, 0x77, 0x0a, 0x6e, 0x9f, 0x45, 0x44, 0xf9, 0x8c, 0x4e, 0xba, 0xef, 0xc6, 0x3d,
+	0x93, 0xe0, 0xc6, 0x5d, 0xfc, 0x9f, 0x14, 0x6c, 0x24, 0xfa, 0x25, 0xe2, 0xbb, 0x89, 0x0f, 0x4d,
+	0xbc, 0x7b, 0xa5, 0x98, 0x49, 0x72, 0xcf, 0xfc, 0xcb, 0x69, 0xd6, 0xc4, 0xb3, 0x7a, 0x48, 0xde,
+	0x4d, 0x7c, 0x64, 0x0b, 0x4b, 0x66, 0x98, 0xde, 0xf7, 0xbf, 0x4d, 0xc1, 0x66, 0xc2, 0x5f, 0x88,
+	0xbe, 0x5b, 0x87, 0x81, 0x75, 0x58, 0xb3, 0xe0, 0x3c, 0xfe, 0x73, 0x5b, 0x6b, 0x5a, 0xaa, 0x81,
+	0x87, 0xba, 0x06, 0xb3, 0xd2, 0x33, 0x69, 0xb7, 0x5a, 0xdb, 0xae, 0xec, 0x54, 0x25, 0xb9, 0x56,
+	0xfd, 0xf2, 0xbe, 0x54, 0xab, 0xec, 0x3e, 0x2b, 0xee, 0x54, 0xca, 0xf9, 0xf7, 0xd0, 0x02, 0x5c,
+	0x0d, 0x7f, 0x2e, 0xee, 0xec, 0xd4, 0xf0, 0xdb, 0xbc, 0x80, 0x96, 0xe0, 0x5a, 0x98, 0xa0, 0xb4,
+	0xb3, 0x77, 0x20, 0x11, 0x92, 0xd4, 0xda, 0x1f, 0x9f, 0x87, 0x2c, 0x1e, 0x96, 0x34, 0xa9, 0x22,
+	0x87, 0x21, 0x30, 0xd2, 0x2d, 0xb8, 0xe1, 0x7b, 0xff, 0x7c, 0x4f, 0xfe, 0x78, 0x7b, 0x67, 0xef,
+	0x79, 0x4d, 0xfa, 0x44, 0x2a, 0x1d, 0x56, 0x2b, 0x7b, 0xbb, 0xb5, 0x83, 0x6a, 0x51, 0xae, 0x4a,
+	0xe5, 0xbc, 0x80, 0x6e, 0xc3, 0xcd, 0x68, 0xd2, 0xd2, 0xde, 0xd3, 0xfd, 0x1d, 0xc9, 0x26, 0x4e,
+	0xa1, 0x55, 0x58, 0x8e, 0x26, 0xde, 0x2e, 0x56, 0x76, 0xa4, 0x72, 0x3e, 0x1d, 0x2f, 0xb6, 0x5a,
+	0x79, 0x2a, 0x95, 0x6b, 0x7b, 0x87, 0xd5, 0xfc, 0x08, 0x5a, 0x01, 0xd1, 0x47, 0x5c, 0x96, 0x4a,
+	0x95, 0x03, 0x4c, 0x52, 0x3c, 0xf8, 0xb8, 0x76, 0x50, 0x7a, 0x22, 0x95, 0x0f, 0x6d, 0xa1, 0xa3,
+	0x68, 0x19, 0x16, 0xd9, 0x74, 0x44, 0xa3, 0x4c, 0xa4, 0x34, 0x4f, 0x99, 0xb1, 0x48, 0x3a, 0x0f,
+	0xdd, 0x38, 0xba, 0x0e, 0x0b, 0x4c, 0x3a, 0xa2, 0x6f, 0x36, 0x20, 0xac, 0x58, 0xaa, 0x56, 0x9e,
+	0x55, 0xaa, 0x5f, 0x0e, 0xaa, 0x00, 0x01, 0x15, 0x02, 0x74, 0x44, 0x85, 0x5c, 0xa4, 0x34, 0x4f,
+	0x85, 0x89, 0x00, 0xb4, 0x41, 0x3a, 0x02, 0xed, 0x5c, 0xa4, 0x30, 0x4f, 0xcf, 0x49, 0xb4, 0x01,
+	0xb7, 0xd8, 0x83, 0x16, 0x77, 0x4b, 0xd2, 0x4e, 0x4d, 0x96, 0x7e, 0xf6, 0x50, 0x3a, 0xb0, 0xc7,
+	0x3e, 0x8f, 0xb6, 0xa0, 0xe0, 0x23, 0x27, 0x5f, 0x5c, 0x42, 0x2a, 0x94, 0x3c, 0xba, 0x01, 0x4b,
+	0x31, 0x43, 0x48, 0xe5, 0xfc, 0x14, 0x9a, 0x83, 0x19, 0x1f, 0x99, 0x8d, 0x51, 0xee, 0x4f, 0x0e,
+	0x42, 0x57, 0x60, 0x3a, 0xf4, 0x75, 0xbb, 0x22, 0x4b, 0xe5, 0xfc, 0x05, 0x24, 0xc2, 0xbc, 0xef,
+	0x1b, 0x01, 0x43, 0x48, 0x1c, 0x10, 0x17, 0x3d, 0x2f, 0xf5, 0xf1, 0xf7, 0x07, 0xbf, 0x14, 0xd0,
+	0x8b, 0xe6, 0x10, 0xc1, 0xb9, 0x98, 0x46, 0x6b, 0xb0, 0xc2, 0xc3, 0x23, 0x95, 0xf3, 0x97, 0x51,
+	0x09, 0x3e, 0x62, 0xcf, 0x9b, 0xf4, 0x49, 0x55, 0x92, 0x77, 0x8b, 0x3b, 0x34, 0x19, 0x95, 0xdd,
+	0x4a, 0xb5, 0x52, 0xb4, 0x07, 0x9c, 0x41, 0x5f, 0x82, 0x2f, 0x0c, 0x27, 0x84, 0xcc, 0xc2, 0x2c,
+	0x7a, 0x04, 0x0f, 0x7c, 0x12, 0xa2, 0x58, 0x42, 0xfa, 0x5e, 0x41, 0xf3, 0x70, 0xc5, 0xc7, 0xfc,
+	0xb4, 0x28, 0x7f, 0x2c, 0xc9, 0x35, 0x59, 0x2a, 0xed, 0xc9, 0x65, 0xa9, 0x9c, 0xbf, 0x1a, 0x3f,
+	0x1f, 0x07, 0x95, 0x9f, 0xd9, 0x2d, 0xda, 0x40, 0xe6, 0xd0, 0x3a, 0xac, 0xc6, 0x44, 0x0a, 0x49,
+	0x7e, 0x5a, 0xd9, 0xc5, 0x8a, 0x5f, 0xe3, 0x58, 0x9d, 0xbd, 0xdd, 0x6a, 0x65, 0xf7, 0x50, 0x2a,
+	0xd7, 0x8a, 0x07, 0xb5, 0x5d, 0xe9, 0x79, 0x7e, 0x1e, 0x3d, 0x80, 0x7b, 0x3e, 0x1e, 0x6c, 0x48,
+	0xb5, 0xd2, 0x93, 0xca, 0x4e, 0x39, 0x7a, 0x96, 0x17, 0xd0, 0x7d, 0xb8, 0xc3, 0xcf, 0x48, 0x66,
+	0x76, 0x11, 0x6d, 0xc2, 0x6d, 0xbf, 0x0d, 0xb2, 0xe8, 0x5d, 0x83, 0x5e, 0x42, 0x77, 0x61, 0x83,
+	0x87, 0xc1, 0x73, 0x7c, 0x11, 0x15, 0x60, 0x8d, 0x87, 0x85, 0x60, 0xba, 0x8e, 0xee, 0xc0, 0x3a,
+	0xd7, 0x10, 0xae, 0x99, 0x2e, 0xf3, 0x82, 0xf2, 0x02, 0xc8, 0x8d, 0xc0, 0xda, 0xb0, 0x59, 0xbc,
+	0xf5, 0x5c, 0x09, 0x98, 0xa1, 0x63, 0x16, 0x9c, 0x5e, 0x70, 0x13, 0x7d, 0x1e, 0xee, 0x27, 0x63,
+	0x26, 0xf3, 0xb1, 0x8a, 0xee, 0xc1, 0x26, 0xa7, 0xf5, 0xf7, 0x2d, 0xf5, 0x56, 0x60, 0x12, 0x0f,
+	0xf7, 0x0f, 0x24, 0xb9, 0xea, 0xb1, 0x1c, 0x48, 0x45, 0xb9, 0xf4, 0xa4, 0x56, 0xac, 0x56, 0xe5,
+	0xca, 0xe3, 0xc3, 0xaa, 0x74, 0x90, 0x5f, 0x7b, 0x7c, 0xf4, 0xdd, 0x4f, 0xe7, 0x85, 0xef, 0x7f,
+	0x3a, 0x2f, 0xfc, 0xe0, 0xd3, 0x79, 0x01, 0x2e, 0xd7, 0xf5, 0x16, 0x2d, 0x7b, 0x79, 0x3c, 0x5e,
+	0xec, 0x68, 0xfb, 0xf6, 0xe5, 0xd3, 0xbe, 0xf0, 0x73, 0x9b, 0x2f, 0x34, 0xeb, 0x65, 0xf7, 0xa8,
+	0x50, 0xd7, 0x5b, 0x9b, 0x03, 0xff, 0xa1, 0xbc, 0xf0, 0x42, 0x6d, 0x3b, 0xff, 0x13, 0x9d, 0xfc,
+	0xb3, 0xf2, 0x47, 0x4a, 0x47, 0x7b, 0x7d, 0xf7, 0x28, 0x83, 0xdf, 0xdd, 0xfb, 0xbf, 0x01, 0x00,
+	0x76, 0x0c, 0xc6, 0xbd, 0x8f, 0x5d, 0x00, 0x00,
 }
 
 func (m *History) Marshal() (dAtA []byte, err error) {
@@ -28,231 +28,263 @@ package apiv1
 var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x38, 0x8e, 0xad, 0xae, 0x64,
-		0x92, 0x68, 0xe2, 0xa4, 0x80, 0x90, 0x96, 0xf1, 0xcc, 0x75, 0x3c, 0xf2, 0xee, 0xcc, 0x76, 0x66,
-		0xd6, 0x1b, 0x23, 0xf1, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x08, 0x84, 0x04,
-		0x6a, 0x11, 0x52, 0x11, 0x08, 0x01, 0xe2, 0x05, 0x90, 0x10, 0x48, 0xa0, 0xc2, 0x13, 0x2f, 0x3c,
-		0xc0, 0x03, 0x0f, 0xf4, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77, 0x66, 0xee,
-		0x9d, 0xb9, 0xb3, 0xde, 0xb4, 0xa0, 0xe6, 0xcd, 0x73, 0xe7, 0xdc, 0x33, 0xbf, 0x7b, 0xee, 0x39,
-		0xe7, 0x9e, 0x7b, 0xce, 0x59, 0xc3, 0xa9, 0xd6, 0x06, 0xb6, 0x17, 0x34, 0x55, 0xc7, 0xa6, 0x86,
-		0x17, 0xd4, 0xa6, 0xb1, 0xb0, 0x73, 0x69, 0x61, 0xcb, 0x70, 0x5c, 0xcb, 0xde, 0xad, 0x34, 0x6d,
-		0xcb, 0xb5, 0xd0, 0x01, 0x8f, 0xa4, 0x42, 0x49, 0x2a, 0x6a, 0xd3, 0xa8, 0xec, 0x5c, 0x3a, 0x72,
-		0xe2, 0xa1, 0x65, 0x3d, 0xac, 0xe3, 0x05, 0x42, 0xb2, 0xd1, 0xda, 0x5c, 0xd0, 0x5b, 0xb6, 0xea,
-		0x1a, 0x96, 0xe9, 0x4f, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x35, 0x1a, 0xd8, 0x71, 0xd5, 0x46, 0x93,
-		0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x59, 0x8d, 0x46, 0x87, 0x85, 0xcc, 0xa2, 0x70, 0x55, 0x67, 0xbb,
-		0x6e, 0x38, 0x6e, 0x12, 0x4d, 0xdb, 0xb2, 0xb7, 0x37, 0xeb, 0x56, 0xdb, 0xa7, 0x91, 0x6f, 0x41,
-		0xf1, 0x25, 0x7f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x36, 0x5d, 0x67, 0x4a, 0x9a, 0xc9, 0xcf,
-		0x96, 0x17, 0x4f, 0x55, 0x18, 0x6b, 0xab, 0x50, 0xea, 0x15, 0x8f, 0x52, 0xa1, 0x13, 0xe4, 0xf7,
-		0xae, 0xc1, 0x68, 0xef, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19, 0x69,
-		0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xfc, 0x57, 0xde, 0xa2, 0xa7, 0x72, 0x33,
-		0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x97, 0x48, 0x25, 0x90, 0x48, 0x65, 0x3d, 0x90, 0x88, 0x52,
-		0x22, 0xd4, 0xde, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x1d, 0xc3, 0x32, 0xa7, 0xf2, 0x3e, 0x53,
-		0xfa, 0x88, 0x0e, 0x43, 0xd1, 0x5b, 0xbc, 0xf7, 0xb9, 0x21, 0xf2, 0xa6, 0xe0, 0x3d, 0x56, 0x75,
-		0xf4, 0x75, 0x09, 0xce, 0x07, 0x4b, 0xae, 0xe1, 0x47, 0x58, 0x6b, 0x79, 0xfb, 0x50, 0x73, 0x5c,
-		0xd5, 0x76, 0xb1, 0x5e, 0xf3, 0x91, 0xa8, 0xae, 0x6b, 0x1b, 0x1b, 0x2d, 0x17, 0x3b, 0x53, 0xc3,
-		0x04, 0xcf, 0xff, 0x33, 0x97, 0xfe, 0x32, 0xe5, 0xb3, 0x12, 0xb0, 0xb9, 0xe7, 0x73, 0x21, 0x4b,
-		0x5e, 0xea, 0xf0, 0x78, 0xe9, 0x29, 0xe5, 0x5c, 0x5b, 0x8c, 0x14, 0x7d, 0x5b, 0x82, 0x0b, 0x0c,
-		0x78, 0x9a, 0xd5, 0x68, 0xd6, 0x31, 0x13, 0x60, 0x81, 0x00, 0x7c, 0x41, 0x0c, 0xe0, 0x72, 0xc0,
-		0x27, 0x0e, 0xf1, 0x99, 0xb6, 0x28, 0x31, 0x7a, 0x53, 0x82, 0x39, 0x06, 0xc8, 0x4d, 0xd5, 0xa8,
-		0xb3, 0x10, 0x16, 0x09, 0xc2, 0x1b, 0x62, 0x08, 0x57, 0x09, 0x93, 0x38, 0xbc, 0xb3, 0x6d, 0x21,
-		0x4a, 0xf4, 0x2d, 0xb6, 0x00, 0x3d, 0xdd, 0xd2, 0x6b, 0x56, 0xcb, 0x8d, 0xc3, 0x1b, 0x21, 0xf0,
-		0x9e, 0x17, 0x83, 0xe7, 0xa9, 0x9d, 0x7e, 0xa7, 0xe5, 0xc6, 0x01, 0xce, 0xb6, 0x05, 0x69, 0xd1,
-		0x1b, 0x12, 0xcc, 0xea, 0x58, 0x33, 0x1c, 0x02, 0xcc, 0xd3, 0x52, 0x47, 0xdb, 0xc2, 0x7a, 0x8b,
-		0x29, 0xbc, 0x12, 0x41, 0x77, 0x9d, 0x89, 0xee, 0x16, 0x65, 0xb2, 0xae, 0x3a, 0xdb, 0xf7, 0x02,
-		0x16, 0x71, 0x64, 0x67, 0x74, 0x01, 0x3a, 0xf4, 0x9a, 0x04, 0x67, 0x23, 0xa8, 0x78, 0x36, 0x01,
-		0x04, 0xd3, 0xb5, 0x74, 0x4c, 0x3c, 0x73, 0x90, 0xf5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x8c, 0xa0,
-		0x2c, 0x28, 0xa5, 0x04, 0xfd, 0x0f, 0x49, 0x89, 0xab, 0xfa, 0xaf, 0xc7, 0x50, 0x25, 0x68, 0xd6,
-		0x28, 0x41, 0xf5, 0x7f, 0xa9, 0xa8, 0xf8, 0x4a, 0x75, 0x5a, 0x4f, 0x27, 0x43, 0x5f, 0x94, 0xe0,
-		0xe9, 0x30, 0x26, 0x9e, 0x25, 0xee, 0x23, 0x80, 0xae, 0xa6, 0x02, 0xe2, 0x19, 0xe1, 0x29, 0x3d,
-		0x8d, 0x88, 0x6c, 0x9b, 0xaa, 0xb9, 0xc6, 0x8e, 0xe1, 0xee, 0xa6, 0x2a, 0xf7, 0x58, 0xc2, 0xb6,
-		0x2d, 0x51, 0x26, 0x69, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0xe5, 0x8e, 0xa0, 0xe2, 0x29, 0xf7, 0xfe,
-		0x04, 0xe5, 0x0e, 0x61, 0xe2, 0x2a, 0xb7, 0x9a, 0x4a, 0xc5, 0x90, 0x52, 0x82, 0x72, 0x8f, 0x0b,
-		0x4a, 0x29, 0x49, 0xb9, 0x55, 0x01, 0x3a, 0xa2, 0x48, 0x61, 0x54, 0x3c, 0x45, 0x9a, 0x48, 0x50,
-		0xa4, 0x5e, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a, 0x4a,
-		0xb0, 0xb4, 0x5e, 0x3c, 0x09, 0x96, 0xa6, 0xa6, 0x93, 0xa1, 0x36, 0x9c, 0xf0, 0x40, 0xd8, 0x7c,
-		0xed, 0x39, 0x40, 0x80, 0x5c, 0x64, 0x02, 0xf1, 0xb8, 0xda, 0x5c, 0xb5, 0x39, 0xea, 0xf2, 0x5f,
-		0xa3, 0x57, 0xe0, 0x98, 0xff, 0xe1, 0x4d, 0xc3, 0x66, 0x7d, 0xf6, 0x20, 0xf9, 0x6c, 0x85, 0xff,
-		0xd9, 0x55, 0x6f, 0x5e, 0xfc, 0xa3, 0xd3, 0x2e, 0xef, 0x25, 0xfa, 0xae, 0x04, 0x0b, 0x11, 0x15,
-		0x55, 0x4d, 0x0d, 0xd7, 0x6b, 0x36, 0x7e, 0xa5, 0x85, 0x1d, 0xe6, 0xea, 0x0f, 0x11, 0x18, 0x2f,
-		0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0x04, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x23, 0x09,
-		0xae, 0x50, 0x4c, 0x01, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7, 0xfc,
-		0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xce, 0x34, 0x03, 0x7d, 0x59, 0x82, 0x73, 0x2c, 0xf1, 0xb2, 0x80,
-		0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0xb3, 0x70, 0xd2, 0x57,
-		0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x7c, 0x05, 0xe6, 0x7d, 0xfb,
-		0x0b, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c, 0x04,
-		0x3e, 0x73, 0x5f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc1, 0x4c, 0x43, 0xb5,
-		0xb7, 0xb1, 0x5d, 0xb3, 0xb1, 0x66, 0xd9, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09, 0xe2,
-		0xe3, 0x64, 0xb2, 0x42, 0xe7, 0xc6, 0x11, 0x1c, 0x6f, 0x24, 0x11, 0xa0, 0x6f, 0x4a, 0x30, 0xcf,
-		0xba, 0x9f, 0x18, 0x0f, 0x4d, 0x95, 0x29, 0x90, 0xa3, 0x59, 0xc2, 0xd7, 0x7b, 0x94, 0x8d, 0x48,
-		0xf8, 0xca, 0xa1, 0x45, 0xdf, 0x91, 0xa0, 0xc2, 0x8a, 0xb0, 0xb1, 0xdd, 0x30, 0x4c, 0x95, 0xe9,
-		0x17, 0x8e, 0x25, 0xf8, 0x85, 0x78, 0x88, 0xdd, 0x61, 0xc4, 0xf0, 0x0b, 0x6d, 0x61, 0x6a, 0xf4,
-		0x63, 0x09, 0xae, 0xb0, 0xae, 0x52, 0xa9, 0x5e, 0xec, 0x38, 0x41, 0x7b, 0x4b, 0xf0, 0x46, 0x95,
-		0xe6, 0xca, 0x16, 0xda, 0xd9, 0xa6, 0xf0, 0x34, 0x80, 0x6f, 0x94, 0x27, 0xb2, 0x68, 0x00, 0xdf,
-		0x40, 0x67, 0xdb, 0x82, 0xb4, 0xe8, 0x6f, 0x12, 0xac, 0x44, 0x3c, 0x2e, 0x7e, 0xe4, 0x62, 0xdb,
-		0x54, 0xeb, 0x35, 0x06, 0x72, 0xc3, 0x34, 0x5c, 0x83, 0xad, 0x18, 0x27, 0x09, 0xf4, 0x7b, 0xe9,
-		0x2e, 0x78, 0x85, 0xf2, 0x8f, 0xad, 0xa7, 0x1a, 0x30, 0x8f, 0x2f, 0xe8, 0x05, 0x7b, 0x4f, 0x1c,
-		0xd0, 0x9f, 0x25, 0xb8, 0x99, 0x61, 0x99, 0x3c, 0x8f, 0x35, 0x43, 0xd6, 0x78, 0x77, 0x0f, 0x6b,
-		0xe4, 0x39, 0xb3, 0x1b, 0x76, 0xff, 0xd3, 0xd1, 0xbb, 0x12, 0x3c, 0x9f, 0xb4, 0x9c, 0x74, 0x3b,
-		0x39, 0x45, 0x16, 0xb6, 0xc6, 0x5c, 0x18, 0x17, 0x4c, 0xaa, 0xbd, 0x5c, 0xc3, 0xfd, 0x4d, 0x25,
-		0x71, 0x00, 0x33, 0x75, 0x62, 0xba, 0x86, 0xd9, 0xc2, 0x7a, 0x4d, 0x75, 0x6a, 0x26, 0x6e, 0xc7,
-		0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0xe4, 0xdc, 0xc6, 0x6d, 0x46, 0x1c,
-		0xd0, 0xce, 0x34, 0x03, 0xfd, 0x4a, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46, 0x5d, 0xcf,
-		0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x31, 0xcd, 0x62, 0x34,
-		0x97, 0x9d, 0xec, 0xd3, 0xd0, 0xcf, 0x24, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c, 0x21, 0x2b,
-		0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0x74, 0x32, 0xce, 0x41, 0xdf, 0x97, 0xe0, 0x12, 0x17,
-		0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03, 0xff, 0x79,
-		0x2d, 0x03, 0x3d, 0xfa, 0xa1, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13, 0x94, 0x9c,
-		0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0x6f, 0x4b, 0x70, 0x31, 0xb3, 0x66, 0x9c,
-		0x23, 0x88, 0x3f, 0x96, 0x01, 0x31, 0x4f, 0x29, 0xce, 0x6b, 0x19, 0xf4, 0xe1, 0x1d, 0x09, 0x16,
-		0xf9, 0x02, 0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0xcd, 0x2c, 0xf2, 0xe5, 0x9e, 0xc4, 0x17, 0xb4,
-		0x2c, 0x13, 0xd0, 0x0f, 0x92, 0x54, 0x22, 0xe1, 0xd2, 0xfc, 0x4c, 0x66, 0xc8, 0xfc, 0xeb, 0x33,
-		0x07, 0x32, 0xef, 0x22, 0xed, 0xc5, 0x66, 0x7c, 0xc8, 0x09, 0x91, 0xe4, 0x5c, 0x42, 0x6c, 0xc6,
-		0xc1, 0x9c, 0x10, 0x4e, 0x2e, 0x68, 0xd9, 0xa6, 0x90, 0x43, 0xd3, 0x0f, 0xc5, 0xfb, 0x8d, 0x78,
-		0xce, 0x27, 0x1c, 0x9a, 0x7e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xe9, 0x6f, 0x2a, 0xfa, 0xb5,
-		0x04, 0xcf, 0x09, 0x2c, 0x88, 0x67, 0xa3, 0xf3, 0x64, 0x35, 0xd5, 0x7e, 0x56, 0xc3, 0x33, 0xd6,
-		0x2b, 0x4e, 0x1f, 0xf3, 0xd0, 0x4f, 0x25, 0x78, 0x36, 0x69, 0x01, 0xfc, 0xfb, 0xd3, 0x85, 0x84,
-		0x03, 0x88, 0x0b, 0x82, 0x7f, 0x8f, 0xba, 0x88, 0x33, 0xce, 0x21, 0x0e, 0xa7, 0xd5, 0x74, 0xb0,
-		0xed, 0x76, 0x81, 0x3b, 0x58, 0xb5, 0xb5, 0xad, 0x1e, 0x98, 0x71, 0xdc, 0x95, 0x04, 0xeb, 0xbd,
-		0x4f, 0xd8, 0x05, 0x08, 0xee, 0x11, 0x66, 0xdd, 0x2f, 0x32, 0xac, 0xb7, 0x95, 0x65, 0xc2, 0xcd,
-		0x51, 0x80, 0x2e, 0x10, 0xf9, 0xaf, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe, 0xce, 0x1a,
-		0xdd, 0xdd, 0x26, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0x31, 0x60, 0xba, 0xbe, 0xdb, 0xc4, 0xca, 0x68,
-		0xbb, 0xe7, 0x09, 0x7d, 0x1a, 0x0e, 0x35, 0x55, 0xdb, 0x93, 0x48, 0xaf, 0xd1, 0x6d, 0x5a, 0xb4,
-		0x7c, 0x38, 0xcb, 0xe4, 0x77, 0x97, 0xcc, 0xe8, 0xb1, 0x89, 0x4d, 0x4b, 0x39, 0xd0, 0x8c, 0x0f,
-		0xa2, 0xe7, 0xa0, 0x44, 0x32, 0x32, 0x75, 0xc3, 0x71, 0x49, 0x61, 0xb1, 0xbc, 0x78, 0x9c, 0x9d,
-		0xf2, 0x50, 0x9d, 0xed, 0x35, 0xc3, 0x71, 0x95, 0x11, 0x97, 0xfe, 0x85, 0x16, 0x61, 0xd8, 0x30,
-		0x9b, 0x2d, 0x97, 0x94, 0x1d, 0xcb, 0x8b, 0xc7, 0x38, 0x48, 0x76, 0xeb, 0x96, 0xaa, 0x2b, 0x3e,
-		0x29, 0x52, 0x61, 0x26, 0x12, 0x72, 0xd4, 0x5c, 0xab, 0xa6, 0xd5, 0x2d, 0x07, 0x13, 0xff, 0x6d,
-		0xb5, 0x5c, 0x5a, 0x87, 0x9c, 0x8e, 0xd5, 0x45, 0x6f, 0xd1, 0x4a, 0xb2, 0x72, 0x0c, 0x87, 0x64,
-		0xbf, 0x6e, 0x2d, 0x7b, 0xf3, 0xd7, 0xfd, 0xe9, 0xe8, 0x65, 0x38, 0xda, 0x4d, 0x7b, 0xc7, 0xb9,
-		0x17, 0xd2, 0xb8, 0x1f, 0x76, 0x83, 0x64, 0x76, 0x84, 0xf1, 0x0d, 0x38, 0xd2, 0x8d, 0xb0, 0xbb,
-		0xab, 0xb0, 0x5b, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x50, 0x74, 0xe4, 0xac,
-		0xb4, 0xcc, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb2, 0x49, 0x1d, 0x6e, 0x6c, 0xf1, 0x3c,
-		0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0x06, 0x53, 0x94, 0xee, 0x6c, 0x54, 0x85, 0x89, 0x2e,
-		0x0e, 0xcf, 0x5d, 0xb5, 0x6c, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x69, 0x94, 0xf1, 0xce,
-		0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xde, 0x9d, 0xcf, 0x0f, 0x67, 0xc8, 0x72, 0xb0, 0xd3,
-		0xaa, 0xbb, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xbd, 0xb9, 0xcb, 0x9d, 0xa9, 0x0a, 0x99, 0x89,
-		0xae, 0xc3, 0xb4, 0x65, 0x1b, 0x0f, 0x0d, 0xdf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52, 0x9a, 0x0c,
-		0x08, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x4d, 0xd7, 0x70, 0x77, 0x49, 0x45, 0xa9, 0xa4,
-		0x74, 0x9e, 0xd1, 0x65, 0x98, 0xdc, 0x34, 0x6c, 0xc7, 0x8d, 0xf3, 0xdc, 0x47, 0x28, 0x0f, 0x90,
-		0xb7, 0x11, 0x86, 0xcb, 0x30, 0x6a, 0x63, 0xd7, 0xde, 0xad, 0x35, 0xad, 0xba, 0xa1, 0xed, 0xd2,
-		0x2a, 0xcc, 0x0c, 0xe7, 0x82, 0xea, 0xda, 0xbb, 0x77, 0x09, 0x9d, 0x52, 0xb6, 0xbb, 0x0f, 0x68,
-		0x0a, 0x8a, 0xaa, 0xeb, 0xe2, 0x46, 0xd3, 0x25, 0x15, 0x93, 0x61, 0x25, 0x78, 0x44, 0xcb, 0xb0,
-		0x1f, 0x3f, 0x6a, 0x1a, 0xbe, 0xe2, 0xf8, 0x45, 0xfd, 0xf1, 0xd4, 0xa2, 0xfe, 0x58, 0x77, 0x0a,
-		0xa9, 0xec, 0x9f, 0x86, 0x7d, 0x9a, 0xed, 0x59, 0x03, 0xad, 0xe8, 0x90, 0x8a, 0x43, 0x49, 0x19,
-		0xf5, 0x06, 0x83, 0x2a, 0x0f, 0xfa, 0x04, 0x1c, 0xf5, 0x57, 0x1f, 0xae, 0x7e, 0x6d, 0xa8, 0xda,
-		0xb6, 0xb5, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0xf7, 0x16, 0xbe, 0x6e, 0xfa,
-		0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x45, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f, 0x6e, 0x58,
-		0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b, 0x23, 0x1e,
-		0x56, 0x19, 0x77, 0x22, 0x23, 0xe8, 0x3e, 0x4c, 0x36, 0x6d, 0xbc, 0x53, 0x53, 0x5b, 0xae, 0xe5,
-		0xe9, 0x1f, 0x76, 0x6b, 0x4d, 0xcb, 0x30, 0xdd, 0x20, 0xcb, 0xce, 0xdb, 0x2f, 0x07, 0xbb, 0x77,
-		0x09, 0x9d, 0x72, 0xc0, 0x9b, 0xbf, 0xd4, 0x72, 0xad, 0x9e, 0x41, 0x74, 0x19, 0x0a, 0x5b, 0x58,
-		0xd5, 0xb1, 0x4d, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2, 0xdb, 0x12,
-		0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d, 0x5a, 0x85,
-		0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55, 0x5d, 0x7e,
-		0x4b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0x06, 0x9e, 0x42, 0x12, 0xf0, 0x14, 0x01, 0xf1,
-		0xc0, 0xa0, 0x5a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc3, 0x28, 0x75, 0xd6, 0xdd, 0x83, 0x73, 0x8c,
-		0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0x76, 0x1f, 0xe4, 0xdf, 0x4b, 0x70, 0x46, 0xa4,
-		0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0xdb, 0x30, 0xc9, 0x39, 0x65, 0x72, 0x69, 0x06,
-		0x79, 0xc0, 0x61, 0x9c, 0x30, 0x3d, 0x9e, 0x26, 0x1f, 0xf2, 0x34, 0xf2, 0x6b, 0x12, 0xc8, 0xe9,
-		0xfd, 0x06, 0x68, 0x1e, 0x50, 0xb4, 0x06, 0xdd, 0xe9, 0x42, 0x1a, 0x77, 0x42, 0x22, 0x88, 0xb8,
-		0xdb, 0x5c, 0xc4, 0xdd, 0x1e, 0x07, 0x08, 0x12, 0x82, 0x86, 0x4e, 0xd0, 0x94, 0x94, 0x12, 0x1d,
-		0xa9, 0xea, 0xf2, 0x3f, 0x22, 0xe2, 0xe5, 0x5a, 0x48, 0x36, 0x44, 0xb3, 0x30, 0x1e, 0xce, 0x43,
-		0x74, 0xd4, 0x6b, 0xcc, 0xe9, 0x59, 0x71, 0x04, 0x7b, 0x3e, 0x82, 0xfd, 0x1c, 0xec, 0xdf, 0x30,
-		0x4c, 0xd5, 0xde, 0xad, 0x69, 0x5b, 0x58, 0xdb, 0x76, 0x5a, 0x0d, 0x12, 0xa2, 0x94, 0x94, 0x31,
-		0x7f, 0x78, 0x99, 0x8e, 0xa2, 0xf3, 0x30, 0x11, 0xce, 0x9e, 0xe1, 0x47, 0x7e, 0xf8, 0x31, 0xaa,
-		0x8c, 0xe3, 0xde, 0xa4, 0x16, 0x7e, 0xe4, 0xca, 0xaf, 0xe6, 0xe1, 0xb4, 0x40, 0x2b, 0xc3, 0x63,
-		0x5b, 0x71, 0xd4, 0x2c, 0xf2, 0x7d, 0x98, 0x05, 0x3a, 0x01, 0xe5, 0x0d, 0xd5, 0xc1, 0xc1, 0xd1,
-		0xe9, 0x8b, 0xa5, 0xe4, 0x0d, 0xf9, 0x07, 0xe6, 0x31, 0x00, 0x13, 0xb7, 0x83, 0xd7, 0xc3, 0xbe,
-		0x60, 0x4d, 0xdc, 0xf6, 0xdf, 0xce, 0x03, 0xda, 0xb4, 0xec, 0x6d, 0x8a, 0x34, 0xe8, 0x47, 0x2b,
-		0xf8, 0x4b, 0xf3, 0xde, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xe7, 0x1c, 0x55, 0xc7, 0x32,
-		0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0x96, 0x83, 0x69, 0x18, 0x54, 0x11, 0x6e,
-		0x1a, 0x59, 0xf6, 0x66, 0x29, 0xfe, 0x64, 0xf9, 0xad, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4, 0x78, 0x6c,
-		0x9b, 0x71, 0x33, 0x58, 0x83, 0xbf, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xbd, 0x2b, 0xe8, 0xf5, 0xc9,
-		0x43, 0x59, 0x7c, 0x72, 0xaf, 0xea, 0x0f, 0x47, 0x54, 0x3f, 0xb2, 0xbf, 0x85, 0xe4, 0xfd, 0x2d,
-		0x0a, 0xed, 0xef, 0x08, 0x67, 0x7f, 0x19, 0x66, 0x56, 0x62, 0x99, 0x99, 0xfc, 0x8d, 0x02, 0x9c,
-		0x11, 0xe9, 0x71, 0x41, 0x27, 0xa1, 0xdc, 0x29, 0x14, 0xd3, 0x6d, 0x2a, 0x29, 0x10, 0x0c, 0x55,
-		0x75, 0xef, 0x52, 0xd5, 0xad, 0x24, 0x7b, 0x46, 0x90, 0x4b, 0xb8, 0x54, 0x75, 0x3e, 0x49, 0x2e,
-		0x55, 0x6a, 0xcf, 0x93, 0xa7, 0x9a, 0xba, 0xd5, 0x50, 0x0d, 0x93, 0xfa, 0x0e, 0xfa, 0x14, 0x3e,
-		0x0c, 0x86, 0xfa, 0xbc, 0x0e, 0x15, 0xc4, 0xaf, 0x43, 0xeb, 0x30, 0x1d, 0x28, 0x61, 0xfc, 0x0c,
-		0x29, 0xa6, 0x9d, 0x21, 0x93, 0xc1, 0xdc, 0xc8, 0x31, 0x12, 0xe1, 0x4a, 0x8f, 0x28, 0xca, 0x75,
-		0x24, 0x03, 0x57, 0xff, 0x16, 0x44, 0xb9, 0xf2, 0x0f, 0xbb, 0x52, 0x5f, 0x87, 0xdd, 0x2a, 0x4c,
-		0x6c, 0x61, 0xd5, 0x76, 0x37, 0xb0, 0xda, 0x45, 0x07, 0x69, 0xac, 0xc6, 0x3b, 0x73, 0xba, 0x7c,
-		0xd2, 0x43, 0x94, 0x72, 0x7a, 0x88, 0x12, 0xbb, 0x2b, 0x8c, 0xf6, 0x73, 0x57, 0xe8, 0xc6, 0x9c,
-		0xfb, 0xc4, 0x63, 0xce, 0xbf, 0x4b, 0x20, 0xa7, 0xf7, 0x5b, 0x7d, 0x60, 0x87, 0x7b, 0x6f, 0x18,
-		0x32, 0x14, 0xbe, 0xf0, 0xbc, 0x08, 0xa3, 0xe4, 0xbe, 0x18, 0xf8, 0xad, 0x61, 0x01, 0xbf, 0x55,
-		0xf6, 0x66, 0xd0, 0x07, 0xf9, 0x8f, 0x52, 0xd8, 0x15, 0x0c, 0x38, 0xb2, 0x66, 0x8b, 0x28, 0x97,
-		0xc1, 0xdd, 0xe7, 0x53, 0xa3, 0x8d, 0xa1, 0xb0, 0x30, 0xe5, 0x3f, 0x48, 0x70, 0x2a, 0xbd, 0x09,
-		0xa6, 0xdf, 0x00, 0xfc, 0xc3, 0x58, 0xd1, 0xcf, 0x73, 0x70, 0x5a, 0xa0, 0x95, 0xcc, 0x5b, 0x93,
-		0x8e, 0x5d, 0xd5, 0xa8, 0x3b, 0x42, 0x9b, 0x14, 0x10, 0x3f, 0xb6, 0x35, 0x45, 0x23, 0xa4, 0xa1,
-		0x7e, 0x22, 0xa4, 0x3d, 0xab, 0xf8, 0x57, 0x24, 0x98, 0x13, 0xef, 0x00, 0x13, 0x39, 0xf3, 0x06,
-		0x73, 0x05, 0x7b, 0x47, 0x82, 0x8c, 0xbd, 0x5e, 0xe9, 0xd8, 0x0e, 0x06, 0x61, 0x90, 0xef, 0x61,
-		0x68, 0x60, 0x23, 0x82, 0x38, 0x2f, 0x80, 0xf8, 0xcd, 0x88, 0x1e, 0xf2, 0xaa, 0x42, 0xfd, 0xea,
-		0xe1, 0x2a, 0xcc, 0xd4, 0x55, 0xb7, 0xa7, 0xe7, 0x21, 0xda, 0x01, 0xd0, 0x95, 0xac, 0x4f, 0xc7,
-		0xda, 0x4a, 0x3f, 0x6c, 0x62, 0xe8, 0x73, 0x3e, 0x83, 0x3e, 0x0f, 0xa5, 0xda, 0x68, 0x24, 0xd0,
-		0x93, 0xdf, 0x95, 0xe0, 0x68, 0x42, 0x97, 0x25, 0x9a, 0x86, 0x11, 0xbf, 0xbb, 0xac, 0xb3, 0x6f,
-		0x45, 0xf2, 0x5c, 0xd5, 0xd1, 0x1a, 0x1c, 0xea, 0x1c, 0xe4, 0x9b, 0x86, 0x9d, 0xe1, 0xd2, 0x8a,
-		0xe8, 0x39, 0xbe, 0x6a, 0xd8, 0x38, 0xcb, 0xf1, 0x2b, 0xb2, 0xd9, 0x9f, 0x81, 0x69, 0x6e, 0xfb,
-		0x66, 0xd2, 0x6a, 0x84, 0x63, 0x76, 0xf9, 0x37, 0x12, 0x1c, 0x4b, 0xea, 0xdc, 0x1b, 0xc8, 0x57,
-		0x06, 0x25, 0x8f, 0x44, 0x07, 0xfd, 0x13, 0x09, 0x66, 0xd2, 0x3a, 0x00, 0x93, 0x56, 0xf3, 0x58,
-		0xcd, 0x36, 0x11, 0xf9, 0xbf, 0x8b, 0x90, 0xb1, 0xd1, 0x04, 0x2d, 0xc0, 0x41, 0xd2, 0xcb, 0x12,
-		0x4d, 0xfb, 0xfa, 0x6b, 0x9a, 0x30, 0x71, 0x3b, 0x92, 0xf4, 0x8d, 0x55, 0x5e, 0x72, 0xfd, 0x55,
-		0x5e, 0x9e, 0xd4, 0x46, 0xc4, 0x6b, 0x23, 0x22, 0xba, 0x53, 0x14, 0xd0, 0x9d, 0x3b, 0x30, 0x49,
-		0x73, 0xda, 0x14, 0xa3, 0x61, 0xba, 0xd8, 0xde, 0x51, 0xeb, 0xe9, 0xf7, 0x96, 0x83, 0x74, 0x22,
-		0x81, 0x57, 0xa5, 0xd3, 0xc2, 0x75, 0x97, 0xd2, 0x9e, 0xea, 0x2e, 0x3d, 0x21, 0x1c, 0x64, 0x09,
-		0xe1, 0xf8, 0x45, 0x96, 0x72, 0xdf, 0x45, 0x96, 0xee, 0x3d, 0x63, 0x54, 0xf8, 0x9e, 0xd1, 0x49,
-		0xf5, 0xef, 0xdb, 0x43, 0xaa, 0x7f, 0x6c, 0x4f, 0xa9, 0x7e, 0xcf, 0x07, 0x2f, 0x64, 0xed, 0x76,
-		0xeb, 0x78, 0x2b, 0xa9, 0xd7, 0x5b, 0x25, 0xdd, 0x6f, 0x36, 0xe0, 0x70, 0xa7, 0x42, 0x1e, 0xa9,
-		0x9a, 0xfa, 0x76, 0x3c, 0x97, 0x58, 0x03, 0x0f, 0xd7, 0x4d, 0x0f, 0x61, 0xd6, 0xb0, 0xfc, 0x3d,
-		0x89, 0x91, 0xd2, 0xe6, 0x9d, 0x2c, 0x22, 0xe6, 0x21, 0x09, 0x98, 0x47, 0x4f, 0xa4, 0x93, 0xcb,
-		0x10, 0xe9, 0xc8, 0xef, 0x4b, 0x70, 0x3c, 0xb1, 0x5b, 0xdb, 0x0b, 0xf5, 0x68, 0x2f, 0xb8, 0xa9,
-		0x36, 0x02, 0x51, 0x83, 0x3f, 0x74, 0x5b, 0x6d, 0xe0, 0x7e, 0x3f, 0x3d, 0xb0, 0x53, 0xa5, 0xab,
-		0xf1, 0x43, 0xe2, 0x37, 0xeb, 0xaf, 0xb1, 0x36, 0x89, 0xd7, 0x9d, 0x70, 0x12, 0xca, 0xb4, 0x3f,
-		0xa4, 0x57, 0x04, 0xfe, 0x10, 0x11, 0x41, 0xc7, 0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42, 0x9e, 0x5a,
-		0xfe, 0xaa, 0x04, 0x73, 0x19, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x29, 0x94, 0x4f, 0xed, 0x77, 0x67,
-		0x92, 0xa0, 0xfd, 0x32, 0x07, 0x2f, 0xec, 0xad, 0x2b, 0x79, 0x60, 0x3a, 0xdf, 0xcd, 0xd5, 0xe5,
-		0x42, 0xb9, 0xba, 0xfb, 0x80, 0xe2, 0xdd, 0x2f, 0xd4, 0xbe, 0xcf, 0x8a, 0x75, 0xb8, 0x2a, 0x13,
-		0xb1, 0x16, 0x56, 0x34, 0x05, 0x45, 0xcd, 0x32, 0x5d, 0xdb, 0xaa, 0x13, 0x45, 0x1b, 0x55, 0x82,
-		0x47, 0x54, 0x81, 0x03, 0x91, 0x46, 0x2e, 0xcb, 0xac, 0xfb, 0x91, 0xf9, 0x88, 0x32, 0x11, 0xea,
-		0xaf, 0xba, 0x63, 0xd6, 0x77, 0xe5, 0x37, 0xf2, 0x70, 0x63, 0x0f, 0x5d, 0xcf, 0xe8, 0x7e, 0xaf,
-		0xdf, 0x1b, 0xe3, 0xfc, 0xa6, 0x40, 0x88, 0x73, 0x28, 0xed, 0x3c, 0xa0, 0xfb, 0x24, 0x37, 0x87,
-		0xca, 0xde, 0x97, 0xa1, 0xbd, 0xee, 0xcb, 0x3c, 0xa0, 0x68, 0xaf, 0x19, 0xad, 0x50, 0xe4, 0x95,
-		0x71, 0x23, 0xa4, 0x84, 0x7e, 0x0a, 0x2b, 0xd8, 0xc5, 0x42, 0x68, 0x17, 0xe5, 0x3f, 0x49, 0x70,
-		0xad, 0xcf, 0x96, 0x6d, 0x0e, 0x06, 0x89, 0x83, 0xe1, 0x83, 0x55, 0x5c, 0xf9, 0x4b, 0x79, 0xb8,
-		0xd6, 0x67, 0x5b, 0xdd, 0xff, 0xaa, 0xad, 0x46, 0x3c, 0xf6, 0x10, 0xdf, 0x63, 0x0f, 0x8b, 0x7b,
-		0x6c, 0xae, 0xea, 0xf0, 0x1c, 0x40, 0x91, 0xe7, 0x00, 0x5e, 0xcd, 0xc3, 0x95, 0x7e, 0x5a, 0x03,
-		0xc5, 0x2c, 0x5f, 0x88, 0xf3, 0x13, 0xcb, 0xef, 0x5a, 0xfe, 0x7b, 0x12, 0x5c, 0xcc, 0xda, 0xe6,
-		0xf8, 0x5f, 0x6d, 0xf2, 0xfc, 0xb3, 0x4a, 0xfe, 0x9d, 0x04, 0x17, 0x32, 0xb5, 0x46, 0x0e, 0xcc,
-		0x05, 0x30, 0x6f, 0x0d, 0xb9, 0xbd, 0xdd, 0x1a, 0xfe, 0x32, 0x02, 0x97, 0xfb, 0xf8, 0x8d, 0x47,
-		0xcf, 0x76, 0x48, 0xa1, 0xed, 0x38, 0x09, 0xe5, 0xce, 0x76, 0x50, 0x9d, 0x2f, 0x29, 0x10, 0x0c,
-		0xb1, 0x52, 0x08, 0xf9, 0x01, 0xa4, 0x10, 0xfa, 0xad, 0x27, 0x0e, 0x0f, 0x36, 0x85, 0x50, 0x78,
-		0xac, 0x29, 0x84, 0x62, 0xdf, 0x29, 0x84, 0x07, 0x40, 0x3b, 0x54, 0x29, 0x47, 0x5a, 0x86, 0xf3,
-		0x9b, 0x04, 0xce, 0x26, 0xb4, 0xb9, 0x12, 0x2e, 0xb4, 0x18, 0x37, 0xd1, 0x8c, 0x0e, 0xf5, 0x1a,
-		0x49, 0x29, 0xec, 0xcf, 0x45, 0x54, 0x1e, 0x04, 0x54, 0x5e, 0x83, 0xa9, 0x1e, 0x75, 0xaa, 0xd9,
-		0xb8, 0xd5, 0x85, 0x5f, 0x26, 0xf0, 0xe7, 0x12, 0x15, 0xa7, 0xaa, 0x2b, 0xde, 0x14, 0xba, 0x84,
-		0x43, 0x6d, 0xd6, 0x70, 0xac, 0x3c, 0xb9, 0xaf, 0x9f, 0xf2, 0x64, 0xac, 0xd7, 0x70, 0x8c, 0xd1,
-		0x6b, 0xd8, 0xbd, 0x69, 0xed, 0xcf, 0x9e, 0x5b, 0x18, 0xdf, 0x43, 0x6e, 0x61, 0x62, 0x6f, 0x6d,
-		0x84, 0xcf, 0x41, 0x59, 0xc7, 0x75, 0x75, 0xd7, 0x57, 0xcd, 0xf4, 0x9e, 0x48, 0x20, 0xd4, 0x44,
-		0x15, 0xe5, 0xd7, 0xf3, 0x70, 0x31, 0xeb, 0x6f, 0xb0, 0x3e, 0x7c, 0xf7, 0xb2, 0x16, 0xc4, 0x09,
-		0x7e, 0xa5, 0xeb, 0x6a, 0xe6, 0x1f, 0x10, 0x85, 0xc2, 0x83, 0x1e, 0x43, 0x19, 0x0e, 0x1b, 0x0a,
-		0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe6, 0x60, 0x3e, 0xcb, 0x0f,
-		0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9, 0x0e,
-		0x71, 0xa4, 0xdb, 0xb5, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0xfb, 0x39, 0xc8, 0xf8, 0xd3, 0xb7, 0x8f,
-		0x86, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xdb, 0x8f, 0x50, 0x10, 0xef, 0x47, 0x90,
-		0xff, 0x99, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x51, 0xa1, 0xf7, 0x64, 0xdc, 0x0b, 0x19, 0x32,
-		0xee, 0xf2, 0xbf, 0x72, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0xf8, 0x20, 0xa5,
-		0x58, 0xc8, 0x92, 0x67, 0xfe, 0x7c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27, 0xb6,
-		0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x45, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e, 0x84,
-		0xf6, 0x61, 0xce, 0x85, 0xfd, 0xe4, 0xcf, 0x55, 0xa3, 0xee, 0x62, 0x9b, 0x7c, 0xea, 0x38, 0x4c,
-		0xaf, 0x3c, 0x58, 0xb9, 0xbd, 0x5e, 0x5b, 0xad, 0xae, 0xad, 0xaf, 0x28, 0xb5, 0xf5, 0x4f, 0xde,
-		0x5d, 0xa9, 0x55, 0x6f, 0x3f, 0x58, 0x5a, 0xab, 0xde, 0x1a, 0x7f, 0x0a, 0x9d, 0x84, 0xa3, 0xf1,
-		0xd7, 0x4b, 0x6b, 0x6b, 0x35, 0x32, 0x3a, 0x2e, 0xa1, 0x53, 0x70, 0x3c, 0x4e, 0xb0, 0xbc, 0x76,
-		0xe7, 0xde, 0x0a, 0x25, 0xc9, 0xdd, 0x7c, 0x00, 0x87, 0x35, 0xab, 0xc1, 0x92, 0xc1, 0xcd, 0x91,
-		0xa5, 0xa6, 0x71, 0xd7, 0x0b, 0x61, 0xef, 0x4a, 0x9f, 0x5a, 0x78, 0x68, 0xb8, 0x5b, 0xad, 0x8d,
-		0x8a, 0x66, 0x35, 0x16, 0x42, 0xff, 0xe7, 0xb4, 0xf2, 0x10, 0x9b, 0xfe, 0x7f, 0x56, 0xa5, 0xff,
-		0xf2, 0xf4, 0x86, 0xda, 0x34, 0x76, 0x2e, 0x6d, 0x14, 0xc8, 0xd8, 0xe5, 0xff, 0x04, 0x00, 0x00,
-		0xff, 0xff, 0xee, 0x07, 0x38, 0xbd, 0xd5, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x51, 0x6c, 0x1b, 0x5d,
+		0x56, 0xfe, 0xc7, 0x4e, 0x9c, 0xf8, 0x38, 0x4d, 0x9d, 0xdb, 0x36, 0x4d, 0xd2, 0xb4, 0x49, 0xa6,
+		0x69, 0x9a, 0xa6, 0x89, 0xd3, 0xa6, 0xdd, 0xbf, 0xdb, 0xbf, 0xbb, 0xff, 0xbf, 0xae, 0x3d, 0xa1,
+		0xd6, 0x9f, 0x26, 0x61, 0xe2, 0xb4, 0xff, 0x22, 0x24, 0x33, 0xb1, 0x27, 0xed, 0xa8, 0xb6, 0xc7,
+		0x3b, 0x33, 0x8e, 0x1b, 0x24, 0x9e, 0x78, 0x40, 0x42, 0xbb, 0x82, 0xd5, 0x0a, 0x89, 0x15, 0x20,
+		0x10, 0x12, 0x68, 0x17, 0x90, 0x16, 0x81, 0x10, 0x20, 0x5e, 0x00, 0x09, 0x81, 0x04, 0x5a, 0x78,
+		0xe2, 0x85, 0x07, 0x78, 0xe0, 0x81, 0x7d, 0xe3, 0x81, 0xe5, 0x0d, 0x09, 0xcd, 0x9d, 0x3b, 0x9e,
+		0xf1, 0xcc, 0xbd, 0x33, 0x77, 0x9c, 0x74, 0x17, 0xf4, 0xf7, 0x2d, 0x33, 0x73, 0xce, 0xb9, 0xdf,
+		0xb9, 0xf7, 0x9c, 0x73, 0xcf, 0xbd, 0xe7, 0x38, 0xb0, 0xd4, 0x3d, 0x52, 0x8d, 0xcd, 0xba, 0xd2,
+		0x50, 0xdb, 0x75, 0x75, 0x53, 0xe9, 0x68, 0x9b, 0x27, 0xf7, 0x37, 0x5f, 0x6b, 0xa6, 0xa5, 0x1b,
+		0xa7, 0x85, 0x8e, 0xa1, 0x5b, 0x3a, 0xba, 0x64, 0x93, 0x14, 0x08, 0x49, 0x41, 0xe9, 0x68, 0x85,
+		0x93, 0xfb, 0x73, 0x37, 0x5e, 0xe9, 0xfa, 0xab, 0xa6, 0xba, 0x89, 0x49, 0x8e, 0xba, 0xc7, 0x9b,
+		0x8d, 0xae, 0xa1, 0x58, 0x9a, 0xde, 0x76, 0x98, 0xe6, 0x16, 0x82, 0xdf, 0x2d, 0xad, 0xa5, 0x9a,
+		0x96, 0xd2, 0xea, 0x10, 0x82, 0x45, 0xda, 0xc0, 0x75, 0xbd, 0xd5, 0xea, 0x8b, 0x10, 0x69, 0x14,
+		0x96, 0x62, 0xbe, 0x69, 0x6a, 0xa6, 0x15, 0x45, 0xd3, 0xd3, 0x8d, 0x37, 0xc7, 0x4d, 0xbd, 0xe7,
+		0xd0, 0x88, 0x65, 0x18, 0x7b, 0xe6, 0x28, 0x84, 0x1e, 0x43, 0x46, 0x3d, 0x51, 0xdb, 0x96, 0x39,
+		0x23, 0x2c, 0xa6, 0x57, 0x73, 0x5b, 0x4b, 0x05, 0x8a, 0x6e, 0x05, 0x42, 0x2d, 0xd9, 0x94, 0x32,
+		0x61, 0x10, 0x7f, 0xf0, 0x08, 0x26, 0xfc, 0x1f, 0xd0, 0x2c, 0x8c, 0xe3, 0x4f, 0x35, 0xad, 0x31,
+		0x23, 0x2c, 0x0a, 0xab, 0x69, 0x79, 0x0c, 0x3f, 0x57, 0x1a, 0xe8, 0x31, 0x80, 0xf3, 0xc9, 0x56,
+		0x7a, 0x26, 0xb5, 0x28, 0xac, 0xe6, 0xb6, 0xe6, 0x0a, 0xce, 0x8c, 0x14, 0xdc, 0x19, 0x29, 0x54,
+		0xdd, 0x19, 0x91, 0xb3, 0x98, 0xda, 0x7e, 0x46, 0x33, 0x30, 0x76, 0xa2, 0x1a, 0xa6, 0xa6, 0xb7,
+		0x67, 0xd2, 0x8e, 0x50, 0xf2, 0x88, 0xae, 0xc2, 0x98, 0xad, 0xbc, 0x3d, 0xdc, 0x08, 0xfe, 0x92,
+		0xb1, 0x1f, 0x2b, 0x0d, 0xf4, 0xeb, 0x02, 0xdc, 0x75, 0x55, 0xae, 0xa9, 0x6f, 0xd5, 0x7a, 0xd7,
+		0x5e, 0x87, 0x9a, 0x69, 0x29, 0x86, 0xa5, 0x36, 0x6a, 0x0e, 0x12, 0xc5, 0xb2, 0x0c, 0xed, 0xa8,
+		0x6b, 0xa9, 0xe6, 0xcc, 0x28, 0xc6, 0xf3, 0x25, 0xaa, 0xea, 0x2f, 0x89, 0x1c, 0xc9, 0x15, 0x73,
+		0xe0, 0x48, 0xc1, 0x2a, 0x17, 0xfb, 0x32, 0x9e, 0x7d, 0x20, 0xdf, 0xee, 0xf1, 0x91, 0xa2, 0xdf,
+		0x16, 0x60, 0x83, 0x02, 0xaf, 0xae, 0xb7, 0x3a, 0x4d, 0x95, 0x0a, 0x30, 0x83, 0x01, 0x7e, 0xcc,
+		0x07, 0xb0, 0xe4, 0xca, 0x09, 0x43, 0xbc, 0xd3, 0xe3, 0x25, 0x46, 0xdf, 0x16, 0x60, 0x8d, 0x02,
+		0xf2, 0x58, 0xd1, 0x9a, 0x34, 0x84, 0x63, 0x18, 0xe1, 0x13, 0x3e, 0x84, 0xdb, 0x58, 0x48, 0x18,
+		0xde, 0x4a, 0x8f, 0x8b, 0x12, 0xfd, 0x16, 0x7d, 0x02, 0x6d, 0xdb, 0x6a, 0xd4, 0xf4, 0xae, 0x15,
+		0x86, 0x37, 0x8e, 0xe1, 0x7d, 0x99, 0x0f, 0x9e, 0x6d, 0x76, 0x8d, 0xbd, 0xae, 0x15, 0x06, 0xb8,
+		0xda, 0xe3, 0xa4, 0x45, 0xdf, 0x12, 0x60, 0xb5, 0xa1, 0xd6, 0x35, 0x13, 0x03, 0xb3, 0xad, 0xd4,
+		0xac, 0xbf, 0x56, 0x1b, 0x5d, 0xea, 0xe4, 0x65, 0x31, 0xba, 0xc7, 0x54, 0x74, 0x65, 0x22, 0xa4,
+		0xaa, 0x98, 0x6f, 0x0e, 0x5c, 0x11, 0x61, 0x64, 0xcb, 0x0d, 0x0e, 0x3a, 0xf4, 0x0d, 0x01, 0x56,
+		0x02, 0xa8, 0x58, 0x3e, 0x01, 0x18, 0xd3, 0xa3, 0x78, 0x4c, 0x2c, 0x77, 0x10, 0x1b, 0xb1, 0x54,
+		0x94, 0x59, 0x8a, 0x70, 0x82, 0x1c, 0xe7, 0x2c, 0x45, 0xd8, 0xff, 0x72, 0x83, 0x83, 0x0e, 0x7d,
+		0x33, 0x84, 0x2a, 0xc2, 0xb2, 0x26, 0x30, 0xaa, 0x2f, 0xc6, 0xa2, 0x62, 0x1b, 0xd5, 0xcd, 0x46,
+		0x3c, 0x19, 0xfa, 0x45, 0x01, 0x6e, 0x0d, 0x62, 0x62, 0x79, 0xe2, 0x05, 0x0c, 0xe8, 0xc3, 0x58,
+		0x40, 0x2c, 0x27, 0x5c, 0x6a, 0xc4, 0x11, 0xe1, 0x65, 0x53, 0xea, 0x96, 0x76, 0xa2, 0x59, 0xa7,
+		0xb1, 0xc6, 0x3d, 0x19, 0xb1, 0x6c, 0x45, 0x22, 0x24, 0xce, 0xb8, 0x15, 0x0e, 0x3a, 0x6c, 0xdc,
+		0x01, 0x54, 0x2c, 0xe3, 0xbe, 0x18, 0x61, 0xdc, 0x03, 0x98, 0x98, 0xc6, 0xad, 0xc4, 0x52, 0x51,
+		0x66, 0x29, 0xc2, 0xb8, 0xf3, 0x9c, 0xb3, 0x14, 0x65, 0xdc, 0x0a, 0x07, 0x1d, 0x36, 0xa4, 0x41,
+		0x54, 0x2c, 0x43, 0x9a, 0x8a, 0x30, 0x24, 0x3f, 0x24, 0xa6, 0x21, 0x29, 0x71, 0x44, 0xd8, 0xd3,
+		0x06, 0xc1, 0x44, 0x78, 0x1a, 0x8a, 0xf0, 0x34, 0x3f, 0x9e, 0x08, 0x4f, 0x53, 0xe2, 0xc9, 0x50,
+		0x0f, 0x6e, 0xd8, 0x20, 0x0c, 0xb6, 0xf5, 0x5c, 0xc2, 0x40, 0xee, 0x51, 0x81, 0xd8, 0x52, 0x0d,
+		0xa6, 0xd9, 0x5c, 0xb3, 0xd8, 0x9f, 0xd1, 0xd7, 0x60, 0xde, 0x19, 0xf8, 0x58, 0x33, 0x68, 0xc3,
+		0x5e, 0xc6, 0xc3, 0x16, 0xd8, 0xc3, 0x6e, 0x6b, 0x46, 0x48, 0xea, 0xb3, 0x0f, 0xe4, 0x59, 0x8b,
+		0xf5, 0x11, 0xfd, 0xae, 0x00, 0x9b, 0x01, 0x13, 0x55, 0xda, 0x75, 0xb5, 0x59, 0x33, 0xd4, 0xaf,
+		0x75, 0x55, 0x93, 0xaa, 0xfd, 0x15, 0x0c, 0xe3, 0x93, 0x78, 0x4b, 0xc5, 0x92, 0x64, 0x57, 0x50,
+		0x18, 0xd7, 0x9a, 0xc2, 0x4d, 0x8d, 0xfe, 0x58, 0x80, 0x87, 0x04, 0x93, 0x0b, 0x91, 0xcf, 0x88,
+		0xa7, 0x31, 0xda, 0x12, 0x15, 0x2d, 0x19, 0xcd, 0x19, 0x9a, 0xc7, 0xa2, 0x0b, 0x46, 0x22, 0x0e,
+		0xf4, 0xcb, 0x02, 0xdc, 0xa6, 0x4d, 0x2f, 0x0d, 0xe8, 0x55, 0x4e, 0xeb, 0x2e, 0x11, 0x09, 0x31,
+		0xd6, 0xcd, 0x20, 0x43, 0x3f, 0x0b, 0x0b, 0x8e, 0x91, 0xb1, 0x91, 0xcc, 0x60, 0x24, 0xf7, 0xd9,
+		0x76, 0xc6, 0x86, 0x30, 0x6f, 0x45, 0x7c, 0x47, 0xbf, 0x20, 0xc0, 0x32, 0x59, 0x3c, 0x62, 0xe8,
+		0x8c, 0x45, 0x9b, 0xc5, 0x08, 0xbe, 0x40, 0x45, 0xe0, 0x08, 0x77, 0xec, 0x9d, 0xb1, 0x4c, 0x8b,
+		0xf5, 0x18, 0x1a, 0xf4, 0x73, 0xb0, 0xd8, 0x52, 0x8c, 0x37, 0xaa, 0x51, 0x33, 0xd4, 0xba, 0x6e,
+		0x34, 0x68, 0x20, 0xe6, 0x30, 0x88, 0x2d, 0x2a, 0x88, 0xe7, 0x98, 0x59, 0x26, 0xbc, 0x61, 0x04,
+		0xd7, 0x5b, 0x51, 0x04, 0xe8, 0x37, 0x05, 0x58, 0xa7, 0x9d, 0x4f, 0xb4, 0x57, 0x6d, 0x85, 0x3a,
+		0x21, 0xd7, 0x92, 0xa4, 0xaf, 0x07, 0x44, 0x0c, 0x4f, 0xfa, 0xca, 0xa0, 0x45, 0xbf, 0x23, 0x40,
+		0x81, 0x82, 0xd0, 0x52, 0x8d, 0x96, 0xd6, 0x56, 0xa8, 0x71, 0x61, 0x3e, 0x22, 0x2e, 0x84, 0x53,
+		0xec, 0xbe, 0x20, 0x4a, 0x5c, 0xe8, 0x71, 0x53, 0xa3, 0x3f, 0x11, 0xe0, 0x21, 0xed, 0x28, 0x15,
+		0x1b, 0xc5, 0xae, 0x63, 0xb4, 0x65, 0xce, 0x13, 0x55, 0x5c, 0x28, 0xdb, 0xec, 0x25, 0x63, 0x61,
+		0x59, 0x00, 0xdb, 0x29, 0x6f, 0x24, 0xb1, 0x00, 0xb6, 0x83, 0xae, 0xf6, 0x38, 0x69, 0xd1, 0xbf,
+		0x0b, 0x20, 0x05, 0x22, 0xae, 0xfa, 0xd6, 0x52, 0x8d, 0xb6, 0xd2, 0xac, 0x51, 0x90, 0x6b, 0x6d,
+		0xcd, 0xd2, 0xe8, 0x86, 0xb1, 0x80, 0xa1, 0x1f, 0xc4, 0x87, 0x60, 0x89, 0xc8, 0x0f, 0xe9, 0x53,
+		0x71, 0x85, 0x87, 0x15, 0xfa, 0xd8, 0x38, 0x93, 0x04, 0xf4, 0x2f, 0x02, 0x3c, 0x4d, 0xa0, 0x26,
+		0x2b, 0x62, 0x2d, 0x62, 0x1d, 0xf7, 0xcf, 0xa0, 0x23, 0x2b, 0x98, 0x3d, 0x31, 0x86, 0x67, 0x47,
+		0xdf, 0x17, 0xe0, 0xcb, 0x51, 0xea, 0xc4, 0xfb, 0xc9, 0x12, 0x56, 0x6c, 0x87, 0xaa, 0x18, 0x13,
+		0x4c, 0xac, 0xbf, 0x3c, 0x52, 0x87, 0x63, 0xc5, 0x79, 0x00, 0x4d, 0x0f, 0xbd, 0x6d, 0x69, 0xed,
+		0xae, 0xda, 0xa8, 0x29, 0x66, 0xad, 0xad, 0xf6, 0xc2, 0x7a, 0x88, 0x11, 0x79, 0x40, 0x18, 0x84,
+		0x2b, 0xae, 0x68, 0xee, 0xaa, 0xbd, 0x30, 0xfc, 0x42, 0x2f, 0x11, 0x07, 0xfa, 0x6b, 0x01, 0x1e,
+		0xe3, 0x6c, 0xb2, 0x56, 0x7f, 0xad, 0x35, 0x1b, 0x09, 0xfd, 0xe7, 0x26, 0x86, 0xfe, 0x8c, 0x0a,
+		0x1d, 0xa7, 0x92, 0x25, 0x5b, 0x68, 0x12, 0xa7, 0x79, 0x60, 0x26, 0x67, 0x43, 0x7f, 0x2e, 0xc0,
+		0x87, 0x31, 0x4a, 0xb0, 0xbc, 0x63, 0x19, 0x6b, 0x20, 0x25, 0xd5, 0x80, 0xe5, 0x12, 0xf7, 0xcc,
+		0x84, 0x3c, 0xe8, 0x0f, 0x04, 0xb8, 0xcf, 0x44, 0xcd, 0xcc, 0xf3, 0x6f, 0x61, 0xd8, 0x45, 0x7a,
+		0x1a, 0x42, 0x1d, 0x9d, 0x99, 0xf8, 0xaf, 0xd7, 0x13, 0xd0, 0xa3, 0x3f, 0x12, 0xe0, 0x01, 0x13,
+		0x6e, 0xc4, 0x21, 0x72, 0x25, 0xc2, 0xc8, 0xe9, 0x80, 0x23, 0x8e, 0x93, 0x85, 0x7a, 0x22, 0x0e,
+		0xf4, 0x5d, 0x01, 0xee, 0x25, 0xb6, 0x8c, 0xdb, 0x18, 0xf1, 0x57, 0x12, 0x20, 0x66, 0x19, 0xc5,
+		0xdd, 0x7a, 0x02, 0x7b, 0xf8, 0x9e, 0x00, 0x5b, 0xec, 0x09, 0x66, 0x6e, 0xc2, 0xab, 0x18, 0xed,
+		0xd3, 0x24, 0xf3, 0xcb, 0xdc, 0x89, 0x37, 0xea, 0x49, 0x18, 0xd0, 0x1f, 0x46, 0x99, 0x44, 0xc4,
+		0xa1, 0xf9, 0x4e, 0x62, 0xc8, 0xec, 0xe3, 0xf3, 0x46, 0x3d, 0x09, 0x03, 0xce, 0xcd, 0xd8, 0x90,
+		0x23, 0x32, 0xc9, 0xb5, 0x88, 0xdc, 0x8c, 0x81, 0x39, 0x22, 0x9d, 0xdc, 0xac, 0x27, 0x63, 0xc1,
+		0x9b, 0xa6, 0x93, 0x8a, 0x0f, 0x9b, 0xf1, 0xdc, 0x8d, 0xd8, 0x34, 0x9d, 0x8c, 0x7b, 0x98, 0x54,
+		0xe7, 0x91, 0x39, 0x1c, 0x2b, 0xfa, 0x1b, 0x01, 0x3e, 0xe2, 0x50, 0x88, 0xe5, 0xa3, 0xeb, 0x58,
+		0x9b, 0xca, 0x30, 0xda, 0xb0, 0x9c, 0xf5, 0xa1, 0x39, 0x04, 0x1f, 0xfa, 0x33, 0x01, 0xbe, 0x10,
+		0xa5, 0x00, 0xfb, 0xfc, 0xb4, 0x11, 0xb1, 0x01, 0x31, 0x41, 0xb0, 0xcf, 0x51, 0xf7, 0xd4, 0x84,
+		0x3c, 0x38, 0xe0, 0x74, 0x3b, 0xa6, 0x6a, 0x58, 0x1e, 0x70, 0x53, 0x55, 0x8c, 0xfa, 0x6b, 0x1f,
+		0xcc, 0x30, 0xee, 0x42, 0x84, 0xf7, 0x1e, 0x62, 0x71, 0x2e, 0x82, 0x03, 0x2c, 0xcc, 0x1b, 0x91,
+		0xe2, 0xbd, 0xdd, 0x24, 0x0c, 0x4f, 0x27, 0x00, 0x3c, 0x20, 0xe2, 0xbf, 0xe5, 0xe0, 0x36, 0xef,
+		0xee, 0xb5, 0x0d, 0x17, 0xfa, 0x3a, 0x5a, 0xa7, 0x1d, 0x15, 0xd7, 0x02, 0x59, 0x95, 0x45, 0x57,
+		0x68, 0xf5, 0xb4, 0xa3, 0xca, 0x13, 0x3d, 0xdf, 0x13, 0xfa, 0x69, 0xb8, 0xd2, 0x51, 0x0c, 0x7b,
+		0x46, 0xfc, 0x4e, 0x77, 0xac, 0x93, 0xf2, 0xe1, 0x2a, 0x55, 0xde, 0x3e, 0xe6, 0xf0, 0xf9, 0xc4,
+		0xb1, 0x2e, 0x5f, 0xea, 0x84, 0x5f, 0xa2, 0x8f, 0x20, 0x8b, 0x6f, 0x64, 0x9a, 0x9a, 0x69, 0xe1,
+		0xc2, 0x62, 0x6e, 0xeb, 0x3a, 0x55, 0xa2, 0x7d, 0x9b, 0xb2, 0xa3, 0x99, 0x96, 0x3c, 0x6e, 0x91,
+		0xbf, 0xd0, 0x16, 0x8c, 0x6a, 0xed, 0x4e, 0xd7, 0xc2, 0x65, 0xc7, 0xdc, 0xd6, 0x3c, 0x03, 0xc9,
+		0x69, 0x53, 0x57, 0x1a, 0xb2, 0x43, 0x8a, 0x14, 0x58, 0x0c, 0xa4, 0x1c, 0x35, 0x4b, 0xaf, 0xd5,
+		0x9b, 0xba, 0xa9, 0xe2, 0xf8, 0xad, 0x77, 0x2d, 0x52, 0x87, 0x9c, 0x0d, 0xd5, 0x45, 0xcb, 0xa4,
+		0x92, 0x2c, 0xcf, 0xab, 0x03, 0x73, 0x5f, 0xd5, 0x4b, 0x36, 0x7f, 0xd5, 0x61, 0x47, 0x2f, 0xe1,
+		0x9a, 0x77, 0xed, 0x1d, 0x96, 0x9e, 0x89, 0x93, 0x7e, 0xd5, 0x72, 0x2f, 0xb3, 0x03, 0x82, 0x9f,
+		0xc0, 0x9c, 0x97, 0x61, 0x7b, 0x5a, 0x18, 0xdd, 0xb6, 0x5d, 0x7b, 0xb5, 0x4b, 0x7f, 0x59, 0xf9,
+		0x6a, 0x9f, 0xa2, 0x3f, 0xcf, 0x72, 0xb7, 0x5d, 0x69, 0xa0, 0x0a, 0x64, 0x49, 0xa8, 0xd4, 0x0d,
+		0x5c, 0x87, 0x9b, 0xdc, 0xba, 0x4b, 0x0f, 0xed, 0x44, 0x00, 0x4e, 0xa1, 0x2b, 0x2e, 0x8b, 0xec,
+		0x71, 0xa3, 0x0a, 0x4c, 0x79, 0x38, 0xec, 0x70, 0xd5, 0x35, 0xd4, 0x99, 0x6c, 0xc4, 0x1a, 0x6c,
+		0x3b, 0x34, 0x72, 0xbe, 0xcf, 0x46, 0xde, 0x20, 0x19, 0xa6, 0x9b, 0x8a, 0x7d, 0xe6, 0x73, 0xd2,
+		0x19, 0xac, 0x8e, 0x6a, 0x76, 0x9b, 0xd6, 0x0c, 0x44, 0xc8, 0x73, 0xd7, 0xf4, 0xb2, 0xcd, 0x5b,
+		0xea, 0xb3, 0xca, 0x98, 0x13, 0x3d, 0x86, 0x59, 0xdd, 0xd0, 0x5e, 0x69, 0x4e, 0xa0, 0x0d, 0xcc,
+		0x52, 0x0e, 0xcf, 0xd2, 0xb4, 0x4b, 0x10, 0x98, 0xa4, 0x39, 0x18, 0xd7, 0x1a, 0x6a, 0xdb, 0xd2,
+		0xac, 0x53, 0x5c, 0x51, 0xca, 0xca, 0xfd, 0x67, 0xf4, 0x00, 0xa6, 0x8f, 0x35, 0xc3, 0xb4, 0xc2,
+		0x32, 0x2f, 0x60, 0xca, 0x4b, 0xf8, 0x6b, 0x40, 0x60, 0x09, 0x26, 0x0c, 0xd5, 0x32, 0x4e, 0x6b,
+		0x1d, 0xbd, 0xa9, 0xd5, 0x4f, 0x49, 0x15, 0x66, 0x91, 0x71, 0x40, 0xb5, 0x8c, 0xd3, 0x7d, 0x4c,
+		0x27, 0xe7, 0x0c, 0xef, 0xc1, 0x2e, 0xbd, 0x2b, 0x96, 0xa5, 0xb6, 0x3a, 0x16, 0xae, 0x98, 0x8c,
+		0xca, 0xee, 0x23, 0x2a, 0xc1, 0x45, 0xf5, 0x6d, 0x47, 0x73, 0x0c, 0xc7, 0x29, 0xea, 0xe7, 0x63,
+		0x8b, 0xfa, 0x93, 0x1e, 0x8b, 0xfd, 0x12, 0xdd, 0x84, 0x0b, 0x75, 0xc3, 0xf6, 0x06, 0x52, 0xd1,
+		0xc1, 0x15, 0x87, 0xac, 0x3c, 0x61, 0xbf, 0x74, 0xab, 0x3c, 0xe8, 0x33, 0xb8, 0xe6, 0x68, 0x3f,
+		0x58, 0xfd, 0x3a, 0x52, 0xea, 0x6f, 0xf4, 0xe3, 0xe3, 0x19, 0x14, 0x67, 0xd4, 0x33, 0x98, 0xdb,
+		0x5f, 0xf8, 0x7a, 0xea, 0xb0, 0xa2, 0x0d, 0x18, 0x69, 0xa9, 0x2d, 0x9d, 0x5c, 0xe7, 0xcf, 0xd2,
+		0x2f, 0xfa, 0xd4, 0x96, 0x2e, 0x63, 0x32, 0x24, 0xc3, 0x54, 0x28, 0x62, 0x93, 0x3b, 0xf9, 0x5b,
+		0xf4, 0xbd, 0x31, 0x10, 0x61, 0xe5, 0xbc, 0x19, 0x78, 0x83, 0x0e, 0x61, 0xba, 0x63, 0xa8, 0x27,
+		0x35, 0xa5, 0x6b, 0xe9, 0xb6, 0xfd, 0xa9, 0x56, 0xad, 0xa3, 0x6b, 0x6d, 0xcb, 0xbd, 0x65, 0x67,
+		0xad, 0x97, 0xa9, 0x5a, 0xfb, 0x98, 0x4e, 0xbe, 0x64, 0xf3, 0x17, 0xbb, 0x96, 0xee, 0x7b, 0x89,
+		0x1e, 0x40, 0xe6, 0xb5, 0xaa, 0x34, 0x54, 0x83, 0x5c, 0x7f, 0x5f, 0xa3, 0x37, 0x75, 0x60, 0x12,
+		0x99, 0x90, 0x8a, 0xdf, 0x15, 0xe0, 0x0e, 0x7f, 0xb6, 0xff, 0x10, 0x32, 0xc4, 0x5f, 0x04, 0x0e,
+		0x7f, 0x21, 0xb4, 0x68, 0x1b, 0x16, 0xa3, 0xcb, 0xbd, 0x5a, 0x03, 0x47, 0xf7, 0xb4, 0x3c, 0xcf,
+		0xae, 0xd4, 0x56, 0x1a, 0xe2, 0x77, 0x04, 0x58, 0xe1, 0x4c, 0x1a, 0x3e, 0x84, 0x31, 0x37, 0x52,
+		0x08, 0x1c, 0x91, 0xc2, 0x25, 0x3e, 0x37, 0xa8, 0x3a, 0xac, 0x72, 0x67, 0xcc, 0x25, 0x98, 0x20,
+		0xc1, 0xda, 0xdb, 0x38, 0x27, 0x19, 0x46, 0x40, 0x62, 0x33, 0xde, 0x37, 0x73, 0x96, 0xf7, 0x20,
+		0xfe, 0x83, 0x00, 0xcb, 0x3c, 0x4d, 0x03, 0x83, 0x3b, 0xa0, 0x90, 0x6c, 0x07, 0xdc, 0x85, 0x69,
+		0xc6, 0x2e, 0x93, 0x8a, 0x73, 0xc8, 0x4b, 0x26, 0x65, 0x87, 0xf1, 0x45, 0x9a, 0xf4, 0x40, 0xa4,
+		0x11, 0xbf, 0x21, 0x80, 0x18, 0xdf, 0x6f, 0x80, 0xd6, 0x01, 0x05, 0x6b, 0xd0, 0xfd, 0x2e, 0xa4,
+		0xbc, 0x39, 0x30, 0x05, 0x81, 0x70, 0x9b, 0x0a, 0x84, 0xdb, 0xeb, 0x00, 0xee, 0x85, 0xa0, 0xd6,
+		0xc0, 0x68, 0xb2, 0x72, 0x96, 0xbc, 0xa9, 0x34, 0xc4, 0xff, 0x0c, 0x4c, 0x2f, 0xd3, 0x43, 0x92,
+		0x21, 0x5a, 0x85, 0xfc, 0xe0, 0x3d, 0x44, 0xdf, 0xbc, 0x26, 0x4d, 0x9f, 0xc6, 0x01, 0xec, 0xe9,
+		0x00, 0xf6, 0xdb, 0x70, 0xf1, 0x48, 0x6b, 0x2b, 0xc6, 0x69, 0xad, 0xfe, 0x5a, 0xad, 0xbf, 0x31,
+		0xbb, 0x2d, 0x9c, 0xa2, 0x64, 0xe5, 0x49, 0xe7, 0x75, 0x89, 0xbc, 0x45, 0x77, 0x61, 0x6a, 0xf0,
+		0xf6, 0x4c, 0x7d, 0xeb, 0xa4, 0x1f, 0x13, 0x72, 0x5e, 0xf5, 0x5f, 0x6a, 0xa9, 0x6f, 0x2d, 0xf1,
+		0xeb, 0x69, 0xb8, 0xc9, 0xd1, 0xca, 0xf0, 0xce, 0x34, 0x0e, 0xba, 0x45, 0x7a, 0x08, 0xb7, 0x40,
+		0x37, 0x20, 0x77, 0xa4, 0x98, 0xaa, 0xbb, 0x75, 0x3a, 0xd3, 0x92, 0xb5, 0x5f, 0x39, 0x1b, 0xe6,
+		0x3c, 0x80, 0x7d, 0x71, 0x48, 0x3e, 0x8f, 0x3a, 0x13, 0xdb, 0x56, 0x7b, 0xce, 0xd7, 0x75, 0x40,
+		0xc7, 0xba, 0xf1, 0x86, 0x20, 0x75, 0xfb, 0xd1, 0x32, 0x8e, 0x6a, 0xf6, 0x17, 0x8c, 0xf5, 0x85,
+		0xf3, 0x1e, 0x4d, 0xdb, 0xc1, 0x51, 0x31, 0xf5, 0x36, 0xc9, 0x8d, 0xc8, 0x13, 0x2a, 0xc3, 0x68,
+		0x5d, 0xe9, 0x9a, 0x2a, 0x49, 0x83, 0x0a, 0xdc, 0x4d, 0x23, 0x25, 0x9b, 0x4b, 0x76, 0x98, 0xc5,
+		0xef, 0xa4, 0x61, 0x29, 0xb6, 0x91, 0xe3, 0x9d, 0x2d, 0xc6, 0x53, 0x57, 0x07, 0x67, 0x15, 0xd6,
+		0x39, 0xfb, 0x4c, 0xfc, 0x1a, 0xf8, 0x63, 0xf2, 0x48, 0x92, 0x98, 0xec, 0x37, 0xfd, 0xd1, 0x80,
+		0xe9, 0x07, 0xd6, 0x37, 0x13, 0xbd, 0xbe, 0x63, 0x5c, 0xeb, 0x3b, 0xce, 0x58, 0x5f, 0x8a, 0x9b,
+		0x65, 0x69, 0x6e, 0x26, 0xfe, 0x46, 0x06, 0x96, 0x79, 0x7a, 0x5c, 0xd0, 0x02, 0xe4, 0xfa, 0x85,
+		0x62, 0xb2, 0x4c, 0x59, 0x19, 0xdc, 0x57, 0x95, 0x86, 0x7d, 0xa8, 0xea, 0x13, 0x60, 0x27, 0x48,
+		0x45, 0x1c, 0xaa, 0xfa, 0x43, 0xe2, 0x43, 0x95, 0xe2, 0x7b, 0xb2, 0x4d, 0xb3, 0xa1, 0xb7, 0x14,
+		0xad, 0x4d, 0x62, 0x07, 0x79, 0x1a, 0xdc, 0x0c, 0x46, 0x86, 0x3c, 0x0e, 0x65, 0xf8, 0x8f, 0x43,
+		0x55, 0x98, 0x75, 0x8d, 0x30, 0xbc, 0x87, 0x8c, 0xc5, 0xed, 0x21, 0xd3, 0x2e, 0x6f, 0x60, 0x1b,
+		0x09, 0x48, 0x25, 0x5b, 0x14, 0x91, 0x3a, 0x9e, 0x40, 0xaa, 0x73, 0x0a, 0x22, 0x52, 0xd9, 0x9b,
+		0x5d, 0x76, 0xa8, 0xcd, 0x6e, 0x1b, 0xa6, 0x5e, 0xab, 0x8a, 0x61, 0x1d, 0xa9, 0x8a, 0x87, 0x0e,
+		0xe2, 0x44, 0xe5, 0xfb, 0x3c, 0x9e, 0x9c, 0xf8, 0x14, 0x25, 0x17, 0x9f, 0xa2, 0x84, 0xce, 0x0a,
+		0x13, 0xc3, 0x9c, 0x15, 0xbc, 0x9c, 0xf3, 0x02, 0x7f, 0xce, 0xf9, 0x1f, 0x02, 0x88, 0xf1, 0xfd,
+		0x56, 0x3f, 0xb2, 0xcd, 0xdd, 0x9f, 0x86, 0x8c, 0x0c, 0x1e, 0x78, 0x3e, 0x81, 0x09, 0x7c, 0x5e,
+		0x74, 0xe3, 0xd6, 0x28, 0x47, 0xdc, 0xca, 0xd9, 0x1c, 0xe4, 0x41, 0xfc, 0x27, 0x61, 0x30, 0x14,
+		0x9c, 0x73, 0x66, 0x4d, 0x9f, 0xa2, 0x54, 0x82, 0x70, 0x9f, 0x8e, 0xcd, 0x36, 0x46, 0x06, 0x27,
+		0x53, 0xfc, 0x47, 0x01, 0x96, 0xe2, 0x9b, 0x60, 0x86, 0x4d, 0xc0, 0x7f, 0x1c, 0x1a, 0xfd, 0x45,
+		0x0a, 0x6e, 0x72, 0xb4, 0x92, 0xd9, 0x3a, 0x35, 0x54, 0x4b, 0xd1, 0x9a, 0x26, 0xd7, 0x22, 0xb9,
+		0xc4, 0xef, 0x4c, 0xa7, 0x60, 0x86, 0x34, 0x32, 0x4c, 0x86, 0x74, 0x66, 0x13, 0xff, 0x15, 0x01,
+		0xd6, 0xf8, 0x3b, 0xc0, 0x78, 0xf6, 0xbc, 0xf3, 0x39, 0x82, 0x7d, 0x4f, 0x80, 0x84, 0xbd, 0x5e,
+		0xf1, 0xd8, 0x2e, 0xbb, 0x69, 0x90, 0x13, 0x61, 0x9c, 0x07, 0x2e, 0xc4, 0x69, 0x0e, 0xc4, 0xdf,
+		0x0e, 0xd8, 0x21, 0xab, 0x2a, 0x34, 0xac, 0x1d, 0x6e, 0xc3, 0x62, 0x53, 0xb1, 0x7c, 0x3d, 0x0f,
+		0xc1, 0x0e, 0x00, 0x6f, 0x66, 0x1d, 0x3a, 0xda, 0x52, 0x3a, 0x69, 0x13, 0xc5, 0x9e, 0xd3, 0x09,
+		0xec, 0x79, 0x24, 0xd6, 0x47, 0x03, 0x89, 0x9e, 0xf8, 0x7d, 0x01, 0xae, 0x45, 0x74, 0x59, 0xda,
+		0xbf, 0x42, 0x71, 0xba, 0xcb, 0xfa, 0xeb, 0x36, 0x86, 0x9f, 0x2b, 0x0d, 0xb4, 0x03, 0x57, 0xfa,
+		0x1b, 0xf9, 0xb1, 0x66, 0x24, 0x38, 0xb4, 0x22, 0xb2, 0x8f, 0xdb, 0x5d, 0x94, 0x49, 0xb6, 0x5f,
+		0x9e, 0xc5, 0xfe, 0x19, 0x98, 0x65, 0xb6, 0x6f, 0x46, 0x69, 0xc3, 0x9d, 0xb3, 0x8b, 0x7f, 0x2b,
+		0xc0, 0x7c, 0x54, 0xe7, 0xde, 0xb9, 0x8c, 0x72, 0x5e, 0xf3, 0x11, 0x19, 0xa0, 0xff, 0x54, 0x80,
+		0xc5, 0xb8, 0x0e, 0xc0, 0x28, 0x6d, 0xde, 0xa9, 0xdb, 0x46, 0x22, 0xff, 0x9f, 0x31, 0x48, 0xd8,
+		0x68, 0x82, 0x36, 0xe1, 0x32, 0xee, 0x65, 0x09, 0x5e, 0xfb, 0x3a, 0x3a, 0x4d, 0xb5, 0xd5, 0x5e,
+		0xe0, 0xd2, 0x37, 0x54, 0x79, 0x49, 0x0d, 0x57, 0x79, 0x79, 0x5f, 0x1b, 0xe1, 0xaf, 0x8d, 0xf0,
+		0xd8, 0xce, 0x18, 0x87, 0xed, 0xec, 0xc1, 0x34, 0xb9, 0xd3, 0x26, 0x18, 0xb5, 0xb6, 0xa5, 0x1a,
+		0x27, 0x4a, 0x33, 0xfe, 0xdc, 0x72, 0x99, 0x30, 0x62, 0x78, 0x15, 0xc2, 0x36, 0x58, 0x77, 0xc9,
+		0x9e, 0xa9, 0xee, 0xe2, 0x4b, 0xe1, 0x20, 0x49, 0x0a, 0xc7, 0x2e, 0xb2, 0xe4, 0x86, 0x2e, 0xb2,
+		0x78, 0xe7, 0x8c, 0x09, 0xee, 0x73, 0x46, 0xff, 0xaa, 0xff, 0xc2, 0x19, 0xae, 0xfa, 0x27, 0xcf,
+		0x74, 0xd5, 0x6f, 0xc7, 0xe0, 0xcd, 0xa4, 0xdd, 0x6e, 0xfd, 0x68, 0x25, 0xf8, 0xa3, 0x55, 0xd4,
+		0xf9, 0xe6, 0x08, 0xae, 0xf6, 0x2b, 0xe4, 0x81, 0xaa, 0xa9, 0xe3, 0xc7, 0x6b, 0x91, 0x35, 0xf0,
+		0xc1, 0xba, 0xe9, 0x15, 0x95, 0xf6, 0x5a, 0xfc, 0x3d, 0x01, 0x56, 0x19, 0x9a, 0xd0, 0x8a, 0xc1,
+		0xf1, 0xee, 0x21, 0x70, 0xb8, 0x87, 0x2f, 0xd3, 0x49, 0x25, 0xc8, 0x74, 0xc4, 0x1f, 0x0a, 0x70,
+		0x3d, 0xb2, 0x5b, 0xdb, 0x4e, 0xf5, 0x48, 0x2f, 0x78, 0x5b, 0x69, 0xb9, 0x53, 0x0d, 0xce, 0xab,
+		0x5d, 0xa5, 0xa5, 0x0e, 0x3b, 0xf4, 0xb9, 0xed, 0x2a, 0x9e, 0xc5, 0x8f, 0xf0, 0x9f, 0xac, 0x7f,
+		0x8d, 0xb6, 0x48, 0xac, 0xee, 0x84, 0x05, 0xc8, 0x91, 0xfe, 0x10, 0xff, 0x14, 0x38, 0xaf, 0xf0,
+		0x14, 0xf4, 0x83, 0x7a, 0x8a, 0x3f, 0xa8, 0x47, 0xdc, 0x53, 0x8b, 0xbf, 0x2a, 0xc0, 0x5a, 0x82,
+		0x8e, 0x1c, 0xef, 0x3e, 0x55, 0x18, 0xb8, 0x4f, 0x1d, 0x76, 0x65, 0xa2, 0xa0, 0xfd, 0x55, 0x0a,
+		0x3e, 0x3e, 0x5b, 0x57, 0xf2, 0xb9, 0xd9, 0xbc, 0x77, 0x57, 0x97, 0x1a, 0xb8, 0xab, 0x3b, 0x04,
+		0x14, 0xee, 0x7e, 0x21, 0xfe, 0xbd, 0xc2, 0xd7, 0xe1, 0x2a, 0x4f, 0x85, 0x5a, 0x58, 0xed, 0xcb,
+		0x0f, 0xbb, 0x12, 0x60, 0xe8, 0x4d, 0x6c, 0x68, 0x13, 0xb2, 0xfb, 0x88, 0x0a, 0x70, 0x29, 0xd0,
+		0xc8, 0xa5, 0xb7, 0x9b, 0x4e, 0x66, 0x3e, 0x2e, 0x4f, 0x0d, 0xf4, 0x57, 0xed, 0xb5, 0x9b, 0xa7,
+		0xe2, 0xb7, 0xd2, 0xf0, 0xe4, 0x0c, 0x5d, 0xcf, 0xe8, 0xd0, 0x1f, 0xf7, 0x26, 0x19, 0xbf, 0x29,
+		0xe0, 0x92, 0x3c, 0x70, 0xed, 0x7c, 0x4e, 0xe7, 0x49, 0xe6, 0x1d, 0x2a, 0x7d, 0x5d, 0x46, 0xce,
+		0xba, 0x2e, 0xeb, 0x80, 0x82, 0xbd, 0x66, 0xa4, 0x42, 0x91, 0x96, 0xf3, 0xda, 0x80, 0x11, 0x3a,
+		0x57, 0x58, 0xee, 0x2a, 0x66, 0x06, 0x56, 0x51, 0xfc, 0x67, 0x01, 0x1e, 0x0d, 0xd9, 0xb2, 0xcd,
+		0xc0, 0x20, 0x30, 0x30, 0xfc, 0x68, 0x0d, 0x57, 0xfc, 0xa5, 0x34, 0x3c, 0x1a, 0xb2, 0xad, 0xee,
+		0xff, 0xab, 0xaf, 0x06, 0x22, 0xf6, 0x08, 0x3b, 0x62, 0x8f, 0xf2, 0x47, 0x6c, 0xa6, 0xe9, 0xb0,
+		0x02, 0xc0, 0x18, 0x2b, 0x00, 0x7c, 0x3d, 0x0d, 0x0f, 0x87, 0x69, 0x0d, 0xe4, 0xf3, 0x7c, 0x2e,
+		0xc9, 0xef, 0x3d, 0xdf, 0xf3, 0xfc, 0x1f, 0x08, 0x70, 0x2f, 0x69, 0x9b, 0xe3, 0xff, 0x69, 0x97,
+		0x67, 0xef, 0x55, 0xe2, 0xdf, 0x0b, 0xb0, 0x91, 0xa8, 0x35, 0xf2, 0xdc, 0x42, 0x00, 0xf5, 0xd4,
+		0x90, 0x3a, 0xdb, 0xa9, 0xe1, 0x5f, 0xc7, 0xe1, 0xc1, 0x10, 0xbf, 0xf1, 0xf0, 0x2d, 0x87, 0x30,
+		0xb0, 0x1c, 0x0b, 0x90, 0xeb, 0x2f, 0x07, 0xb1, 0xf9, 0xac, 0x0c, 0xee, 0x2b, 0xda, 0x15, 0x42,
+		0xfa, 0x1c, 0xae, 0x10, 0x86, 0xad, 0x27, 0x8e, 0x9e, 0xef, 0x15, 0x42, 0xe6, 0x9d, 0x5e, 0x21,
+		0x8c, 0x0d, 0x7d, 0x85, 0xf0, 0x02, 0x48, 0x87, 0x2a, 0x91, 0x48, 0xca, 0x70, 0x4e, 0x93, 0xc0,
+		0x4a, 0x44, 0x9b, 0x2b, 0x96, 0x42, 0x8a, 0x71, 0x53, 0x9d, 0xe0, 0x2b, 0xbf, 0x93, 0x64, 0x07,
+		0xe3, 0x39, 0x8f, 0xc9, 0x03, 0x87, 0xc9, 0xd7, 0x61, 0xc6, 0x67, 0x4e, 0x35, 0x43, 0xed, 0x7a,
+		0xf0, 0x73, 0x18, 0xfe, 0x5a, 0xa4, 0xe1, 0x54, 0x1a, 0xb2, 0xda, 0x75, 0xf1, 0xca, 0x57, 0x7a,
+		0xb4, 0xd7, 0xa1, 0xf2, 0xe4, 0x85, 0x61, 0xca, 0x93, 0xa1, 0x5e, 0xc3, 0x49, 0x4a, 0xaf, 0xa1,
+		0x77, 0xd2, 0xba, 0x98, 0xfc, 0x6e, 0x21, 0x7f, 0x86, 0xbb, 0x85, 0xa9, 0xb3, 0xb5, 0x11, 0x7e,
+		0x04, 0xb9, 0x86, 0xda, 0x54, 0x4e, 0x1d, 0xd3, 0x8c, 0xef, 0x89, 0x04, 0x4c, 0x8d, 0x4d, 0x51,
+		0xfc, 0x66, 0x1a, 0xee, 0x25, 0xfd, 0x0d, 0xd6, 0x8f, 0x3f, 0xbc, 0xec, 0xb8, 0x79, 0x82, 0x53,
+		0xe9, 0xfa, 0x30, 0xf1, 0x0f, 0x88, 0x06, 0xd2, 0x03, 0x9f, 0xa3, 0x8c, 0x0e, 0x3a, 0x0a, 0x7d,
+		0x13, 0xcc, 0x30, 0x36, 0xc1, 0x73, 0xba, 0x0b, 0x14, 0xff, 0x2e, 0x05, 0xeb, 0x49, 0x7e, 0x60,
+		0xc6, 0x5c, 0x0f, 0xfa, 0xee, 0x9b, 0x3a, 0xeb, 0xee, 0x7b, 0x5e, 0xab, 0x48, 0x9f, 0xdd, 0x11,
+		0xc6, 0xec, 0x7a, 0xde, 0x39, 0xca, 0x7f, 0x0f, 0xf2, 0xc3, 0x14, 0x24, 0xfc, 0xe9, 0xdb, 0xe7,
+		0x63, 0x32, 0x69, 0x65, 0x9d, 0x51, 0x6a, 0x59, 0xc7, 0xeb, 0x47, 0xc8, 0xf0, 0xf7, 0x23, 0x88,
+		0xff, 0x95, 0x82, 0xbb, 0xe7, 0x11, 0x51, 0x3e, 0xa7, 0x93, 0xee, 0xbb, 0x71, 0xcf, 0x24, 0xb8,
+		0x71, 0x17, 0xff, 0x3b, 0x05, 0x1b, 0x89, 0x7e, 0x89, 0xf8, 0x7e, 0xe2, 0x43, 0x13, 0xef, 0x5e,
+		0x29, 0x66, 0x92, 0xdc, 0x33, 0xff, 0x7c, 0x9a, 0x35, 0xf1, 0xac, 0x1e, 0x92, 0xf7, 0x13, 0x1f,
+		0xd9, 0xc2, 0x92, 0x19, 0xa6, 0xf7, 0xfd, 0x2f, 0x53, 0xb0, 0x99, 0xf0, 0x17, 0xa2, 0xef, 0xd7,
+		0x61, 0x60, 0x1d, 0xd6, 0x2c, 0xb8, 0x88, 0xff, 0xdc, 0xd6, 0x9a, 0x96, 0x6a, 0xe0, 0xa1, 0xae,
+		0xc3, 0xac, 0xf4, 0x42, 0xda, 0xad, 0xd6, 0xb6, 0x2b, 0x3b, 0x55, 0x49, 0xae, 0x55, 0xbf, 0xba,
+		0x2f, 0xd5, 0x2a, 0xbb, 0x2f, 0x8a, 0x3b, 0x95, 0x72, 0xfe, 0x03, 0xb4, 0x00, 0xd7, 0xc2, 0x9f,
+		0x8b, 0x3b, 0x3b, 0x35, 0xfc, 0x36, 0x2f, 0xa0, 0x25, 0xb8, 0x1e, 0x26, 0x28, 0xed, 0xec, 0x1d,
+		0x48, 0x84, 0x24, 0xb5, 0xf6, 0xfb, 0x17, 0x21, 0x8b, 0x87, 0x25, 0x4d, 0xaa, 0xc8, 0x61, 0x08,
+		0x8c, 0x74, 0x07, 0x6e, 0xf9, 0xde, 0xbf, 0xdc, 0x93, 0x3f, 0xdd, 0xde, 0xd9, 0x7b, 0x59, 0x93,
+		0x3e, 0x93, 0x4a, 0x87, 0xd5, 0xca, 0xde, 0x6e, 0xed, 0xa0, 0x5a, 0x94, 0xab, 0x52, 0x39, 0x2f,
+		0xa0, 0xbb, 0x70, 0x3b, 0x9a, 0xb4, 0xb4, 0xf7, 0x7c, 0x7f, 0x47, 0xb2, 0x89, 0x53, 0x68, 0x15,
+		0x96, 0xa3, 0x89, 0xb7, 0x8b, 0x95, 0x1d, 0xa9, 0x9c, 0x4f, 0xc7, 0x8b, 0xad, 0x56, 0x9e, 0x4b,
+		0xe5, 0xda, 0xde, 0x61, 0x35, 0x3f, 0x82, 0x56, 0x40, 0xf4, 0x11, 0x97, 0xa5, 0x52, 0xe5, 0x00,
+		0x93, 0x14, 0x0f, 0x3e, 0xad, 0x1d, 0x94, 0x9e, 0x49, 0xe5, 0x43, 0x5b, 0xe8, 0x28, 0x5a, 0x86,
+		0x45, 0x36, 0x1d, 0xd1, 0x28, 0x13, 0x29, 0xcd, 0x53, 0x66, 0x2c, 0x92, 0xce, 0x43, 0x37, 0x8e,
+		0x6e, 0xc2, 0x02, 0x93, 0x8e, 0xe8, 0x9b, 0x0d, 0x08, 0x2b, 0x96, 0xaa, 0x95, 0x17, 0x95, 0xea,
+		0x57, 0x83, 0x2a, 0x40, 0x40, 0x85, 0x00, 0x1d, 0x51, 0x21, 0x17, 0x29, 0xcd, 0x53, 0x61, 0x22,
+		0x00, 0x6d, 0x90, 0x8e, 0x40, 0xbb, 0x10, 0x29, 0xcc, 0xd3, 0x73, 0x12, 0x6d, 0xc0, 0x1d, 0xf6,
+		0xa0, 0xc5, 0xdd, 0x92, 0xb4, 0x53, 0x93, 0xa5, 0x9f, 0x3c, 0x94, 0x0e, 0xec, 0xb1, 0x2f, 0xa2,
+		0x2d, 0x28, 0xf8, 0xc8, 0xc9, 0x17, 0x97, 0x90, 0x0a, 0x25, 0x8f, 0x6e, 0xc1, 0x52, 0xcc, 0x10,
+		0x52, 0x39, 0x3f, 0x85, 0xe6, 0x61, 0xc6, 0x47, 0x66, 0x63, 0x94, 0xfb, 0x93, 0x83, 0xd0, 0x1c,
+		0x4c, 0x87, 0xbe, 0x6e, 0x57, 0x64, 0xa9, 0x9c, 0xbf, 0x84, 0x44, 0xb8, 0xe1, 0xfb, 0x46, 0xc0,
+		0x10, 0x12, 0x07, 0xc4, 0x65, 0xcf, 0x4b, 0x7d, 0xfc, 0xfd, 0xc1, 0xaf, 0x04, 0xf4, 0xa2, 0x39,
+		0x44, 0x70, 0x2e, 0xa6, 0xd1, 0x1a, 0xac, 0xf0, 0xf0, 0x48, 0xe5, 0xfc, 0x55, 0x54, 0x82, 0x4f,
+		0xd8, 0xf3, 0x26, 0x7d, 0x56, 0x95, 0xe4, 0xdd, 0xe2, 0x0e, 0x4d, 0x46, 0x65, 0xb7, 0x52, 0xad,
+		0x14, 0xed, 0x01, 0x67, 0xd0, 0x57, 0xe0, 0x4b, 0xc3, 0x09, 0x21, 0xb3, 0x30, 0x8b, 0x9e, 0xc0,
+		0x23, 0x9f, 0x84, 0x28, 0x96, 0x90, 0xbe, 0x73, 0xe8, 0x06, 0xcc, 0xf9, 0x98, 0x9f, 0x17, 0xe5,
+		0x4f, 0x25, 0xb9, 0x26, 0x4b, 0xa5, 0x3d, 0xb9, 0x2c, 0x95, 0xf3, 0xd7, 0xe2, 0xe7, 0xe3, 0xa0,
+		0xf2, 0x13, 0xbb, 0x45, 0x1b, 0xc8, 0x3c, 0x5a, 0x87, 0xd5, 0x98, 0x48, 0x21, 0xc9, 0xcf, 0x2b,
+		0xbb, 0x58, 0xf1, 0xeb, 0x1c, 0xab, 0xb3, 0xb7, 0x5b, 0xad, 0xec, 0x1e, 0x4a, 0xe5, 0x5a, 0xf1,
+		0xa0, 0xb6, 0x2b, 0xbd, 0xcc, 0xdf, 0x40, 0x8f, 0xe0, 0x81, 0x8f, 0x07, 0x1b, 0x52, 0xad, 0xf4,
+		0xac, 0xb2, 0x53, 0x8e, 0x9e, 0xe5, 0x05, 0xf4, 0x10, 0xee, 0xf1, 0x33, 0x92, 0x99, 0x5d, 0x44,
+		0x9b, 0x70, 0xd7, 0x6f, 0x83, 0x2c, 0x7a, 0xd7, 0xa0, 0x97, 0xd0, 0x7d, 0xd8, 0xe0, 0x61, 0xf0,
+		0x1c, 0x5f, 0x44, 0x05, 0x58, 0xe3, 0x61, 0x21, 0x98, 0x6e, 0xa2, 0x7b, 0xb0, 0xce, 0x35, 0x84,
+		0x6b, 0xa6, 0xcb, 0xbc, 0xa0, 0xbc, 0x00, 0x72, 0x2b, 0xb0, 0x36, 0x6c, 0x16, 0x6f, 0x3d, 0x57,
+		0x02, 0x66, 0xe8, 0x98, 0x05, 0xa7, 0x17, 0xdc, 0x46, 0x5f, 0x84, 0x87, 0xc9, 0x98, 0xc9, 0x7c,
+		0xac, 0xa2, 0x07, 0xb0, 0xc9, 0x69, 0xfd, 0x7d, 0x4b, 0xbd, 0x13, 0x98, 0xc4, 0xc3, 0xfd, 0x03,
+		0x49, 0xae, 0x7a, 0x2c, 0x07, 0x52, 0x51, 0x2e, 0x3d, 0xab, 0x15, 0xab, 0x55, 0xb9, 0xf2, 0xf4,
+		0xb0, 0x2a, 0x1d, 0xe4, 0xd7, 0x9e, 0xbe, 0x80, 0xab, 0x75, 0xbd, 0x45, 0xcb, 0x58, 0x9e, 0x8e,
+		0x17, 0x3b, 0xda, 0xbe, 0x7d, 0xe1, 0xb4, 0x2f, 0xfc, 0xd4, 0xe6, 0x2b, 0xcd, 0x7a, 0xdd, 0x3d,
+		0x2a, 0xd4, 0xf5, 0xd6, 0xe6, 0xc0, 0x7f, 0x25, 0x2f, 0xbc, 0x52, 0xdb, 0xce, 0xff, 0x41, 0x27,
+		0xff, 0xa0, 0xfc, 0x89, 0xd2, 0xd1, 0x4e, 0xee, 0x1f, 0x65, 0xf0, 0xbb, 0x07, 0xff, 0x3b, 0x00,
+		0xd2, 0x8b, 0x0a, 0x75, 0x83, 0x5d, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -410,148 +442,151 @@ var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0xdb, 0xc8,
+		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd4, 0xd4, 0x07, 0x34, 0xb2, 0x56, 0xb4, 0xbd, 0xb6, 0x65, 0x7a,
+		0x6d, 0xcb, 0x7c, 0xd7, 0xd4, 0xca, 0x5e, 0xaf, 0xe3, 0x75, 0x1c, 0x07, 0x02, 0x20, 0x0b, 0x36,
+		0x05, 0x32, 0x03, 0xd0, 0xb2, 0xb6, 0x52, 0x41, 0x41, 0xe4, 0x48, 0x44, 0x99, 0x04, 0x58, 0xc0,
+		0xd0, 0xb6, 0xee, 0xa9, 0xca, 0x39, 0xb7, 0x54, 0x72, 0xc9, 0x0f, 0x48, 0x55, 0x2a, 0x3f, 0x20,
+		0x95, 0xad, 0x1c, 0x72, 0xcb, 0x35, 0xc7, 0xdc, 0xf3, 0x2f, 0x52, 0x33, 0x00, 0x48, 0xf0, 0x13,
+		0x74, 0x52, 0xb5, 0xb9, 0x09, 0x3d, 0xcf, 0xd3, 0xd3, 0xd3, 0xd3, 0xfd, 0xcc, 0x0c, 0x05, 0x85,
+		0xee, 0x29, 0xf1, 0x77, 0xeb, 0x76, 0x83, 0xb8, 0x75, 0xb2, 0x6b, 0x77, 0x9c, 0xdd, 0xf7, 0x7b,
+		0xbb, 0x1f, 0x3c, 0xff, 0xdd, 0x59, 0xcb, 0xfb, 0x50, 0xea, 0xf8, 0x1e, 0xf5, 0xd0, 0x06, 0xc3,
+		0x94, 0x22, 0x4c, 0xc9, 0xee, 0x38, 0xa5, 0xf7, 0x7b, 0x57, 0x6f, 0x9c, 0x7b, 0xde, 0x79, 0x8b,
+		0xec, 0x72, 0xc8, 0x69, 0xf7, 0x6c, 0xb7, 0xd1, 0xf5, 0x6d, 0xea, 0x78, 0x6e, 0x48, 0xba, 0x7a,
+		0x73, 0x78, 0x9c, 0x3a, 0x6d, 0x12, 0x50, 0xbb, 0xdd, 0x89, 0x00, 0xdb, 0xe3, 0x66, 0xae, 0x7b,
+		0xed, 0x76, 0xcf, 0xc5, 0xd8, 0xd8, 0xa8, 0x1d, 0xbc, 0x6b, 0x39, 0x01, 0x0d, 0x31, 0x85, 0xef,
+		0x17, 0x60, 0xf3, 0x38, 0x0a, 0x57, 0xfd, 0x48, 0xea, 0x5d, 0x16, 0x82, 0xe6, 0x9e, 0x79, 0xa8,
+		0x06, 0x28, 0x5e, 0x87, 0x45, 0xe2, 0x91, 0xbc, 0xb0, 0x2d, 0xec, 0xe4, 0x1e, 0xde, 0x2d, 0x8d,
+		0x59, 0x52, 0x69, 0xc4, 0x0f, 0x5e, 0xff, 0x30, 0x6c, 0x42, 0x8f, 0x61, 0x8e, 0x5e, 0x74, 0x48,
+		0x3e, 0xc3, 0x1d, 0xdd, 0x9a, 0xea, 0xc8, 0xbc, 0xe8, 0x10, 0xcc, 0xe1, 0xe8, 0x29, 0x40, 0x40,
+		0x6d, 0x9f, 0x5a, 0x2c, 0x0d, 0xf9, 0x2c, 0x27, 0x5f, 0x2d, 0x85, 0x39, 0x2a, 0xc5, 0x39, 0x2a,
+		0x99, 0x71, 0x8e, 0xf0, 0x12, 0x47, 0xb3, 0x6f, 0x46, 0xad, 0xb7, 0xbc, 0x80, 0x84, 0xd4, 0xb9,
+		0x74, 0x2a, 0x47, 0x73, 0xaa, 0x09, 0xcb, 0x21, 0x35, 0xa0, 0x36, 0xed, 0x06, 0xf9, 0xf9, 0x6d,
+		0x61, 0x67, 0xf5, 0xe1, 0xde, 0x6c, 0xab, 0x97, 0x19, 0xd3, 0xe0, 0x44, 0x9c, 0xab, 0xf7, 0x3f,
+		0xd0, 0x1d, 0x58, 0x6d, 0x3a, 0x01, 0xf5, 0xfc, 0x0b, 0xab, 0x45, 0xdc, 0x73, 0xda, 0xcc, 0x2f,
+		0x6c, 0x0b, 0x3b, 0x59, 0xbc, 0x12, 0x59, 0xcb, 0xdc, 0x88, 0x7e, 0x0e, 0x9b, 0x1d, 0xdb, 0x27,
+		0x2e, 0xed, 0xa7, 0xdf, 0x72, 0xdc, 0x33, 0x2f, 0x7f, 0x89, 0x2f, 0x61, 0x67, 0x6c, 0x14, 0x55,
+		0xce, 0x18, 0xd8, 0x49, 0xbc, 0xd1, 0x19, 0x35, 0x22, 0x09, 0x56, 0xfb, 0x6e, 0x79, 0x66, 0x16,
+		0x53, 0x33, 0xb3, 0xd2, 0x63, 0xf0, 0xec, 0x3c, 0x80, 0xb9, 0x36, 0x69, 0x7b, 0xf9, 0x25, 0x4e,
+		0xbc, 0x32, 0x36, 0x9e, 0x23, 0xd2, 0xf6, 0x30, 0x87, 0x21, 0x0c, 0xeb, 0x01, 0xb1, 0xfd, 0x7a,
+		0xd3, 0xb2, 0x29, 0xf5, 0x9d, 0xd3, 0x2e, 0x25, 0x41, 0x1e, 0x38, 0xf7, 0xce, 0x58, 0xae, 0xc1,
+		0xd1, 0x52, 0x0f, 0x8c, 0xc5, 0x60, 0xc8, 0x82, 0xca, 0xb0, 0x6e, 0x77, 0xa9, 0x67, 0xf9, 0x24,
+		0x20, 0xd4, 0xea, 0x78, 0x8e, 0x4b, 0x83, 0x7c, 0x8e, 0xfb, 0xdc, 0x1e, 0xeb, 0x13, 0x33, 0x60,
+		0x95, 0xe3, 0xf0, 0x1a, 0xa3, 0x26, 0x0c, 0xe8, 0x1a, 0x2c, 0xb1, 0xf6, 0xb0, 0x58, 0x7f, 0xe4,
+		0x97, 0xb7, 0x85, 0x9d, 0x25, 0xbc, 0xc8, 0x0c, 0x65, 0x27, 0xa0, 0x68, 0x0b, 0x2e, 0x39, 0x81,
+		0x55, 0xf7, 0x3d, 0x37, 0xbf, 0xb2, 0x2d, 0xec, 0x2c, 0xe2, 0x05, 0x27, 0x90, 0x7d, 0xcf, 0x2d,
+		0xfc, 0x26, 0x03, 0x37, 0x46, 0x37, 0xdf, 0x73, 0xcf, 0x9c, 0xf3, 0xa8, 0xa5, 0xd1, 0xb7, 0x49,
+		0xc7, 0x61, 0x0b, 0x5d, 0x1f, 0x1b, 0x9e, 0x19, 0xcd, 0x96, 0x98, 0xd7, 0x86, 0xed, 0xfe, 0x46,
+		0x45, 0x3d, 0xe0, 0x59, 0xfd, 0x8a, 0xf6, 0xba, 0x34, 0x6a, 0xa6, 0x2b, 0x23, 0x5b, 0xa7, 0x44,
+		0x01, 0xe0, 0xcf, 0x7b, 0x2e, 0x0c, 0xde, 0x17, 0x9e, 0x1c, 0xd7, 0xb8, 0xd7, 0xa5, 0xe8, 0x18,
+		0xae, 0xf1, 0xf0, 0x26, 0x78, 0xcf, 0xa6, 0x79, 0xdf, 0x62, 0xec, 0x31, 0x8e, 0x0b, 0x7f, 0x17,
+		0x60, 0x63, 0x4c, 0x45, 0xb2, 0x44, 0x37, 0xbc, 0xb6, 0xed, 0xb8, 0x96, 0xd3, 0xe0, 0xf9, 0x58,
+		0xc2, 0x8b, 0xa1, 0x41, 0x6b, 0xa0, 0x9b, 0x90, 0x8b, 0x06, 0x5d, 0xbb, 0x1d, 0x0a, 0xc5, 0x12,
+		0x86, 0xd0, 0xa4, 0xdb, 0x6d, 0x32, 0x41, 0x99, 0xb2, 0xff, 0xad, 0x32, 0xdd, 0x82, 0x65, 0xc7,
+		0x75, 0xa8, 0x63, 0x53, 0xd2, 0x60, 0x71, 0xcd, 0xf1, 0xa6, 0xcc, 0xf5, 0x6c, 0x5a, 0xa3, 0xf0,
+		0x6b, 0x01, 0x36, 0xd5, 0x8f, 0x94, 0xf8, 0xae, 0xdd, 0xfa, 0x41, 0xd4, 0x72, 0x38, 0xa6, 0xcc,
+		0x68, 0x4c, 0xff, 0x9c, 0x87, 0x8d, 0x2a, 0x71, 0x1b, 0x8e, 0x7b, 0x2e, 0xd5, 0xa9, 0xf3, 0xde,
+		0xa1, 0x17, 0x3c, 0xa2, 0x9b, 0x90, 0xb3, 0xa3, 0xef, 0x7e, 0x96, 0x21, 0x36, 0x69, 0x0d, 0x74,
+		0x00, 0x2b, 0x3d, 0x40, 0xaa, 0x24, 0xc7, 0xae, 0xb9, 0x24, 0x2f, 0xdb, 0x89, 0x2f, 0xf4, 0x02,
+		0xe6, 0x99, 0x3c, 0x86, 0xaa, 0xbc, 0xfa, 0xf0, 0xfe, 0x78, 0x5d, 0x1a, 0x8c, 0x90, 0x29, 0x21,
+		0xc1, 0x21, 0x0f, 0x69, 0xb0, 0xde, 0x24, 0xb6, 0x4f, 0x4f, 0x89, 0x4d, 0xad, 0x06, 0xa1, 0xb6,
+		0xd3, 0x0a, 0x22, 0x9d, 0xfe, 0x7c, 0x82, 0xc8, 0x5d, 0xb4, 0x3c, 0xbb, 0x81, 0xc5, 0x1e, 0x4d,
+		0x09, 0x59, 0xe8, 0x15, 0x6c, 0xb4, 0xec, 0x80, 0x5a, 0x7d, 0x7f, 0x5c, 0xda, 0xe6, 0x53, 0xa5,
+		0x6d, 0x9d, 0xd1, 0x0e, 0x63, 0x16, 0xb3, 0xa3, 0x03, 0xe0, 0xc6, 0xb0, 0x2b, 0x48, 0x23, 0xf4,
+		0xb4, 0x90, 0xea, 0x69, 0x8d, 0x91, 0x8c, 0x90, 0xc3, 0xfd, 0xe4, 0xe1, 0x92, 0x4d, 0x29, 0x69,
+		0x77, 0x28, 0x57, 0xee, 0x79, 0x1c, 0x7f, 0xa2, 0xfb, 0x20, 0xb6, 0xed, 0x8f, 0x4e, 0xbb, 0xdb,
+		0xb6, 0x22, 0x53, 0xc0, 0x55, 0x78, 0x1e, 0xaf, 0x45, 0x76, 0x29, 0x32, 0x33, 0xb9, 0x0e, 0xea,
+		0x4d, 0xd2, 0xe8, 0xb6, 0xe2, 0x48, 0x96, 0xd2, 0xe5, 0xba, 0xc7, 0xe0, 0x71, 0xc8, 0xb0, 0x46,
+		0x3e, 0x76, 0x9c, 0xb0, 0x67, 0x43, 0x1f, 0x90, 0xea, 0x63, 0xb5, 0x4f, 0xe1, 0x4e, 0x5e, 0xc0,
+		0x32, 0x4f, 0xca, 0x99, 0xed, 0xb4, 0xba, 0x3e, 0xc9, 0xe7, 0xa6, 0x6c, 0xd3, 0x41, 0x88, 0xc1,
+		0x39, 0xc6, 0x88, 0x3e, 0xd0, 0x57, 0x70, 0x99, 0x3b, 0x60, 0xb5, 0x4e, 0x7c, 0xcb, 0x69, 0x10,
+		0x97, 0x3a, 0xf4, 0x22, 0x92, 0x5b, 0xc4, 0xc6, 0x8e, 0xf9, 0x90, 0x16, 0x8d, 0x14, 0x7e, 0x97,
+		0x81, 0x2b, 0x51, 0xf9, 0xc8, 0x4d, 0xa7, 0xd5, 0xf8, 0x41, 0x1a, 0xef, 0xcb, 0x84, 0x5b, 0xd6,
+		0x1c, 0x49, 0x2d, 0x12, 0x3f, 0x24, 0xee, 0x27, 0x5c, 0x91, 0x86, 0xdb, 0x34, 0x3b, 0xd2, 0xa6,
+		0xe8, 0x0d, 0x44, 0xc7, 0x70, 0x24, 0xae, 0x1d, 0xaf, 0xe5, 0xd4, 0x2f, 0x78, 0x99, 0xaf, 0x4e,
+		0x08, 0x34, 0x54, 0x4e, 0x2e, 0xa8, 0x55, 0x8e, 0xc6, 0xeb, 0x9d, 0x61, 0x53, 0xe1, 0x6f, 0x99,
+		0x5e, 0xfb, 0x2b, 0xa4, 0xee, 0x04, 0x71, 0x5e, 0x7a, 0x5d, 0x29, 0xa4, 0x77, 0x65, 0x4c, 0x1c,
+		0xe8, 0xca, 0xd1, 0x8a, 0xcb, 0x7c, 0x6a, 0xc5, 0x3d, 0x87, 0xe5, 0x81, 0xe6, 0x49, 0xbf, 0xb6,
+		0xe5, 0x82, 0xf1, 0x8d, 0x33, 0x37, 0xd8, 0x38, 0x18, 0xb6, 0x3c, 0xdf, 0x39, 0x77, 0x5c, 0xbb,
+		0x65, 0x0d, 0x05, 0x99, 0xde, 0xea, 0x9b, 0x31, 0xd5, 0x48, 0x06, 0x5b, 0xf8, 0x73, 0x06, 0xae,
+		0xc4, 0xf2, 0x54, 0xf6, 0xea, 0x76, 0x4b, 0x71, 0x82, 0x8e, 0x4d, 0xeb, 0xcd, 0xd9, 0xd4, 0xf4,
+		0x7f, 0x9f, 0xae, 0x5f, 0xc0, 0x8d, 0xc1, 0x08, 0x2c, 0xef, 0xcc, 0xa2, 0x4d, 0x27, 0xb0, 0x92,
+		0x59, 0x9c, 0xee, 0xf0, 0xea, 0x40, 0x44, 0x95, 0x33, 0xb3, 0xe9, 0x04, 0x91, 0x06, 0xa1, 0xeb,
+		0x00, 0xfc, 0x96, 0x40, 0xbd, 0x77, 0xc4, 0xe5, 0x79, 0x5e, 0xc6, 0xfc, 0x5a, 0x63, 0x32, 0x43,
+		0xe1, 0x15, 0xe4, 0x92, 0x77, 0xa9, 0x67, 0xb0, 0x10, 0x5d, 0xc7, 0x84, 0xed, 0xec, 0x4e, 0xee,
+		0xe1, 0xed, 0x94, 0xeb, 0x18, 0xbf, 0xa9, 0x46, 0x94, 0xc2, 0x1f, 0x33, 0xb0, 0x3a, 0x38, 0x84,
+		0xee, 0xc1, 0xda, 0xa9, 0xe3, 0xda, 0xfe, 0x85, 0x55, 0x6f, 0x92, 0xfa, 0xbb, 0xa0, 0xdb, 0x8e,
+		0x36, 0x61, 0x35, 0x34, 0xcb, 0x91, 0x15, 0x6d, 0xc2, 0x82, 0xdf, 0x75, 0xe3, 0xc3, 0x72, 0x09,
+		0xcf, 0xfb, 0x5d, 0x76, 0xab, 0x78, 0x0e, 0xd7, 0xce, 0x1c, 0x3f, 0x60, 0x07, 0x4c, 0x58, 0xec,
+		0x56, 0xdd, 0x6b, 0x77, 0x5a, 0x64, 0xa0, 0x63, 0xf3, 0x1c, 0x12, 0xb7, 0x83, 0x1c, 0x03, 0x38,
+		0x7d, 0xb9, 0xee, 0x13, 0xbb, 0xb7, 0x37, 0xe9, 0xa9, 0xcc, 0x45, 0xf8, 0x48, 0x36, 0x57, 0xb8,
+		0x90, 0x3a, 0xee, 0xf9, 0xac, 0x65, 0xba, 0x1c, 0x13, 0xb8, 0x83, 0x1b, 0x00, 0xfc, 0x8e, 0x4b,
+		0xed, 0xd3, 0x56, 0x78, 0x0a, 0x2d, 0xe2, 0x84, 0xa5, 0xf8, 0x27, 0x01, 0x2e, 0x8f, 0x3b, 0x63,
+		0x51, 0x01, 0x6e, 0x54, 0x55, 0x5d, 0xd1, 0xf4, 0x97, 0x96, 0x24, 0x9b, 0xda, 0x1b, 0xcd, 0x3c,
+		0xb1, 0x0c, 0x53, 0x32, 0x55, 0x4b, 0xd3, 0xdf, 0x48, 0x65, 0x4d, 0x11, 0xff, 0x0f, 0x7d, 0x01,
+		0xdb, 0x13, 0x30, 0x86, 0x7c, 0xa8, 0x2a, 0xb5, 0xb2, 0xaa, 0x88, 0xc2, 0x14, 0x4f, 0x86, 0x29,
+		0x61, 0x53, 0x55, 0xc4, 0x0c, 0xfa, 0x7f, 0xb8, 0x37, 0x01, 0x23, 0x4b, 0xba, 0xac, 0x96, 0x2d,
+		0xac, 0xfe, 0xac, 0xa6, 0x1a, 0x0c, 0x9c, 0x2d, 0xfe, 0xb2, 0x1f, 0xf3, 0x80, 0x02, 0x25, 0x67,
+		0x52, 0x54, 0x59, 0x33, 0xb4, 0x8a, 0x3e, 0x2d, 0xe6, 0x21, 0xcc, 0x84, 0x98, 0x87, 0x51, 0x71,
+		0xcc, 0xc5, 0x5f, 0x65, 0xfa, 0x4f, 0x60, 0xad, 0x81, 0x49, 0x37, 0xd6, 0x56, 0x36, 0xc7, 0x71,
+		0x05, 0xbf, 0x3e, 0x28, 0x57, 0x8e, 0x2d, 0x4d, 0xb1, 0xb0, 0x5a, 0x33, 0x54, 0xab, 0x5a, 0x29,
+		0x6b, 0xf2, 0x49, 0x22, 0x92, 0x1f, 0xc1, 0xd7, 0x13, 0x51, 0x52, 0x99, 0x59, 0x95, 0x5a, 0xb5,
+		0xac, 0xc9, 0x6c, 0xd6, 0x03, 0x49, 0x2b, 0xab, 0x8a, 0x55, 0xd1, 0xcb, 0x27, 0xa2, 0x80, 0xbe,
+		0x84, 0x9d, 0x59, 0x99, 0x62, 0x06, 0x3d, 0x80, 0xfb, 0x13, 0xd1, 0x58, 0x7d, 0xa5, 0xca, 0x66,
+		0x02, 0x9e, 0x45, 0x7b, 0xf0, 0x60, 0x22, 0xdc, 0x54, 0xf1, 0x91, 0xa6, 0xf3, 0x84, 0x1e, 0x58,
+		0xb8, 0xa6, 0xeb, 0x9a, 0xfe, 0x52, 0x9c, 0x2b, 0xfe, 0x5e, 0x80, 0xf5, 0x91, 0x43, 0x07, 0xdd,
+		0x84, 0x6b, 0x55, 0x09, 0xab, 0xba, 0x69, 0xc9, 0xe5, 0xca, 0xb8, 0x04, 0x4c, 0x00, 0x48, 0xfb,
+		0x92, 0xae, 0x54, 0x74, 0x51, 0x40, 0x77, 0xa1, 0x30, 0x0e, 0x10, 0xd5, 0x42, 0x54, 0x1a, 0x62,
+		0x06, 0xdd, 0x82, 0xeb, 0xe3, 0x70, 0xbd, 0x68, 0xc5, 0x6c, 0xf1, 0x5f, 0x19, 0xf8, 0x7c, 0xda,
+		0x4b, 0x9b, 0x55, 0x60, 0x6f, 0xd9, 0xea, 0x5b, 0x55, 0xae, 0x99, 0x6c, 0xcf, 0x43, 0x7f, 0x6c,
+		0xe7, 0x6b, 0x46, 0x22, 0xf2, 0x64, 0x4a, 0x27, 0x80, 0xe5, 0xca, 0x51, 0xb5, 0xac, 0x9a, 0xbc,
+		0x9a, 0x8a, 0x70, 0x37, 0x0d, 0x1e, 0x6e, 0xb0, 0x98, 0x19, 0xd8, 0xdb, 0x49, 0xae, 0xf9, 0xba,
+		0x59, 0x2b, 0xa0, 0x12, 0x14, 0xd3, 0xd0, 0xbd, 0x2c, 0x28, 0xe2, 0x1c, 0xfa, 0x1a, 0xbe, 0x4a,
+		0x0f, 0x5c, 0x37, 0x35, 0xbd, 0xa6, 0x2a, 0x96, 0x64, 0x58, 0xba, 0x7a, 0x2c, 0xce, 0xcf, 0xb2,
+		0x5c, 0x53, 0x3b, 0x62, 0xf5, 0x59, 0x33, 0xc5, 0x85, 0xe2, 0x5f, 0x04, 0xf8, 0x4c, 0xf6, 0x5c,
+		0xea, 0xb8, 0x5d, 0x22, 0x05, 0x3a, 0xf9, 0xa0, 0x85, 0xf7, 0x19, 0xcf, 0x47, 0x77, 0xe0, 0x56,
+		0xec, 0x3f, 0x72, 0x6f, 0x69, 0xba, 0x66, 0x6a, 0x92, 0x59, 0xc1, 0x89, 0xfc, 0x4e, 0x85, 0xb1,
+		0x86, 0x54, 0x54, 0x1c, 0xe6, 0x75, 0x32, 0x0c, 0xab, 0x26, 0x3e, 0x89, 0x4a, 0x21, 0x54, 0x98,
+		0xc9, 0x58, 0x19, 0x57, 0xf4, 0x5e, 0xff, 0x8b, 0xd9, 0xe2, 0x1f, 0x04, 0xc8, 0x45, 0x6f, 0x51,
+		0xfe, 0x54, 0xc9, 0xc3, 0x65, 0xb6, 0xc0, 0x4a, 0xcd, 0xb4, 0xcc, 0x93, 0xaa, 0x3a, 0x58, 0xc3,
+		0x03, 0x23, 0x5c, 0x1e, 0x2c, 0xb3, 0x12, 0x66, 0x27, 0x54, 0x92, 0x41, 0x40, 0x34, 0x0b, 0xc3,
+		0x70, 0xb0, 0x98, 0x99, 0x8a, 0x09, 0xfd, 0x64, 0xd1, 0x55, 0xf8, 0x6c, 0x00, 0x73, 0xa8, 0x4a,
+		0xd8, 0xdc, 0x57, 0x25, 0x53, 0x9c, 0x2b, 0xfe, 0x56, 0x80, 0x2b, 0xb1, 0x12, 0xb2, 0x5f, 0x02,
+		0x58, 0xe8, 0x8d, 0x4a, 0x97, 0xca, 0x76, 0x37, 0x20, 0xe8, 0x3e, 0xdc, 0xe9, 0x69, 0x98, 0x29,
+		0x19, 0xaf, 0xfb, 0x7b, 0x65, 0xc9, 0x52, 0xcd, 0x48, 0xae, 0x26, 0x15, 0x1a, 0x85, 0x20, 0x0a,
+		0xe8, 0x1e, 0xdc, 0x9e, 0x0e, 0xc5, 0xaa, 0xa1, 0x9a, 0x62, 0xa6, 0xf8, 0x8f, 0x1c, 0x6c, 0x25,
+		0x83, 0x63, 0x17, 0x7a, 0xd2, 0x08, 0x43, 0xbb, 0x0b, 0x85, 0x41, 0x27, 0x91, 0xce, 0x0d, 0xc7,
+		0xb5, 0x07, 0x0f, 0xa6, 0xe0, 0x6a, 0xfa, 0xa1, 0xa4, 0x2b, 0xec, 0x3b, 0x06, 0x89, 0x02, 0x7a,
+		0x01, 0xcf, 0xa6, 0x50, 0xf6, 0x25, 0xa5, 0x9f, 0xe5, 0xde, 0x89, 0x23, 0x99, 0x26, 0xd6, 0xf6,
+		0x6b, 0xa6, 0x6a, 0x88, 0x19, 0xa4, 0x82, 0x94, 0xe2, 0x60, 0x50, 0x87, 0xc6, 0xba, 0xc9, 0xa2,
+		0xa7, 0xf0, 0x38, 0x2d, 0x8e, 0xb0, 0x64, 0xb4, 0x23, 0x15, 0x27, 0xa9, 0x73, 0xe8, 0x5b, 0xf8,
+		0x26, 0x85, 0x1a, 0xcd, 0x3c, 0xc2, 0x9d, 0x47, 0xcf, 0xe0, 0x49, 0x6a, 0xf4, 0x72, 0x05, 0x2b,
+		0xd6, 0x91, 0x84, 0x5f, 0x0f, 0x92, 0x17, 0x90, 0x06, 0x6a, 0xda, 0xc4, 0x91, 0xba, 0x59, 0x63,
+		0x74, 0x21, 0xe1, 0xea, 0xd2, 0x0c, 0x59, 0x64, 0x86, 0x14, 0x37, 0x8b, 0xe8, 0x25, 0xc8, 0xb3,
+		0xa5, 0x62, 0xba, 0xa3, 0x25, 0xf4, 0x16, 0xcc, 0x4f, 0xdb, 0x55, 0xf5, 0xad, 0xa9, 0x62, 0x5d,
+		0x4a, 0xf3, 0x0c, 0xe8, 0x39, 0x3c, 0x4d, 0x4d, 0xda, 0xa0, 0xfe, 0x24, 0xe8, 0x39, 0xf4, 0x04,
+		0x1e, 0x4d, 0xa1, 0x27, 0x6b, 0xa4, 0x7f, 0x2b, 0xd0, 0x14, 0x71, 0x19, 0x3d, 0x86, 0xbd, 0x29,
+		0x44, 0xde, 0x85, 0x96, 0x61, 0x6a, 0xf2, 0xeb, 0x93, 0x70, 0xb8, 0xac, 0x19, 0xa6, 0xb8, 0x82,
+		0x7e, 0x0a, 0x3f, 0x9e, 0x42, 0xeb, 0x2d, 0x96, 0xfd, 0xa1, 0xe2, 0x44, 0x8b, 0x31, 0x58, 0x0d,
+		0xab, 0xe2, 0xea, 0x0c, 0x7b, 0x62, 0x68, 0x2f, 0xd3, 0x33, 0xb7, 0x86, 0x64, 0x78, 0x31, 0x53,
+		0x8b, 0xc8, 0x87, 0x5a, 0x59, 0x19, 0xef, 0x44, 0x44, 0x8f, 0x60, 0x77, 0x8a, 0x93, 0x83, 0x0a,
+		0x96, 0xd5, 0xe8, 0xc4, 0xea, 0x89, 0xc4, 0x3a, 0xfa, 0x06, 0x1e, 0x4e, 0x23, 0x49, 0x5a, 0xb9,
+		0xf2, 0x46, 0xc5, 0xc3, 0x3c, 0xc4, 0x8e, 0xd1, 0xd9, 0x96, 0xae, 0xe9, 0xd5, 0x9a, 0x69, 0x19,
+		0xda, 0x77, 0xaa, 0xb8, 0xc1, 0x8e, 0xd1, 0xd4, 0x9d, 0x8a, 0x73, 0x25, 0x5e, 0x1e, 0x15, 0xe3,
+		0x91, 0x49, 0xf6, 0x35, 0x5d, 0xc2, 0x27, 0xe2, 0x66, 0x4a, 0xed, 0x8d, 0x0a, 0xdd, 0x40, 0x09,
+		0x7d, 0x36, 0xcb, 0x72, 0x54, 0x09, 0xcb, 0x87, 0xc9, 0x8c, 0x6f, 0xb1, 0x53, 0xe7, 0x16, 0xff,
+		0x61, 0x65, 0xe4, 0x5e, 0x95, 0x94, 0xf8, 0x3d, 0x78, 0x10, 0xee, 0xdb, 0x98, 0x2a, 0x98, 0xa0,
+		0xf6, 0xfb, 0xf0, 0x93, 0xd9, 0x28, 0xbd, 0x71, 0xa9, 0x8c, 0x55, 0x49, 0x39, 0xe9, 0x5d, 0x49,
+		0x85, 0xe2, 0x5f, 0x05, 0x28, 0xca, 0xb6, 0x5b, 0x27, 0xad, 0xf8, 0x77, 0xd7, 0xa9, 0x51, 0x3e,
+		0x83, 0x27, 0x33, 0xf4, 0xfb, 0x84, 0x78, 0x8f, 0xc1, 0xf8, 0x54, 0x72, 0x4d, 0x7f, 0xad, 0x57,
+		0x8e, 0xf5, 0x69, 0x84, 0x68, 0x11, 0x86, 0x73, 0xee, 0xda, 0x33, 0x2f, 0x22, 0x2a, 0xbb, 0xff,
+		0x6c, 0x11, 0x9f, 0x4a, 0x9e, 0x6d, 0x11, 0xdf, 0x0b, 0x90, 0xe7, 0x6f, 0xf2, 0x81, 0x5a, 0x89,
+		0xde, 0x08, 0xf7, 0xe0, 0x76, 0x58, 0xf7, 0x43, 0x1b, 0x3e, 0xf2, 0x56, 0xb8, 0x0b, 0x85, 0x69,
+		0xc0, 0xf0, 0x1d, 0x23, 0x0a, 0xac, 0x69, 0xa6, 0xe1, 0xfa, 0x4f, 0x82, 0x0c, 0xda, 0x81, 0x2f,
+		0xa6, 0xbb, 0x94, 0x4c, 0x53, 0x92, 0x0f, 0xc5, 0xec, 0xfe, 0x1b, 0xd8, 0xaa, 0x7b, 0xed, 0x71,
+		0x3f, 0x44, 0xec, 0x2f, 0x4a, 0x1d, 0xa7, 0xca, 0x1e, 0xe1, 0x55, 0xe1, 0xbb, 0xdd, 0x73, 0x87,
+		0x36, 0xbb, 0xa7, 0xa5, 0xba, 0xd7, 0xde, 0x1d, 0xf8, 0x17, 0x6a, 0xe9, 0x9c, 0xb8, 0xe1, 0x3f,
+		0x64, 0xa3, 0xff, 0xa6, 0x3e, 0xb3, 0x3b, 0xce, 0xfb, 0xbd, 0xd3, 0x05, 0x6e, 0x7b, 0xf4, 0xef,
+		0x01, 0x00, 0xf2, 0xda, 0xa6, 0xd9, 0x0d, 0x1e, 0x00, 0x00,
 	},
 }
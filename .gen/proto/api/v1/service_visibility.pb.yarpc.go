@@ -650,149 +650,152 @@ var yarpcFileDescriptorClosurea7341dc69cef4364 = [][]byte{
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0xdb, 0xc8,
+		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd4, 0xd4, 0x07, 0x34, 0xb2, 0x56, 0xb4, 0xbd, 0xb6, 0x65, 0x7a,
+		0x6d, 0xcb, 0x7c, 0xd7, 0xd4, 0xca, 0x5e, 0xaf, 0xe3, 0x75, 0x1c, 0x07, 0x02, 0x20, 0x0b, 0x36,
+		0x05, 0x32, 0x03, 0xd0, 0xb2, 0xb6, 0x52, 0x41, 0x41, 0xe4, 0x48, 0x44, 0x99, 0x04, 0x58, 0xc0,
+		0xd0, 0xb6, 0xee, 0xa9, 0xca, 0x39, 0xb7, 0x54, 0x72, 0xc9, 0x0f, 0x48, 0x55, 0x2a, 0x3f, 0x20,
+		0x95, 0xad, 0x1c, 0x72, 0xcb, 0x35, 0xc7, 0xdc, 0xf3, 0x2f, 0x52, 0x33, 0x00, 0x48, 0xf0, 0x13,
+		0x74, 0x52, 0xb5, 0xb9, 0x09, 0x3d, 0xcf, 0xd3, 0xd3, 0xd3, 0xd3, 0xfd, 0xcc, 0x0c, 0x05, 0x85,
+		0xee, 0x29, 0xf1, 0x77, 0xeb, 0x76, 0x83, 0xb8, 0x75, 0xb2, 0x6b, 0x77, 0x9c, 0xdd, 0xf7, 0x7b,
+		0xbb, 0x1f, 0x3c, 0xff, 0xdd, 0x59, 0xcb, 0xfb, 0x50, 0xea, 0xf8, 0x1e, 0xf5, 0xd0, 0x06, 0xc3,
+		0x94, 0x22, 0x4c, 0xc9, 0xee, 0x38, 0xa5, 0xf7, 0x7b, 0x57, 0x6f, 0x9c, 0x7b, 0xde, 0x79, 0x8b,
+		0xec, 0x72, 0xc8, 0x69, 0xf7, 0x6c, 0xb7, 0xd1, 0xf5, 0x6d, 0xea, 0x78, 0x6e, 0x48, 0xba, 0x7a,
+		0x73, 0x78, 0x9c, 0x3a, 0x6d, 0x12, 0x50, 0xbb, 0xdd, 0x89, 0x00, 0xdb, 0xe3, 0x66, 0xae, 0x7b,
+		0xed, 0x76, 0xcf, 0xc5, 0xd8, 0xd8, 0xa8, 0x1d, 0xbc, 0x6b, 0x39, 0x01, 0x0d, 0x31, 0x85, 0xef,
+		0x17, 0x60, 0xf3, 0x38, 0x0a, 0x57, 0xfd, 0x48, 0xea, 0x5d, 0x16, 0x82, 0xe6, 0x9e, 0x79, 0xa8,
+		0x06, 0x28, 0x5e, 0x87, 0x45, 0xe2, 0x91, 0xbc, 0xb0, 0x2d, 0xec, 0xe4, 0x1e, 0xde, 0x2d, 0x8d,
+		0x59, 0x52, 0x69, 0xc4, 0x0f, 0x5e, 0xff, 0x30, 0x6c, 0x42, 0x8f, 0x61, 0x8e, 0x5e, 0x74, 0x48,
+		0x3e, 0xc3, 0x1d, 0xdd, 0x9a, 0xea, 0xc8, 0xbc, 0xe8, 0x10, 0xcc, 0xe1, 0xe8, 0x29, 0x40, 0x40,
+		0x6d, 0x9f, 0x5a, 0x2c, 0x0d, 0xf9, 0x2c, 0x27, 0x5f, 0x2d, 0x85, 0x39, 0x2a, 0xc5, 0x39, 0x2a,
+		0x99, 0x71, 0x8e, 0xf0, 0x12, 0x47, 0xb3, 0x6f, 0x46, 0xad, 0xb7, 0xbc, 0x80, 0x84, 0xd4, 0xb9,
+		0x74, 0x2a, 0x47, 0x73, 0xaa, 0x09, 0xcb, 0x21, 0x35, 0xa0, 0x36, 0xed, 0x06, 0xf9, 0xf9, 0x6d,
+		0x61, 0x67, 0xf5, 0xe1, 0xde, 0x6c, 0xab, 0x97, 0x19, 0xd3, 0xe0, 0x44, 0x9c, 0xab, 0xf7, 0x3f,
+		0xd0, 0x1d, 0x58, 0x6d, 0x3a, 0x01, 0xf5, 0xfc, 0x0b, 0xab, 0x45, 0xdc, 0x73, 0xda, 0xcc, 0x2f,
+		0x6c, 0x0b, 0x3b, 0x59, 0xbc, 0x12, 0x59, 0xcb, 0xdc, 0x88, 0x7e, 0x0e, 0x9b, 0x1d, 0xdb, 0x27,
+		0x2e, 0xed, 0xa7, 0xdf, 0x72, 0xdc, 0x33, 0x2f, 0x7f, 0x89, 0x2f, 0x61, 0x67, 0x6c, 0x14, 0x55,
+		0xce, 0x18, 0xd8, 0x49, 0xbc, 0xd1, 0x19, 0x35, 0x22, 0x09, 0x56, 0xfb, 0x6e, 0x79, 0x66, 0x16,
+		0x53, 0x33, 0xb3, 0xd2, 0x63, 0xf0, 0xec, 0x3c, 0x80, 0xb9, 0x36, 0x69, 0x7b, 0xf9, 0x25, 0x4e,
+		0xbc, 0x32, 0x36, 0x9e, 0x23, 0xd2, 0xf6, 0x30, 0x87, 0x21, 0x0c, 0xeb, 0x01, 0xb1, 0xfd, 0x7a,
+		0xd3, 0xb2, 0x29, 0xf5, 0x9d, 0xd3, 0x2e, 0x25, 0x41, 0x1e, 0x38, 0xf7, 0xce, 0x58, 0xae, 0xc1,
+		0xd1, 0x52, 0x0f, 0x8c, 0xc5, 0x60, 0xc8, 0x82, 0xca, 0xb0, 0x6e, 0x77, 0xa9, 0x67, 0xf9, 0x24,
+		0x20, 0xd4, 0xea, 0x78, 0x8e, 0x4b, 0x83, 0x7c, 0x8e, 0xfb, 0xdc, 0x1e, 0xeb, 0x13, 0x33, 0x60,
+		0x95, 0xe3, 0xf0, 0x1a, 0xa3, 0x26, 0x0c, 0xe8, 0x1a, 0x2c, 0xb1, 0xf6, 0xb0, 0x58, 0x7f, 0xe4,
+		0x97, 0xb7, 0x85, 0x9d, 0x25, 0xbc, 0xc8, 0x0c, 0x65, 0x27, 0xa0, 0x68, 0x0b, 0x2e, 0x39, 0x81,
+		0x55, 0xf7, 0x3d, 0x37, 0xbf, 0xb2, 0x2d, 0xec, 0x2c, 0xe2, 0x05, 0x27, 0x90, 0x7d, 0xcf, 0x2d,
+		0xfc, 0x26, 0x03, 0x37, 0x46, 0x37, 0xdf, 0x73, 0xcf, 0x9c, 0xf3, 0xa8, 0xa5, 0xd1, 0xb7, 0x49,
+		0xc7, 0x61, 0x0b, 0x5d, 0x1f, 0x1b, 0x9e, 0x19, 0xcd, 0x96, 0x98, 0xd7, 0x86, 0xed, 0xfe, 0x46,
+		0x45, 0x3d, 0xe0, 0x59, 0xfd, 0x8a, 0xf6, 0xba, 0x34, 0x6a, 0xa6, 0x2b, 0x23, 0x5b, 0xa7, 0x44,
+		0x01, 0xe0, 0xcf, 0x7b, 0x2e, 0x0c, 0xde, 0x17, 0x9e, 0x1c, 0xd7, 0xb8, 0xd7, 0xa5, 0xe8, 0x18,
+		0xae, 0xf1, 0xf0, 0x26, 0x78, 0xcf, 0xa6, 0x79, 0xdf, 0x62, 0xec, 0x31, 0x8e, 0x0b, 0x7f, 0x17,
+		0x60, 0x63, 0x4c, 0x45, 0xb2, 0x44, 0x37, 0xbc, 0xb6, 0xed, 0xb8, 0x96, 0xd3, 0xe0, 0xf9, 0x58,
+		0xc2, 0x8b, 0xa1, 0x41, 0x6b, 0xa0, 0x9b, 0x90, 0x8b, 0x06, 0x5d, 0xbb, 0x1d, 0x0a, 0xc5, 0x12,
+		0x86, 0xd0, 0xa4, 0xdb, 0x6d, 0x32, 0x41, 0x99, 0xb2, 0xff, 0xad, 0x32, 0xdd, 0x82, 0x65, 0xc7,
+		0x75, 0xa8, 0x63, 0x53, 0xd2, 0x60, 0x71, 0xcd, 0xf1, 0xa6, 0xcc, 0xf5, 0x6c, 0x5a, 0xa3, 0xf0,
+		0x6b, 0x01, 0x36, 0xd5, 0x8f, 0x94, 0xf8, 0xae, 0xdd, 0xfa, 0x41, 0xd4, 0x72, 0x38, 0xa6, 0xcc,
+		0x68, 0x4c, 0xff, 0x9c, 0x87, 0x8d, 0x2a, 0x71, 0x1b, 0x8e, 0x7b, 0x2e, 0xd5, 0xa9, 0xf3, 0xde,
+		0xa1, 0x17, 0x3c, 0xa2, 0x9b, 0x90, 0xb3, 0xa3, 0xef, 0x7e, 0x96, 0x21, 0x36, 0x69, 0x0d, 0x74,
+		0x00, 0x2b, 0x3d, 0x40, 0xaa, 0x24, 0xc7, 0xae, 0xb9, 0x24, 0x2f, 0xdb, 0x89, 0x2f, 0xf4, 0x02,
+		0xe6, 0x99, 0x3c, 0x86, 0xaa, 0xbc, 0xfa, 0xf0, 0xfe, 0x78, 0x5d, 0x1a, 0x8c, 0x90, 0x29, 0x21,
+		0xc1, 0x21, 0x0f, 0x69, 0xb0, 0xde, 0x24, 0xb6, 0x4f, 0x4f, 0x89, 0x4d, 0xad, 0x06, 0xa1, 0xb6,
+		0xd3, 0x0a, 0x22, 0x9d, 0xfe, 0x7c, 0x82, 0xc8, 0x5d, 0xb4, 0x3c, 0xbb, 0x81, 0xc5, 0x1e, 0x4d,
+		0x09, 0x59, 0xe8, 0x15, 0x6c, 0xb4, 0xec, 0x80, 0x5a, 0x7d, 0x7f, 0x5c, 0xda, 0xe6, 0x53, 0xa5,
+		0x6d, 0x9d, 0xd1, 0x0e, 0x63, 0x16, 0xb3, 0xa3, 0x03, 0xe0, 0xc6, 0xb0, 0x2b, 0x48, 0x23, 0xf4,
+		0xb4, 0x90, 0xea, 0x69, 0x8d, 0x91, 0x8c, 0x90, 0xc3, 0xfd, 0xe4, 0xe1, 0x92, 0x4d, 0x29, 0x69,
+		0x77, 0x28, 0x57, 0xee, 0x79, 0x1c, 0x7f, 0xa2, 0xfb, 0x20, 0xb6, 0xed, 0x8f, 0x4e, 0xbb, 0xdb,
+		0xb6, 0x22, 0x53, 0xc0, 0x55, 0x78, 0x1e, 0xaf, 0x45, 0x76, 0x29, 0x32, 0x33, 0xb9, 0x0e, 0xea,
+		0x4d, 0xd2, 0xe8, 0xb6, 0xe2, 0x48, 0x96, 0xd2, 0xe5, 0xba, 0xc7, 0xe0, 0x71, 0xc8, 0xb0, 0x46,
+		0x3e, 0x76, 0x9c, 0xb0, 0x67, 0x43, 0x1f, 0x90, 0xea, 0x63, 0xb5, 0x4f, 0xe1, 0x4e, 0x5e, 0xc0,
+		0x32, 0x4f, 0xca, 0x99, 0xed, 0xb4, 0xba, 0x3e, 0xc9, 0xe7, 0xa6, 0x6c, 0xd3, 0x41, 0x88, 0xc1,
+		0x39, 0xc6, 0x88, 0x3e, 0xd0, 0x57, 0x70, 0x99, 0x3b, 0x60, 0xb5, 0x4e, 0x7c, 0xcb, 0x69, 0x10,
+		0x97, 0x3a, 0xf4, 0x22, 0x92, 0x5b, 0xc4, 0xc6, 0x8e, 0xf9, 0x90, 0x16, 0x8d, 0x14, 0x7e, 0x97,
+		0x81, 0x2b, 0x51, 0xf9, 0xc8, 0x4d, 0xa7, 0xd5, 0xf8, 0x41, 0x1a, 0xef, 0xcb, 0x84, 0x5b, 0xd6,
+		0x1c, 0x49, 0x2d, 0x12, 0x3f, 0x24, 0xee, 0x27, 0x5c, 0x91, 0x86, 0xdb, 0x34, 0x3b, 0xd2, 0xa6,
+		0xe8, 0x0d, 0x44, 0xc7, 0x70, 0x24, 0xae, 0x1d, 0xaf, 0xe5, 0xd4, 0x2f, 0x78, 0x99, 0xaf, 0x4e,
+		0x08, 0x34, 0x54, 0x4e, 0x2e, 0xa8, 0x55, 0x8e, 0xc6, 0xeb, 0x9d, 0x61, 0x53, 0xe1, 0x6f, 0x99,
+		0x5e, 0xfb, 0x2b, 0xa4, 0xee, 0x04, 0x71, 0x5e, 0x7a, 0x5d, 0x29, 0xa4, 0x77, 0x65, 0x4c, 0x1c,
+		0xe8, 0xca, 0xd1, 0x8a, 0xcb, 0x7c, 0x6a, 0xc5, 0x3d, 0x87, 0xe5, 0x81, 0xe6, 0x49, 0xbf, 0xb6,
+		0xe5, 0x82, 0xf1, 0x8d, 0x33, 0x37, 0xd8, 0x38, 0x18, 0xb6, 0x3c, 0xdf, 0x39, 0x77, 0x5c, 0xbb,
+		0x65, 0x0d, 0x05, 0x99, 0xde, 0xea, 0x9b, 0x31, 0xd5, 0x48, 0x06, 0x5b, 0xf8, 0x73, 0x06, 0xae,
+		0xc4, 0xf2, 0x54, 0xf6, 0xea, 0x76, 0x4b, 0x71, 0x82, 0x8e, 0x4d, 0xeb, 0xcd, 0xd9, 0xd4, 0xf4,
+		0x7f, 0x9f, 0xae, 0x5f, 0xc0, 0x8d, 0xc1, 0x08, 0x2c, 0xef, 0xcc, 0xa2, 0x4d, 0x27, 0xb0, 0x92,
+		0x59, 0x9c, 0xee, 0xf0, 0xea, 0x40, 0x44, 0x95, 0x33, 0xb3, 0xe9, 0x04, 0x91, 0x06, 0xa1, 0xeb,
+		0x00, 0xfc, 0x96, 0x40, 0xbd, 0x77, 0xc4, 0xe5, 0x79, 0x5e, 0xc6, 0xfc, 0x5a, 0x63, 0x32, 0x43,
+		0xe1, 0x15, 0xe4, 0x92, 0x77, 0xa9, 0x67, 0xb0, 0x10, 0x5d, 0xc7, 0x84, 0xed, 0xec, 0x4e, 0xee,
+		0xe1, 0xed, 0x94, 0xeb, 0x18, 0xbf, 0xa9, 0x46, 0x94, 0xc2, 0x1f, 0x33, 0xb0, 0x3a, 0x38, 0x84,
+		0xee, 0xc1, 0xda, 0xa9, 0xe3, 0xda, 0xfe, 0x85, 0x55, 0x6f, 0x92, 0xfa, 0xbb, 0xa0, 0xdb, 0x8e,
+		0x36, 0x61, 0x35, 0x34, 0xcb, 0x91, 0x15, 0x6d, 0xc2, 0x82, 0xdf, 0x75, 0xe3, 0xc3, 0x72, 0x09,
+		0xcf, 0xfb, 0x5d, 0x76, 0xab, 0x78, 0x0e, 0xd7, 0xce, 0x1c, 0x3f, 0x60, 0x07, 0x4c, 0x58, 0xec,
+		0x56, 0xdd, 0x6b, 0x77, 0x5a, 0x64, 0xa0, 0x63, 0xf3, 0x1c, 0x12, 0xb7, 0x83, 0x1c, 0x03, 0x38,
+		0x7d, 0xb9, 0xee, 0x13, 0xbb, 0xb7, 0x37, 0xe9, 0xa9, 0xcc, 0x45, 0xf8, 0x48, 0x36, 0x57, 0xb8,
+		0x90, 0x3a, 0xee, 0xf9, 0xac, 0x65, 0xba, 0x1c, 0x13, 0xb8, 0x83, 0x1b, 0x00, 0xfc, 0x8e, 0x4b,
+		0xed, 0xd3, 0x56, 0x78, 0x0a, 0x2d, 0xe2, 0x84, 0xa5, 0xf8, 0x27, 0x01, 0x2e, 0x8f, 0x3b, 0x63,
+		0x51, 0x01, 0x6e, 0x54, 0x55, 0x5d, 0xd1, 0xf4, 0x97, 0x96, 0x24, 0x9b, 0xda, 0x1b, 0xcd, 0x3c,
+		0xb1, 0x0c, 0x53, 0x32, 0x55, 0x4b, 0xd3, 0xdf, 0x48, 0x65, 0x4d, 0x11, 0xff, 0x0f, 0x7d, 0x01,
+		0xdb, 0x13, 0x30, 0x86, 0x7c, 0xa8, 0x2a, 0xb5, 0xb2, 0xaa, 0x88, 0xc2, 0x14, 0x4f, 0x86, 0x29,
+		0x61, 0x53, 0x55, 0xc4, 0x0c, 0xfa, 0x7f, 0xb8, 0x37, 0x01, 0x23, 0x4b, 0xba, 0xac, 0x96, 0x2d,
+		0xac, 0xfe, 0xac, 0xa6, 0x1a, 0x0c, 0x9c, 0x2d, 0xfe, 0xb2, 0x1f, 0xf3, 0x80, 0x02, 0x25, 0x67,
+		0x52, 0x54, 0x59, 0x33, 0xb4, 0x8a, 0x3e, 0x2d, 0xe6, 0x21, 0xcc, 0x84, 0x98, 0x87, 0x51, 0x71,
+		0xcc, 0xc5, 0x5f, 0x65, 0xfa, 0x4f, 0x60, 0xad, 0x81, 0x49, 0x37, 0xd6, 0x56, 0x36, 0xc7, 0x71,
+		0x05, 0xbf, 0x3e, 0x28, 0x57, 0x8e, 0x2d, 0x4d, 0xb1, 0xb0, 0x5a, 0x33, 0x54, 0xab, 0x5a, 0x29,
+		0x6b, 0xf2, 0x49, 0x22, 0x92, 0x1f, 0xc1, 0xd7, 0x13, 0x51, 0x52, 0x99, 0x59, 0x95, 0x5a, 0xb5,
+		0xac, 0xc9, 0x6c, 0xd6, 0x03, 0x49, 0x2b, 0xab, 0x8a, 0x55, 0xd1, 0xcb, 0x27, 0xa2, 0x80, 0xbe,
+		0x84, 0x9d, 0x59, 0x99, 0x62, 0x06, 0x3d, 0x80, 0xfb, 0x13, 0xd1, 0x58, 0x7d, 0xa5, 0xca, 0x66,
+		0x02, 0x9e, 0x45, 0x7b, 0xf0, 0x60, 0x22, 0xdc, 0x54, 0xf1, 0x91, 0xa6, 0xf3, 0x84, 0x1e, 0x58,
+		0xb8, 0xa6, 0xeb, 0x9a, 0xfe, 0x52, 0x9c, 0x2b, 0xfe, 0x5e, 0x80, 0xf5, 0x91, 0x43, 0x07, 0xdd,
+		0x84, 0x6b, 0x55, 0x09, 0xab, 0xba, 0x69, 0xc9, 0xe5, 0xca, 0xb8, 0x04, 0x4c, 0x00, 0x48, 0xfb,
+		0x92, 0xae, 0x54, 0x74, 0x51, 0x40, 0x77, 0xa1, 0x30, 0x0e, 0x10, 0xd5, 0x42, 0x54, 0x1a, 0x62,
+		0x06, 0xdd, 0x82, 0xeb, 0xe3, 0x70, 0xbd, 0x68, 0xc5, 0x6c, 0xf1, 0x5f, 0x19, 0xf8, 0x7c, 0xda,
+		0x4b, 0x9b, 0x55, 0x60, 0x6f, 0xd9, 0xea, 0x5b, 0x55, 0xae, 0x99, 0x6c, 0xcf, 0x43, 0x7f, 0x6c,
+		0xe7, 0x6b, 0x46, 0x22, 0xf2, 0x64, 0x4a, 0x27, 0x80, 0xe5, 0xca, 0x51, 0xb5, 0xac, 0x9a, 0xbc,
+		0x9a, 0x8a, 0x70, 0x37, 0x0d, 0x1e, 0x6e, 0xb0, 0x98, 0x19, 0xd8, 0xdb, 0x49, 0xae, 0xf9, 0xba,
+		0x59, 0x2b, 0xa0, 0x12, 0x14, 0xd3, 0xd0, 0xbd, 0x2c, 0x28, 0xe2, 0x1c, 0xfa, 0x1a, 0xbe, 0x4a,
+		0x0f, 0x5c, 0x37, 0x35, 0xbd, 0xa6, 0x2a, 0x96, 0x64, 0x58, 0xba, 0x7a, 0x2c, 0xce, 0xcf, 0xb2,
+		0x5c, 0x53, 0x3b, 0x62, 0xf5, 0x59, 0x33, 0xc5, 0x85, 0xe2, 0x5f, 0x04, 0xf8, 0x4c, 0xf6, 0x5c,
+		0xea, 0xb8, 0x5d, 0x22, 0x05, 0x3a, 0xf9, 0xa0, 0x85, 0xf7, 0x19, 0xcf, 0x47, 0x77, 0xe0, 0x56,
+		0xec, 0x3f, 0x72, 0x6f, 0x69, 0xba, 0x66, 0x6a, 0x92, 0x59, 0xc1, 0x89, 0xfc, 0x4e, 0x85, 0xb1,
+		0x86, 0x54, 0x54, 0x1c, 0xe6, 0x75, 0x32, 0x0c, 0xab, 0x26, 0x3e, 0x89, 0x4a, 0x21, 0x54, 0x98,
+		0xc9, 0x58, 0x19, 0x57, 0xf4, 0x5e, 0xff, 0x8b, 0xd9, 0xe2, 0x1f, 0x04, 0xc8, 0x45, 0x6f, 0x51,
+		0xfe, 0x54, 0xc9, 0xc3, 0x65, 0xb6, 0xc0, 0x4a, 0xcd, 0xb4, 0xcc, 0x93, 0xaa, 0x3a, 0x58, 0xc3,
+		0x03, 0x23, 0x5c, 0x1e, 0x2c, 0xb3, 0x12, 0x66, 0x27, 0x54, 0x92, 0x41, 0x40, 0x34, 0x0b, 0xc3,
+		0x70, 0xb0, 0x98, 0x99, 0x8a, 0x09, 0xfd, 0x64, 0xd1, 0x55, 0xf8, 0x6c, 0x00, 0x73, 0xa8, 0x4a,
+		0xd8, 0xdc, 0x57, 0x25, 0x53, 0x9c, 0x2b, 0xfe, 0x56, 0x80, 0x2b, 0xb1, 0x12, 0xb2, 0x5f, 0x02,
+		0x58, 0xe8, 0x8d, 0x4a, 0x97, 0xca, 0x76, 0x37, 0x20, 0xe8, 0x3e, 0xdc, 0xe9, 0x69, 0x98, 0x29,
+		0x19, 0xaf, 0xfb, 0x7b, 0x65, 0xc9, 0x52, 0xcd, 0x48, 0xae, 0x26, 0x15, 0x1a, 0x85, 0x20, 0x0a,
+		0xe8, 0x1e, 0xdc, 0x9e, 0x0e, 0xc5, 0xaa, 0xa1, 0x9a, 0x62, 0xa6, 0xf8, 0x8f, 0x1c, 0x6c, 0x25,
+		0x83, 0x63, 0x17, 0x7a, 0xd2, 0x08, 0x43, 0xbb, 0x0b, 0x85, 0x41, 0x27, 0x91, 0xce, 0x0d, 0xc7,
+		0xb5, 0x07, 0x0f, 0xa6, 0xe0, 0x6a, 0xfa, 0xa1, 0xa4, 0x2b, 0xec, 0x3b, 0x06, 0x89, 0x02, 0x7a,
+		0x01, 0xcf, 0xa6, 0x50, 0xf6, 0x25, 0xa5, 0x9f, 0xe5, 0xde, 0x89, 0x23, 0x99, 0x26, 0xd6, 0xf6,
+		0x6b, 0xa6, 0x6a, 0x88, 0x19, 0xa4, 0x82, 0x94, 0xe2, 0x60, 0x50, 0x87, 0xc6, 0xba, 0xc9, 0xa2,
+		0xa7, 0xf0, 0x38, 0x2d, 0x8e, 0xb0, 0x64, 0xb4, 0x23, 0x15, 0x27, 0xa9, 0x73, 0xe8, 0x5b, 0xf8,
+		0x26, 0x85, 0x1a, 0xcd, 0x3c, 0xc2, 0x9d, 0x47, 0xcf, 0xe0, 0x49, 0x6a, 0xf4, 0x72, 0x05, 0x2b,
+		0xd6, 0x91, 0x84, 0x5f, 0x0f, 0x92, 0x17, 0x90, 0x06, 0x6a, 0xda, 0xc4, 0x91, 0xba, 0x59, 0x63,
+		0x74, 0x21, 0xe1, 0xea, 0xd2, 0x0c, 0x59, 0x64, 0x86, 0x14, 0x37, 0x8b, 0xe8, 0x25, 0xc8, 0xb3,
+		0xa5, 0x62, 0xba, 0xa3, 0x25, 0xf4, 0x16, 0xcc, 0x4f, 0xdb, 0x55, 0xf5, 0xad, 0xa9, 0x62, 0x5d,
+		0x4a, 0xf3, 0x0c, 0xe8, 0x39, 0x3c, 0x4d, 0x4d, 0xda, 0xa0, 0xfe, 0x24, 0xe8, 0x39, 0xf4, 0x04,
+		0x1e, 0x4d, 0xa1, 0x27, 0x6b, 0xa4, 0x7f, 0x2b, 0xd0, 0x14, 0x71, 0x19, 0x3d, 0x86, 0xbd, 0x29,
+		0x44, 0xde, 0x85, 0x96, 0x61, 0x6a, 0xf2, 0xeb, 0x93, 0x70, 0xb8, 0xac, 0x19, 0xa6, 0xb8, 0x82,
+		0x7e, 0x0a, 0x3f, 0x9e, 0x42, 0xeb, 0x2d, 0x96, 0xfd, 0xa1, 0xe2, 0x44, 0x8b, 0x31, 0x58, 0x0d,
+		0xab, 0xe2, 0xea, 0x0c, 0x7b, 0x62, 0x68, 0x2f, 0xd3, 0x33, 0xb7, 0x86, 0x64, 0x78, 0x31, 0x53,
+		0x8b, 0xc8, 0x87, 0x5a, 0x59, 0x19, 0xef, 0x44, 0x44, 0x8f, 0x60, 0x77, 0x8a, 0x93, 0x83, 0x0a,
+		0x96, 0xd5, 0xe8, 0xc4, 0xea, 0x89, 0xc4, 0x3a, 0xfa, 0x06, 0x1e, 0x4e, 0x23, 0x49, 0x5a, 0xb9,
+		0xf2, 0x46, 0xc5, 0xc3, 0x3c, 0xc4, 0x8e, 0xd1, 0xd9, 0x96, 0xae, 0xe9, 0xd5, 0x9a, 0x69, 0x19,
+		0xda, 0x77, 0xaa, 0xb8, 0xc1, 0x8e, 0xd1, 0xd4, 0x9d, 0x8a, 0x73, 0x25, 0x5e, 0x1e, 0x15, 0xe3,
+		0x91, 0x49, 0xf6, 0x35, 0x5d, 0xc2, 0x27, 0xe2, 0x66, 0x4a, 0xed, 0x8d, 0x0a, 0xdd, 0x40, 0x09,
+		0x7d, 0x36, 0xcb, 0x72, 0x54, 0x09, 0xcb, 0x87, 0xc9, 0x8c, 0x6f, 0xb1, 0x53, 0xe7, 0x16, 0xff,
+		0x61, 0x65, 0xe4, 0x5e, 0x95, 0x94, 0xf8, 0x3d, 0x78, 0x10, 0xee, 0xdb, 0x98, 0x2a, 0x98, 0xa0,
+		0xf6, 0xfb, 0xf0, 0x93, 0xd9, 0x28, 0xbd, 0x71, 0xa9, 0x8c, 0x55, 0x49, 0x39, 0xe9, 0x5d, 0x49,
+		0x85, 0xe2, 0x5f, 0x05, 0x28, 0xca, 0xb6, 0x5b, 0x27, 0xad, 0xf8, 0x77, 0xd7, 0xa9, 0x51, 0x3e,
+		0x83, 0x27, 0x33, 0xf4, 0xfb, 0x84, 0x78, 0x8f, 0xc1, 0xf8, 0x54, 0x72, 0x4d, 0x7f, 0xad, 0x57,
+		0x8e, 0xf5, 0x69, 0x84, 0x68, 0x11, 0x86, 0x73, 0xee, 0xda, 0x33, 0x2f, 0x22, 0x2a, 0xbb, 0xff,
+		0x6c, 0x11, 0x9f, 0x4a, 0x9e, 0x6d, 0x11, 0xdf, 0x0b, 0x90, 0xe7, 0x6f, 0xf2, 0x81, 0x5a, 0x89,
+		0xde, 0x08, 0xf7, 0xe0, 0x76, 0x58, 0xf7, 0x43, 0x1b, 0x3e, 0xf2, 0x56, 0xb8, 0x0b, 0x85, 0x69,
+		0xc0, 0xf0, 0x1d, 0x23, 0x0a, 0xac, 0x69, 0xa6, 0xe1, 0xfa, 0x4f, 0x82, 0x0c, 0xda, 0x81, 0x2f,
+		0xa6, 0xbb, 0x94, 0x4c, 0x53, 0x92, 0x0f, 0xc5, 0xec, 0xfe, 0x1b, 0xd8, 0xaa, 0x7b, 0xed, 0x71,
+		0x3f, 0x44, 0xec, 0x2f, 0x4a, 0x1d, 0xa7, 0xca, 0x1e, 0xe1, 0x55, 0xe1, 0xbb, 0xdd, 0x73, 0x87,
+		0x36, 0xbb, 0xa7, 0xa5, 0xba, 0xd7, 0xde, 0x1d, 0xf8, 0x17, 0x6a, 0xe9, 0x9c, 0xb8, 0xe1, 0x3f,
+		0x64, 0xa3, 0xff, 0xa6, 0x3e, 0xb3, 0x3b, 0xce, 0xfb, 0xbd, 0xd3, 0x05, 0x6e, 0x7b, 0xf4, 0xef,
+		0x01, 0x00, 0xf2, 0xda, 0xa6, 0xd9, 0x0d, 0x1e, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -477,6 +477,40 @@ func (SignalExternalWorkflowExecutionFailedCause) EnumDescriptor() ([]byte, []in
 	return fileDescriptor_2775eefb5053680f, []int{11}
 }
 
+type ResetChildWorkflowPolicy int32
+
+const (
+	ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_INVALID ResetChildWorkflowPolicy = 0
+	// Reject means failing the reset if child workflows are still running at the reset point.
+	ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_REJECT ResetChildWorkflowPolicy = 1
+	// Terminate means terminating the child workflows still running at the reset point.
+	ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_TERMINATE ResetChildWorkflowPolicy = 2
+	// Reattach means keeping the child workflows still running at the reset point as children of the reset run.
+	ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_REATTACH ResetChildWorkflowPolicy = 3
+)
+
+var ResetChildWorkflowPolicy_name = map[int32]string{
+	0: "RESET_CHILD_WORKFLOW_POLICY_INVALID",
+	1: "RESET_CHILD_WORKFLOW_POLICY_REJECT",
+	2: "RESET_CHILD_WORKFLOW_POLICY_TERMINATE",
+	3: "RESET_CHILD_WORKFLOW_POLICY_REATTACH",
+}
+
+var ResetChildWorkflowPolicy_value = map[string]int32{
+	"RESET_CHILD_WORKFLOW_POLICY_INVALID":   0,
+	"RESET_CHILD_WORKFLOW_POLICY_REJECT":    1,
+	"RESET_CHILD_WORKFLOW_POLICY_TERMINATE": 2,
+	"RESET_CHILD_WORKFLOW_POLICY_REATTACH":  3,
+}
+
+func (x ResetChildWorkflowPolicy) String() string {
+	return proto.EnumName(ResetChildWorkflowPolicy_name, int32(x))
+}
+
+func (ResetChildWorkflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2775eefb5053680f, []int{12}
+}
+
 type WorkflowExecutionInfo struct {
 	WorkflowExecution    *WorkflowExecution           `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	Type                 *WorkflowType                `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -1320,6 +1354,7 @@ func init() {
 	proto.RegisterEnum("uber.cadence.api.v1.ChildWorkflowExecutionFailedCause", ChildWorkflowExecutionFailedCause_name, ChildWorkflowExecutionFailedCause_value)
 	proto.RegisterEnum("uber.cadence.api.v1.CancelExternalWorkflowExecutionFailedCause", CancelExternalWorkflowExecutionFailedCause_name, CancelExternalWorkflowExecutionFailedCause_value)
 	proto.RegisterEnum("uber.cadence.api.v1.SignalExternalWorkflowExecutionFailedCause", SignalExternalWorkflowExecutionFailedCause_name, SignalExternalWorkflowExecutionFailedCause_value)
+	proto.RegisterEnum("uber.cadence.api.v1.ResetChildWorkflowPolicy", ResetChildWorkflowPolicy_name, ResetChildWorkflowPolicy_value)
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "uber.cadence.api.v1.WorkflowExecutionInfo")
 	proto.RegisterType((*WorkflowExecutionConfiguration)(nil), "uber.cadence.api.v1.WorkflowExecutionConfiguration")
 	proto.RegisterType((*ParentExecutionInfo)(nil), "uber.cadence.api.v1.ParentExecutionInfo")
@@ -1337,151 +1372,154 @@ func init() {
 }
 
 var fileDescriptor_2775eefb5053680f = []byte{
-	// 2302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0x6b, 0x25, 0xd9, 0x38, 0x8e, 0xb6,
-	0x49, 0x1c, 0x75, 0x23, 0xaf, 0x93, 0xcd, 0xa6, 0xd9, 0x34, 0x4d, 0x69, 0x92, 0x8e, 0x99, 0xc8,
-	0x94, 0x3a, 0xa2, 0xe2, 0x78, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x92, 0x4a,
-	0xe2, 0x7b, 0x81, 0x9e, 0x7b, 0x2b, 0xda, 0x4b, 0xff, 0x80, 0x02, 0x45, 0xff, 0x80, 0xa2, 0x45,
-	0x0f, 0x45, 0x2f, 0x05, 0x7a, 0xea, 0xb1, 0x28, 0x72, 0xeb, 0x7f, 0x51, 0xcc, 0x70, 0x28, 0x51,
-	0x12, 0x25, 0x2a, 0x2d, 0xb0, 0xbd, 0x99, 0x8f, 0xdf, 0xf7, 0xf1, 0xcd, 0x9b, 0xf7, 0x3e, 0x0e,
-	0x2d, 0x28, 0x74, 0x4f, 0x88, 0xb7, 0xd3, 0xb0, 0x9a, 0xc4, 0x69, 0x90, 0x1d, 0xab, 0x63, 0xef,
-	0xbc, 0xdd, 0xdd, 0x79, 0xe7, 0x7a, 0x6f, 0x4e, 0x5b, 0xee, 0xbb, 0x52, 0xc7, 0x73, 0x03, 0x17,
-	0xad, 0x51, 0x4c, 0x89, 0x63, 0x4a, 0x56, 0xc7, 0x2e, 0xbd, 0xdd, 0xbd, 0xba, 0x79, 0xe6, 0xba,
-	0x67, 0x2d, 0xb2, 0xc3, 0x20, 0x27, 0xdd, 0xd3, 0x9d, 0x66, 0xd7, 0xb3, 0x02, 0xdb, 0x75, 0x42,
-	0xd2, 0xd5, 0x1b, 0xc3, 0xf7, 0x03, 0xbb, 0x4d, 0xfc, 0xc0, 0x6a, 0x77, 0x38, 0x60, 0x2b, 0xe9,
-	0xc9, 0x0d, 0xb7, 0xdd, 0xee, 0x49, 0x24, 0xe6, 0x16, 0x58, 0xfe, 0x9b, 0x96, 0xed, 0x07, 0x21,
-	0xa6, 0xf0, 0xa7, 0x39, 0x58, 0x3f, 0xe2, 0xe9, 0xaa, 0xef, 0x49, 0xa3, 0x4b, 0x53, 0xd0, 0x9c,
-	0x53, 0x17, 0xd5, 0x01, 0x45, 0xeb, 0x30, 0x49, 0x74, 0x27, 0x2f, 0x6c, 0x09, 0xdb, 0xb9, 0xfb,
-	0xb7, 0x4b, 0x09, 0x4b, 0x2a, 0x8d, 0xe8, 0xe0, 0xd5, 0x77, 0xc3, 0x21, 0xf4, 0x10, 0x66, 0x82,
-	0x8b, 0x0e, 0xc9, 0x67, 0x98, 0xd0, 0xcd, 0x89, 0x42, 0xc6, 0x45, 0x87, 0x60, 0x06, 0x47, 0x8f,
-	0x01, 0xfc, 0xc0, 0xf2, 0x02, 0x93, 0x96, 0x21, 0x9f, 0x65, 0xe4, 0xab, 0xa5, 0xb0, 0x46, 0xa5,
-	0xa8, 0x46, 0x25, 0x23, 0xaa, 0x11, 0x5e, 0x60, 0x68, 0x7a, 0x4d, 0xa9, 0x8d, 0x96, 0xeb, 0x93,
-	0x90, 0x3a, 0x93, 0x4e, 0x65, 0x68, 0x46, 0x35, 0x60, 0x31, 0xa4, 0xfa, 0x81, 0x15, 0x74, 0xfd,
-	0xfc, 0xec, 0x96, 0xb0, 0xbd, 0x7c, 0x7f, 0x77, 0xba, 0xd5, 0xcb, 0x94, 0x59, 0x63, 0x44, 0x9c,
-	0x6b, 0xf4, 0x2f, 0xd0, 0x2d, 0x58, 0x3e, 0xb7, 0xfd, 0xc0, 0xf5, 0x2e, 0xcc, 0x16, 0x71, 0xce,
-	0x82, 0xf3, 0xfc, 0xdc, 0x96, 0xb0, 0x9d, 0xc5, 0x4b, 0x3c, 0x5a, 0x66, 0x41, 0xf4, 0x13, 0x58,
-	0xef, 0x58, 0x1e, 0x71, 0x82, 0x7e, 0xf9, 0x4d, 0xdb, 0x39, 0x75, 0xf3, 0x97, 0xd8, 0x12, 0xb6,
-	0x13, 0xb3, 0xa8, 0x32, 0xc6, 0xc0, 0x4e, 0xe2, 0xb5, 0xce, 0x68, 0x10, 0x49, 0xb0, 0xdc, 0x97,
-	0x65, 0x95, 0x99, 0x4f, 0xad, 0xcc, 0x52, 0x8f, 0xc1, 0xaa, 0x73, 0x0f, 0x66, 0xda, 0xa4, 0xed,
-	0xe6, 0x17, 0x18, 0xf1, 0x4a, 0x62, 0x3e, 0x87, 0xa4, 0xed, 0x62, 0x06, 0x43, 0x18, 0x56, 0x7d,
-	0x62, 0x79, 0x8d, 0x73, 0xd3, 0x0a, 0x02, 0xcf, 0x3e, 0xe9, 0x06, 0xc4, 0xcf, 0x03, 0xe3, 0xde,
-	0x4a, 0xe4, 0xd6, 0x18, 0x5a, 0xea, 0x81, 0xb1, 0xe8, 0x0f, 0x45, 0x50, 0x19, 0x56, 0xad, 0x6e,
-	0xe0, 0x9a, 0x1e, 0xf1, 0x49, 0x60, 0x76, 0x5c, 0xdb, 0x09, 0xfc, 0x7c, 0x8e, 0x69, 0x6e, 0x25,
-	0x6a, 0x62, 0x0a, 0xac, 0x32, 0x1c, 0x5e, 0xa1, 0xd4, 0x58, 0x00, 0x5d, 0x83, 0x05, 0x3a, 0x1e,
-	0x26, 0x9d, 0x8f, 0xfc, 0xe2, 0x96, 0xb0, 0xbd, 0x80, 0xe7, 0x69, 0xa0, 0x6c, 0xfb, 0x01, 0xda,
-	0x80, 0x4b, 0xb6, 0x6f, 0x36, 0x3c, 0xd7, 0xc9, 0x2f, 0x6d, 0x09, 0xdb, 0xf3, 0x78, 0xce, 0xf6,
-	0x65, 0xcf, 0x75, 0x0a, 0xbf, 0xcc, 0xc0, 0xe6, 0xe8, 0xe6, 0xbb, 0xce, 0xa9, 0x7d, 0xc6, 0x47,
-	0x1a, 0x7d, 0x1d, 0x17, 0x0e, 0x47, 0xe8, 0x7a, 0x62, 0x7a, 0x06, 0x7f, 0x5a, 0xec, 0xb9, 0x16,
-	0x6c, 0xf5, 0x37, 0x8a, 0xcf, 0x80, 0x6b, 0xf6, 0x3b, 0xda, 0xed, 0x06, 0x7c, 0x98, 0xae, 0x8c,
-	0x6c, 0x9d, 0xc2, 0x13, 0xc0, 0x9f, 0xf6, 0x24, 0x6a, 0x6c, 0x2e, 0x5c, 0x39, 0xea, 0x71, 0xb7,
-	0x1b, 0xa0, 0x23, 0xb8, 0xc6, 0xd2, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0xa0, 0xec, 0x04, 0xe1,
-	0xc2, 0xdf, 0x04, 0x58, 0x4b, 0xe8, 0x48, 0x5a, 0xe8, 0xa6, 0xdb, 0xb6, 0x6c, 0xc7, 0xb4, 0x9b,
-	0xac, 0x1e, 0x0b, 0x78, 0x3e, 0x0c, 0x68, 0x4d, 0x74, 0x03, 0x72, 0xfc, 0xa6, 0x63, 0xb5, 0x43,
-	0xa3, 0x58, 0xc0, 0x10, 0x86, 0x74, 0xab, 0x4d, 0xc6, 0x38, 0x53, 0xf6, 0x7f, 0x75, 0xa6, 0x9b,
-	0xb0, 0x68, 0x3b, 0x76, 0x60, 0x5b, 0x01, 0x69, 0xd2, 0xbc, 0x66, 0xd8, 0x50, 0xe6, 0x7a, 0x31,
-	0xad, 0x59, 0xf8, 0x85, 0x00, 0xeb, 0xea, 0xfb, 0x80, 0x78, 0x8e, 0xd5, 0xfa, 0x56, 0xdc, 0x72,
-	0x38, 0xa7, 0xcc, 0x68, 0x4e, 0xff, 0x9c, 0x85, 0xb5, 0x2a, 0x71, 0x9a, 0xb6, 0x73, 0x26, 0x35,
-	0x02, 0xfb, 0xad, 0x1d, 0x5c, 0xb0, 0x8c, 0x6e, 0x40, 0xce, 0xe2, 0xd7, 0xfd, 0x2a, 0x43, 0x14,
-	0xd2, 0x9a, 0x68, 0x1f, 0x96, 0x7a, 0x80, 0x54, 0x4b, 0x8e, 0xa4, 0x99, 0x25, 0x2f, 0x5a, 0xb1,
-	0x2b, 0xf4, 0x0c, 0x66, 0xa9, 0x3d, 0x86, 0xae, 0xbc, 0x7c, 0xff, 0x6e, 0xb2, 0x2f, 0x0d, 0x66,
-	0x48, 0x9d, 0x90, 0xe0, 0x90, 0x87, 0x34, 0x58, 0x3d, 0x27, 0x96, 0x17, 0x9c, 0x10, 0x2b, 0x30,
-	0x9b, 0x24, 0xb0, 0xec, 0x96, 0xcf, 0x7d, 0xfa, 0xd3, 0x31, 0x26, 0x77, 0xd1, 0x72, 0xad, 0x26,
-	0x16, 0x7b, 0x34, 0x25, 0x64, 0xa1, 0x17, 0xb0, 0xd6, 0xb2, 0xfc, 0xc0, 0xec, 0xeb, 0x31, 0x6b,
-	0x9b, 0x4d, 0xb5, 0xb6, 0x55, 0x4a, 0x3b, 0x88, 0x58, 0xcc, 0xde, 0xf6, 0x81, 0x05, 0xc3, 0xa9,
-	0x20, 0xcd, 0x50, 0x69, 0x2e, 0x55, 0x69, 0x85, 0x92, 0x6a, 0x21, 0x87, 0xe9, 0xe4, 0xe1, 0x92,
-	0x15, 0x04, 0xa4, 0xdd, 0x09, 0x98, 0x73, 0xcf, 0xe2, 0xe8, 0x12, 0xdd, 0x05, 0xb1, 0x6d, 0xbd,
-	0xb7, 0xdb, 0xdd, 0xb6, 0xc9, 0x43, 0x3e, 0x73, 0xe1, 0x59, 0xbc, 0xc2, 0xe3, 0x12, 0x0f, 0x53,
-	0xbb, 0xf6, 0x1b, 0xe7, 0xa4, 0xd9, 0x6d, 0x45, 0x99, 0x2c, 0xa4, 0xdb, 0x75, 0x8f, 0xc1, 0xf2,
-	0x90, 0x61, 0x85, 0xbc, 0xef, 0xd8, 0xe1, 0xcc, 0x86, 0x1a, 0x90, 0xaa, 0xb1, 0xdc, 0xa7, 0x30,
-	0x91, 0x67, 0xb0, 0xc8, 0x8a, 0x72, 0x6a, 0xd9, 0xad, 0xae, 0x47, 0xb8, 0xd7, 0x26, 0x6f, 0xd3,
-	0x7e, 0x88, 0xc1, 0x39, 0xca, 0xe0, 0x17, 0xe8, 0x0b, 0xb8, 0xcc, 0x04, 0x68, 0xaf, 0x13, 0xcf,
-	0xb4, 0x9b, 0xc4, 0x09, 0xec, 0xe0, 0x82, 0xdb, 0x2d, 0xa2, 0xf7, 0x8e, 0xd8, 0x2d, 0x8d, 0xdf,
-	0x29, 0xfc, 0x3a, 0x03, 0x57, 0x78, 0xfb, 0xc8, 0xe7, 0x76, 0xab, 0xf9, 0xad, 0x0c, 0xde, 0xe7,
-	0x31, 0x59, 0x3a, 0x1c, 0x71, 0x2f, 0x12, 0xdf, 0xc5, 0xce, 0x27, 0xcc, 0x91, 0x86, 0xc7, 0x34,
-	0x3b, 0x32, 0xa6, 0xe8, 0x15, 0xf0, 0xd7, 0x30, 0x37, 0xd7, 0x8e, 0xdb, 0xb2, 0x1b, 0x17, 0xac,
-	0xcd, 0x97, 0xc7, 0x24, 0x1a, 0x3a, 0x27, 0x33, 0xd4, 0x2a, 0x43, 0xe3, 0xd5, 0xce, 0x70, 0xa8,
-	0xf0, 0x97, 0x4c, 0x6f, 0xfc, 0x15, 0xd2, 0xb0, 0xfd, 0xa8, 0x2e, 0xbd, 0xa9, 0x14, 0xd2, 0xa7,
-	0x32, 0x22, 0x0e, 0x4c, 0xe5, 0x68, 0xc7, 0x65, 0x3e, 0xb6, 0xe3, 0x9e, 0xc2, 0xe2, 0xc0, 0xf0,
-	0xa4, 0x1f, 0xdb, 0x72, 0x7e, 0xf2, 0xe0, 0xcc, 0x0c, 0x0e, 0x0e, 0x86, 0x0d, 0xd7, 0xb3, 0xcf,
-	0x6c, 0xc7, 0x6a, 0x99, 0x43, 0x49, 0xa6, 0x8f, 0xfa, 0x7a, 0x44, 0xad, 0xc5, 0x93, 0x2d, 0xfc,
-	0x21, 0x03, 0x57, 0x22, 0x7b, 0x2a, 0xbb, 0x0d, 0xab, 0xa5, 0xd8, 0x7e, 0xc7, 0x0a, 0x1a, 0xe7,
-	0xd3, 0xb9, 0xe9, 0xff, 0xbf, 0x5c, 0x3f, 0x85, 0xcd, 0xc1, 0x0c, 0x4c, 0xf7, 0xd4, 0x0c, 0xce,
-	0x6d, 0xdf, 0x8c, 0x57, 0x71, 0xb2, 0xe0, 0xd5, 0x81, 0x8c, 0x2a, 0xa7, 0xc6, 0xb9, 0xed, 0x73,
-	0x0f, 0x42, 0xd7, 0x01, 0xd8, 0x29, 0x21, 0x70, 0xdf, 0x10, 0x87, 0xd5, 0x79, 0x11, 0xb3, 0x63,
-	0x8d, 0x41, 0x03, 0x85, 0x17, 0x90, 0x8b, 0x9f, 0xa5, 0x9e, 0xc0, 0x1c, 0x3f, 0x8e, 0x09, 0x5b,
-	0xd9, 0xed, 0xdc, 0xfd, 0xcf, 0x52, 0x8e, 0x63, 0xec, 0xa4, 0xca, 0x29, 0x85, 0xdf, 0x65, 0x60,
-	0x79, 0xf0, 0x16, 0xba, 0x03, 0x2b, 0x27, 0xb6, 0x63, 0x79, 0x17, 0x66, 0xe3, 0x9c, 0x34, 0xde,
-	0xf8, 0xdd, 0x36, 0xdf, 0x84, 0xe5, 0x30, 0x2c, 0xf3, 0x28, 0x5a, 0x87, 0x39, 0xaf, 0xeb, 0x44,
-	0x2f, 0xcb, 0x05, 0x3c, 0xeb, 0x75, 0xe9, 0xa9, 0xe2, 0x29, 0x5c, 0x3b, 0xb5, 0x3d, 0x9f, 0xbe,
-	0x60, 0xc2, 0x66, 0x37, 0x1b, 0x6e, 0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0x67, 0x90, 0x68, 0x1c,
-	0xe4, 0x08, 0xc0, 0xe8, 0x8b, 0x0d, 0x8f, 0x58, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x8e, 0xe3, 0xb9,
-	0x6d, 0x2e, 0x31, 0x23, 0xb5, 0x9d, 0xb3, 0x69, 0xdb, 0x74, 0x31, 0x22, 0x30, 0x81, 0x4d, 0x00,
-	0x76, 0xc6, 0x0d, 0xac, 0x93, 0x56, 0xf8, 0x16, 0x9a, 0xc7, 0xb1, 0x48, 0xf1, 0xf7, 0x02, 0x5c,
-	0x4e, 0x7a, 0xc7, 0xa2, 0x02, 0x6c, 0x56, 0x55, 0x5d, 0xd1, 0xf4, 0xe7, 0xa6, 0x24, 0x1b, 0xda,
-	0x2b, 0xcd, 0x38, 0x36, 0x6b, 0x86, 0x64, 0xa8, 0xa6, 0xa6, 0xbf, 0x92, 0xca, 0x9a, 0x22, 0x7e,
-	0x07, 0x7d, 0x17, 0xb6, 0xc6, 0x60, 0x6a, 0xf2, 0x81, 0xaa, 0xd4, 0xcb, 0xaa, 0x22, 0x0a, 0x13,
-	0x94, 0x6a, 0x86, 0x84, 0x0d, 0x55, 0x11, 0x33, 0xe8, 0x7b, 0x70, 0x67, 0x0c, 0x46, 0x96, 0x74,
-	0x59, 0x2d, 0x9b, 0x58, 0xfd, 0x71, 0x5d, 0xad, 0x51, 0x70, 0xb6, 0xf8, 0xb3, 0x7e, 0xce, 0x03,
-	0x0e, 0x14, 0x7f, 0x92, 0xa2, 0xca, 0x5a, 0x4d, 0xab, 0xe8, 0x93, 0x72, 0x1e, 0xc2, 0x8c, 0xc9,
-	0x79, 0x18, 0x15, 0xe5, 0x5c, 0xfc, 0x79, 0xa6, 0xff, 0x09, 0xac, 0x35, 0x31, 0xe9, 0x46, 0xde,
-	0x4a, 0x9f, 0x71, 0x54, 0xc1, 0x2f, 0xf7, 0xcb, 0x95, 0x23, 0x53, 0x53, 0x4c, 0xac, 0xd6, 0x6b,
-	0xaa, 0x59, 0xad, 0x94, 0x35, 0xf9, 0x38, 0x96, 0xc9, 0xf7, 0xe1, 0xcb, 0xb1, 0x28, 0xa9, 0x4c,
-	0xa3, 0x4a, 0xbd, 0x5a, 0xd6, 0x64, 0xfa, 0xd4, 0x7d, 0x49, 0x2b, 0xab, 0x8a, 0x59, 0xd1, 0xcb,
-	0xc7, 0xa2, 0x80, 0x3e, 0x87, 0xed, 0x69, 0x99, 0x62, 0x06, 0xdd, 0x83, 0xbb, 0x63, 0xd1, 0x58,
-	0x7d, 0xa1, 0xca, 0x46, 0x0c, 0x9e, 0x45, 0xbb, 0x70, 0x6f, 0x2c, 0xdc, 0x50, 0xf1, 0xa1, 0xa6,
-	0xb3, 0x82, 0xee, 0x9b, 0xb8, 0xae, 0xeb, 0x9a, 0xfe, 0x5c, 0x9c, 0x29, 0xfe, 0x46, 0x80, 0xd5,
-	0x91, 0x97, 0x0e, 0xba, 0x01, 0xd7, 0xaa, 0x12, 0x56, 0x75, 0xc3, 0x94, 0xcb, 0x95, 0xa4, 0x02,
-	0x8c, 0x01, 0x48, 0x7b, 0x92, 0xae, 0x54, 0x74, 0x51, 0x40, 0xb7, 0xa1, 0x90, 0x04, 0xe0, 0xbd,
-	0xc0, 0x5b, 0x43, 0xcc, 0xa0, 0x9b, 0x70, 0x3d, 0x09, 0xd7, 0xcb, 0x56, 0xcc, 0x16, 0xff, 0x9d,
-	0x81, 0x4f, 0x27, 0x7d, 0x69, 0xd3, 0x0e, 0xec, 0x2d, 0x5b, 0x7d, 0xad, 0xca, 0x75, 0x83, 0xee,
-	0x79, 0xa8, 0x47, 0x77, 0xbe, 0x5e, 0x8b, 0x65, 0x1e, 0x2f, 0xe9, 0x18, 0xb0, 0x5c, 0x39, 0xac,
-	0x96, 0x55, 0x83, 0x75, 0x53, 0x11, 0x6e, 0xa7, 0xc1, 0xc3, 0x0d, 0x16, 0x33, 0x03, 0x7b, 0x3b,
-	0x4e, 0x9a, 0xad, 0x9b, 0x8e, 0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x22, 0xce, 0xa0,
-	0x2f, 0xe1, 0x8b, 0xf4, 0xc4, 0x75, 0x43, 0xd3, 0xeb, 0xaa, 0x62, 0x4a, 0x35, 0x53, 0x57, 0x8f,
-	0xc4, 0xd9, 0x69, 0x96, 0x6b, 0x68, 0x87, 0xb4, 0x3f, 0xeb, 0x86, 0x38, 0x57, 0xfc, 0xa3, 0x00,
-	0x9f, 0xc8, 0xae, 0x13, 0xd8, 0x4e, 0x97, 0x48, 0xbe, 0x4e, 0xde, 0x69, 0xe1, 0x79, 0xc6, 0xf5,
-	0xd0, 0x2d, 0xb8, 0x19, 0xe9, 0x73, 0x79, 0x53, 0xd3, 0x35, 0x43, 0x93, 0x8c, 0x0a, 0x8e, 0xd5,
-	0x77, 0x22, 0x8c, 0x0e, 0xa4, 0xa2, 0xe2, 0xb0, 0xae, 0xe3, 0x61, 0x58, 0x35, 0xf0, 0x31, 0x6f,
-	0x85, 0xd0, 0x61, 0xc6, 0x63, 0x65, 0x4c, 0xe7, 0x9b, 0xcf, 0xbf, 0x98, 0x2d, 0xfe, 0x56, 0x80,
-	0x1c, 0xff, 0x16, 0x65, 0x9f, 0x2a, 0x79, 0xb8, 0x4c, 0x17, 0x58, 0xa9, 0x1b, 0xa6, 0x71, 0x5c,
-	0x55, 0x07, 0x7b, 0x78, 0xe0, 0x0e, 0xb3, 0x07, 0xd3, 0xa8, 0x84, 0xd5, 0x09, 0x9d, 0x64, 0x10,
-	0xc0, 0x9f, 0x42, 0x31, 0x0c, 0x2c, 0x66, 0x26, 0x62, 0x42, 0x9d, 0x2c, 0xba, 0x0a, 0x9f, 0x0c,
-	0x60, 0x0e, 0x54, 0x09, 0x1b, 0x7b, 0xaa, 0x64, 0x88, 0x33, 0xc5, 0x5f, 0x09, 0x70, 0x25, 0x72,
-	0x42, 0x83, 0xbe, 0x58, 0xed, 0x36, 0x69, 0x56, 0xba, 0x81, 0x6c, 0x75, 0x7d, 0x82, 0xee, 0xc2,
-	0xad, 0x9e, 0x87, 0x19, 0x52, 0xed, 0x65, 0x7f, 0xaf, 0x4c, 0x59, 0xa2, 0xc3, 0xdd, 0x5f, 0x4d,
-	0x2a, 0x94, 0xa7, 0x20, 0x0a, 0xe8, 0x0e, 0x7c, 0x36, 0x19, 0x8a, 0xd5, 0x9a, 0x6a, 0x88, 0x99,
-	0xe2, 0x3f, 0x72, 0xb0, 0x11, 0x4f, 0x8e, 0x1e, 0xe8, 0x49, 0x33, 0x4c, 0xed, 0x36, 0x14, 0x06,
-	0x45, 0xb8, 0xcf, 0x0d, 0xe7, 0xb5, 0x0b, 0xf7, 0x26, 0xe0, 0xea, 0xfa, 0x81, 0xa4, 0x2b, 0xf4,
-	0x3a, 0x02, 0x89, 0x02, 0x7a, 0x06, 0x4f, 0x26, 0x50, 0xf6, 0x24, 0xa5, 0x5f, 0xe5, 0xde, 0x1b,
-	0x47, 0x32, 0x0c, 0xac, 0xed, 0xd5, 0x0d, 0xb5, 0x26, 0x66, 0x90, 0x0a, 0x52, 0x8a, 0xc0, 0xa0,
-	0x0f, 0x25, 0xca, 0x64, 0xd1, 0x63, 0x78, 0x98, 0x96, 0x47, 0xd8, 0x32, 0xda, 0xa1, 0x8a, 0xe3,
-	0xd4, 0x19, 0xf4, 0x35, 0x7c, 0x95, 0x42, 0xe5, 0x4f, 0x1e, 0xe1, 0xce, 0xa2, 0x27, 0xf0, 0x28,
-	0x35, 0x7b, 0xb9, 0x82, 0x15, 0xf3, 0x50, 0xc2, 0x2f, 0x07, 0xc9, 0x73, 0x48, 0x03, 0x35, 0xed,
-	0xc1, 0xdc, 0xdd, 0xcc, 0x04, 0x5f, 0x88, 0x49, 0x5d, 0x9a, 0xa2, 0x8a, 0x34, 0x90, 0x22, 0x33,
-	0x8f, 0x9e, 0x83, 0x3c, 0x5d, 0x29, 0x26, 0x0b, 0x2d, 0xa0, 0xd7, 0x60, 0x7c, 0xdc, 0xae, 0xaa,
-	0xaf, 0x0d, 0x15, 0xeb, 0x52, 0x9a, 0x32, 0xa0, 0xa7, 0xf0, 0x38, 0xb5, 0x68, 0x83, 0xfe, 0x13,
-	0xa3, 0xe7, 0xd0, 0x23, 0x78, 0x30, 0x81, 0x1e, 0xef, 0x91, 0xfe, 0xa9, 0x40, 0x53, 0xc4, 0x45,
-	0xf4, 0x10, 0x76, 0x27, 0x10, 0xd9, 0x14, 0x9a, 0x35, 0x43, 0x93, 0x5f, 0x1e, 0x87, 0xb7, 0xcb,
-	0x5a, 0xcd, 0x10, 0x97, 0xd0, 0x8f, 0xe0, 0x07, 0x13, 0x68, 0xbd, 0xc5, 0xd2, 0x3f, 0x54, 0x1c,
-	0x1b, 0x31, 0x0a, 0xab, 0x63, 0x55, 0x5c, 0x9e, 0x62, 0x4f, 0x6a, 0xda, 0xf3, 0xf4, 0xca, 0xad,
-	0x20, 0x19, 0x9e, 0x4d, 0x35, 0x22, 0xf2, 0x81, 0x56, 0x56, 0x92, 0x45, 0x44, 0xf4, 0x00, 0x76,
-	0x26, 0x88, 0xec, 0x57, 0xb0, 0xac, 0xf2, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x57, 0x70, 0x7f,
-	0x12, 0x49, 0xd2, 0xca, 0x95, 0x57, 0x2a, 0x1e, 0xe6, 0x21, 0xfa, 0x1a, 0x9d, 0x6e, 0xe9, 0x9a,
-	0x5e, 0xad, 0x1b, 0x66, 0x4d, 0xfb, 0x46, 0x15, 0xd7, 0xe8, 0x6b, 0x34, 0x75, 0xa7, 0xa2, 0x5a,
-	0x89, 0x97, 0x47, 0xcd, 0x78, 0xe4, 0x21, 0x7b, 0x9a, 0x2e, 0xe1, 0x63, 0x71, 0x3d, 0xa5, 0xf7,
-	0x46, 0x8d, 0x6e, 0xa0, 0x85, 0x3e, 0x99, 0x66, 0x39, 0xaa, 0x84, 0xe5, 0x83, 0x78, 0xc5, 0x37,
-	0xe8, 0x5b, 0xe7, 0x26, 0xfb, 0xc7, 0xca, 0xc8, 0xb9, 0x2a, 0x6e, 0xf1, 0xbb, 0x70, 0x2f, 0xdc,
-	0xb7, 0x84, 0x2e, 0x18, 0xe3, 0xf6, 0x7b, 0xf0, 0xc3, 0xe9, 0x28, 0xbd, 0xfb, 0x52, 0x19, 0xab,
-	0x92, 0x72, 0xdc, 0x3b, 0x92, 0x0a, 0xc5, 0x3f, 0x0b, 0x50, 0x94, 0x2d, 0xa7, 0x41, 0x5a, 0xd1,
-	0xff, 0x5d, 0x27, 0x66, 0xf9, 0x04, 0x1e, 0x4d, 0x31, 0xef, 0x63, 0xf2, 0x3d, 0x82, 0xda, 0xc7,
-	0x92, 0xeb, 0xfa, 0x4b, 0xbd, 0x72, 0xa4, 0x4f, 0x22, 0xf0, 0x45, 0xd4, 0xec, 0x33, 0xf6, 0x4f,
-	0xe3, 0xe9, 0x16, 0xc1, 0xdb, 0xee, 0xbf, 0x5b, 0xc4, 0xc7, 0x92, 0xa7, 0x5a, 0xc4, 0xde, 0xc9,
-	0x5f, 0x3f, 0x6c, 0x0a, 0x7f, 0xff, 0xb0, 0x29, 0xfc, 0xeb, 0xc3, 0xa6, 0x00, 0x1b, 0x0d, 0xb7,
-	0x9d, 0xf4, 0x45, 0xbf, 0x37, 0x2f, 0x75, 0xec, 0x2a, 0xfd, 0x9a, 0xad, 0x0a, 0xdf, 0xec, 0x9c,
-	0xd9, 0xc1, 0x79, 0xf7, 0xa4, 0xd4, 0x70, 0xdb, 0x3b, 0x03, 0xbf, 0x45, 0x96, 0xce, 0x88, 0x13,
-	0xfe, 0xb2, 0xc9, 0x7f, 0x96, 0x7c, 0x62, 0x75, 0xec, 0xb7, 0xbb, 0x27, 0x73, 0x2c, 0xf6, 0xe0,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xa4, 0xf1, 0x62, 0x56, 0x1d, 0x00, 0x00,
+	// 2345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x2f, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x87, 0x1e, 0xc7, 0xb1, 0xf2, 0xcf, 0x71, 0x94, 0x4d,
+	0xe2, 0xa8, 0x1b, 0x79, 0x9d, 0x6c, 0x36, 0xcd, 0xa6, 0x69, 0x4a, 0x93, 0x74, 0xcc, 0x44, 0xa6,
+	0xd4, 0x21, 0x15, 0xc7, 0x8b, 0xa2, 0x04, 0x2d, 0x8d, 0x2d, 0x22, 0x12, 0x29, 0x90, 0x54, 0x12,
+	0xdf, 0x0b, 0xf4, 0xdc, 0x5b, 0xd1, 0x5e, 0xfa, 0x01, 0x0a, 0x14, 0xfd, 0x00, 0x45, 0x17, 0x3d,
+	0x14, 0xbd, 0x14, 0xe8, 0xa9, 0xc7, 0xa2, 0xc8, 0xad, 0xdf, 0xa2, 0x98, 0x21, 0x29, 0x51, 0xff,
+	0x48, 0xa5, 0x05, 0xb6, 0x37, 0xf3, 0xcd, 0xef, 0xf7, 0xe6, 0xcd, 0x9b, 0xf7, 0x7e, 0x33, 0x23,
+	0x43, 0xa1, 0x7b, 0x42, 0xdc, 0x9d, 0xba, 0xd9, 0x20, 0x76, 0x9d, 0xec, 0x98, 0x1d, 0x6b, 0xe7,
+	0xdd, 0xee, 0xce, 0x7b, 0xc7, 0x7d, 0x7b, 0xda, 0x72, 0xde, 0x97, 0x3a, 0xae, 0xe3, 0x3b, 0x68,
+	0x8d, 0x62, 0x4a, 0x21, 0xa6, 0x64, 0x76, 0xac, 0xd2, 0xbb, 0xdd, 0x2b, 0x9b, 0x67, 0x8e, 0x73,
+	0xd6, 0x22, 0x3b, 0x0c, 0x72, 0xd2, 0x3d, 0xdd, 0x69, 0x74, 0x5d, 0xd3, 0xb7, 0x1c, 0x3b, 0x20,
+	0x5d, 0xb9, 0x31, 0x3c, 0xee, 0x5b, 0x6d, 0xe2, 0xf9, 0x66, 0xbb, 0x13, 0x02, 0xb6, 0xc6, 0xcd,
+	0x5c, 0x77, 0xda, 0xed, 0x9e, 0x8b, 0xb1, 0xb1, 0xf9, 0xa6, 0xf7, 0xb6, 0x65, 0x79, 0x7e, 0x80,
+	0x29, 0x7c, 0x3b, 0x07, 0xeb, 0x47, 0x61, 0xb8, 0xf2, 0x07, 0x52, 0xef, 0xd2, 0x10, 0x14, 0xfb,
+	0xd4, 0x41, 0x35, 0x40, 0xd1, 0x3a, 0x0c, 0x12, 0x8d, 0xe4, 0xb9, 0x2d, 0x6e, 0x3b, 0xf7, 0xe0,
+	0x4e, 0x69, 0xcc, 0x92, 0x4a, 0x23, 0x7e, 0xf0, 0xea, 0xfb, 0x61, 0x13, 0x7a, 0x04, 0x33, 0xfe,
+	0x79, 0x87, 0xe4, 0x33, 0xcc, 0xd1, 0xcd, 0x44, 0x47, 0xfa, 0x79, 0x87, 0x60, 0x06, 0x47, 0x4f,
+	0x00, 0x3c, 0xdf, 0x74, 0x7d, 0x83, 0xa6, 0x21, 0x9f, 0x65, 0xe4, 0x2b, 0xa5, 0x20, 0x47, 0xa5,
+	0x28, 0x47, 0x25, 0x3d, 0xca, 0x11, 0x5e, 0x60, 0x68, 0xfa, 0x4d, 0xa9, 0xf5, 0x96, 0xe3, 0x91,
+	0x80, 0x3a, 0x93, 0x4e, 0x65, 0x68, 0x46, 0xd5, 0x61, 0x31, 0xa0, 0x7a, 0xbe, 0xe9, 0x77, 0xbd,
+	0xfc, 0xec, 0x16, 0xb7, 0xbd, 0xfc, 0x60, 0x77, 0xba, 0xd5, 0x8b, 0x94, 0xa9, 0x31, 0x22, 0xce,
+	0xd5, 0xfb, 0x1f, 0xe8, 0x36, 0x2c, 0x37, 0x2d, 0xcf, 0x77, 0xdc, 0x73, 0xa3, 0x45, 0xec, 0x33,
+	0xbf, 0x99, 0x9f, 0xdb, 0xe2, 0xb6, 0xb3, 0x78, 0x29, 0xb4, 0x96, 0x99, 0x11, 0xfd, 0x14, 0xd6,
+	0x3b, 0xa6, 0x4b, 0x6c, 0xbf, 0x9f, 0x7e, 0xc3, 0xb2, 0x4f, 0x9d, 0xfc, 0x05, 0xb6, 0x84, 0xed,
+	0xb1, 0x51, 0x54, 0x19, 0x63, 0x60, 0x27, 0xf1, 0x5a, 0x67, 0xd4, 0x88, 0x04, 0x58, 0xee, 0xbb,
+	0x65, 0x99, 0x99, 0x4f, 0xcd, 0xcc, 0x52, 0x8f, 0xc1, 0xb2, 0x73, 0x1f, 0x66, 0xda, 0xa4, 0xed,
+	0xe4, 0x17, 0x18, 0xf1, 0xf2, 0xd8, 0x78, 0x0e, 0x49, 0xdb, 0xc1, 0x0c, 0x86, 0x30, 0xac, 0x7a,
+	0xc4, 0x74, 0xeb, 0x4d, 0xc3, 0xf4, 0x7d, 0xd7, 0x3a, 0xe9, 0xfa, 0xc4, 0xcb, 0x03, 0xe3, 0xde,
+	0x1e, 0xcb, 0xd5, 0x18, 0x5a, 0xe8, 0x81, 0x31, 0xef, 0x0d, 0x59, 0x50, 0x19, 0x56, 0xcd, 0xae,
+	0xef, 0x18, 0x2e, 0xf1, 0x88, 0x6f, 0x74, 0x1c, 0xcb, 0xf6, 0xbd, 0x7c, 0x8e, 0xf9, 0xdc, 0x1a,
+	0xeb, 0x13, 0x53, 0x60, 0x95, 0xe1, 0xf0, 0x0a, 0xa5, 0xc6, 0x0c, 0xe8, 0x2a, 0x2c, 0xd0, 0xf6,
+	0x30, 0x68, 0x7f, 0xe4, 0x17, 0xb7, 0xb8, 0xed, 0x05, 0x3c, 0x4f, 0x0d, 0x65, 0xcb, 0xf3, 0xd1,
+	0x06, 0x5c, 0xb0, 0x3c, 0xa3, 0xee, 0x3a, 0x76, 0x7e, 0x69, 0x8b, 0xdb, 0x9e, 0xc7, 0x73, 0x96,
+	0x27, 0xba, 0x8e, 0x5d, 0xf8, 0x55, 0x06, 0x36, 0x47, 0x37, 0xdf, 0xb1, 0x4f, 0xad, 0xb3, 0xb0,
+	0xa5, 0xd1, 0xd7, 0x71, 0xc7, 0x41, 0x0b, 0x5d, 0x1f, 0x1b, 0x9e, 0x1e, 0xce, 0x16, 0x9b, 0xd7,
+	0x84, 0xad, 0xfe, 0x46, 0x85, 0x3d, 0xe0, 0x18, 0xfd, 0x8a, 0x76, 0xba, 0x7e, 0xd8, 0x4c, 0x97,
+	0x47, 0xb6, 0x4e, 0x0a, 0x03, 0xc0, 0xd7, 0x7a, 0x2e, 0x34, 0xd6, 0x17, 0x8e, 0x18, 0xd5, 0xb8,
+	0xd3, 0xf5, 0xd1, 0x11, 0x5c, 0x65, 0xe1, 0x4d, 0xf0, 0x9e, 0x4d, 0xf3, 0xbe, 0x41, 0xd9, 0x63,
+	0x1c, 0x17, 0xfe, 0xc6, 0xc1, 0xda, 0x98, 0x8a, 0xa4, 0x89, 0x6e, 0x38, 0x6d, 0xd3, 0xb2, 0x0d,
+	0xab, 0xc1, 0xf2, 0xb1, 0x80, 0xe7, 0x03, 0x83, 0xd2, 0x40, 0x37, 0x20, 0x17, 0x0e, 0xda, 0x66,
+	0x3b, 0x10, 0x8a, 0x05, 0x0c, 0x81, 0x49, 0x35, 0xdb, 0x64, 0x82, 0x32, 0x65, 0xff, 0x57, 0x65,
+	0xba, 0x09, 0x8b, 0x96, 0x6d, 0xf9, 0x96, 0xe9, 0x93, 0x06, 0x8d, 0x6b, 0x86, 0x35, 0x65, 0xae,
+	0x67, 0x53, 0x1a, 0x85, 0x5f, 0x72, 0xb0, 0x2e, 0x7f, 0xf0, 0x89, 0x6b, 0x9b, 0xad, 0xef, 0x44,
+	0x2d, 0x87, 0x63, 0xca, 0x8c, 0xc6, 0xf4, 0xcf, 0x59, 0x58, 0xab, 0x12, 0xbb, 0x61, 0xd9, 0x67,
+	0x42, 0xdd, 0xb7, 0xde, 0x59, 0xfe, 0x39, 0x8b, 0xe8, 0x06, 0xe4, 0xcc, 0xf0, 0xbb, 0x9f, 0x65,
+	0x88, 0x4c, 0x4a, 0x03, 0xed, 0xc3, 0x52, 0x0f, 0x90, 0x2a, 0xc9, 0x91, 0x6b, 0x26, 0xc9, 0x8b,
+	0x66, 0xec, 0x0b, 0x3d, 0x87, 0x59, 0x2a, 0x8f, 0x81, 0x2a, 0x2f, 0x3f, 0xb8, 0x37, 0x5e, 0x97,
+	0x06, 0x23, 0xa4, 0x4a, 0x48, 0x70, 0xc0, 0x43, 0x0a, 0xac, 0x36, 0x89, 0xe9, 0xfa, 0x27, 0xc4,
+	0xf4, 0x8d, 0x06, 0xf1, 0x4d, 0xab, 0xe5, 0x85, 0x3a, 0x7d, 0x6d, 0x82, 0xc8, 0x9d, 0xb7, 0x1c,
+	0xb3, 0x81, 0xf9, 0x1e, 0x4d, 0x0a, 0x58, 0xe8, 0x25, 0xac, 0xb5, 0x4c, 0xcf, 0x37, 0xfa, 0xfe,
+	0x98, 0xb4, 0xcd, 0xa6, 0x4a, 0xdb, 0x2a, 0xa5, 0x1d, 0x44, 0x2c, 0x6a, 0x47, 0xfb, 0xc0, 0x8c,
+	0x41, 0x57, 0x90, 0x46, 0xe0, 0x69, 0x2e, 0xd5, 0xd3, 0x0a, 0x25, 0x69, 0x01, 0x87, 0xf9, 0xc9,
+	0xc3, 0x05, 0xd3, 0xf7, 0x49, 0xbb, 0xe3, 0x33, 0xe5, 0x9e, 0xc5, 0xd1, 0x27, 0xba, 0x07, 0x7c,
+	0xdb, 0xfc, 0x60, 0xb5, 0xbb, 0x6d, 0x23, 0x34, 0x79, 0x4c, 0x85, 0x67, 0xf1, 0x4a, 0x68, 0x17,
+	0x42, 0x33, 0x95, 0x6b, 0xaf, 0xde, 0x24, 0x8d, 0x6e, 0x2b, 0x8a, 0x64, 0x21, 0x5d, 0xae, 0x7b,
+	0x0c, 0x16, 0x87, 0x08, 0x2b, 0xe4, 0x43, 0xc7, 0x0a, 0x7a, 0x36, 0xf0, 0x01, 0xa9, 0x3e, 0x96,
+	0xfb, 0x14, 0xe6, 0xe4, 0x39, 0x2c, 0xb2, 0xa4, 0x9c, 0x9a, 0x56, 0xab, 0xeb, 0x92, 0x7c, 0x2e,
+	0x61, 0x9b, 0xf6, 0x03, 0x0c, 0xce, 0x51, 0x46, 0xf8, 0x81, 0xbe, 0x80, 0x8b, 0xcc, 0x01, 0xad,
+	0x75, 0xe2, 0x1a, 0x56, 0x83, 0xd8, 0xbe, 0xe5, 0x9f, 0x87, 0x72, 0x8b, 0xe8, 0xd8, 0x11, 0x1b,
+	0x52, 0xc2, 0x91, 0xc2, 0x6f, 0x32, 0x70, 0x39, 0x2c, 0x1f, 0xb1, 0x69, 0xb5, 0x1a, 0xdf, 0x49,
+	0xe3, 0x7d, 0x1e, 0x73, 0x4b, 0x9b, 0x23, 0xae, 0x45, 0xfc, 0xfb, 0xd8, 0xfd, 0x84, 0x29, 0xd2,
+	0x70, 0x9b, 0x66, 0x47, 0xda, 0x14, 0xbd, 0x86, 0xf0, 0x18, 0x0e, 0xc5, 0xb5, 0xe3, 0xb4, 0xac,
+	0xfa, 0x39, 0x2b, 0xf3, 0xe5, 0x09, 0x81, 0x06, 0xca, 0xc9, 0x04, 0xb5, 0xca, 0xd0, 0x78, 0xb5,
+	0x33, 0x6c, 0x2a, 0xfc, 0x25, 0xd3, 0x6b, 0x7f, 0x89, 0xd4, 0x2d, 0x2f, 0xca, 0x4b, 0xaf, 0x2b,
+	0xb9, 0xf4, 0xae, 0x8c, 0x88, 0x03, 0x5d, 0x39, 0x5a, 0x71, 0x99, 0x4f, 0xad, 0xb8, 0x67, 0xb0,
+	0x38, 0xd0, 0x3c, 0xe9, 0xd7, 0xb6, 0x9c, 0x37, 0xbe, 0x71, 0x66, 0x06, 0x1b, 0x07, 0xc3, 0x86,
+	0xe3, 0x5a, 0x67, 0x96, 0x6d, 0xb6, 0x8c, 0xa1, 0x20, 0xd3, 0x5b, 0x7d, 0x3d, 0xa2, 0x6a, 0xf1,
+	0x60, 0x0b, 0x7f, 0xcc, 0xc0, 0xe5, 0x48, 0x9e, 0xca, 0x4e, 0xdd, 0x6c, 0x49, 0x96, 0xd7, 0x31,
+	0xfd, 0x7a, 0x73, 0x3a, 0x35, 0xfd, 0xff, 0xa7, 0xeb, 0x67, 0xb0, 0x39, 0x18, 0x81, 0xe1, 0x9c,
+	0x1a, 0x7e, 0xd3, 0xf2, 0x8c, 0x78, 0x16, 0x93, 0x1d, 0x5e, 0x19, 0x88, 0xa8, 0x72, 0xaa, 0x37,
+	0x2d, 0x2f, 0xd4, 0x20, 0x74, 0x1d, 0x80, 0xdd, 0x12, 0x7c, 0xe7, 0x2d, 0xb1, 0x59, 0x9e, 0x17,
+	0x31, 0xbb, 0xd6, 0xe8, 0xd4, 0x50, 0x78, 0x09, 0xb9, 0xf8, 0x5d, 0xea, 0x29, 0xcc, 0x85, 0xd7,
+	0x31, 0x6e, 0x2b, 0xbb, 0x9d, 0x7b, 0x70, 0x2b, 0xe5, 0x3a, 0xc6, 0x6e, 0xaa, 0x21, 0xa5, 0xf0,
+	0xfb, 0x0c, 0x2c, 0x0f, 0x0e, 0xa1, 0xbb, 0xb0, 0x72, 0x62, 0xd9, 0xa6, 0x7b, 0x6e, 0xd4, 0x9b,
+	0xa4, 0xfe, 0xd6, 0xeb, 0xb6, 0xc3, 0x4d, 0x58, 0x0e, 0xcc, 0x62, 0x68, 0x45, 0xeb, 0x30, 0xe7,
+	0x76, 0xed, 0xe8, 0xb0, 0x5c, 0xc0, 0xb3, 0x6e, 0x97, 0xde, 0x2a, 0x9e, 0xc1, 0xd5, 0x53, 0xcb,
+	0xf5, 0xe8, 0x01, 0x13, 0x14, 0xbb, 0x51, 0x77, 0xda, 0x9d, 0x16, 0x19, 0xe8, 0xd8, 0x3c, 0x83,
+	0x44, 0xed, 0x20, 0x46, 0x00, 0x46, 0x5f, 0xac, 0xbb, 0xc4, 0xec, 0xed, 0x4d, 0x7a, 0x2a, 0x73,
+	0x21, 0x3e, 0x94, 0xcd, 0x25, 0x26, 0xa4, 0x96, 0x7d, 0x36, 0x6d, 0x99, 0x2e, 0x46, 0x04, 0xe6,
+	0x60, 0x13, 0x80, 0xdd, 0x71, 0x7d, 0xf3, 0xa4, 0x15, 0x9c, 0x42, 0xf3, 0x38, 0x66, 0x29, 0xfe,
+	0x81, 0x83, 0x8b, 0xe3, 0xce, 0x58, 0x54, 0x80, 0xcd, 0xaa, 0xac, 0x4a, 0x8a, 0xfa, 0xc2, 0x10,
+	0x44, 0x5d, 0x79, 0xad, 0xe8, 0xc7, 0x86, 0xa6, 0x0b, 0xba, 0x6c, 0x28, 0xea, 0x6b, 0xa1, 0xac,
+	0x48, 0xfc, 0xf7, 0xd0, 0x67, 0xb0, 0x35, 0x01, 0xa3, 0x89, 0x07, 0xb2, 0x54, 0x2b, 0xcb, 0x12,
+	0xcf, 0x25, 0x78, 0xd2, 0x74, 0x01, 0xeb, 0xb2, 0xc4, 0x67, 0xd0, 0xf7, 0xe1, 0xee, 0x04, 0x8c,
+	0x28, 0xa8, 0xa2, 0x5c, 0x36, 0xb0, 0xfc, 0x93, 0x9a, 0xac, 0x51, 0x70, 0xb6, 0xf8, 0xf3, 0x7e,
+	0xcc, 0x03, 0x0a, 0x14, 0x9f, 0x49, 0x92, 0x45, 0x45, 0x53, 0x2a, 0x6a, 0x52, 0xcc, 0x43, 0x98,
+	0x09, 0x31, 0x0f, 0xa3, 0xa2, 0x98, 0x8b, 0xbf, 0xc8, 0xf4, 0x9f, 0xc0, 0x4a, 0x03, 0x93, 0x6e,
+	0xa4, 0xad, 0x74, 0x8e, 0xa3, 0x0a, 0x7e, 0xb5, 0x5f, 0xae, 0x1c, 0x19, 0x8a, 0x64, 0x60, 0xb9,
+	0xa6, 0xc9, 0x46, 0xb5, 0x52, 0x56, 0xc4, 0xe3, 0x58, 0x24, 0x3f, 0x80, 0x2f, 0x27, 0xa2, 0x84,
+	0x32, 0xb5, 0x4a, 0xb5, 0x6a, 0x59, 0x11, 0xe9, 0xac, 0xfb, 0x82, 0x52, 0x96, 0x25, 0xa3, 0xa2,
+	0x96, 0x8f, 0x79, 0x0e, 0x7d, 0x0e, 0xdb, 0xd3, 0x32, 0xf9, 0x0c, 0xba, 0x0f, 0xf7, 0x26, 0xa2,
+	0xb1, 0xfc, 0x52, 0x16, 0xf5, 0x18, 0x3c, 0x8b, 0x76, 0xe1, 0xfe, 0x44, 0xb8, 0x2e, 0xe3, 0x43,
+	0x45, 0x65, 0x09, 0xdd, 0x37, 0x70, 0x4d, 0x55, 0x15, 0xf5, 0x05, 0x3f, 0x53, 0xfc, 0x2d, 0x07,
+	0xab, 0x23, 0x87, 0x0e, 0xba, 0x01, 0x57, 0xab, 0x02, 0x96, 0x55, 0xdd, 0x10, 0xcb, 0x95, 0x71,
+	0x09, 0x98, 0x00, 0x10, 0xf6, 0x04, 0x55, 0xaa, 0xa8, 0x3c, 0x87, 0xee, 0x40, 0x61, 0x1c, 0x20,
+	0xac, 0x85, 0xb0, 0x34, 0xf8, 0x0c, 0xba, 0x09, 0xd7, 0xc7, 0xe1, 0x7a, 0xd1, 0xf2, 0xd9, 0xe2,
+	0xbf, 0x33, 0x70, 0x2d, 0xe9, 0xa5, 0x4d, 0x2b, 0xb0, 0xb7, 0x6c, 0xf9, 0x8d, 0x2c, 0xd6, 0x74,
+	0xba, 0xe7, 0x81, 0x3f, 0xba, 0xf3, 0x35, 0x2d, 0x16, 0x79, 0x3c, 0xa5, 0x13, 0xc0, 0x62, 0xe5,
+	0xb0, 0x5a, 0x96, 0x75, 0x56, 0x4d, 0x45, 0xb8, 0x93, 0x06, 0x0f, 0x36, 0x98, 0xcf, 0x0c, 0xec,
+	0xed, 0x24, 0xd7, 0x6c, 0xdd, 0xb4, 0x15, 0x50, 0x09, 0x8a, 0x69, 0xe8, 0x5e, 0x16, 0x24, 0x7e,
+	0x06, 0x7d, 0x09, 0x5f, 0xa4, 0x07, 0xae, 0xea, 0x8a, 0x5a, 0x93, 0x25, 0x43, 0xd0, 0x0c, 0x55,
+	0x3e, 0xe2, 0x67, 0xa7, 0x59, 0xae, 0xae, 0x1c, 0xd2, 0xfa, 0xac, 0xe9, 0xfc, 0x5c, 0xf1, 0x4f,
+	0x1c, 0x5c, 0x12, 0x1d, 0xdb, 0xb7, 0xec, 0x2e, 0x11, 0x3c, 0x95, 0xbc, 0x57, 0x82, 0xfb, 0x8c,
+	0xe3, 0xa2, 0xdb, 0x70, 0x33, 0xf2, 0x1f, 0xba, 0x37, 0x14, 0x55, 0xd1, 0x15, 0x41, 0xaf, 0xe0,
+	0x58, 0x7e, 0x13, 0x61, 0xb4, 0x21, 0x25, 0x19, 0x07, 0x79, 0x9d, 0x0c, 0xc3, 0xb2, 0x8e, 0x8f,
+	0xc3, 0x52, 0x08, 0x14, 0x66, 0x32, 0x56, 0xc4, 0x15, 0xb5, 0xd7, 0xff, 0x7c, 0xb6, 0xf8, 0x3b,
+	0x0e, 0x72, 0xe1, 0x5b, 0x94, 0x3d, 0x55, 0xf2, 0x70, 0x91, 0x2e, 0xb0, 0x52, 0xd3, 0x0d, 0xfd,
+	0xb8, 0x2a, 0x0f, 0xd6, 0xf0, 0xc0, 0x08, 0x93, 0x07, 0x43, 0xaf, 0x04, 0xd9, 0x09, 0x94, 0x64,
+	0x10, 0x10, 0xce, 0x42, 0x31, 0x0c, 0xcc, 0x67, 0x12, 0x31, 0x81, 0x9f, 0x2c, 0xba, 0x02, 0x97,
+	0x06, 0x30, 0x07, 0xb2, 0x80, 0xf5, 0x3d, 0x59, 0xd0, 0xf9, 0x99, 0xe2, 0xaf, 0x39, 0xb8, 0x1c,
+	0x29, 0x21, 0xfd, 0x25, 0x80, 0x86, 0xde, 0xa8, 0x74, 0x7d, 0xd1, 0xec, 0x7a, 0x04, 0xdd, 0x83,
+	0xdb, 0x3d, 0x0d, 0xd3, 0x05, 0xed, 0x55, 0x7f, 0xaf, 0x0c, 0x51, 0xa8, 0x69, 0xf1, 0xd5, 0xa4,
+	0x42, 0xc3, 0x10, 0x78, 0x0e, 0xdd, 0x85, 0x5b, 0xc9, 0x50, 0x2c, 0x6b, 0xb2, 0xce, 0x67, 0x8a,
+	0xff, 0xc8, 0xc1, 0x46, 0x3c, 0x38, 0x7a, 0xa1, 0x27, 0x8d, 0x20, 0xb4, 0x3b, 0x50, 0x18, 0x74,
+	0x12, 0xea, 0xdc, 0x70, 0x5c, 0xbb, 0x70, 0x3f, 0x01, 0x57, 0x53, 0x0f, 0x04, 0x55, 0xa2, 0xdf,
+	0x11, 0x88, 0xe7, 0xd0, 0x73, 0x78, 0x9a, 0x40, 0xd9, 0x13, 0xa4, 0x7e, 0x96, 0x7b, 0x27, 0x8e,
+	0xa0, 0xeb, 0x58, 0xd9, 0xab, 0xe9, 0xb2, 0xc6, 0x67, 0x90, 0x0c, 0x42, 0x8a, 0x83, 0x41, 0x1d,
+	0x1a, 0xeb, 0x26, 0x8b, 0x9e, 0xc0, 0xa3, 0xb4, 0x38, 0x82, 0x92, 0x51, 0x0e, 0x65, 0x1c, 0xa7,
+	0xce, 0xa0, 0xaf, 0xe1, 0xab, 0x14, 0x6a, 0x38, 0xf3, 0x08, 0x77, 0x16, 0x3d, 0x85, 0xc7, 0xa9,
+	0xd1, 0x8b, 0x15, 0x2c, 0x19, 0x87, 0x02, 0x7e, 0x35, 0x48, 0x9e, 0x43, 0x0a, 0xc8, 0x69, 0x13,
+	0x87, 0xea, 0x66, 0x8c, 0xd1, 0x85, 0x98, 0xab, 0x0b, 0x53, 0x64, 0x91, 0x1a, 0x52, 0xdc, 0xcc,
+	0xa3, 0x17, 0x20, 0x4e, 0x97, 0x8a, 0x64, 0x47, 0x0b, 0xe8, 0x0d, 0xe8, 0x9f, 0xb6, 0xab, 0xf2,
+	0x1b, 0x5d, 0xc6, 0xaa, 0x90, 0xe6, 0x19, 0xd0, 0x33, 0x78, 0x92, 0x9a, 0xb4, 0x41, 0xfd, 0x89,
+	0xd1, 0x73, 0xe8, 0x31, 0x3c, 0x4c, 0xa0, 0xc7, 0x6b, 0xa4, 0x7f, 0x2b, 0x50, 0x24, 0x7e, 0x11,
+	0x3d, 0x82, 0xdd, 0x04, 0x22, 0xeb, 0x42, 0x43, 0xd3, 0x15, 0xf1, 0xd5, 0x71, 0x30, 0x5c, 0x56,
+	0x34, 0x9d, 0x5f, 0x42, 0x3f, 0x86, 0x1f, 0x26, 0xd0, 0x7a, 0x8b, 0xa5, 0x7f, 0xc8, 0x38, 0xd6,
+	0x62, 0x14, 0x56, 0xc3, 0x32, 0xbf, 0x3c, 0xc5, 0x9e, 0x68, 0xca, 0x8b, 0xf4, 0xcc, 0xad, 0x20,
+	0x11, 0x9e, 0x4f, 0xd5, 0x22, 0xe2, 0x81, 0x52, 0x96, 0xc6, 0x3b, 0xe1, 0xd1, 0x43, 0xd8, 0x49,
+	0x70, 0xb2, 0x5f, 0xc1, 0xa2, 0x1c, 0x9e, 0x58, 0x3d, 0x91, 0x58, 0x45, 0x5f, 0xc1, 0x83, 0x24,
+	0x92, 0xa0, 0x94, 0x2b, 0xaf, 0x65, 0x3c, 0xcc, 0x43, 0xf4, 0x18, 0x9d, 0x6e, 0xe9, 0x8a, 0x5a,
+	0xad, 0xe9, 0x86, 0xa6, 0x7c, 0x23, 0xf3, 0x6b, 0xf4, 0x18, 0x4d, 0xdd, 0xa9, 0x28, 0x57, 0xfc,
+	0xc5, 0x51, 0x31, 0x1e, 0x99, 0x64, 0x4f, 0x51, 0x05, 0x7c, 0xcc, 0xaf, 0xa7, 0xd4, 0xde, 0xa8,
+	0xd0, 0x0d, 0x94, 0xd0, 0xa5, 0x69, 0x96, 0x23, 0x0b, 0x58, 0x3c, 0x88, 0x67, 0x7c, 0x83, 0x9e,
+	0x3a, 0x37, 0xd9, 0x0f, 0x2b, 0x23, 0xf7, 0xaa, 0xb8, 0xc4, 0xef, 0xc2, 0xfd, 0x60, 0xdf, 0xc6,
+	0x54, 0xc1, 0x04, 0xb5, 0xdf, 0x83, 0x1f, 0x4d, 0x47, 0xe9, 0x8d, 0x0b, 0x65, 0x2c, 0x0b, 0xd2,
+	0x71, 0xef, 0x4a, 0xca, 0x15, 0xff, 0xcc, 0x41, 0x51, 0x34, 0xed, 0x3a, 0x69, 0x45, 0xbf, 0xbb,
+	0x26, 0x46, 0xf9, 0x14, 0x1e, 0x4f, 0xd1, 0xef, 0x13, 0xe2, 0x3d, 0x02, 0xed, 0x53, 0xc9, 0x35,
+	0xf5, 0x95, 0x5a, 0x39, 0x52, 0x93, 0x08, 0xe1, 0x22, 0x34, 0xeb, 0xcc, 0x36, 0xa7, 0x5e, 0x44,
+	0x58, 0x76, 0xff, 0xdd, 0x22, 0x3e, 0x95, 0x3c, 0xdd, 0x22, 0xbe, 0xe5, 0x20, 0xcf, 0xde, 0xe4,
+	0x03, 0xb5, 0x12, 0xbe, 0x11, 0xee, 0xc2, 0xad, 0xa0, 0xee, 0x87, 0x36, 0x7c, 0xe4, 0xad, 0x70,
+	0x07, 0x0a, 0x49, 0xc0, 0xe0, 0x1d, 0xc3, 0x73, 0xb4, 0x69, 0x92, 0x70, 0xfd, 0x27, 0x41, 0x06,
+	0x6d, 0xc3, 0x67, 0xc9, 0x2e, 0x05, 0x5d, 0x17, 0xc4, 0x03, 0x3e, 0xbb, 0x77, 0xf2, 0xd7, 0x8f,
+	0x9b, 0xdc, 0xdf, 0x3f, 0x6e, 0x72, 0xff, 0xfa, 0xb8, 0xc9, 0xc1, 0x46, 0xdd, 0x69, 0x8f, 0xfb,
+	0x51, 0x62, 0x6f, 0x5e, 0xe8, 0x58, 0x55, 0xfa, 0x20, 0xaf, 0x72, 0xdf, 0xec, 0x9c, 0x59, 0x7e,
+	0xb3, 0x7b, 0x52, 0xaa, 0x3b, 0xed, 0x9d, 0x81, 0x7f, 0xa7, 0x96, 0xce, 0x88, 0x1d, 0xfc, 0x73,
+	0x36, 0xfc, 0xcf, 0xea, 0x53, 0xb3, 0x63, 0xbd, 0xdb, 0x3d, 0x99, 0x63, 0xb6, 0x87, 0xff, 0x19,
+	0x00, 0xcd, 0x4e, 0x33, 0xad, 0x19, 0x1e, 0x00, 0x00,
 }
 
 func (m *WorkflowExecutionInfo) Marshal() (dAtA []byte, err error) {
@@ -619,6 +619,204 @@ func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
 
+type DeleteWorkflowExecutionRequest struct {
+	Request              *v1.DeleteWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                             `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *DeleteWorkflowExecutionRequest) Reset()         { *m = DeleteWorkflowExecutionRequest{} }
+func (m *DeleteWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteWorkflowExecutionRequest) ProtoMessage()    {}
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{10}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionRequest) GetRequest() *v1.DeleteWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+type DeleteWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWorkflowExecutionResponse) Reset()         { *m = DeleteWorkflowExecutionResponse{} }
+func (m *DeleteWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteWorkflowExecutionResponse) ProtoMessage()    {}
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{11}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UpsertWorkflowSearchAttributesRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	SearchAttributes     *v1.SearchAttributes  `protobuf:"bytes,3,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) Reset()         { *m = UpsertWorkflowSearchAttributesRequest{} }
+func (m *UpsertWorkflowSearchAttributesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertWorkflowSearchAttributesRequest) ProtoMessage()    {}
+func (*UpsertWorkflowSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{12}
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.Merge(m, src)
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowSearchAttributesRequest proto.InternalMessageInfo
+
+func (m *UpsertWorkflowSearchAttributesRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) GetSearchAttributes() *v1.SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+type UpsertWorkflowSearchAttributesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Reset() {
+	*m = UpsertWorkflowSearchAttributesResponse{}
+}
+func (m *UpsertWorkflowSearchAttributesResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertWorkflowSearchAttributesResponse) ProtoMessage()    {}
+func (*UpsertWorkflowSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{13}
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.Merge(m, src)
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowSearchAttributesResponse proto.InternalMessageInfo
+
 type DescribeWorkflowExecutionRequest struct {
 	Request              *v1.DescribeWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -631,7 +829,7 @@ func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkfl
 func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{10}
+	return fileDescriptor_fee8ff76963a38ed, []int{14}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +887,7 @@ func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkf
 func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{11}
+	return fileDescriptor_fee8ff76963a38ed, []int{15}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +963,7 @@ func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
 func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowRequest) ProtoMessage()    {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{12}
+	return fileDescriptor_fee8ff76963a38ed, []int{16}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +1018,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{13}
+	return fileDescriptor_fee8ff76963a38ed, []int{17}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1073,7 @@ func (m *ResetStickyTaskListRequest) Reset()         { *m = ResetStickyTaskListR
 func (m *ResetStickyTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListRequest) ProtoMessage()    {}
 func (*ResetStickyTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{14}
+	return fileDescriptor_fee8ff76963a38ed, []int{18}
 }
 func (m *ResetStickyTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,7 +1126,7 @@ func (m *ResetStickyTaskListResponse) Reset()         { *m = ResetStickyTaskList
 func (m *ResetStickyTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListResponse) ProtoMessage()    {}
 func (*ResetStickyTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{15}
+	return fileDescriptor_fee8ff76963a38ed, []int{19}
 }
 func (m *ResetStickyTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1169,7 @@ func (m *GetMutableStateRequest) Reset()         { *m = GetMutableStateRequest{}
 func (m *GetMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateRequest) ProtoMessage()    {}
 func (*GetMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{16}
+	return fileDescriptor_fee8ff76963a38ed, []int{20}
 }
 func (m *GetMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,7 +1253,7 @@ func (m *GetMutableStateResponse) Reset()         { *m = GetMutableStateResponse
 func (m *GetMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateResponse) ProtoMessage()    {}
 func (*GetMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{17}
+	return fileDescriptor_fee8ff76963a38ed, []int{21}
 }
 func (m *GetMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,7 +1415,7 @@ func (m *PollMutableStateRequest) Reset()         { *m = PollMutableStateRequest
 func (m *PollMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateRequest) ProtoMessage()    {}
 func (*PollMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{18}
+	return fileDescriptor_fee8ff76963a38ed, []int{22}
 }
 func (m *PollMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1497,7 @@ func (m *PollMutableStateResponse) Reset()         { *m = PollMutableStateRespon
 func (m *PollMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateResponse) ProtoMessage()    {}
 func (*PollMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{19}
+	return fileDescriptor_fee8ff76963a38ed, []int{23}
 }
 func (m *PollMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1648,7 @@ func (m *RecordDecisionTaskStartedRequest) Reset()         { *m = RecordDecision
 func (m *RecordDecisionTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedRequest) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{20}
+	return fileDescriptor_fee8ff76963a38ed, []int{24}
 }
 func (m *RecordDecisionTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1743,7 @@ func (m *RecordDecisionTaskStartedResponse) Reset()         { *m = RecordDecisio
 func (m *RecordDecisionTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedResponse) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{21}
+	return fileDescriptor_fee8ff76963a38ed, []int{25}
 }
 func (m *RecordDecisionTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +1887,7 @@ func (m *RecordActivityTaskStartedRequest) Reset()         { *m = RecordActivity
 func (m *RecordActivityTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedRequest) ProtoMessage()    {}
 func (*RecordActivityTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{22}
+	return fileDescriptor_fee8ff76963a38ed, []int{26}
 }
 func (m *RecordActivityTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1777,7 +1975,7 @@ func (m *RecordActivityTaskStartedResponse) Reset()         { *m = RecordActivit
 func (m *RecordActivityTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedResponse) ProtoMessage()    {}
 func (*RecordActivityTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{23}
+	return fileDescriptor_fee8ff76963a38ed, []int{27}
 }
 func (m *RecordActivityTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +2065,7 @@ func (m *RespondDecisionTaskCompletedRequest) Reset()         { *m = RespondDeci
 func (m *RespondDecisionTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{24}
+	return fileDescriptor_fee8ff76963a38ed, []int{28}
 }
 func (m *RespondDecisionTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1922,7 +2120,7 @@ func (m *RespondDecisionTaskCompletedResponse) Reset()         { *m = RespondDec
 func (m *RespondDecisionTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{25}
+	return fileDescriptor_fee8ff76963a38ed, []int{29}
 }
 func (m *RespondDecisionTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1977,7 +2175,7 @@ func (m *RespondDecisionTaskFailedRequest) Reset()         { *m = RespondDecisio
 func (m *RespondDecisionTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{26}
+	return fileDescriptor_fee8ff76963a38ed, []int{30}
 }
 func (m *RespondDecisionTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2030,7 +2228,7 @@ func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisi
 func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{27}
+	return fileDescriptor_fee8ff76963a38ed, []int{31}
 }
 func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2071,7 +2269,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Reset()         { *m = RecordActivi
 func (m *RecordActivityTaskHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatRequest) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{28}
+	return fileDescriptor_fee8ff76963a38ed, []int{32}
 }
 func (m *RecordActivityTaskHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2125,7 +2323,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Reset()         { *m = RecordActiv
 func (m *RecordActivityTaskHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatResponse) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{29}
+	return fileDescriptor_fee8ff76963a38ed, []int{33}
 }
 func (m *RecordActivityTaskHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2173,7 +2371,7 @@ func (m *RespondActivityTaskCompletedRequest) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{30}
+	return fileDescriptor_fee8ff76963a38ed, []int{34}
 }
 func (m *RespondActivityTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2226,7 +2424,7 @@ func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondAct
 func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{31}
+	return fileDescriptor_fee8ff76963a38ed, []int{35}
 }
 func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2267,7 +2465,7 @@ func (m *RespondActivityTaskFailedRequest) Reset()         { *m = RespondActivit
 func (m *RespondActivityTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{32}
+	return fileDescriptor_fee8ff76963a38ed, []int{36}
 }
 func (m *RespondActivityTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2320,7 +2518,7 @@ func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivi
 func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{33}
+	return fileDescriptor_fee8ff76963a38ed, []int{37}
 }
 func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2361,7 +2559,7 @@ func (m *RespondActivityTaskCanceledRequest) Reset()         { *m = RespondActiv
 func (m *RespondActivityTaskCanceledRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{34}
+	return fileDescriptor_fee8ff76963a38ed, []int{38}
 }
 func (m *RespondActivityTaskCanceledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2414,7 +2612,7 @@ func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{35}
+	return fileDescriptor_fee8ff76963a38ed, []int{39}
 }
 func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2456,7 +2654,7 @@ func (m *RemoveSignalMutableStateRequest) Reset()         { *m = RemoveSignalMut
 func (m *RemoveSignalMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateRequest) ProtoMessage()    {}
 func (*RemoveSignalMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{36}
+	return fileDescriptor_fee8ff76963a38ed, []int{40}
 }
 func (m *RemoveSignalMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2516,7 +2714,7 @@ func (m *RemoveSignalMutableStateResponse) Reset()         { *m = RemoveSignalMu
 func (m *RemoveSignalMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateResponse) ProtoMessage()    {}
 func (*RemoveSignalMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{37}
+	return fileDescriptor_fee8ff76963a38ed, []int{41}
 }
 func (m *RemoveSignalMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2757,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCa
 func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{38}
+	return fileDescriptor_fee8ff76963a38ed, []int{42}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2628,7 +2826,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{39}
+	return fileDescriptor_fee8ff76963a38ed, []int{43}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2670,7 +2868,7 @@ func (m *ScheduleDecisionTaskRequest) Reset()         { *m = ScheduleDecisionTas
 func (m *ScheduleDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskRequest) ProtoMessage()    {}
 func (*ScheduleDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{40}
+	return fileDescriptor_fee8ff76963a38ed, []int{44}
 }
 func (m *ScheduleDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2730,7 +2928,7 @@ func (m *ScheduleDecisionTaskResponse) Reset()         { *m = ScheduleDecisionTa
 func (m *ScheduleDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskResponse) ProtoMessage()    {}
 func (*ScheduleDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{41}
+	return fileDescriptor_fee8ff76963a38ed, []int{45}
 }
 func (m *ScheduleDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2779,7 +2977,7 @@ func (m *RecordChildExecutionCompletedRequest) Reset()         { *m = RecordChil
 func (m *RecordChildExecutionCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{42}
+	return fileDescriptor_fee8ff76963a38ed, []int{46}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2853,7 +3051,7 @@ func (m *RecordChildExecutionCompletedResponse) Reset()         { *m = RecordChi
 func (m *RecordChildExecutionCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{43}
+	return fileDescriptor_fee8ff76963a38ed, []int{47}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2898,7 +3096,7 @@ func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Reque
 func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Request) ProtoMessage()    {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{44}
+	return fileDescriptor_fee8ff76963a38ed, []int{48}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2972,7 +3170,7 @@ func (m *ReplicateEventsV2Response) Reset()         { *m = ReplicateEventsV2Resp
 func (m *ReplicateEventsV2Response) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Response) ProtoMessage()    {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{45}
+	return fileDescriptor_fee8ff76963a38ed, []int{49}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3212,7 @@ func (m *SyncShardStatusRequest) Reset()         { *m = SyncShardStatusRequest{}
 func (m *SyncShardStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusRequest) ProtoMessage()    {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{46}
+	return fileDescriptor_fee8ff76963a38ed, []int{50}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3074,7 +3272,7 @@ func (m *SyncShardStatusResponse) Reset()         { *m = SyncShardStatusResponse
 func (m *SyncShardStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusResponse) ProtoMessage()    {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{47}
+	return fileDescriptor_fee8ff76963a38ed, []int{51}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3324,7 @@ func (m *SyncActivityRequest) Reset()         { *m = SyncActivityRequest{} }
 func (m *SyncActivityRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityRequest) ProtoMessage()    {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{48}
+	return fileDescriptor_fee8ff76963a38ed, []int{52}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3256,7 +3454,7 @@ func (m *SyncActivityResponse) Reset()         { *m = SyncActivityResponse{} }
 func (m *SyncActivityResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityResponse) ProtoMessage()    {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{49}
+	return fileDescriptor_fee8ff76963a38ed, []int{53}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3297,7 +3495,7 @@ func (m *DescribeMutableStateRequest) Reset()         { *m = DescribeMutableStat
 func (m *DescribeMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateRequest) ProtoMessage()    {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{50}
+	return fileDescriptor_fee8ff76963a38ed, []int{54}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3352,7 +3550,7 @@ func (m *DescribeMutableStateResponse) Reset()         { *m = DescribeMutableSta
 func (m *DescribeMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateResponse) ProtoMessage()    {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{51}
+	return fileDescriptor_fee8ff76963a38ed, []int{55}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3405,7 +3603,7 @@ func (m *DescribeHistoryHostRequest) Reset()         { *m = DescribeHistoryHostR
 func (m *DescribeHistoryHostRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostRequest) ProtoMessage()    {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{52}
+	return fileDescriptor_fee8ff76963a38ed, []int{56}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3449,7 +3647,7 @@ func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHost
 func (m *DescribeHistoryHostResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostResponse) ProtoMessage()    {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{53}
+	return fileDescriptor_fee8ff76963a38ed, []int{57}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3524,7 +3722,7 @@ func (m *CloseShardRequest) Reset()         { *m = CloseShardRequest{} }
 func (m *CloseShardRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseShardRequest) ProtoMessage()    {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{54}
+	return fileDescriptor_fee8ff76963a38ed, []int{58}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3570,7 +3768,7 @@ func (m *CloseShardResponse) Reset()         { *m = CloseShardResponse{} }
 func (m *CloseShardResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseShardResponse) ProtoMessage()    {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{55}
+	return fileDescriptor_fee8ff76963a38ed, []int{59}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3614,7 +3812,7 @@ func (m *RemoveTaskRequest) Reset()         { *m = RemoveTaskRequest{} }
 func (m *RemoveTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskRequest) ProtoMessage()    {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{56}
+	return fileDescriptor_fee8ff76963a38ed, []int{60}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3688,7 +3886,7 @@ func (m *RemoveTaskResponse) Reset()         { *m = RemoveTaskResponse{} }
 func (m *RemoveTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskResponse) ProtoMessage()    {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{57}
+	return fileDescriptor_fee8ff76963a38ed, []int{61}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3730,7 +3928,7 @@ func (m *ResetQueueRequest) Reset()         { *m = ResetQueueRequest{} }
 func (m *ResetQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueRequest) ProtoMessage()    {}
 func (*ResetQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{58}
+	return fileDescriptor_fee8ff76963a38ed, []int{62}
 }
 func (m *ResetQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3790,7 +3988,7 @@ func (m *ResetQueueResponse) Reset()         { *m = ResetQueueResponse{} }
 func (m *ResetQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueResponse) ProtoMessage()    {}
 func (*ResetQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{59}
+	return fileDescriptor_fee8ff76963a38ed, []int{63}
 }
 func (m *ResetQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3832,7 +4030,7 @@ func (m *DescribeQueueRequest) Reset()         { *m = DescribeQueueRequest{} }
 func (m *DescribeQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueRequest) ProtoMessage()    {}
 func (*DescribeQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{60}
+	return fileDescriptor_fee8ff76963a38ed, []int{64}
 }
 func (m *DescribeQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3893,7 +4091,7 @@ func (m *DescribeQueueResponse) Reset()         { *m = DescribeQueueResponse{} }
 func (m *DescribeQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueResponse) ProtoMessage()    {}
 func (*DescribeQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{61}
+	return fileDescriptor_fee8ff76963a38ed, []int{65}
 }
 func (m *DescribeQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3941,7 +4139,7 @@ func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMes
 func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{62}
+	return fileDescriptor_fee8ff76963a38ed, []int{66}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3995,7 +4193,7 @@ func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMe
 func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{63}
+	return fileDescriptor_fee8ff76963a38ed, []int{67}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4042,7 +4240,7 @@ func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicat
 func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{64}
+	return fileDescriptor_fee8ff76963a38ed, []int{68}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4089,7 +4287,7 @@ func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplica
 func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{65}
+	return fileDescriptor_fee8ff76963a38ed, []int{69}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4139,7 +4337,7 @@ func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
 func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsRequest) ProtoMessage()    {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{66}
+	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4206,7 +4404,7 @@ func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
 func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsResponse) ProtoMessage()    {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{67}
+	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4248,7 +4446,7 @@ func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTask
 func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{68}
+	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4308,7 +4506,7 @@ func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTas
 func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{69}
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4353,7 +4551,7 @@ func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{}
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{70}
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4438,7 +4636,7 @@ func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{71}
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4509,7 +4707,7 @@ func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{72}
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4576,7 +4774,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4621,7 +4819,7 @@ func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4703,7 +4901,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4750,7 +4948,7 @@ func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMark
 func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
 func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
 func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4796,7 +4994,7 @@ func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMar
 func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
 func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
 func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4837,7 +5035,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4892,7 +5090,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4951,7 +5149,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
 func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
 func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
 func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5023,7 +5221,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) String() string {
 }
 func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
 func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
 }
 func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5070,7 +5268,7 @@ func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{}
 func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoRequest) ProtoMessage()    {}
 func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{82}
+	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
 func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5118,7 +5316,7 @@ func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse
 func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoResponse) ProtoMessage()    {}
 func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{83}
+	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
 func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5172,6 +5370,10 @@ func init() {
 	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
 	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
 	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*UpsertWorkflowSearchAttributesRequest)(nil), "uber.cadence.history.v1.UpsertWorkflowSearchAttributesRequest")
+	proto.RegisterType((*UpsertWorkflowSearchAttributesResponse)(nil), "uber.cadence.history.v1.UpsertWorkflowSearchAttributesResponse")
 	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
 	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
 	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
@@ -5258,303 +5460,307 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4725 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x24, 0x49,
-	0x56, 0x56, 0xba, 0xda, 0x7f, 0xcf, 0x76, 0xd9, 0x8e, 0xf6, 0x4f, 0xb9, 0xdc, 0xed, 0xb6, 0x73,
-	0xfb, 0xc7, 0xdb, 0x33, 0x53, 0x3d, 0xed, 0x9e, 0xe9, 0xee, 0xe9, 0x99, 0xd9, 0x99, 0x6e, 0xbb,
-	0xbb, 0xa7, 0x5a, 0xfd, 0x9b, 0x36, 0xdd, 0x80, 0x50, 0xa7, 0xd2, 0x95, 0x51, 0x76, 0xe2, 0xaa,
-	0xcc, 0xea, 0xcc, 0x2c, 0xbb, 0x6b, 0x0e, 0x68, 0x81, 0x15, 0xd2, 0xae, 0x10, 0x2c, 0xcb, 0x82,
-	0x90, 0x90, 0x56, 0x42, 0x8b, 0xb4, 0xb0, 0x1c, 0x38, 0xc0, 0x0d, 0x71, 0xe2, 0x02, 0x07, 0x24,
-	0xae, 0x9c, 0x40, 0xa3, 0x15, 0x07, 0x90, 0xb8, 0x80, 0x38, 0x22, 0x14, 0x3f, 0xf9, 0x57, 0x19,
-	0x19, 0x99, 0x65, 0x23, 0xf5, 0x30, 0xcc, 0xad, 0x32, 0x22, 0xde, 0x8b, 0x17, 0x2f, 0x5e, 0x7c,
-	0x11, 0xf1, 0xde, 0x8b, 0x82, 0x0b, 0xdd, 0x5d, 0xec, 0x5e, 0x69, 0x18, 0x26, 0xb6, 0x1b, 0xf8,
-	0xca, 0xbe, 0xe5, 0xf9, 0x8e, 0xdb, 0xbb, 0x72, 0x78, 0xf5, 0x8a, 0x87, 0xdd, 0x43, 0xab, 0x81,
-	0x6b, 0x1d, 0xd7, 0xf1, 0x1d, 0xb4, 0x48, 0x9a, 0xd5, 0x78, 0xb3, 0x1a, 0x6f, 0x56, 0x3b, 0xbc,
-	0x5a, 0x5d, 0xd9, 0x73, 0x9c, 0xbd, 0x16, 0xbe, 0x42, 0x9b, 0xed, 0x76, 0x9b, 0x57, 0xcc, 0xae,
-	0x6b, 0xf8, 0x96, 0x63, 0x33, 0xc2, 0xea, 0xb9, 0xfe, 0x7a, 0xdf, 0x6a, 0x63, 0xcf, 0x37, 0xda,
-	0x1d, 0xde, 0x20, 0xc5, 0xe0, 0xc8, 0x35, 0x3a, 0x1d, 0xec, 0x7a, 0xbc, 0x7e, 0x35, 0x21, 0xa0,
-	0xd1, 0xb1, 0x88, 0x70, 0x0d, 0xa7, 0xdd, 0x0e, 0xbb, 0x58, 0x13, 0xb5, 0x08, 0x44, 0xe4, 0x52,
-	0x88, 0x9a, 0xbc, 0xea, 0xe2, 0xb0, 0x81, 0x2a, 0x6a, 0xe0, 0x1b, 0xde, 0x41, 0xcb, 0xf2, 0x7c,
-	0x59, 0x9b, 0x23, 0xc7, 0x3d, 0x68, 0xb6, 0x9c, 0x23, 0xde, 0xe6, 0xb2, 0xa8, 0x0d, 0x57, 0xa5,
-	0xde, 0xd7, 0x76, 0x3d, 0xaf, 0x2d, 0x76, 0x79, 0xcb, 0xf3, 0x89, 0x96, 0xde, 0xbe, 0xe1, 0x62,
-	0x93, 0xaa, 0xa1, 0xd5, 0xf5, 0xfc, 0xdc, 0x56, 0x49, 0x55, 0xa8, 0x19, 0xad, 0x5e, 0x75, 0x71,
-	0x17, 0x0b, 0x25, 0x8b, 0xda, 0xb8, 0xb8, 0xd3, 0xb2, 0x1a, 0xf1, 0xe9, 0xbd, 0x90, 0xd1, 0x32,
-	0x39, 0x54, 0xf5, 0x4f, 0x86, 0xe1, 0xec, 0xb6, 0x6f, 0xb8, 0xfe, 0x0b, 0x5e, 0x7e, 0xf7, 0x35,
-	0x6e, 0x74, 0x09, 0x1f, 0x0d, 0xbf, 0xea, 0x62, 0xcf, 0x47, 0x0f, 0x61, 0xd4, 0x65, 0x3f, 0x2b,
-	0xca, 0xaa, 0xb2, 0x3e, 0xb1, 0xb1, 0x51, 0x4b, 0x98, 0x9c, 0xd1, 0xb1, 0x6a, 0x87, 0x57, 0x6b,
-	0x52, 0x26, 0x5a, 0xc0, 0x02, 0x2d, 0xc3, 0xb8, 0xe9, 0xb4, 0x0d, 0xcb, 0xd6, 0x2d, 0xb3, 0x32,
-	0xb4, 0xaa, 0xac, 0x8f, 0x6b, 0x63, 0xac, 0xa0, 0x6e, 0xa2, 0x5f, 0x82, 0xf9, 0x8e, 0xe1, 0x62,
-	0xdb, 0xd7, 0x71, 0xc0, 0x40, 0xb7, 0xec, 0xa6, 0x53, 0x29, 0xd1, 0x8e, 0xd7, 0x85, 0x1d, 0x3f,
-	0xa5, 0x14, 0x61, 0x8f, 0x75, 0xbb, 0xe9, 0x68, 0xa7, 0x3b, 0xe9, 0x42, 0x54, 0x81, 0x51, 0xc3,
-	0xf7, 0x71, 0xbb, 0xe3, 0x57, 0x4e, 0xad, 0x2a, 0xeb, 0xc3, 0x5a, 0xf0, 0x89, 0x36, 0x61, 0x1a,
-	0xbf, 0xee, 0x58, 0x6c, 0x79, 0xe8, 0x64, 0x1d, 0x54, 0x86, 0x69, 0x8f, 0xd5, 0x1a, 0x5b, 0x03,
-	0xb5, 0x60, 0x0d, 0xd4, 0x76, 0x82, 0x45, 0xa2, 0x95, 0x23, 0x12, 0x52, 0x88, 0x9a, 0xb0, 0xd4,
-	0x70, 0x6c, 0xdf, 0xb2, 0xbb, 0x58, 0x37, 0x3c, 0xdd, 0xc6, 0x47, 0xba, 0x65, 0x5b, 0xbe, 0x65,
-	0xf8, 0x8e, 0x5b, 0x19, 0x59, 0x55, 0xd6, 0xcb, 0x1b, 0x6f, 0x09, 0x07, 0xb0, 0xc9, 0xa9, 0x6e,
-	0x7b, 0x8f, 0xf1, 0x51, 0x3d, 0x20, 0xd1, 0x16, 0x1a, 0xc2, 0x72, 0x54, 0x87, 0xd9, 0xa0, 0xc6,
-	0xd4, 0x9b, 0x86, 0xd5, 0xea, 0xba, 0xb8, 0x32, 0x4a, 0xc5, 0x3d, 0x23, 0xe4, 0x7f, 0x8f, 0xb5,
-	0xd1, 0x66, 0x42, 0x32, 0x5e, 0x82, 0x34, 0x58, 0x68, 0x19, 0x9e, 0xaf, 0x37, 0x9c, 0x76, 0xa7,
-	0x85, 0xe9, 0xe0, 0x5d, 0xec, 0x75, 0x5b, 0x7e, 0x65, 0x4c, 0xc2, 0xef, 0xa9, 0xd1, 0x6b, 0x39,
-	0x86, 0xa9, 0xcd, 0x11, 0xda, 0xcd, 0x90, 0x54, 0xa3, 0x94, 0xe8, 0xe7, 0x61, 0xb9, 0x69, 0xb9,
-	0x9e, 0xaf, 0x9b, 0xb8, 0x61, 0x79, 0x54, 0x9f, 0x86, 0x77, 0xa0, 0xef, 0x1a, 0x8d, 0x03, 0xa7,
-	0xd9, 0xac, 0x8c, 0x53, 0xc6, 0x4b, 0x29, 0xbd, 0x6e, 0x71, 0x70, 0xd2, 0x2a, 0x94, 0x7a, 0x8b,
-	0x13, 0xef, 0x18, 0xde, 0xc1, 0x1d, 0x46, 0xaa, 0xde, 0x80, 0x95, 0x2c, 0x23, 0xf3, 0x3a, 0x8e,
-	0xed, 0x61, 0x34, 0x0f, 0x23, 0x6e, 0x97, 0x5a, 0x96, 0x42, 0x2d, 0x6b, 0xd8, 0xed, 0xda, 0x75,
-	0x53, 0xfd, 0xe3, 0x21, 0x58, 0xd9, 0xb6, 0xf6, 0x6c, 0xa3, 0x95, 0x69, 0xe4, 0x8f, 0xfa, 0x8d,
-	0xfc, 0x9a, 0xd8, 0xc8, 0xa5, 0x5c, 0x0a, 0x5a, 0x79, 0x13, 0x96, 0xf1, 0x6b, 0x1f, 0xbb, 0xb6,
-	0xd1, 0x0a, 0x81, 0x27, 0x32, 0x78, 0x6e, 0xeb, 0x17, 0x85, 0xfd, 0xa7, 0x7b, 0x5e, 0x0a, 0x58,
-	0xa5, 0xaa, 0x50, 0x0d, 0x4e, 0x37, 0xf6, 0xad, 0x96, 0x19, 0x75, 0xe2, 0xd8, 0xad, 0x1e, 0xb5,
-	0xfd, 0x31, 0x6d, 0x96, 0x56, 0x05, 0x44, 0x4f, 0xec, 0x56, 0x4f, 0x5d, 0x83, 0x73, 0x99, 0xe3,
-	0x63, 0x0a, 0x56, 0x7f, 0xa4, 0xc0, 0x25, 0xde, 0xc6, 0xf2, 0xf7, 0xe5, 0xb8, 0xf1, 0xbc, 0x5f,
-	0xa5, 0x1f, 0xc9, 0x54, 0x9a, 0xc7, 0xae, 0x98, 0x6e, 0xd5, 0xdb, 0xb0, 0x9e, 0xcf, 0x50, 0x6e,
-	0x2d, 0xdf, 0x53, 0xe0, 0xac, 0x86, 0x3d, 0x7c, 0x62, 0x44, 0x94, 0x32, 0x29, 0x38, 0x9e, 0x1b,
-	0xb0, 0x92, 0xc5, 0x46, 0x3e, 0x8a, 0x1f, 0x28, 0xb0, 0xb6, 0x83, 0xdd, 0xb6, 0x65, 0x1b, 0x3e,
-	0xce, 0x1c, 0xc9, 0xd3, 0xfe, 0x91, 0x5c, 0x17, 0x8e, 0x24, 0x97, 0x51, 0xc1, 0xd1, 0x9c, 0x07,
-	0x55, 0xc6, 0x8a, 0x1b, 0xd9, 0xf7, 0x15, 0x58, 0xdd, 0xc2, 0x5e, 0xc3, 0xb5, 0x76, 0xb3, 0x25,
-	0x7f, 0xd2, 0x2f, 0xf9, 0xfb, 0x42, 0xc9, 0xf3, 0xf8, 0x14, 0x14, 0xfc, 0xbf, 0x4b, 0xb0, 0x26,
-	0x61, 0xc5, 0xa7, 0xa2, 0x05, 0x8b, 0xd1, 0xbe, 0xd5, 0x70, 0xec, 0xa6, 0xb5, 0xc7, 0x51, 0x4d,
-	0x0a, 0x2a, 0x29, 0x86, 0x9b, 0x71, 0x52, 0x6d, 0x01, 0x0b, 0xcb, 0xd1, 0x2e, 0x2c, 0xa6, 0xd1,
-	0x83, 0x6d, 0x97, 0x43, 0xb4, 0xb7, 0xcb, 0xc5, 0x7a, 0xa3, 0x1b, 0xe6, 0xfc, 0x91, 0xa8, 0x18,
-	0xbd, 0x00, 0xd4, 0xc1, 0xb6, 0x69, 0xd9, 0x7b, 0xba, 0xd1, 0xf0, 0xad, 0x43, 0xcb, 0xb7, 0xb0,
-	0x57, 0x29, 0xad, 0x96, 0xb2, 0x77, 0x63, 0xd6, 0xfc, 0x36, 0x6b, 0xdd, 0xa3, 0xcc, 0x67, 0x3b,
-	0x89, 0x42, 0x0b, 0x7b, 0xe8, 0x17, 0x60, 0x26, 0x60, 0x4c, 0x81, 0xc8, 0xc5, 0x76, 0xe5, 0x14,
-	0x65, 0x5b, 0x93, 0xb1, 0xdd, 0x24, 0x6d, 0x93, 0x92, 0x4f, 0x77, 0x62, 0x55, 0x2e, 0xb6, 0xd1,
-	0x76, 0xc4, 0x3a, 0xd8, 0x82, 0xf8, 0x6e, 0x2e, 0x95, 0x38, 0xd8, 0x71, 0x12, 0x4c, 0x83, 0x42,
-	0xf5, 0x35, 0xcc, 0x3d, 0x23, 0x87, 0xd2, 0x40, 0x7b, 0x81, 0x19, 0x6e, 0xf6, 0x9b, 0xe1, 0x37,
-	0x85, 0x7d, 0x88, 0x68, 0x0b, 0x9a, 0xde, 0x8f, 0x15, 0x98, 0xef, 0x23, 0xe7, 0xe6, 0xf6, 0x09,
-	0x4c, 0xd2, 0x83, 0x72, 0xb0, 0x67, 0x2b, 0x05, 0xf6, 0xec, 0x09, 0x4a, 0xc1, 0xb7, 0xea, 0x3a,
-	0x94, 0x03, 0x06, 0xbf, 0x8c, 0x1b, 0x3e, 0x36, 0xb9, 0xe1, 0xa8, 0xd9, 0x63, 0xd0, 0x78, 0x4b,
-	0x6d, 0xea, 0x55, 0xfc, 0x53, 0xfd, 0x8e, 0x02, 0x55, 0x0a, 0x54, 0xdb, 0xbe, 0xd5, 0x38, 0xe8,
-	0x91, 0x6d, 0xfb, 0xa1, 0xe5, 0xf9, 0x81, 0x9a, 0xea, 0xfd, 0x6a, 0xba, 0x92, 0x8d, 0x98, 0x42,
-	0x0e, 0x05, 0x95, 0x75, 0x16, 0x96, 0x85, 0x3c, 0x38, 0xb2, 0xfc, 0x87, 0x02, 0x0b, 0xf7, 0xb1,
-	0xff, 0xa8, 0xeb, 0x1b, 0xbb, 0x2d, 0xbc, 0xed, 0x1b, 0x3e, 0xd6, 0x44, 0x6c, 0x95, 0xbe, 0x1d,
-	0xfb, 0xe7, 0x00, 0x09, 0x36, 0xea, 0xa1, 0x81, 0x36, 0xea, 0xd9, 0xd4, 0x0a, 0x43, 0xd7, 0x60,
-	0x01, 0xbf, 0xee, 0x50, 0x05, 0xea, 0x36, 0x7e, 0xed, 0xeb, 0xf8, 0x90, 0x9c, 0x7d, 0x2d, 0x93,
-	0x9e, 0x01, 0x4a, 0xda, 0xe9, 0xa0, 0xf6, 0x31, 0x7e, 0xed, 0xdf, 0x25, 0x75, 0x75, 0x13, 0xbd,
-	0x0b, 0x73, 0x8d, 0xae, 0x4b, 0x0f, 0xc9, 0xbb, 0xae, 0x61, 0x37, 0xf6, 0x75, 0xdf, 0x39, 0xa0,
-	0xab, 0x47, 0x59, 0x9f, 0xd4, 0x10, 0xaf, 0xbb, 0x43, 0xab, 0x76, 0x48, 0x8d, 0xfa, 0xc3, 0x71,
-	0x58, 0x4c, 0x8d, 0x9a, 0xdb, 0x90, 0x78, 0x64, 0xca, 0x49, 0x47, 0x76, 0x0f, 0xa6, 0x42, 0xb6,
-	0x7e, 0xaf, 0x83, 0xb9, 0xae, 0xd6, 0xa4, 0x1c, 0x77, 0x7a, 0x1d, 0xac, 0x4d, 0x1e, 0xc5, 0xbe,
-	0x90, 0x0a, 0x53, 0x22, 0xc5, 0x4c, 0xd8, 0x31, 0x85, 0x3c, 0x87, 0xa5, 0x8e, 0x8b, 0x0f, 0x2d,
-	0xa7, 0xeb, 0xe9, 0x1e, 0xd9, 0xf1, 0xb1, 0x19, 0xb5, 0x3f, 0x45, 0xfb, 0x5d, 0x4e, 0x1d, 0x37,
-	0xeb, 0xb6, 0x7f, 0xfd, 0xbd, 0xe7, 0x46, 0xab, 0x8b, 0xb5, 0x85, 0x80, 0x7a, 0x9b, 0x11, 0x07,
-	0x7c, 0xdf, 0x81, 0xd3, 0xf4, 0x70, 0xcc, 0x4e, 0xb3, 0x21, 0xc7, 0x61, 0x2a, 0xc1, 0x0c, 0xa9,
-	0xba, 0x47, 0x6a, 0x82, 0xe6, 0xb7, 0x60, 0x9c, 0x1e, 0x74, 0xc9, 0xb5, 0x94, 0x1e, 0xf7, 0x27,
-	0x36, 0xce, 0x8a, 0x37, 0xd3, 0xc0, 0x2a, 0xc7, 0x7c, 0xfe, 0x0b, 0xdd, 0x87, 0x19, 0x8f, 0x5a,
-	0xac, 0x1e, 0xb1, 0x18, 0x2d, 0xc2, 0xa2, 0xec, 0x25, 0x0c, 0x1d, 0xbd, 0x07, 0x0b, 0x8d, 0x96,
-	0x45, 0x24, 0x6d, 0x59, 0xbb, 0xae, 0xe1, 0xf6, 0xf4, 0x43, 0xec, 0x52, 0x04, 0x1c, 0xa3, 0x26,
-	0x3d, 0xc7, 0x6a, 0x1f, 0xb2, 0xca, 0xe7, 0xac, 0x2e, 0x46, 0xd5, 0xc4, 0x86, 0xdf, 0x75, 0x71,
-	0x48, 0x35, 0x1e, 0xa7, 0xba, 0xc7, 0x2a, 0x03, 0xaa, 0x73, 0x30, 0xc1, 0xa9, 0xac, 0x76, 0xa7,
-	0x55, 0x01, 0xda, 0x14, 0x58, 0x51, 0xbd, 0xdd, 0x69, 0x21, 0x0f, 0x2e, 0xf7, 0x8f, 0x4a, 0xf7,
-	0x1a, 0xfb, 0xd8, 0xec, 0xb6, 0xb0, 0xee, 0x3b, 0x6c, 0xb2, 0xe8, 0x6d, 0xcb, 0xe9, 0xfa, 0x95,
-	0x89, 0xbc, 0x8b, 0xc1, 0xf9, 0xe4, 0x58, 0xb7, 0x39, 0xa7, 0x1d, 0x87, 0xce, 0xdb, 0x0e, 0x63,
-	0x43, 0x0e, 0xbd, 0x6c, 0xaa, 0xc8, 0xcd, 0x3a, 0x1a, 0xc8, 0x24, 0xbd, 0xf0, 0xcd, 0xd2, 0xaa,
-	0x6d, 0x52, 0x13, 0x8c, 0x22, 0x6b, 0x39, 0x4d, 0x65, 0x2d, 0x27, 0xf4, 0x10, 0xca, 0xa1, 0x6d,
-	0x7b, 0x64, 0x31, 0x55, 0xca, 0xf4, 0x72, 0x77, 0x21, 0x39, 0x55, 0xec, 0xc6, 0x1d, 0xb7, 0x6f,
-	0xb6, 0xf2, 0xc2, 0x85, 0x41, 0x3f, 0x51, 0x03, 0xe6, 0x42, 0x6e, 0x8d, 0x96, 0xe3, 0x61, 0xce,
-	0x73, 0x9a, 0xf2, 0xbc, 0x5a, 0xf0, 0xc0, 0x40, 0x08, 0x09, 0xbf, 0xae, 0xa7, 0x85, 0xeb, 0x39,
-	0x2c, 0x24, 0xab, 0x7c, 0x96, 0x2b, 0x42, 0x67, 0x2e, 0x07, 0xb2, 0x8b, 0xcf, 0x88, 0xf6, 0xc4,
-	0x48, 0x6a, 0xae, 0xa0, 0xcf, 0x82, 0xf6, 0xda, 0xcc, 0x61, 0x5f, 0x09, 0xfa, 0x08, 0x96, 0x2d,
-	0xb2, 0xe6, 0xfa, 0xe6, 0x18, 0xdb, 0x04, 0x67, 0xcc, 0xca, 0x2c, 0xbd, 0x68, 0x2c, 0x5a, 0x5e,
-	0x12, 0x8d, 0xef, 0xb2, 0x6a, 0xf5, 0x3f, 0x15, 0x58, 0x7c, 0xea, 0xb4, 0x5a, 0xff, 0xcf, 0xd0,
-	0xf8, 0x27, 0x63, 0x50, 0x49, 0x0f, 0xfb, 0x6b, 0x38, 0xfe, 0x1a, 0x8e, 0xbf, 0x8a, 0x70, 0x9c,
-	0xb5, 0x3e, 0x26, 0x33, 0xe1, 0x55, 0x88, 0x55, 0x53, 0x27, 0xc6, 0xaa, 0xff, 0x7b, 0xa8, 0xad,
-	0xfe, 0xcd, 0x10, 0xac, 0x6a, 0xb8, 0xe1, 0xb8, 0x66, 0xdc, 0x1b, 0xc6, 0x97, 0xc5, 0x9b, 0x44,
-	0xca, 0x73, 0x30, 0x11, 0x1a, 0x4e, 0x08, 0x02, 0x10, 0x14, 0xd5, 0x4d, 0xb4, 0x08, 0xa3, 0xd4,
-	0xc6, 0xf8, 0x8a, 0x2f, 0x69, 0x23, 0xe4, 0xb3, 0x6e, 0xa2, 0xb3, 0x00, 0xfc, 0x1c, 0x1f, 0xac,
-	0xdd, 0x71, 0x6d, 0x9c, 0x97, 0xd4, 0x4d, 0xa4, 0xc1, 0x64, 0xc7, 0x69, 0xb5, 0xf4, 0xe0, 0xae,
-	0x30, 0x22, 0xb9, 0x2b, 0x10, 0x0c, 0xbd, 0xe7, 0xb8, 0x71, 0xd5, 0x04, 0x77, 0x85, 0x09, 0xc2,
-	0x84, 0x7f, 0xa8, 0xff, 0x34, 0x0a, 0x6b, 0x12, 0x2d, 0x72, 0xe0, 0x4d, 0x21, 0xa4, 0x72, 0x3c,
-	0x84, 0x94, 0xa2, 0xdf, 0xd0, 0xf1, 0xd1, 0xef, 0x6d, 0x40, 0x81, 0x7e, 0xcd, 0x7e, 0xf8, 0x9d,
-	0x09, 0x6b, 0x82, 0xd6, 0xeb, 0x04, 0xc0, 0x04, 0xd0, 0x5b, 0x22, 0x08, 0x95, 0xe0, 0x9b, 0x42,
-	0xf4, 0xe1, 0x34, 0xa2, 0xc7, 0xfc, 0xe6, 0x23, 0x49, 0xbf, 0xf9, 0x4d, 0xa8, 0x70, 0x48, 0x89,
-	0x1c, 0x10, 0xc1, 0xee, 0x3f, 0x4a, 0x77, 0xff, 0x05, 0x56, 0x1f, 0xda, 0x0e, 0xdf, 0xfc, 0x91,
-	0x06, 0x53, 0xa1, 0x7f, 0x98, 0xba, 0x2c, 0x98, 0xc3, 0xf9, 0x9d, 0xac, 0xd5, 0xb8, 0xe3, 0x1a,
-	0xb6, 0x47, 0xa0, 0x2c, 0x71, 0x4d, 0x9f, 0x34, 0x63, 0x5f, 0xe8, 0x25, 0x9c, 0x11, 0x38, 0x44,
-	0x22, 0x08, 0x1f, 0x2f, 0x02, 0xe1, 0x4b, 0x29, 0x73, 0x0f, 0xd1, 0x3c, 0xe3, 0x68, 0x09, 0x59,
-	0x47, 0xcb, 0x35, 0x98, 0x4c, 0x60, 0xde, 0x04, 0xc5, 0xbc, 0x89, 0xdd, 0x18, 0xd8, 0xdd, 0x86,
-	0x72, 0x34, 0xad, 0x34, 0xee, 0x30, 0x99, 0x1b, 0x77, 0x98, 0x0a, 0x29, 0x68, 0xd8, 0xe1, 0x63,
-	0x98, 0x0c, 0xe6, 0x9a, 0x32, 0x98, 0xca, 0x65, 0x30, 0xc1, 0xdb, 0x53, 0x72, 0x03, 0x46, 0xc9,
-	0x4d, 0x9e, 0x80, 0x6c, 0x99, 0xfa, 0x5f, 0xee, 0xd7, 0x32, 0x02, 0x8a, 0xb5, 0xdc, 0x55, 0x44,
-	0x5d, 0x04, 0x16, 0xf6, 0xee, 0xda, 0xbe, 0xdb, 0xd3, 0x02, 0xbe, 0xd5, 0x97, 0x30, 0x19, 0xaf,
-	0x40, 0x33, 0x50, 0x3a, 0xc0, 0x3d, 0x0e, 0x56, 0xe4, 0x27, 0xba, 0x09, 0xc3, 0x87, 0xc4, 0xfc,
-	0xa5, 0xfe, 0x87, 0x60, 0xd5, 0x31, 0x3f, 0x04, 0x23, 0xb8, 0x35, 0x74, 0x53, 0x89, 0xe1, 0x64,
-	0xe0, 0x75, 0xfa, 0x1a, 0x27, 0x53, 0x38, 0x19, 0x57, 0x8d, 0x10, 0x27, 0x7f, 0x56, 0x0a, 0x70,
-	0x52, 0xa8, 0x45, 0x8e, 0x93, 0x0f, 0x60, 0xba, 0x0f, 0x87, 0xa4, 0x48, 0xc9, 0xf6, 0xdf, 0x1e,
-	0x45, 0x12, 0xad, 0x9c, 0xc4, 0xa9, 0x94, 0xe5, 0x0e, 0x0d, 0x66, 0xb9, 0x31, 0x58, 0x2a, 0x25,
-	0x61, 0xe9, 0x25, 0xac, 0x24, 0x57, 0x95, 0xee, 0x34, 0x75, 0x7f, 0xdf, 0xf2, 0xf4, 0x78, 0xfc,
-	0x4f, 0xde, 0x55, 0x35, 0xb1, 0xca, 0x9e, 0x34, 0x77, 0xf6, 0x2d, 0xef, 0x36, 0xe7, 0x5f, 0x87,
-	0xd9, 0x7d, 0x6c, 0xb8, 0xfe, 0x2e, 0x36, 0x7c, 0xdd, 0xc4, 0xbe, 0x61, 0xb5, 0x3c, 0xee, 0x62,
-	0x94, 0x7b, 0xdf, 0x66, 0x42, 0xb2, 0x2d, 0x46, 0x95, 0xde, 0x77, 0x46, 0x8e, 0xb7, 0xef, 0x5c,
-	0x82, 0xe9, 0x90, 0x0f, 0x33, 0x6b, 0x0a, 0xc0, 0xe3, 0x5a, 0x78, 0xea, 0xd9, 0xa2, 0xa5, 0xea,
-	0xef, 0x2b, 0xf0, 0x0d, 0x36, 0x9b, 0x89, 0x95, 0xcc, 0xc3, 0x78, 0xd1, 0x7a, 0xd1, 0xfa, 0x3d,
-	0x76, 0x37, 0xb3, 0x3c, 0x76, 0x79, 0xac, 0x0a, 0xba, 0xee, 0xfe, 0xa2, 0x04, 0xe7, 0xe5, 0xdc,
-	0xb8, 0x09, 0xe2, 0x68, 0x73, 0x73, 0x79, 0x19, 0x17, 0xf1, 0xd6, 0xf1, 0xa1, 0x4b, 0x9b, 0xf6,
-	0xfa, 0x2c, 0xfd, 0xc7, 0x0a, 0xac, 0x44, 0x3e, 0x6f, 0x72, 0x40, 0x36, 0x2d, 0xaf, 0x63, 0xf8,
-	0x8d, 0x7d, 0xbd, 0xe5, 0x34, 0x8c, 0x56, 0xab, 0x57, 0x19, 0xa2, 0x80, 0xf9, 0x52, 0xd2, 0x6b,
-	0xfe, 0x70, 0x6a, 0x91, 0x53, 0x7c, 0xc7, 0xd9, 0xe2, 0x3d, 0x3c, 0x64, 0x1d, 0x30, 0x1c, 0x5d,
-	0x36, 0xb2, 0x5b, 0x54, 0x7f, 0x05, 0x56, 0xf3, 0x18, 0x08, 0xf0, 0x76, 0x2b, 0x89, 0xb7, 0x62,
-	0x97, 0x7b, 0x00, 0x03, 0x94, 0x57, 0xc0, 0x98, 0x6e, 0xbb, 0x31, 0xec, 0xfd, 0xbe, 0x42, 0xb0,
-	0x37, 0x35, 0xcc, 0x7b, 0x86, 0xd5, 0x8a, 0x6c, 0xa9, 0x60, 0xac, 0x26, 0x8f, 0x4f, 0x41, 0x43,
-	0xfa, 0x06, 0xc1, 0xb1, 0x4c, 0x4e, 0xdc, 0x13, 0xfc, 0x43, 0x05, 0xd4, 0x34, 0xda, 0x7d, 0x16,
-	0x2c, 0xcf, 0x40, 0xf2, 0x67, 0xfd, 0x92, 0xdf, 0xc8, 0x90, 0x3c, 0x8f, 0x53, 0x41, 0xd9, 0x9f,
-	0x92, 0xc5, 0x29, 0xe1, 0xc5, 0x6d, 0xf3, 0x9b, 0x30, 0xd3, 0x30, 0xec, 0x06, 0x0e, 0x77, 0x00,
-	0xcc, 0xf6, 0xb4, 0x31, 0x6d, 0x9a, 0x95, 0x6b, 0x41, 0x71, 0x7c, 0xbd, 0xc7, 0x79, 0x9e, 0x70,
-	0xbd, 0xcb, 0x58, 0x15, 0x1c, 0xea, 0xc5, 0x70, 0xb9, 0x67, 0x30, 0x8b, 0x45, 0x03, 0x05, 0x0d,
-	0x4f, 0x62, 0x61, 0x99, 0x7c, 0x06, 0xb6, 0x30, 0x11, 0xa7, 0x84, 0x85, 0xa5, 0x07, 0x48, 0xe7,
-	0x27, 0x92, 0xbc, 0xb0, 0x85, 0xe5, 0x71, 0x2a, 0x28, 0xfb, 0x05, 0xb1, 0x39, 0x84, 0xbc, 0xb8,
-	0xf4, 0x7f, 0xa9, 0xc0, 0x39, 0x0d, 0xb7, 0x9d, 0x43, 0xcc, 0xc2, 0xe9, 0x5f, 0x16, 0x27, 0x5d,
-	0xf2, 0x60, 0x54, 0xea, 0x3b, 0x18, 0xa9, 0x2a, 0xb1, 0x95, 0x2c, 0xa9, 0xf9, 0xd0, 0xfe, 0x6a,
-	0x08, 0x2e, 0xf0, 0x21, 0xb0, 0x61, 0x67, 0xc6, 0x98, 0xa5, 0x03, 0x34, 0xa0, 0x9c, 0x5c, 0x83,
-	0x7c, 0x70, 0xb7, 0x32, 0xe6, 0xaf, 0x40, 0x87, 0xda, 0x54, 0x62, 0xf5, 0xa2, 0x5d, 0x58, 0x0c,
-	0x13, 0x45, 0x84, 0x09, 0x51, 0xe2, 0x08, 0xef, 0x5d, 0x4e, 0xd3, 0x17, 0xe1, 0xc5, 0xa2, 0xe2,
-	0x81, 0x93, 0x44, 0xd6, 0xe1, 0x62, 0xde, 0x58, 0xb8, 0x9e, 0xff, 0x5a, 0x81, 0xe5, 0xc0, 0x2b,
-	0x24, 0xb8, 0xa5, 0xbf, 0x11, 0xf3, 0xb9, 0x0c, 0xb3, 0x96, 0xa7, 0x27, 0xf3, 0x93, 0xa8, 0x2e,
-	0xc7, 0xb4, 0x69, 0xcb, 0xbb, 0x17, 0xcf, 0x3c, 0x52, 0x57, 0xe0, 0x8c, 0x58, 0x7c, 0x3e, 0xbe,
-	0x9f, 0x0d, 0x11, 0x04, 0x23, 0x60, 0x9d, 0x8c, 0x4a, 0xa7, 0xa0, 0xf5, 0x4d, 0x0c, 0x74, 0x0d,
-	0x26, 0x79, 0xf2, 0x19, 0x36, 0x63, 0x8e, 0xda, 0xb0, 0xac, 0x6e, 0xa2, 0x17, 0x70, 0xba, 0x11,
-	0x88, 0x1a, 0xeb, 0xfa, 0xd4, 0x40, 0x5d, 0xa3, 0x90, 0x45, 0xd4, 0xf7, 0x43, 0x98, 0x89, 0x25,
-	0x94, 0xb1, 0x4b, 0xc2, 0x70, 0xd1, 0x4b, 0xc2, 0x74, 0x44, 0x4a, 0x0b, 0xd4, 0x4b, 0x64, 0xb5,
-	0x4a, 0xb5, 0xcc, 0xe7, 0xe3, 0x5f, 0x87, 0xa0, 0xa2, 0xf1, 0x34, 0x48, 0x4c, 0x69, 0xbd, 0xe7,
-	0x1b, 0x6f, 0x72, 0x0e, 0x5e, 0xc2, 0x7c, 0xd2, 0x93, 0xd9, 0xd3, 0x2d, 0x1f, 0xb7, 0x83, 0xfc,
-	0x89, 0xcb, 0x85, 0xbc, 0x99, 0xbd, 0xba, 0x8f, 0xdb, 0xda, 0xe9, 0xc3, 0x54, 0x99, 0x87, 0xde,
-	0x87, 0x11, 0xaa, 0x5c, 0x8f, 0xcf, 0x99, 0xd8, 0xb3, 0xb1, 0x65, 0xf8, 0xc6, 0x9d, 0x96, 0xb3,
-	0xab, 0xf1, 0xc6, 0x68, 0x13, 0xca, 0x36, 0x3e, 0xd2, 0xdd, 0x2e, 0x9f, 0x9b, 0xe0, 0xea, 0x92,
-	0x43, 0x3e, 0x69, 0xe3, 0x23, 0xad, 0xcb, 0x26, 0xc5, 0x53, 0x97, 0x61, 0x49, 0xa0, 0x6b, 0x3e,
-	0x13, 0xdf, 0x53, 0x60, 0x61, 0xbb, 0x67, 0x37, 0xb6, 0xf7, 0x0d, 0xd7, 0xe4, 0x0e, 0x4e, 0x3e,
-	0x0f, 0x17, 0xa0, 0xec, 0x39, 0x5d, 0xb7, 0x81, 0x75, 0x9e, 0x21, 0xcb, 0x27, 0x63, 0x8a, 0x95,
-	0x6e, 0xb2, 0x42, 0xb4, 0x04, 0x63, 0x44, 0x1f, 0x66, 0xb0, 0x83, 0x0d, 0x6b, 0xa3, 0xf4, 0xbb,
-	0x6e, 0xa2, 0x1a, 0x9c, 0xa2, 0xb7, 0xc5, 0x52, 0xee, 0x15, 0x8e, 0xb6, 0x53, 0x97, 0x60, 0x31,
-	0x25, 0x0b, 0x97, 0xf3, 0xef, 0x86, 0xe1, 0x34, 0xa9, 0x0b, 0x76, 0xc2, 0x37, 0x69, 0x2c, 0x15,
-	0x18, 0x0d, 0x1c, 0x4a, 0x6c, 0xad, 0x06, 0x9f, 0x64, 0x29, 0x47, 0xb7, 0xd9, 0xd0, 0x53, 0x10,
-	0x7a, 0x16, 0x88, 0x4e, 0xd2, 0x6e, 0xa4, 0xe1, 0x41, 0xdd, 0x48, 0x67, 0x01, 0x82, 0x5b, 0x95,
-	0x65, 0xd2, 0x5b, 0x68, 0x49, 0x1b, 0xe7, 0x25, 0x75, 0x33, 0x75, 0x57, 0x1f, 0x1d, 0xec, 0xae,
-	0xfe, 0x80, 0x07, 0x6f, 0xa2, 0x6b, 0x33, 0xe5, 0x32, 0x96, 0xcb, 0x65, 0x96, 0x90, 0x85, 0x07,
-	0x60, 0xca, 0xeb, 0x3a, 0x8c, 0x06, 0x77, 0xee, 0xf1, 0x02, 0x77, 0xee, 0xa0, 0x71, 0xdc, 0x5f,
-	0x00, 0x49, 0x7f, 0xc1, 0x27, 0x30, 0xc9, 0x42, 0x4b, 0x3c, 0x99, 0x76, 0xa2, 0x40, 0x32, 0xed,
-	0x04, 0x8d, 0x38, 0xf1, 0x3c, 0xda, 0x77, 0x81, 0xe6, 0xc2, 0xf2, 0xd4, 0x70, 0xdd, 0x32, 0xb1,
-	0xed, 0x5b, 0x7e, 0x8f, 0x3a, 0xf3, 0xc6, 0x35, 0x44, 0xea, 0x5e, 0xd0, 0xaa, 0x3a, 0xaf, 0x41,
-	0x4f, 0x60, 0xba, 0x0f, 0x1b, 0xb8, 0xe3, 0xee, 0x62, 0x31, 0x54, 0xd0, 0xca, 0x49, 0x44, 0x50,
-	0x17, 0x60, 0x2e, 0x69, 0xca, 0xdc, 0xc6, 0x7f, 0x47, 0x81, 0xe5, 0x20, 0x73, 0xed, 0x4b, 0x72,
-	0x88, 0x53, 0x7f, 0x4b, 0x81, 0x33, 0x62, 0x99, 0xf8, 0xfd, 0xe6, 0x1a, 0x2c, 0xb4, 0x59, 0x39,
-	0x8b, 0xab, 0xe8, 0x96, 0xad, 0x37, 0x8c, 0xc6, 0x3e, 0xe6, 0x12, 0x9e, 0x6e, 0xc7, 0xa8, 0xea,
-	0xf6, 0x26, 0xa9, 0x42, 0x1f, 0xc0, 0x52, 0x8a, 0xc8, 0x34, 0x7c, 0x63, 0xd7, 0xf0, 0x30, 0x3f,
-	0x06, 0x2f, 0x24, 0xe9, 0xb6, 0x78, 0xad, 0x7a, 0x06, 0xaa, 0x81, 0x3c, 0x5c, 0x9f, 0x9f, 0x39,
-	0x61, 0xea, 0x91, 0xfa, 0x6b, 0x43, 0x91, 0x0a, 0x13, 0xd5, 0x5c, 0xda, 0x75, 0x98, 0xb1, 0xbb,
-	0xed, 0x5d, 0xec, 0xea, 0x4e, 0x53, 0xa7, 0x30, 0xe5, 0x51, 0x39, 0x87, 0xb5, 0x32, 0x2b, 0x7f,
-	0xd2, 0xa4, 0xe8, 0xe3, 0x11, 0x65, 0x07, 0xb0, 0xe6, 0x51, 0xef, 0xc1, 0xb0, 0x36, 0xc6, 0x71,
-	0xcd, 0x43, 0x0f, 0x60, 0x92, 0xcf, 0x04, 0x1b, 0x2a, 0x03, 0xb8, 0x4b, 0x59, 0xf6, 0xc0, 0xfc,
-	0x39, 0x74, 0xe8, 0xf4, 0x7c, 0x37, 0x61, 0x46, 0x05, 0xe8, 0x3a, 0x2c, 0xb2, 0x8e, 0x1a, 0x8e,
-	0xed, 0xbb, 0x4e, 0xab, 0x85, 0x5d, 0xaa, 0x94, 0x2e, 0xdb, 0x2b, 0xc6, 0xb5, 0x79, 0x5a, 0xbd,
-	0x19, 0xd6, 0x32, 0x64, 0xa4, 0x6b, 0xc4, 0x34, 0x5d, 0xec, 0x79, 0xdc, 0xe9, 0x18, 0x7c, 0xaa,
-	0x35, 0x98, 0x65, 0xa1, 0x29, 0x42, 0x17, 0x18, 0x4f, 0x1c, 0xa6, 0x95, 0x04, 0x4c, 0xab, 0x73,
-	0x80, 0xe2, 0xed, 0xb9, 0x35, 0xfe, 0xbb, 0x02, 0xb3, 0xec, 0x80, 0x1e, 0x3f, 0x09, 0x66, 0xb3,
-	0x41, 0x1f, 0xf3, 0x30, 0x6e, 0x18, 0xb5, 0x2e, 0x6f, 0xac, 0x66, 0xc6, 0x08, 0x0c, 0xef, 0x80,
-	0xba, 0xc6, 0x68, 0x24, 0x97, 0xba, 0xc5, 0x62, 0x0e, 0xd6, 0x52, 0xc2, 0xc1, 0xba, 0x09, 0xd3,
-	0x87, 0x96, 0x67, 0xed, 0x5a, 0x2d, 0xcb, 0xef, 0x31, 0x30, 0xca, 0xf7, 0x09, 0x96, 0x23, 0x12,
-	0x8a, 0x44, 0x6b, 0x30, 0xc9, 0x77, 0x31, 0xdd, 0x36, 0x38, 0xe8, 0x8e, 0x6b, 0x13, 0xbc, 0xec,
-	0xb1, 0xd1, 0xc6, 0x44, 0x0d, 0xf1, 0xf1, 0x46, 0x77, 0xda, 0x59, 0x9a, 0xa7, 0xf6, 0xac, 0x8b,
-	0xbb, 0xb8, 0x80, 0x1a, 0xfa, 0x7b, 0x1a, 0x4a, 0xf5, 0x94, 0xd4, 0x54, 0x69, 0x50, 0x4d, 0x31,
-	0x41, 0x23, 0x89, 0xb8, 0xa0, 0xbf, 0xab, 0xc0, 0x5c, 0x60, 0xfa, 0x5f, 0x1e, 0x59, 0x9f, 0xc0,
-	0x7c, 0x9f, 0x50, 0x7c, 0x25, 0x5e, 0x87, 0xc5, 0x8e, 0xeb, 0x34, 0xb0, 0xe7, 0x59, 0xf6, 0x9e,
-	0x4e, 0xdf, 0xcd, 0x30, 0x2c, 0x20, 0x0b, 0xb2, 0x44, 0xcc, 0x3e, 0xaa, 0xa6, 0x94, 0x14, 0x08,
-	0x3c, 0xf5, 0x3b, 0x0a, 0x9c, 0xbd, 0x8f, 0x7d, 0x2d, 0x7a, 0x44, 0xf3, 0x08, 0x7b, 0x9e, 0xb1,
-	0x87, 0xc3, 0x73, 0xcb, 0xa7, 0x30, 0x42, 0x83, 0x38, 0x8c, 0x91, 0x24, 0x14, 0x1d, 0xe3, 0x41,
-	0x43, 0x3c, 0x1a, 0xa7, 0x2b, 0xa0, 0x16, 0x02, 0x34, 0x2b, 0x59, 0x62, 0xf0, 0x11, 0xbe, 0x82,
-	0x32, 0xd3, 0x7b, 0x9b, 0xd7, 0x70, 0x79, 0x1e, 0x64, 0x3a, 0x21, 0xe5, 0x0c, 0x6b, 0x74, 0x7d,
-	0x06, 0xa5, 0xcc, 0xe1, 0x38, 0xe5, 0xc5, 0xcb, 0xaa, 0x6d, 0x40, 0xe9, 0x46, 0x71, 0xa7, 0xe2,
-	0x30, 0x73, 0x2a, 0xde, 0x4e, 0x3a, 0x15, 0xdf, 0x2a, 0xa0, 0xa1, 0x50, 0x9a, 0x98, 0x47, 0xd1,
-	0x86, 0xd5, 0xfb, 0xd8, 0xdf, 0x7a, 0xf8, 0x4c, 0x32, 0x1b, 0x0f, 0x00, 0xd8, 0xb2, 0xb6, 0x9b,
-	0x4e, 0xa0, 0x81, 0x22, 0xfd, 0x11, 0x5b, 0xa2, 0x60, 0x49, 0xcd, 0x8f, 0xfc, 0xf2, 0xd4, 0x1e,
-	0xac, 0x49, 0xfa, 0xe3, 0x6a, 0xdf, 0x81, 0xd9, 0xd8, 0x0b, 0x2b, 0x1a, 0x53, 0x0c, 0xfa, 0xbd,
-	0x54, 0xb0, 0x5f, 0x6d, 0xc6, 0x4d, 0x16, 0x78, 0xea, 0x3f, 0x2a, 0x30, 0xa7, 0x61, 0xa3, 0xd3,
-	0x69, 0xb1, 0xcb, 0x4f, 0x38, 0xbe, 0x05, 0x18, 0xe1, 0x4e, 0x7c, 0xb6, 0xdf, 0xf1, 0x2f, 0xf9,
-	0xb3, 0x12, 0xf1, 0x66, 0x5d, 0x3a, 0xe9, 0xc1, 0xf4, 0x78, 0xb7, 0x0c, 0x75, 0x11, 0xe6, 0xfb,
-	0x86, 0xc6, 0x21, 0xe5, 0x4f, 0x15, 0x58, 0xd6, 0x70, 0xd3, 0xc5, 0xde, 0x7e, 0x18, 0xcf, 0x20,
-	0xda, 0xf8, 0x12, 0x8e, 0x5d, 0x5d, 0x81, 0x33, 0x62, 0x51, 0xf9, 0x58, 0x7e, 0x3a, 0x04, 0x0b,
-	0x1a, 0x36, 0xcc, 0xad, 0x87, 0xcf, 0xfa, 0x4d, 0xf4, 0x1a, 0x9c, 0x0a, 0xf3, 0x08, 0xca, 0x1b,
-	0xe7, 0x32, 0x77, 0xf1, 0x87, 0xcf, 0x28, 0xb8, 0xd1, 0xc6, 0xb2, 0x6b, 0x4f, 0xfa, 0xe2, 0x54,
-	0x12, 0x5d, 0x9c, 0x76, 0xa0, 0x62, 0xd9, 0xa4, 0x85, 0x75, 0x88, 0x75, 0x6c, 0x87, 0x38, 0x51,
-	0x30, 0xf9, 0x6a, 0x3e, 0x24, 0xbe, 0x6b, 0x07, 0x0b, 0xbe, 0x6e, 0x12, 0xdd, 0x77, 0x08, 0x13,
-	0xcf, 0xfa, 0x9c, 0xed, 0x72, 0xc3, 0xda, 0x18, 0x29, 0xd8, 0xb6, 0x3e, 0xc7, 0xe8, 0x22, 0x4c,
-	0xd3, 0x14, 0x02, 0xda, 0x82, 0x45, 0xba, 0x47, 0x68, 0xa4, 0x9b, 0x66, 0x16, 0x3c, 0x35, 0xf6,
-	0x30, 0x4b, 0x7c, 0xfb, 0xf3, 0x21, 0x58, 0x4c, 0x29, 0x2b, 0x3c, 0xf0, 0x1d, 0x43, 0x5b, 0xc2,
-	0x45, 0x39, 0x74, 0xc2, 0x45, 0x89, 0x0c, 0x58, 0x48, 0x71, 0x0d, 0x7c, 0x6e, 0x03, 0xe3, 0xcc,
-	0x5c, 0x3f, 0x7b, 0xea, 0x73, 0x13, 0x68, 0xec, 0x94, 0x48, 0x63, 0xff, 0xa2, 0xc0, 0xe2, 0xd3,
-	0xae, 0xbb, 0x87, 0xbf, 0xe2, 0xf6, 0xa5, 0x56, 0xa1, 0x92, 0x1e, 0x27, 0x5f, 0x63, 0x7f, 0x36,
-	0x04, 0x8b, 0x8f, 0xf0, 0x57, 0x5f, 0x09, 0xff, 0x3b, 0x8b, 0xec, 0x0e, 0x54, 0xd2, 0xca, 0xe2,
-	0x8b, 0x4c, 0xc0, 0x43, 0x11, 0xf1, 0xf8, 0x55, 0x05, 0xce, 0x3c, 0x76, 0x7c, 0xab, 0xd9, 0x23,
-	0xf7, 0x5b, 0xe7, 0x10, 0xbb, 0x8f, 0x0c, 0x72, 0x79, 0x0d, 0xd5, 0x6e, 0xc0, 0x42, 0x93, 0xd7,
-	0xe8, 0x6d, 0x5a, 0xa5, 0x27, 0x0e, 0x47, 0x99, 0x4b, 0x24, 0xc9, 0x8f, 0x9d, 0x8f, 0xe6, 0x9a,
-	0xe9, 0x42, 0x4f, 0x3d, 0x07, 0x67, 0x33, 0x44, 0xe0, 0x66, 0x61, 0xc0, 0xf2, 0x7d, 0xec, 0x6f,
-	0xba, 0x8e, 0xe7, 0xf1, 0x69, 0x49, 0xec, 0x22, 0x89, 0x9b, 0x96, 0xd2, 0x77, 0xd3, 0xba, 0x00,
-	0x65, 0xdf, 0x70, 0xf7, 0xb0, 0x1f, 0x4e, 0x33, 0xdb, 0x4f, 0xa6, 0x58, 0x29, 0xe7, 0xa7, 0xfe,
-	0x57, 0x09, 0xce, 0x88, 0xfb, 0xe0, 0x0a, 0x6d, 0x13, 0x3e, 0x04, 0x1e, 0x76, 0x7b, 0xec, 0xde,
-	0xc7, 0xc7, 0x7f, 0x5f, 0x76, 0x18, 0xcb, 0x64, 0x47, 0x4f, 0xba, 0xde, 0x9d, 0x1e, 0x3d, 0x6c,
-	0xb1, 0x93, 0xd8, 0xa4, 0x1f, 0x2b, 0x42, 0xdf, 0x56, 0x60, 0xbe, 0x49, 0x83, 0x4c, 0x7a, 0xc3,
-	0xe8, 0x7a, 0x38, 0xea, 0x96, 0x81, 0xde, 0xa3, 0xe3, 0x75, 0xcb, 0xe2, 0x56, 0x9b, 0x84, 0x63,
-	0xa2, 0x73, 0xd4, 0x4c, 0x55, 0x54, 0x5f, 0xc1, 0x6c, 0x4a, 0x4a, 0xc1, 0x51, 0xf0, 0x5e, 0xf2,
-	0x28, 0xf8, 0x6e, 0x96, 0x3d, 0xf4, 0x0b, 0xc5, 0x67, 0x2f, 0x7e, 0x1e, 0xac, 0xbe, 0x82, 0xc5,
-	0x0c, 0x09, 0x05, 0x1d, 0x7f, 0x1a, 0xef, 0xb8, 0x9c, 0xed, 0x62, 0xbd, 0x8f, 0xfd, 0x28, 0x64,
-	0x47, 0x19, 0xc7, 0x8f, 0xa0, 0xff, 0xa6, 0xc0, 0x3a, 0x0f, 0x92, 0xa5, 0xd4, 0x96, 0xf2, 0xee,
-	0x4b, 0x6e, 0x42, 0xc5, 0xec, 0x0c, 0xbd, 0x60, 0x66, 0x14, 0x66, 0x33, 0x04, 0x0e, 0xe2, 0x01,
-	0xd4, 0xc6, 0x93, 0x18, 0xa6, 0xfc, 0xd8, 0x97, 0x87, 0xce, 0xc3, 0x54, 0x13, 0xfb, 0x8d, 0xfd,
-	0xc7, 0x98, 0x9d, 0x5b, 0x78, 0x54, 0x27, 0x59, 0xa8, 0x7a, 0xf0, 0xcd, 0x02, 0x83, 0x0d, 0xf3,
-	0x24, 0x87, 0x83, 0xc3, 0xef, 0x31, 0x67, 0x96, 0x92, 0xab, 0xef, 0xd3, 0x87, 0x58, 0xc1, 0xe2,
-	0xa6, 0x7b, 0x65, 0x01, 0x87, 0x94, 0xea, 0xd3, 0x97, 0x4c, 0x49, 0x32, 0x2e, 0xd9, 0x06, 0xcc,
-	0x47, 0xe1, 0x8c, 0xc0, 0xf9, 0xd1, 0xe5, 0xf9, 0x49, 0xc3, 0x5a, 0x14, 0xeb, 0xd8, 0x66, 0x9e,
-	0x8f, 0xae, 0x4d, 0xbd, 0xd1, 0xc1, 0x53, 0x41, 0xee, 0xb7, 0x61, 0x4e, 0x99, 0x29, 0x5e, 0xca,
-	0xdc, 0x36, 0x1b, 0x7f, 0xff, 0x36, 0x00, 0x77, 0xfc, 0xdc, 0x7e, 0x5a, 0x47, 0xdf, 0x55, 0x60,
-	0x41, 0xfc, 0xb4, 0x18, 0x5d, 0xcf, 0x5c, 0x82, 0xd2, 0xc7, 0xcd, 0xd5, 0x1b, 0x03, 0xd3, 0xf1,
-	0x51, 0xff, 0xa6, 0x02, 0x8b, 0x19, 0x8f, 0xb6, 0x91, 0x84, 0xa9, 0xf4, 0x19, 0x7b, 0xf5, 0xe6,
-	0xe0, 0x84, 0x5c, 0x9c, 0x9f, 0x28, 0xb0, 0x9a, 0xf7, 0xfe, 0x1a, 0x7d, 0x9a, 0xc7, 0x3e, 0xef,
-	0x2d, 0x78, 0xf5, 0xf6, 0x09, 0x38, 0x70, 0x49, 0xc9, 0x24, 0x8a, 0x5f, 0x56, 0x4b, 0x26, 0x51,
-	0xfa, 0xa2, 0x5b, 0x32, 0x89, 0x39, 0x4f, 0xb8, 0x7f, 0x4f, 0x81, 0x6a, 0xf6, 0xbb, 0x68, 0x94,
-	0x9d, 0xd6, 0x94, 0xfb, 0x2e, 0xbb, 0xfa, 0xe1, 0xb1, 0x68, 0xb9, 0x5c, 0x3f, 0x50, 0x60, 0x29,
-	0xf3, 0xd5, 0x33, 0xfa, 0x20, 0x93, 0x75, 0xde, 0xa3, 0xeb, 0xea, 0xad, 0xe3, 0x90, 0x72, 0xa1,
-	0x6c, 0x98, 0x4a, 0x3c, 0x87, 0x45, 0xef, 0x64, 0x32, 0x13, 0xbd, 0xba, 0xad, 0xd6, 0x8a, 0x36,
-	0xe7, 0xfd, 0x7d, 0x5b, 0x81, 0xd3, 0x82, 0x37, 0xa5, 0xe8, 0x9a, 0x7c, 0xb6, 0x85, 0xaf, 0x58,
-	0xab, 0xef, 0x0d, 0x46, 0xc4, 0x45, 0xf0, 0x61, 0xba, 0xef, 0xfd, 0x26, 0xba, 0x22, 0xdb, 0xeb,
-	0x05, 0x7e, 0xfe, 0xea, 0xbb, 0xc5, 0x09, 0x78, 0xaf, 0x47, 0x30, 0xd3, 0xff, 0x4e, 0x09, 0x65,
-	0x73, 0xc9, 0x78, 0xc9, 0x55, 0xbd, 0x3a, 0x00, 0x45, 0xcc, 0xec, 0x32, 0x13, 0xf6, 0x24, 0x66,
-	0x97, 0xf7, 0x56, 0xa2, 0x7a, 0x82, 0xfc, 0x40, 0xf4, 0x87, 0x0a, 0x9c, 0x91, 0xe5, 0xf3, 0xa1,
-	0x8f, 0x8e, 0x99, 0x06, 0xc8, 0x44, 0xfb, 0xf8, 0x44, 0x49, 0x84, 0x5c, 0x65, 0x19, 0x49, 0x6f,
-	0x52, 0x95, 0xc9, 0x53, 0xee, 0xa4, 0x2a, 0xcb, 0xc9, 0xb1, 0x8b, 0xcd, 0xa3, 0x20, 0xa3, 0x38,
-	0x77, 0x1e, 0xb3, 0x73, 0xb9, 0x73, 0xe7, 0x51, 0x96, 0xc0, 0x1c, 0x9b, 0x47, 0x61, 0xde, 0x59,
-	0xfe, 0x3c, 0xca, 0x72, 0xdf, 0xf2, 0xe7, 0x51, 0x9a, 0xec, 0x16, 0x9f, 0xc7, 0x74, 0x6a, 0x59,
-	0xfe, 0x3c, 0x66, 0x26, 0xb6, 0xe5, 0xcf, 0x63, 0x76, 0x26, 0x1b, 0xfa, 0x03, 0xea, 0xb1, 0xcb,
-	0xcc, 0x19, 0x43, 0x1f, 0x0e, 0x34, 0xe6, 0x64, 0xd6, 0x5a, 0xf5, 0xa3, 0xe3, 0x11, 0x27, 0x44,
-	0xcb, 0x4c, 0x98, 0x94, 0x8a, 0x96, 0x97, 0xb2, 0x29, 0x15, 0x2d, 0x3f, 0x47, 0xf3, 0x8f, 0x14,
-	0x58, 0x91, 0x67, 0x4a, 0xa1, 0x6f, 0x49, 0x3a, 0x28, 0x90, 0x2e, 0x56, 0xfd, 0xe4, 0xd8, 0xf4,
-	0x5c, 0xc6, 0xdf, 0x56, 0xa0, 0x92, 0x95, 0x2f, 0x87, 0x6e, 0x4a, 0xb8, 0x4b, 0x13, 0x03, 0xab,
-	0x1f, 0x1c, 0x83, 0x92, 0x4b, 0xf4, 0xeb, 0x0a, 0xcc, 0x89, 0xb2, 0xae, 0x50, 0xf6, 0xce, 0x29,
-	0xc9, 0x31, 0xab, 0xbe, 0x3f, 0x20, 0x15, 0x97, 0xe2, 0x47, 0xf4, 0x2f, 0x80, 0x24, 0x49, 0x47,
-	0xe8, 0xe3, 0x1c, 0xdb, 0x90, 0xa7, 0x84, 0x55, 0xbf, 0x75, 0x5c, 0x72, 0x2e, 0xe0, 0xe7, 0x30,
-	0x9b, 0x4a, 0xbf, 0x41, 0x57, 0x25, 0x4c, 0xc5, 0x69, 0x51, 0xd5, 0x8d, 0x41, 0x48, 0xa2, 0xd3,
-	0x48, 0x5f, 0x42, 0x8d, 0xe4, 0x34, 0x22, 0x4e, 0x03, 0x92, 0x9c, 0x46, 0x32, 0x72, 0x75, 0xd0,
-	0x01, 0x4c, 0xc6, 0xf3, 0x1b, 0xd0, 0xdb, 0x52, 0x0e, 0x7d, 0x19, 0x3d, 0xd5, 0x77, 0x0a, 0xb6,
-	0x8e, 0x59, 0xa1, 0x28, 0x41, 0x41, 0x62, 0x85, 0x92, 0x1c, 0x0b, 0x89, 0x15, 0x4a, 0xb3, 0x20,
-	0xc8, 0xc9, 0x53, 0x90, 0x77, 0x20, 0x39, 0x79, 0x66, 0x27, 0x31, 0x54, 0xdf, 0x1b, 0x8c, 0x28,
-	0x7c, 0x6b, 0x01, 0x51, 0x14, 0x1f, 0x5d, 0xce, 0xe4, 0x91, 0x4a, 0x0d, 0xa8, 0xbe, 0x55, 0xa8,
-	0x6d, 0xd4, 0x4d, 0x14, 0x25, 0x97, 0x74, 0x93, 0x4a, 0x1d, 0x90, 0x74, 0x93, 0x0e, 0xbb, 0xb3,
-	0x6e, 0x82, 0x18, 0xb7, 0xb4, 0x9b, 0xbe, 0xd0, 0xbc, 0xb4, 0x9b, 0xfe, 0xa0, 0x39, 0xb9, 0xa1,
-	0x24, 0xc2, 0xd3, 0x92, 0x1b, 0x8a, 0x28, 0xb6, 0x2e, 0xb9, 0xa1, 0x88, 0xa3, 0xde, 0xdf, 0x65,
-	0xff, 0x6a, 0x23, 0x88, 0x5f, 0x4a, 0xae, 0xb2, 0xd2, 0x70, 0xb7, 0xe4, 0x2a, 0x9b, 0x13, 0x9f,
-	0x26, 0x07, 0x98, 0xcc, 0x70, 0xaa, 0xe4, 0x00, 0x93, 0x17, 0xf2, 0x95, 0x1c, 0x60, 0xf2, 0xa3,
-	0xb7, 0x36, 0x4c, 0x25, 0x62, 0x91, 0x92, 0x09, 0x11, 0x85, 0x63, 0x25, 0x13, 0x22, 0x0c, 0x71,
-	0x52, 0xf8, 0x10, 0xc5, 0x0d, 0x91, 0xec, 0xfa, 0x97, 0x19, 0x11, 0x95, 0xc0, 0x87, 0x2c, 0x38,
-	0x49, 0x70, 0xba, 0x2f, 0xdc, 0x26, 0xc1, 0x69, 0x71, 0x14, 0x53, 0x82, 0xd3, 0x59, 0x91, 0x3c,
-	0x72, 0x6b, 0xec, 0x0b, 0xe5, 0xc8, 0x6e, 0x8d, 0xe2, 0xe8, 0x96, 0xec, 0xd6, 0x98, 0x11, 0x27,
-	0x22, 0x1d, 0xf7, 0x47, 0x3e, 0x24, 0x1d, 0x67, 0x44, 0x94, 0x24, 0x1d, 0x67, 0x86, 0x55, 0x7e,
-	0x43, 0x81, 0x79, 0x61, 0xac, 0x02, 0x65, 0x4f, 0x9c, 0x2c, 0xbc, 0x52, 0xbd, 0x3e, 0x28, 0x59,
-	0xcc, 0xec, 0x44, 0x9e, 0x7e, 0x89, 0xd9, 0x49, 0x42, 0x28, 0x12, 0xb3, 0x93, 0x06, 0x45, 0x7e,
-	0xaa, 0x84, 0xaf, 0x63, 0xb2, 0xfd, 0xc9, 0xe8, 0x76, 0xde, 0xb1, 0x3f, 0xd7, 0xf1, 0x5e, 0xbd,
-	0x73, 0x12, 0x16, 0x09, 0xcf, 0x4a, 0xdc, 0x9f, 0x2c, 0xf7, 0xac, 0x08, 0x1c, 0xd6, 0x72, 0xcf,
-	0x8a, 0xc8, 0x55, 0x7d, 0xe7, 0xee, 0xdf, 0x7e, 0xb1, 0xa2, 0xfc, 0xc3, 0x17, 0x2b, 0xca, 0x3f,
-	0x7f, 0xb1, 0xa2, 0xfc, 0xe2, 0x8d, 0x3d, 0xcb, 0xdf, 0xef, 0xee, 0xd6, 0x1a, 0x4e, 0xfb, 0x4a,
-	0xe2, 0x3f, 0x7b, 0x6b, 0x7b, 0xd8, 0x66, 0x7f, 0xbe, 0x1c, 0xfb, 0xf7, 0xe7, 0x0f, 0xf9, 0xcf,
-	0xc3, 0xab, 0xbb, 0x23, 0xb4, 0xee, 0xda, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x0e, 0x74,
-	0xdb, 0x29, 0x5a, 0x00, 0x00,
+	// 4797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0x68, 0x52, 0xfc, 0x3d, 0x92, 0x43, 0xb2, 0xc4, 0xcf, 0xb0, 0x29, 0x51, 0x64, 0x5b, 0xb2,
+	0x69, 0x79, 0x3d, 0x94, 0x28, 0xeb, 0x63, 0xd9, 0x5e, 0x9b, 0x22, 0x25, 0x79, 0x0c, 0xc9, 0x92,
+	0x9a, 0x5c, 0x3b, 0x09, 0x02, 0x37, 0x9a, 0xd3, 0x35, 0x64, 0x87, 0x33, 0xdd, 0xa3, 0xae, 0x1e,
+	0x52, 0xe3, 0x43, 0xe0, 0x7c, 0x10, 0x20, 0x8b, 0x45, 0x3e, 0x9b, 0x4d, 0x10, 0x20, 0xc0, 0x02,
+	0xc1, 0x06, 0xd8, 0x64, 0x73, 0xc8, 0x21, 0xb9, 0x05, 0x39, 0xe5, 0x92, 0xdc, 0x72, 0xcd, 0x29,
+	0x81, 0xb1, 0xc8, 0x21, 0x01, 0x92, 0x43, 0x82, 0x1c, 0x83, 0xa0, 0xab, 0xaa, 0x7f, 0xd3, 0xd5,
+	0x35, 0x3d, 0xc3, 0x00, 0x72, 0xbc, 0xbe, 0x4d, 0x57, 0xbd, 0xf7, 0xea, 0xd5, 0xab, 0x57, 0xaf,
+	0xea, 0x7d, 0x6a, 0xe0, 0x4a, 0xfb, 0x00, 0x7b, 0x9b, 0x35, 0xd3, 0xc2, 0x4e, 0x0d, 0x6f, 0x1e,
+	0xd9, 0xc4, 0x77, 0xbd, 0xce, 0xe6, 0xc9, 0xf5, 0x4d, 0x82, 0xbd, 0x13, 0xbb, 0x86, 0x2b, 0x2d,
+	0xcf, 0xf5, 0x5d, 0xb4, 0x14, 0x80, 0x55, 0x38, 0x58, 0x85, 0x83, 0x55, 0x4e, 0xae, 0xab, 0xab,
+	0x87, 0xae, 0x7b, 0xd8, 0xc0, 0x9b, 0x14, 0xec, 0xa0, 0x5d, 0xdf, 0xb4, 0xda, 0x9e, 0xe9, 0xdb,
+	0xae, 0xc3, 0x10, 0xd5, 0x4b, 0xdd, 0xfd, 0xbe, 0xdd, 0xc4, 0xc4, 0x37, 0x9b, 0x2d, 0x0e, 0x90,
+	0x21, 0x70, 0xea, 0x99, 0xad, 0x16, 0xf6, 0x08, 0xef, 0x5f, 0x4b, 0x31, 0x68, 0xb6, 0xec, 0x80,
+	0xb9, 0x9a, 0xdb, 0x6c, 0x46, 0x43, 0xac, 0x8b, 0x20, 0x42, 0x16, 0x39, 0x17, 0x22, 0x90, 0xe7,
+	0x6d, 0x1c, 0x01, 0x68, 0x22, 0x00, 0xdf, 0x24, 0xc7, 0x0d, 0x9b, 0xf8, 0x32, 0x98, 0x53, 0xd7,
+	0x3b, 0xae, 0x37, 0xdc, 0x53, 0x0e, 0x73, 0x55, 0x04, 0xc3, 0x45, 0x69, 0x74, 0xc1, 0x6e, 0xf4,
+	0x82, 0xc5, 0x1e, 0x87, 0xbc, 0x9c, 0x82, 0x24, 0x47, 0xa6, 0x87, 0x2d, 0x2a, 0x86, 0x46, 0x9b,
+	0xf8, 0x3d, 0xa1, 0xd2, 0xa2, 0xd0, 0x72, 0xa0, 0x9e, 0xb7, 0x71, 0x1b, 0x0b, 0x39, 0x8b, 0x61,
+	0x3c, 0xdc, 0x6a, 0xd8, 0xb5, 0xe4, 0xf2, 0x5e, 0xc9, 0x81, 0x4c, 0x4f, 0x55, 0xfb, 0xd3, 0x11,
+	0xb8, 0xb8, 0xe7, 0x9b, 0x9e, 0xff, 0x29, 0x6f, 0xbf, 0xff, 0x02, 0xd7, 0xda, 0x01, 0x1d, 0x1d,
+	0x3f, 0x6f, 0x63, 0xe2, 0xa3, 0x47, 0x30, 0xe6, 0xb1, 0x9f, 0x65, 0x65, 0x4d, 0xd9, 0x98, 0xdc,
+	0xda, 0xaa, 0xa4, 0x54, 0xce, 0x6c, 0xd9, 0x95, 0x93, 0xeb, 0x15, 0x29, 0x11, 0x3d, 0x24, 0x81,
+	0x56, 0x60, 0xc2, 0x72, 0x9b, 0xa6, 0xed, 0x18, 0xb6, 0x55, 0x1e, 0x5a, 0x53, 0x36, 0x26, 0xf4,
+	0x71, 0xd6, 0x50, 0xb5, 0xd0, 0x2f, 0xc2, 0x42, 0xcb, 0xf4, 0xb0, 0xe3, 0x1b, 0x38, 0x24, 0x60,
+	0xd8, 0x4e, 0xdd, 0x2d, 0x0f, 0xd3, 0x81, 0x37, 0x84, 0x03, 0x3f, 0xa5, 0x18, 0xd1, 0x88, 0x55,
+	0xa7, 0xee, 0xea, 0xe7, 0x5b, 0xd9, 0x46, 0x54, 0x86, 0x31, 0xd3, 0xf7, 0x71, 0xb3, 0xe5, 0x97,
+	0xcf, 0xad, 0x29, 0x1b, 0x23, 0x7a, 0xf8, 0x89, 0x76, 0x60, 0x06, 0xbf, 0x68, 0xd9, 0x6c, 0x7b,
+	0x18, 0xc1, 0x3e, 0x28, 0x8f, 0xd0, 0x11, 0xd5, 0x0a, 0xdb, 0x03, 0x95, 0x70, 0x0f, 0x54, 0xf6,
+	0xc3, 0x4d, 0xa2, 0x97, 0x62, 0x94, 0xa0, 0x11, 0xd5, 0x61, 0xb9, 0xe6, 0x3a, 0xbe, 0xed, 0xb4,
+	0xb1, 0x61, 0x12, 0xc3, 0xc1, 0xa7, 0x86, 0xed, 0xd8, 0xbe, 0x6d, 0xfa, 0xae, 0x57, 0x1e, 0x5d,
+	0x53, 0x36, 0x4a, 0x5b, 0x6f, 0x08, 0x27, 0xb0, 0xc3, 0xb1, 0xb6, 0xc9, 0xc7, 0xf8, 0xb4, 0x1a,
+	0xa2, 0xe8, 0x8b, 0x35, 0x61, 0x3b, 0xaa, 0xc2, 0x5c, 0xd8, 0x63, 0x19, 0x75, 0xd3, 0x6e, 0xb4,
+	0x3d, 0x5c, 0x1e, 0xa3, 0xec, 0x5e, 0x10, 0xd2, 0x7f, 0xc0, 0x60, 0xf4, 0xd9, 0x08, 0x8d, 0xb7,
+	0x20, 0x1d, 0x16, 0x1b, 0x26, 0xf1, 0x8d, 0x9a, 0xdb, 0x6c, 0x35, 0x30, 0x9d, 0xbc, 0x87, 0x49,
+	0xbb, 0xe1, 0x97, 0xc7, 0x25, 0xf4, 0x9e, 0x9a, 0x9d, 0x86, 0x6b, 0x5a, 0xfa, 0x7c, 0x80, 0xbb,
+	0x13, 0xa1, 0xea, 0x14, 0x13, 0xfd, 0x1c, 0xac, 0xd4, 0x6d, 0x8f, 0xf8, 0x86, 0x85, 0x6b, 0x36,
+	0xa1, 0xf2, 0x34, 0xc9, 0xb1, 0x71, 0x60, 0xd6, 0x8e, 0xdd, 0x7a, 0xbd, 0x3c, 0x41, 0x09, 0x2f,
+	0x67, 0xe4, 0xba, 0xcb, 0x8d, 0x93, 0x5e, 0xa6, 0xd8, 0xbb, 0x1c, 0x79, 0xdf, 0x24, 0xc7, 0xf7,
+	0x18, 0xaa, 0x76, 0x1b, 0x56, 0xf3, 0x94, 0x8c, 0xb4, 0x5c, 0x87, 0x60, 0xb4, 0x00, 0xa3, 0x5e,
+	0x9b, 0x6a, 0x96, 0x42, 0x35, 0x6b, 0xc4, 0x6b, 0x3b, 0x55, 0x4b, 0xfb, 0x93, 0x21, 0x58, 0xdd,
+	0xb3, 0x0f, 0x1d, 0xb3, 0x91, 0xab, 0xe4, 0x8f, 0xbb, 0x95, 0xfc, 0x86, 0x58, 0xc9, 0xa5, 0x54,
+	0x0a, 0x6a, 0x79, 0x1d, 0x56, 0xf0, 0x0b, 0x1f, 0x7b, 0x8e, 0xd9, 0x88, 0x0c, 0x4f, 0xac, 0xf0,
+	0x5c, 0xd7, 0x5f, 0x15, 0x8e, 0x9f, 0x1d, 0x79, 0x39, 0x24, 0x95, 0xe9, 0x42, 0x15, 0x38, 0x5f,
+	0x3b, 0xb2, 0x1b, 0x56, 0x3c, 0x88, 0xeb, 0x34, 0x3a, 0x54, 0xf7, 0xc7, 0xf5, 0x39, 0xda, 0x15,
+	0x22, 0x3d, 0x71, 0x1a, 0x1d, 0x6d, 0x1d, 0x2e, 0xe5, 0xce, 0x8f, 0x09, 0x58, 0xfb, 0xa1, 0x02,
+	0xaf, 0x71, 0x18, 0xdb, 0x3f, 0x92, 0xdb, 0x8d, 0x4f, 0xba, 0x45, 0xfa, 0xae, 0x4c, 0xa4, 0xbd,
+	0xc8, 0x15, 0x93, 0xad, 0xb6, 0x0d, 0x1b, 0xbd, 0x09, 0xca, 0xb5, 0xe5, 0xbb, 0x0a, 0x5c, 0xd4,
+	0x31, 0xc1, 0x67, 0xb6, 0x88, 0x52, 0x22, 0x05, 0xe7, 0x73, 0x1b, 0x56, 0xf3, 0xc8, 0xc8, 0x67,
+	0xf1, 0x7d, 0x05, 0xd6, 0xf7, 0xb1, 0xd7, 0xb4, 0x1d, 0xd3, 0xc7, 0xb9, 0x33, 0x79, 0xda, 0x3d,
+	0x93, 0x5b, 0xc2, 0x99, 0xf4, 0x24, 0x54, 0x70, 0x36, 0x97, 0x41, 0x93, 0x91, 0xe2, 0x4a, 0xf6,
+	0x3d, 0x05, 0x56, 0x77, 0x71, 0x03, 0xfb, 0xf8, 0xac, 0xdb, 0x55, 0x4e, 0xa5, 0x20, 0xd3, 0xeb,
+	0x70, 0x29, 0x97, 0x0e, 0xe7, 0xf8, 0x3f, 0x14, 0xb8, 0xf2, 0x9d, 0x16, 0xc1, 0xb1, 0xb6, 0xed,
+	0x61, 0xd3, 0xab, 0x1d, 0x6d, 0xfb, 0xbe, 0x67, 0x1f, 0xb4, 0x7d, 0x4c, 0x74, 0xd1, 0x48, 0x4a,
+	0x97, 0x61, 0xf8, 0x0e, 0x20, 0x81, 0x3d, 0x18, 0xea, 0xcb, 0x1e, 0xcc, 0x9d, 0x76, 0x37, 0x21,
+	0x1d, 0xe6, 0x08, 0x65, 0xc7, 0x30, 0x23, 0x7e, 0xb8, 0x95, 0xb9, 0x22, 0xde, 0x92, 0xdd, 0xcc,
+	0xcf, 0x92, 0xae, 0x16, 0x6d, 0x03, 0x5e, 0xed, 0x35, 0x61, 0x2e, 0x9b, 0xdf, 0x51, 0x60, 0x6d,
+	0x17, 0x93, 0x9a, 0x67, 0x1f, 0xe4, 0xaf, 0xe7, 0x93, 0xee, 0xf5, 0xbc, 0x99, 0xb3, 0x9e, 0x72,
+	0x3a, 0x05, 0x57, 0xf4, 0x7f, 0x86, 0x61, 0x5d, 0x42, 0x8a, 0x6f, 0xac, 0x06, 0x2c, 0xc5, 0xb7,
+	0x90, 0x9a, 0xeb, 0xd4, 0xed, 0x43, 0x7e, 0x46, 0x49, 0x75, 0x2e, 0x43, 0x70, 0x27, 0x89, 0xaa,
+	0x2f, 0x62, 0x61, 0x3b, 0x3a, 0x80, 0xa5, 0xec, 0xda, 0xb3, 0xcb, 0x0f, 0x53, 0x80, 0xab, 0xc5,
+	0x46, 0xa3, 0xd7, 0x9f, 0x85, 0x53, 0x51, 0x33, 0xfa, 0x14, 0x50, 0x0b, 0x3b, 0x96, 0xed, 0x1c,
+	0x1a, 0x66, 0xcd, 0xb7, 0x4f, 0x6c, 0xdf, 0xa6, 0x9a, 0x30, 0x9c, 0x7f, 0xb7, 0x62, 0xe0, 0xdb,
+	0x0c, 0xba, 0x43, 0x89, 0xcf, 0xb5, 0x52, 0x8d, 0x36, 0x26, 0xe8, 0xe7, 0x61, 0x36, 0x24, 0x4c,
+	0x8f, 0x15, 0x0f, 0x3b, 0xe5, 0x73, 0x94, 0x6c, 0x45, 0x46, 0x76, 0x27, 0x80, 0x4d, 0x73, 0x3e,
+	0xd3, 0x4a, 0x74, 0x79, 0xd8, 0x41, 0x7b, 0x31, 0xe9, 0xf0, 0x42, 0xc1, 0xef, 0x66, 0x52, 0x8e,
+	0xc3, 0xfb, 0x43, 0x8a, 0x68, 0xd8, 0xa8, 0xbd, 0x80, 0xf9, 0x67, 0x81, 0x8b, 0x11, 0x4a, 0x2f,
+	0x54, 0xc3, 0x9d, 0x6e, 0x35, 0x7c, 0x5d, 0x38, 0x86, 0x08, 0xb7, 0xa0, 0xea, 0xfd, 0x48, 0x81,
+	0x85, 0x2e, 0x74, 0xae, 0x6e, 0xef, 0xc3, 0x14, 0x75, 0x7b, 0xc2, 0x1b, 0x98, 0x52, 0xe0, 0x06,
+	0x36, 0x49, 0x31, 0xf8, 0xc5, 0xab, 0x0a, 0xa5, 0x90, 0xc0, 0x2f, 0xe1, 0x9a, 0x8f, 0x2d, 0xae,
+	0x38, 0x5a, 0xfe, 0x1c, 0x74, 0x0e, 0xa9, 0x4f, 0x3f, 0x4f, 0x7e, 0x6a, 0xbf, 0xae, 0x80, 0x4a,
+	0x8f, 0x9d, 0x3d, 0xdf, 0xae, 0x1d, 0x77, 0x82, 0x4b, 0xd8, 0x23, 0x9b, 0xf8, 0xa1, 0x98, 0xaa,
+	0xdd, 0x62, 0xda, 0xcc, 0x3f, 0xff, 0x84, 0x14, 0x0a, 0x0a, 0xeb, 0x22, 0xac, 0x08, 0x69, 0x70,
+	0xcb, 0xf2, 0x9f, 0x0a, 0x2c, 0x3e, 0xc4, 0xfe, 0xe3, 0xb6, 0x6f, 0x1e, 0x34, 0xf0, 0x9e, 0x6f,
+	0xfa, 0xf8, 0x65, 0x9a, 0xd9, 0x1b, 0xb0, 0x88, 0x5f, 0xb4, 0xa8, 0x00, 0x0d, 0x07, 0xbf, 0xf0,
+	0x0d, 0x7c, 0x12, 0x78, 0x32, 0xb6, 0x45, 0x6d, 0xed, 0xb0, 0x7e, 0x3e, 0xec, 0xfd, 0x18, 0xbf,
+	0xf0, 0xef, 0x07, 0x7d, 0x55, 0x0b, 0x5d, 0x83, 0xf9, 0x5a, 0xdb, 0xa3, 0x2e, 0xcf, 0x81, 0x67,
+	0x3a, 0xb5, 0x23, 0xc3, 0x77, 0x8f, 0xe9, 0xee, 0x51, 0x36, 0xa6, 0x74, 0xc4, 0xfb, 0xee, 0xd1,
+	0xae, 0xfd, 0xa0, 0x47, 0xfb, 0xc1, 0x04, 0x2c, 0x65, 0x66, 0xcd, 0x75, 0x48, 0x3c, 0x33, 0xe5,
+	0xac, 0x33, 0x7b, 0x00, 0xd3, 0x11, 0x59, 0xbf, 0xd3, 0xc2, 0x5c, 0x56, 0xeb, 0x52, 0x8a, 0xfb,
+	0x9d, 0x16, 0xd6, 0xa7, 0x4e, 0x13, 0x5f, 0x48, 0x83, 0x69, 0x91, 0x60, 0x26, 0x9d, 0x84, 0x40,
+	0x3e, 0x81, 0xe5, 0x96, 0x87, 0x4f, 0x6c, 0xb7, 0x4d, 0x0c, 0x12, 0xdc, 0xdf, 0xb0, 0x15, 0xc3,
+	0x9f, 0xa3, 0xe3, 0xae, 0x64, 0x9c, 0x87, 0xaa, 0xe3, 0xdf, 0x7a, 0xeb, 0x13, 0xb3, 0xd1, 0xc6,
+	0xfa, 0x62, 0x88, 0xbd, 0xc7, 0x90, 0x43, 0xba, 0x6f, 0xc2, 0x79, 0xea, 0xea, 0x30, 0xdf, 0x24,
+	0xa2, 0x38, 0x42, 0x39, 0x98, 0x0d, 0xba, 0x1e, 0x04, 0x3d, 0x21, 0xf8, 0x5d, 0x98, 0xa0, 0x6e,
+	0x4b, 0xc3, 0x26, 0x3e, 0x75, 0xde, 0x26, 0xb7, 0x2e, 0x8a, 0xaf, 0x46, 0xa1, 0x56, 0x8e, 0xfb,
+	0xfc, 0x17, 0x7a, 0x08, 0xb3, 0x84, 0x6a, 0xac, 0x11, 0x93, 0x18, 0x2b, 0x42, 0xa2, 0x44, 0x52,
+	0x8a, 0x8e, 0xde, 0x82, 0xc5, 0x5a, 0xc3, 0x0e, 0x38, 0x6d, 0xd8, 0x07, 0x9e, 0xe9, 0x75, 0x8c,
+	0x13, 0xec, 0x51, 0x0b, 0x38, 0x4e, 0x55, 0x7a, 0x9e, 0xf5, 0x3e, 0x62, 0x9d, 0x9f, 0xb0, 0xbe,
+	0x04, 0x56, 0x1d, 0x9b, 0x7e, 0xdb, 0xc3, 0x11, 0xd6, 0x44, 0x12, 0xeb, 0x01, 0xeb, 0x0c, 0xb1,
+	0x2e, 0xc1, 0x24, 0xc7, 0xb2, 0x9b, 0xad, 0x46, 0x19, 0x28, 0x28, 0xb0, 0xa6, 0x6a, 0xb3, 0xd5,
+	0x40, 0x04, 0xae, 0x76, 0xcf, 0xca, 0x20, 0xb5, 0x23, 0x6c, 0xb5, 0x1b, 0xd8, 0xf0, 0x5d, 0xb6,
+	0x58, 0xd4, 0x77, 0x76, 0xdb, 0x7e, 0x79, 0xb2, 0x97, 0x9b, 0x77, 0x39, 0x3d, 0xd7, 0x3d, 0x4e,
+	0x69, 0xdf, 0xa5, 0xeb, 0xb6, 0xcf, 0xc8, 0x04, 0x2e, 0x0c, 0x5b, 0x2a, 0xe2, 0xbb, 0x89, 0x89,
+	0x4c, 0x51, 0xf7, 0x7d, 0x8e, 0x76, 0xed, 0xf9, 0x6e, 0x3c, 0x8b, 0xbc, 0xed, 0x34, 0x9d, 0xb7,
+	0x9d, 0xd0, 0x23, 0x28, 0x45, 0xba, 0x4d, 0x82, 0xcd, 0x54, 0x2e, 0x51, 0x57, 0xbd, 0xeb, 0x66,
+	0xc4, 0xe2, 0x27, 0x49, 0xfd, 0x66, 0x3b, 0x6f, 0xfa, 0x34, 0xf9, 0x89, 0x6a, 0x30, 0x1f, 0x51,
+	0xab, 0x35, 0x5c, 0x82, 0x39, 0xcd, 0x19, 0x4a, 0xf3, 0x7a, 0xc1, 0x0b, 0x43, 0x80, 0x18, 0xd0,
+	0x6b, 0x13, 0x3d, 0xda, 0xcf, 0x51, 0x63, 0xb0, 0xcb, 0xe7, 0xb8, 0x20, 0x0c, 0x16, 0x40, 0x0a,
+	0x4e, 0xf1, 0x59, 0xd1, 0x99, 0x18, 0x73, 0xcd, 0x05, 0xf4, 0x61, 0x08, 0xaf, 0xcf, 0x9e, 0x74,
+	0xb5, 0xa0, 0x77, 0x61, 0xc5, 0x26, 0x06, 0x5b, 0x96, 0xc4, 0x1a, 0x63, 0x27, 0xb0, 0x33, 0x56,
+	0x79, 0x8e, 0xba, 0x8d, 0x4b, 0x36, 0x49, 0x5b, 0xe3, 0xfb, 0xac, 0x5b, 0xfb, 0x2f, 0x05, 0x96,
+	0x9e, 0xba, 0x8d, 0xc6, 0xcf, 0x98, 0x35, 0xfe, 0xf1, 0x38, 0x94, 0xb3, 0xd3, 0xfe, 0xc6, 0x1c,
+	0x7f, 0x63, 0x8e, 0xbf, 0x8e, 0xe6, 0x38, 0x6f, 0x7f, 0x4c, 0xe5, 0x9a, 0x57, 0xa1, 0xad, 0x9a,
+	0x3e, 0xb3, 0xad, 0xfa, 0xff, 0x67, 0xb5, 0xb5, 0xbf, 0x1d, 0x82, 0x35, 0x1d, 0xd7, 0x5c, 0xcf,
+	0x4a, 0xc6, 0x36, 0xf9, 0xb6, 0x78, 0x99, 0x96, 0xf2, 0x12, 0x4c, 0x46, 0x8a, 0x13, 0x19, 0x01,
+	0x08, 0x9b, 0xaa, 0x16, 0x5a, 0x82, 0x31, 0xaa, 0x63, 0x7c, 0xc7, 0x0f, 0xeb, 0xa3, 0xc1, 0x67,
+	0xd5, 0x42, 0x17, 0x01, 0xf8, 0x3d, 0x3e, 0xdc, 0xbb, 0x13, 0xfa, 0x04, 0x6f, 0xa9, 0x5a, 0x48,
+	0x87, 0xa9, 0x96, 0xdb, 0x68, 0x18, 0xbc, 0xa5, 0x3c, 0x2a, 0xf1, 0x15, 0x02, 0x1b, 0xfa, 0xc0,
+	0xf5, 0x92, 0xa2, 0x09, 0x7d, 0x85, 0xc9, 0x80, 0x08, 0xff, 0xd0, 0xfe, 0x69, 0x0c, 0xd6, 0x25,
+	0x52, 0xe4, 0x86, 0x37, 0x63, 0x21, 0x95, 0xc1, 0x2c, 0xa4, 0xd4, 0xfa, 0x0d, 0x0d, 0x6e, 0xfd,
+	0xbe, 0x05, 0x28, 0x94, 0xaf, 0xd5, 0x6d, 0x7e, 0x67, 0xa3, 0x9e, 0x10, 0x7a, 0x23, 0x30, 0x60,
+	0x02, 0xd3, 0x3b, 0xac, 0x97, 0x78, 0x7b, 0x08, 0x99, 0xb1, 0xe8, 0x23, 0x59, 0x8b, 0x9e, 0xc8,
+	0x82, 0x8c, 0xa6, 0xb3, 0x20, 0x77, 0xa0, 0xcc, 0x4d, 0x4a, 0x1c, 0x80, 0x08, 0x4f, 0xff, 0x31,
+	0x7a, 0xfa, 0x2f, 0xb2, 0xfe, 0x48, 0x77, 0xf8, 0xe1, 0x8f, 0x74, 0x98, 0x8e, 0xa2, 0xfd, 0x34,
+	0x64, 0xc1, 0xd2, 0x07, 0x6f, 0xe6, 0xed, 0xc6, 0x7d, 0xcf, 0x74, 0x48, 0x60, 0xca, 0x52, 0x6e,
+	0xfa, 0x94, 0x95, 0xf8, 0x42, 0x9f, 0xc1, 0x05, 0x41, 0x40, 0x24, 0x36, 0xe1, 0x13, 0x45, 0x4c,
+	0xf8, 0x72, 0x46, 0xdd, 0xc3, 0xae, 0xbc, 0xab, 0x25, 0xe4, 0x5d, 0x2d, 0xd7, 0x61, 0x2a, 0x65,
+	0xf3, 0x26, 0xa9, 0xcd, 0x9b, 0x3c, 0x48, 0x18, 0xbb, 0x6d, 0x28, 0xc5, 0xcb, 0x4a, 0xb3, 0x48,
+	0x53, 0x3d, 0xb3, 0x48, 0xd3, 0x11, 0x46, 0xd0, 0x86, 0xde, 0x83, 0xa9, 0x70, 0xad, 0x29, 0x81,
+	0xe9, 0x9e, 0x04, 0x26, 0x39, 0x3c, 0x45, 0x37, 0x61, 0x2c, 0xf0, 0xe4, 0x03, 0x23, 0x5b, 0xa2,
+	0xf1, 0x97, 0x87, 0x95, 0x9c, 0xf4, 0x70, 0xa5, 0xe7, 0x2e, 0xa2, 0x21, 0x02, 0x1b, 0x93, 0xfb,
+	0x8e, 0xef, 0x75, 0xf4, 0x90, 0xae, 0xfa, 0x19, 0x4c, 0x25, 0x3b, 0xd0, 0x2c, 0x0c, 0x1f, 0xe3,
+	0x0e, 0x37, 0x56, 0xc1, 0x4f, 0x74, 0x07, 0x46, 0x4e, 0x02, 0xf5, 0x97, 0xc6, 0x1f, 0xc2, 0x5d,
+	0xc7, 0xe2, 0x10, 0x0c, 0xe1, 0xee, 0xd0, 0x1d, 0x25, 0x61, 0x27, 0xc3, 0xa8, 0xd3, 0x37, 0x76,
+	0x32, 0x63, 0x27, 0x93, 0xa2, 0x11, 0xda, 0xc9, 0x9f, 0x0e, 0x87, 0x76, 0x52, 0x28, 0x45, 0x6e,
+	0x27, 0x3f, 0x82, 0x99, 0x2e, 0x3b, 0x24, 0xb5, 0x94, 0xec, 0xfc, 0xed, 0x50, 0x4b, 0xa2, 0x97,
+	0xd2, 0x76, 0x2a, 0xa3, 0xb9, 0x43, 0xfd, 0x69, 0x6e, 0xc2, 0x2c, 0x0d, 0xa7, 0xcd, 0xd2, 0x67,
+	0xb0, 0x9a, 0xde, 0x55, 0x86, 0x5b, 0x37, 0xfc, 0x23, 0x9b, 0x18, 0xc9, 0x6c, 0xae, 0x7c, 0x28,
+	0x35, 0xb5, 0xcb, 0x9e, 0xd4, 0xf7, 0x8f, 0x6c, 0xb2, 0xcd, 0xe9, 0x57, 0x61, 0xee, 0x08, 0x9b,
+	0x9e, 0x7f, 0x80, 0x4d, 0xdf, 0xb0, 0xb0, 0x6f, 0xda, 0x0d, 0x52, 0x1e, 0x29, 0x10, 0x7d, 0x9b,
+	0x8d, 0xd0, 0x76, 0x19, 0x56, 0xf6, 0xdc, 0x19, 0x1d, 0xec, 0xdc, 0x79, 0x0d, 0x66, 0x22, 0x3a,
+	0x4c, 0xad, 0xa9, 0x01, 0x9e, 0xd0, 0xa3, 0x5b, 0xcf, 0x2e, 0x6d, 0xd5, 0xfe, 0x40, 0x81, 0x57,
+	0xd8, 0x6a, 0xa6, 0x76, 0x32, 0x4f, 0xca, 0xc6, 0xfb, 0x45, 0xef, 0x8e, 0xd8, 0xdd, 0xc9, 0x8b,
+	0xd8, 0xf5, 0x22, 0x55, 0x30, 0x74, 0xf7, 0x97, 0xc3, 0x70, 0x59, 0x4e, 0x8d, 0xab, 0x20, 0x8e,
+	0x0f, 0x37, 0x8f, 0xb7, 0x71, 0x16, 0xef, 0x0e, 0x6e, 0xba, 0xf4, 0x19, 0xd2, 0xa5, 0xe9, 0x3f,
+	0x52, 0x60, 0x35, 0x8e, 0x79, 0x07, 0x17, 0x64, 0xcb, 0x26, 0x2d, 0xd3, 0xaf, 0x1d, 0x19, 0x0d,
+	0xb7, 0x66, 0x36, 0x1a, 0x9d, 0xf2, 0x10, 0x35, 0x98, 0x9f, 0x49, 0x46, 0xed, 0x3d, 0x9d, 0x4a,
+	0x1c, 0x14, 0xdf, 0x77, 0x77, 0xf9, 0x08, 0x8f, 0xd8, 0x00, 0xcc, 0x8e, 0xae, 0x98, 0xf9, 0x10,
+	0xea, 0x2f, 0xc3, 0x5a, 0x2f, 0x02, 0x02, 0x7b, 0xbb, 0x9b, 0xb6, 0xb7, 0xe2, 0x90, 0x7b, 0x68,
+	0x06, 0x28, 0xad, 0x90, 0x30, 0x3d, 0x76, 0x13, 0xb6, 0x37, 0xc8, 0xd5, 0x08, 0xa6, 0x19, 0x94,
+	0x0b, 0x60, 0xab, 0xcf, 0x5c, 0x4d, 0x2f, 0x3a, 0x05, 0x15, 0xe9, 0x15, 0x58, 0x97, 0x50, 0xe2,
+	0x91, 0xe0, 0x1f, 0x28, 0xa0, 0x65, 0xad, 0xdd, 0x87, 0xe1, 0xf6, 0x0c, 0x39, 0x7f, 0xd6, 0xcd,
+	0xf9, 0xed, 0x1c, 0xce, 0x7b, 0x51, 0x2a, 0xc8, 0xfb, 0x53, 0x78, 0x45, 0x4a, 0x8b, 0xeb, 0xe6,
+	0xeb, 0x30, 0x5b, 0x33, 0x9d, 0x1a, 0x8e, 0x4e, 0x00, 0xcc, 0xce, 0xb4, 0x71, 0x7d, 0x86, 0xb5,
+	0xeb, 0x61, 0x73, 0x72, 0xbf, 0x27, 0x69, 0x9e, 0x71, 0xbf, 0xcb, 0x48, 0x15, 0x9c, 0xea, 0xab,
+	0x70, 0x59, 0x4e, 0x2c, 0x91, 0x0d, 0x14, 0x00, 0x9e, 0x45, 0xc3, 0x72, 0xe9, 0xf4, 0xad, 0x61,
+	0x22, 0x4a, 0x29, 0x0d, 0xcb, 0x4e, 0x90, 0xae, 0x0f, 0xb6, 0xfa, 0xd6, 0xb0, 0x5e, 0x94, 0x0a,
+	0xf2, 0x7e, 0x05, 0x5e, 0x91, 0xd2, 0xe2, 0xdc, 0xff, 0x95, 0x02, 0x97, 0x74, 0xdc, 0x74, 0x4f,
+	0x30, 0x2b, 0x8e, 0xf8, 0xaa, 0x04, 0xe9, 0xd2, 0x17, 0xa3, 0xe1, 0xae, 0x8b, 0x91, 0xa6, 0xc1,
+	0x5a, 0x3e, 0xd7, 0x7c, 0x6a, 0x7f, 0x3d, 0x04, 0x57, 0xf8, 0x14, 0xd8, 0xb4, 0x73, 0x73, 0xcc,
+	0xd2, 0x09, 0x9a, 0x50, 0x4a, 0xef, 0xc1, 0xf2, 0x90, 0xe8, 0x10, 0x8a, 0xd6, 0xaf, 0xc0, 0x80,
+	0xfa, 0x74, 0x6a, 0xf7, 0x06, 0x19, 0xde, 0xa8, 0xec, 0x47, 0x58, 0xde, 0x26, 0xce, 0xf0, 0xde,
+	0xe7, 0x38, 0x5d, 0x19, 0x5e, 0x2c, 0x6a, 0xee, 0xbb, 0xe4, 0x67, 0x03, 0x5e, 0xed, 0x35, 0x17,
+	0x2e, 0xe7, 0xbf, 0x51, 0x60, 0x25, 0x8c, 0x0a, 0x09, 0xbc, 0xf4, 0x97, 0xa2, 0x3e, 0x57, 0x61,
+	0xce, 0x26, 0x46, 0xba, 0xda, 0x8c, 0xca, 0x72, 0x5c, 0x9f, 0xb1, 0xc9, 0x83, 0x64, 0x1d, 0x99,
+	0xb6, 0x0a, 0x17, 0xc4, 0xec, 0xf3, 0xf9, 0xfd, 0x74, 0x08, 0x2e, 0x33, 0x63, 0x9d, 0xce, 0x4a,
+	0x67, 0x4c, 0xeb, 0xcb, 0x98, 0xe8, 0x3a, 0x4c, 0xf1, 0x52, 0x42, 0x6c, 0x25, 0x02, 0xb5, 0x51,
+	0x5b, 0xd5, 0x42, 0x9f, 0xc2, 0xf9, 0x5a, 0xc8, 0x6a, 0x62, 0xe8, 0x73, 0x7d, 0x0d, 0x8d, 0x22,
+	0x12, 0xf1, 0xd8, 0x8f, 0x60, 0x36, 0x51, 0x1e, 0xc8, 0x9c, 0x84, 0x91, 0xa2, 0x4e, 0xc2, 0x4c,
+	0x8c, 0x4a, 0x1b, 0xb4, 0xd7, 0xe0, 0x4a, 0x0f, 0x29, 0xf3, 0xf5, 0xf8, 0xd7, 0x21, 0x28, 0xeb,
+	0xbc, 0xa8, 0x15, 0x53, 0x5c, 0xf2, 0xc9, 0xd6, 0xcb, 0x5c, 0x83, 0xcf, 0x60, 0x21, 0x1d, 0xc9,
+	0xec, 0x18, 0xb6, 0x8f, 0x9b, 0x61, 0xfd, 0xc4, 0xd5, 0x42, 0xd1, 0xcc, 0x4e, 0xd5, 0xc7, 0x4d,
+	0xfd, 0xfc, 0x49, 0xa6, 0x8d, 0xa0, 0x9b, 0x30, 0x4a, 0x85, 0x4b, 0xca, 0xe7, 0x24, 0x91, 0x8d,
+	0x5d, 0xd3, 0x37, 0xef, 0x35, 0xdc, 0x03, 0x9d, 0x03, 0xa3, 0x1d, 0x28, 0x05, 0x95, 0xa6, 0x41,
+	0x09, 0x18, 0x47, 0x1f, 0x29, 0x82, 0x3e, 0xe5, 0xe0, 0x53, 0xbd, 0xcd, 0x16, 0x85, 0x68, 0x2b,
+	0xb0, 0x2c, 0x90, 0x35, 0x5f, 0x89, 0xef, 0x2a, 0xb0, 0xb8, 0xd7, 0x71, 0x6a, 0x7b, 0x47, 0xa6,
+	0x67, 0xf1, 0x00, 0x27, 0x5f, 0x87, 0x2b, 0x50, 0x22, 0x6e, 0xdb, 0xab, 0x61, 0x83, 0xd7, 0x3b,
+	0xf3, 0xc5, 0x98, 0x66, 0xad, 0x3b, 0xac, 0x11, 0x2d, 0xc3, 0x78, 0x20, 0x0f, 0x2b, 0x3c, 0xc1,
+	0x46, 0xf4, 0x31, 0xfa, 0x5d, 0xb5, 0x50, 0x05, 0xce, 0x51, 0x6f, 0x71, 0xb8, 0xa7, 0x0b, 0x47,
+	0xe1, 0xb4, 0x65, 0x58, 0xca, 0xf0, 0xc2, 0xf9, 0xfc, 0xfb, 0x11, 0x38, 0x1f, 0xf4, 0x85, 0x27,
+	0xe1, 0xcb, 0x54, 0x96, 0x32, 0x8c, 0x85, 0x01, 0x25, 0xb6, 0x57, 0xc3, 0xcf, 0x60, 0x2b, 0xc7,
+	0xde, 0x6c, 0x14, 0x29, 0x88, 0x22, 0x0b, 0x81, 0x4c, 0xb2, 0x61, 0xa4, 0x91, 0x7e, 0xc3, 0x48,
+	0x17, 0x01, 0x42, 0xaf, 0xca, 0xb6, 0xa8, 0x17, 0x3a, 0xac, 0x4f, 0xf0, 0x96, 0xaa, 0x95, 0xf1,
+	0xd5, 0xc7, 0xfa, 0xf3, 0xd5, 0x3f, 0xe2, 0xc9, 0x9b, 0xd8, 0x6d, 0xa6, 0x54, 0xc6, 0x7b, 0x52,
+	0x99, 0x0b, 0xd0, 0xa2, 0x0b, 0x30, 0xa5, 0x75, 0x0b, 0xc6, 0x42, 0x9f, 0x7b, 0xa2, 0x80, 0xcf,
+	0x1d, 0x02, 0x27, 0xe3, 0x05, 0x90, 0x8e, 0x17, 0xbc, 0x0f, 0x53, 0x2c, 0xb5, 0xc4, 0x4b, 0xa3,
+	0x27, 0x0b, 0x94, 0x46, 0x4f, 0xd2, 0x8c, 0x13, 0xfb, 0x08, 0xb2, 0x1c, 0x94, 0x00, 0x2b, 0xf4,
+	0x37, 0x6c, 0x0b, 0x3b, 0xbe, 0xed, 0x77, 0x68, 0x30, 0x6f, 0x42, 0x47, 0x41, 0xdf, 0xa7, 0xb4,
+	0xab, 0xca, 0x7b, 0xd0, 0x13, 0x98, 0xe9, 0xb2, 0x0d, 0xe5, 0x69, 0x91, 0x0a, 0xe5, 0x59, 0x05,
+	0xbd, 0x94, 0xb6, 0x08, 0xda, 0x22, 0xcc, 0xa7, 0x55, 0x99, 0xeb, 0xf8, 0xef, 0x2a, 0xb0, 0x12,
+	0x56, 0xae, 0x7d, 0x45, 0x2e, 0x71, 0xda, 0x6f, 0x29, 0x70, 0x41, 0xcc, 0x13, 0xf7, 0x6f, 0x6e,
+	0xc0, 0x62, 0x93, 0xb5, 0xb3, 0xbc, 0x8a, 0x61, 0x3b, 0x46, 0xcd, 0xac, 0x1d, 0x61, 0xce, 0xe1,
+	0xf9, 0x66, 0x02, 0xab, 0xea, 0xec, 0x04, 0x5d, 0xe8, 0x6d, 0x58, 0xce, 0x20, 0x59, 0xa6, 0x6f,
+	0x1e, 0x98, 0x04, 0xf3, 0x6b, 0xf0, 0x62, 0x1a, 0x6f, 0x97, 0xf7, 0x6a, 0x17, 0x40, 0x0d, 0xf9,
+	0xe1, 0xf2, 0xfc, 0xd0, 0x8d, 0x4a, 0x8f, 0xb4, 0x5f, 0x1d, 0x82, 0x15, 0x61, 0x37, 0xe7, 0x76,
+	0x03, 0x66, 0x9d, 0x76, 0xf3, 0x00, 0x7b, 0x41, 0x98, 0x89, 0x9a, 0x29, 0x42, 0xf9, 0x1c, 0xd1,
+	0x4b, 0xac, 0xfd, 0x49, 0x9d, 0x5a, 0x1f, 0x12, 0x08, 0x3b, 0x34, 0x6b, 0x84, 0x46, 0x0f, 0x46,
+	0xf4, 0x71, 0x6e, 0xd7, 0x08, 0xfa, 0x08, 0xa6, 0xf8, 0x4a, 0xb0, 0xa9, 0x32, 0x03, 0xf7, 0x5a,
+	0x9e, 0x3e, 0xb0, 0x78, 0x0e, 0x9d, 0x3a, 0xbd, 0xdf, 0x4d, 0x5a, 0x71, 0x03, 0xba, 0x05, 0x4b,
+	0x6c, 0xa0, 0x9a, 0xeb, 0xf8, 0x9e, 0xdb, 0x68, 0x60, 0x8f, 0x0a, 0xa5, 0xcd, 0xce, 0x8a, 0x09,
+	0x7d, 0x81, 0x76, 0xef, 0x44, 0xbd, 0xcc, 0x32, 0xd2, 0x3d, 0x62, 0x59, 0x1e, 0x26, 0x84, 0x07,
+	0x1d, 0xc3, 0x4f, 0xad, 0x02, 0x73, 0x2c, 0x35, 0x15, 0xe0, 0x85, 0xca, 0x93, 0x34, 0xd3, 0x4a,
+	0xca, 0x4c, 0x6b, 0xf3, 0x80, 0x92, 0xf0, 0x5c, 0x1b, 0xff, 0x5d, 0x81, 0x39, 0x76, 0x41, 0x4f,
+	0xde, 0x04, 0xf3, 0xc9, 0xa0, 0xf7, 0x78, 0x1a, 0x37, 0xca, 0x5a, 0x97, 0xb6, 0xd6, 0x72, 0x73,
+	0x04, 0x26, 0x39, 0xa6, 0xa1, 0xb1, 0x71, 0x9f, 0xff, 0x4a, 0x06, 0x58, 0x87, 0x53, 0x01, 0xd6,
+	0x1d, 0x98, 0x39, 0xb1, 0x89, 0x7d, 0x60, 0x37, 0x6c, 0xbf, 0xc3, 0x8c, 0x51, 0xef, 0x98, 0x60,
+	0x29, 0x46, 0x09, 0x1a, 0x03, 0xcb, 0xcc, 0x4f, 0x31, 0xc3, 0x31, 0xb9, 0xd1, 0x9d, 0xd0, 0x27,
+	0x79, 0xdb, 0xc7, 0x66, 0x13, 0x07, 0x62, 0x48, 0xce, 0x37, 0xf6, 0x69, 0xe7, 0x74, 0x4c, 0xb0,
+	0xff, 0xac, 0x8d, 0xdb, 0xb8, 0x80, 0x18, 0xba, 0x47, 0x1a, 0xca, 0x8c, 0x94, 0x96, 0xd4, 0x70,
+	0xbf, 0x92, 0x62, 0x8c, 0xc6, 0x1c, 0x71, 0x46, 0x7f, 0x4f, 0x81, 0xf9, 0x50, 0xf5, 0xbf, 0x3a,
+	0xbc, 0x3e, 0x81, 0x85, 0x2e, 0xa6, 0xf8, 0x4e, 0xbc, 0x05, 0x4b, 0x2d, 0xcf, 0xad, 0x61, 0x42,
+	0x82, 0xea, 0x4f, 0xfa, 0x0a, 0x8a, 0xd9, 0x82, 0x60, 0x43, 0x0e, 0x07, 0x6a, 0x1f, 0x77, 0x53,
+	0x4c, 0x6a, 0x08, 0x48, 0x50, 0xbd, 0x78, 0xf1, 0x21, 0xf6, 0xf5, 0xf8, 0x49, 0xd4, 0x63, 0x4c,
+	0x88, 0x79, 0x18, 0x57, 0x61, 0x7f, 0x00, 0xa3, 0x34, 0x89, 0xc3, 0x08, 0x49, 0x52, 0xd1, 0x09,
+	0x1a, 0x34, 0xc5, 0xa3, 0x73, 0xbc, 0x02, 0x62, 0x09, 0x0c, 0xcd, 0x6a, 0x1e, 0x1b, 0x7c, 0x86,
+	0xcf, 0xa1, 0xc4, 0xe4, 0xde, 0xe4, 0x3d, 0x9c, 0x9f, 0x8f, 0x72, 0x83, 0x90, 0x72, 0x82, 0x15,
+	0xba, 0x3f, 0xc3, 0x56, 0x16, 0x70, 0x9c, 0x26, 0xc9, 0x36, 0xb5, 0x09, 0x28, 0x0b, 0x94, 0x0c,
+	0x2a, 0x8e, 0xb0, 0xa0, 0xe2, 0x76, 0x3a, 0xa8, 0xf8, 0x46, 0x01, 0x09, 0x45, 0xdc, 0x24, 0x22,
+	0x8a, 0x0e, 0xac, 0x3d, 0xc4, 0xfe, 0xee, 0xa3, 0x67, 0x92, 0xd5, 0xf8, 0x08, 0x80, 0x6d, 0x6b,
+	0xa7, 0xee, 0x86, 0x12, 0x28, 0x32, 0x5e, 0xa0, 0x4b, 0xd4, 0x58, 0x4e, 0xf8, 0xfc, 0x17, 0xd1,
+	0x3a, 0xb0, 0x2e, 0x19, 0x8f, 0x8b, 0x7d, 0x1f, 0xe6, 0x12, 0xef, 0xe5, 0x68, 0x4e, 0x31, 0x1c,
+	0xf7, 0xb5, 0x82, 0xe3, 0xea, 0xb3, 0x5e, 0xba, 0x81, 0x68, 0xff, 0xa8, 0xc0, 0xbc, 0x8e, 0xcd,
+	0x56, 0xab, 0xc1, 0x9c, 0x9f, 0x68, 0x7e, 0x8b, 0x30, 0xca, 0x83, 0xf8, 0xec, 0xbc, 0xe3, 0x5f,
+	0xf2, 0x47, 0x42, 0xe2, 0xc3, 0x7a, 0xf8, 0xac, 0x17, 0xd3, 0xc1, 0xbc, 0x0c, 0x6d, 0x09, 0x16,
+	0xba, 0xa6, 0xc6, 0x4d, 0xca, 0x9f, 0x29, 0x41, 0x8d, 0x6e, 0xdd, 0xc3, 0xe4, 0x28, 0xca, 0x67,
+	0x04, 0xd2, 0xf8, 0x0a, 0xce, 0x3d, 0x08, 0x01, 0x88, 0x59, 0xe5, 0x73, 0xf9, 0xc9, 0x10, 0x2c,
+	0xea, 0xd8, 0xb4, 0x76, 0x1f, 0x3d, 0xeb, 0x56, 0xd1, 0x1b, 0x70, 0x2e, 0xaa, 0x23, 0x28, 0x6d,
+	0x5d, 0xca, 0x3d, 0xc5, 0x1f, 0x3d, 0xa3, 0xc6, 0x8d, 0x02, 0xcb, 0xdc, 0x9e, 0xac, 0xe3, 0x34,
+	0x2c, 0x72, 0x9c, 0xf6, 0xa1, 0x6c, 0x3b, 0x01, 0x84, 0x7d, 0x82, 0x0d, 0xec, 0x44, 0x76, 0xa2,
+	0x60, 0xf1, 0xd5, 0x42, 0x84, 0x7c, 0xdf, 0x09, 0x37, 0x7c, 0xd5, 0x0a, 0x64, 0xdf, 0x0a, 0x88,
+	0x10, 0xfb, 0x73, 0x76, 0xca, 0x8d, 0xe8, 0xe3, 0x41, 0xc3, 0x9e, 0xfd, 0x39, 0x46, 0xaf, 0xc2,
+	0x0c, 0x2d, 0x21, 0xa0, 0x10, 0x2c, 0xd3, 0x3d, 0x4a, 0x33, 0xdd, 0xb4, 0xb2, 0xe0, 0xa9, 0x79,
+	0x88, 0x59, 0xe1, 0xdb, 0x5f, 0x0c, 0xc1, 0x52, 0x46, 0x58, 0xd1, 0x85, 0x6f, 0x00, 0x69, 0x09,
+	0x37, 0xe5, 0xd0, 0x19, 0x37, 0x25, 0x32, 0x61, 0x31, 0x43, 0x35, 0x8c, 0xb9, 0xf5, 0x6d, 0x67,
+	0xe6, 0xbb, 0xc9, 0x07, 0xad, 0x22, 0x89, 0x9d, 0x13, 0x49, 0xec, 0x5f, 0x82, 0x0a, 0xc9, 0xb6,
+	0x77, 0x88, 0xbf, 0xe6, 0xfa, 0xa5, 0xa9, 0x50, 0xce, 0xce, 0x93, 0xef, 0xb1, 0x3f, 0x1f, 0x82,
+	0xa5, 0xc7, 0xf8, 0xeb, 0x2f, 0x84, 0xff, 0x9b, 0x4d, 0x76, 0x0f, 0xca, 0x8f, 0xb1, 0x58, 0x92,
+	0x22, 0x1a, 0x8a, 0x88, 0xc6, 0xaf, 0x28, 0x70, 0xe1, 0x63, 0xd7, 0xb7, 0xeb, 0x9d, 0xc0, 0xbf,
+	0x75, 0x4f, 0xb0, 0xf7, 0xd8, 0x0c, 0x9c, 0xd7, 0x48, 0xec, 0x26, 0x2c, 0xd6, 0x79, 0x8f, 0xd1,
+	0xa4, 0x5d, 0x46, 0xea, 0x72, 0x94, 0xbb, 0x45, 0xd2, 0xf4, 0xe8, 0x68, 0xfa, 0x7c, 0x3d, 0xdb,
+	0x48, 0xb4, 0x4b, 0x70, 0x31, 0x87, 0x05, 0xae, 0x16, 0x26, 0xac, 0x3c, 0xc4, 0xfe, 0x8e, 0xe7,
+	0x12, 0xc2, 0x97, 0x25, 0x75, 0x8a, 0xa4, 0x3c, 0x2d, 0xa5, 0xcb, 0xd3, 0xba, 0x02, 0x25, 0xdf,
+	0xf4, 0x0e, 0xb1, 0x1f, 0x2d, 0x33, 0x3b, 0x4f, 0xa6, 0x59, 0x2b, 0xa7, 0xa7, 0xfd, 0xf7, 0x30,
+	0x5c, 0x10, 0x8f, 0xc1, 0x05, 0xda, 0x84, 0x12, 0x33, 0x0f, 0x07, 0x1d, 0xe6, 0xf7, 0x95, 0x95,
+	0x1e, 0x25, 0x34, 0x32, 0x72, 0xf4, 0xa6, 0x4b, 0xee, 0x75, 0xe8, 0x65, 0x8b, 0xdd, 0xc4, 0xa6,
+	0xfc, 0x44, 0x13, 0xfa, 0x42, 0x81, 0x85, 0x3a, 0x4d, 0x32, 0x19, 0x35, 0xb3, 0x4d, 0x70, 0x3c,
+	0x2c, 0x33, 0x7a, 0x8f, 0x07, 0x1b, 0x96, 0xe5, 0xad, 0x76, 0x02, 0x8a, 0xa9, 0xc1, 0x51, 0x3d,
+	0xd3, 0xa1, 0x3e, 0x87, 0xb9, 0x0c, 0x97, 0x82, 0xab, 0xe0, 0x83, 0xf4, 0x55, 0xf0, 0x5a, 0x9e,
+	0x3e, 0x74, 0x33, 0xc5, 0x57, 0x2f, 0x79, 0x1f, 0x54, 0x9f, 0xc3, 0x52, 0x0e, 0x87, 0x82, 0x81,
+	0x3f, 0x48, 0x0e, 0x5c, 0xca, 0x0f, 0xb1, 0x3e, 0xc4, 0x7e, 0x9c, 0xb2, 0xa3, 0x84, 0x93, 0x57,
+	0xd0, 0x7f, 0x53, 0x60, 0x83, 0x27, 0xc9, 0x32, 0x62, 0xcb, 0x44, 0xf7, 0x25, 0x9e, 0x50, 0x31,
+	0x3d, 0x43, 0x9f, 0x32, 0x35, 0x8a, 0xaa, 0x19, 0xc2, 0x00, 0x71, 0x1f, 0x62, 0x63, 0x88, 0x01,
+	0xe1, 0xf8, 0x8b, 0xa0, 0xcb, 0x30, 0x5d, 0xc7, 0x7e, 0xed, 0xe8, 0x63, 0xcc, 0xee, 0x2d, 0x3c,
+	0xab, 0x93, 0x6e, 0xd4, 0x08, 0xbc, 0x5e, 0x60, 0xb2, 0x51, 0x9d, 0xe4, 0x48, 0x78, 0xf9, 0x1d,
+	0x70, 0x65, 0x29, 0xba, 0x76, 0x93, 0x3e, 0xc4, 0x0a, 0x37, 0x37, 0x3d, 0x2b, 0x0b, 0x04, 0xa4,
+	0x34, 0x1f, 0x96, 0x32, 0x68, 0x9c, 0xb3, 0x2d, 0x58, 0x88, 0xd3, 0x19, 0x61, 0xf0, 0xa3, 0xcd,
+	0xeb, 0x93, 0x46, 0xf4, 0x38, 0xd7, 0xb1, 0xc7, 0x22, 0x1f, 0x6d, 0x87, 0x46, 0xa3, 0xc3, 0xa7,
+	0x82, 0x3c, 0x6e, 0xc3, 0x82, 0x32, 0xd3, 0xbc, 0x95, 0x82, 0x92, 0xad, 0x2f, 0x36, 0x01, 0x78,
+	0xe0, 0x67, 0xfb, 0x69, 0x15, 0xfd, 0x66, 0x10, 0xde, 0x16, 0x3e, 0x14, 0x47, 0xb7, 0x72, 0xb7,
+	0xa0, 0xf4, 0xa9, 0xba, 0x7a, 0xbb, 0x6f, 0x3c, 0x3e, 0xeb, 0xef, 0x29, 0xb0, 0x94, 0xf3, 0x04,
+	0x1f, 0x49, 0x88, 0x4a, 0xff, 0x94, 0x40, 0xbd, 0xd3, 0x3f, 0x22, 0x67, 0xe7, 0xc7, 0x0a, 0xac,
+	0xf5, 0x7a, 0x4d, 0x8f, 0x3e, 0xe8, 0x45, 0xbe, 0xd7, 0xcb, 0x7e, 0x75, 0xfb, 0x0c, 0x14, 0x38,
+	0xa7, 0xc1, 0x22, 0x8a, 0xdf, 0xc9, 0x4b, 0x16, 0x51, 0xfa, 0x3e, 0x5f, 0xbd, 0xdd, 0x37, 0x1e,
+	0xe7, 0xe5, 0xf7, 0x15, 0x50, 0xf3, 0x5f, 0xb9, 0xa3, 0xfc, 0xb2, 0xa6, 0x9e, 0xaf, 0xec, 0xd5,
+	0x77, 0x06, 0xc2, 0x4d, 0x28, 0x57, 0xce, 0x43, 0x76, 0x89, 0x72, 0xc9, 0x9f, 0xd0, 0xab, 0x77,
+	0xfa, 0x47, 0xe4, 0xec, 0xfc, 0xb1, 0x02, 0xab, 0xf2, 0x27, 0xe4, 0xe8, 0xdb, 0xb9, 0xc4, 0x0b,
+	0x3d, 0xb6, 0x57, 0xdf, 0x1f, 0x18, 0x9f, 0xf3, 0xf8, 0x7d, 0x05, 0x96, 0x73, 0x1f, 0x8a, 0xa3,
+	0xb7, 0x25, 0x73, 0x97, 0xbf, 0x53, 0x57, 0xef, 0x0e, 0x82, 0xca, 0x99, 0x72, 0x60, 0x3a, 0xf5,
+	0x82, 0x18, 0xbd, 0x99, 0x4b, 0x4c, 0xf4, 0x50, 0x59, 0xad, 0x14, 0x05, 0xe7, 0xe3, 0x7d, 0xa1,
+	0xc0, 0x79, 0xc1, 0x33, 0x5c, 0x74, 0x43, 0xbe, 0x41, 0x84, 0x0f, 0x7f, 0xd5, 0xb7, 0xfa, 0x43,
+	0xe2, 0x2c, 0xf8, 0x30, 0xd3, 0xf5, 0xe4, 0x15, 0x6d, 0xca, 0xae, 0x47, 0x82, 0xd4, 0x88, 0x7a,
+	0xad, 0x38, 0x02, 0x1f, 0xf5, 0x14, 0x66, 0xbb, 0x9f, 0x76, 0xa1, 0x7c, 0x2a, 0x39, 0x8f, 0xdf,
+	0xd4, 0xeb, 0x7d, 0x60, 0x24, 0xd4, 0x2e, 0xb7, 0xc6, 0x51, 0xa2, 0x76, 0xbd, 0x9e, 0x97, 0xa8,
+	0x67, 0x28, 0xa9, 0x44, 0x7f, 0xa4, 0xc0, 0x05, 0xf6, 0x21, 0x2e, 0x81, 0x44, 0xef, 0x0e, 0x58,
+	0x39, 0xc9, 0x58, 0x7b, 0xef, 0x4c, 0x75, 0x97, 0x5c, 0x64, 0x39, 0x75, 0x82, 0x52, 0x91, 0xc9,
+	0xab, 0x14, 0xd5, 0xbb, 0x83, 0xa0, 0x66, 0xd6, 0x51, 0x50, 0x84, 0xdd, 0x73, 0x1d, 0xf3, 0xcb,
+	0xdf, 0xd5, 0xbb, 0x83, 0xa0, 0x66, 0xd7, 0x51, 0x58, 0xaa, 0xd7, 0x7b, 0x1d, 0x65, 0xe5, 0x82,
+	0xea, 0x7b, 0x03, 0x62, 0x67, 0xd7, 0x31, 0x5b, 0x8d, 0xd7, 0x7b, 0x1d, 0x73, 0x6b, 0x01, 0xd5,
+	0xbb, 0x83, 0xa0, 0x72, 0xa6, 0xfe, 0x90, 0x06, 0x39, 0x73, 0xcb, 0xec, 0xd0, 0x3b, 0x7d, 0xcd,
+	0x39, 0x5d, 0xe8, 0xa7, 0xbe, 0x3b, 0x18, 0x72, 0x8a, 0xb5, 0xdc, 0x1a, 0x53, 0x29, 0x6b, 0xbd,
+	0xaa, 0x5c, 0xd5, 0x77, 0x07, 0x43, 0x4e, 0x1c, 0xf0, 0xf2, 0xe2, 0x32, 0xc9, 0x01, 0x5f, 0xa8,
+	0xc2, 0x4e, 0x72, 0xc0, 0x17, 0xab, 0x6a, 0x43, 0xbf, 0xad, 0x40, 0x99, 0x65, 0xf4, 0xb2, 0x25,
+	0x86, 0xe8, 0x8e, 0x84, 0xba, 0xb4, 0x96, 0x52, 0x7d, 0x7b, 0x00, 0x4c, 0xce, 0xd1, 0xaf, 0x29,
+	0x30, 0x2f, 0x2a, 0x54, 0x43, 0xf9, 0x27, 0xa7, 0xa4, 0x2c, 0x4f, 0xbd, 0xd9, 0x27, 0x16, 0xe7,
+	0xe2, 0x87, 0xf4, 0x3f, 0xb0, 0x24, 0x75, 0x5a, 0xe8, 0xbd, 0x1e, 0xba, 0x21, 0xaf, 0xa2, 0x53,
+	0xbf, 0x3d, 0x28, 0x3a, 0x67, 0xf0, 0xf3, 0x20, 0xe5, 0xda, 0x55, 0xb1, 0x84, 0xae, 0x4b, 0x88,
+	0x8a, 0x2b, 0xc9, 0xd4, 0xad, 0x7e, 0x50, 0xe2, 0xdb, 0x48, 0x57, 0x0d, 0x92, 0xe4, 0x36, 0x22,
+	0xae, 0x9c, 0x52, 0xaf, 0x15, 0x47, 0xe0, 0xa3, 0x1e, 0xc3, 0x54, 0xb2, 0x24, 0x04, 0x7d, 0x4b,
+	0x4a, 0xa1, 0xab, 0x08, 0x4a, 0x7d, 0xb3, 0x20, 0x74, 0x42, 0x0b, 0x45, 0x35, 0x1d, 0x12, 0x2d,
+	0x94, 0x94, 0xa5, 0xa8, 0x37, 0xfb, 0xc4, 0x4a, 0xdc, 0x3c, 0x05, 0xa5, 0x1a, 0x92, 0x9b, 0x67,
+	0x7e, 0xdd, 0x87, 0xfa, 0x56, 0x7f, 0x48, 0xd1, 0xf3, 0x14, 0x88, 0x0b, 0x1f, 0xd0, 0xd5, 0x5c,
+	0x1a, 0x99, 0x6a, 0x0a, 0xf5, 0x8d, 0x42, 0xb0, 0xf1, 0x30, 0x71, 0x61, 0x81, 0x64, 0x98, 0x4c,
+	0xb5, 0x85, 0xfa, 0x46, 0x21, 0xd8, 0xe4, 0x30, 0x61, 0x59, 0x80, 0x74, 0x98, 0xae, 0x6a, 0x06,
+	0xf5, 0x8d, 0x42, 0xb0, 0xb1, 0x87, 0x92, 0xca, 0xe8, 0x4b, 0x3c, 0x14, 0x51, 0x39, 0x82, 0x5a,
+	0x29, 0x0a, 0x9e, 0xf0, 0xfe, 0xc5, 0x89, 0x71, 0x89, 0xf7, 0x2f, 0xad, 0x10, 0x50, 0x6f, 0xf7,
+	0x8d, 0x97, 0xb8, 0xc0, 0xe4, 0x66, 0xa0, 0x25, 0x17, 0x98, 0x5e, 0x59, 0x72, 0xf5, 0xee, 0x20,
+	0xa8, 0xf1, 0x82, 0xa4, 0xd2, 0xb7, 0x92, 0x05, 0x11, 0x65, 0xb0, 0xd5, 0x4a, 0x51, 0xf0, 0x84,
+	0xf9, 0x10, 0xa5, 0x5a, 0x91, 0xcc, 0xfd, 0xcb, 0x4d, 0x22, 0xab, 0x37, 0xfb, 0xc4, 0x8a, 0xed,
+	0x74, 0x57, 0x86, 0x52, 0x62, 0xa7, 0xc5, 0x89, 0x5f, 0xf5, 0x5a, 0x71, 0x84, 0x84, 0xd7, 0xd8,
+	0x95, 0xfd, 0x92, 0x79, 0x8d, 0xe2, 0x84, 0xa0, 0x7a, 0xbd, 0x0f, 0x8c, 0x78, 0xe0, 0xc7, 0xb8,
+	0xf0, 0xc0, 0x8f, 0x71, 0xbf, 0x03, 0xe7, 0x66, 0xa2, 0x7e, 0x43, 0x81, 0x05, 0x61, 0x7a, 0x07,
+	0xe5, 0x2f, 0x9c, 0x2c, 0x23, 0xa5, 0xde, 0xea, 0x17, 0x2d, 0xa1, 0x76, 0xa2, 0xe4, 0x88, 0x44,
+	0xed, 0x24, 0x59, 0x27, 0xf5, 0x66, 0x9f, 0x58, 0x9c, 0x8b, 0x9f, 0x28, 0xd1, 0x83, 0xa2, 0xfc,
+	0x10, 0x3c, 0xda, 0xee, 0x75, 0xed, 0xef, 0x99, 0xab, 0x50, 0xef, 0x9d, 0x85, 0x44, 0x2a, 0xb2,
+	0x92, 0x0c, 0xc1, 0xcb, 0x23, 0x2b, 0x82, 0x18, 0xbf, 0x7a, 0xad, 0x38, 0x02, 0x1b, 0xf5, 0xde,
+	0xfd, 0xbf, 0xfb, 0x72, 0x55, 0xf9, 0x87, 0x2f, 0x57, 0x95, 0x7f, 0xfe, 0x72, 0x55, 0xf9, 0x85,
+	0xdb, 0x87, 0xb6, 0x7f, 0xd4, 0x3e, 0xa8, 0xd4, 0xdc, 0xe6, 0x66, 0xea, 0x4f, 0xab, 0x2b, 0x87,
+	0xd8, 0x61, 0xff, 0x3e, 0x9e, 0xf8, 0xfb, 0xf3, 0x77, 0xf8, 0xcf, 0x93, 0xeb, 0x07, 0xa3, 0xb4,
+	0xef, 0xc6, 0xff, 0x0e, 0x00, 0xbe, 0x59, 0xaf, 0x7d, 0x2a, 0x5d, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -6035,6 +6241,164 @@ func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8583,21 +8947,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA81 := make([]byte, len(m.ShardIds)*10)
-		var j80 int
+		dAtA84 := make([]byte, len(m.ShardIds)*10)
+		var j83 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j80++
+				j83++
 			}
-			dAtA81[j80] = uint8(num)
-			j80++
+			dAtA84[j83] = uint8(num)
+			j83++
 		}
-		i -= j80
-		copy(dAtA[i:], dAtA81[:j80])
-		i = encodeVarintService(dAtA, i, uint64(j80))
+		i -= j83
+		copy(dAtA[i:], dAtA84[:j83])
+		i = encodeVarintService(dAtA, i, uint64(j83))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9680,21 +10044,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA91 := make([]byte, len(m.ShardIds)*10)
-		var j90 int
+		dAtA94 := make([]byte, len(m.ShardIds)*10)
+		var j93 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j90++
+				j93++
 			}
-			dAtA91[j90] = uint8(num)
-			j90++
+			dAtA94[j93] = uint8(num)
+			j93++
 		}
-		i -= j90
-		copy(dAtA[i:], dAtA91[:j90])
-		i = encodeVarintService(dAtA, i, uint64(j90))
+		i -= j93
+		copy(dAtA[i:], dAtA94[:j93])
+		i = encodeVarintService(dAtA, i, uint64(j93))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9928,21 +10292,21 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PendingShards) > 0 {
-		dAtA95 := make([]byte, len(m.PendingShards)*10)
-		var j94 int
+		dAtA98 := make([]byte, len(m.PendingShards)*10)
+		var j97 int
 		for _, num1 := range m.PendingShards {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA95[j94] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j94++
+				j97++
 			}
-			dAtA95[j94] = uint8(num)
-			j94++
+			dAtA98[j97] = uint8(num)
+			j97++
 		}
-		i -= j94
-		copy(dAtA[i:], dAtA95[:j94])
-		i = encodeVarintService(dAtA, i, uint64(j94))
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintService(dAtA, i, uint64(j97))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10170,6 +10534,74 @@ func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13122,6 +13554,382 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.DeleteWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertWorkflowSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &v1.SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertWorkflowSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
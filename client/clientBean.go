@@ -24,6 +24,8 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -38,6 +40,7 @@ import (
 	"go.uber.org/yarpc/peer/roundrobin"
 	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
@@ -71,6 +74,8 @@ type (
 
 	DispatcherOptions struct {
 		AuthProvider clientworker.AuthorizationProvider
+		// TLSConfig enables TLS on the connections, it is only supported by GRPC
+		TLSConfig *tls.Config
 	}
 
 	// DispatcherProvider provides a dispatcher to a given address
@@ -136,9 +141,18 @@ func NewClientBean(factory Factory, dispatcherProvider DispatcherProvider, clust
 				AuthProvider: authProvider,
 			}
 		}
+		tlsConfig, err := info.TLS.ToTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig != nil {
+			if dispatcherOptions == nil {
+				dispatcherOptions = &DispatcherOptions{}
+			}
+			dispatcherOptions.TLSConfig = tlsConfig
+		}
 
 		var dispatcher *yarpc.Dispatcher
-		var err error
 		switch info.RPCTransport {
 		case tchannel.TransportName:
 			dispatcher, err = dispatcherProvider.GetTChannel(info.RPCName, info.RPCAddress, dispatcherOptions)
@@ -285,6 +299,10 @@ func NewDNSYarpcDispatcherProvider(logger log.Logger, interval time.Duration) Di
 }
 
 func (p *dnsDispatcherProvider) GetTChannel(serviceName string, address string, options *DispatcherOptions) (*yarpc.Dispatcher, error) {
+	if options != nil && options.TLSConfig != nil {
+		return nil, errors.New("TLS is not supported by TChannel dispatcher")
+	}
+
 	tchanTransport, err := tchannel.NewTransport(
 		tchannel.ServiceName(serviceName),
 		// this aim to get rid of the annoying popup about accepting incoming network connections
@@ -309,7 +327,11 @@ func (p *dnsDispatcherProvider) GetTChannel(serviceName string, address string,
 func (p *dnsDispatcherProvider) GetGRPC(serviceName string, address string, options *DispatcherOptions) (*yarpc.Dispatcher, error) {
 	grpcTransport := grpc.NewTransport()
 
-	peerList := roundrobin.New(grpcTransport)
+	var peerTransport peer.Transport = grpcTransport
+	if options != nil && options.TLSConfig != nil {
+		peerTransport = grpcTransport.NewDialer(grpc.DialerCredentials(credentials.NewTLS(options.TLSConfig)))
+	}
+	peerList := roundrobin.New(peerTransport)
 	peerListUpdater, err := newDNSUpdater(peerList, address, p.interval, p.logger)
 	if err != nil {
 		return nil, err
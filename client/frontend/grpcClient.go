@@ -51,6 +51,11 @@ func (g grpcClient) CountWorkflowExecutions(ctx context.Context, request *types.
 	return proto.ToCountWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) DeleteWorkflowExecution(ctx context.Context, request *types.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.DeleteWorkflowExecution(ctx, proto.FromDeleteWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) DeprecateDomain(ctx context.Context, request *types.DeprecateDomainRequest, opts ...yarpc.CallOption) error {
 	_, err := g.domain.DeprecateDomain(ctx, proto.FromDeprecateDomainRequest(request), opts...)
 	return proto.ToError(err)
@@ -116,6 +121,71 @@ func (g grpcClient) GetTaskListsByDomain(ctx context.Context, request *types.Get
 	return proto.ToGetTaskListsByDomainResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.CreateSchedule(ctx, proto.FromCreateScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest, opts ...yarpc.CallOption) (*types.DescribeScheduleResponse, error) {
+	response, err := g.workflow.DescribeSchedule(ctx, proto.FromDescribeScheduleRequest(request), opts...)
+	return proto.ToDescribeScheduleResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UpdateSchedule(ctx, proto.FromUpdateScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.PauseSchedule(ctx, proto.FromPauseScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseSchedule(ctx context.Context, request *types.UnpauseScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UnpauseSchedule(ctx, proto.FromUnpauseScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) TriggerSchedule(ctx context.Context, request *types.TriggerScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.TriggerSchedule(ctx, proto.FromTriggerScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.BackfillSchedule(ctx, proto.FromBackfillScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) DeleteSchedule(ctx context.Context, request *types.DeleteScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.DeleteSchedule(ctx, proto.FromDeleteScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest, opts ...yarpc.CallOption) (*types.ListSchedulesResponse, error) {
+	response, err := g.workflow.ListSchedules(ctx, proto.FromListSchedulesRequest(request), opts...)
+	return proto.ToListSchedulesResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) StartBatchOperation(ctx context.Context, request *types.StartBatchOperationRequest, opts ...yarpc.CallOption) (*types.StartBatchOperationResponse, error) {
+	response, err := g.workflow.StartBatchOperation(ctx, proto.FromStartBatchOperationRequest(request), opts...)
+	return proto.ToStartBatchOperationResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DescribeBatchOperation(ctx context.Context, request *types.DescribeBatchOperationRequest, opts ...yarpc.CallOption) (*types.DescribeBatchOperationResponse, error) {
+	response, err := g.workflow.DescribeBatchOperation(ctx, proto.FromDescribeBatchOperationRequest(request), opts...)
+	return proto.ToDescribeBatchOperationResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ListBatchOperations(ctx context.Context, request *types.ListBatchOperationsRequest, opts ...yarpc.CallOption) (*types.ListBatchOperationsResponse, error) {
+	response, err := g.workflow.ListBatchOperations(ctx, proto.FromListBatchOperationsRequest(request), opts...)
+	return proto.ToListBatchOperationsResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) CancelBatchOperation(ctx context.Context, request *types.CancelBatchOperationRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.CancelBatchOperation(ctx, proto.FromCancelBatchOperationRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) ListWorkflowExecutions(ctx context.Context, request *types.ListWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error) {
 	response, err := g.visibility.ListWorkflowExecutions(ctx, proto.FromListWorkflowExecutionsRequest(request), opts...)
 	return proto.ToListWorkflowExecutionsResponse(response), proto.ToError(err)
@@ -44,6 +44,11 @@ func (t thriftClient) CountWorkflowExecutions(ctx context.Context, request *type
 	return thrift.ToCountWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) DeleteWorkflowExecution(ctx context.Context, request *types.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteWorkflowExecution(ctx, thrift.FromDeleteWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) DeprecateDomain(ctx context.Context, request *types.DeprecateDomainRequest, opts ...yarpc.CallOption) error {
 	err := t.c.DeprecateDomain(ctx, thrift.FromDeprecateDomainRequest(request), opts...)
 	return thrift.ToError(err)
@@ -109,6 +114,71 @@ func (t thriftClient) GetTaskListsByDomain(ctx context.Context, request *types.G
 	return thrift.ToGetTaskListsByDomainResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.CreateSchedule(ctx, thrift.FromCreateScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest, opts ...yarpc.CallOption) (*types.DescribeScheduleResponse, error) {
+	response, err := t.c.DescribeSchedule(ctx, thrift.FromDescribeScheduleRequest(request), opts...)
+	return thrift.ToDescribeScheduleResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UpdateSchedule(ctx, thrift.FromUpdateScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseSchedule(ctx, thrift.FromPauseScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseSchedule(ctx context.Context, request *types.UnpauseScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseSchedule(ctx, thrift.FromUnpauseScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) TriggerSchedule(ctx context.Context, request *types.TriggerScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.TriggerSchedule(ctx, thrift.FromTriggerScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.BackfillSchedule(ctx, thrift.FromBackfillScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteSchedule(ctx context.Context, request *types.DeleteScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteSchedule(ctx, thrift.FromDeleteScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest, opts ...yarpc.CallOption) (*types.ListSchedulesResponse, error) {
+	response, err := t.c.ListSchedules(ctx, thrift.FromListSchedulesRequest(request), opts...)
+	return thrift.ToListSchedulesResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) StartBatchOperation(ctx context.Context, request *types.StartBatchOperationRequest, opts ...yarpc.CallOption) (*types.StartBatchOperationResponse, error) {
+	response, err := t.c.StartBatchOperation(ctx, thrift.FromStartBatchOperationRequest(request), opts...)
+	return thrift.ToStartBatchOperationResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeBatchOperation(ctx context.Context, request *types.DescribeBatchOperationRequest, opts ...yarpc.CallOption) (*types.DescribeBatchOperationResponse, error) {
+	response, err := t.c.DescribeBatchOperation(ctx, thrift.FromDescribeBatchOperationRequest(request), opts...)
+	return thrift.ToDescribeBatchOperationResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ListBatchOperations(ctx context.Context, request *types.ListBatchOperationsRequest, opts ...yarpc.CallOption) (*types.ListBatchOperationsResponse, error) {
+	response, err := t.c.ListBatchOperations(ctx, thrift.FromListBatchOperationsRequest(request), opts...)
+	return thrift.ToListBatchOperationsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) CancelBatchOperation(ctx context.Context, request *types.CancelBatchOperationRequest, opts ...yarpc.CallOption) error {
+	err := t.c.CancelBatchOperation(ctx, thrift.FromCancelBatchOperationRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) ListWorkflowExecutions(ctx context.Context, request *types.ListWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error) {
 	response, err := t.c.ListWorkflowExecutions(ctx, thrift.FromListWorkflowExecutionsRequest(request), opts...)
 	return thrift.ToListWorkflowExecutionsResponse(response), thrift.ToError(err)
@@ -243,6 +243,16 @@ func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *typ
 	return proto.ToError(err)
 }
 
+func (g grpcClient) DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.DeleteWorkflowExecution(ctx, proto.FromHistoryDeleteWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UpsertWorkflowSearchAttributes(ctx context.Context, request *types.UpsertWorkflowSearchAttributesRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UpsertWorkflowSearchAttributes(ctx, proto.FromHistoryUpsertWorkflowSearchAttributesRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) GetFailoverInfo(ctx context.Context, request *types.GetFailoverInfoRequest, opts ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error) {
 	response, err := g.c.GetFailoverInfo(ctx, proto.FromHistoryGetFailoverInfoRequest(request), opts...)
 	return proto.ToHistoryGetFailoverInfoResponse(response), proto.ToError(err)
@@ -73,5 +73,7 @@ type Client interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest, ...yarpc.CallOption) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest, ...yarpc.CallOption) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
+	DeleteWorkflowExecution(context.Context, *types.HistoryDeleteWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UpsertWorkflowSearchAttributes(context.Context, *types.UpsertWorkflowSearchAttributesRequest, ...yarpc.CallOption) error
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest, ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error)
 }
@@ -244,6 +244,16 @@ func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *t
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteWorkflowExecution(ctx, thrift.FromHistoryDeleteWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UpsertWorkflowSearchAttributes(ctx context.Context, request *types.UpsertWorkflowSearchAttributesRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UpsertWorkflowSearchAttributes(ctx, thrift.FromUpsertWorkflowSearchAttributesRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) GetFailoverInfo(ctx context.Context, request *types.GetFailoverInfoRequest, opts ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error) {
 	response, err := t.c.GetFailoverInfo(ctx, thrift.FromGetFailoverInfoRequest(request), opts...)
 	return thrift.ToGetFailoverInfoResponse(response), thrift.ToError(err)
@@ -23,8 +23,19 @@ package cadence
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/frontend"
+	historyConfig "github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/matching"
+	"github.com/uber/cadence/service/worker"
 )
 
 type CadenceSuite struct {
@@ -54,3 +65,30 @@ func (s *CadenceSuite) TestPath() {
 	s.Equal("foo/bar", constructPathIfNeed("foo", "bar"))
 	s.Equal("/bar", constructPathIfNeed("foo", "/bar"))
 }
+
+func (s *CadenceSuite) TestDefaultValueDrift() {
+	logger := &log.MockLogger{}
+	logger.On("Debug", mock.Anything, mock.Anything)
+	logger.On("Info", mock.Anything, mock.Anything)
+	logger.On("Warn", mock.Anything, mock.Anything)
+	logger.On("Error", mock.Anything, mock.Anything)
+	logger.On("WithTags", mock.Anything).Return(logger)
+
+	// the default values given by the services must match the ones registered in dynamic config
+	dc := dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), logger)
+	frontend.NewConfig(dc, 16, true, false)
+	historyConfig.New(dc, 16, config.StoreTypeCassandra, true)
+	matching.NewConfig(dc)
+	worker.NewConfig(&service.BootstrapParams{
+		Logger:            logger,
+		DynamicConfig:     dynamicconfig.NewNopClient(),
+		ClusterMetadata:   cluster.GetTestClusterMetadata(true, true),
+		PersistenceConfig: config.Persistence{AdvancedVisibilityStore: "es-visibility"},
+	})
+
+	for _, call := range logger.Calls {
+		if call.Method == "Warn" && call.Arguments.String(0) == dynamicconfig.DefaultValueDriftMessage {
+			s.Fail("default value drift", "%v", call.Arguments.Get(1))
+		}
+	}
+}
@@ -21,6 +21,7 @@
 package cadence
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -31,7 +32,10 @@ import (
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/gcsstore"
+	"github.com/uber/cadence/common/blobstore/s3store"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -40,7 +44,9 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging/kafka"
+	"github.com/uber/cadence/common/messaging/persistencequeue"
 	"github.com/uber/cadence/common/metrics"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/frontend"
 	"github.com/uber/cadence/service/history"
@@ -114,10 +120,14 @@ func (s *server) startService() common.Daemon {
 	params.UpdateLoggerWithServiceName(params.Name)
 	params.PersistenceConfig = s.cfg.Persistence
 
+	svcCfg := s.cfg.Services[s.name]
+	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger, params.Name)
+	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
+
 	err = nil
 	if s.cfg.DynamicConfig.Client == "" {
 		//try to fallback to legacy dynamicClientConfig
-		params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, s.doneC)
+		params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, params.MetricsClient, s.doneC)
 	} else {
 		switch s.cfg.DynamicConfig.Client {
 		case dynamicconfig.DynamicConfigConfigStoreClient:
@@ -126,11 +136,12 @@ func (s *server) startService() common.Daemon {
 				&s.cfg.DynamicConfig.ConfigStore,
 				&s.cfg.Persistence,
 				params.Logger,
+				params.MetricsClient,
 				s.doneC,
 			)
 		case dynamicconfig.DynamicConfigFileBasedClient:
 			log.Printf("Trying to initialize File Based Dynamic Config Client\n")
-			params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfig.FileBased, params.Logger, s.doneC)
+			params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfig.FileBased, params.Logger, params.MetricsClient, s.doneC)
 		default:
 			log.Printf("Trying to initialize Nop Config Client\n")
 			params.DynamicConfig = dynamicconfig.NewNopClient()
@@ -148,12 +159,11 @@ func (s *server) startService() common.Daemon {
 		dynamicconfig.ClusterNameFilter(clusterGroupMetadata.CurrentClusterName),
 	)
 
-	svcCfg := s.cfg.Services[s.name]
-	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger, params.Name)
 	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger, s.cfg.NewGRPCPorts())
 	params.MembershipFactory, err = s.cfg.Ringpop.NewFactory(
 		params.RPCFactory.GetDispatcher(),
 		params.Name,
+		svcCfg.RPC.GossipPort,
 		params.Logger,
 	)
 	if err != nil {
@@ -163,8 +173,6 @@ func (s *server) startService() common.Daemon {
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
 
-	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
-
 	params.ClusterMetadata = cluster.NewMetadata(
 		params.Logger,
 		dc.GetBoolProperty(dynamicconfig.EnableGlobalDomain, clusterGroupMetadata.EnableGlobalDomain),
@@ -185,12 +193,7 @@ func (s *server) startService() common.Daemon {
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)()
 	isAdvancedVisEnabled := advancedVisMode != common.AdvancedVisibilityWritingModeOff
-	if isAdvancedVisEnabled {
-		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isAdvancedVisEnabled)
-	} else {
-		params.MessagingClient = nil
-	}
-
+	params.MessagingClient = nil
 	if isAdvancedVisEnabled {
 		// verify config of advanced visibility store
 		advancedVisStoreKey := s.cfg.Persistence.AdvancedVisibilityStore
@@ -212,6 +215,26 @@ func (s *server) startService() common.Daemon {
 		if !ok || len(indexName) == 0 {
 			log.Fatalf("elastic search config missing visibility index")
 		}
+
+		// messaging is not needed when history writes visibility records to ElasticSearch directly
+		if !params.ESConfig.IsDirectIndexing() {
+			if s.cfg.Messaging.IsPersistenceQueue() {
+				params.MessagingClient = persistencequeue.NewClient(
+					s.cfg.Messaging.PersistenceQueue,
+					persistenceClient.NewFactory(
+						&params.PersistenceConfig,
+						nil,
+						params.ClusterMetadata.GetCurrentClusterName(),
+						params.MetricsClient,
+						params.Logger,
+					),
+					params.MetricsClient,
+					params.Logger,
+				)
+			} else {
+				params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isAdvancedVisEnabled)
+			}
+		}
 	}
 
 	var options *client.DispatcherOptions
@@ -240,13 +263,17 @@ func (s *server) startService() common.Daemon {
 		&s.cfg.DomainDefaults.Archival,
 	)
 
-	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider)
+	archiverProviderOptions, err := newArchiverProviderOptions(&s.cfg.Archival.History)
+	if err != nil {
+		log.Fatalf("failed to create archiver provider options: %v", err)
+	}
+	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider, archiverProviderOptions...)
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
 	params.PersistenceConfig.ErrorInjectionRate = dc.GetFloat64Property(dynamicconfig.PersistenceErrorInjectionRate, 0)
 	params.AuthorizationConfig = s.cfg.Authorization
-	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
+	params.BlobstoreClient, err = newBlobstoreClient(&s.cfg.Blobstore)
 	if err != nil {
-		log.Printf("failed to create file blobstore client, will continue startup without it: %v", err)
+		log.Printf("failed to create blobstore client, will continue startup without it: %v", err)
 		params.BlobstoreClient = nil
 	}
 
@@ -273,6 +300,47 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newArchiverProviderOptions creates the options for compressing and encrypting archived histories
+func newArchiverProviderOptions(cfg *config.HistoryArchival) ([]provider.ProviderOption, error) {
+	var opts []provider.ProviderOption
+	if cfg.Compression != "" {
+		opts = append(opts, provider.WithHistoryCompression(archiver.CompressionType(cfg.Compression)))
+	}
+	if cfg.Encryption != nil {
+		domainKeys, defaultKey, err := cfg.Encryption.GetKeys()
+		if err != nil {
+			return nil, err
+		}
+		keyProvider, err := archiver.NewStaticKeyProvider(domainKeys, defaultKey)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, provider.WithHistoryEncryption(keyProvider))
+	}
+	return opts, nil
+}
+
+// newBlobstoreClient creates the client of the configured blobstore backend,
+// clients of object storage backends retry transient errors
+func newBlobstoreClient(cfg *config.Blobstore) (blobstore.Client, error) {
+	switch {
+	case cfg.S3 != nil:
+		client, err := s3store.NewS3Client(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		return blobstore.NewRetryableClient(client, common.CreateBlobstoreClientRetryPolicy()), nil
+	case cfg.GCS != nil:
+		client, err := gcsstore.NewGCSClient(context.Background(), cfg.GCS)
+		if err != nil {
+			return nil, err
+		}
+		return blobstore.NewRetryableClient(client, common.CreateBlobstoreClientRetryPolicy()), nil
+	default:
+		return filestore.NewFilestoreClient(cfg.Filestore)
+	}
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
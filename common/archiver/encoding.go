@@ -0,0 +1,237 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/DataDog/zstd"
+)
+
+type (
+	// CompressionType is the compression of archived histories
+	CompressionType string
+
+	// archiveHeader describes how the payload of an encoded archive is transformed
+	archiveHeader struct {
+		Compression CompressionType `json:"compression,omitempty"`
+		// EncryptedDataKey is the data key encrypting the payload, wrapped by the key provider
+		EncryptedDataKey []byte `json:"encryptedDataKey,omitempty"`
+		Nonce            []byte `json:"nonce,omitempty"`
+	}
+)
+
+const (
+	// CompressionTypeNone is the compression type for uncompressed archives
+	CompressionTypeNone CompressionType = ""
+	// CompressionTypeGzip is the compression type for gzip compressed archives
+	CompressionTypeGzip CompressionType = "gzip"
+	// CompressionTypeZstd is the compression type for zstd compressed archives
+	CompressionTypeZstd CompressionType = "zstd"
+
+	archiveFormatVersion = 1
+)
+
+var (
+	// archiveMagic prefixes encoded archives, archives written before encoding was supported
+	// are plain json which never starts with a zero byte
+	archiveMagic = []byte{0x00, 'c', 'a', 'r'}
+
+	// ErrUnknownCompressionType is the error for unknown archive compression type
+	ErrUnknownCompressionType = errors.New("unknown archive compression type")
+	// ErrKeyProviderNotFound is the error for reading an encrypted archive without a key provider
+	ErrKeyProviderNotFound = errors.New("archive is encrypted but no key provider is configured")
+	// ErrArchiveCorrupted is the error for an archive which can't be decoded
+	ErrArchiveCorrupted = errors.New("archive is corrupted")
+)
+
+// EncodeArchive compresses and encrypts the archive data according to the compression and
+// key provider in the feature catalog. The data is returned as is if neither is set, so
+// the archive stays readable by older versions.
+func EncodeArchive(
+	ctx context.Context,
+	domainID string,
+	data []byte,
+	featureCatalog *ArchiveFeatureCatalog,
+) ([]byte, error) {
+	if featureCatalog.Compression == CompressionTypeNone && featureCatalog.KeyProvider == nil {
+		return data, nil
+	}
+
+	header := archiveHeader{
+		Compression: featureCatalog.Compression,
+	}
+	payload, err := compress(featureCatalog.Compression, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if featureCatalog.KeyProvider != nil {
+		dataKey, encryptedDataKey, err := featureCatalog.KeyProvider.GenerateDataKey(ctx, domainID)
+		if err != nil {
+			return nil, err
+		}
+		gcm, err := newGCM(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+		header.EncryptedDataKey = encryptedDataKey
+		header.Nonce = nonce
+		payload = gcm.Seal(nil, nonce, payload, []byte(domainID))
+	}
+
+	encodedHeader, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, len(archiveMagic)+5+len(encodedHeader)+len(payload)))
+	buf.Write(archiveMagic)
+	buf.WriteByte(archiveFormatVersion)
+	binary.Write(buf, binary.BigEndian, uint32(len(encodedHeader)))
+	buf.Write(encodedHeader)
+	buf.Write(payload)
+	return buf.Bytes(), nil
+}
+
+// DecodeArchive reverts EncodeArchive, the key provider is only required for encrypted archives.
+// Archives written before encoding was supported are returned as is.
+func DecodeArchive(
+	ctx context.Context,
+	domainID string,
+	data []byte,
+	keyProvider KeyProvider,
+) ([]byte, error) {
+	if !bytes.HasPrefix(data, archiveMagic) {
+		return data, nil
+	}
+
+	data = data[len(archiveMagic):]
+	if len(data) < 5 {
+		return nil, ErrArchiveCorrupted
+	}
+	if version := data[0]; version != archiveFormatVersion {
+		return nil, fmt.Errorf("unknown archive format version: %v", version)
+	}
+	headerSize := binary.BigEndian.Uint32(data[1:5])
+	data = data[5:]
+	if uint64(len(data)) < uint64(headerSize) {
+		return nil, ErrArchiveCorrupted
+	}
+	var header archiveHeader
+	if err := json.Unmarshal(data[:headerSize], &header); err != nil {
+		return nil, ErrArchiveCorrupted
+	}
+	payload := data[headerSize:]
+
+	if len(header.EncryptedDataKey) != 0 {
+		if keyProvider == nil {
+			return nil, ErrKeyProviderNotFound
+		}
+		dataKey, err := keyProvider.DecryptDataKey(ctx, domainID, header.EncryptedDataKey)
+		if err != nil {
+			return nil, err
+		}
+		gcm, err := newGCM(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(header.Nonce) != gcm.NonceSize() {
+			return nil, ErrArchiveCorrupted
+		}
+		if payload, err = gcm.Open(nil, header.Nonce, payload, []byte(domainID)); err != nil {
+			return nil, err
+		}
+	}
+
+	return decompress(header.Compression, payload)
+}
+
+// ValidateCompressionType validates the archive compression type
+func ValidateCompressionType(compression CompressionType) error {
+	switch compression {
+	case CompressionTypeNone, CompressionTypeGzip, CompressionTypeZstd:
+		return nil
+	default:
+		return ErrUnknownCompressionType
+	}
+}
+
+func compress(compression CompressionType, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionTypeNone:
+		return data, nil
+	case CompressionTypeGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionTypeZstd:
+		return zstd.Compress(nil, data)
+	default:
+		return nil, ErrUnknownCompressionType
+	}
+}
+
+func decompress(compression CompressionType, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionTypeNone:
+		return data, nil
+	case CompressionTypeGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	case CompressionTypeZstd:
+		return zstd.Decompress(nil, data)
+	default:
+		return nil, ErrUnknownCompressionType
+	}
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	encodingSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		keyProvider KeyProvider
+	}
+)
+
+const (
+	testEncodingDomainID = "test-domain-id"
+)
+
+func TestEncodingSuite(t *testing.T) {
+	suite.Run(t, new(encodingSuite))
+}
+
+func (s *encodingSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.keyProvider, err = NewStaticKeyProvider(nil, bytes.Repeat([]byte{1}, 32))
+	s.NoError(err)
+}
+
+func (s *encodingSuite) TestEncodeArchive_NoOption() {
+	data := []byte(`[{"events":[]}]`)
+	encoded, err := EncodeArchive(context.Background(), testEncodingDomainID, data, GetFeatureCatalog())
+	s.NoError(err)
+	s.Equal(data, encoded)
+}
+
+func (s *encodingSuite) TestDecodeArchive_Plain() {
+	data := []byte(`[{"events":[]}]`)
+	decoded, err := DecodeArchive(context.Background(), testEncodingDomainID, data, nil)
+	s.NoError(err)
+	s.Equal(data, decoded)
+}
+
+func (s *encodingSuite) TestEncodeAndDecodeArchive() {
+	data := bytes.Repeat([]byte(`{"eventId":1,"eventType":"WorkflowExecutionStarted"}`), 100)
+	testCases := []struct {
+		compression CompressionType
+		keyProvider KeyProvider
+	}{
+		{compression: CompressionTypeGzip},
+		{compression: CompressionTypeZstd},
+		{keyProvider: s.keyProvider},
+		{compression: CompressionTypeGzip, keyProvider: s.keyProvider},
+		{compression: CompressionTypeZstd, keyProvider: s.keyProvider},
+	}
+
+	for _, tc := range testCases {
+		catalog := GetFeatureCatalog(GetCompressionArchiveOption(tc.compression), GetEncryptionArchiveOption(tc.keyProvider))
+		encoded, err := EncodeArchive(context.Background(), testEncodingDomainID, data, catalog)
+		s.NoError(err)
+		s.NotEqual(data, encoded)
+		if tc.compression != CompressionTypeNone {
+			s.True(len(encoded) < len(data))
+		}
+		if tc.keyProvider != nil {
+			s.False(bytes.Contains(encoded, []byte("WorkflowExecutionStarted")))
+		}
+
+		decoded, err := DecodeArchive(context.Background(), testEncodingDomainID, encoded, tc.keyProvider)
+		s.NoError(err)
+		s.Equal(data, decoded)
+	}
+}
+
+func (s *encodingSuite) TestDecodeArchive_Encrypted_NoKeyProvider() {
+	catalog := GetFeatureCatalog(GetEncryptionArchiveOption(s.keyProvider))
+	encoded, err := EncodeArchive(context.Background(), testEncodingDomainID, []byte("history"), catalog)
+	s.NoError(err)
+
+	_, err = DecodeArchive(context.Background(), testEncodingDomainID, encoded, nil)
+	s.Equal(ErrKeyProviderNotFound, err)
+}
+
+func (s *encodingSuite) TestDecodeArchive_Encrypted_WrongDomain() {
+	catalog := GetFeatureCatalog(GetEncryptionArchiveOption(s.keyProvider))
+	encoded, err := EncodeArchive(context.Background(), testEncodingDomainID, []byte("history"), catalog)
+	s.NoError(err)
+
+	_, err = DecodeArchive(context.Background(), "other-domain-id", encoded, s.keyProvider)
+	s.Error(err)
+}
+
+func (s *encodingSuite) TestDecodeArchive_Corrupted() {
+	catalog := GetFeatureCatalog(GetCompressionArchiveOption(CompressionTypeGzip))
+	encoded, err := EncodeArchive(context.Background(), testEncodingDomainID, []byte("history"), catalog)
+	s.NoError(err)
+
+	_, err = DecodeArchive(context.Background(), testEncodingDomainID, encoded[:len(archiveMagic)+3], nil)
+	s.Equal(ErrArchiveCorrupted, err)
+	_, err = DecodeArchive(context.Background(), testEncodingDomainID, encoded[:len(archiveMagic)+7], nil)
+	s.Equal(ErrArchiveCorrupted, err)
+}
+
+func (s *encodingSuite) TestEncodeArchive_UnknownCompression() {
+	catalog := GetFeatureCatalog(GetCompressionArchiveOption("lz4"))
+	_, err := EncodeArchive(context.Background(), testEncodingDomainID, []byte("history"), catalog)
+	s.Equal(ErrUnknownCompressionType, err)
+}
@@ -56,6 +56,7 @@ const (
 	URIScheme = "file"
 
 	errEncodeHistory = "failed to encode history batches"
+	errEncodeArchive = "failed to compress or encrypt history batches"
 	errMakeDirectory = "failed to make directory"
 	errWriteFile     = "failed to write history to file"
 
@@ -176,6 +177,11 @@ func (h *historyArchiver) Archive(
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
 	}
+	encodedHistoryBatches, err = archiver.EncodeArchive(ctx, request.DomainID, encodedHistoryBatches, featureCatalog)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeArchive), tag.Error(err))
+		return err
+	}
 
 	dirPath := URI.Path()
 	if err = util.MkdirAll(dirPath, h.dirMode); err != nil {
@@ -250,6 +256,10 @@ func (h *historyArchiver) Get(
 	if err != nil {
 		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
+	encodedHistoryBatches, err = archiver.DecodeArchive(ctx, request.DomainID, encodedHistoryBatches, h.container.KeyProvider)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
 
 	historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
 	if err != nil {
@@ -281,6 +291,39 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+// Delete deletes the archived histories of all close failover versions of the workflow run
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	dirPath := URI.Path()
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	filenames, err := util.ListFilesByPrefix(dirPath, constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID)+"_")
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if err := deleteFiles(dirPath, filenames); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -563,6 +563,111 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestDelete() {
+	dir, err := ioutil.TempDir("", "TestDelete")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	for _, version := range []int64{1, 100} {
+		filename := constructHistoryFilename(testDomainID, testWorkflowID, testRunID, version)
+		s.NoError(util.WriteFile(path.Join(dir, filename), []byte("history"), testFileMode))
+	}
+	otherFilename := constructHistoryFilename(testDomainID, testWorkflowID, "other run ID", 1)
+	s.NoError(util.WriteFile(path.Join(dir, otherFilename), []byte("history"), testFileMode))
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+	files, err := util.ListFiles(dir)
+	s.NoError(err)
+	s.Equal([]string{otherFilename}, files)
+
+	// deleting again is a no-op
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{DomainID: testDomainID})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_CompressedAndEncrypted() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndGet_CompressedAndEncrypted")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	keyProvider, err := archiver.NewStaticKeyProvider(map[string][]byte{testDomainID: make([]byte, 32)}, nil)
+	s.NoError(err)
+	container := *s.container
+	container.KeyProvider = keyProvider
+	historyArchiver, err := newHistoryArchiver(&container, &config.FilestoreArchiver{
+		FileMode: testFileModeStr,
+		DirMode:  testDirModeStr,
+	}, historyIterator)
+	s.NoError(err)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(
+		context.Background(),
+		URI,
+		archiveRequest,
+		archiver.GetCompressionArchiveOption(archiver.CompressionTypeGzip),
+		archiver.GetEncryptionArchiveOption(keyProvider),
+	)
+	s.NoError(err)
+
+	expectedFilename := constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	data, err := ioutil.ReadFile(path.Join(dir, expectedFilename))
+	s.NoError(err)
+	_, err = decodeHistoryBatches(data)
+	s.Error(err)
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	// reading encrypted history requires the key provider
+	historyArchiver = s.newTestHistoryArchiver(nil)
+	_, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.Error(err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -23,26 +23,24 @@
 package filestore
 
 import (
-	"errors"
-	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
-	"github.com/xwb1989/sqlparser"
-
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/types"
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
+	// QueryParser parses a SQL where clause into a struct
 	QueryParser interface {
 		Parse(query string) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
 
+	// parsedQuery holds the conditions of the query which can be checked without reading
+	// the visibility records, filter is the complete query and is nil when all records match
 	parsedQuery struct {
 		earliestCloseTime int64
 		latestCloseTime   int64
@@ -51,22 +49,17 @@ type (
 		workflowTypeName  *string
 		closeStatus       *types.WorkflowExecutionCloseStatus
 		emptyResult       bool
+		filter            *archiver.VisibilityQuery
 	}
 )
 
-// All allowed fields for filtering
-const (
-	WorkflowID   = "WorkflowID"
-	RunID        = "RunID"
-	WorkflowType = "WorkflowType"
-	CloseTime    = "CloseTime"
-	CloseStatus  = "CloseStatus"
-)
-
+// All allowed fields for filtering, any other field is treated as a custom search attribute
 const (
-	queryTemplate = "select * from dummy where %s"
-
-	defaultDateTimeFormat = time.RFC3339
+	WorkflowID   = archiver.VisibilityQueryWorkflowID
+	RunID        = archiver.VisibilityQueryRunID
+	WorkflowType = archiver.VisibilityQueryWorkflowType
+	CloseTime    = archiver.VisibilityQueryCloseTime
+	CloseStatus  = archiver.VisibilityQueryCloseStatus
 )
 
 // NewQueryParser creates a new query parser for filestore
@@ -75,195 +68,58 @@ func NewQueryParser() QueryParser {
 }
 
 func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	filter, err := archiver.ParseVisibilityQuery(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	earliestCloseTime, latestCloseTime := filter.TimeRange(CloseTime)
 	parsedQuery := &parsedQuery{
-		earliestCloseTime: 0,
-		latestCloseTime:   time.Now().UnixNano(),
-	}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
-		return nil, err
-	}
-	return parsedQuery, nil
-}
-
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
-	}
-
-	switch expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr.(*sqlparser.ComparisonExpr), parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr.(*sqlparser.ParenExpr), parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
-	}
-}
-
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
-	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
-	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
-	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
-	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with file system", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
+		earliestCloseTime: earliestCloseTime,
+		latestCloseTime:   common.MinInt64(latestCloseTime, time.Now().UnixNano()),
+		filter:            filter,
+	}
+	if parsedQuery.earliestCloseTime > parsedQuery.latestCloseTime {
+		parsedQuery.emptyResult = true
+		return parsedQuery, nil
+	}
+
+	for field, hint := range map[string]**string{
+		WorkflowID:   &parsedQuery.workflowID,
+		RunID:        &parsedQuery.runID,
+		WorkflowType: &parsedQuery.workflowTypeName,
+	} {
+		value, emptyResult := singleValue(filter, field)
+		if emptyResult {
 			parsedQuery.emptyResult = true
-			return nil
+			return parsedQuery, nil
 		}
-		parsedQuery.workflowID = common.StringPtr(val)
-	case RunID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with file system", RunID)
-		}
-		if parsedQuery.runID != nil && *parsedQuery.runID != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.runID = common.StringPtr(val)
-	case WorkflowType:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with file system", WorkflowType)
-		}
-		if parsedQuery.workflowTypeName != nil && *parsedQuery.workflowTypeName != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.workflowTypeName = common.StringPtr(val)
-	case CloseStatus:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			// if failed to extract string value, it means user input close status as a number
-			val = valStr
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with file system", CloseStatus)
-		}
-		status, err := convertStatusStr(val)
-		if err != nil {
-			return err
-		}
-		if parsedQuery.closeStatus != nil && *parsedQuery.closeStatus != status {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.closeStatus = status.Ptr()
-	case CloseTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		return p.convertCloseTime(timestamp, op, parsedQuery)
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		*hint = value
 	}
 
-	return nil
-}
-
-func (p *queryParser) convertCloseTime(timestamp int64, op string, parsedQuery *parsedQuery) error {
-	switch op {
-	case "=":
-		if err := p.convertCloseTime(timestamp, ">=", parsedQuery); err != nil {
-			return err
-		}
-		if err := p.convertCloseTime(timestamp, "<=", parsedQuery); err != nil {
-			return err
-		}
-	case "<":
-		parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, timestamp-1)
-	case "<=":
-		parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, timestamp)
-	case ">":
-		parsedQuery.earliestCloseTime = common.MaxInt64(parsedQuery.earliestCloseTime, timestamp+1)
-	case ">=":
-		parsedQuery.earliestCloseTime = common.MaxInt64(parsedQuery.earliestCloseTime, timestamp)
-	default:
-		return fmt.Errorf("operator %s is not supported for close time", op)
+	status, emptyResult := singleValue(filter, CloseStatus)
+	if emptyResult {
+		parsedQuery.emptyResult = true
+		return parsedQuery, nil
 	}
-	return nil
-}
-
-func convertToTimestamp(timeStr string) (int64, error) {
-	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp, nil
-	}
-	timestampStr, err := extractStringValue(timeStr)
-	if err != nil {
-		return 0, err
-	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return 0, err
+	if status != nil {
+		closeStatus, err := strconv.Atoi(*status)
+		if err != nil {
+			return nil, err
+		}
+		parsedQuery.closeStatus = types.WorkflowExecutionCloseStatus(closeStatus).Ptr()
 	}
-	return parsedTime.UnixNano(), nil
+	return parsedQuery, nil
 }
 
-func convertStatusStr(statusStr string) (types.WorkflowExecutionCloseStatus, error) {
-	statusStr = strings.ToLower(strings.TrimSpace(statusStr))
-	switch statusStr {
-	case "completed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCompleted)):
-		return types.WorkflowExecutionCloseStatusCompleted, nil
-	case "failed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusFailed)):
-		return types.WorkflowExecutionCloseStatusFailed, nil
-	case "canceled", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCanceled)):
-		return types.WorkflowExecutionCloseStatusCanceled, nil
-	case "terminated", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTerminated)):
-		return types.WorkflowExecutionCloseStatusTerminated, nil
-	case "continuedasnew", "continued_as_new", strconv.Itoa(int(types.WorkflowExecutionCloseStatusContinuedAsNew)):
-		return types.WorkflowExecutionCloseStatusContinuedAsNew, nil
-	case "timedout", "timed_out", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTimedOut)):
-		return types.WorkflowExecutionCloseStatusTimedOut, nil
-	default:
-		return 0, fmt.Errorf("unknown workflow close status: %s", statusStr)
+// singleValue returns the value of the field if the query only matches records with that value,
+// emptyResult is true if the query can't match any record
+func singleValue(filter *archiver.VisibilityQuery, field string) (value *string, emptyResult bool) {
+	values, ok := filter.StringValues(field)
+	if !ok || len(values) > 1 {
+		return nil, false
 	}
-}
-
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
+	if len(values) == 0 {
+		return nil, true
 	}
-	return "", fmt.Errorf("value %s is not a string value", s)
+	return common.StringPtr(values[0]), false
 }
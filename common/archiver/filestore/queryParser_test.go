@@ -108,8 +108,35 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 			expectErr: true,
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
-			expectErr: true,
+			query:       "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
+		},
+		{
+			query:     "WorkflowID in (\"random workflowID\") or WorkflowID = \"random workflowID\"",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID: common.StringPtr("random workflowID"),
+			},
+		},
+		{
+			query:     "WorkflowID in (\"random workflowID\", \"another workflowID\") and WorkflowID != \"another workflowID\"",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				emptyResult: false,
+			},
+		},
+		{
+			query:     "WorkflowID in (\"random workflowID\") and WorkflowID = \"another workflowID\"",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				emptyResult: true,
+			},
+		},
+		{
+			query:       "WorkflowID = \"random workflowID\" or RunID = \"random runID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "WorkflowID = \"random workflowID\" or runID = \"random runID\"",
@@ -188,7 +215,15 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 		},
 		{
 			query:     "CloseStatus = \"Failed\" or CloseStatus = \"Failed\"",
-			expectErr: true,
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				closeStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(),
+			},
+		},
+		{
+			query:       "CloseStatus in (\"Failed\", \"Completed\")",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "CloseStatus = \"unknown\"",
@@ -334,7 +369,9 @@ func (s *queryParserSuite) TestParse() {
 		}
 		s.NoError(err)
 		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
+		s.NotNil(parsedQuery.filter)
 		if !tc.parsedQuery.emptyResult {
+			tc.parsedQuery.filter = parsedQuery.filter
 			s.Equal(tc.parsedQuery, parsedQuery)
 		}
 	}
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 
@@ -102,6 +103,164 @@ func hash(s string) string {
 	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
 }
 
+func deleteFiles(dirPath string, filenames []string) error {
+	for _, filename := range filenames {
+		if err := os.Remove(path.Join(dirPath, filename)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Visibility index
+//
+// Visibility records are indexed by workflowID, workflow type and custom search attributes so that queries
+// on them don't need to read every record of the domain. An index entry is an empty file with the same name as
+// the record file under <URI path>/.index/<domainID>/<index name>/<hash(value)>/. The .indexed marker file of
+// a domain is written once the records archived before indexing was introduced have been indexed.
+
+func constructVisibilityIndexDir(dirPath string, domainID string) string {
+	return path.Join(dirPath, visibilityIndexDirName, domainID)
+}
+
+func constructSearchAttributeIndexName(key string) string {
+	return searchAttributeIndexPrefix + key
+}
+
+// visibilityIndexEntries returns the directories, relative to the index directory of the domain,
+// which should contain an index entry for the record
+func visibilityIndexEntries(record *visibilityRecord) []string {
+	entries := []string{
+		path.Join(workflowIDIndexName, hash(record.WorkflowID)),
+		path.Join(workflowTypeIndexName, hash(record.WorkflowTypeName)),
+	}
+	for key, value := range record.SearchAttributes {
+		for _, indexValue := range archiver.SearchAttributeIndexValues(value) {
+			entries = append(entries, path.Join(constructSearchAttributeIndexName(key), hash(indexValue)))
+		}
+	}
+	return entries
+}
+
+func writeVisibilityIndex(indexDir string, record *visibilityRecord, filename string, dirMode, fileMode os.FileMode) error {
+	for _, entry := range visibilityIndexEntries(record) {
+		entryDir := path.Join(indexDir, entry)
+		if err := util.MkdirAll(entryDir, dirMode); err != nil {
+			return err
+		}
+		if err := util.WriteFile(path.Join(entryDir, filename), nil, fileMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteVisibilityIndex(indexDir string, record *visibilityRecord, filename string) error {
+	for _, entry := range visibilityIndexEntries(record) {
+		if err := deleteFiles(path.Join(indexDir, entry), []string{filename}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillVisibilityIndex indexes all visibility records in dirPath unless it has been done before
+func backfillVisibilityIndex(dirPath string, indexDir string, dirMode, fileMode os.FileMode) error {
+	markerPath := path.Join(indexDir, visibilityIndexMarker)
+	indexed, err := util.FileExists(markerPath)
+	if err != nil || indexed {
+		return err
+	}
+
+	files, err := util.ListFiles(dirPath)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		record, err := readVisibilityRecord(path.Join(dirPath, file))
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			continue
+		}
+		if err := writeVisibilityIndex(indexDir, record, file, dirMode, fileMode); err != nil {
+			return err
+		}
+	}
+
+	if err := util.MkdirAll(indexDir, dirMode); err != nil {
+		return err
+	}
+	return util.WriteFile(markerPath, nil, fileMode)
+}
+
+// lookupVisibilityIndex returns the names of the record files which may match the query,
+// ok is false if the index of the domain is incomplete or the query can't be answered with it
+func lookupVisibilityIndex(indexDir string, filter *archiver.VisibilityQuery) (filenames []string, ok bool, err error) {
+	if filter == nil {
+		return nil, false, nil
+	}
+	indexed, err := util.FileExists(path.Join(indexDir, visibilityIndexMarker))
+	if err != nil || !indexed {
+		return nil, false, err
+	}
+
+	indexName, values, ok := selectVisibilityIndex(filter)
+	if !ok {
+		return nil, false, nil
+	}
+	seen := make(map[string]struct{})
+	for _, value := range values {
+		entryDir := path.Join(indexDir, indexName, hash(value))
+		exists, err := util.DirectoryExists(entryDir)
+		if err != nil {
+			return nil, false, err
+		}
+		if !exists {
+			continue
+		}
+		files, err := util.ListFiles(entryDir)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, file := range files {
+			if _, ok := seen[file]; !ok {
+				seen[file] = struct{}{}
+				filenames = append(filenames, file)
+			}
+		}
+	}
+	return filenames, true, nil
+}
+
+func selectVisibilityIndex(filter *archiver.VisibilityQuery) (indexName string, values []string, ok bool) {
+	if values, ok := filter.StringValues(WorkflowID); ok {
+		return workflowIDIndexName, values, true
+	}
+	if values, ok := filter.StringValues(WorkflowType); ok {
+		return workflowTypeIndexName, values, true
+	}
+	for _, key := range filter.SearchAttributes() {
+		if values, ok := filter.StringValues(key); ok {
+			return constructSearchAttributeIndexName(key), values, true
+		}
+	}
+	return "", nil, false
+}
+
+// readVisibilityRecord returns nil if the record file doesn't exist
+func readVisibilityRecord(filepath string) (*visibilityRecord, error) {
+	encodedRecord, err := util.ReadFile(filepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeVisibilityRecord(encodedRecord)
+}
+
 // Validation
 
 func validateDirPath(dirPath string) error {
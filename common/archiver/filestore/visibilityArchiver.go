@@ -39,6 +39,13 @@ import (
 
 const (
 	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errIndexVisibilityRecord  = "failed to index visibility record"
+
+	visibilityIndexDirName     = ".index"
+	visibilityIndexMarker      = ".indexed"
+	workflowIDIndexName        = "workflowID"
+	workflowTypeIndexName      = "workflowType"
+	searchAttributeIndexPrefix = "searchAttribute-"
 )
 
 type (
@@ -52,6 +59,8 @@ type (
 	queryVisibilityToken struct {
 		LastCloseTime int64
 		LastRunID     string
+		// Offset is used instead of the last record when the query is not ordered by close time
+		Offset int
 	}
 
 	visibilityRecord archiver.ArchiveVisibilityRequest
@@ -125,6 +134,18 @@ func (v *visibilityArchiver) Archive(
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.CloseTimestamp, request.RunID)
+
+	// The index is written before the record, an index entry without a record is ignored by queries
+	indexDir := constructVisibilityIndexDir(URI.Path(), request.DomainID)
+	if err := backfillVisibilityIndex(dirPath, indexDir, v.dirMode, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errIndexVisibilityRecord), tag.Error(err))
+		return err
+	}
+	if err := writeVisibilityIndex(indexDir, (*visibilityRecord)(request), filename, v.dirMode, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errIndexVisibilityRecord), tag.Error(err))
+		return err
+	}
+
 	if err := util.WriteFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
@@ -186,10 +207,23 @@ func (v *visibilityArchiver) query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
-	files, err := util.ListFiles(dirPath)
+	files, indexed, err := lookupVisibilityIndex(
+		constructVisibilityIndexDir(URI.Path(), request.domainID),
+		request.parsedQuery.filter,
+	)
 	if err != nil {
 		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
+	if !indexed {
+		if files, err = util.ListFiles(dirPath); err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+
+	filter := request.parsedQuery.filter
+	if filter != nil && !filter.IsOrderedBy(CloseTime, true) {
+		return v.queryAndSort(dirPath, files, request, token)
+	}
 
 	files, err = sortAndFilterFiles(files, token)
 	if err != nil {
@@ -201,14 +235,12 @@ func (v *visibilityArchiver) query(
 
 	response := &archiver.QueryVisibilityResponse{}
 	for idx, file := range files {
-		encodedRecord, err := util.ReadFile(path.Join(dirPath, file))
+		record, err := readVisibilityRecord(path.Join(dirPath, file))
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
-
-		record, err := decodeVisibilityRecord(encodedRecord)
-		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+		if record == nil {
+			continue
 		}
 
 		if record.CloseTimestamp < request.parsedQuery.earliestCloseTime {
@@ -237,6 +269,134 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+// queryAndSort reads all matching records to sort them by the ORDER BY field of the query
+// and returns the page starting at the offset in the token
+func (v *visibilityArchiver) queryAndSort(
+	dirPath string,
+	files []string,
+	request *queryVisibilityRequest,
+	token *queryVisibilityToken,
+) (*archiver.QueryVisibilityResponse, error) {
+	var records []*archiver.ArchiveVisibilityRequest
+	for _, file := range files {
+		record, err := readVisibilityRecord(path.Join(dirPath, file))
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		if record != nil && matchQuery(record, request.parsedQuery) {
+			records = append(records, (*archiver.ArchiveVisibilityRequest)(record))
+		}
+	}
+	// sort by close time first so that records with equal values of the ORDER BY field have a stable order
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].CloseTimestamp == records[j].CloseTimestamp {
+			return hash(records[i].RunID) > hash(records[j].RunID)
+		}
+		return records[i].CloseTimestamp > records[j].CloseTimestamp
+	})
+	request.parsedQuery.filter.Sort(records)
+
+	offset := 0
+	if token != nil {
+		offset = token.Offset
+	}
+	if offset >= len(records) {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+	end := common.MinInt(offset+request.pageSize, len(records))
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, record := range records[offset:end] {
+		response.Executions = append(response.Executions, convertToExecutionInfo((*visibilityRecord)(record)))
+	}
+	if end < len(records) {
+		encodedToken, err := serializeToken(&queryVisibilityToken{Offset: end})
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.NextPageToken = encodedToken
+	}
+	return response, nil
+}
+
+// Delete deletes the archived visibility record of the workflow run
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	dirPath := path.Join(URI.Path(), request.DomainID)
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	files, err := util.ListFiles(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	// the close timestamp is unknown, so match the files by the hashed runID suffix
+	suffix := "_" + hash(request.RunID) + ".visibility"
+	indexDir := constructVisibilityIndexDir(URI.Path(), request.DomainID)
+	var filenames []string
+	for _, file := range files {
+		if !strings.HasSuffix(file, suffix) {
+			continue
+		}
+		// the index entries are deleted first so that a retry can still find them from the record
+		record, err := readVisibilityRecord(path.Join(dirPath, file))
+		if err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		if record != nil {
+			if err := deleteVisibilityIndex(indexDir, record, file); err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+		}
+		filenames = append(filenames, file)
+	}
+	if err := deleteFiles(dirPath, filenames); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
+// QueryExpired returns the visibility records closed before the expiration timestamp, the latest closed first
+func (v *visibilityArchiver) QueryExpired(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryExpiredVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	return v.query(ctx, URI, &queryVisibilityRequest{
+		domainID:      request.DomainID,
+		pageSize:      request.PageSize,
+		nextPageToken: request.NextPageToken,
+		parsedQuery: &parsedQuery{
+			earliestCloseTime: 0,
+			latestCloseTime:   request.ExpirationTimestamp - 1,
+		},
+	})
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -319,6 +479,9 @@ func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
 	if query.closeStatus != nil && record.CloseStatus != *query.closeStatus {
 		return false
 	}
+	if query.filter != nil && !query.filter.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+		return false
+	}
 	return true
 }
 
@@ -491,6 +491,172 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveQueryExpiredAndDelete() {
+	dir, err := ioutil.TempDir("", "TestArchiveQueryExpiredAndDelete")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.NoError(err)
+	}
+
+	request := &archiver.QueryExpiredVisibilityRequest{
+		DomainID:            testDomainID,
+		ExpirationTimestamp: 1000,
+		PageSize:            1,
+	}
+	executions := []*types.WorkflowExecutionInfo{}
+	for {
+		response, err := visibilityArchiver.QueryExpired(context.Background(), URI, request)
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			executions = append(executions, execution)
+			s.NoError(visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+				DomainID:   testDomainID,
+				WorkflowID: execution.Execution.GetWorkflowID(),
+				RunID:      execution.Execution.GetRunID(),
+			}))
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[3]), executions[1])
+
+	files, err := util.ListFiles(path.Join(dir, testDomainID))
+	s.NoError(err)
+	s.Len(files, 2)
+
+	// deleting a record which doesn't exist is not an error
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      "non-existent run ID",
+	}))
+	_, err = visibilityArchiver.QueryExpired(context.Background(), URI, &archiver.QueryExpiredVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_IndexedQuery() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQuery_IndexedQuery")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	records := []*visibilityRecord{
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-1",
+			RunID:            "run-1",
+			WorkflowTypeName: "type-a",
+			StartTimestamp:   50,
+			CloseTimestamp:   100,
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+			HistoryLength:    30,
+		},
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-2",
+			RunID:            "run-2",
+			WorkflowTypeName: "type-a",
+			StartTimestamp:   150,
+			CloseTimestamp:   200,
+			CloseStatus:      types.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:    10,
+			SearchAttributes: map[string]string{"CustomKeywordField": `"blue"`, "CustomIntField": "5"},
+		},
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-3",
+			RunID:            "run-3",
+			WorkflowTypeName: "type-b",
+			StartTimestamp:   250,
+			CloseTimestamp:   300,
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+			HistoryLength:    20,
+			SearchAttributes: map[string]string{"CustomKeywordField": `["red","blue"]`, "CustomIntField": "7"},
+		},
+	}
+	// the first record is archived without index to test backfilling
+	data, err := encode(records[0])
+	s.NoError(err)
+	s.NoError(os.MkdirAll(path.Join(dir, testDomainID), testDirMode))
+	s.NoError(util.WriteFile(path.Join(dir, testDomainID, constructVisibilityFilename(records[0].CloseTimestamp, records[0].RunID)), data, testFileMode))
+	for _, record := range records[1:] {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
+
+	testCases := []struct {
+		query    string
+		expected []*visibilityRecord
+	}{
+		{
+			query:    "WorkflowID = 'workflow-1' or WorkflowID = 'workflow-3'",
+			expected: []*visibilityRecord{records[2], records[0]},
+		},
+		{
+			query:    "WorkflowType in ('type-a') and CloseStatus != 'Failed'",
+			expected: []*visibilityRecord{records[0]},
+		},
+		{
+			query:    "CustomKeywordField = 'blue' and CustomIntField > 5",
+			expected: []*visibilityRecord{records[2]},
+		},
+		{
+			query:    "WorkflowID like 'workflow-%' order by HistoryLength",
+			expected: []*visibilityRecord{records[1], records[2], records[0]},
+		},
+		{
+			query:    "CustomKeywordField in ('red', 'blue') order by CloseTime asc",
+			expected: []*visibilityRecord{records[1], records[2]},
+		},
+	}
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			DomainID: testDomainID,
+			PageSize: 1,
+			Query:    tc.query,
+		}
+		executions := []*types.WorkflowExecutionInfo{}
+		for len(executions) == 0 || request.NextPageToken != nil {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request)
+			s.NoError(err)
+			executions = append(executions, response.Executions...)
+			request.NextPageToken = response.NextPageToken
+		}
+		s.Len(executions, len(tc.expected), tc.query)
+		for i, record := range tc.expected {
+			s.Equal(convertToExecutionInfo(record), executions[i], tc.query)
+		}
+	}
+
+	// deleting the record also deletes its index entries
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: records[0].WorkflowID,
+		RunID:      records[0].RunID,
+	}))
+	files, err := util.ListFiles(path.Join(constructVisibilityIndexDir(dir, testDomainID), workflowIDIndexName, hash(records[0].WorkflowID)))
+	s.NoError(err)
+	s.Empty(files)
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
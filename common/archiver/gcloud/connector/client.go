@@ -59,6 +59,7 @@ type (
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		Delete(ctx context.Context, URI archiver.URI, fileName string) error
 	}
 
 	storageWrapper struct {
@@ -138,6 +139,16 @@ func (s *storageWrapper) Get(ctx context.Context, URI archiver.URI, fileName str
 	return nil, err
 }
 
+// Delete removes a file, it's not an error if the file doesn't exist
+func (s *storageWrapper) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	err := bucket.Object(formatSinkPath(URI.Path()) + "/" + fileName).Delete(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil
+	}
+	return err
+}
+
 // Query, retieves file names by provided storage query
 func (s *storageWrapper) Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) (fileNames []string, err error) {
 	fileNames = make([]string, 0)
@@ -152,6 +163,9 @@ func (s *storageWrapper) Query(ctx context.Context, URI archiver.URI, fileNamePr
 		if err == iterator.Done {
 			return fileNames, nil
 		}
+		if err != nil {
+			return nil, err
+		}
 		fileNames = append(fileNames, attrs.Name)
 	}
 
@@ -174,6 +188,9 @@ func (s *storageWrapper) QueryWithFilters(ctx context.Context, URI archiver.URI,
 		if err == iterator.Done {
 			return resultSet, true, currentPos, nil
 		}
+		if err != nil {
+			return nil, false, currentPos, err
+		}
 
 		if completed := isPageCompleted(pageSize, len(resultSet)); completed {
 			return resultSet, completed, currentPos, err
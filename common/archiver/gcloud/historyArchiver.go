@@ -47,6 +47,7 @@ const (
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 	errEncodeHistory      = "failed to encode history batches"
+	errEncodeArchive      = "failed to compress or encrypt history batches"
 	errBucketHistory      = "failed to get google storage bucket handle"
 	errWriteFile          = "failed to write history to google storage"
 )
@@ -171,6 +172,11 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return errUploadNonRetriable
 		}
+		encodedHistoryPart, err = archiver.EncodeArchive(ctx, request.DomainID, encodedHistoryPart, featureCatalog)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeArchive), tag.Error(err))
+			return errUploadNonRetriable
+		}
 
 		filename := constructHistoryFilenameMultipart(request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, part)
 		if exist, _ := h.gcloudStorage.Exist(ctx, URI, filename); !exist {
@@ -243,6 +249,11 @@ outer:
 			return nil, &types.InternalServiceError{Message: "Fail retrieving history file: " + URI.String() + "/" + filename}
 		}
 
+		encodedHistoryBatches, err = archiver.DecodeArchive(ctx, request.DomainID, encodedHistoryBatches, h.container.KeyProvider)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+
 		batches, err := decodeHistoryBatches(encodedHistoryBatches)
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
@@ -282,6 +293,28 @@ outer:
 	return response, nil
 }
 
+// Delete deletes the archived histories of all close failover versions of the workflow run
+func (h *historyArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteHistoryRequest) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	filenames, err := h.gcloudStorage.Query(ctx, URI, constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID)+"_")
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	for _, filename := range filenames {
+		if err := h.gcloudStorage.Delete(ctx, URI, filepath.Base(filename)); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (h *historyArchiver) ValidateURI(URI archiver.URI) (err error) {
 
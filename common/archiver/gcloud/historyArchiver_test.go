@@ -601,3 +601,28 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 
 	h.EqualValues(4, numOfEvents)
 }
+
+func (h *historyArchiverSuite) TestDelete() {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(h.T())
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil)
+	storageWrapper.On("Query", ctx, URI, "71817125141568232911739672280485489488911532452831150339470_").Return([]string{"cadence_archival/development/71817125141568232911739672280485489488911532452831150339470_-24_0.history", "cadence_archival/development/71817125141568232911739672280485489488911532452831150339470_-25_0.history"}, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, "71817125141568232911739672280485489488911532452831150339470_-24_0.history").Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, "71817125141568232911739672280485489488911532452831150339470_-25_0.history").Return(nil).Times(1)
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper).(*historyArchiver)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+
+	h.NoError(historyArchiver.Delete(ctx, URI, request))
+	storageWrapper.AssertExpectations(h.T())
+
+	err = historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{DomainID: testDomainID})
+	h.IsType(&types.BadRequestError{}, err)
+}
@@ -23,268 +23,114 @@
 package gcloud
 
 import (
-	"errors"
-	"fmt"
-	"strconv"
-	"strings"
-	"time"
+	"math"
 
-	"github.com/xwb1989/sqlparser"
-
-	"github.com/uber/cadence/common"
-	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/archiver"
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
+	// QueryParser parses a SQL where clause into a struct
 	QueryParser interface {
 		Parse(query string) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
 
+	// parsedQuery holds the query and the conditions on the visibility filenames which can be checked
+	// without downloading the records, nil value sets don't restrict the field
 	parsedQuery struct {
-		workflowID      *string
-		workflowType    *string
-		startTime       int64
-		closeTime       int64
-		searchPrecision *string
-		runID           *string
-		emptyResult     bool
+		filter        *archiver.VisibilityQuery
+		workflowIDs   []string
+		workflowTypes []string
+		runIDs        []string
+		// timeIndex is the tag of the filenames ordered by the time the query is restricted by
+		timeIndex    string
+		earliestTime int64
+		latestTime   int64
 	}
 )
 
-// All allowed fields for filtering
+// All allowed fields for filtering, any other field is treated as a custom search attribute
 const (
-	WorkflowID      = "WorkflowID"
-	RunID           = "RunID"
-	WorkflowType    = "WorkflowType"
-	CloseTime       = "CloseTime"
-	StartTime       = "StartTime"
-	CloseStatus     = "CloseStatus"
-	SearchPrecision = "SearchPrecision"
+	WorkflowID      = archiver.VisibilityQueryWorkflowID
+	RunID           = archiver.VisibilityQueryRunID
+	WorkflowType    = archiver.VisibilityQueryWorkflowType
+	CloseTime       = archiver.VisibilityQueryCloseTime
+	StartTime       = archiver.VisibilityQueryStartTime
+	CloseStatus     = archiver.VisibilityQueryCloseStatus
+	SearchPrecision = archiver.VisibilityQuerySearchPrecision
 )
 
 // Precision specific values
 const (
-	PrecisionDay    = "Day"
-	PrecisionHour   = "Hour"
-	PrecisionMinute = "Minute"
-	PrecisionSecond = "Second"
+	PrecisionDay    = archiver.PrecisionDay
+	PrecisionHour   = archiver.PrecisionHour
+	PrecisionMinute = archiver.PrecisionMinute
+	PrecisionSecond = archiver.PrecisionSecond
 )
 
-const (
-	queryTemplate = "select * from dummy where %s"
-
-	defaultDateTimeFormat = time.RFC3339
-)
-
-// NewQueryParser creates a new query parser for filestore
+// NewQueryParser creates a new query parser for Google Cloud Storage
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
 func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	filter, err := archiver.ParseVisibilityQuery(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
-		return nil, err
+	parsedQuery := &parsedQuery{
+		filter:        filter,
+		workflowIDs:   stringValues(filter, WorkflowID),
+		workflowTypes: stringValues(filter, WorkflowType),
+		runIDs:        stringValues(filter, RunID),
+		timeIndex:     indexKeyCloseTimeout,
 	}
 
-	if (parsedQuery.closeTime == 0 && parsedQuery.startTime == 0) || (parsedQuery.closeTime != 0 && parsedQuery.startTime != 0) {
-		return nil, errors.New("Requires a StartTime or CloseTime")
-	}
-
-	if parsedQuery.searchPrecision == nil {
-		return nil, errors.New("SearchPrecision is required when searching for a StartTime or CloseTime")
+	parsedQuery.earliestTime, parsedQuery.latestTime = filter.TimeRange(CloseTime)
+	if parsedQuery.earliestTime == 0 && parsedQuery.latestTime == math.MaxInt64 {
+		earliestStartTime, latestStartTime := filter.TimeRange(StartTime)
+		if earliestStartTime != 0 || latestStartTime != math.MaxInt64 {
+			parsedQuery.timeIndex = indexKeyStartTimeout
+			parsedQuery.earliestTime, parsedQuery.latestTime = earliestStartTime, latestStartTime
+		}
 	}
-
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
-	}
-
-	switch expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr.(*sqlparser.ComparisonExpr), parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr.(*sqlparser.ParenExpr), parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
-	}
-}
-
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
+// emptyResult returns true if no record can match the query
+func (q *parsedQuery) emptyResult() bool {
+	if q.earliestTime > q.latestTime {
+		return true
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
-	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
-	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
-	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
-			parsedQuery.emptyResult = true
-			return nil
+	for _, values := range [][]string{q.workflowIDs, q.workflowTypes, q.runIDs} {
+		if values != nil && len(values) == 0 {
+			return true
 		}
-		parsedQuery.workflowID = common.StringPtr(val)
-	case RunID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", RunID)
-		}
-		if parsedQuery.runID != nil && *parsedQuery.runID != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.runID = common.StringPtr(val)
-	case CloseTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", CloseTime)
-		}
-		parsedQuery.closeTime = timestamp
-
-	case StartTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", StartTime)
-		}
-		parsedQuery.startTime = timestamp
-	case WorkflowType:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", WorkflowType)
-		}
-		if parsedQuery.workflowType != nil && *parsedQuery.workflowType != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.workflowType = common.StringPtr(val)
-	case SearchPrecision:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", SearchPrecision)
-		}
-		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
-			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
-		}
-		switch val {
-		case PrecisionDay:
-		case PrecisionHour:
-		case PrecisionMinute:
-		case PrecisionSecond:
-		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
-		}
-		parsedQuery.searchPrecision = common.StringPtr(val)
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
 	}
-
-	return nil
+	return false
 }
 
-func (p *queryParser) convertCloseTime(timestamp int64, op string, parsedQuery *parsedQuery) error {
-	switch op {
-	case "=":
-		if err := p.convertCloseTime(timestamp, ">=", parsedQuery); err != nil {
-			return err
-		}
-		if err := p.convertCloseTime(timestamp, "<=", parsedQuery); err != nil {
-			return err
-		}
-	default:
-		return fmt.Errorf("operator %s is not supported for close time", op)
-	}
-	return nil
+// hasTimeRange returns true if the query is restricted by the time of its time index
+func (q *parsedQuery) hasTimeRange() bool {
+	return q.earliestTime != 0 || q.latestTime != math.MaxInt64
 }
 
-func convertToTimestamp(timeStr string) (int64, error) {
-	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp, nil
+// timeIndexField returns the field the filenames of the time index are ordered by
+func (q *parsedQuery) timeIndexField() string {
+	if q.timeIndex == indexKeyStartTimeout {
+		return StartTime
 	}
-	timestampStr, err := extractStringValue(timeStr)
-	if err != nil {
-		return 0, err
-	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return 0, err
-	}
-	return parsedTime.UnixNano(), nil
+	return CloseTime
 }
 
-func convertStatusStr(statusStr string) (types.WorkflowExecutionCloseStatus, error) {
-	statusStr = strings.ToLower(statusStr)
-	switch statusStr {
-	case "completed":
-		return types.WorkflowExecutionCloseStatusCompleted, nil
-	case "failed":
-		return types.WorkflowExecutionCloseStatusFailed, nil
-	case "canceled":
-		return types.WorkflowExecutionCloseStatusCanceled, nil
-	case "continuedasnew":
-		return types.WorkflowExecutionCloseStatusContinuedAsNew, nil
-	case "timedout":
-		return types.WorkflowExecutionCloseStatusTimedOut, nil
-	default:
-		return 0, fmt.Errorf("unknown workflow close status: %s", statusStr)
+func stringValues(filter *archiver.VisibilityQuery, field string) []string {
+	values, ok := filter.StringValues(field)
+	if !ok {
+		return nil
 	}
-}
-
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
+	if values == nil {
+		return []string{}
 	}
-	return "", fmt.Errorf("value %s is not a string value", s)
+	return values
 }
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -68,24 +69,46 @@ func constructVisibilityFilenamePrefix(domainID, tag string) string {
 	return fmt.Sprintf("%s/%s", domainID, tag)
 }
 
-func constructTimeBasedSearchKey(domainID, tag string, timestamp int64, precision string) string {
-	t := time.Unix(0, timestamp).In(time.UTC)
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
+// constructVisibilityIndexTags returns the tags of the index filenames of the record, besides the time index tags.
+// The tags contain the hashed values, so listing the filenames with the tag prefix finds all records with a value.
+func constructVisibilityIndexTags(record *archiver.ArchiveVisibilityRequest) []string {
+	tags := []string{
+		constructWorkflowIDIndexTag(record.WorkflowID),
+		constructWorkflowTypeIndexTag(record.WorkflowTypeName),
+	}
+	for key, value := range record.SearchAttributes {
+		for _, indexValue := range archiver.SearchAttributeIndexValues(value) {
+			tags = append(tags, constructSearchAttributeIndexTag(key, indexValue))
+		}
 	}
+	return tags
+}
+
+func constructWorkflowIDIndexTag(workflowID string) string {
+	return indexKeyWorkflowID + "-" + hash(workflowID)
+}
+
+func constructWorkflowTypeIndexTag(workflowTypeName string) string {
+	return indexKeyWorkflowType + "-" + hash(workflowTypeName)
+}
+
+func constructSearchAttributeIndexTag(key, value string) string {
+	return fmt.Sprintf("%s-%s-%s", indexKeySearchAttribute, hash(key), hash(value))
+}
+
+func constructIndexedMarkerFilename(domainID string) string {
+	return fmt.Sprintf("%s/%s", domainID, indexedMarker)
+}
 
-	return fmt.Sprintf("%s_%s", constructVisibilityFilenamePrefix(domainID, tag), t.Format(timeFormat))
+// constructTimeRangePrefix returns the common prefix of the formatted times of all filenames in the time range
+func constructTimeRangePrefix(earliestTime, latestTime int64) string {
+	earliest := time.Unix(0, earliestTime).In(time.UTC).Format(time.RFC3339)
+	latest := time.Unix(0, latestTime).In(time.UTC).Format(time.RFC3339)
+	i := 0
+	for i < len(earliest) && i < len(latest) && earliest[i] == latest[i] {
+		i++
+	}
+	return earliest[:i]
 }
 
 func hash(s string) (result string) {
@@ -176,75 +199,79 @@ func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionIn
 	}
 }
 
-func newRunIDPrecondition(runID string) connector.Precondition {
-	return func(subject interface{}) bool {
+// parseVisibilityFilename splits the name of a visibility file into its tag, timestamp and hashes
+func parseVisibilityFilename(fileName string) ([]string, bool) {
+	fileNameParts := strings.Split(strings.TrimSuffix(filepath.Base(fileName), ".visibility"), "_")
+	return fileNameParts, len(fileNameParts) == 5
+}
 
-		if runID == "" {
-			return true
+// newQueryPrecondition matches the filenames in the time range whose hashed workflow type, workflowID and runID
+// are in the value sets of the query. The time in filenames is truncated to seconds.
+func newQueryPrecondition(query *parsedQuery, earliestTime, latestTime int64) connector.Precondition {
+	earliest := time.Unix(0, earliestTime).Truncate(time.Second)
+	latest := time.Unix(0, latestTime)
+	hashedValues := make([]map[string]struct{}, 5)
+	for i, values := range map[int][]string{2: query.workflowTypes, 3: query.workflowIDs, 4: query.runIDs} {
+		if values == nil {
+			continue
 		}
-
+		hashedValues[i] = make(map[string]struct{}, len(values))
+		for _, value := range values {
+			hashedValues[i][hash(value)] = struct{}{}
+		}
+	}
+	return func(subject interface{}) bool {
 		fileName, ok := subject.(string)
 		if !ok {
 			return false
 		}
 
-		if strings.Contains(fileName, runID) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
+		fileNameParts, ok := parseVisibilityFilename(fileName)
+		if !ok {
+			return false
+		}
+		timestamp, err := time.Parse(time.RFC3339, fileNameParts[1])
+		if err != nil || timestamp.Before(earliest) || timestamp.After(latest) {
+			return false
+		}
+		for i, hashes := range hashedValues {
+			if hashes == nil {
+				continue
+			}
+			if _, ok := hashes[fileNameParts[i]]; !ok {
+				return false
 			}
-			return strings.Contains(fileName, fileNameParts[4])
 		}
-
-		return false
+		return true
 	}
 }
 
-func newWorkflowIDPrecondition(workflowID string) connector.Precondition {
+func newExecutionPrecondition(hashedWorkflowID, hashedRunID string) connector.Precondition {
 	return func(subject interface{}) bool {
-
-		if workflowID == "" {
-			return true
-		}
-
 		fileName, ok := subject.(string)
 		if !ok {
 			return false
 		}
 
-		if strings.Contains(fileName, workflowID) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[3])
-		}
-
-		return false
+		fileNameParts, ok := parseVisibilityFilename(fileName)
+		return ok && fileNameParts[3] == hashedWorkflowID && fileNameParts[4] == hashedRunID
 	}
 }
 
-func newWorkflowTypeNamePrecondition(workflowTypeName string) connector.Precondition {
+func newExpiredPrecondition(expirationTimestamp int64, lastFileName string) connector.Precondition {
+	expirationTime := time.Unix(0, expirationTimestamp)
 	return func(subject interface{}) bool {
-
-		if workflowTypeName == "" {
-			return true
-		}
-
 		fileName, ok := subject.(string)
-		if !ok {
+		if !ok || fileName <= lastFileName {
 			return false
 		}
 
-		if strings.Contains(fileName, workflowTypeName) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[2])
+		fileNameParts, ok := parseVisibilityFilename(fileName)
+		if !ok {
+			return false
 		}
-
-		return false
+		closeTime, err := time.Parse(time.RFC3339, fileNameParts[1])
+		return err == nil && closeTime.Before(expirationTime)
 	}
 }
 
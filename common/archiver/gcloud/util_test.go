@@ -21,6 +21,7 @@
 package gcloud
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -128,109 +130,113 @@ func (s *utilSuite) TestConstructVisibilityFilenamePrefix() {
 	s.Equal("domainID/startTimeout", constructVisibilityFilenamePrefix("domainID", indexKeyStartTimeout))
 }
 
-func (s *utilSuite) TestConstructTimeBasedSearchKey() {
-	s.Equal("domainID/startTimeout_1970-01-01T", constructTimeBasedSearchKey("domainID", indexKeyStartTimeout, 1580819141, "Day"))
+func (s *utilSuite) TestConstructTimeRangePrefix() {
+	day := time.Date(2020, 2, 27, 0, 0, 0, 0, time.UTC)
+	s.Equal("2020-02-27T", constructTimeRangePrefix(day.UnixNano(), day.Add(24*time.Hour-1).UnixNano()))
+	s.Equal("2020-02-27T09:", constructTimeRangePrefix(day.Add(9*time.Hour).UnixNano(), day.Add(10*time.Hour-1).UnixNano()))
+	s.Equal("2020-02-27T09:42:28Z", constructTimeRangePrefix(day.Add(9*time.Hour+42*time.Minute+28*time.Second).UnixNano(), day.Add(9*time.Hour+42*time.Minute+28*time.Second).UnixNano()))
+	s.Equal("", constructTimeRangePrefix(0, math.MaxInt64))
+}
+
+func (s *utilSuite) TestConstructVisibilityIndexTags() {
+	record := &archiver.ArchiveVisibilityRequest{
+		WorkflowID:       "workflowID",
+		WorkflowTypeName: "workflowTypeName",
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": `["keyword1","keyword2"]`,
+		},
+	}
+	s.ElementsMatch([]string{
+		constructWorkflowIDIndexTag("workflowID"),
+		constructWorkflowTypeIndexTag("workflowTypeName"),
+		constructSearchAttributeIndexTag("CustomKeywordField", "keyword1"),
+		constructSearchAttributeIndexTag("CustomKeywordField", "keyword2"),
+	}, constructVisibilityIndexTags(record))
+	s.Equal("workflowID-8344541402884576509", constructWorkflowIDIndexTag("workflowID"))
 }
 
 func (s *utilSuite) TestConstructVisibilityFilename() {
 	s.Equal("domainID/startTimeout_1970-01-01T00:24:32Z_4346151385925082125_8344541402884576509_131521284625246243.visibility", constructVisibilityFilename("domainID", "workflowTypeName", "workflowID", "runID", indexKeyStartTimeout, 1472313624305))
 }
 
-func (s *utilSuite) TestWorkflowIdPrecondition() {
+func (s *utilSuite) TestQueryPrecondition() {
+	fileName := "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility"
+	closeTime := time.Date(2020, 2, 27, 9, 42, 28, 0, time.UTC).UnixNano()
 	testCases := []struct {
-		workflowID     string
-		fileName       string
+		query          *parsedQuery
+		earliestTime   int64
+		latestTime     int64
 		expectedResult bool
 	}{
 		{
-			workflowID:     "4418294404690464320",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
+			query:          &parsedQuery{},
+			earliestTime:   0,
+			latestTime:     math.MaxInt64,
 			expectedResult: true,
 		},
 		{
-			workflowID:     "testWorkflowID",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: false,
+			query:          &parsedQuery{},
+			earliestTime:   closeTime + int64(time.Millisecond),
+			latestTime:     closeTime + int64(time.Second),
+			expectedResult: true,
 		},
 		{
-			workflowID:     "",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: true,
+			query:          &parsedQuery{},
+			earliestTime:   closeTime + int64(time.Second),
+			latestTime:     math.MaxInt64,
+			expectedResult: false,
 		},
-	}
-
-	for _, testCase := range testCases {
-		s.Equal(newWorkflowIDPrecondition(testCase.workflowID)(testCase.fileName), testCase.expectedResult)
-	}
-
-}
-
-func (s *utilSuite) TestRunIdPrecondition() {
-	testCases := []struct {
-		workflowID     string
-		runID          string
-		fileName       string
-		expectedResult bool
-	}{
 		{
-			workflowID:     "4418294404690464320",
-			runID:          "15619178330501475177",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: true,
+			query:          &parsedQuery{},
+			earliestTime:   0,
+			latestTime:     closeTime - 1,
+			expectedResult: false,
 		},
 		{
-			workflowID:     "4418294404690464320",
-			runID:          "15619178330501475177",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_unkonwnRunID.visibility",
+			query:          &parsedQuery{workflowIDs: []string{"unknownWorkflowID", "testWorkflowID"}},
+			earliestTime:   0,
+			latestTime:     math.MaxInt64,
 			expectedResult: false,
 		},
 		{
-			workflowID:     "4418294404690464320",
-			runID:          "",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_unkonwnRunID.visibility",
-			expectedResult: true,
+			query:          &parsedQuery{workflowIDs: []string{"testWorkflowID"}, runIDs: []string{"testRunID"}},
+			earliestTime:   0,
+			latestTime:     math.MaxInt64,
+			expectedResult: false,
 		},
 	}
 
 	for _, testCase := range testCases {
-		s.Equal(newRunIDPrecondition(testCase.runID)(testCase.fileName), testCase.expectedResult)
+		s.Equal(testCase.expectedResult, newQueryPrecondition(testCase.query, testCase.earliestTime, testCase.latestTime)(fileName))
 	}
 
+	hashedFileName := constructVisibilityFilename("domainID", "workflowTypeName", "workflowID", "runID", indexKeyCloseTimeout, closeTime)
+	s.True(newQueryPrecondition(&parsedQuery{
+		workflowIDs:   []string{"workflowID"},
+		workflowTypes: []string{"otherWorkflowTypeName", "workflowTypeName"},
+		runIDs:        []string{"runID"},
+	}, 0, math.MaxInt64)(hashedFileName))
+	s.False(newQueryPrecondition(&parsedQuery{
+		workflowTypes: []string{"otherWorkflowTypeName"},
+	}, 0, math.MaxInt64)(hashedFileName))
+	s.False(newQueryPrecondition(&parsedQuery{}, 0, math.MaxInt64)("test-domain-id/invalid.visibility"))
+	s.False(newQueryPrecondition(&parsedQuery{}, 0, math.MaxInt64)(1))
 }
 
-func (s *utilSuite) TestWorkflowTypeNamePrecondition() {
-	testCases := []struct {
-		workflowID       string
-		runID            string
-		workflowTypeName string
-		fileName         string
-		expectedResult   bool
-	}{
-		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "12851121011173788097",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   true,
-		},
-		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "12851121011173788097",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_12851121011173788098_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   false,
-		},
-		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_unkownWorkflowTypeName_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   true,
-		},
-	}
-
-	for _, testCase := range testCases {
-		s.Equal(newWorkflowTypeNamePrecondition(testCase.workflowTypeName)(testCase.fileName), testCase.expectedResult)
-	}
+func (s *utilSuite) TestExecutionPrecondition() {
+	precondition := newExecutionPrecondition("4418294404690464320", "15619178330501475177")
+	s.True(precondition("test-domain-id/closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility"))
+	s.False(precondition("test-domain-id/closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_1.visibility"))
+	s.False(precondition("test-domain-id/closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_1_15619178330501475177.visibility"))
+	s.False(precondition("test-domain-id/invalid.visibility"))
+	s.False(precondition(1))
+}
 
+func (s *utilSuite) TestExpiredPrecondition() {
+	expiration := time.Date(2020, 2, 27, 10, 0, 0, 0, time.UTC).UnixNano()
+	fileName := "test-domain-id/closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility"
+	s.True(newExpiredPrecondition(expiration, "")(fileName))
+	s.False(newExpiredPrecondition(expiration, fileName)(fileName))
+	s.False(newExpiredPrecondition(time.Date(2020, 2, 27, 9, 0, 0, 0, time.UTC).UnixNano(), "")(fileName))
+	s.False(newExpiredPrecondition(expiration, "")("test-domain-id/closeTimeout_invalid_1_2_3.visibility"))
 }
@@ -22,11 +22,13 @@ package gcloud
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/gcloud/connector"
 	"github.com/uber/cadence/common/config"
@@ -39,6 +41,10 @@ const (
 	errEncodeVisibilityRecord = "failed to encode visibility record"
 	indexKeyStartTimeout      = "startTimeout"
 	indexKeyCloseTimeout      = "closeTimeout"
+	indexKeyWorkflowID        = "workflowID"
+	indexKeyWorkflowType      = "workflowType"
+	indexKeySearchAttribute   = "searchAttribute"
+	indexedMarker             = ".indexed"
 	timeoutInSeconds          = 5
 )
 
@@ -55,6 +61,12 @@ type (
 
 	queryVisibilityToken struct {
 		Offset int
+		// TagIdx is the position in the index tags of the query
+		TagIdx int
+	}
+
+	queryExpiredVisibilityToken struct {
+		LastFilename string
 	}
 
 	visibilityRecord archiver.ArchiveVisibilityRequest
@@ -126,6 +138,18 @@ func (v *visibilityArchiver) Archive(ctx context.Context, URI archiver.URI, requ
 		return err
 	}
 
+	if err := v.backfillIndex(ctx, URI, request.DomainID); err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return errRetriable
+	}
+	for _, indexTag := range constructVisibilityIndexTags(request) {
+		filename := constructVisibilityFilename(request.DomainID, request.WorkflowTypeName, request.WorkflowID, request.RunID, indexTag, request.CloseTimestamp)
+		if err := v.gcloudStorage.Upload(ctx, URI, filename, encodedVisibilityRecord); err != nil {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+			return errRetriable
+		}
+	}
+
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.DomainID, request.WorkflowTypeName, request.WorkflowID, request.RunID, indexKeyCloseTimeout, request.CloseTimestamp)
@@ -163,10 +187,6 @@ func (v *visibilityArchiver) Query(ctx context.Context, URI archiver.URI, reques
 		return nil, &types.BadRequestError{Message: err.Error()}
 	}
 
-	if parsedQuery.emptyResult {
-		return &archiver.QueryVisibilityResponse{}, nil
-	}
-
 	return v.query(ctx, URI, &queryVisibilityRequest{
 		domainID:      request.DomainID,
 		pageSize:      request.PageSize,
@@ -185,35 +205,286 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 		}
 	}
 
-	var prefix = constructVisibilityFilenamePrefix(request.domainID, indexKeyCloseTimeout)
-	if request.parsedQuery.closeTime != 0 {
-		prefix = constructTimeBasedSearchKey(request.domainID, indexKeyCloseTimeout, request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
+	query := request.parsedQuery
+	if query.emptyResult() {
+		return &archiver.QueryVisibilityResponse{}, nil
 	}
-	if request.parsedQuery.startTime != 0 {
-		prefix = constructTimeBasedSearchKey(request.domainID, indexKeyStartTimeout, request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+	plan, err := v.planQuery(ctx, URI, request.domainID, query)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
+	if !query.filter.IsOrderedBy(plan.orderedBy, false) {
+		return v.queryAndSort(ctx, URI, request, plan, token)
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for tagIdx := token.TagIdx; tagIdx < len(plan.prefixes); tagIdx++ {
+		offset := 0
+		if tagIdx == token.TagIdx {
+			offset = token.Offset
+		}
+		for {
+			pageSize := request.pageSize - len(response.Executions)
+			filenames, _, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, URI, plan.prefixes[tagIdx], pageSize, offset, plan.filters)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			offset = currentCursorPos
+			records, err := v.getMatchingRecords(ctx, URI, request.domainID, query, plan, tagIdx, filenames)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			for _, record := range records {
+				response.Executions = append(response.Executions, convertToExecutionInfo(record))
+			}
 
-	filters := make([]connector.Precondition, 0)
-	if request.parsedQuery.workflowID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowID)))
+			if len(filenames) < pageSize {
+				break
+			}
+			if len(response.Executions) == request.pageSize {
+				encodedToken, err := serializeToken(&queryVisibilityToken{Offset: offset, TagIdx: tagIdx})
+				if err != nil {
+					return nil, &types.InternalServiceError{Message: err.Error()}
+				}
+				response.NextPageToken = encodedToken
+				return response, nil
+			}
+		}
 	}
+	return response, nil
+}
 
-	if request.parsedQuery.runID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.runID)))
+// queryAndSort gets all matching records to sort them by the ORDER BY field of the query
+// and returns the page starting at the offset in the token
+func (v *visibilityArchiver) queryAndSort(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	plan *queryPlan,
+	token *queryVisibilityToken,
+) (*archiver.QueryVisibilityResponse, error) {
+	var records []*archiver.ArchiveVisibilityRequest
+	for tagIdx, prefix := range plan.prefixes {
+		filenames, _, _, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, 0, 0, plan.filters)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		matchingRecords, err := v.getMatchingRecords(ctx, URI, request.domainID, request.parsedQuery, plan, tagIdx, filenames)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		for _, record := range matchingRecords {
+			records = append(records, (*archiver.ArchiveVisibilityRequest)(record))
+		}
 	}
+	request.parsedQuery.filter.Sort(records)
 
-	if request.parsedQuery.workflowType != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowType)))
+	if token.Offset >= len(records) {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+	end := common.MinInt(token.Offset+request.pageSize, len(records))
+	response := &archiver.QueryVisibilityResponse{}
+	for _, record := range records[token.Offset:end] {
+		response.Executions = append(response.Executions, convertToExecutionInfo((*visibilityRecord)(record)))
+	}
+	if end < len(records) {
+		encodedToken, err := serializeToken(&queryVisibilityToken{Offset: end})
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.NextPageToken = encodedToken
 	}
+	return response, nil
+}
 
-	filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.pageSize, token.Offset, filters)
+type queryPlan struct {
+	prefixes []string
+	filters  []connector.Precondition
+	// orderedBy is the field the filenames of each prefix are ordered by
+	orderedBy string
+	// searchAttribute is set if the prefixes are the values of a search attribute index
+	searchAttribute string
+	values          []string
+}
+
+// planQuery selects the filename prefixes to list for the query. The workflowID, workflow type and search attribute
+// indexes are used once all records of the domain are indexed, the close or start time index otherwise.
+func (v *visibilityArchiver) planQuery(ctx context.Context, URI archiver.URI, domainID string, query *parsedQuery) (*queryPlan, error) {
+	indexed, err := v.gcloudStorage.Exist(ctx, URI, constructIndexedMarkerFilename(domainID))
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &queryPlan{}
+	var tags []string
+	if indexed {
+		if query.workflowIDs != nil {
+			for _, workflowID := range query.workflowIDs {
+				tags = append(tags, constructWorkflowIDIndexTag(workflowID))
+			}
+		} else if query.workflowTypes != nil {
+			for _, workflowType := range query.workflowTypes {
+				tags = append(tags, constructWorkflowTypeIndexTag(workflowType))
+			}
+		} else {
+			for _, key := range query.filter.SearchAttributes() {
+				if values, ok := query.filter.StringValues(key); ok {
+					for _, value := range values {
+						tags = append(tags, constructSearchAttributeIndexTag(key, value))
+					}
+					plan.searchAttribute, plan.values = key, values
+					break
+				}
+			}
+		}
+	}
+
+	// the value indexes are ordered by close time
+	earliestTime, latestTime := query.filter.TimeRange(CloseTime)
+	plan.orderedBy = CloseTime
+	if len(tags) == 0 {
+		tags = []string{query.timeIndex}
+		earliestTime, latestTime = query.earliestTime, query.latestTime
+		plan.orderedBy = query.timeIndexField()
+	}
+	for _, tag := range tags {
+		plan.prefixes = append(plan.prefixes, constructVisibilityFilenamePrefix(domainID, tag)+"_"+constructTimeRangePrefix(earliestTime, latestTime))
+	}
+	plan.filters = []connector.Precondition{newQueryPrecondition(query, earliestTime, latestTime)}
+	return plan, nil
+}
+
+// getMatchingRecords downloads the records of the filenames listed for a prefix and returns those matching the query.
+// A record with multiple values of a search attribute is only returned for the first value in the query.
+func (v *visibilityArchiver) getMatchingRecords(
+	ctx context.Context,
+	URI archiver.URI,
+	domainID string,
+	query *parsedQuery,
+	plan *queryPlan,
+	tagIdx int,
+	filenames []string,
+) ([]*visibilityRecord, error) {
+	var records []*visibilityRecord
+	for _, file := range filenames {
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", domainID, filepath.Base(file)))
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, err
+		}
+		if !query.filter.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+			continue
+		}
+		if plan.searchAttribute != "" && hasAnyIndexValue(record, plan.searchAttribute, plan.values[:tagIdx]) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func hasAnyIndexValue(record *visibilityRecord, searchAttribute string, values []string) bool {
+	value, ok := record.SearchAttributes[searchAttribute]
+	if !ok {
+		return false
+	}
+	for _, indexValue := range archiver.SearchAttributeIndexValues(value) {
+		for _, v := range values {
+			if indexValue == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// backfillIndex adds the index filenames of the records archived before the indexes were introduced,
+// it's done once for each domain
+func (v *visibilityArchiver) backfillIndex(ctx context.Context, URI archiver.URI, domainID string) error {
+	markerFilename := constructIndexedMarkerFilename(domainID)
+	indexed, err := v.gcloudStorage.Exist(ctx, URI, markerFilename)
+	if err != nil || indexed {
+		return err
+	}
+
+	filenames, err := v.gcloudStorage.Query(ctx, URI, constructVisibilityFilenamePrefix(domainID, indexKeyCloseTimeout)+"_")
+	if err != nil {
+		return err
+	}
+	for _, file := range filenames {
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", domainID, filepath.Base(file)))
+		if err != nil {
+			return err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return err
+		}
+		for _, indexTag := range constructVisibilityIndexTags((*archiver.ArchiveVisibilityRequest)(record)) {
+			filename := constructVisibilityFilename(record.DomainID, record.WorkflowTypeName, record.WorkflowID, record.RunID, indexTag, record.CloseTimestamp)
+			if err := v.gcloudStorage.Upload(ctx, URI, filename, encodedRecord); err != nil {
+				return err
+			}
+		}
+	}
+	return v.gcloudStorage.Upload(ctx, URI, markerFilename, []byte{})
+}
+
+// Delete deletes the archived visibility records of the workflow run
+func (v *visibilityArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteVisibilityRequest) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	filters := []connector.Precondition{newExecutionPrecondition(hash(request.WorkflowID), hash(request.RunID))}
+	filenames, _, _, err := v.gcloudStorage.QueryWithFilters(ctx, URI, request.DomainID+"/", 0, 0, filters)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	for _, file := range filenames {
+		if err := v.gcloudStorage.Delete(ctx, URI, fmt.Sprintf("%s/%s", request.DomainID, filepath.Base(file))); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+// QueryExpired returns the visibility records closed before the expiration timestamp, the earliest closed first
+func (v *visibilityArchiver) QueryExpired(ctx context.Context, URI archiver.URI, request *archiver.QueryExpiredVisibilityRequest) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	token := new(queryExpiredVisibilityToken)
+	if request.NextPageToken != nil {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	}
+
+	// filenames of the close timeout index are ordered by close time
+	prefix := constructVisibilityFilenamePrefix(request.DomainID, indexKeyCloseTimeout) + "_"
+	filters := []connector.Precondition{newExpiredPrecondition(request.ExpirationTimestamp, token.LastFilename)}
+	filenames, _, _, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.PageSize, 0, filters)
 	if err != nil {
 		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
 
 	response := &archiver.QueryVisibilityResponse{}
 	for _, file := range filenames {
-		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", request.domainID, filepath.Base(file)))
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", request.DomainID, filepath.Base(file)))
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
@@ -222,21 +493,20 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
-
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		if record.CloseTimestamp < request.ExpirationTimestamp {
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		}
 	}
 
-	if !completed {
-		newToken := &queryVisibilityToken{
-			Offset: currentCursorPos,
-		}
-		encodedToken, err := serializeToken(newToken)
+	if len(filenames) == request.PageSize {
+		encodedToken, err := serializeToken(&queryExpiredVisibilityToken{
+			LastFilename: filenames[len(filenames)-1],
+		})
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
 		response.NextPageToken = encodedToken
 	}
-
 	return response, nil
 }
 
@@ -34,7 +34,6 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/gcloud/connector/mocks"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -170,6 +169,7 @@ func (s *visibilityArchiverSuite) TestVisibilityArchive() {
 	s.NoError(err)
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	storageWrapper.On("Query", mock.Anything, URI, "test-domain-id/closeTimeout_").Return([]string{}, nil)
 	storageWrapper.On("Upload", mock.Anything, URI, mock.Anything, mock.Anything).Return(nil)
 	mockCtrl := gomock.NewController(s.T())
 	defer mockCtrl.Finish()
@@ -224,18 +224,10 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime: int64(101),
-		startTime: int64(1),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID:      testDomainID,
-		Query:         "parsed by mockParser",
+		Query:         "CloseTime = 101",
 		PageSize:      1,
 		NextPageToken: []byte{1, 2, 3},
 	}
@@ -250,28 +242,16 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NoError(err)
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, 10, 0, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 1, nil).Times(1)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T", 10, 0, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 1, nil).Times(1)
 	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
 
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	dayPrecision := string("Day")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       int64(101),
-		searchPrecision: &dayPrecision,
-		workflowType:    common.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      common.StringPtr(testWorkflowID),
-		runID:           common.StringPtr(testRunID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 10,
-		Query:    "parsed by mockParser",
+		Query:    "WorkflowID = 'test-workflow-id' AND RunID = 'test-run-id' AND CloseTime = '2020-02-05T00:00:00Z' AND SearchPrecision = 'Day'",
 	}
 
 	response, err := visibilityArchiver.Query(ctx, URI, request)
@@ -290,31 +270,19 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NoError(err)
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, pageSize, 0, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility", "closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, false, 1, nil).Times(2)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, pageSize, 1, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:16Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 2, nil).Times(2)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T", pageSize, 0, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility", "closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, false, 1, nil).Times(1)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T", pageSize, 1, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:16Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 2, nil).Times(1)
 	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
 	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
 	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:16Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
 
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	dayPrecision := string("Day")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       int64(101),
-		searchPrecision: &dayPrecision,
-		workflowType:    common.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      common.StringPtr(testWorkflowID),
-		runID:           common.StringPtr(testRunID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: pageSize,
-		Query:    "parsed by mockParser",
+		Query:    "WorkflowID = 'test-workflow-id' AND RunID = 'test-run-id' AND CloseTime = '2020-02-05T00:00:00Z' AND SearchPrecision = 'Day'",
 	}
 
 	response, err := visibilityArchiver.Query(ctx, URI, request)
@@ -333,3 +301,139 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.Len(response.Executions, 1)
 	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
 }
+
+func (s *visibilityArchiverSuite) TestQuery_Success_SearchAttributeIndex() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	records := []*visibilityRecord{
+		{
+			DomainID:         testDomainID,
+			WorkflowID:       testWorkflowID,
+			RunID:            "run-1",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   10,
+			SearchAttributes: map[string]string{"CustomKeywordField": `["keyword1","keyword2"]`},
+		},
+		{
+			DomainID:         testDomainID,
+			WorkflowID:       testWorkflowID,
+			RunID:            "run-2",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   2,
+			CloseTimestamp:   20,
+			SearchAttributes: map[string]string{"CustomKeywordField": `"keyword2"`},
+		},
+		{
+			DomainID:         testDomainID,
+			WorkflowID:       testWorkflowID,
+			RunID:            "run-3",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   3,
+			CloseTimestamp:   30,
+			SearchAttributes: map[string]string{"CustomKeywordField": `"keyword3"`},
+		},
+	}
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, "test-domain-id/.indexed").Return(true, nil)
+	for _, tc := range []struct {
+		value   string
+		records []*visibilityRecord
+	}{
+		{value: "keyword1", records: records[:1]},
+		// the last record is listed by the precondition-free mock but filtered by the query
+		{value: "keyword2", records: records},
+	} {
+		indexTag := constructSearchAttributeIndexTag("CustomKeywordField", tc.value)
+		var filenames []string
+		for _, record := range tc.records {
+			filename := constructVisibilityFilename(testDomainID, record.WorkflowTypeName, record.WorkflowID, record.RunID, indexTag, record.CloseTimestamp)
+			encodedRecord, err := encode(record)
+			s.NoError(err)
+			storageWrapper.On("Get", mock.Anything, URI, filename).Return(encodedRecord, nil)
+			filenames = append(filenames, filename)
+		}
+		prefix := constructVisibilityFilenamePrefix(testDomainID, indexTag) + "_"
+		storageWrapper.On("QueryWithFilters", mock.Anything, URI, prefix, 0, 0, mock.Anything).Return(filenames, true, len(filenames), nil)
+	}
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+		Query:    "CustomKeywordField IN ('keyword1', 'keyword2') ORDER BY StartTime DESC",
+	}
+	response, err := visibilityArchiver.Query(ctx, URI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(records[1]), response.Executions[0])
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.Query(ctx, URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(records[0]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestDelete() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/", 0, 0, mock.Anything).Return([]string{
+		"cadence_archival/visibility/test-domain-id/closeTimeout_2020-02-05T09:56:15Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
+		"cadence_archival/visibility/test-domain-id/startTimeout_2020-02-05T09:56:14Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
+	}, true, 2, nil).Times(1)
+	storageWrapper.On("Delete", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:15Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility").Return(nil).Times(1)
+	storageWrapper.On("Delete", mock.Anything, URI, "test-domain-id/startTimeout_2020-02-05T09:56:14Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility").Return(nil).Times(1)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	request := &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(visibilityArchiver.Delete(ctx, URI, request))
+	storageWrapper.AssertExpectations(s.T())
+
+	err = visibilityArchiver.Delete(ctx, URI, &archiver.DeleteVisibilityRequest{DomainID: testDomainID})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQueryExpired() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	filename := "cadence_archival/visibility/test-domain-id/closeTimeout_2020-02-05T09:56:15Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility"
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_", 1, 0, mock.Anything).Return([]string{filename}, false, 1, nil).Times(1)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_", 1, 0, mock.Anything).Return([]string{}, true, 0, nil).Times(1)
+	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:15Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility").Return([]byte(exampleVisibilityRecord), nil)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	request := &archiver.QueryExpiredVisibilityRequest{
+		DomainID:            testDomainID,
+		ExpirationTimestamp: time.Unix(0, 1580896575946478000).Add(time.Hour).UnixNano(),
+		PageSize:            1,
+	}
+	response, err := visibilityArchiver.QueryExpired(ctx, URI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.QueryExpired(ctx, URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Empty(response.Executions)
+
+	request.NextPageToken = []byte{1, 2, 3}
+	_, err = visibilityArchiver.QueryExpired(ctx, URI, request)
+	s.IsType(&types.BadRequestError{}, err)
+}
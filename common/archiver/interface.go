@@ -67,6 +67,8 @@ type (
 		MetricsClient    metrics.Client
 		ClusterMetadata  cluster.Metadata
 		DomainCache      cache.DomainCache
+		// KeyProvider is used to read encrypted histories, it can be nil if encryption is not used
+		KeyProvider KeyProvider
 	}
 
 	// HistoryArchiver is used to archive history and read archived history
@@ -76,6 +78,19 @@ type (
 		ValidateURI(URI) error
 	}
 
+	// DeleteHistoryRequest is the request to delete archived history of a workflow run
+	DeleteHistoryRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	// HistoryDeleter is implemented by history archivers which support deleting archived histories
+	HistoryDeleter interface {
+		// Delete deletes all archived histories of the workflow run, it's not an error if there is none
+		Delete(context.Context, URI, *DeleteHistoryRequest) error
+	}
+
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
 	VisibilityBootstrapContainer struct {
 		Logger          log.Logger
@@ -121,4 +136,29 @@ type (
 		Query(context.Context, URI, *QueryVisibilityRequest) (*QueryVisibilityResponse, error)
 		ValidateURI(URI) error
 	}
+
+	// DeleteVisibilityRequest is the request to delete archived visibility records of a workflow run
+	DeleteVisibilityRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	// QueryExpiredVisibilityRequest is the request to query archived visibility records
+	// of workflows closed before the expiration timestamp
+	QueryExpiredVisibilityRequest struct {
+		DomainID            string
+		ExpirationTimestamp int64
+		PageSize            int
+		NextPageToken       []byte
+	}
+
+	// VisibilityDeleter is implemented by visibility archivers which support deleting archived visibility records
+	VisibilityDeleter interface {
+		// Delete deletes all archived visibility records of the workflow run, it's not an error if there is none
+		Delete(context.Context, URI, *DeleteVisibilityRequest) error
+		// QueryExpired returns the visibility records of workflows closed before the expiration timestamp.
+		// The next page token stays valid after the records of previous pages are deleted.
+		QueryExpired(context.Context, URI, *QueryExpiredVisibilityRequest) (*QueryVisibilityResponse, error)
+	}
 )
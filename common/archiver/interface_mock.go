@@ -153,3 +153,64 @@ func (_m *VisibilityArchiverMock) ValidateURI(uri URI) error {
 
 	return r0
 }
+
+// HistoryDeleterMock is an autogenerated mock type for the HistoryDeleter type
+type HistoryDeleterMock struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *HistoryDeleterMock) Delete(_a0 context.Context, _a1 URI, _a2 *DeleteHistoryRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *DeleteHistoryRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VisibilityDeleterMock is an autogenerated mock type for the VisibilityDeleter type
+type VisibilityDeleterMock struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *VisibilityDeleterMock) Delete(_a0 context.Context, _a1 URI, _a2 *DeleteVisibilityRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *DeleteVisibilityRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryExpired provides a mock function with given fields: _a0, _a1, _a2
+func (_m *VisibilityDeleterMock) QueryExpired(_a0 context.Context, _a1 URI, _a2 *QueryExpiredVisibilityRequest) (*QueryVisibilityResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *QueryVisibilityResponse
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *QueryExpiredVisibilityRequest) *QueryVisibilityResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*QueryVisibilityResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, URI, *QueryExpiredVisibilityRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type (
+	// KeyProvider provides the keys for envelope encryption of archives. Every archive is
+	// encrypted by a new data key, which is stored with the archive after being encrypted
+	// by the key of the domain. Implementations can be backed by a KMS.
+	KeyProvider interface {
+		// GenerateDataKey returns a new data key for the domain and the data key encrypted by the key of the domain
+		GenerateDataKey(ctx context.Context, domainID string) (dataKey []byte, encryptedDataKey []byte, err error)
+		// DecryptDataKey decrypts the data key encrypted by GenerateDataKey
+		DecryptDataKey(ctx context.Context, domainID string, encryptedDataKey []byte) ([]byte, error)
+	}
+
+	staticKeyProvider struct {
+		domainKeys map[string][]byte
+		defaultKey []byte
+	}
+)
+
+const (
+	dataKeySize = 32
+)
+
+var (
+	// ErrDomainKeyNotFound is the error for encrypting an archive of a domain without key
+	ErrDomainKeyNotFound = errors.New("no encryption key is configured for the domain")
+)
+
+// NewStaticKeyProvider creates a KeyProvider with static AES keys keyed by domain ID,
+// defaultKey is used for domains without a key and can be nil. Keys must be 16, 24 or 32 bytes.
+// A domain key must not be removed or replaced while archives encrypted by it are retained.
+func NewStaticKeyProvider(
+	domainKeys map[string][]byte,
+	defaultKey []byte,
+) (KeyProvider, error) {
+	for domainID, key := range domainKeys {
+		if err := validateKey(key); err != nil {
+			return nil, fmt.Errorf("invalid encryption key for domain %v: %v", domainID, err)
+		}
+	}
+	if defaultKey != nil {
+		if err := validateKey(defaultKey); err != nil {
+			return nil, fmt.Errorf("invalid default encryption key: %v", err)
+		}
+	}
+	return &staticKeyProvider{
+		domainKeys: domainKeys,
+		defaultKey: defaultKey,
+	}, nil
+}
+
+func (p *staticKeyProvider) GenerateDataKey(
+	ctx context.Context,
+	domainID string,
+) ([]byte, []byte, error) {
+	gcm, err := p.domainGCM(domainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, nil, err
+	}
+	return dataKey, gcm.Seal(nonce, nonce, dataKey, []byte(domainID)), nil
+}
+
+func (p *staticKeyProvider) DecryptDataKey(
+	ctx context.Context,
+	domainID string,
+	encryptedDataKey []byte,
+) ([]byte, error) {
+	gcm, err := p.domainGCM(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encryptedDataKey) < gcm.NonceSize() {
+		return nil, ErrArchiveCorrupted
+	}
+	nonce := encryptedDataKey[:gcm.NonceSize()]
+	return gcm.Open(nil, nonce, encryptedDataKey[gcm.NonceSize():], []byte(domainID))
+}
+
+func (p *staticKeyProvider) domainGCM(domainID string) (cipher.AEAD, error) {
+	key, ok := p.domainKeys[domainID]
+	if !ok {
+		key = p.defaultKey
+	}
+	if key == nil {
+		return nil, ErrDomainKeyNotFound
+	}
+	return newGCM(key)
+}
+
+func validateKey(key []byte) error {
+	switch len(key) {
+	case 16, 24, 32:
+		return nil
+	default:
+		return fmt.Errorf("key size must be 16, 24 or 32 bytes, got %v", len(key))
+	}
+}
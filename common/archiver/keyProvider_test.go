@@ -0,0 +1,69 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticKeyProvider(t *testing.T) {
+	domainKey := bytes.Repeat([]byte{1}, 16)
+	defaultKey := bytes.Repeat([]byte{2}, 32)
+	keyProvider, err := NewStaticKeyProvider(map[string][]byte{"domain-1": domainKey}, defaultKey)
+	require.NoError(t, err)
+
+	for _, domainID := range []string{"domain-1", "domain-2"} {
+		dataKey, encryptedDataKey, err := keyProvider.GenerateDataKey(context.Background(), domainID)
+		require.NoError(t, err)
+		require.Len(t, dataKey, dataKeySize)
+		require.False(t, bytes.Contains(encryptedDataKey, dataKey))
+
+		decryptedDataKey, err := keyProvider.DecryptDataKey(context.Background(), domainID, encryptedDataKey)
+		require.NoError(t, err)
+		require.Equal(t, dataKey, decryptedDataKey)
+	}
+
+	_, encryptedDataKey, err := keyProvider.GenerateDataKey(context.Background(), "domain-1")
+	require.NoError(t, err)
+	_, err = keyProvider.DecryptDataKey(context.Background(), "domain-2", encryptedDataKey)
+	require.Error(t, err)
+	_, err = keyProvider.DecryptDataKey(context.Background(), "domain-1", encryptedDataKey[:4])
+	require.Equal(t, ErrArchiveCorrupted, err)
+}
+
+func TestStaticKeyProvider_NoDefaultKey(t *testing.T) {
+	keyProvider, err := NewStaticKeyProvider(map[string][]byte{"domain-1": bytes.Repeat([]byte{1}, 24)}, nil)
+	require.NoError(t, err)
+
+	_, _, err = keyProvider.GenerateDataKey(context.Background(), "domain-2")
+	require.Equal(t, ErrDomainKeyNotFound, err)
+}
+
+func TestStaticKeyProvider_InvalidKey(t *testing.T) {
+	_, err := NewStaticKeyProvider(map[string][]byte{"domain-1": []byte("short")}, nil)
+	require.Error(t, err)
+	_, err = NewStaticKeyProvider(nil, []byte("short"))
+	require.Error(t, err)
+}
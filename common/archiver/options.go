@@ -37,6 +37,8 @@ type (
 	ArchiveFeatureCatalog struct {
 		ProgressManager   ProgressManager
 		NonRetriableError NonRetriableError
+		Compression       CompressionType
+		KeyProvider       KeyProvider
 	}
 
 	// NonRetriableError returns an error indicating archiver has encountered an non-retriable error
@@ -95,3 +97,20 @@ func GetNonRetriableErrorOption(nonRetryableErr error) ArchiveOption {
 		}
 	}
 }
+
+// GetCompressionArchiveOption returns an ArchiveOption for compressing the archived history.
+// The compression is recorded in the archive, so reading it doesn't require the option.
+func GetCompressionArchiveOption(compression CompressionType) ArchiveOption {
+	return func(catalog *ArchiveFeatureCatalog) {
+		catalog.Compression = compression
+	}
+}
+
+// GetEncryptionArchiveOption returns an ArchiveOption for encrypting the archived history
+// with data keys from the key provider. The same key provider needs to be set in the
+// HistoryBootstrapContainer for reading the archived history.
+func GetEncryptionArchiveOption(keyProvider KeyProvider) ArchiveOption {
+	return func(catalog *ArchiveFeatureCatalog) {
+		catalog.KeyProvider = keyProvider
+	}
+}
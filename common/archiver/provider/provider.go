@@ -21,14 +21,11 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"sync"
 
-	"github.com/uber/cadence/common/archiver/gcloud"
-
 	"github.com/uber/cadence/common/archiver"
-	"github.com/uber/cadence/common/archiver/filestore"
-	"github.com/uber/cadence/common/archiver/s3store"
 	"github.com/uber/cadence/common/config"
 )
 
@@ -58,6 +55,9 @@ type (
 		GetVisibilityArchiver(scheme, serviceName string) (archiver.VisibilityArchiver, error)
 	}
 
+	// ProviderOption is used to configure the archivers created by ArchiverProvider
+	ProviderOption func(*archiverProvider)
+
 	archiverProvider struct {
 		sync.RWMutex
 
@@ -71,15 +71,46 @@ type (
 		// Key for the archiver is scheme + serviceName
 		historyArchivers    map[string]archiver.HistoryArchiver
 		visibilityArchivers map[string]archiver.VisibilityArchiver
+
+		historyArchiveOptions []archiver.ArchiveOption
+		historyKeyProvider    archiver.KeyProvider
+	}
+
+	historyArchiverWithOptions struct {
+		archiver.HistoryArchiver
+
+		archiveOptions []archiver.ArchiveOption
+	}
+
+	// historyDeleterWithOptions keeps the HistoryDeleter of the wrapped archiver visible
+	historyDeleterWithOptions struct {
+		*historyArchiverWithOptions
+		archiver.HistoryDeleter
 	}
 )
 
+// WithHistoryCompression compresses histories archived by all history archivers
+func WithHistoryCompression(compression archiver.CompressionType) ProviderOption {
+	return func(p *archiverProvider) {
+		p.historyArchiveOptions = append(p.historyArchiveOptions, archiver.GetCompressionArchiveOption(compression))
+	}
+}
+
+// WithHistoryEncryption encrypts histories archived by all history archivers with keys from the key provider
+func WithHistoryEncryption(keyProvider archiver.KeyProvider) ProviderOption {
+	return func(p *archiverProvider) {
+		p.historyArchiveOptions = append(p.historyArchiveOptions, archiver.GetEncryptionArchiveOption(keyProvider))
+		p.historyKeyProvider = keyProvider
+	}
+}
+
 // NewArchiverProvider returns a new Archiver provider
 func NewArchiverProvider(
 	historyArchiverConfigs *config.HistoryArchiverProvider,
 	visibilityArchiverConfigs *config.VisibilityArchiverProvider,
+	opts ...ProviderOption,
 ) ArchiverProvider {
-	return &archiverProvider{
+	p := &archiverProvider{
 		historyArchiverConfigs:    historyArchiverConfigs,
 		visibilityArchiverConfigs: visibilityArchiverConfigs,
 		historyContainers:         make(map[string]*archiver.HistoryBootstrapContainer),
@@ -87,6 +118,10 @@ func NewArchiverProvider(
 		historyArchivers:          make(map[string]archiver.HistoryArchiver),
 		visibilityArchivers:       make(map[string]archiver.VisibilityArchiver),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // RegisterBootstrapContainer stores the given bootstrap container given the serviceName
@@ -132,32 +167,36 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 		return nil, ErrBootstrapContainerNotFound
 	}
 
-	switch scheme {
-	case filestore.URIScheme:
-		if p.historyArchiverConfigs.Filestore == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		historyArchiver, err = filestore.NewHistoryArchiver(container, p.historyArchiverConfigs.Filestore)
-
-	case gcloud.URIScheme:
-		if p.historyArchiverConfigs.Gstorage == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-
-		historyArchiver, err = gcloud.NewHistoryArchiver(container, p.historyArchiverConfigs.Gstorage)
-
-	case s3store.URIScheme:
-		if p.historyArchiverConfigs.S3store == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
-	default:
+	constructor, ok := getHistoryArchiverConstructor(scheme)
+	if !ok {
 		return nil, ErrUnknownScheme
 	}
-
+	if p.historyArchiverConfigs == nil {
+		return nil, ErrArchiverConfigNotFound
+	}
+	if p.historyKeyProvider != nil {
+		containerWithKeyProvider := *container
+		containerWithKeyProvider.KeyProvider = p.historyKeyProvider
+		container = &containerWithKeyProvider
+	}
+	historyArchiver, err = constructor(container, p.historyArchiverConfigs)
 	if err != nil {
 		return nil, err
 	}
+	if len(p.historyArchiveOptions) != 0 {
+		withOptions := &historyArchiverWithOptions{
+			HistoryArchiver: historyArchiver,
+			archiveOptions:  p.historyArchiveOptions,
+		}
+		if deleter, ok := historyArchiver.(archiver.HistoryDeleter); ok {
+			historyArchiver = &historyDeleterWithOptions{
+				historyArchiverWithOptions: withOptions,
+				HistoryDeleter:             deleter,
+			}
+		} else {
+			historyArchiver = withOptions
+		}
+	}
 
 	p.Lock()
 	defer p.Unlock()
@@ -182,29 +221,14 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 		return nil, ErrBootstrapContainerNotFound
 	}
 
-	var visibilityArchiver archiver.VisibilityArchiver
-	var err error
-
-	switch scheme {
-	case filestore.URIScheme:
-		if p.visibilityArchiverConfigs.Filestore == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		visibilityArchiver, err = filestore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore)
-	case s3store.URIScheme:
-		if p.visibilityArchiverConfigs.S3store == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		visibilityArchiver, err = s3store.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.S3store)
-	case gcloud.URIScheme:
-		if p.visibilityArchiverConfigs.Gstorage == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
-
-	default:
+	constructor, ok := getVisibilityArchiverConstructor(scheme)
+	if !ok {
 		return nil, ErrUnknownScheme
 	}
+	if p.visibilityArchiverConfigs == nil {
+		return nil, ErrArchiverConfigNotFound
+	}
+	visibilityArchiver, err := constructor(container, p.visibilityArchiverConfigs)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +243,19 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 
 }
 
+// Archive applies the archive options of the provider before the options of the request
+func (h *historyArchiverWithOptions) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) error {
+	archiveOptions := make([]archiver.ArchiveOption, 0, len(h.archiveOptions)+len(opts))
+	archiveOptions = append(archiveOptions, h.archiveOptions...)
+	archiveOptions = append(archiveOptions, opts...)
+	return h.HistoryArchiver.Archive(ctx, URI, request, archiveOptions...)
+}
+
 func (p *archiverProvider) getArchiverKey(scheme, serviceName string) string {
 	return scheme + ":" + serviceName
 }
@@ -0,0 +1,84 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+)
+
+func TestHistoryArchiverProviderOptions(t *testing.T) {
+	scheme := "test-history-options"
+	historyArchiver := &archiver.HistoryArchiverMock{}
+	var keyProvider archiver.KeyProvider
+	require.NoError(t, RegisterHistoryArchiver(scheme, func(
+		container *archiver.HistoryBootstrapContainer,
+		node *config.YamlNode,
+	) (archiver.HistoryArchiver, error) {
+		keyProvider = container.KeyProvider
+		return historyArchiver, nil
+	}))
+
+	staticKeyProvider, err := archiver.NewStaticKeyProvider(nil, make([]byte, 32))
+	require.NoError(t, err)
+	var configs config.HistoryArchiverProvider
+	require.NoError(t, yaml.Unmarshal([]byte(scheme+": {}\n"), &configs))
+	provider := NewArchiverProvider(
+		&configs,
+		nil,
+		WithHistoryCompression(archiver.CompressionTypeZstd),
+		WithHistoryEncryption(staticKeyProvider),
+	)
+	container := &archiver.HistoryBootstrapContainer{}
+	require.NoError(t, provider.RegisterBootstrapContainer("history", container, nil))
+
+	result, err := provider.GetHistoryArchiver(scheme, "history")
+	require.NoError(t, err)
+	require.Equal(t, staticKeyProvider, keyProvider)
+	require.Nil(t, container.KeyProvider)
+
+	URI, err := archiver.NewURI(scheme + "://bucket/cadence")
+	require.NoError(t, err)
+	request := &archiver.ArchiveHistoryRequest{DomainID: "domain-id"}
+	historyArchiver.On("Archive", mock.Anything, URI, request, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			opts := []archiver.ArchiveOption{
+				args.Get(3).(archiver.ArchiveOption),
+				args.Get(4).(archiver.ArchiveOption),
+				args.Get(5).(archiver.ArchiveOption),
+			}
+			catalog := archiver.GetFeatureCatalog(opts...)
+			require.Equal(t, archiver.CompressionTypeGzip, catalog.Compression)
+			require.Equal(t, staticKeyProvider, catalog.KeyProvider)
+		}).
+		Once()
+	err = result.Archive(context.Background(), URI, request, archiver.GetCompressionArchiveOption(archiver.CompressionTypeGzip))
+	require.NoError(t, err)
+	historyArchiver.AssertExpectations(t)
+}
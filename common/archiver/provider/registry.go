@@ -0,0 +1,206 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/filestore"
+	"github.com/uber/cadence/common/archiver/gcloud"
+	"github.com/uber/cadence/common/archiver/s3store"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	// HistoryArchiverFactory creates a history archiver from the bootstrap container of the service
+	// and the config block of its scheme under config.HistoryArchiverProvider
+	HistoryArchiverFactory func(
+		container *archiver.HistoryBootstrapContainer,
+		config *config.YamlNode,
+	) (archiver.HistoryArchiver, error)
+
+	// VisibilityArchiverFactory creates a visibility archiver from the bootstrap container of the service
+	// and the config block of its scheme under config.VisibilityArchiverProvider
+	VisibilityArchiverFactory func(
+		container *archiver.VisibilityBootstrapContainer,
+		config *config.YamlNode,
+	) (archiver.VisibilityArchiver, error)
+
+	historyArchiverConstructor func(
+		container *archiver.HistoryBootstrapContainer,
+		configs *config.HistoryArchiverProvider,
+	) (archiver.HistoryArchiver, error)
+
+	visibilityArchiverConstructor func(
+		container *archiver.VisibilityBootstrapContainer,
+		configs *config.VisibilityArchiverProvider,
+	) (archiver.VisibilityArchiver, error)
+)
+
+var (
+	// ErrSchemeAlreadyRegistered is the error for registering multiple archivers for the same scheme
+	ErrSchemeAlreadyRegistered = errors.New("archiver has already been registered for the given scheme")
+	// ErrInvalidRegistration is the error for registering an archiver without scheme or factory
+	ErrInvalidRegistration = errors.New("archiver registration must provide scheme and factory")
+
+	registryLock                   sync.RWMutex
+	historyArchiverConstructors    = make(map[string]historyArchiverConstructor)
+	visibilityArchiverConstructors = make(map[string]visibilityArchiverConstructor)
+)
+
+func init() {
+	historyArchiverConstructors[filestore.URIScheme] = func(
+		container *archiver.HistoryBootstrapContainer,
+		configs *config.HistoryArchiverProvider,
+	) (archiver.HistoryArchiver, error) {
+		if configs.Filestore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return filestore.NewHistoryArchiver(container, configs.Filestore)
+	}
+	historyArchiverConstructors[gcloud.URIScheme] = func(
+		container *archiver.HistoryBootstrapContainer,
+		configs *config.HistoryArchiverProvider,
+	) (archiver.HistoryArchiver, error) {
+		if configs.Gstorage == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return gcloud.NewHistoryArchiver(container, configs.Gstorage)
+	}
+	historyArchiverConstructors[s3store.URIScheme] = func(
+		container *archiver.HistoryBootstrapContainer,
+		configs *config.HistoryArchiverProvider,
+	) (archiver.HistoryArchiver, error) {
+		if configs.S3store == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return s3store.NewHistoryArchiver(container, configs.S3store)
+	}
+
+	visibilityArchiverConstructors[filestore.URIScheme] = func(
+		container *archiver.VisibilityBootstrapContainer,
+		configs *config.VisibilityArchiverProvider,
+	) (archiver.VisibilityArchiver, error) {
+		if configs.Filestore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return filestore.NewVisibilityArchiver(container, configs.Filestore)
+	}
+	visibilityArchiverConstructors[gcloud.URIScheme] = func(
+		container *archiver.VisibilityBootstrapContainer,
+		configs *config.VisibilityArchiverProvider,
+	) (archiver.VisibilityArchiver, error) {
+		if configs.Gstorage == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return gcloud.NewVisibilityArchiver(container, configs.Gstorage)
+	}
+	visibilityArchiverConstructors[s3store.URIScheme] = func(
+		container *archiver.VisibilityBootstrapContainer,
+		configs *config.VisibilityArchiverProvider,
+	) (archiver.VisibilityArchiver, error) {
+		if configs.S3store == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return s3store.NewVisibilityArchiver(container, configs.S3store)
+	}
+}
+
+// RegisterHistoryArchiver registers a history archiver implementation for the URI scheme,
+// so archivers maintained out of tree can be linked into a server build.
+// The config of the archiver is the block keyed by the scheme under the history archival provider config,
+// e.g. for scheme "hdfs":
+//
+//	archival:
+//	  history:
+//	    provider:
+//	      hdfs:
+//	        ...
+//
+// It should be called before the services start, usually from an init function.
+func RegisterHistoryArchiver(scheme string, factory HistoryArchiverFactory) error {
+	if scheme == "" || factory == nil {
+		return ErrInvalidRegistration
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, ok := historyArchiverConstructors[scheme]; ok {
+		return ErrSchemeAlreadyRegistered
+	}
+	historyArchiverConstructors[scheme] = func(
+		container *archiver.HistoryBootstrapContainer,
+		configs *config.HistoryArchiverProvider,
+	) (archiver.HistoryArchiver, error) {
+		archiverConfig, ok := configs.Custom[scheme]
+		if !ok {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return factory(container, archiverConfig)
+	}
+	return nil
+}
+
+// RegisterVisibilityArchiver registers a visibility archiver implementation for the URI scheme,
+// the config of the archiver is the block keyed by the scheme under the visibility archival provider config.
+// It should be called before the services start, usually from an init function.
+func RegisterVisibilityArchiver(scheme string, factory VisibilityArchiverFactory) error {
+	if scheme == "" || factory == nil {
+		return ErrInvalidRegistration
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, ok := visibilityArchiverConstructors[scheme]; ok {
+		return ErrSchemeAlreadyRegistered
+	}
+	visibilityArchiverConstructors[scheme] = func(
+		container *archiver.VisibilityBootstrapContainer,
+		configs *config.VisibilityArchiverProvider,
+	) (archiver.VisibilityArchiver, error) {
+		archiverConfig, ok := configs.Custom[scheme]
+		if !ok {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return factory(container, archiverConfig)
+	}
+	return nil
+}
+
+func getHistoryArchiverConstructor(scheme string) (historyArchiverConstructor, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	constructor, ok := historyArchiverConstructors[scheme]
+	return constructor, ok
+}
+
+func getVisibilityArchiverConstructor(scheme string) (visibilityArchiverConstructor, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	constructor, ok := visibilityArchiverConstructors[scheme]
+	return constructor, ok
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/filestore"
+	"github.com/uber/cadence/common/config"
+)
+
+type testArchiverConfig struct {
+	Endpoint string `yaml:"endpoint"`
+}
+
+func TestRegisterHistoryArchiver(t *testing.T) {
+	scheme := "test-history"
+	historyArchiver := &archiver.HistoryArchiverMock{}
+	var archiverConfig testArchiverConfig
+	factory := func(
+		container *archiver.HistoryBootstrapContainer,
+		node *config.YamlNode,
+	) (archiver.HistoryArchiver, error) {
+		if err := node.Decode(&archiverConfig); err != nil {
+			return nil, err
+		}
+		return historyArchiver, nil
+	}
+
+	require.Equal(t, ErrInvalidRegistration, RegisterHistoryArchiver("", factory))
+	require.Equal(t, ErrInvalidRegistration, RegisterHistoryArchiver(scheme, nil))
+	require.Equal(t, ErrSchemeAlreadyRegistered, RegisterHistoryArchiver(filestore.URIScheme, factory))
+	require.NoError(t, RegisterHistoryArchiver(scheme, factory))
+	require.Equal(t, ErrSchemeAlreadyRegistered, RegisterHistoryArchiver(scheme, factory))
+
+	var configs config.HistoryArchiverProvider
+	require.NoError(t, yaml.Unmarshal([]byte(scheme+":\n  endpoint: \"localhost:8020\"\n"), &configs))
+	provider := NewArchiverProvider(&configs, nil)
+	require.NoError(t, provider.RegisterBootstrapContainer("frontend", &archiver.HistoryBootstrapContainer{}, nil))
+
+	result, err := provider.GetHistoryArchiver(scheme, "frontend")
+	require.NoError(t, err)
+	require.Equal(t, historyArchiver, result)
+	require.Equal(t, "localhost:8020", archiverConfig.Endpoint)
+
+	URI, err := archiver.NewURI(scheme + "://namenode/cadence")
+	require.NoError(t, err)
+	historyArchiver.On("ValidateURI", URI).Return(nil).Once()
+	require.NoError(t, result.ValidateURI(URI))
+	historyArchiver.AssertExpectations(t)
+
+	_, err = provider.GetHistoryArchiver("unknown", "frontend")
+	require.Equal(t, ErrUnknownScheme, err)
+	// the archiver is registered but not configured
+	provider = NewArchiverProvider(&config.HistoryArchiverProvider{}, nil)
+	require.NoError(t, provider.RegisterBootstrapContainer("frontend", &archiver.HistoryBootstrapContainer{}, nil))
+	_, err = provider.GetHistoryArchiver(scheme, "frontend")
+	require.Equal(t, ErrArchiverConfigNotFound, err)
+}
+
+func TestRegisterVisibilityArchiver(t *testing.T) {
+	scheme := "test-visibility"
+	visibilityArchiver := &archiver.VisibilityArchiverMock{}
+	factory := func(
+		container *archiver.VisibilityBootstrapContainer,
+		node *config.YamlNode,
+	) (archiver.VisibilityArchiver, error) {
+		return visibilityArchiver, nil
+	}
+
+	require.Equal(t, ErrSchemeAlreadyRegistered, RegisterVisibilityArchiver(filestore.URIScheme, factory))
+	require.NoError(t, RegisterVisibilityArchiver(scheme, factory))
+	require.Equal(t, ErrSchemeAlreadyRegistered, RegisterVisibilityArchiver(scheme, factory))
+
+	var configs config.VisibilityArchiverProvider
+	require.NoError(t, yaml.Unmarshal([]byte(scheme+": {}\n"), &configs))
+	provider := NewArchiverProvider(nil, &configs)
+	require.NoError(t, provider.RegisterBootstrapContainer("frontend", nil, &archiver.VisibilityBootstrapContainer{}))
+
+	result, err := provider.GetVisibilityArchiver(scheme, "frontend")
+	require.NoError(t, err)
+	require.Equal(t, visibilityArchiver, result)
+
+	// archivers are cached per scheme and service
+	cached, err := provider.GetVisibilityArchiver(scheme, "frontend")
+	require.NoError(t, err)
+	require.True(t, result == cached)
+
+	_, err = provider.GetHistoryArchiver(scheme, "frontend")
+	require.Equal(t, ErrBootstrapContainerNotFound, err)
+}
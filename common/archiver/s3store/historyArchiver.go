@@ -51,6 +51,7 @@ const (
 	// URIScheme is the scheme for the s3 implementation
 	URIScheme               = "s3"
 	errEncodeHistory        = "failed to encode history batches"
+	errEncodeArchive        = "failed to compress or encrypt history batches"
 	errWriteKey             = "failed to write history to s3"
 	defaultBlobstoreTimeout = 60 * time.Second
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
@@ -187,6 +188,11 @@ func (h *historyArchiver) Archive(
 			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return err
 		}
+		encodedHistoryBlob, err = archiver.EncodeArchive(ctx, request.DomainID, encodedHistoryBlob, featureCatalog)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeArchive), tag.Error(err))
+			return err
+		}
 
 		key := constructHistoryKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
 
@@ -319,6 +325,11 @@ func (h *historyArchiver) Get(
 			}
 		}
 
+		encodedRecord, err = archiver.DecodeArchive(ctx, request.DomainID, encodedRecord, h.container.KeyProvider)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+
 		historyBlob, err := decodeHistoryBlob(encodedRecord)
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
@@ -346,6 +357,39 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+// Delete deletes the archived histories of all close failover versions of the workflow run
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	keys, err := listKeys(ctx, h.s3cli, URI, constructHistoryKeyPrefix(URI.Path(), request.DomainID, request.WorkflowID, request.RunID)+"/")
+	if err == nil {
+		for _, key := range keys {
+			if err = deleteKey(ctx, h.s3cli, URI, key); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		if isRetryableError(err) {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		return err
+	}
+	return nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
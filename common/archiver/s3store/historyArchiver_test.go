@@ -158,11 +158,9 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 			}
 
 			if input.StartAfter != nil {
-				for k, v := range objects {
-					if *input.StartAfter == *v.Key {
-						start = k + 1
-					}
-				}
+				start = sort.Search(len(objects), func(i int) bool {
+					return *objects[i].Key > *input.StartAfter
+				})
 			}
 
 			isTruncated := false
@@ -199,6 +197,11 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 			}
 		}, nil)
 	s3cli.On("PutObjectWithContext", mock.Anything, mock.Anything).Return(putObjectFn, nil)
+	s3cli.On("DeleteObjectWithContext", mock.Anything, mock.Anything).
+		Return(func(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) *s3.DeleteObjectOutput {
+			delete(fs, *input.Bucket+*input.Key)
+			return &s3.DeleteObjectOutput{}
+		}, nil)
 
 	s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
 		_, ok := fs[*input.Bucket+*input.Key]
@@ -666,6 +669,40 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestDelete() {
+	URI, err := archiver.NewURI(testBucketURI + "/delete")
+	s.NoError(err)
+	var keys []string
+	for _, runID := range []string{testRunID, "other-run-id"} {
+		for _, version := range []int64{1, testCloseFailoverVersion} {
+			for batchIdx := 0; batchIdx < 2; batchIdx++ {
+				key := constructHistoryKey(URI.Path(), testDomainID, testWorkflowID, runID, version, batchIdx)
+				s.NoError(upload(context.Background(), s.s3cli, URI, key, []byte("history")))
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+	for i, key := range keys {
+		exists, err := keyExists(context.Background(), s.s3cli, URI, key)
+		s.NoError(err)
+		s.Equal(i >= 4, exists)
+	}
+
+	// deleting again is a no-op
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{DomainID: testDomainID})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	//config := &config.S3Archiver{}
 	//archiver, err := newHistoryArchiver(s.container, config, historyIterator)
@@ -23,219 +23,106 @@
 package s3store
 
 import (
-	"errors"
-	"fmt"
-	"strconv"
-	"time"
+	"math"
 
-	"github.com/xwb1989/sqlparser"
-
-	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
+	// QueryParser parses a SQL where clause into a struct
 	QueryParser interface {
 		Parse(query string) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
 
+	// parsedQuery holds the query and the index used to answer it. Records are listed from
+	// the secondary index of each primary index value, in ascending order of the secondary index time.
 	parsedQuery struct {
-		workflowTypeName *string
-		workflowID       *string
-		startTime        *int64
-		closeTime        *int64
-		searchPrecision  *string
+		filter             *archiver.VisibilityQuery
+		primaryIndex       string
+		primaryIndexValues []string
+		secondaryIndex     string
+		earliestTime       int64
+		latestTime         int64
 	}
 )
 
-// All allowed fields for filtering
+// All allowed fields for filtering, any other field is treated as a custom search attribute
 const (
 	WorkflowTypeName = "WorkflowTypeName"
-	WorkflowID       = "WorkflowID"
-	StartTime        = "StartTime"
-	CloseTime        = "CloseTime"
-	SearchPrecision  = "SearchPrecision"
+	WorkflowID       = archiver.VisibilityQueryWorkflowID
+	StartTime        = archiver.VisibilityQueryStartTime
+	CloseTime        = archiver.VisibilityQueryCloseTime
+	SearchPrecision  = archiver.VisibilityQuerySearchPrecision
 )
 
 // Precision specific values
 const (
-	PrecisionDay    = "Day"
-	PrecisionHour   = "Hour"
-	PrecisionMinute = "Minute"
-	PrecisionSecond = "Second"
-)
-const (
-	queryTemplate         = "select * from dummy where %s"
-	defaultDateTimeFormat = time.RFC3339
+	PrecisionDay    = archiver.PrecisionDay
+	PrecisionHour   = archiver.PrecisionHour
+	PrecisionMinute = archiver.PrecisionMinute
+	PrecisionSecond = archiver.PrecisionSecond
 )
 
-// NewQueryParser creates a new query parser for filestore
+// NewQueryParser creates a new query parser for Amazon S3
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
 func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	filter, err := archiver.ParseVisibilityQuery(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
-		return nil, err
-	}
-	if parsedQuery.workflowID == nil && parsedQuery.workflowTypeName == nil {
-		return nil, errors.New("WorkflowID or WorkflowTypeName is required in query")
-	}
-	if parsedQuery.workflowID != nil && parsedQuery.workflowTypeName != nil {
-		return nil, errors.New("only one of WorkflowID or WorkflowTypeName can be specified in a query")
-	}
-	if parsedQuery.closeTime != nil && parsedQuery.startTime != nil {
-		return nil, errors.New("only one of StartTime or CloseTime can be specified in a query")
-	}
-	if (parsedQuery.closeTime != nil || parsedQuery.startTime != nil) && parsedQuery.searchPrecision == nil {
-		return nil, errors.New("SearchPrecision is required when searching for a StartTime or CloseTime")
+	parsedQuery := &parsedQuery{
+		filter:         filter,
+		secondaryIndex: secondaryIndexKeyCloseTimeout,
+	}
+
+	// prefer the most selective index, records are indexed by domain if the query has no other indexed field
+	if values, ok := filter.StringValues(WorkflowID); ok {
+		parsedQuery.primaryIndex, parsedQuery.primaryIndexValues = primaryIndexKeyWorkflowID, values
+	} else if values, ok := filter.StringValues(WorkflowTypeName); ok {
+		parsedQuery.primaryIndex, parsedQuery.primaryIndexValues = primaryIndexKeyWorkflowTypeName, values
+	} else {
+		parsedQuery.primaryIndex, parsedQuery.primaryIndexValues = primaryIndexKeyDomain, nil
+		for _, key := range filter.SearchAttributes() {
+			if values, ok := filter.StringValues(key); ok {
+				parsedQuery.primaryIndex, parsedQuery.primaryIndexValues = constructSearchAttributeIndexKey(key), values
+				break
+			}
+		}
 	}
 
-	if parsedQuery.closeTime == nil && parsedQuery.startTime == nil && parsedQuery.searchPrecision != nil {
-		return nil, errors.New("SearchPrecision requires a StartTime or CloseTime")
+	earliestCloseTime, latestCloseTime := filter.TimeRange(CloseTime)
+	earliestStartTime, latestStartTime := filter.TimeRange(StartTime)
+	parsedQuery.earliestTime, parsedQuery.latestTime = earliestCloseTime, latestCloseTime
+	startTimeOnly := !isUnbounded(earliestStartTime, latestStartTime) && isUnbounded(earliestCloseTime, latestCloseTime)
+	if startTimeOnly && hasStartTimeIndex(parsedQuery.primaryIndex) {
+		parsedQuery.secondaryIndex = secondaryIndexKeyStartTimeout
+		parsedQuery.earliestTime, parsedQuery.latestTime = earliestStartTime, latestStartTime
 	}
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
-	}
-
-	switch expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr.(*sqlparser.ComparisonExpr), parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr.(*sqlparser.ParenExpr), parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
-	}
-}
-
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
-	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
+// emptyResult returns true if no record can match the query
+func (q *parsedQuery) emptyResult() bool {
+	return q.earliestTime > q.latestTime || (q.primaryIndexValues != nil && len(q.primaryIndexValues) == 0)
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
-	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
-	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
-	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
-	case WorkflowTypeName:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", WorkflowTypeName)
-		}
-		if parsedQuery.workflowTypeName != nil {
-			return fmt.Errorf("can not query %s multiple times", WorkflowTypeName)
-		}
-		parsedQuery.workflowTypeName = common.StringPtr(val)
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil {
-			return fmt.Errorf("can not query %s multiple times", WorkflowID)
-		}
-		parsedQuery.workflowID = common.StringPtr(val)
-	case CloseTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", CloseTime)
-		}
-		parsedQuery.closeTime = &timestamp
-	case StartTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", StartTime)
-		}
-		parsedQuery.startTime = &timestamp
-	case SearchPrecision:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", SearchPrecision)
-		}
-		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
-			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
-		}
-		switch val {
-		case PrecisionDay:
-		case PrecisionHour:
-		case PrecisionMinute:
-		case PrecisionSecond:
-		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
-		}
-		parsedQuery.searchPrecision = common.StringPtr(val)
-
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+// secondaryIndexField returns the field the secondary index is ordered by
+func (q *parsedQuery) secondaryIndexField() string {
+	if q.secondaryIndex == secondaryIndexKeyStartTimeout {
+		return StartTime
 	}
-
-	return nil
+	return CloseTime
 }
 
-func convertToTimestamp(timeStr string) (int64, error) {
-	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp, nil
-	}
-	timestampStr, err := extractStringValue(timeStr)
-	if err != nil {
-		return 0, err
-	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return 0, err
-	}
-	return parsedTime.UnixNano(), nil
+func isUnbounded(earliest, latest int64) bool {
+	return earliest == 0 && latest == math.MaxInt64
 }
 
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
-	}
-	return "", fmt.Errorf("value %s is not a string value", s)
+func hasStartTimeIndex(primaryIndex string) bool {
+	return primaryIndex == primaryIndexKeyWorkflowID || primaryIndex == primaryIndexKeyWorkflowTypeName
 }
@@ -21,12 +21,11 @@
 package s3store
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-
-	"github.com/uber/cadence/common"
 )
 
 type queryParserSuite struct {
@@ -45,62 +44,60 @@ func (s *queryParserSuite) SetupTest() {
 	s.parser = NewQueryParser()
 }
 
-func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
+func (s *queryParserSuite) TestParsePrimaryIndex() {
 	testCases := []struct {
-		query       string
-		expectErr   bool
-		parsedQuery *parsedQuery
+		query              string
+		expectErr          bool
+		primaryIndex       string
+		primaryIndexValues []string
 	}{
 		{
-			query:     "WorkflowID = \"random workflowID\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
-			},
+			query:              "WorkflowID = \"random workflowID\"",
+			primaryIndex:       primaryIndexKeyWorkflowID,
+			primaryIndexValues: []string{"random workflowID"},
 		},
 		{
-			query:     "WorkflowTypeName = \"random workflowTypeName\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowTypeName: common.StringPtr("random workflowTypeName"),
-			},
+			query:              "WorkflowTypeName = \"random workflowTypeName\"",
+			primaryIndex:       primaryIndexKeyWorkflowTypeName,
+			primaryIndexValues: []string{"random workflowTypeName"},
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
-			expectErr: true,
+			query:              "WorkflowID = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
+			primaryIndex:       primaryIndexKeyWorkflowID,
+			primaryIndexValues: []string{"random workflowID"},
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" and WorkflowID = \"random workflowID\"",
-			expectErr: true,
+			query:              "(WorkflowID = 'random workflowID' or WorkflowID = 'another workflowID') and RunID = 'random runID'",
+			primaryIndex:       primaryIndexKeyWorkflowID,
+			primaryIndexValues: []string{"random workflowID", "another workflowID"},
 		},
 		{
-			query:     "RunID = \"random runID\"",
-			expectErr: true,
+			query:              "WorkflowID in ('random workflowID', 'another workflowID') and WorkflowID = 'random workflowID'",
+			primaryIndex:       primaryIndexKeyWorkflowID,
+			primaryIndexValues: []string{"random workflowID"},
 		},
 		{
-			query:     "WorkflowID = 'random workflowID'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
-			},
+			query:              "WorkflowID = 'random workflowID' or RunID = 'random runID'",
+			primaryIndex:       primaryIndexKeyDomain,
+			primaryIndexValues: nil,
 		},
 		{
-			query:     "(WorkflowID = \"random workflowID\")",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
-			},
+			query:              "CustomKeywordField in ('keyword', 'another keyword') and CustomIntField = 1",
+			primaryIndex:       constructSearchAttributeIndexKey("CustomIntField"),
+			primaryIndexValues: []string{"1"},
 		},
 		{
-			query:     "runID = random workflowID",
-			expectErr: true,
+			query:              "WorkflowID != 'random workflowID' and CloseStatus = 'Failed'",
+			primaryIndex:       primaryIndexKeyDomain,
+			primaryIndexValues: nil,
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
-			expectErr: true,
+			query:              "order by CloseTime desc",
+			primaryIndex:       primaryIndexKeyDomain,
+			primaryIndexValues: nil,
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" or runID = \"random runID\"",
+			query:     "runID = random workflowID",
 			expectErr: true,
 		},
 		{
@@ -108,7 +105,7 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 			expectErr: true,
 		},
 		{
-			query:     "runID > \"random workflowID\"",
+			query:     "WorkflowID like '%workflowID'",
 			expectErr: true,
 		},
 	}
@@ -116,144 +113,81 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 	for _, tc := range testCases {
 		parsedQuery, err := s.parser.Parse(tc.query)
 		if tc.expectErr {
-			s.Error(err)
+			s.Error(err, tc.query)
 			continue
 		}
-		s.NoError(err)
-		s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID)
-		s.Equal(tc.parsedQuery.workflowTypeName, parsedQuery.workflowTypeName)
-
+		s.NoError(err, tc.query)
+		s.Equal(tc.primaryIndex, parsedQuery.primaryIndex, tc.query)
+		s.Equal(tc.primaryIndexValues, parsedQuery.primaryIndexValues, tc.query)
 	}
 }
 
-func (s *queryParserSuite) TestParsePrecision() {
-	commonQueryPart := "WorkflowID = \"random workflowID\" AND "
+func (s *queryParserSuite) TestParseSecondaryIndex() {
 	testCases := []struct {
-		query       string
-		expectErr   bool
-		parsedQuery *parsedQuery
+		query          string
+		expectErr      bool
+		secondaryIndex string
+		earliestTime   int64
+		latestTime     int64
+		emptyResult    bool
 	}{
 		{
-			query:     commonQueryPart + "CloseTime = 1000 and SearchPrecision = 'Day'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionDay),
-			},
-		},
-		{
-			query:     commonQueryPart + "CloseTime = 1000 and SearchPrecision = 'Hour'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionHour),
-			},
+			query:          "WorkflowID = 'random workflowID'",
+			secondaryIndex: secondaryIndexKeyCloseTimeout,
+			earliestTime:   0,
+			latestTime:     math.MaxInt64,
 		},
 		{
-			query:     commonQueryPart + "CloseTime = 1000 and SearchPrecision = 'Minute'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionMinute),
-			},
+			query:          "WorkflowID = 'random workflowID' and CloseTime = '2019-10-04T11:21:32Z' and SearchPrecision = 'Hour'",
+			secondaryIndex: secondaryIndexKeyCloseTimeout,
+			earliestTime:   1570186800000000000,
+			latestTime:     1570190399999999999,
 		},
 		{
-			query:     commonQueryPart + "StartTime = 1000 and SearchPrecision = 'Second'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionSecond),
-			},
+			query:          "WorkflowID = 'random workflowID' and StartTime >= 1000 and StartTime < 2000",
+			secondaryIndex: secondaryIndexKeyStartTimeout,
+			earliestTime:   1000,
+			latestTime:     1999,
 		},
 		{
-			query:     commonQueryPart + "SearchPrecision = 'Second'",
-			expectErr: true,
+			query:          "WorkflowID = 'random workflowID' and StartTime >= 1000 and CloseTime < 2000",
+			secondaryIndex: secondaryIndexKeyCloseTimeout,
+			earliestTime:   0,
+			latestTime:     1999,
 		},
 		{
-			query:     commonQueryPart + "SearchPrecision = 'Invalid string'",
-			expectErr: true,
+			query:          "CustomKeywordField = 'keyword' and StartTime >= 1000",
+			secondaryIndex: secondaryIndexKeyCloseTimeout,
+			earliestTime:   0,
+			latestTime:     math.MaxInt64,
 		},
-	}
-
-	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
-		if tc.expectErr {
-			s.Error(err)
-			continue
-		}
-		s.NoError(err)
-		s.Equal(tc.parsedQuery.searchPrecision, parsedQuery.searchPrecision)
-	}
-}
-
-func (s *queryParserSuite) TestParseCloseTime() {
-	commonQueryPart := "WorkflowID = \"random workflowID\" AND SearchPrecision = 'Day' AND "
-
-	testCases := []struct {
-		query       string
-		expectErr   bool
-		parsedQuery *parsedQuery
-	}{
 		{
-			query:     commonQueryPart + "CloseTime = 1000",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeTime: common.Int64Ptr(1000),
-			},
+			query:          "WorkflowID = 'random workflowID' and (CloseTime between 1000 and 2000 or CloseTime between 3000 and 4000)",
+			secondaryIndex: secondaryIndexKeyCloseTimeout,
+			earliestTime:   1000,
+			latestTime:     4000,
 		},
 		{
-			query:     commonQueryPart + "CloseTime = \"2019-01-01T11:11:11Z\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeTime: common.Int64Ptr(1546341071000000000),
-			},
+			query:          "WorkflowID = 'random workflowID' and CloseTime > 2000 and CloseTime < 1000",
+			secondaryIndex: secondaryIndexKeyCloseTimeout,
+			earliestTime:   2001,
+			latestTime:     999,
+			emptyResult:    true,
 		},
 		{
-			query:     commonQueryPart + "closeTime = 2000",
+			query:     "WorkflowID = 'random workflowID' and SearchPrecision = 'Week'",
 			expectErr: true,
 		},
 		{
-			query:     commonQueryPart + "CloseTime > \"2019-01-01 00:00:00\"",
+			query:     "WorkflowID = 'random workflowID' and CloseTime = '2019-10-04T11:21:32Z' and SearchPrecision = 'Hour' and SearchPrecision = 'Day'",
 			expectErr: true,
 		},
-	}
-
-	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
-		if tc.expectErr {
-			s.Error(err)
-			continue
-		}
-		s.NoError(err)
-		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
-
-	}
-}
-
-func (s *queryParserSuite) TestParseStartTime() {
-	commonQueryPart := "WorkflowID = \"random workflowID\" AND SearchPrecision = 'Day' AND "
-
-	testCases := []struct {
-		query       string
-		expectErr   bool
-		parsedQuery *parsedQuery
-	}{
-		{
-			query:     commonQueryPart + "StartTime = 1000",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				startTime: common.Int64Ptr(1000),
-			},
-		},
-		{
-			query:     commonQueryPart + "StartTime = \"2019-01-01T11:11:11Z\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				startTime: common.Int64Ptr(1546341071000000000),
-			},
-		},
 		{
-			query:     commonQueryPart + "startTime = 2000",
+			query:     "WorkflowID = 'random workflowID' or SearchPrecision = 'Day'",
 			expectErr: true,
 		},
 		{
-			query:     commonQueryPart + "StartTime > \"2019-01-01 00:00:00\"",
+			query:     "CloseTime > '2019-10-04 11:21:32'",
 			expectErr: true,
 		},
 	}
@@ -261,10 +195,13 @@ func (s *queryParserSuite) TestParseStartTime() {
 	for _, tc := range testCases {
 		parsedQuery, err := s.parser.Parse(tc.query)
 		if tc.expectErr {
-			s.Error(err)
+			s.Error(err, tc.query)
 			continue
 		}
-		s.NoError(err)
-		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
+		s.NoError(err, tc.query)
+		s.Equal(tc.secondaryIndex, parsedQuery.secondaryIndex, tc.query)
+		s.Equal(tc.earliestTime, parsedQuery.earliestTime, tc.query)
+		s.Equal(tc.latestTime, parsedQuery.latestTime, tc.query)
+		s.Equal(tc.emptyResult, parsedQuery.emptyResult(), tc.query)
 	}
 }
@@ -77,12 +77,10 @@ func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
 	return token, err
 }
 
-func deserializeQueryVisibilityToken(bytes []byte) *string {
-	var ret = string(bytes)
-	return &ret
-}
-func serializeQueryVisibilityToken(token string) []byte {
-	return []byte(token)
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
 }
 
 // Only validates the scheme and buckets are passed
@@ -147,29 +145,45 @@ func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string
 	return strings.TrimLeft(strings.Join([]string{path, domainID, "history", workflowID, runID}, "/"), "/")
 }
 
-func constructTimeBasedSearchKey(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, precision string) string {
+func constructTimestampIndex(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, runID string) string {
 	t := time.Unix(0, timestamp).In(time.UTC)
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
+	return fmt.Sprintf("%s/%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(time.RFC3339), runID)
+}
+
+func constructVisibilityIndexPrefix(path, domainID, primaryIndexKey string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility", primaryIndexKey}, "/"), "/")
+}
+
+// parseCloseTimeIndexKey returns the close time in the key of a close timeout index, ok is false for other keys
+func parseCloseTimeIndexKey(key string) (closeTime time.Time, ok bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 3 || parts[len(parts)-3] != secondaryIndexKeyCloseTimeout {
+		return time.Time{}, false
 	}
+	return parseTimestampIndexKey(key)
+}
 
-	return fmt.Sprintf("%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(timeFormat))
+// parseTimestampIndexKey returns the time in the key of a secondary index, truncated to seconds
+func parseTimestampIndexKey(key string) (timestamp time.Time, ok bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 2 {
+		return time.Time{}, false
+	}
+	timestamp, err := time.Parse(time.RFC3339, parts[len(parts)-2])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return timestamp, true
 }
 
-func constructTimestampIndex(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, runID string) string {
-	t := time.Unix(0, timestamp).In(time.UTC)
-	return fmt.Sprintf("%s/%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(time.RFC3339), runID)
+// constructIndexedMarkerKey returns the key marking that the records of the domain archived before the domain
+// and search attribute indexes were introduced have been indexed
+func constructIndexedMarkerKey(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility", indexedMarker}, "/"), "/")
+}
+
+func constructSearchAttributeIndexKey(key string) string {
+	return primaryIndexKeySearchAttributePrefix + key
 }
 
 func constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexType string) string {
@@ -202,6 +216,44 @@ func upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key stri
 	return nil
 }
 
+func deleteKey(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) error {
+	_, err := s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == s3.ErrCodeNoSuchBucket {
+				return &types.BadRequestError{Message: errBucketNotExists.Error()}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func listKeys(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, prefix string) ([]string, error) {
+	var keys []string
+	var token *string
+	for {
+		results, err := s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(URI.Hostname()),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range results.Contents {
+			keys = append(keys, *item.Key)
+		}
+		if results.IsTruncated == nil || !*results.IsTruncated {
+			return keys, nil
+		}
+		token = results.NextContinuationToken
+	}
+}
+
 func download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) ([]byte, error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
@@ -22,6 +22,8 @@ package s3store
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/metrics"
 
@@ -30,6 +32,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
@@ -52,6 +55,14 @@ type (
 		parsedQuery   *parsedQuery
 	}
 
+	// queryVisibilityToken is the position in the primary index values and the last listed key,
+	// or the offset in the sorted records if the query is not ordered by the secondary index
+	queryVisibilityToken struct {
+		PrimaryIndexValueIdx int
+		StartAfter           string
+		Offset               int
+	}
+
 	indexToArchive struct {
 		primaryIndex            string
 		primaryIndexValue       string
@@ -66,6 +77,10 @@ const (
 	secondaryIndexKeyCloseTimeout   = "closeTimeout"
 	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
 	primaryIndexKeyWorkflowID       = "workflowID"
+	primaryIndexKeyDomain           = "domain"
+	// the primary index key of a search attribute is the prefix followed by the search attribute key
+	primaryIndexKeySearchAttributePrefix = "searchAttribute-"
+	indexedMarker                        = ".indexed"
 )
 
 // NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on s3
@@ -137,6 +152,10 @@ func (v *visibilityArchiver) Archive(
 		archiveFailReason = errEncodeVisibilityRecord
 		return err
 	}
+	if err := v.backfillIndex(ctx, URI, request.DomainID); err != nil {
+		archiveFailReason = errWriteKey
+		return err
+	}
 	indexes := createIndexesToArchive(request)
 	// Upload archive to all indexes
 	for _, element := range indexes {
@@ -149,13 +168,63 @@ func (v *visibilityArchiver) Archive(
 	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
 	return nil
 }
+
 func createIndexesToArchive(request *archiver.ArchiveVisibilityRequest) []indexToArchive {
-	return []indexToArchive{
+	indexes := []indexToArchive{
 		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
 		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyStartTimeout, request.StartTimestamp},
 		{primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
 		{primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndexKeyStartTimeout, request.StartTimestamp},
+		{primaryIndexKeyDomain, request.DomainID, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
+	}
+	for key, value := range request.SearchAttributes {
+		for _, indexValue := range archiver.SearchAttributeIndexValues(value) {
+			indexes = append(indexes, indexToArchive{constructSearchAttributeIndexKey(key), indexValue, secondaryIndexKeyCloseTimeout, request.CloseTimestamp})
+		}
+	}
+	return indexes
+}
+
+// backfillIndex adds the domain and search attribute indexes of the records archived before these indexes
+// were introduced, it's done once for each domain
+func (v *visibilityArchiver) backfillIndex(ctx context.Context, URI archiver.URI, domainID string) error {
+	markerKey := constructIndexedMarkerKey(URI.Path(), domainID)
+	indexed, err := keyExists(ctx, v.s3cli, URI, markerKey)
+	if err != nil || indexed {
+		return err
+	}
+
+	keys, err := listKeys(ctx, v.s3cli, URI, constructVisibilityIndexPrefix(URI.Path(), domainID, primaryIndexKeyWorkflowID)+"/")
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		// every record has a single close timeout index by workflow ID
+		if _, ok := parseCloseTimeIndexKey(key); !ok {
+			continue
+		}
+		encodedRecord, err := download(ctx, v.s3cli, URI, key)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				continue
+			}
+			return err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return err
+		}
+		for _, element := range createIndexesToArchive((*archiver.ArchiveVisibilityRequest)(record)) {
+			if element.primaryIndex == primaryIndexKeyWorkflowTypeName || element.primaryIndex == primaryIndexKeyWorkflowID {
+				continue
+			}
+			indexKey := constructTimestampIndex(URI.Path(), domainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, record.RunID)
+			if err := upload(ctx, v.s3cli, URI, indexKey, encodedRecord); err != nil {
+				return err
+			}
+		}
 	}
+	return upload(ctx, v.s3cli, URI, markerKey, []byte{})
 }
 
 func (v *visibilityArchiver) Query(
@@ -191,57 +260,321 @@ func (v *visibilityArchiver) query(
 ) (*archiver.QueryVisibilityResponse, error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
-	var token *string
+	token := &queryVisibilityToken{}
 	if request.nextPageToken != nil {
-		token = deserializeQueryVisibilityToken(request.nextPageToken)
+		var err error
+		if token, err = deserializeQueryVisibilityToken(request.nextPageToken); err != nil {
+			return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
 	}
-	primaryIndex := primaryIndexKeyWorkflowTypeName
-	primaryIndexValue := request.parsedQuery.workflowTypeName
-	if request.parsedQuery.workflowID != nil {
-		primaryIndex = primaryIndexKeyWorkflowID
-		primaryIndexValue = request.parsedQuery.workflowID
+	query := request.parsedQuery
+	if query.emptyResult() {
+		return &archiver.QueryVisibilityResponse{}, nil
 	}
-	var prefix = constructVisibilitySearchPrefix(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout) + "/"
-	if request.parsedQuery.closeTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout, *request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
+
+	primaryIndexValues := query.primaryIndexValues
+	if primaryIndexValues == nil {
+		primaryIndexValues = []string{request.domainID}
 	}
-	if request.parsedQuery.startTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+	if !query.filter.IsOrderedBy(query.secondaryIndexField(), false) {
+		return v.queryAndSort(ctx, URI, request, primaryIndexValues, token)
 	}
 
-	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
-		Bucket:            aws.String(URI.Hostname()),
-		Prefix:            aws.String(prefix),
-		MaxKeys:           aws.Int64(int64(request.pageSize)),
-		ContinuationToken: token,
-	})
-	if err != nil {
-		if isRetryableError(err) {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+	response := &archiver.QueryVisibilityResponse{}
+	for idx := token.PrimaryIndexValueIdx; idx < len(primaryIndexValues); idx++ {
+		startAfter := ""
+		if idx == token.PrimaryIndexValueIdx {
+			startAfter = token.StartAfter
+		}
+		err := v.listIndex(ctx, URI, request.domainID, query, primaryIndexValues[idx], startAfter, func(key string, record *visibilityRecord) (bool, error) {
+			if listedBefore(record, query, primaryIndexValues[:idx]) {
+				return true, nil
+			}
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+			if len(response.Executions) < request.pageSize {
+				return true, nil
+			}
+			encodedToken, err := serializeToken(&queryVisibilityToken{PrimaryIndexValueIdx: idx, StartAfter: key})
+			if err != nil {
+				return false, err
+			}
+			response.NextPageToken = encodedToken
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if response.NextPageToken != nil {
+			break
 		}
-		return nil, &types.BadRequestError{Message: err.Error()}
 	}
-	if len(results.Contents) == 0 {
-		return &archiver.QueryVisibilityResponse{}, nil
+	return response, nil
+}
+
+// queryAndSort lists all matching records to sort them by the ORDER BY field of the query
+// and returns the page starting at the offset in the token
+func (v *visibilityArchiver) queryAndSort(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	primaryIndexValues []string,
+	token *queryVisibilityToken,
+) (*archiver.QueryVisibilityResponse, error) {
+	var records []*archiver.ArchiveVisibilityRequest
+	for idx, primaryIndexValue := range primaryIndexValues {
+		err := v.listIndex(ctx, URI, request.domainID, request.parsedQuery, primaryIndexValue, "", func(_ string, record *visibilityRecord) (bool, error) {
+			if !listedBefore(record, request.parsedQuery, primaryIndexValues[:idx]) {
+				records = append(records, (*archiver.ArchiveVisibilityRequest)(record))
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
+	request.parsedQuery.filter.Sort(records)
 
+	if token.Offset >= len(records) {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+	end := common.MinInt(token.Offset+request.pageSize, len(records))
 	response := &archiver.QueryVisibilityResponse{}
-	if *results.IsTruncated {
-		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
+	for _, record := range records[token.Offset:end] {
+		response.Executions = append(response.Executions, convertToExecutionInfo((*visibilityRecord)(record)))
 	}
-	for _, item := range results.Contents {
-		encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+	if end < len(records) {
+		encodedToken, err := serializeToken(&queryVisibilityToken{Offset: end})
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
+		response.NextPageToken = encodedToken
+	}
+	return response, nil
+}
+
+// listIndex lists the keys of the secondary index of a primary index value in the time range of the query,
+// starting after the given key. The matching records are passed to the callback until it returns false.
+func (v *visibilityArchiver) listIndex(
+	ctx context.Context,
+	URI archiver.URI,
+	domainID string,
+	query *parsedQuery,
+	primaryIndexValue string,
+	startAfter string,
+	callback func(key string, record *visibilityRecord) (bool, error),
+) error {
+	prefix := constructVisibilitySearchPrefix(URI.Path(), domainID, query.primaryIndex, primaryIndexValue, query.secondaryIndex) + "/"
+	if startAfter == "" && query.earliestTime > 0 {
+		// keys with the earliest time are longer than this prefix, so they are listed after it
+		startAfter = prefix + time.Unix(0, query.earliestTime).In(time.UTC).Format(time.RFC3339)
+	}
+	latestTime := time.Unix(0, query.latestTime)
+	for {
+		input := &s3.ListObjectsV2Input{
+			Bucket: aws.String(URI.Hostname()),
+			Prefix: aws.String(prefix),
+		}
+		if startAfter != "" {
+			input.StartAfter = aws.String(startAfter)
+		}
+		results, err := v.s3cli.ListObjectsV2WithContext(ctx, input)
+		if err != nil {
+			if isRetryableError(err) {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+			return &types.BadRequestError{Message: err.Error()}
+		}
+
+		for _, item := range results.Contents {
+			startAfter = *item.Key
+			if indexTime, ok := parseTimestampIndexKey(*item.Key); ok && indexTime.After(latestTime) {
+				return nil
+			}
+
+			encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+			if err != nil {
+				if _, ok := err.(*types.EntityNotExistsError); ok {
+					continue
+				}
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+			if !query.filter.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+				continue
+			}
+			next, err := callback(*item.Key, record)
+			if err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+			if !next {
+				return nil
+			}
+		}
 
+		if results.IsTruncated == nil || !*results.IsTruncated {
+			return nil
+		}
+	}
+}
+
+// listedBefore returns true if the record is also indexed by one of the previous primary index values,
+// which happens when the primary index is a multi-valued search attribute
+func listedBefore(record *visibilityRecord, query *parsedQuery, previousValues []string) bool {
+	if !strings.HasPrefix(query.primaryIndex, primaryIndexKeySearchAttributePrefix) {
+		return false
+	}
+	value, ok := record.SearchAttributes[strings.TrimPrefix(query.primaryIndex, primaryIndexKeySearchAttributePrefix)]
+	if !ok {
+		return false
+	}
+	for _, indexValue := range archiver.SearchAttributeIndexValues(value) {
+		for _, previousValue := range previousValues {
+			if indexValue == previousValue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Delete deletes all indexes of the archived visibility record of the workflow run
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	if err := v.delete(ctx, URI, request); err != nil {
+		if isRetryableError(err) {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		return err
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	prefix := constructVisibilitySearchPrefix(URI.Path(), request.DomainID, primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndexKeyCloseTimeout) + "/"
+	keys, err := listKeys(ctx, v.s3cli, URI, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, lookupKey := range keys {
+		if !strings.HasSuffix(lookupKey, "/"+request.RunID) {
+			continue
+		}
+		encodedRecord, err := download(ctx, v.s3cli, URI, lookupKey)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				continue
+			}
+			return err
+		}
 		record, err := decodeVisibilityRecord(encodedRecord)
 		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+			return err
+		}
+
+		// the lookup key is deleted last, so the other indexes can still be found if the deletion fails
+		for _, element := range createIndexesToArchive((*archiver.ArchiveVisibilityRequest)(record)) {
+			key := constructTimestampIndex(URI.Path(), request.DomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.RunID)
+			if key == lookupKey {
+				continue
+			}
+			if err := deleteKey(ctx, v.s3cli, URI, key); err != nil {
+				return err
+			}
+		}
+		if err := deleteKey(ctx, v.s3cli, URI, lookupKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QueryExpired returns the visibility records closed before the expiration timestamp.
+// All indexes of the domain are listed in key order, the next page token is the last listed key.
+func (v *visibilityArchiver) QueryExpired(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryExpiredVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var startAfter *string
+	if request.NextPageToken != nil {
+		startAfter = common.StringPtr(string(request.NextPageToken))
+	}
+	expirationTime := time.Unix(0, request.ExpirationTimestamp)
+	response := &archiver.QueryVisibilityResponse{}
+	for {
+		results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:     aws.String(URI.Hostname()),
+			Prefix:     aws.String(constructVisibilityIndexPrefix(URI.Path(), request.DomainID, primaryIndexKeyWorkflowID) + "/"),
+			StartAfter: startAfter,
+		})
+		if err != nil {
+			if isRetryableError(err) {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+
+		for _, item := range results.Contents {
+			startAfter = item.Key
+			closeTime, ok := parseCloseTimeIndexKey(*item.Key)
+			if !ok || !closeTime.Before(expirationTime) {
+				continue
+			}
+
+			encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			if record.CloseTimestamp >= request.ExpirationTimestamp {
+				continue
+			}
+
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+			if len(response.Executions) == request.PageSize {
+				response.NextPageToken = []byte(*startAfter)
+				return response, nil
+			}
+		}
+
+		if results.IsTruncated == nil || !*results.IsTruncated {
+			return response, nil
 		}
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
 	}
-	return response, nil
 }
 
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,7 +38,6 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/s3store/mocks"
 	"github.com/uber/cadence/common/log"
@@ -258,16 +258,9 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 }
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID:      common.StringPtr(testWorkflowID),
-		closeTime:       common.Int64Ptr(0),
-		searchPrecision: common.StringPtr(PrecisionSecond),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowID = '%s' and CloseTime = 0 and SearchPrecision = '%s'", testWorkflowID, PrecisionSecond),
 		PageSize: 1,
 	}
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
@@ -279,17 +272,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       common.Int64Ptr(int64(1 * time.Hour)),
-		searchPrecision: common.StringPtr(PrecisionHour),
-		workflowID:      common.StringPtr(testWorkflowID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 10,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowID = '%s' and CloseTime = %d and SearchPrecision = '%s'", testWorkflowID, int64(1*time.Hour), PrecisionHour),
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -303,17 +289,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       common.Int64Ptr(0),
-		searchPrecision: common.StringPtr(PrecisionDay),
-		workflowID:      common.StringPtr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 2,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowID = '%s' and CloseTime = 0 and SearchPrecision = '%s'", testWorkflowID, PrecisionDay),
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -438,56 +417,31 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 100,
-		Query:    "parsed by mockParser",
 	}
 
 	for i, testData := range precisionTests {
-		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:       common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision: common.StringPtr(testData.precision),
-			workflowID:      common.StringPtr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
+		request.Query = fmt.Sprintf("WorkflowID = '%s' and CloseTime = %d and SearchPrecision = '%s'", testWorkflowID, (testData.day+30)*int64(time.Hour)*24+testData.hour*int64(time.Hour)+testData.minute*int64(time.Minute)+testData.second*int64(time.Second), testData.precision)
 
 		response, err := visibilityArchiver.Query(context.Background(), URI, request)
 		s.NoError(err)
 		s.NotNil(response)
 		s.Len(response.Executions, 2, "Iteration ", i)
 
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:       common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision: common.StringPtr(testData.precision),
-			workflowID:      common.StringPtr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
+		request.Query = fmt.Sprintf("WorkflowID = '%s' and StartTime = %d and SearchPrecision = '%s'", testWorkflowID, testData.day*int64(time.Hour)*24+testData.hour*int64(time.Hour)+testData.minute*int64(time.Minute)+testData.second*int64(time.Second), testData.precision)
 
 		response, err = visibilityArchiver.Query(context.Background(), URI, request)
 		s.NoError(err)
 		s.NotNil(response)
 		s.Len(response.Executions, 2, "Iteration ", i)
 
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:        common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision:  common.StringPtr(testData.precision),
-			workflowTypeName: common.StringPtr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
+		request.Query = fmt.Sprintf("WorkflowTypeName = '%s' and CloseTime = %d and SearchPrecision = '%s'", testWorkflowTypeName, (testData.day+30)*int64(time.Hour)*24+testData.hour*int64(time.Hour)+testData.minute*int64(time.Minute)+testData.second*int64(time.Second), testData.precision)
 
 		response, err = visibilityArchiver.Query(context.Background(), URI, request)
 		s.NoError(err)
 		s.NotNil(response)
 		s.Len(response.Executions, 2, "Iteration ", i)
 
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:        common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision:  common.StringPtr(testData.precision),
-			workflowTypeName: common.StringPtr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
+		request.Query = fmt.Sprintf("WorkflowTypeName = '%s' and StartTime = %d and SearchPrecision = '%s'", testWorkflowTypeName, testData.day*int64(time.Hour)*24+testData.hour*int64(time.Hour)+testData.minute*int64(time.Minute)+testData.second*int64(time.Second), testData.precision)
 
 		response, err = visibilityArchiver.Query(context.Background(), URI, request)
 		s.NoError(err)
@@ -504,15 +458,10 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 		s.NoError(err)
 	}
 
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID: common.StringPtr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 1,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowID = '%s'", testWorkflowID),
 	}
 	executions := []*types.WorkflowExecutionInfo{}
 	var first = true
@@ -529,15 +478,10 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
 
-	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowTypeName: common.StringPtr(testWorkflowTypeName),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request = &archiver.QueryVisibilityRequest{
 		DomainID: testDomainID,
 		PageSize: 1,
-		Query:    "parsed by mockParser",
+		Query:    fmt.Sprintf("WorkflowTypeName = '%s'", testWorkflowTypeName),
 	}
 	executions = []*types.WorkflowExecutionInfo{}
 	first = true
@@ -555,6 +499,239 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_SearchAttributes() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query-search-attributes")
+	s.NoError(err)
+	records := []*visibilityRecord{
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-1",
+			RunID:            "run-1",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(1 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+			HistoryLength:    30,
+		},
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-2",
+			RunID:            "run-2",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(2 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:    10,
+			SearchAttributes: map[string]string{"CustomKeywordField": `"blue"`},
+		},
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-3",
+			RunID:            "run-3",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(3 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+			HistoryLength:    20,
+			SearchAttributes: map[string]string{"CustomKeywordField": `["red","blue"]`},
+		},
+	}
+	for _, record := range records {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
+
+	testCases := []struct {
+		query    string
+		expected []*visibilityRecord
+	}{
+		{
+			query:    "CustomKeywordField in ('red', 'blue')",
+			expected: []*visibilityRecord{records[2], records[1]},
+		},
+		{
+			query:    "CustomKeywordField = 'blue' and CloseTime < '1970-01-01T03:00:00Z'",
+			expected: []*visibilityRecord{records[1]},
+		},
+		{
+			query:    "WorkflowID = 'workflow-1' or CloseStatus = 'Failed'",
+			expected: []*visibilityRecord{records[0], records[1]},
+		},
+		{
+			query:    "CloseTime >= '1970-01-01T02:00:00Z' order by HistoryLength desc",
+			expected: []*visibilityRecord{records[2], records[1]},
+		},
+	}
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			DomainID: testDomainID,
+			PageSize: 1,
+			Query:    tc.query,
+		}
+		executions := []*types.WorkflowExecutionInfo{}
+		for len(executions) == 0 || request.NextPageToken != nil {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request)
+			s.NoError(err)
+			executions = append(executions, response.Executions...)
+			request.NextPageToken = response.NextPageToken
+		}
+		s.Len(executions, len(tc.expected), tc.query)
+		for i, record := range tc.expected {
+			s.Equal(convertToExecutionInfo(record), executions[i], tc.query)
+		}
+	}
+
+	_, err = visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		DomainID:      testDomainID,
+		PageSize:      1,
+		Query:         "CustomKeywordField = 'blue'",
+		NextPageToken: []byte("invalid token"),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_BackfillIndex() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query-backfill-index")
+	s.NoError(err)
+	records := []*visibilityRecord{
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-1",
+			RunID:            "run-1",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(1 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+			SearchAttributes: map[string]string{"CustomKeywordField": `"blue"`},
+		},
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "workflow-2",
+			RunID:            "run-2",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(2 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+			SearchAttributes: map[string]string{"CustomKeywordField": `"blue"`},
+		},
+	}
+
+	// the first record is archived with the indexes written before the domain and search attribute indexes
+	encodedRecord, err := encode(records[0])
+	s.NoError(err)
+	for _, element := range createIndexesToArchive((*archiver.ArchiveVisibilityRequest)(records[0])) {
+		if element.primaryIndex == primaryIndexKeyWorkflowTypeName || element.primaryIndex == primaryIndexKeyWorkflowID {
+			key := constructTimestampIndex(URI.Path(), testDomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, records[0].RunID)
+			s.NoError(upload(context.Background(), s.s3cli, URI, key, encodedRecord))
+		}
+	}
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(records[1])))
+
+	for _, query := range []string{
+		"CloseStatus = 'Completed'",
+		"CustomKeywordField = 'blue'",
+	} {
+		response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+			DomainID: testDomainID,
+			PageSize: 10,
+			Query:    query,
+		})
+		s.NoError(err)
+		s.Equal([]*types.WorkflowExecutionInfo{
+			convertToExecutionInfo(records[1]),
+			convertToExecutionInfo(records[0]),
+		}, response.Executions, query)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchiveQueryExpiredAndDelete() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/query-expired")
+	s.NoError(err)
+	records := []*visibilityRecord{
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       testWorkflowID,
+			RunID:            "run-1",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(1 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+		},
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       "other-workflow-id",
+			RunID:            "run-2",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(2 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+		},
+		{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       testWorkflowID,
+			RunID:            "run-3",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(5 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+		},
+	}
+	for _, record := range records {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.NoError(err)
+	}
+
+	request := &archiver.QueryExpiredVisibilityRequest{
+		DomainID:            testDomainID,
+		ExpirationTimestamp: int64(3 * time.Hour),
+		PageSize:            1,
+	}
+	executions := []*types.WorkflowExecutionInfo{}
+	for {
+		response, err := visibilityArchiver.QueryExpired(context.Background(), URI, request)
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			executions = append(executions, execution)
+			s.NoError(visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+				DomainID:   testDomainID,
+				WorkflowID: execution.Execution.GetWorkflowID(),
+				RunID:      execution.Execution.GetRunID(),
+			}))
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 2)
+	s.Equal(convertToExecutionInfo(records[1]), executions[0])
+	s.Equal(convertToExecutionInfo(records[0]), executions[1])
+
+	keys, err := listKeys(context.Background(), s.s3cli, URI, URI.Path()[1:]+"/")
+	s.NoError(err)
+	s.Len(keys, 6)
+	for _, key := range keys {
+		s.True(strings.HasSuffix(key, "/run-3") || key == constructIndexedMarkerKey(URI.Path(), testDomainID), key)
+	}
+
+	// deleting a record which doesn't exist is not an error
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      "run-1",
+	}))
+}
+
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
 	s.visibilityRecords = []*visibilityRecord{
 		{
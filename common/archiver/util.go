@@ -37,6 +37,7 @@ var (
 	errEmptyStartTime        = errors.New("StartTimestamp is empty")
 	errEmptyCloseTime        = errors.New("CloseTimestamp is empty")
 	errEmptyQuery            = errors.New("Query string is empty")
+	errEmptyExpirationTime   = errors.New("ExpirationTimestamp is empty")
 )
 
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
@@ -142,6 +143,48 @@ func ValidateQueryRequest(request *QueryVisibilityRequest) error {
 	return nil
 }
 
+// ValidateDeleteHistoryRequest validates the delete archived history request
+func ValidateDeleteHistoryRequest(request *DeleteHistoryRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
+// ValidateDeleteVisibilityRequest validates the delete archived visibility request
+func ValidateDeleteVisibilityRequest(request *DeleteVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
+// ValidateQueryExpiredRequest validates the query expired visibility request
+func ValidateQueryExpiredRequest(request *QueryExpiredVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.PageSize == 0 {
+		return errInvalidPageSize
+	}
+	if request.ExpirationTimestamp == 0 {
+		return errEmptyExpirationTime
+	}
+	return nil
+}
+
 // ConvertSearchAttrToBytes converts search attribute value from string back to byte array
 func ConvertSearchAttrToBytes(searchAttrStr map[string]string) map[string][]byte {
 	searchAttr := make(map[string][]byte)
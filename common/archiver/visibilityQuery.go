@@ -0,0 +1,889 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// VisibilityQuery is a query on archived visibility records. It's parsed from a SQL-like where clause
+	// which supports AND, OR, NOT, comparisons, IN, BETWEEN and prefix LIKE on the workflow fields and
+	// on custom search attributes, optionally followed by an ORDER BY on a single field.
+	VisibilityQuery struct {
+		expr       visibilityQueryExpr // nil matches all records
+		fields     map[string]struct{}
+		orderBy    string
+		descending bool
+	}
+
+	visibilityQueryExpr interface {
+		match(record *ArchiveVisibilityRequest) bool
+		// stringValues returns the values one of which the field must have in all matching records,
+		// ok is false if the expression doesn't restrict the field to a set of values
+		stringValues(field string) (values []string, ok bool)
+		// timeRange returns the inclusive range of the field in all matching records
+		timeRange(field string) (earliest int64, latest int64)
+	}
+
+	andExpr struct {
+		left  visibilityQueryExpr
+		right visibilityQueryExpr
+	}
+
+	orExpr struct {
+		left  visibilityQueryExpr
+		right visibilityQueryExpr
+	}
+
+	notExpr struct {
+		expr visibilityQueryExpr
+	}
+
+	comparisonExpr struct {
+		field    string
+		operator string
+		// values holds a single value for comparison and LIKE operators, the list for IN
+		// and the lower and upper bound for BETWEEN
+		values []interface{}
+	}
+
+	visibilityQueryFieldType int
+)
+
+// Fields of archived visibility records which can be used in a visibility query,
+// all other field names are treated as custom search attributes
+const (
+	VisibilityQueryWorkflowID    = "WorkflowID"
+	VisibilityQueryRunID         = "RunID"
+	VisibilityQueryWorkflowType  = "WorkflowType"
+	VisibilityQueryStartTime     = "StartTime"
+	VisibilityQueryExecutionTime = "ExecutionTime"
+	VisibilityQueryCloseTime     = "CloseTime"
+	VisibilityQueryCloseStatus   = "CloseStatus"
+	VisibilityQueryHistoryLength = "HistoryLength"
+
+	// VisibilityQuerySearchPrecision turns equality on time fields into a match on the whole day, hour, minute or second,
+	// it can only be used as a top level AND condition, e.g. CloseTime = '2020-02-05T00:00:00Z' AND SearchPrecision = 'Day'
+	VisibilityQuerySearchPrecision = "SearchPrecision"
+)
+
+// Values of SearchPrecision
+const (
+	PrecisionDay    = "Day"
+	PrecisionHour   = "Hour"
+	PrecisionMinute = "Minute"
+	PrecisionSecond = "Second"
+)
+
+const (
+	fieldTypeString visibilityQueryFieldType = iota
+	fieldTypeTime
+	fieldTypeInt
+	fieldTypeCloseStatus
+	fieldTypeSearchAttribute
+)
+
+const (
+	visibilityQueryTemplate = "select * from dummy %s"
+	visibilityQueryOrderBy  = "order by"
+	// likeWildcard is the only wildcard supported by LIKE and it must end the pattern
+	likeWildcard = "%"
+)
+
+var (
+	visibilityQueryFieldTypes = map[string]visibilityQueryFieldType{
+		VisibilityQueryWorkflowID:    fieldTypeString,
+		VisibilityQueryRunID:         fieldTypeString,
+		VisibilityQueryWorkflowType:  fieldTypeString,
+		VisibilityQueryStartTime:     fieldTypeTime,
+		VisibilityQueryExecutionTime: fieldTypeTime,
+		VisibilityQueryCloseTime:     fieldTypeTime,
+		VisibilityQueryCloseStatus:   fieldTypeCloseStatus,
+		VisibilityQueryHistoryLength: fieldTypeInt,
+	}
+
+	visibilityQueryFieldAliases = map[string]string{
+		"WorkflowTypeName": VisibilityQueryWorkflowType,
+	}
+
+	searchPrecisions = map[string]time.Duration{
+		PrecisionDay:    24 * time.Hour,
+		PrecisionHour:   time.Hour,
+		PrecisionMinute: time.Minute,
+		PrecisionSecond: time.Second,
+	}
+
+	errSearchPrecisionNotTopLevel = errors.New("SearchPrecision can only be used as a top level AND condition")
+)
+
+// ParseVisibilityQuery parses a query on archived visibility records
+func ParseVisibilityQuery(query string) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if !strings.HasPrefix(strings.ToLower(query), visibilityQueryOrderBy) {
+		query = "where " + query
+	}
+	stmt, err := sqlparser.Parse(fmt.Sprintf(visibilityQueryTemplate, query))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("invalid query")
+	}
+
+	parser := &visibilityQueryParser{fields: make(map[string]struct{})}
+	visibilityQuery := &VisibilityQuery{fields: parser.fields}
+	if sel.Where != nil {
+		whereExpr, err := parser.extractSearchPrecision(sel.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if whereExpr != nil {
+			if visibilityQuery.expr, err = parser.convertExpr(whereExpr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(sel.OrderBy) > 1 {
+		return nil, errors.New("only one ORDER BY field is supported")
+	}
+	if len(sel.OrderBy) == 1 {
+		colName, ok := sel.OrderBy[0].Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("invalid ORDER BY field: %s", sqlparser.String(sel.OrderBy[0].Expr))
+		}
+		visibilityQuery.orderBy = normalizeVisibilityQueryField(sqlparser.String(colName))
+		visibilityQuery.descending = sel.OrderBy[0].Direction == sqlparser.DescScr
+	}
+	return visibilityQuery, nil
+}
+
+// Match returns true if the record matches the query
+func (q *VisibilityQuery) Match(record *ArchiveVisibilityRequest) bool {
+	return q.expr == nil || q.expr.match(record)
+}
+
+// StringValues returns the values one of which the field must have in all records matching the query.
+// It can be used to look up the records in an index of the field, ok is false if the query doesn't
+// restrict the field to a set of values and an empty set means no record can match.
+func (q *VisibilityQuery) StringValues(field string) (values []string, ok bool) {
+	if q.expr == nil {
+		return nil, false
+	}
+	return q.expr.stringValues(normalizeVisibilityQueryField(field))
+}
+
+// TimeRange returns the inclusive range of the time field in all records matching the query,
+// the range is empty if earliest is after latest
+func (q *VisibilityQuery) TimeRange(field string) (earliest int64, latest int64) {
+	if q.expr == nil {
+		return 0, math.MaxInt64
+	}
+	return q.expr.timeRange(normalizeVisibilityQueryField(field))
+}
+
+// SearchAttributes returns the sorted names of the custom search attributes used in the query
+func (q *VisibilityQuery) SearchAttributes() []string {
+	var searchAttributes []string
+	for field := range q.fields {
+		if getVisibilityQueryFieldType(field) == fieldTypeSearchAttribute {
+			searchAttributes = append(searchAttributes, field)
+		}
+	}
+	sort.Strings(searchAttributes)
+	return searchAttributes
+}
+
+// OrderBy returns the field the results should be ordered by, ok is false if the query has no ORDER BY
+func (q *VisibilityQuery) OrderBy() (field string, descending bool, ok bool) {
+	return q.orderBy, q.descending, q.orderBy != ""
+}
+
+// IsOrderedBy returns true if the query has no ORDER BY or is ordered by the given field and direction,
+// so results returned by a store in that order need no sorting
+func (q *VisibilityQuery) IsOrderedBy(field string, descending bool) bool {
+	return q.orderBy == "" || (q.orderBy == normalizeVisibilityQueryField(field) && q.descending == descending)
+}
+
+// Sort sorts the records by the ORDER BY field of the query, records without the field come last
+func (q *VisibilityQuery) Sort(records []*ArchiveVisibilityRequest) {
+	if q.orderBy == "" {
+		return
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		left := getVisibilityQueryFieldValues(records[i], q.orderBy)
+		right := getVisibilityQueryFieldValues(records[j], q.orderBy)
+		if len(left) == 0 || len(right) == 0 {
+			return len(left) != 0
+		}
+		result, ok := compareVisibilityQueryValues(left[0], right[0])
+		if !ok {
+			return false
+		}
+		if q.descending {
+			return result > 0
+		}
+		return result < 0
+	})
+}
+
+// SearchAttributeIndexValues returns the values a search attribute of an archived visibility record
+// should be indexed by, so that the record can be found with the values returned by StringValues
+func SearchAttributeIndexValues(value string) []string {
+	var indexValues []string
+	for _, v := range decodeSearchAttributeValue(value) {
+		indexValues = append(indexValues, formatVisibilityQueryValue(v))
+	}
+	return indexValues
+}
+
+func (e *andExpr) match(record *ArchiveVisibilityRequest) bool {
+	return e.left.match(record) && e.right.match(record)
+}
+
+func (e *andExpr) stringValues(field string) ([]string, bool) {
+	leftValues, leftOK := e.left.stringValues(field)
+	rightValues, rightOK := e.right.stringValues(field)
+	switch {
+	case leftOK && rightOK:
+		var values []string
+		for _, value := range leftValues {
+			if containsString(rightValues, value) {
+				values = append(values, value)
+			}
+		}
+		return values, true
+	case leftOK:
+		return leftValues, true
+	default:
+		return rightValues, rightOK
+	}
+}
+
+func (e *andExpr) timeRange(field string) (int64, int64) {
+	leftEarliest, leftLatest := e.left.timeRange(field)
+	rightEarliest, rightLatest := e.right.timeRange(field)
+	return maxInt64(leftEarliest, rightEarliest), minInt64(leftLatest, rightLatest)
+}
+
+func (e *orExpr) match(record *ArchiveVisibilityRequest) bool {
+	return e.left.match(record) || e.right.match(record)
+}
+
+func (e *orExpr) stringValues(field string) ([]string, bool) {
+	leftValues, leftOK := e.left.stringValues(field)
+	rightValues, rightOK := e.right.stringValues(field)
+	if !leftOK || !rightOK {
+		return nil, false
+	}
+	values := leftValues
+	for _, value := range rightValues {
+		if !containsString(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values, true
+}
+
+func (e *orExpr) timeRange(field string) (int64, int64) {
+	leftEarliest, leftLatest := e.left.timeRange(field)
+	rightEarliest, rightLatest := e.right.timeRange(field)
+	// an empty side doesn't widen the range of the other side
+	if leftEarliest > leftLatest {
+		return rightEarliest, rightLatest
+	}
+	if rightEarliest > rightLatest {
+		return leftEarliest, leftLatest
+	}
+	return minInt64(leftEarliest, rightEarliest), maxInt64(leftLatest, rightLatest)
+}
+
+func (e *notExpr) match(record *ArchiveVisibilityRequest) bool {
+	return !e.expr.match(record)
+}
+
+func (e *notExpr) stringValues(string) ([]string, bool) {
+	return nil, false
+}
+
+func (e *notExpr) timeRange(string) (int64, int64) {
+	return 0, math.MaxInt64
+}
+
+func (e *comparisonExpr) match(record *ArchiveVisibilityRequest) bool {
+	for _, recordValue := range getVisibilityQueryFieldValues(record, e.field) {
+		if e.matchValue(recordValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *comparisonExpr) matchValue(recordValue interface{}) bool {
+	switch e.operator {
+	case sqlparser.InStr:
+		for _, value := range e.values {
+			if result, ok := compareVisibilityQueryValues(recordValue, value); ok && result == 0 {
+				return true
+			}
+		}
+		return false
+	case sqlparser.BetweenStr:
+		lower, lowerOK := compareVisibilityQueryValues(recordValue, e.values[0])
+		upper, upperOK := compareVisibilityQueryValues(recordValue, e.values[1])
+		return lowerOK && upperOK && lower >= 0 && upper <= 0
+	case sqlparser.LikeStr:
+		s, ok := recordValue.(string)
+		return ok && strings.HasPrefix(s, e.values[0].(string))
+	}
+
+	result, ok := compareVisibilityQueryValues(recordValue, e.values[0])
+	if !ok {
+		return false
+	}
+	switch e.operator {
+	case sqlparser.EqualStr:
+		return result == 0
+	case sqlparser.LessThanStr:
+		return result < 0
+	case sqlparser.LessEqualStr:
+		return result <= 0
+	case sqlparser.GreaterThanStr:
+		return result > 0
+	case sqlparser.GreaterEqualStr:
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+func (e *comparisonExpr) stringValues(field string) ([]string, bool) {
+	if e.field != field || (e.operator != sqlparser.EqualStr && e.operator != sqlparser.InStr) {
+		return nil, false
+	}
+	var values []string
+	for _, value := range e.values {
+		formatted := formatVisibilityQueryValue(value)
+		if !containsString(values, formatted) {
+			values = append(values, formatted)
+		}
+	}
+	return values, true
+}
+
+func (e *comparisonExpr) timeRange(field string) (int64, int64) {
+	earliest, latest := int64(0), int64(math.MaxInt64)
+	if e.field != field || getVisibilityQueryFieldType(field) != fieldTypeTime {
+		return earliest, latest
+	}
+	switch e.operator {
+	case sqlparser.EqualStr:
+		return e.values[0].(int64), e.values[0].(int64)
+	case sqlparser.BetweenStr:
+		return e.values[0].(int64), e.values[1].(int64)
+	case sqlparser.LessThanStr:
+		return earliest, e.values[0].(int64) - 1
+	case sqlparser.LessEqualStr:
+		return earliest, e.values[0].(int64)
+	case sqlparser.GreaterThanStr:
+		return e.values[0].(int64) + 1, latest
+	case sqlparser.GreaterEqualStr:
+		return e.values[0].(int64), latest
+	case sqlparser.InStr:
+		earliest, latest = math.MaxInt64, 0
+		for _, value := range e.values {
+			earliest = minInt64(earliest, value.(int64))
+			latest = maxInt64(latest, value.(int64))
+		}
+		return earliest, latest
+	default:
+		return earliest, latest
+	}
+}
+
+type visibilityQueryParser struct {
+	fields    map[string]struct{}
+	precision time.Duration
+}
+
+// extractSearchPrecision removes the SearchPrecision conditions from the top level AND
+// of the where expression and remembers the precision for the time comparisons
+func (p *visibilityQueryParser) extractSearchPrecision(expr sqlparser.Expr) (sqlparser.Expr, error) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, err := p.extractSearchPrecision(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := p.extractSearchPrecision(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		if left == nil {
+			return right, nil
+		}
+		if right == nil {
+			return left, nil
+		}
+		return &sqlparser.AndExpr{Left: left, Right: right}, nil
+	case *sqlparser.ParenExpr:
+		inner, err := p.extractSearchPrecision(e.Expr)
+		if err != nil || inner == nil {
+			return nil, err
+		}
+		return &sqlparser.ParenExpr{Expr: inner}, nil
+	case *sqlparser.ComparisonExpr:
+		colName, ok := e.Left.(*sqlparser.ColName)
+		if !ok || sqlparser.String(colName) != VisibilityQuerySearchPrecision {
+			return expr, nil
+		}
+		value, err := extractStringLiteral(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		precision, ok := searchPrecisions[value]
+		if e.Operator != sqlparser.EqualStr || !ok {
+			return nil, fmt.Errorf("invalid value for %s: %s", VisibilityQuerySearchPrecision, value)
+		}
+		if p.precision != 0 && p.precision != precision {
+			return nil, fmt.Errorf("only one expression is allowed for %s", VisibilityQuerySearchPrecision)
+		}
+		p.precision = precision
+		return nil, nil
+	default:
+		return expr, nil
+	}
+}
+
+func (p *visibilityQueryParser) convertExpr(expr sqlparser.Expr) (visibilityQueryExpr, error) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, err := p.convertExpr(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := p.convertExpr(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &andExpr{left: left, right: right}, nil
+	case *sqlparser.OrExpr:
+		left, err := p.convertExpr(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := p.convertExpr(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &orExpr{left: left, right: right}, nil
+	case *sqlparser.NotExpr:
+		inner, err := p.convertExpr(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: inner}, nil
+	case *sqlparser.ParenExpr:
+		return p.convertExpr(e.Expr)
+	case *sqlparser.ComparisonExpr:
+		return p.convertComparisonExpr(e)
+	case *sqlparser.RangeCond:
+		return p.convertRangeCond(e)
+	default:
+		return nil, fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func (p *visibilityQueryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr) (visibilityQueryExpr, error) {
+	field, err := p.convertField(compExpr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := compExpr.Operator
+	negated := false
+	switch operator {
+	case sqlparser.NotEqualStr:
+		operator, negated = sqlparser.EqualStr, true
+	case sqlparser.NotInStr:
+		operator, negated = sqlparser.InStr, true
+	case sqlparser.NotLikeStr:
+		operator, negated = sqlparser.LikeStr, true
+	}
+
+	var expr visibilityQueryExpr
+	switch operator {
+	case sqlparser.InStr:
+		tuple, ok := compExpr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		}
+		values := make([]interface{}, 0, len(tuple))
+		for _, valExpr := range tuple {
+			value, err := convertVisibilityQueryValue(field, valExpr)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		expr = &comparisonExpr{field: field, operator: operator, values: values}
+	case sqlparser.LikeStr:
+		pattern, err := extractStringLiteral(compExpr.Right)
+		if err != nil {
+			return nil, err
+		}
+		prefix := strings.TrimSuffix(pattern, likeWildcard)
+		if strings.Contains(prefix, likeWildcard) || !isStringField(field) {
+			return nil, fmt.Errorf("only prefix LIKE on string fields is supported: %s", sqlparser.String(compExpr))
+		}
+		expr = &comparisonExpr{field: field, operator: operator, values: []interface{}{prefix}}
+	case sqlparser.EqualStr, sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		if operator != sqlparser.EqualStr && getVisibilityQueryFieldType(field) == fieldTypeCloseStatus {
+			return nil, fmt.Errorf("operator %s is not supported for %s", compExpr.Operator, field)
+		}
+		value, err := convertVisibilityQueryValue(field, compExpr.Right)
+		if err != nil {
+			return nil, err
+		}
+		expr = &comparisonExpr{field: field, operator: operator, values: []interface{}{value}}
+		if operator == sqlparser.EqualStr && p.precision != 0 && getVisibilityQueryFieldType(field) == fieldTypeTime {
+			earliest := time.Unix(0, value.(int64)).Truncate(p.precision)
+			expr = &comparisonExpr{
+				field:    field,
+				operator: sqlparser.BetweenStr,
+				values:   []interface{}{earliest.UnixNano(), earliest.Add(p.precision).UnixNano() - 1},
+			}
+		}
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", compExpr.Operator)
+	}
+
+	if negated {
+		return &notExpr{expr: expr}, nil
+	}
+	return expr, nil
+}
+
+func (p *visibilityQueryParser) convertRangeCond(rangeCond *sqlparser.RangeCond) (visibilityQueryExpr, error) {
+	field, err := p.convertField(rangeCond.Left)
+	if err != nil {
+		return nil, err
+	}
+	if getVisibilityQueryFieldType(field) == fieldTypeCloseStatus {
+		return nil, fmt.Errorf("operator %s is not supported for %s", rangeCond.Operator, field)
+	}
+	from, err := convertVisibilityQueryValue(field, rangeCond.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertVisibilityQueryValue(field, rangeCond.To)
+	if err != nil {
+		return nil, err
+	}
+	expr := &comparisonExpr{field: field, operator: sqlparser.BetweenStr, values: []interface{}{from, to}}
+	if rangeCond.Operator == sqlparser.NotBetweenStr {
+		return &notExpr{expr: expr}, nil
+	}
+	return expr, nil
+}
+
+func (p *visibilityQueryParser) convertField(expr sqlparser.Expr) (string, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", fmt.Errorf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	field := normalizeVisibilityQueryField(sqlparser.String(colName))
+	if field == VisibilityQuerySearchPrecision {
+		return "", errSearchPrecisionNotTopLevel
+	}
+	if getVisibilityQueryFieldType(field) == fieldTypeSearchAttribute {
+		// catch misspelled field names instead of silently treating them as search attributes
+		for name := range visibilityQueryFieldTypes {
+			if strings.EqualFold(field, name) {
+				return "", fmt.Errorf("unknown filter name: %s", field)
+			}
+		}
+	}
+	p.fields[field] = struct{}{}
+	return field, nil
+}
+
+func normalizeVisibilityQueryField(field string) string {
+	if alias, ok := visibilityQueryFieldAliases[field]; ok {
+		return alias
+	}
+	return field
+}
+
+func getVisibilityQueryFieldType(field string) visibilityQueryFieldType {
+	if fieldType, ok := visibilityQueryFieldTypes[field]; ok {
+		return fieldType
+	}
+	return fieldTypeSearchAttribute
+}
+
+func isStringField(field string) bool {
+	fieldType := getVisibilityQueryFieldType(field)
+	return fieldType == fieldTypeString || fieldType == fieldTypeSearchAttribute
+}
+
+// convertVisibilityQueryValue converts a literal to the representation used when matching the field:
+// string for string fields, int64 for time, int and close status fields, and string, float64 or bool
+// for custom search attributes
+func convertVisibilityQueryValue(field string, expr sqlparser.Expr) (interface{}, error) {
+	switch getVisibilityQueryFieldType(field) {
+	case fieldTypeString:
+		return extractStringLiteral(expr)
+	case fieldTypeTime:
+		if s, err := extractStringLiteral(expr); err == nil {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return nil, err
+			}
+			return t.UnixNano(), nil
+		}
+		return extractIntLiteral(expr)
+	case fieldTypeInt:
+		return extractIntLiteral(expr)
+	case fieldTypeCloseStatus:
+		s, err := extractStringLiteral(expr)
+		if err != nil {
+			// close status can also be given as a number
+			s = sqlparser.String(expr)
+		}
+		status, err := parseCloseStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		return int64(status), nil
+	default:
+		if boolVal, ok := expr.(sqlparser.BoolVal); ok {
+			return bool(boolVal), nil
+		}
+		if s, err := extractStringLiteral(expr); err == nil {
+			return s, nil
+		}
+		return extractFloatLiteral(expr)
+	}
+}
+
+func extractStringLiteral(expr sqlparser.Expr) (string, error) {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.StrVal {
+		return "", fmt.Errorf("value %s is not a string value", sqlparser.String(expr))
+	}
+	return string(val.Val), nil
+}
+
+func extractIntLiteral(expr sqlparser.Expr) (int64, error) {
+	s := sqlparser.String(expr)
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("value %s is not an integer value", s)
+	}
+	return value, nil
+}
+
+func extractFloatLiteral(expr sqlparser.Expr) (float64, error) {
+	s := sqlparser.String(expr)
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %s", s)
+	}
+	return value, nil
+}
+
+func parseCloseStatus(statusStr string) (types.WorkflowExecutionCloseStatus, error) {
+	statusStr = strings.ToLower(strings.TrimSpace(statusStr))
+	switch statusStr {
+	case "completed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCompleted)):
+		return types.WorkflowExecutionCloseStatusCompleted, nil
+	case "failed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusFailed)):
+		return types.WorkflowExecutionCloseStatusFailed, nil
+	case "canceled", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCanceled)):
+		return types.WorkflowExecutionCloseStatusCanceled, nil
+	case "terminated", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTerminated)):
+		return types.WorkflowExecutionCloseStatusTerminated, nil
+	case "continuedasnew", "continued_as_new", strconv.Itoa(int(types.WorkflowExecutionCloseStatusContinuedAsNew)):
+		return types.WorkflowExecutionCloseStatusContinuedAsNew, nil
+	case "timedout", "timed_out", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTimedOut)):
+		return types.WorkflowExecutionCloseStatusTimedOut, nil
+	default:
+		return 0, fmt.Errorf("unknown workflow close status: %s", statusStr)
+	}
+}
+
+func getVisibilityQueryFieldValues(record *ArchiveVisibilityRequest, field string) []interface{} {
+	switch field {
+	case VisibilityQueryWorkflowID:
+		return []interface{}{record.WorkflowID}
+	case VisibilityQueryRunID:
+		return []interface{}{record.RunID}
+	case VisibilityQueryWorkflowType:
+		return []interface{}{record.WorkflowTypeName}
+	case VisibilityQueryStartTime:
+		return []interface{}{record.StartTimestamp}
+	case VisibilityQueryExecutionTime:
+		return []interface{}{record.ExecutionTimestamp}
+	case VisibilityQueryCloseTime:
+		return []interface{}{record.CloseTimestamp}
+	case VisibilityQueryCloseStatus:
+		return []interface{}{int64(record.CloseStatus)}
+	case VisibilityQueryHistoryLength:
+		return []interface{}{record.HistoryLength}
+	default:
+		value, ok := record.SearchAttributes[field]
+		if !ok {
+			return nil
+		}
+		return decodeSearchAttributeValue(value)
+	}
+}
+
+// decodeSearchAttributeValue decodes the JSON encoded value of a search attribute,
+// the elements of an array value are returned as separate values
+func decodeSearchAttributeValue(value string) []interface{} {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return []interface{}{value}
+	}
+	if array, ok := decoded.([]interface{}); ok {
+		var values []interface{}
+		for _, element := range array {
+			if element != nil {
+				values = append(values, element)
+			}
+		}
+		return values
+	}
+	if decoded == nil {
+		return nil
+	}
+	return []interface{}{decoded}
+}
+
+// compareVisibilityQueryValues compares two values of the same kind, ok is false if they can't be compared
+func compareVisibilityQueryValues(left, right interface{}) (result int, ok bool) {
+	switch l := left.(type) {
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(l, r), true
+	case bool:
+		r, ok := right.(bool)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case l == r:
+			return 0, true
+		case r:
+			return -1, true
+		default:
+			return 1, true
+		}
+	case int64:
+		if r, ok := right.(int64); ok {
+			switch {
+			case l < r:
+				return -1, true
+			case l > r:
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+		return compareVisibilityQueryValues(float64(l), right)
+	case float64:
+		var r float64
+		switch v := right.(type) {
+		case float64:
+			r = v
+		case int64:
+			r = float64(v)
+		default:
+			return 0, false
+		}
+		switch {
+		case l < r:
+			return -1, true
+		case l > r:
+			return 1, true
+		default:
+			return 0, true
+		}
+	default:
+		return 0, false
+	}
+}
+
+func formatVisibilityQueryValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	visibilityQuerySuite struct {
+		*require.Assertions
+		suite.Suite
+
+		record *ArchiveVisibilityRequest
+	}
+)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.record = &ArchiveVisibilityRequest{
+		WorkflowID:       "workflow-id",
+		RunID:            "run-id",
+		WorkflowTypeName: "workflow-type",
+		StartTimestamp:   time.Date(2020, 2, 5, 9, 0, 0, 0, time.UTC).UnixNano(),
+		CloseTimestamp:   time.Date(2020, 2, 5, 10, 30, 0, 0, time.UTC).UnixNano(),
+		CloseStatus:      types.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:    36,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": `["keyword1","keyword2"]`,
+			"CustomIntField":     `5`,
+			"CustomBoolField":    `true`,
+		},
+	}
+}
+
+func (s *visibilityQuerySuite) TestMatch() {
+	testCases := []struct {
+		query       string
+		expectMatch bool
+	}{
+		{query: "WorkflowID = 'workflow-id'", expectMatch: true},
+		{query: "WorkflowID != 'workflow-id'", expectMatch: false},
+		{query: "WorkflowTypeName = 'workflow-type' AND RunID = 'run-id'", expectMatch: true},
+		{query: "WorkflowID = 'other-id' OR RunID = 'run-id'", expectMatch: true},
+		{query: "WorkflowID IN ('other-id', 'workflow-id')", expectMatch: true},
+		{query: "WorkflowID NOT IN ('other-id', 'workflow-id')", expectMatch: false},
+		{query: "WorkflowID LIKE 'workflow-%'", expectMatch: true},
+		{query: "WorkflowID LIKE 'other-%'", expectMatch: false},
+		{query: "CloseStatus = 'Failed'", expectMatch: true},
+		{query: "CloseStatus IN ('Completed', 'TimedOut')", expectMatch: false},
+		{query: "HistoryLength BETWEEN 10 AND 40", expectMatch: true},
+		{query: "HistoryLength > 36", expectMatch: false},
+		{query: "CloseTime >= '2020-02-05T10:00:00Z' AND CloseTime < '2020-02-05T11:00:00Z'", expectMatch: true},
+		{query: "CloseTime = '2020-02-05T00:00:00Z' AND SearchPrecision = 'Day'", expectMatch: true},
+		{query: "CloseTime = '2020-02-05T00:00:00Z' AND SearchPrecision = 'Hour'", expectMatch: false},
+		{query: "CustomKeywordField = 'keyword2'", expectMatch: true},
+		{query: "CustomKeywordField IN ('keyword3')", expectMatch: false},
+		{query: "CustomIntField >= 5 AND CustomBoolField = true", expectMatch: true},
+		{query: "NOT (CustomIntField < 5) AND UnknownField = 'value'", expectMatch: false},
+		{query: "ORDER BY CloseTime DESC", expectMatch: true},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.expectMatch, query.Match(s.record), tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestParse_Invalid() {
+	for _, query := range []string{
+		"some invalid query",
+		"WorkflowID LIKE '%workflow'",
+		"CloseStatus > 'Failed'",
+		"CloseStatus = 'unknown'",
+		"workflowid = 'workflow-id'",
+		"WorkflowID = 'workflow-id' OR SearchPrecision = 'Day'",
+		"SearchPrecision = 'Week'",
+		"CloseTime = 'not a time'",
+		"WorkflowID = 'workflow-id' ORDER BY StartTime, CloseTime",
+	} {
+		_, err := ParseVisibilityQuery(query)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestStringValues() {
+	query, err := ParseVisibilityQuery("WorkflowID IN ('a', 'b', 'c') AND (WorkflowID = 'b' OR WorkflowID = 'c') AND RunID != 'd'")
+	s.NoError(err)
+	values, ok := query.StringValues(VisibilityQueryWorkflowID)
+	s.True(ok)
+	s.Equal([]string{"b", "c"}, values)
+	_, ok = query.StringValues(VisibilityQueryRunID)
+	s.False(ok)
+
+	query, err = ParseVisibilityQuery("WorkflowTypeName = 'a' AND WorkflowType = 'b'")
+	s.NoError(err)
+	values, ok = query.StringValues(VisibilityQueryWorkflowType)
+	s.True(ok)
+	s.Empty(values)
+
+	query, err = ParseVisibilityQuery("CustomKeywordField = 'a' OR WorkflowID = 'b'")
+	s.NoError(err)
+	_, ok = query.StringValues("CustomKeywordField")
+	s.False(ok)
+	s.Equal([]string{"CustomKeywordField"}, query.SearchAttributes())
+}
+
+func (s *visibilityQuerySuite) TestTimeRange() {
+	day := time.Date(2020, 2, 5, 0, 0, 0, 0, time.UTC)
+	query, err := ParseVisibilityQuery("CloseTime = '2020-02-05T10:00:00Z' AND SearchPrecision = 'Day' AND WorkflowID = 'workflow-id'")
+	s.NoError(err)
+	earliest, latest := query.TimeRange(VisibilityQueryCloseTime)
+	s.Equal(day.UnixNano(), earliest)
+	s.Equal(day.Add(24*time.Hour).UnixNano()-1, latest)
+	earliest, latest = query.TimeRange(VisibilityQueryStartTime)
+	s.Equal(int64(0), earliest)
+	s.Equal(int64(math.MaxInt64), latest)
+
+	query, err = ParseVisibilityQuery("CloseTime < 100 OR CloseTime BETWEEN 200 AND 300")
+	s.NoError(err)
+	earliest, latest = query.TimeRange(VisibilityQueryCloseTime)
+	s.Equal(int64(0), earliest)
+	s.Equal(int64(300), latest)
+
+	query, err = ParseVisibilityQuery("CloseTime > 300 AND CloseTime < 200")
+	s.NoError(err)
+	earliest, latest = query.TimeRange(VisibilityQueryCloseTime)
+	s.True(earliest > latest)
+}
+
+func (s *visibilityQuerySuite) TestSort() {
+	query, err := ParseVisibilityQuery("WorkflowID = 'workflow-id' ORDER BY CustomIntField DESC")
+	s.NoError(err)
+	field, descending, ok := query.OrderBy()
+	s.True(ok)
+	s.Equal("CustomIntField", field)
+	s.True(descending)
+	s.False(query.IsOrderedBy(VisibilityQueryCloseTime, false))
+
+	records := []*ArchiveVisibilityRequest{
+		{RunID: "1", SearchAttributes: map[string]string{"CustomIntField": `1`}},
+		{RunID: "2"},
+		{RunID: "3", SearchAttributes: map[string]string{"CustomIntField": `3`}},
+	}
+	query.Sort(records)
+	s.Equal("3", records[0].RunID)
+	s.Equal("1", records[1].RunID)
+	s.Equal("2", records[2].RunID)
+
+	query, err = ParseVisibilityQuery("WorkflowID = 'workflow-id'")
+	s.NoError(err)
+	s.True(query.IsOrderedBy(VisibilityQueryCloseTime, true))
+}
+
+func (s *visibilityQuerySuite) TestSearchAttributeIndexValues() {
+	s.Equal([]string{"keyword1", "keyword2"}, SearchAttributeIndexValues(`["keyword1","keyword2"]`))
+	s.Equal([]string{"5"}, SearchAttributeIndexValues(`5`))
+	s.Equal([]string{"true"}, SearchAttributeIndexValues(`true`))
+	s.Equal([]string{"not json"}, SearchAttributeIndexValues(`not json`))
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+// taskListAPIs are the APIs whose requests carry the task list in the attributes
+var taskListAPIs = map[string]struct{}{
+	"DescribeTaskList":                 {},
+	"ListTaskListPartitions":           {},
+	"PollForActivityTask":              {},
+	"PollForDecisionTask":              {},
+	"SignalWithStartWorkflowExecution": {},
+	"StartWorkflowExecution":           {},
+}
+
+// workflowTypeAPIs are the APIs whose requests carry the workflow type in the attributes
+var workflowTypeAPIs = map[string]struct{}{
+	"SignalWithStartWorkflowExecution": {},
+	"StartWorkflowExecution":           {},
+}
+
+// apiPermissions maps the frontend and admin API names to the permission required to call them.
+// The admin handler shares some API names with the frontend handler, in which case
+// the permission passed in by the handler takes precedence if it is higher.
+var apiPermissions = map[string]Permission{
+	// frontend APIs
+	"BackfillSchedule":                 PermissionWrite,
+	"CancelBatchOperation":             PermissionWrite,
+	"CountWorkflowExecutions":          PermissionRead,
+	"CreateSchedule":                   PermissionWrite,
+	"DeleteSchedule":                   PermissionWrite,
+	"DeleteWorkflowExecution":          PermissionWrite,
+	"DeprecateDomain":                  PermissionAdmin,
+	"DescribeBatchOperation":           PermissionRead,
+	"DescribeDomain":                   PermissionRead,
+	"DescribeSchedule":                 PermissionRead,
+	"DescribeTaskList":                 PermissionRead,
+	"DescribeWorkflowExecution":        PermissionRead,
+	"GetTaskListsByDomain":             PermissionRead,
+	"GetWorkflowExecutionHistory":      PermissionRead,
+	"ListArchivedWorkflowExecutions":   PermissionRead,
+	"ListBatchOperations":              PermissionRead,
+	"ListClosedWorkflowExecutions":     PermissionRead,
+	"ListDomains":                      PermissionAdmin,
+	"ListOpenWorkflowExecutions":       PermissionRead,
+	"ListSchedules":                    PermissionRead,
+	"ListTaskListPartitions":           PermissionRead,
+	"ListWorkflowExecutions":           PermissionRead,
+	"PauseSchedule":                    PermissionWrite,
+	"PollForActivityTask":              PermissionWrite,
+	"PollForDecisionTask":              PermissionWrite,
+	"QueryWorkflow":                    PermissionRead,
+	"RegisterDomain":                   PermissionAdmin,
+	"RequestCancelWorkflowExecution":   PermissionWrite,
+	"ResetStickyTaskList":              PermissionWrite,
+	"ResetWorkflowExecution":           PermissionWrite,
+	"ScanWorkflowExecutions":           PermissionRead,
+	"SignalWithStartWorkflowExecution": PermissionWrite,
+	"SignalWorkflowExecution":          PermissionWrite,
+	"StartBatchOperation":              PermissionWrite,
+	"StartWorkflowExecution":           PermissionWrite,
+	"TerminateWorkflowExecution":       PermissionWrite,
+	"TriggerSchedule":                  PermissionWrite,
+	"UnpauseSchedule":                  PermissionWrite,
+	"UpdateDomain":                     PermissionAdmin,
+	"UpdateSchedule":                   PermissionWrite,
+
+	// admin APIs
+	"AddSearchAttribute":               PermissionAdmin,
+	"CloseShard":                       PermissionAdmin,
+	"DescribeCluster":                  PermissionAdmin,
+	"DescribeHistoryHost":              PermissionAdmin,
+	"DescribeQueue":                    PermissionAdmin,
+	"DescribeShardDistribution":        PermissionAdmin,
+	"GetCrossClusterTasks":             PermissionAdmin,
+	"GetDLQReplicationMessages":        PermissionAdmin,
+	"GetDomainReplicationMessages":     PermissionAdmin,
+	"GetDynamicConfig":                 PermissionAdmin,
+	"GetReplicationMessages":           PermissionAdmin,
+	"GetWorkflowExecutionRawHistoryV2": PermissionAdmin,
+	"ListDynamicConfig":                PermissionAdmin,
+	"MergeDLQMessages":                 PermissionAdmin,
+	"PurgeDLQMessages":                 PermissionAdmin,
+	"ReadDLQMessages":                  PermissionAdmin,
+	"ReapplyEvents":                    PermissionAdmin,
+	"RefreshWorkflowTasks":             PermissionAdmin,
+	"RemoveTask":                       PermissionAdmin,
+	"ResendReplicationTasks":           PermissionAdmin,
+	"ResetQueue":                       PermissionAdmin,
+	"RestoreDynamicConfig":             PermissionAdmin,
+	"UpdateDynamicConfig":              PermissionAdmin,
+}
+
+// GetRequiredPermission returns the permission required by the request.
+// It is the highest of the permission in the API table and the one set on the attributes,
+// and defaults to admin for unknown APIs without a permission set.
+func GetRequiredPermission(attributes *Attributes) Permission {
+	permission := attributes.Permission
+	if tablePermission, ok := apiPermissions[attributes.APIName]; ok && tablePermission > permission {
+		permission = tablePermission
+	}
+	if permission <= 0 {
+		permission = PermissionAdmin
+	}
+	return permission
+}
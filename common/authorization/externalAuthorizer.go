@@ -0,0 +1,314 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/yarpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	defaultExternalAuthorizerTimeout   = time.Second
+	defaultExternalAuthorizerCacheSize = 10000
+)
+
+type (
+	externalAuthority struct {
+		authorizationCfg config.ExternalAuthorizer
+		httpClient       *http.Client
+		decisions        cache.Cache
+		log              log.Logger
+		metricsScope     metrics.Scope
+		// token verifies the JWT with the validation of the oauth authorizer, it's nil if JWT is not configured
+		token *oauthAuthority
+	}
+
+	// ExternalAuthorizerRequest is the body sent to the policy decision point.
+	// Its shape follows the OPA data API so that a policy can be queried as is.
+	ExternalAuthorizerRequest struct {
+		Input ExternalAuthorizerInput `json:"input"`
+	}
+
+	// ExternalAuthorizerInput holds the attributes of the request to authorize.
+	// Permission is the permission required by the API, see GetRequiredPermission.
+	// Actor and Groups are only set from a verified identity: the subject and groups of the JWT,
+	// or the identity of the TLS client certificate.
+	ExternalAuthorizerInput struct {
+		Actor        string   `json:"actor,omitempty"`
+		Groups       []string `json:"groups,omitempty"`
+		APIName      string   `json:"apiName"`
+		DomainName   string   `json:"domainName,omitempty"`
+		WorkflowType string   `json:"workflowType,omitempty"`
+		TaskList     string   `json:"taskList,omitempty"`
+		Permission   string   `json:"permission"`
+		Token        string   `json:"token,omitempty"`
+	}
+
+	// ExternalAuthorizerResponse is the body returned by the policy decision point.
+	// Result is either a boolean or an object with an allow boolean field.
+	ExternalAuthorizerResponse struct {
+		Result json.RawMessage `json:"result"`
+	}
+)
+
+// NewExternalAuthorizer creates an authority delegating the decisions to an external policy decision point over HTTP.
+// Only HTTP(S) PDPs are supported, a gRPC PDP (e.g. the Envoy ext_authz API) is out of scope of this authorizer.
+func NewExternalAuthorizer(
+	authorizationCfg config.ExternalAuthorizer,
+	log log.Logger,
+	metricsClient metrics.Client,
+) (Authorizer, error) {
+	if authorizationCfg.URL == "" {
+		return nil, fmt.Errorf("external authorizer URL is not set")
+	}
+	timeout := authorizationCfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultExternalAuthorizerTimeout
+	}
+	var decisions cache.Cache
+	if authorizationCfg.CacheTTL > 0 {
+		cacheSize := authorizationCfg.CacheSize
+		if cacheSize <= 0 {
+			cacheSize = defaultExternalAuthorizerCacheSize
+		}
+		decisions = cache.New(&cache.Options{
+			TTL:      authorizationCfg.CacheTTL,
+			MaxCount: cacheSize,
+		})
+	}
+	if metricsClient == nil {
+		metricsClient = metrics.NewNoopMetricsClient()
+	}
+	var token *oauthAuthority
+	if authorizationCfg.JwtCredentials != nil {
+		authority, err := NewOAuthAuthorizer(config.OAuthAuthorizer{
+			Enable:         true,
+			JwtCredentials: *authorizationCfg.JwtCredentials,
+			MaxJwtTTL:      authorizationCfg.MaxJwtTTL,
+		}, log, nil)
+		if err != nil {
+			return nil, err
+		}
+		token = authority.(*oauthAuthority)
+	}
+	return &externalAuthority{
+		authorizationCfg: authorizationCfg,
+		httpClient:       &http.Client{Timeout: timeout},
+		decisions:        decisions,
+		log:              log,
+		metricsScope:     metricsClient.Scope(metrics.ExternalAuthorizerScope),
+		token:            token,
+	}, nil
+}
+
+// Authorize sends the attributes of the request to the policy decision point and applies its answer
+func (a *externalAuthority) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+	scope := a.metricsScope.Tagged(metrics.DomainTag(attributes.DomainName))
+	scope.IncCounter(metrics.ExternalAuthorizerRequests)
+	input, err := a.getInput(ctx, attributes)
+	if err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return a.handleDecision(scope, DecisionDeny), nil
+	}
+
+	key, err := json.Marshal(input)
+	if err != nil {
+		return a.handleFailure(scope, attributes, err)
+	}
+	if a.decisions != nil {
+		if decision, ok := a.decisions.Get(string(key)).(Decision); ok {
+			scope.IncCounter(metrics.ExternalAuthorizerCacheHits)
+			return a.handleDecision(scope, decision), nil
+		}
+	}
+
+	sw := scope.StartTimer(metrics.ExternalAuthorizerLatency)
+	decision, err := a.getDecision(ctx, input)
+	sw.Stop()
+	if err != nil {
+		return a.handleFailure(scope, attributes, err)
+	}
+	if a.decisions != nil {
+		a.decisions.Put(string(key), decision)
+	}
+	return a.handleDecision(scope, decision), nil
+}
+
+// getInput returns the input of the PDP. The actor of the request is taken from the verified JWT or TLS identity,
+// never from the caller name of the request or attributes.Actor as both of them are set by the client.
+// It returns an error if the request carries a JWT which fails the verification.
+func (a *externalAuthority) getInput(ctx context.Context, attributes *Attributes) (ExternalAuthorizerInput, error) {
+	input := ExternalAuthorizerInput{
+		APIName:    attributes.APIName,
+		DomainName: attributes.DomainName,
+		Permission: GetRequiredPermission(attributes).String(),
+	}
+	if attributes.WorkflowType != nil {
+		input.WorkflowType = attributes.WorkflowType.GetName()
+	}
+	if attributes.TaskList != nil {
+		input.TaskList = attributes.TaskList.GetName()
+	}
+
+	token := yarpc.CallFromContext(ctx).Header(common.AuthorizationTokenHeaderName)
+	if a.token != nil && token != "" {
+		claims, err := a.verifyToken(token)
+		if err != nil {
+			return input, err
+		}
+		input.Actor = claims.Sub
+		for _, group := range strings.Split(claims.Groups, groupSeparator) {
+			if group != "" {
+				input.Groups = append(input.Groups, group)
+			}
+		}
+	} else {
+		input.Actor = GetTLSIdentity(ctx)
+	}
+	if a.authorizationCfg.ForwardToken {
+		input.Token = token
+	}
+	return input, nil
+}
+
+func (a *externalAuthority) verifyToken(token string) (*JWTClaims, error) {
+	verifier, err := a.token.getVerifier()
+	if err != nil {
+		return nil, err
+	}
+	claims, err := a.token.parseToken(token, verifier)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.token.validateTTL(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// GetTLSIdentity returns the identity of the verified client certificate of a gRPC request,
+// which is its first URI SAN, e.g. a SPIFFE ID, or its common name
+func GetTLSIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	certificate := info.State.VerifiedChains[0][0]
+	if len(certificate.URIs) > 0 {
+		return certificate.URIs[0].String()
+	}
+	return certificate.Subject.CommonName
+}
+
+func (a *externalAuthority) getDecision(ctx context.Context, input ExternalAuthorizerInput) (Decision, error) {
+	body, err := json.Marshal(ExternalAuthorizerRequest{Input: input})
+	if err != nil {
+		return DecisionDeny, err
+	}
+	request, err := http.NewRequest(http.MethodPost, a.authorizationCfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return DecisionDeny, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := a.httpClient.Do(request)
+	if err != nil {
+		return DecisionDeny, err
+	}
+	defer response.Body.Close()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return DecisionDeny, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return DecisionDeny, fmt.Errorf("policy decision point returned status %v: %s", response.StatusCode, responseBody)
+	}
+	return parseExternalDecision(responseBody)
+}
+
+func parseExternalDecision(body []byte) (Decision, error) {
+	var response ExternalAuthorizerResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return DecisionDeny, err
+	}
+	if len(response.Result) == 0 {
+		return DecisionDeny, fmt.Errorf("policy decision point returned no result")
+	}
+
+	var allow bool
+	if err := json.Unmarshal(response.Result, &allow); err != nil {
+		var result struct {
+			Allow *bool `json:"allow"`
+		}
+		if err := json.Unmarshal(response.Result, &result); err != nil || result.Allow == nil {
+			return DecisionDeny, fmt.Errorf("policy decision point returned invalid result: %s", response.Result)
+		}
+		allow = *result.Allow
+	}
+	if allow {
+		return DecisionAllow, nil
+	}
+	return DecisionDeny, nil
+}
+
+func (a *externalAuthority) handleDecision(scope metrics.Scope, decision Decision) Result {
+	if decision == DecisionAllow {
+		scope.IncCounter(metrics.ExternalAuthorizerAllowed)
+	} else {
+		scope.IncCounter(metrics.ExternalAuthorizerDenied)
+	}
+	return Result{Decision: decision}
+}
+
+func (a *externalAuthority) handleFailure(scope metrics.Scope, attributes *Attributes, err error) (Result, error) {
+	scope.IncCounter(metrics.ExternalAuthorizerFailures)
+	if a.authorizationCfg.FailOpen {
+		a.log.Warn("policy decision point failed, allowing the request",
+			tag.WorkflowDomainName(attributes.DomainName), tag.Error(err))
+		return a.handleDecision(scope, DecisionAllow), nil
+	}
+	return a.handleDecision(scope, DecisionDeny), err
+}
@@ -24,12 +24,22 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 )
 
-func NewAuthorizer(authorization config.Authorization, logger log.Logger, domainCache cache.DomainCache) (Authorizer, error) {
+func NewAuthorizer(
+	authorization config.Authorization,
+	logger log.Logger,
+	domainCache cache.DomainCache,
+	metricsClient metrics.Client,
+) (Authorizer, error) {
 	switch true {
 	case authorization.OAuthAuthorizer.Enable:
 		return NewOAuthAuthorizer(authorization.OAuthAuthorizer, logger, domainCache)
+	case authorization.RBACAuthorizer.Enable:
+		return NewRBACAuthorizer(authorization.RBACAuthorizer, logger, domainCache)
+	case authorization.ExternalAuthorizer.Enable:
+		return NewExternalAuthorizer(authorization.ExternalAuthorizer, logger, metricsClient)
 	default:
 		return NewNopAuthorizer()
 	}
@@ -84,8 +84,25 @@ func (s *factorySuite) TestFactoryNoopAuthorizer() {
 	}
 
 	for _, test := range tests {
-		authorizer, err := NewAuthorizer(test.cfg, s.logger, nil)
+		authorizer, err := NewAuthorizer(test.cfg, s.logger, nil, nil)
 		s.Equal(authorizer, test.expected)
 		s.Equal(err, test.err)
 	}
 }
+
+func (s *factorySuite) TestFactoryRBACAuthorizer() {
+	cfg := config.Authorization{
+		RBACAuthorizer: config.RBACAuthorizer{
+			Enable: true,
+			JwtCredentials: config.JwtCredentials{
+				Algorithm: jwt.RS256.String(),
+				PublicKey: "../../config/credentials/keytest.pub",
+			},
+			MaxJwtTTL: 12345,
+		},
+	}
+
+	authorizer, err := NewAuthorizer(cfg, s.logger, nil, nil)
+	s.NoError(err)
+	s.IsType(&rbacAuthority{}, authorizer)
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// RoleReader is the built-in role granting read permission on all APIs
+	RoleReader = "reader"
+	// RoleWriter is the built-in role granting write permission on all APIs
+	RoleWriter = "writer"
+	// RoleAdmin is the built-in role granting admin permission on all APIs
+	RoleAdmin = "admin"
+
+	// PrincipalUserPrefix is the prefix of principals matching the subject of the JWT
+	PrincipalUserPrefix = "user:"
+	// PrincipalGroupPrefix is the prefix of principals matching one of the groups of the JWT
+	PrincipalGroupPrefix = "group:"
+)
+
+type (
+	rbacAuthority struct {
+		authorizationCfg config.RBACAuthorizer
+		domainCache      cache.DomainCache
+		log              log.Logger
+		// token reuses the JWT validation of the oauth authorizer
+		token *oauthAuthority
+		roles map[string]role
+	}
+
+	role struct {
+		permission Permission
+		// apis is nil if the role applies to every API
+		apis map[string]struct{}
+	}
+)
+
+// NewRBACAuthorizer creates a role based authority
+func NewRBACAuthorizer(
+	authorizationCfg config.RBACAuthorizer,
+	log log.Logger,
+	domainCache cache.DomainCache,
+) (Authorizer, error) {
+	token, err := NewOAuthAuthorizer(config.OAuthAuthorizer{
+		Enable:         true,
+		JwtCredentials: authorizationCfg.JwtCredentials,
+		MaxJwtTTL:      authorizationCfg.MaxJwtTTL,
+	}, log, domainCache)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := map[string]role{
+		RoleReader: {permission: PermissionRead},
+		RoleWriter: {permission: PermissionWrite},
+		RoleAdmin:  {permission: PermissionAdmin},
+	}
+	for name, cfg := range authorizationCfg.Roles {
+		permission := NewPermission(cfg.Permission)
+		if permission <= 0 {
+			return nil, fmt.Errorf("role %v has invalid permission %q", name, cfg.Permission)
+		}
+		r := role{permission: permission}
+		if len(cfg.APIs) > 0 {
+			r.apis = make(map[string]struct{}, len(cfg.APIs))
+			for _, api := range cfg.APIs {
+				r.apis[api] = struct{}{}
+			}
+		}
+		roles[name] = r
+	}
+
+	authority := &rbacAuthority{
+		authorizationCfg: authorizationCfg,
+		domainCache:      domainCache,
+		log:              log,
+		token:            token.(*oauthAuthority),
+		roles:            roles,
+	}
+	for _, binding := range authorizationCfg.ClusterBindings {
+		if err := authority.validateScopedBinding(binding); err != nil {
+			return nil, err
+		}
+	}
+	return authority, nil
+}
+
+// Authorize identifies the principal from the JWT and checks its role bindings
+func (a *rbacAuthority) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+	call := yarpc.CallFromContext(ctx)
+	verifier, err := a.token.getVerifier()
+	if err != nil {
+		return Result{Decision: DecisionDeny}, err
+	}
+	token := call.Header(common.AuthorizationTokenHeaderName)
+	if token == "" {
+		a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("token is not set in header")))
+		return Result{Decision: DecisionDeny}, nil
+	}
+	claims, err := a.token.parseToken(token, verifier)
+	if err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny}, nil
+	}
+	err = a.token.validateTTL(claims)
+	if err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny}, nil
+	}
+	return a.authorizeClaims(claims, attributes)
+}
+
+func (a *rbacAuthority) authorizeClaims(claims *JWTClaims, attributes *Attributes) (Result, error) {
+	if claims.Admin {
+		return Result{Decision: DecisionAllow}, nil
+	}
+
+	bindings := append([]config.RBACRoleBinding(nil), a.authorizationCfg.ClusterBindings...)
+	if attributes.DomainName != "" {
+		domain, err := a.domainCache.GetDomain(attributes.DomainName)
+		switch err.(type) {
+		case nil:
+			domainBindings, err := ParseRoleBindings(domain.GetInfo().Data)
+			if err != nil {
+				// do not fail the request so that cluster admins are still able to fix the domain data
+				a.log.Warn("invalid role bindings in domain data", tag.WorkflowDomainName(attributes.DomainName), tag.Error(err))
+			}
+			for _, binding := range domainBindings {
+				if err := a.validateScopedBinding(binding); err != nil {
+					a.log.Warn("invalid role binding in domain data", tag.WorkflowDomainName(attributes.DomainName), tag.Error(err))
+					continue
+				}
+				bindings = append(bindings, binding)
+			}
+		case *types.EntityNotExistsError:
+			// domain does not exist (yet), only cluster bindings apply
+		default:
+			return Result{Decision: DecisionDeny}, err
+		}
+	}
+
+	principals := getPrincipals(claims)
+	permission := GetRequiredPermission(attributes)
+	for _, binding := range bindings {
+		if a.isAllowedByBinding(binding, principals, permission, attributes) {
+			return Result{Decision: DecisionAllow}, nil
+		}
+	}
+	a.log.Debug("request is not authorized", tag.Error(
+		fmt.Errorf("no role binding of %v grants permission %v on %v", principals, permission, attributes.APIName),
+	))
+	return Result{Decision: DecisionDeny}, nil
+}
+
+func (a *rbacAuthority) isAllowedByBinding(
+	binding config.RBACRoleBinding,
+	principals map[string]struct{},
+	permission Permission,
+	attributes *Attributes,
+) bool {
+	if _, ok := principals[binding.Principal]; !ok {
+		return false
+	}
+	r, ok := a.roles[binding.Role]
+	if !ok || r.permission < permission {
+		return false
+	}
+	if r.apis != nil {
+		if _, ok := r.apis[attributes.APIName]; !ok {
+			return false
+		}
+	}
+	// scoped bindings only apply when the request carries the restricted attribute,
+	// validateScopedBinding makes sure their roles only grant the APIs carrying it
+	if len(binding.WorkflowTypes) > 0 && (attributes.WorkflowType == nil || !contains(binding.WorkflowTypes, attributes.WorkflowType.GetName())) {
+		return false
+	}
+	if len(binding.TaskLists) > 0 && (attributes.TaskList == nil || !contains(binding.TaskLists, attributes.TaskList.GetName())) {
+		return false
+	}
+	return true
+}
+
+// validateScopedBinding checks that a binding scoped to workflow types or task lists is bound to a role restricted
+// to the APIs whose requests carry them. The binding would never match any other API, e.g. signals and polls don't
+// carry the workflow type, so a role granting other APIs is a misconfiguration.
+func (a *rbacAuthority) validateScopedBinding(binding config.RBACRoleBinding) error {
+	r, ok := a.roles[binding.Role]
+	if !ok {
+		// the binding never matches
+		return nil
+	}
+	validate := func(scope string, scopedAPIs map[string]struct{}) error {
+		if r.apis == nil {
+			return fmt.Errorf("binding of %v is scoped to %v but role %v is not restricted to the APIs carrying them", binding.Principal, scope, binding.Role)
+		}
+		for api := range r.apis {
+			if _, ok := scopedAPIs[api]; !ok {
+				return fmt.Errorf("binding of %v is scoped to %v but role %v grants %v which doesn't carry them", binding.Principal, scope, binding.Role, api)
+			}
+		}
+		return nil
+	}
+	if len(binding.WorkflowTypes) > 0 {
+		if err := validate("workflow types", workflowTypeAPIs); err != nil {
+			return err
+		}
+	}
+	if len(binding.TaskLists) > 0 {
+		if err := validate("task lists", taskListAPIs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getPrincipals(claims *JWTClaims) map[string]struct{} {
+	principals := map[string]struct{}{}
+	if claims.Sub != "" {
+		principals[PrincipalUserPrefix+claims.Sub] = struct{}{}
+	}
+	for _, group := range strings.Split(claims.Groups, groupSeparator) {
+		if group != "" {
+			principals[PrincipalGroupPrefix+group] = struct{}{}
+		}
+	}
+	return principals
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRoleBindings decodes the role bindings stored in the domain data
+func ParseRoleBindings(data map[string]string) ([]config.RBACRoleBinding, error) {
+	encoded, ok := data[common.DomainDataKeyForRoleBindings]
+	if !ok || encoded == "" {
+		return nil, nil
+	}
+	var bindings []config.RBACRoleBinding
+	if err := json.Unmarshal([]byte(encoded), &bindings); err != nil {
+		return nil, err
+	}
+	for _, binding := range bindings {
+		if err := ValidateRoleBinding(binding); err != nil {
+			return nil, err
+		}
+	}
+	return bindings, nil
+}
+
+// EncodeRoleBindings encodes the role bindings to be stored in the domain data
+func EncodeRoleBindings(bindings []config.RBACRoleBinding) (string, error) {
+	for _, binding := range bindings {
+		if err := ValidateRoleBinding(binding); err != nil {
+			return "", err
+		}
+	}
+	encoded, err := json.Marshal(bindings)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// ValidateRoleBinding checks the principal and role of the binding are set
+func ValidateRoleBinding(binding config.RBACRoleBinding) error {
+	if !strings.HasPrefix(binding.Principal, PrincipalUserPrefix) && !strings.HasPrefix(binding.Principal, PrincipalGroupPrefix) {
+		return fmt.Errorf("principal %q must start with %q or %q", binding.Principal, PrincipalUserPrefix, PrincipalGroupPrefix)
+	}
+	if binding.Role == "" {
+		return fmt.Errorf("role of principal %q is empty", binding.Principal)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/cristalhq/jwt/v3"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	rbacSuite struct {
+		suite.Suite
+		controller  *gomock.Controller
+		domainCache *cache.MockDomainCache
+		authorizer  *rbacAuthority
+	}
+)
+
+func TestRBACSuite(t *testing.T) {
+	suite.Run(t, new(rbacSuite))
+}
+
+func (s *rbacSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.domainCache = cache.NewMockDomainCache(s.controller)
+
+	cfg := config.RBACAuthorizer{
+		Enable: true,
+		JwtCredentials: config.JwtCredentials{
+			Algorithm: jwt.RS256.String(),
+			PublicKey: "../../config/credentials/keytest.pub",
+		},
+		MaxJwtTTL: 300000001,
+		Roles: map[string]config.RBACRole{
+			"signaler": {Permission: "write", APIs: []string{"SignalWorkflowExecution"}},
+			"starter":  {Permission: "write", APIs: []string{"StartWorkflowExecution", "SignalWithStartWorkflowExecution"}},
+			"poller":   {Permission: "write", APIs: []string{"PollForDecisionTask", "PollForActivityTask"}},
+		},
+		ClusterBindings: []config.RBACRoleBinding{
+			{Principal: "group:ops", Role: RoleAdmin},
+		},
+	}
+	authorizer, err := NewRBACAuthorizer(cfg, loggerimpl.NewLoggerForTest(s.Suite), s.domainCache)
+	s.NoError(err)
+	s.authorizer = authorizer.(*rbacAuthority)
+}
+
+func (s *rbacSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *rbacSuite) domainEntry(bindings []config.RBACRoleBinding) *cache.DomainCacheEntry {
+	encoded, err := EncodeRoleBindings(bindings)
+	s.NoError(err)
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   "test-domain-id",
+			Name: "test-domain",
+			Data: map[string]string{common.DomainDataKeyForRoleBindings: encoded},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		"",
+		nil,
+	)
+}
+
+func (s *rbacSuite) TestBuiltInRoles() {
+	s.domainCache.EXPECT().GetDomain("test-domain").Return(s.domainEntry([]config.RBACRoleBinding{
+		{Principal: "user:alice", Role: RoleReader},
+		{Principal: "group:dev", Role: RoleWriter},
+	}), nil).AnyTimes()
+
+	tests := []struct {
+		claims   *JWTClaims
+		api      string
+		expected Decision
+	}{
+		{&JWTClaims{Sub: "alice"}, "DescribeWorkflowExecution", DecisionAllow},
+		{&JWTClaims{Sub: "alice"}, "TerminateWorkflowExecution", DecisionDeny},
+		{&JWTClaims{Sub: "bob", Groups: "qa dev"}, "TerminateWorkflowExecution", DecisionAllow},
+		{&JWTClaims{Sub: "bob", Groups: "qa dev"}, "UpdateDomain", DecisionDeny},
+		{&JWTClaims{Sub: "carol", Groups: "ops"}, "UpdateDomain", DecisionAllow},
+		{&JWTClaims{Sub: "dave"}, "DescribeWorkflowExecution", DecisionDeny},
+		{&JWTClaims{Sub: "dave", Admin: true}, "UpdateDomain", DecisionAllow},
+	}
+	for _, test := range tests {
+		result, err := s.authorizer.authorizeClaims(test.claims, &Attributes{
+			APIName:    test.api,
+			DomainName: "test-domain",
+		})
+		s.NoError(err)
+		s.Equal(test.expected, result.Decision, "%v calling %v", test.claims.Sub, test.api)
+	}
+}
+
+func (s *rbacSuite) TestHandlerPermissionIsRespected() {
+	s.domainCache.EXPECT().GetDomain("test-domain").Return(s.domainEntry([]config.RBACRoleBinding{
+		{Principal: "user:alice", Role: RoleReader},
+	}), nil).Times(1)
+
+	// admin DescribeWorkflowExecution shares its name with the frontend API
+	result, err := s.authorizer.authorizeClaims(&JWTClaims{Sub: "alice"}, &Attributes{
+		APIName:    "DescribeWorkflowExecution",
+		DomainName: "test-domain",
+		Permission: PermissionAdmin,
+	})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *rbacSuite) TestCustomRoleRestrictsAPIs() {
+	s.domainCache.EXPECT().GetDomain("test-domain").Return(s.domainEntry([]config.RBACRoleBinding{
+		{Principal: "user:alice", Role: "signaler"},
+	}), nil).Times(2)
+
+	result, err := s.authorizer.authorizeClaims(&JWTClaims{Sub: "alice"}, &Attributes{APIName: "SignalWorkflowExecution", DomainName: "test-domain"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.authorizeClaims(&JWTClaims{Sub: "alice"}, &Attributes{APIName: "DescribeWorkflowExecution", DomainName: "test-domain"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *rbacSuite) TestFineGrainedBindings() {
+	s.domainCache.EXPECT().GetDomain("test-domain").Return(s.domainEntry([]config.RBACRoleBinding{
+		{Principal: "user:alice", Role: "starter", WorkflowTypes: []string{"payments"}},
+		{Principal: "user:worker", Role: "poller", TaskLists: []string{"payments-tl"}},
+	}), nil).AnyTimes()
+
+	tests := []struct {
+		sub      string
+		attr     *Attributes
+		expected Decision
+	}{
+		{"alice", &Attributes{APIName: "StartWorkflowExecution", WorkflowType: &types.WorkflowType{Name: "payments"}}, DecisionAllow},
+		{"alice", &Attributes{APIName: "StartWorkflowExecution", WorkflowType: &types.WorkflowType{Name: "refunds"}}, DecisionDeny},
+		{"alice", &Attributes{APIName: "TerminateWorkflowExecution"}, DecisionDeny},
+		{"worker", &Attributes{APIName: "PollForDecisionTask", TaskList: &types.TaskList{Name: "payments-tl"}}, DecisionAllow},
+		{"worker", &Attributes{APIName: "PollForDecisionTask", TaskList: &types.TaskList{Name: "other-tl"}}, DecisionDeny},
+		{"worker", &Attributes{APIName: "PollForActivityTask", TaskList: &types.TaskList{Name: "payments-tl"}}, DecisionAllow},
+		{"worker", &Attributes{APIName: "StartWorkflowExecution", TaskList: &types.TaskList{Name: "payments-tl"}}, DecisionDeny},
+	}
+	for _, test := range tests {
+		test.attr.DomainName = "test-domain"
+		result, err := s.authorizer.authorizeClaims(&JWTClaims{Sub: test.sub}, test.attr)
+		s.NoError(err)
+		s.Equal(test.expected, result.Decision, "%v calling %v", test.sub, test.attr.APIName)
+	}
+}
+
+func (s *rbacSuite) TestScopedBindingsOfUnrestrictedRoles() {
+	cfg := s.authorizer.authorizationCfg
+	cfg.ClusterBindings = []config.RBACRoleBinding{
+		{Principal: "group:workers", Role: RoleWriter, TaskLists: []string{"payments-tl"}},
+	}
+	_, err := NewRBACAuthorizer(cfg, loggerimpl.NewLoggerForTest(s.Suite), s.domainCache)
+	s.Error(err)
+
+	cfg.ClusterBindings = []config.RBACRoleBinding{
+		{Principal: "group:signalers", Role: "signaler", WorkflowTypes: []string{"payments"}},
+	}
+	_, err = NewRBACAuthorizer(cfg, loggerimpl.NewLoggerForTest(s.Suite), s.domainCache)
+	s.Error(err)
+
+	cfg.ClusterBindings = []config.RBACRoleBinding{
+		{Principal: "group:workers", Role: "poller", TaskLists: []string{"payments-tl"}},
+	}
+	_, err = NewRBACAuthorizer(cfg, loggerimpl.NewLoggerForTest(s.Suite), s.domainCache)
+	s.NoError(err)
+
+	// the invalid bindings in the domain data are ignored
+	s.domainCache.EXPECT().GetDomain("test-domain").Return(s.domainEntry([]config.RBACRoleBinding{
+		{Principal: "user:worker", Role: RoleWriter, TaskLists: []string{"payments-tl"}},
+	}), nil).Times(1)
+	result, err := s.authorizer.authorizeClaims(&JWTClaims{Sub: "worker"}, &Attributes{
+		APIName:    "PollForDecisionTask",
+		DomainName: "test-domain",
+		TaskList:   &types.TaskList{Name: "payments-tl"},
+	})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *rbacSuite) TestDomainNotExists() {
+	s.domainCache.EXPECT().GetDomain("new-domain").Return(nil, &types.EntityNotExistsError{}).Times(2)
+
+	result, err := s.authorizer.authorizeClaims(&JWTClaims{Sub: "carol", Groups: "ops"}, &Attributes{APIName: "RegisterDomain", DomainName: "new-domain"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.authorizeClaims(&JWTClaims{Sub: "alice"}, &Attributes{APIName: "RegisterDomain", DomainName: "new-domain"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *rbacSuite) TestClusterAPIs() {
+	result, err := s.authorizer.authorizeClaims(&JWTClaims{Sub: "carol", Groups: "ops"}, &Attributes{APIName: "DescribeCluster"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.authorizeClaims(&JWTClaims{Sub: "alice"}, &Attributes{APIName: "DescribeCluster"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *rbacSuite) TestInvalidDomainBindingsAreIgnored() {
+	entry := s.domainEntry(nil)
+	entry.GetInfo().Data[common.DomainDataKeyForRoleBindings] = "not json"
+	s.domainCache.EXPECT().GetDomain("test-domain").Return(entry, nil).Times(2)
+
+	result, err := s.authorizer.authorizeClaims(&JWTClaims{Sub: "carol", Groups: "ops"}, &Attributes{APIName: "UpdateDomain", DomainName: "test-domain"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.authorizeClaims(&JWTClaims{Sub: "alice"}, &Attributes{APIName: "DescribeDomain", DomainName: "test-domain"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *rbacSuite) TestEncodeRoleBindings() {
+	bindings := []config.RBACRoleBinding{
+		{Principal: "user:alice", Role: RoleReader},
+		{Principal: "group:dev", Role: RoleWriter, WorkflowTypes: []string{"payments"}, TaskLists: []string{"tl"}},
+	}
+	encoded, err := EncodeRoleBindings(bindings)
+	s.NoError(err)
+	decoded, err := ParseRoleBindings(map[string]string{common.DomainDataKeyForRoleBindings: encoded})
+	s.NoError(err)
+	s.Equal(bindings, decoded)
+
+	_, err = EncodeRoleBindings([]config.RBACRoleBinding{{Principal: "alice", Role: RoleReader}})
+	s.Error(err)
+	_, err = EncodeRoleBindings([]config.RBACRoleBinding{{Principal: "user:alice"}})
+	s.Error(err)
+}
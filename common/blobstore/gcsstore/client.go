@@ -0,0 +1,159 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcsstore
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	client struct {
+		bucket *storage.BucketHandle
+		prefix string
+	}
+)
+
+// NewGCSClient constructs a blobstore backed by Google Cloud Storage,
+// the default application credentials are used if no credentials path is configured
+func NewGCSClient(ctx context.Context, cfg *config.GCSBlobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("gcs blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for gcs blobstore")
+	}
+	var opts []option.ClientOption
+	if len(cfg.CredentialsPath) != 0 {
+		opts = append(opts, option.WithCredentialsFile(cfg.CredentialsPath))
+	}
+	if len(cfg.Endpoint) != 0 {
+		opts = append(opts, option.WithEndpoint(cfg.Endpoint))
+	}
+	storageClient, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(storageClient, cfg.Bucket, cfg.Prefix), nil
+}
+
+func newClient(storageClient *storage.Client, bucket string, prefix string) *client {
+	return &client{
+		bucket: storageClient.Bucket(bucket),
+		prefix: prefix,
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	writer := c.object(request.Key).NewWriter(ctx)
+	writer.Metadata = request.Blob.Tags
+	if _, err := writer.Write(request.Blob.Body); err != nil {
+		writer.Close()
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	object := c.object(request.Key)
+	attrs, err := object.Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := object.Generation(attrs.Generation).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: data,
+			Tags: attrs.Metadata,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.object(request.Key).Attrs(ctx)
+	if err == storage.ErrObjectNotExist {
+		return &blobstore.ExistsResponse{
+			Exists: false,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{
+		Exists: true,
+	}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if err := c.object(request.Key).Delete(ctx); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if gerr, ok := err.(*googleapi.Error); ok {
+		return gerr.Code == http.StatusTooManyRequests ||
+			(gerr.Code >= http.StatusInternalServerError && gerr.Code != http.StatusNotImplemented)
+	}
+	if nerr, ok := err.(net.Error); ok {
+		return nerr.Temporary() || nerr.Timeout()
+	}
+	return false
+}
+
+func (c *client) object(key string) *storage.ObjectHandle {
+	if len(c.prefix) == 0 {
+		return c.bucket.Object(key)
+	}
+	return c.bucket.Object(path.Join(c.prefix, key))
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcsstore
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	ClientSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		server *fakeGCSServer
+		client *client
+	}
+
+	// fakeGCSServer is a minimal in memory Google Cloud Storage server serving
+	// the json api used for object metadata and uploads, and the xml api used for reads
+	fakeGCSServer struct {
+		sync.Mutex
+
+		server     *httptest.Server
+		objects    map[string]*fakeGCSObject
+		generation int64
+		failures   int // number of the next requests failing with 503
+	}
+
+	fakeGCSObject struct {
+		Bucket     string            `json:"bucket"`
+		Name       string            `json:"name"`
+		Generation string            `json:"generation"`
+		Size       string            `json:"size"`
+		Metadata   map[string]string `json:"metadata,omitempty"`
+
+		body []byte
+	}
+)
+
+const (
+	testBucket = "cadence-blobs"
+	testPrefix = "scanner"
+
+	jsonAPIObjectPrefix = "/storage/v1/b/" + testBucket + "/o/"
+	jsonAPIUploadPath   = "/upload/storage/v1/b/" + testBucket + "/o"
+	xmlAPIObjectPrefix  = "/" + testBucket + "/"
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.server = newFakeGCSServer()
+	storageClient, err := storage.NewClient(
+		context.Background(),
+		option.WithEndpoint(s.server.server.URL+"/storage/v1/"),
+		option.WithHTTPClient(s.server.server.Client()),
+	)
+	s.NoError(err)
+	s.client = newClient(storageClient, testBucket, testPrefix)
+}
+
+func (s *ClientSuite) TearDownTest() {
+	s.server.server.Close()
+}
+
+func (s *ClientSuite) TestNewGCSClient_InvalidConfig() {
+	_, err := NewGCSClient(context.Background(), nil)
+	s.Error(err)
+	_, err = NewGCSClient(context.Background(), &config.GCSBlobstore{Prefix: testPrefix})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestCrudOperations() {
+	ctx := context.Background()
+
+	key1 := uuid.New()
+	key2 := uuid.New()
+	blob1 := blobstore.Blob{
+		Tags: nil,
+		Body: []byte{1, 2, 3},
+	}
+	blob2 := blobstore.Blob{
+		Tags: map[string]string{"Key1": "value1", "key2": "value2"},
+		Body: []byte{1, 2, 3, 4, 5},
+	}
+	_, err := s.client.Put(ctx, &blobstore.PutRequest{Key: key1, Blob: blob1})
+	s.NoError(err)
+	_, err = s.client.Put(ctx, &blobstore.PutRequest{Key: key2, Blob: blob2})
+	s.NoError(err)
+	s.Contains(s.server.objects, testPrefix+"/"+key1)
+
+	get1, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key1})
+	s.NoError(err)
+	s.Nil(get1.Blob.Tags)
+	s.Equal(blob1.Body, get1.Blob.Body)
+	get2, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key2})
+	s.NoError(err)
+	s.Equal(blob2.Tags, get2.Blob.Tags)
+	s.Equal(blob2.Body, get2.Blob.Body)
+
+	exists, err := s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key1})
+	s.NoError(err)
+	s.True(exists.Exists)
+
+	_, err = s.client.Delete(ctx, &blobstore.DeleteRequest{Key: key1})
+	s.NoError(err)
+	exists, err = s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key1})
+	s.NoError(err)
+	s.False(exists.Exists)
+	get1, err = s.client.Get(ctx, &blobstore.GetRequest{Key: key1})
+	s.Error(err)
+	s.False(s.client.IsRetryableError(err))
+	s.Nil(get1)
+}
+
+func (s *ClientSuite) TestRetryableClient() {
+	ctx := context.Background()
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(3)
+	retryableClient := blobstore.NewRetryableClient(s.client, policy)
+
+	s.server.fail(2)
+	_, err := retryableClient.Put(ctx, &blobstore.PutRequest{
+		Key:  "key",
+		Blob: blobstore.Blob{Body: []byte{1}},
+	})
+	s.NoError(err)
+
+	s.server.fail(2)
+	_, err = retryableClient.Get(ctx, &blobstore.GetRequest{Key: "key"})
+	s.NoError(err)
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	s.True(s.client.IsRetryableError(&googleapi.Error{Code: http.StatusServiceUnavailable}))
+	s.True(s.client.IsRetryableError(&googleapi.Error{Code: http.StatusTooManyRequests}))
+	s.False(s.client.IsRetryableError(&googleapi.Error{Code: http.StatusNotImplemented}))
+	s.False(s.client.IsRetryableError(&googleapi.Error{Code: http.StatusForbidden}))
+	s.False(s.client.IsRetryableError(storage.ErrObjectNotExist))
+	s.False(s.client.IsRetryableError(nil))
+}
+
+func newFakeGCSServer() *fakeGCSServer {
+	s := &fakeGCSServer{
+		objects: make(map[string]*fakeGCSObject),
+	}
+	s.server = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeGCSServer) fail(count int) {
+	s.Lock()
+	defer s.Unlock()
+	s.failures = count
+}
+
+func (s *fakeGCSServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		writeGCSError(w, http.StatusServiceUnavailable)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == jsonAPIUploadPath:
+		s.upload(w, r)
+	case strings.HasPrefix(r.URL.Path, jsonAPIObjectPrefix):
+		name := strings.TrimPrefix(r.URL.Path, jsonAPIObjectPrefix)
+		object, ok := s.objects[name]
+		if !ok {
+			writeGCSError(w, http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(s.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, object)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, xmlAPIObjectPrefix):
+		object, ok := s.objects[strings.TrimPrefix(r.URL.Path, xmlAPIObjectPrefix)]
+		if !ok {
+			writeGCSError(w, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.body)))
+		w.Header().Set("X-Goog-Generation", object.Generation)
+		w.Header().Set("X-Goog-Metageneration", "1")
+		w.WriteHeader(http.StatusOK)
+		w.Write(object.body)
+	default:
+		writeGCSError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeGCSServer) upload(w http.ResponseWriter, r *http.Request) {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		writeGCSError(w, http.StatusBadRequest)
+		return
+	}
+	reader := multipart.NewReader(r.Body, params["boundary"])
+
+	object := &fakeGCSObject{}
+	metadataPart, err := reader.NextPart()
+	if err != nil {
+		writeGCSError(w, http.StatusBadRequest)
+		return
+	}
+	if err := json.NewDecoder(metadataPart).Decode(object); err != nil {
+		writeGCSError(w, http.StatusBadRequest)
+		return
+	}
+	mediaPart, err := reader.NextPart()
+	if err != nil {
+		writeGCSError(w, http.StatusBadRequest)
+		return
+	}
+	if object.body, err = ioutil.ReadAll(mediaPart); err != nil {
+		writeGCSError(w, http.StatusBadRequest)
+		return
+	}
+
+	s.generation++
+	object.Bucket = testBucket
+	object.Generation = strconv.FormatInt(s.generation, 10)
+	object.Size = strconv.Itoa(len(object.body))
+	s.objects[object.Name] = object
+	writeJSON(w, object)
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeGCSError(w http.ResponseWriter, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write([]byte(`{"error":{"code":` + strconv.Itoa(code) + `,"message":"` + http.StatusText(code) + `"}}`))
+}
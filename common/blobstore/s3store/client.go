@@ -0,0 +1,217 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	// tagsMetadataKey is the object metadata key where blob tags are stored,
+	// tags are base64 encoded json as metadata only allows ascii and its keys are case insensitive
+	tagsMetadataKey = "Cadence-Tags"
+)
+
+type (
+	client struct {
+		s3cli  s3iface.S3API
+		bucket string
+		prefix string
+	}
+)
+
+// NewS3Client constructs a blobstore backed by S3 compatible object storage,
+// credentials are loaded from the default AWS credential chain
+func NewS3Client(cfg *config.S3Blobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("s3 blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for s3 blobstore")
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+		// retries are done by blobstore.NewRetryableClient
+		MaxRetries: aws.Int(0),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClient(s3.New(sess), cfg.Bucket, cfg.Prefix), nil
+}
+
+func newClient(s3cli s3iface.S3API, bucket string, prefix string) *client {
+	return &client{
+		s3cli:  s3cli,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	tags, err := encodeTags(request.Blob.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+		Body:   bytes.NewReader(request.Blob.Body),
+		Metadata: map[string]*string{
+			tagsMetadataKey: aws.String(tags),
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	output, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	data, err := ioutil.ReadAll(output.Body)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := decodeTags(output.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: data,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return &blobstore.ExistsResponse{
+				Exists: false,
+			}, nil
+		}
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{
+		Exists: true,
+	}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if _, err := c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	}); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if rerr, ok := err.(awserr.RequestFailure); ok {
+		statusCode := rerr.StatusCode()
+		if statusCode == http.StatusTooManyRequests ||
+			(statusCode >= http.StatusInternalServerError && statusCode != http.StatusNotImplemented) {
+			return true
+		}
+	}
+	return request.IsErrorRetryable(err) || request.IsErrorThrottle(err)
+}
+
+func (c *client) objectKey(key string) string {
+	if len(c.prefix) == 0 {
+		return key
+	}
+	return path.Join(c.prefix, key)
+}
+
+func isNotFoundError(err error) bool {
+	if rerr, ok := err.(awserr.RequestFailure); ok {
+		return rerr.StatusCode() == http.StatusNotFound
+	}
+	return false
+}
+
+func encodeTags(tags map[string]string) (string, error) {
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func decodeTags(metadata map[string]*string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for key, value := range metadata {
+		if !strings.EqualFold(key, tagsMetadataKey) || value == nil {
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(*value)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &tags); err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
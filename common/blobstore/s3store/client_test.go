@@ -0,0 +1,234 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	ClientSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		server *fakeS3Server
+		client *client
+	}
+
+	// fakeS3Server is a minimal in memory S3 server serving path style object requests
+	fakeS3Server struct {
+		sync.Mutex
+
+		server   *httptest.Server
+		objects  map[string]*fakeS3Object
+		failures int // number of the next requests failing with 503
+	}
+
+	fakeS3Object struct {
+		body     []byte
+		metadata http.Header
+	}
+)
+
+const (
+	testBucket = "cadence-blobs"
+	testPrefix = "scanner"
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.server = newFakeS3Server()
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+		Endpoint:         aws.String(s.server.server.URL),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+		MaxRetries:       aws.Int(0),
+	})
+	s.NoError(err)
+	s.client = newClient(s3.New(sess), testBucket, testPrefix)
+}
+
+func (s *ClientSuite) TearDownTest() {
+	s.server.server.Close()
+}
+
+func (s *ClientSuite) TestNewS3Client_InvalidConfig() {
+	_, err := NewS3Client(nil)
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Region: "us-east-1"})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestCrudOperations() {
+	ctx := context.Background()
+
+	key1 := uuid.New()
+	key2 := uuid.New()
+	blob1 := blobstore.Blob{
+		Tags: nil,
+		Body: []byte{1, 2, 3},
+	}
+	blob2 := blobstore.Blob{
+		Tags: map[string]string{"Key1": "value1", "key2": "välue2"},
+		Body: []byte{1, 2, 3, 4, 5},
+	}
+	_, err := s.client.Put(ctx, &blobstore.PutRequest{Key: key1, Blob: blob1})
+	s.NoError(err)
+	_, err = s.client.Put(ctx, &blobstore.PutRequest{Key: key2, Blob: blob2})
+	s.NoError(err)
+	s.Contains(s.server.objects, "/"+testBucket+"/"+testPrefix+"/"+key1)
+
+	get1, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key1})
+	s.NoError(err)
+	s.Nil(get1.Blob.Tags)
+	s.Equal(blob1.Body, get1.Blob.Body)
+	get2, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key2})
+	s.NoError(err)
+	s.Equal(blob2.Tags, get2.Blob.Tags)
+	s.Equal(blob2.Body, get2.Blob.Body)
+
+	exists, err := s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key1})
+	s.NoError(err)
+	s.True(exists.Exists)
+
+	_, err = s.client.Delete(ctx, &blobstore.DeleteRequest{Key: key1})
+	s.NoError(err)
+	exists, err = s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key1})
+	s.NoError(err)
+	s.False(exists.Exists)
+	get1, err = s.client.Get(ctx, &blobstore.GetRequest{Key: key1})
+	s.Error(err)
+	s.False(s.client.IsRetryableError(err))
+	s.Nil(get1)
+}
+
+func (s *ClientSuite) TestRetryableClient() {
+	ctx := context.Background()
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(3)
+	retryableClient := blobstore.NewRetryableClient(s.client, policy)
+
+	s.server.fail(2)
+	_, err := retryableClient.Put(ctx, &blobstore.PutRequest{
+		Key:  "key",
+		Blob: blobstore.Blob{Body: []byte{1}},
+	})
+	s.NoError(err)
+
+	s.server.fail(5)
+	_, err = retryableClient.Get(ctx, &blobstore.GetRequest{Key: "key"})
+	s.Error(err)
+	s.True(s.client.IsRetryableError(err))
+}
+
+func newFakeS3Server() *fakeS3Server {
+	s := &fakeS3Server{
+		objects: make(map[string]*fakeS3Object),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeS3Server) fail(count int) {
+	s.Lock()
+	defer s.Unlock()
+	s.failures = count
+}
+
+func (s *fakeS3Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		writeS3Error(w, http.StatusServiceUnavailable, "SlowDown")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		metadata := make(http.Header)
+		for key, values := range r.Header {
+			if strings.HasPrefix(key, "X-Amz-Meta-") {
+				metadata[key] = values
+			}
+		}
+		s.objects[r.URL.Path] = &fakeS3Object{
+			body:     body,
+			metadata: metadata,
+		}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		object, ok := s.objects[r.URL.Path]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		for key, values := range object.metadata {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(object.body)
+		}
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, statusCode int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	w.Write([]byte("<Error><Code>" + code + "</Code><Message>" + code + "</Message></Error>"))
+}
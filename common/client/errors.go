@@ -0,0 +1,67 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	cshared "go.uber.org/cadence/.gen/go/shared"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// ToServiceError converts the errors of a cadence client, which are the ones of the client
+// library, to the ones of the service. It is used by the service components that run
+// workflows in the system domains.
+func ToServiceError(err error) error {
+	switch err := err.(type) {
+	case *cshared.BadRequestError:
+		return &types.BadRequestError{Message: err.Message}
+	case *cshared.EntityNotExistsError:
+		return &types.EntityNotExistsError{
+			Message:        err.Message,
+			CurrentCluster: err.GetCurrentCluster(),
+			ActiveCluster:  err.GetActiveCluster(),
+		}
+	case *cshared.WorkflowExecutionAlreadyStartedError:
+		return &types.WorkflowExecutionAlreadyStartedError{
+			Message:        err.GetMessage(),
+			StartRequestID: err.GetStartRequestId(),
+			RunID:          err.GetRunId(),
+		}
+	case *cshared.WorkflowExecutionAlreadyCompletedError:
+		return &types.WorkflowExecutionAlreadyCompletedError{Message: err.Message}
+	case *cshared.QueryFailedError:
+		return &types.QueryFailedError{Message: err.Message}
+	case *cshared.DomainNotActiveError:
+		return &types.DomainNotActiveError{
+			Message:        err.Message,
+			DomainName:     err.DomainName,
+			CurrentCluster: err.CurrentCluster,
+			ActiveCluster:  err.ActiveCluster,
+		}
+	case *cshared.ServiceBusyError:
+		return &types.ServiceBusyError{Message: err.Message}
+	case *cshared.LimitExceededError:
+		return &types.LimitExceededError{Message: err.Message}
+	case *cshared.InternalServiceError:
+		return &types.InternalServiceError{Message: err.Message}
+	}
+	return err
+}
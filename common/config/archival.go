@@ -21,7 +21,9 @@
 package config
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
 
 	"github.com/uber/cadence/common"
 )
@@ -38,6 +40,17 @@ func (a *Archival) Validate(domainDefaults *ArchivalDomainDefaults) error {
 		}
 	}
 
+	switch a.History.Compression {
+	case "", "gzip", "zstd":
+	default:
+		return fmt.Errorf("invalid history archival config, unknown compression: %v", a.History.Compression)
+	}
+	if a.History.Encryption != nil {
+		if _, _, err := a.History.Encryption.GetKeys(); err != nil {
+			return fmt.Errorf("invalid history archival config, %v", err)
+		}
+	}
+
 	if a.Visibility.Status == common.ArchivalEnabled {
 		if domainDefaults.Visibility.URI == "" || a.Visibility.Provider == nil {
 			return errors.New("invalid visibility archival config, must provide domainDefaults.Visibility.URI and Provider")
@@ -50,3 +63,41 @@ func (a *Archival) Validate(domainDefaults *ArchivalDomainDefaults) error {
 
 	return nil
 }
+
+// GetKeys returns the decoded domain keys and default key, the default key is nil if it's not configured
+func (e *ArchivalEncryption) GetKeys() (map[string][]byte, []byte, error) {
+	domainKeys := make(map[string][]byte, len(e.DomainKeys))
+	for domainID, encodedKey := range e.DomainKeys {
+		key, err := decodeArchivalEncryptionKey(encodedKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid encryption key for domain %v: %v", domainID, err)
+		}
+		domainKeys[domainID] = key
+	}
+
+	var defaultKey []byte
+	if e.DefaultKey != "" {
+		key, err := decodeArchivalEncryptionKey(e.DefaultKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid default encryption key: %v", err)
+		}
+		defaultKey = key
+	}
+	if len(domainKeys) == 0 && defaultKey == nil {
+		return nil, nil, errors.New("no encryption key is configured")
+	}
+	return domainKeys, defaultKey, nil
+}
+
+func decodeArchivalEncryptionKey(encodedKey string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, err
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("key size must be 16, 24 or 32 bytes, got %v", len(key))
+	}
+}
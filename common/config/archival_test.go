@@ -153,3 +153,39 @@ func TestValidEmptyVisibilityArchivalConfig(t *testing.T) {
 	err := archival.Validate(&ArchivalDomainDefaults{})
 	require.NoError(t, err)
 }
+
+func TestHistoryArchivalCompressionConfig(t *testing.T) {
+	archival := Archival{
+		History: HistoryArchival{
+			Compression: "zstd",
+		},
+	}
+	require.NoError(t, archival.Validate(&ArchivalDomainDefaults{}))
+
+	archival.History.Compression = "lz4"
+	require.Error(t, archival.Validate(&ArchivalDomainDefaults{}))
+}
+
+func TestHistoryArchivalEncryptionConfig(t *testing.T) {
+	archival := Archival{
+		History: HistoryArchival{
+			Encryption: &ArchivalEncryption{
+				DefaultKey: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+				DomainKeys: map[string]string{
+					"domain-id": "AAAAAAAAAAAAAAAAAAAAAA==",
+				},
+			},
+		},
+	}
+	require.NoError(t, archival.Validate(&ArchivalDomainDefaults{}))
+	domainKeys, defaultKey, err := archival.History.Encryption.GetKeys()
+	require.NoError(t, err)
+	require.Len(t, defaultKey, 32)
+	require.Len(t, domainKeys["domain-id"], 16)
+
+	archival.History.Encryption.DomainKeys["domain-id"] = "AAAA"
+	require.Error(t, archival.Validate(&ArchivalDomainDefaults{}))
+
+	archival.History.Encryption = &ArchivalEncryption{}
+	require.Error(t, archival.Validate(&ArchivalDomainDefaults{}))
+}
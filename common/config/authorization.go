@@ -22,13 +22,25 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/cristalhq/jwt/v3"
 )
 
 // Validate validates the persistence config
 func (a *Authorization) Validate() error {
-	if a.OAuthAuthorizer.Enable && a.NoopAuthorizer.Enable {
+	enabled := 0
+	for _, enable := range []bool{
+		a.OAuthAuthorizer.Enable,
+		a.NoopAuthorizer.Enable,
+		a.RBACAuthorizer.Enable,
+		a.ExternalAuthorizer.Enable,
+	} {
+		if enable {
+			enabled++
+		}
+	}
+	if enabled > 1 {
 		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
 	}
 
@@ -38,6 +50,18 @@ func (a *Authorization) Validate() error {
 		}
 	}
 
+	if a.RBACAuthorizer.Enable {
+		if rbacError := a.validateRBAC(); rbacError != nil {
+			return rbacError
+		}
+	}
+
+	if a.ExternalAuthorizer.Enable {
+		if externalError := a.validateExternal(); externalError != nil {
+			return externalError
+		}
+	}
+
 	return nil
 }
 
@@ -55,3 +79,54 @@ func (a *Authorization) validateOAuth() error {
 	}
 	return nil
 }
+
+func (a *Authorization) validateRBAC() error {
+	rbacConfig := a.RBACAuthorizer
+
+	if rbacConfig.MaxJwtTTL <= 0 {
+		return fmt.Errorf("[RBACConfig] MaxTTL must be greater than 0")
+	}
+	if rbacConfig.JwtCredentials.PublicKey == "" {
+		return fmt.Errorf("[RBACConfig] PublicKey can't be empty")
+	}
+	if rbacConfig.JwtCredentials.Algorithm != jwt.RS256.String() {
+		return fmt.Errorf("[RBACConfig] The only supported Algorithm is RS256")
+	}
+	for name, role := range rbacConfig.Roles {
+		switch role.Permission {
+		case "read", "write", "admin":
+		default:
+			return fmt.Errorf("[RBACConfig] Role %v has invalid permission %q", name, role.Permission)
+		}
+	}
+	for _, binding := range rbacConfig.ClusterBindings {
+		if binding.Principal == "" || binding.Role == "" {
+			return fmt.Errorf("[RBACConfig] Cluster binding must have both principal and role")
+		}
+	}
+	return nil
+}
+
+func (a *Authorization) validateExternal() error {
+	externalConfig := a.ExternalAuthorizer
+
+	u, err := url.Parse(externalConfig.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("[ExternalAuthorizerConfig] URL must be a valid http(s) URL")
+	}
+	if externalConfig.Timeout < 0 || externalConfig.CacheTTL < 0 || externalConfig.CacheSize < 0 {
+		return fmt.Errorf("[ExternalAuthorizerConfig] Timeout, CacheTTL and CacheSize can't be negative")
+	}
+	if externalConfig.JwtCredentials != nil {
+		if externalConfig.MaxJwtTTL <= 0 {
+			return fmt.Errorf("[ExternalAuthorizerConfig] MaxTTL must be greater than 0")
+		}
+		if externalConfig.JwtCredentials.PublicKey == "" {
+			return fmt.Errorf("[ExternalAuthorizerConfig] PublicKey can't be empty")
+		}
+		if externalConfig.JwtCredentials.Algorithm != jwt.RS256.String() {
+			return fmt.Errorf("[ExternalAuthorizerConfig] The only supported Algorithm is RS256")
+		}
+	}
+	return nil
+}
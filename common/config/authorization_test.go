@@ -22,6 +22,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -112,3 +113,83 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestRBACWithOAuthEnabled(t *testing.T) {
+	cfg := Authorization{
+		OAuthAuthorizer: OAuthAuthorizer{
+			Enable: true,
+		},
+		RBACAuthorizer: RBACAuthorizer{
+			Enable: true,
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[AuthorizationConfig] More than one authorizer is enabled")
+}
+
+func TestRBACRoleIsInvalid(t *testing.T) {
+	cfg := Authorization{
+		RBACAuthorizer: RBACAuthorizer{
+			Enable: true,
+			JwtCredentials: JwtCredentials{
+				Algorithm: "RS256",
+				PublicKey: "public",
+			},
+			MaxJwtTTL: 1000000,
+			Roles: map[string]RBACRole{
+				"operator": {Permission: "superuser"},
+			},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, `[RBACConfig] Role operator has invalid permission "superuser"`)
+}
+
+func TestRBACCorrectValidation(t *testing.T) {
+	cfg := Authorization{
+		RBACAuthorizer: RBACAuthorizer{
+			Enable: true,
+			JwtCredentials: JwtCredentials{
+				Algorithm: "RS256",
+				PublicKey: "public",
+			},
+			MaxJwtTTL: 1000000,
+			Roles: map[string]RBACRole{
+				"operator": {Permission: "write", APIs: []string{"SignalWorkflowExecution"}},
+			},
+			ClusterBindings: []RBACRoleBinding{
+				{Principal: "group:cadence-admins", Role: "admin"},
+			},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.NoError(t, err)
+}
+
+func TestExternalURLIsInvalid(t *testing.T) {
+	cfg := Authorization{
+		ExternalAuthorizer: ExternalAuthorizer{
+			Enable: true,
+			URL:    "127.0.0.1:8181",
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[ExternalAuthorizerConfig] URL must be a valid http(s) URL")
+}
+
+func TestExternalCorrectValidation(t *testing.T) {
+	cfg := Authorization{
+		ExternalAuthorizer: ExternalAuthorizer{
+			Enable:   true,
+			URL:      "http://127.0.0.1:8181/v1/data/cadence/allow",
+			CacheTTL: time.Second,
+		},
+	}
+
+	err := cfg.Validate()
+	assert.NoError(t, err)
+}
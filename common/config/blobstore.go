@@ -0,0 +1,50 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+)
+
+// Validate validates the blobstore config
+func (b *Blobstore) Validate() error {
+	configured := 0
+	if b.Filestore != nil {
+		configured++
+	}
+	if b.S3 != nil {
+		configured++
+		if b.S3.Bucket == "" {
+			return errors.New("invalid s3 blobstore config, must provide bucket")
+		}
+	}
+	if b.GCS != nil {
+		configured++
+		if b.GCS.Bucket == "" {
+			return errors.New("invalid gcs blobstore config, must provide bucket")
+		}
+	}
+
+	if configured > 1 {
+		return errors.New("invalid blobstore config, at most one of filestore, s3 and gcs can be configured")
+	}
+	return nil
+}
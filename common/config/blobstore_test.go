@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidBlobstoreConfig(t *testing.T) {
+	for _, blobstore := range []Blobstore{
+		{},
+		{Filestore: &FileBlobstore{OutputDirectory: "/tmp/blobstore"}},
+		{S3: &S3Blobstore{Bucket: "cadence-blobs", Region: "us-east-1"}},
+		{GCS: &GCSBlobstore{Bucket: "cadence-blobs"}},
+	} {
+		require.NoError(t, blobstore.Validate())
+	}
+}
+
+func TestInvalidBlobstoreConfig(t *testing.T) {
+	for _, blobstore := range []Blobstore{
+		{S3: &S3Blobstore{Region: "us-east-1"}},
+		{GCS: &GCSBlobstore{Prefix: "scanner"}},
+		{
+			Filestore: &FileBlobstore{OutputDirectory: "/tmp/blobstore"},
+			S3:        &S3Blobstore{Bucket: "cadence-blobs"},
+		},
+	} {
+		require.Error(t, blobstore.Validate())
+	}
+}
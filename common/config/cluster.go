@@ -68,6 +68,8 @@ type (
 		RPCTransport string `yaml:"rpcTransport"`
 		// AuthorizationProvider contains the information to authorize the cluster
 		AuthorizationProvider AuthorizationProvider `yaml:"authorizationProvider"`
+		// TLS configures the client TLS of the connections to this cluster, it requires the grpc transport
+		TLS TLS `yaml:"tls"`
 	}
 
 	AuthorizationProvider struct {
@@ -135,6 +137,9 @@ func (m *ClusterGroupMetadata) Validate() error {
 			errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc transport must %v or %v",
 				clusterName, tchannel.TransportName, grpc.TransportName))
 		}
+		if info.TLS.Enabled && info.RPCTransport != grpc.TransportName {
+			errs = multierr.Append(errs, fmt.Errorf("cluster %v: tls requires %v rpc transport", clusterName, grpc.TransportName))
+		}
 	}
 	if len(versionToClusterName) != len(m.ClusterGroup) {
 		errs = multierr.Append(errs, errors.New("initial versions of the cluster group have duplicates"))
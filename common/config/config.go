@@ -22,6 +22,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"time"
 
@@ -52,6 +53,8 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Kafka is the config for connecting to kafka
 		Kafka KafkaConfig `yaml:"kafka"`
+		// Messaging is the config for choosing the messaging client, kafka is used by default
+		Messaging Messaging `yaml:"messaging"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
 		// PublicClient is config for sys worker service connecting to cadence frontend
@@ -75,6 +78,9 @@ type (
 	Authorization struct {
 		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
 		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
+		RBACAuthorizer  RBACAuthorizer  `yaml:"rbacAuthorizer"`
+		// ExternalAuthorizer delegates the decisions to an external policy decision point
+		ExternalAuthorizer ExternalAuthorizer `yaml:"externalAuthorizer"`
 	}
 
 	DynamicConfig struct {
@@ -95,6 +101,70 @@ type (
 		MaxJwtTTL int64 `yaml:"maxJwtTTL"`
 	}
 
+	// RBACAuthorizer is the config for the role based authorizer.
+	// Principals are identified by the JWT passed in the request header, and get their roles
+	// from the bindings stored in the domain data and the cluster level bindings below.
+	RBACAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// Credentials to verify the JWT
+		JwtCredentials JwtCredentials `yaml:"jwtCredentials"`
+		// Max of TTL in the claim
+		MaxJwtTTL int64 `yaml:"maxJwtTTL"`
+		// Roles defines custom roles in addition to the built-in reader, writer and admin roles
+		Roles map[string]RBACRole `yaml:"roles"`
+		// ClusterBindings are role bindings that apply to every domain and to the APIs which are not domain scoped
+		ClusterBindings []RBACRoleBinding `yaml:"clusterBindings"`
+	}
+
+	// RBACRole defines a role of the RBACAuthorizer
+	RBACRole struct {
+		// Permission is the highest permission granted by the role, one of read, write or admin
+		Permission string `yaml:"permission"`
+		// APIs optionally restricts the role to the listed API names
+		APIs []string `yaml:"apis"`
+	}
+
+	// RBACRoleBinding binds a role to a principal.
+	// Principal is either user:<jwt sub> or group:<jwt group>.
+	// When WorkflowTypes or TaskLists are set, the binding only applies to requests
+	// carrying one of the listed workflow types or task lists. Only the requests of
+	// StartWorkflowExecution and SignalWithStartWorkflowExecution carry the workflow type,
+	// and only those and the requests of PollForActivityTask, PollForDecisionTask,
+	// DescribeTaskList and ListTaskListPartitions carry the task list, so the role of
+	// such a binding must be restricted to these APIs.
+	RBACRoleBinding struct {
+		Principal     string   `yaml:"principal" json:"principal"`
+		Role          string   `yaml:"role" json:"role"`
+		WorkflowTypes []string `yaml:"workflowTypes" json:"workflowTypes,omitempty"`
+		TaskLists     []string `yaml:"taskLists" json:"taskLists,omitempty"`
+	}
+
+	// ExternalAuthorizer is the config for the authorizer sending the request attributes
+	// to an external policy decision point (PDP), e.g. an OPA sidecar
+	ExternalAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// URL of the decision endpoint, e.g. http://127.0.0.1:8181/v1/data/cadence/allow.
+		// Only http and https are supported, gRPC decision endpoints aren't.
+		URL string `yaml:"url"`
+		// Timeout of a decision request, defaults to 1s
+		Timeout time.Duration `yaml:"timeout"`
+		// CacheTTL is how long the decisions are cached, caching is disabled if not set
+		CacheTTL time.Duration `yaml:"cacheTTL"`
+		// CacheSize is the max number of cached decisions, defaults to 10000
+		CacheSize int `yaml:"cacheSize"`
+		// FailOpen allows the requests when the PDP is unavailable or returns an invalid answer.
+		// By default the requests are denied.
+		FailOpen bool `yaml:"failOpen"`
+		// ForwardToken sends the authorization token of the request to the PDP
+		ForwardToken bool `yaml:"forwardToken"`
+		// JwtCredentials verifies the JWT of the requests, the subject and the groups of a verified JWT
+		// are sent to the PDP as the actor of the request. Without a JWT, the actor is the identity of
+		// the verified TLS client certificate of the request, if any.
+		JwtCredentials *JwtCredentials `yaml:"jwtCredentials"`
+		// MaxJwtTTL is the max TTL in the claims of the JWT
+		MaxJwtTTL int64 `yaml:"maxJwtTTL"`
+	}
+
 	JwtCredentials struct {
 		// support: RS256 (RSA using SHA256)
 		Algorithm string `yaml:"algorithm"`
@@ -136,11 +206,20 @@ type (
 		LogLevel string `yaml:"logLevel"`
 		// GRPCMaxMsgSize allows overriding default (4MB) message size for gRPC
 		GRPCMaxMsgSize int `yaml:"grpcMaxMsgSize"`
+		// TLS configures the TLS of the gRPC inbound, and of the gRPC outbounds to the other services of the cluster
+		// which then use the same certificate as client certificate. TChannel does not support TLS: TLS requires
+		// GRPCPort, and dynamic config system.enableGRPCOutbound must be set as the TChannel outbounds are rejected.
+		TLS TLS `yaml:"tls"`
+		// GossipPort is the port on which the membership gossip will bind to,
+		// it is only used by the memberlist membership provider
+		GossipPort int `yaml:"gossipPort"`
 	}
 
-	// Blobstore contains the config for blobstore
+	// Blobstore contains the config for blobstore, at most one of the backends can be configured
 	Blobstore struct {
 		Filestore *FileBlobstore `yaml:"filestore"`
+		S3        *S3Blobstore   `yaml:"s3"`
+		GCS       *GCSBlobstore  `yaml:"gcs"`
 	}
 
 	// FileBlobstore contains the config for a file backed blobstore
@@ -148,10 +227,38 @@ type (
 		OutputDirectory string `yaml:"outputDirectory"`
 	}
 
+	// S3Blobstore contains the config for a S3 compatible object storage backed blobstore
+	S3Blobstore struct {
+		// Bucket is the name of the bucket where blobs are stored, the bucket must already exist
+		Bucket string `yaml:"bucket"`
+		// Prefix is prepended to the key of every blob
+		Prefix string `yaml:"prefix"`
+		Region string `yaml:"region"`
+		// Endpoint overrides the AWS endpoint, e.g. to use a MinIO deployment
+		Endpoint         *string `yaml:"endpoint"`
+		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+	}
+
+	// GCSBlobstore contains the config for a Google Cloud Storage backed blobstore
+	GCSBlobstore struct {
+		// Bucket is the name of the bucket where blobs are stored, the bucket must already exist
+		Bucket string `yaml:"bucket"`
+		// Prefix is prepended to the key of every blob
+		Prefix string `yaml:"prefix"`
+		// CredentialsPath is the path of the service account key file,
+		// the default application credentials are used if not set
+		CredentialsPath string `yaml:"credentialsPath"`
+		// Endpoint overrides the Google Cloud Storage endpoint
+		Endpoint string `yaml:"endpoint"`
+	}
+
 	// Ringpop contains the ringpop config items
 	Ringpop struct {
 		// Name to be used in ringpop advertisement
 		Name string `yaml:"name" validate:"nonzero"`
+		// Provider is the membership provider, either ringpop (default) or memberlist.
+		// When memberlist is used, the bootstrap hosts are the gossip addresses of the seed members.
+		Provider string `yaml:"provider"`
 		// BootstrapMode is a enum that defines the ringpop bootstrap method
 		BootstrapMode BootstrapMode `yaml:"bootstrapMode"`
 		// BootstrapHosts is a list of seed hosts to be used for ringpop bootstrap
@@ -209,19 +316,19 @@ type (
 
 	// NoSQL contains configuration to connect to NoSQL Database cluster
 	NoSQL struct {
-		// PluginName is the name of NoSQL plugin, default is "cassandra". Supported values: cassandra
+		// PluginName is the name of NoSQL plugin, default is "cassandra". Supported values: cassandra, dynamodb
 		PluginName string `yaml:"pluginName"`
-		// Hosts is a csv of cassandra endpoints
+		// Hosts is a csv of cassandra endpoints. For dynamodb, it's the endpoint of the service(only the first one is used)
 		Hosts string `yaml:"hosts" validate:"nonzero"`
 		// Port is the cassandra port used for connection by gocql client
 		Port int `yaml:"port"`
-		// User is the cassandra user used for authentication by gocql client
+		// User is the cassandra user used for authentication by gocql client. For dynamodb, it's the AWS access key ID
 		User string `yaml:"user"`
-		// Password is the cassandra password used for authentication by gocql client
+		// Password is the cassandra password used for authentication by gocql client. For dynamodb, it's the AWS secret access key
 		Password string `yaml:"password"`
-		// Keyspace is the cassandra keyspace
+		// Keyspace is the cassandra keyspace. For dynamodb, it's used as the prefix of table names
 		Keyspace string `yaml:"keyspace"`
-		// Region is the region filter arg for cassandra
+		// Region is the region filter arg for cassandra. For dynamodb, it's the AWS region, default is us-east-1
 		Region string `yaml:"region"`
 		// Datacenter is the data center filter arg for cassandra
 		Datacenter string `yaml:"datacenter"`
@@ -246,9 +353,9 @@ type (
 		Password string `yaml:"password"`
 		// PluginName is the name of SQL plugin
 		PluginName string `yaml:"pluginName" validate:"nonzero"`
-		// DatabaseName is the name of SQL database to connect to
+		// DatabaseName is the name of SQL database to connect to. For sqlite, it's the path of the database file
 		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
-		// ConnectAddr is the remote addr of the database
+		// ConnectAddr is the remote addr of the database, not used by sqlite
 		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
 		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix
 		ConnectProtocol string `yaml:"connectProtocol" validate:"nonzero"`
@@ -347,6 +454,19 @@ type (
 		EnableRead bool `yaml:"enableRead"`
 		// Provider contains the config for all history archivers
 		Provider *HistoryArchiverProvider `yaml:"provider"`
+		// Compression is the compression of archived histories: gzip, zstd or empty for no compression
+		Compression string `yaml:"compression"`
+		// Encryption contains the keys for encrypting archived histories, histories are not encrypted if it's nil
+		Encryption *ArchivalEncryption `yaml:"encryption"`
+	}
+
+	// ArchivalEncryption contains the keys for client side encryption of archives.
+	// Keys are base64 encoded AES keys of 16, 24 or 32 bytes.
+	ArchivalEncryption struct {
+		// DefaultKey is the key for domains without a key in DomainKeys, domains without a key can't archive if it's empty
+		DefaultKey string `yaml:"defaultKey"`
+		// DomainKeys are the keys for each domain, keyed by domain ID
+		DomainKeys map[string]string `yaml:"domainKeys"`
 	}
 
 	// HistoryArchiverProvider contains the config for all history archivers
@@ -354,6 +474,9 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		// Custom contains the config of the history archivers registered through
+		// provider.RegisterHistoryArchiver, keyed by their URI scheme
+		Custom map[string]*YamlNode `yaml:",inline"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -371,6 +494,9 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		// Custom contains the config of the visibility archivers registered through
+		// provider.RegisterVisibilityArchiver, keyed by their URI scheme
+		Custom map[string]*YamlNode `yaml:",inline"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -450,6 +576,17 @@ func (c *Config) validate() error {
 	if err := c.Archival.Validate(&c.DomainDefaults.Archival); err != nil {
 		return err
 	}
+	if err := c.Blobstore.Validate(); err != nil {
+		return err
+	}
+	if err := c.Messaging.Validate(); err != nil {
+		return err
+	}
+	for name, service := range c.Services {
+		if err := service.RPC.Validate(); err != nil {
+			return fmt.Errorf("service %v: %v", name, err)
+		}
+	}
 
 	return c.Authorization.Validate()
 }
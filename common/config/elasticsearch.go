@@ -23,10 +23,18 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/uber/cadence/common"
 )
 
+const (
+	// ESIndexingModeKafka means history publishes visibility records to Kafka, and the worker indexer writes them to ElasticSearch
+	ESIndexingModeKafka = "kafka"
+	// ESIndexingModeDirect means history writes visibility records to ElasticSearch directly
+	ESIndexingModeDirect = "direct"
+)
+
 var errAWSSigningCredential = fmt.Errorf("must provide exactly one type of credential, EnvironmentCredential or StaticCredential")
 
 // ElasticSearchConfig for connecting to ElasticSearch
@@ -34,7 +42,7 @@ type (
 	ElasticSearchConfig struct {
 		URL     url.URL           `yaml:"url"`     //nolint:govet
 		Indices map[string]string `yaml:"indices"` //nolint:govet
-		// supporting v6 and v7. Default to v6 if empty.
+		// supporting v6, v7, v8 and opensearch. Detected from the cluster info endpoint if empty.
 		Version string `yaml:"version"` //nolint:govet
 		// optional username to communicate with ElasticSearch
 		Username string `yaml:"username"` //nolint:govet
@@ -50,6 +58,24 @@ type (
 		AWSSigning AWSSigning `yaml:"awsSigning"`
 		// optional to use Signed Certificates over https
 		TLS TLS `yaml:"tls"`
+		// optional indexing mode of visibility records, kafka or direct. Default to kafka if empty.
+		// Both modes write documents with the transfer task ID as external version,
+		// so switching modes with records in flight won't overwrite newer documents with older ones.
+		IndexingMode string `yaml:"indexingMode"`
+		// optional settings of the bulk writer used by history in direct indexing mode
+		DirectIndexing DirectIndexingConfig `yaml:"directIndexing"`
+	}
+
+	// DirectIndexingConfig contains the settings of the bulk writer for direct indexing
+	DirectIndexingConfig struct {
+		// number of concurrent bulk requests. Default to 1 if empty.
+		NumOfWorkers int `yaml:"numOfWorkers"`
+		// max number of requests in a bulk. Default to 1000 if empty.
+		BulkActions int `yaml:"bulkActions"`
+		// max total size of a bulk in bytes. Default to 16MB if empty.
+		BulkSize int `yaml:"bulkSize"`
+		// max time a request waits in the bulk before being flushed. Default to 100ms if empty.
+		FlushInterval time.Duration `yaml:"flushInterval"`
 	}
 
 	// AWSSigning contains config to enable signing,
@@ -93,6 +119,21 @@ func (cfg *ElasticSearchConfig) SetUsernamePassword() {
 	}
 }
 
+// IsDirectIndexing returns whether visibility records are written to ElasticSearch without Kafka
+func (cfg *ElasticSearchConfig) IsDirectIndexing() bool {
+	return cfg != nil && cfg.IndexingMode == ESIndexingModeDirect
+}
+
+// Validate validates the ElasticSearch config
+func (cfg *ElasticSearchConfig) Validate() error {
+	switch cfg.IndexingMode {
+	case "", ESIndexingModeKafka, ESIndexingModeDirect:
+		return nil
+	default:
+		return fmt.Errorf("unknown indexing mode: %v", cfg.IndexingMode)
+	}
+}
+
 // CheckAWSSigningConfig checks if the AWSSigning configuration is valid
 func CheckAWSSigningConfig(config AWSSigning) error {
 	if config.EnvironmentCredential == nil && config.StaticCredential == nil {
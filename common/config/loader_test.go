@@ -133,6 +133,48 @@ func (s *LoaderSuite) TestInvalidPath() {
 	s.NotNil(err)
 }
 
+func (s *LoaderSuite) TestCustomArchiverProviderConfig() {
+	dir, err := ioutil.TempDir("", "loader.testCustomArchiverProviderConfig")
+	s.Nil(err)
+	defer os.RemoveAll(dir)
+
+	s.createFile(dir, "base.yaml", `
+history:
+  provider:
+    filestore:
+      fileMode: "0666"
+    hdfs:
+      nameNode: "hdfs://namenode:8020"
+      replication: 3
+visibility:
+  provider:
+    hdfs:
+      nameNode: "hdfs://namenode:8020"`)
+
+	type hdfsArchiver struct {
+		NameNode    string `yaml:"nameNode"`
+		Replication int    `yaml:"replication"`
+	}
+
+	var cfg Archival
+	err = Load("", dir, "", &cfg)
+	s.Nil(err)
+	s.Equal("0666", cfg.History.Provider.Filestore.FileMode)
+	s.Len(cfg.History.Provider.Custom, 1)
+	s.Len(cfg.Visibility.Provider.Custom, 1)
+
+	var historyConfig hdfsArchiver
+	s.NoError(cfg.History.Provider.Custom["hdfs"].Decode(&historyConfig))
+	s.Equal(hdfsArchiver{NameNode: "hdfs://namenode:8020", Replication: 3}, historyConfig)
+
+	var visibilityConfig hdfsArchiver
+	s.NoError(cfg.Visibility.Provider.Custom["hdfs"].Decode(&visibilityConfig))
+	s.Equal(hdfsArchiver{NameNode: "hdfs://namenode:8020"}, visibilityConfig)
+
+	var emptyNode *YamlNode
+	s.NoError(emptyNode.Decode(&visibilityConfig))
+}
+
 func (s *LoaderSuite) createFile(dir string, file string, content string) {
 	err := ioutil.WriteFile(path(dir, file), []byte(content), fileMode)
 	s.Nil(err)
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MessagingClientKafka is the messaging client backed by kafka
+	MessagingClientKafka = "kafka"
+	// MessagingClientPersistenceQueue is the messaging client backed by the queue tables of the default persistence store
+	MessagingClientPersistenceQueue = "persistenceQueue"
+)
+
+type (
+	// Messaging describes the messaging client used by the visibility pipeline
+	Messaging struct {
+		// Client is the messaging client, kafka or persistenceQueue. Default to kafka if empty.
+		Client string `yaml:"client"`
+		// PersistenceQueue is the config of the persistenceQueue messaging client
+		PersistenceQueue PersistenceQueueMessaging `yaml:"persistenceQueue"`
+	}
+
+	// PersistenceQueueMessaging describes how consumers poll the persistence queue
+	PersistenceQueueMessaging struct {
+		// PollInterval is the interval to poll the queue when there is no new message. Default to 1s if empty.
+		PollInterval time.Duration `yaml:"pollInterval"`
+		// BatchSize is the max number of messages read per poll. Default to 100 if empty.
+		BatchSize int `yaml:"batchSize"`
+		// AckLevelUpdateInterval is the interval to persist consumer ack levels. Default to 5s if empty.
+		AckLevelUpdateInterval time.Duration `yaml:"ackLevelUpdateInterval"`
+		// Consumers are the consumer names of each application. Messages are only deleted once all the consumers
+		// of the application acked them, so a consumer must be declared before the messages it needs are written.
+		Consumers map[string][]string `yaml:"consumers"`
+	}
+)
+
+// IsPersistenceQueue returns whether the messaging client is backed by the persistence queue
+func (m *Messaging) IsPersistenceQueue() bool {
+	return m.Client == MessagingClientPersistenceQueue
+}
+
+// Validate validates the messaging config
+func (m *Messaging) Validate() error {
+	switch m.Client {
+	case "", MessagingClientKafka, MessagingClientPersistenceQueue:
+		return nil
+	default:
+		return fmt.Errorf("unknown messaging client: %v", m.Client)
+	}
+}
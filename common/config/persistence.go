@@ -83,6 +83,12 @@ func (c *Persistence) Validate() error {
 		}
 	}
 
+	if ds, ok := c.DataStores[c.AdvancedVisibilityStore]; ok && ds.ElasticSearch != nil {
+		if err := ds.ElasticSearch.Validate(); err != nil {
+			return fmt.Errorf("persistence config: datastore %v: %v", c.AdvancedVisibilityStore, err)
+		}
+	}
+
 	for _, st := range dbStoreKeys {
 		ds, ok := c.DataStores[st]
 		if !ok {
@@ -59,6 +59,13 @@ const (
 	BootstrapModeDNS
 )
 
+const (
+	// MembershipProviderRingpop is the membership provider gossiping over TChannel with ringpop
+	MembershipProviderRingpop = "ringpop"
+	// MembershipProviderMemberlist is the membership provider gossiping on a dedicated port with memberlist
+	MembershipProviderMemberlist = "memberlist"
+)
+
 const (
 	defaultMaxJoinDuration = 10 * time.Second
 )
@@ -76,6 +83,7 @@ type RingpopFactory struct {
 	config      *Ringpop
 	dispatcher  *yarpc.Dispatcher
 	serviceName string
+	gossipPort  int
 	logger      log.Logger
 
 	sync.Mutex
@@ -84,20 +92,27 @@ type RingpopFactory struct {
 }
 
 // NewFactory builds a ringpop factory conforming
-// to the underlying configuration
+// to the underlying configuration, the gossip port
+// is only used by the memberlist provider
 func (rpConfig *Ringpop) NewFactory(
 	dispatcher *yarpc.Dispatcher,
 	serviceName string,
+	gossipPort int,
 	logger log.Logger,
 ) (*RingpopFactory, error) {
 
-	return newRingpopFactory(rpConfig, dispatcher, serviceName, logger)
+	return newRingpopFactory(rpConfig, dispatcher, serviceName, gossipPort, logger)
 }
 
 func (rpConfig *Ringpop) validate() error {
 	if len(rpConfig.Name) == 0 {
 		return fmt.Errorf("ringpop config missing `name` param")
 	}
+	switch rpConfig.Provider {
+	case "", MembershipProviderRingpop, MembershipProviderMemberlist:
+	default:
+		return fmt.Errorf("ringpop config with unknown membership provider %q", rpConfig.Provider)
+	}
 	return validateBootstrapMode(rpConfig)
 }
 
@@ -161,12 +176,16 @@ func newRingpopFactory(
 	rpConfig *Ringpop,
 	dispatcher *yarpc.Dispatcher,
 	serviceName string,
+	gossipPort int,
 	logger log.Logger,
 ) (*RingpopFactory, error) {
 
 	if err := rpConfig.validate(); err != nil {
 		return nil, err
 	}
+	if rpConfig.Provider == MembershipProviderMemberlist && gossipPort == 0 {
+		return nil, fmt.Errorf("memberlist membership provider requires the gossip port of the %v service", serviceName)
+	}
 	if rpConfig.MaxJoinDuration == 0 {
 		rpConfig.MaxJoinDuration = defaultMaxJoinDuration
 	}
@@ -174,6 +193,7 @@ func newRingpopFactory(
 		config:      rpConfig,
 		dispatcher:  dispatcher,
 		serviceName: serviceName,
+		gossipPort:  gossipPort,
 		logger:      logger,
 	}, nil
 }
@@ -200,6 +220,10 @@ func (factory *RingpopFactory) getMembership() (membership.Monitor, error) {
 }
 
 func (factory *RingpopFactory) createMembership() (membership.Monitor, error) {
+	if factory.config.Provider == MembershipProviderMemberlist {
+		return factory.createMemberlistMembership()
+	}
+
 	// use actual listen port (in case service is bound to :0 or 0.0.0.0:0)
 	rp, err := factory.getRingpop()
 	if err != nil {
@@ -210,6 +234,30 @@ func (factory *RingpopFactory) createMembership() (membership.Monitor, error) {
 	return membershipMonitor, nil
 }
 
+func (factory *RingpopFactory) createMemberlistMembership() (membership.Monitor, error) {
+	discoveryProvider, err := newDiscoveryProvider(factory.config, factory.logger)
+	if err != nil {
+		return nil, err
+	}
+	// members are named after their rpc address, which is only known once the dispatcher is started
+	addressProvider := func() (string, error) {
+		ch, err := factory.getChannel(factory.dispatcher)
+		if err != nil {
+			return "", err
+		}
+		return ch.PeerInfo().HostPort, nil
+	}
+	ml := membership.NewMemberlist(
+		factory.config.Name,
+		factory.gossipPort,
+		discoveryProvider,
+		factory.config.MaxJoinDuration,
+		addressProvider,
+		factory.logger,
+	)
+	return membership.NewMemberlistMonitor(factory.serviceName, CadenceServices, ml, factory.logger), nil
+}
+
 func (factory *RingpopFactory) getRingpop() (*membership.RingPop, error) {
 	if factory.ringPop != nil {
 		return factory.ringPop, nil
@@ -231,7 +279,12 @@ func (factory *RingpopFactory) createRingpop() (*membership.RingPop, error) {
 		return nil, err
 	}
 
-	rp, err := ringpop.New(factory.config.Name, ringpop.Channel(ch))
+	rp, err := ringpop.New(
+		factory.config.Name,
+		ringpop.Channel(ch),
+		// the frontend hosts publish their demand per quota key in a label
+		ringpop.LabelLimitValueSize(membership.DemandLabelMaxSize),
+	)
 	if err != nil {
 		return nil, err
 	}
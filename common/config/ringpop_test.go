@@ -57,11 +57,27 @@ func (s *RingpopSuite) TestHostsMode() {
 	s.Equal(time.Second*30, cfg.MaxJoinDuration)
 	err = cfg.validate()
 	s.Nil(err)
-	f, err := cfg.NewFactory(nil, "test", loggerimpl.NewNopLogger())
+	f, err := cfg.NewFactory(nil, "test", 0, loggerimpl.NewNopLogger())
 	s.Nil(err)
 	s.NotNil(f)
 }
 
+func (s *RingpopSuite) TestMemberlistProvider() {
+	var cfg Ringpop
+	err := yaml.Unmarshal([]byte(getMemberlistConfig()), &cfg)
+	s.Nil(err)
+	s.Equal(MembershipProviderMemberlist, cfg.Provider)
+	s.Nil(cfg.validate())
+	_, err = cfg.NewFactory(nil, "test", 0, loggerimpl.NewNopLogger())
+	s.NotNil(err)
+	f, err := cfg.NewFactory(nil, "test", 7946, loggerimpl.NewNopLogger())
+	s.Nil(err)
+	s.NotNil(f)
+
+	cfg.Provider = "unknown"
+	s.NotNil(cfg.validate())
+}
+
 func (s *RingpopSuite) TestFileMode() {
 	var cfg Ringpop
 	err := yaml.Unmarshal([]byte(getJSONConfig()), &cfg)
@@ -72,7 +88,7 @@ func (s *RingpopSuite) TestFileMode() {
 	s.Equal(time.Second*30, cfg.MaxJoinDuration)
 	err = cfg.validate()
 	s.Nil(err)
-	f, err := cfg.NewFactory(nil, "test", loggerimpl.NewNopLogger())
+	f, err := cfg.NewFactory(nil, "test", 0, loggerimpl.NewNopLogger())
 	s.Nil(err)
 	s.NotNil(f)
 }
@@ -86,7 +102,7 @@ func (s *RingpopSuite) TestCustomMode() {
 	s.NotNil(cfg.validate())
 	cfg.DiscoveryProvider = statichosts.New("127.0.0.1")
 	s.Nil(cfg.validate())
-	f, err := cfg.NewFactory(nil, "test", loggerimpl.NewNopLogger())
+	f, err := cfg.NewFactory(nil, "test", 0, loggerimpl.NewNopLogger())
 	s.Nil(err)
 	s.NotNil(f)
 }
@@ -111,7 +127,7 @@ func (s *RingpopSuite) TestDNSMode() {
 	s.Equal(BootstrapModeDNS, cfg.BootstrapMode)
 	s.Nil(cfg.validate())
 	logger := loggerimpl.NewNopLogger()
-	f, err := cfg.NewFactory(nil, "test", logger)
+	f, err := cfg.NewFactory(nil, "test", 0, logger)
 	s.Nil(err)
 	s.NotNil(f)
 
@@ -190,6 +206,14 @@ bootstrapHosts: ["127.0.0.1:1111"]
 maxJoinDuration: 30s`
 }
 
+func getMemberlistConfig() string {
+	return `name: "test"
+provider: "memberlist"
+bootstrapMode: "hosts"
+bootstrapHosts: ["127.0.0.1:7946"]
+maxJoinDuration: 30s`
+}
+
 func getCustomConfig() string {
 	return `name: "test"
 bootstrapMode: "custom"
@@ -21,6 +21,7 @@
 package config
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -29,19 +30,27 @@ import (
 
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/peer"
+	"go.uber.org/yarpc/peer/hostport"
 	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 )
 
+var (
+	errTChannelTLS = errors.New("TChannel does not support TLS, set system.enableGRPCOutbound to talk to the other services over gRPC")
+)
+
 // RPCFactory is an implementation of service.RPCFactory interface
 type RPCFactory struct {
 	config      *RPC
 	serviceName string
 	ch          *tchannel.ChannelTransport
 	grpc        *grpc.Transport
+	clientTLS   *tls.Config
 	logger      log.Logger
 	grpcPorts   GRPCPorts
 
@@ -49,6 +58,14 @@ type RPCFactory struct {
 	dispatcher *yarpc.Dispatcher
 }
 
+// Validate validates the rpc config, TLS is rejected without a gRPC port as TChannel does not support TLS
+func (cfg *RPC) Validate() error {
+	if cfg.TLS.Enabled && cfg.GRPCPort <= 0 {
+		return errors.New("[RPCConfig] TLS requires grpcPort to be set, TChannel does not support TLS")
+	}
+	return nil
+}
+
 // NewFactory builds a new RPCFactory
 // conforming to the underlying configuration
 func (cfg *RPC) NewFactory(sName string, logger log.Logger, grpcPorts GRPCPorts) *RPCFactory {
@@ -56,7 +73,12 @@ func (cfg *RPC) NewFactory(sName string, logger log.Logger, grpcPorts GRPCPorts)
 }
 
 func newRPCFactory(cfg *RPC, sName string, logger log.Logger, grpcPorts GRPCPorts) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger, grpcPorts: grpcPorts}
+	// the other services of the cluster share the TLS configuration of this service
+	clientTLS, err := cfg.TLS.ToTLSConfig()
+	if err != nil {
+		logger.Fatal("Failed to create GRPC outbound TLS config", tag.Error(err))
+	}
+	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger, grpcPorts: grpcPorts, clientTLS: clientTLS}
 	return factory
 }
 
@@ -102,7 +124,17 @@ func (d *RPCFactory) createInboundDispatcher() *yarpc.Dispatcher {
 			d.logger.Fatal("Failed to listen on GRPC port", tag.Error(err))
 		}
 
-		inbounds = append(inbounds, d.grpc.NewInbound(listener))
+		var inboundOptions []grpc.InboundOption
+		serverTLS, err := d.config.TLS.ToServerTLSConfig()
+		if err != nil {
+			d.logger.Fatal("Failed to create GRPC inbound TLS config", tag.Error(err))
+		}
+		if serverTLS != nil {
+			inboundOptions = append(inboundOptions, grpc.InboundCredentials(credentials.NewTLS(serverTLS)))
+			d.logger.Info("TLS is enabled for GRPC requests")
+		}
+
+		inbounds = append(inbounds, d.grpc.NewInbound(listener, inboundOptions...))
 		d.logger.Info("Listening for GRPC requests", tag.Address(grpcAddress))
 	}
 
@@ -112,12 +144,16 @@ func (d *RPCFactory) createInboundDispatcher() *yarpc.Dispatcher {
 	})
 }
 
-// CreateDispatcherForOutbound creates a dispatcher for outbound connection
+// CreateDispatcherForOutbound creates a dispatcher for outbound connection,
+// it fails if TLS is enabled as TChannel does not support TLS
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName string,
 	serviceName string,
 	hostName string,
 ) (*yarpc.Dispatcher, error) {
+	if d.clientTLS != nil {
+		return nil, errTChannelTLS
+	}
 	return d.createOutboundDispatcher(callerName, serviceName, hostName, d.ch.NewSingleOutbound(hostName))
 }
 
@@ -127,7 +163,15 @@ func (d *RPCFactory) CreateGRPCDispatcherForOutbound(
 	serviceName string,
 	hostName string,
 ) (*yarpc.Dispatcher, error) {
-	return d.createOutboundDispatcher(callerName, serviceName, hostName, d.grpc.NewSingleOutbound(hostName))
+	return d.createOutboundDispatcher(callerName, serviceName, hostName, d.newGRPCOutbound(hostName))
+}
+
+func (d *RPCFactory) newGRPCOutbound(hostName string) transport.UnaryOutbound {
+	if d.clientTLS == nil {
+		return d.grpc.NewSingleOutbound(hostName)
+	}
+	dialer := d.grpc.NewDialer(grpc.DialerCredentials(credentials.NewTLS(d.clientTLS)))
+	return d.grpc.NewOutbound(peer.NewSingle(hostport.PeerIdentifier(hostName), dialer))
 }
 
 // ReplaceGRPCPort replaces port in the address to grpc for a given service
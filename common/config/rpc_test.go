@@ -51,3 +51,9 @@ func TestGRPCPorts(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, grpcAddress, "1.2.3.4:9999")
 }
+
+func TestRPCValidate(t *testing.T) {
+	assert.NoError(t, (&RPC{}).Validate())
+	assert.NoError(t, (&RPC{GRPCPort: 7833, TLS: TLS{Enabled: true}}).Validate())
+	assert.EqualError(t, (&RPC{TLS: TLS{Enabled: true}}).Validate(), "[RPCConfig] TLS requires grpcPort to be set, TChannel does not support TLS")
+}
@@ -20,8 +20,19 @@
 
 package config
 
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
 type (
-	// TLS describe TLS configuration (for Kafka, Cassandra, SQL)
+	// TLS describe TLS configuration (for Kafka, Cassandra, SQL, ElasticSearch and RPC)
 	TLS struct {
 		Enabled bool `yaml:"enabled"`
 
@@ -40,8 +51,184 @@ type (
 		// If you want to verify the hostname and server cert (like a wildcard for cass cluster) then you should turn this on
 		// This option is basically the inverse of InSecureSkipVerify
 		// See InSecureSkipVerify in http://golang.org/pkg/crypto/tls/ for more info
+		// For RPC the server cert is always verified, this option only turns on the verification of the hostname.
 		EnableHostVerification bool `yaml:"enableHostVerification"`
 
 		ServerName string `yaml:"serverName"`
+
+		// RequireClientAuth makes a server require and verify the client certificates against the CaFile,
+		// it is only used for the TLS of the RPC servers
+		RequireClientAuth bool `yaml:"requireClientAuth"`
+	}
+
+	// certificateReloader loads a certificate and reloads it whenever its files are modified,
+	// so that rotated certificates are used by the new connections without a restart
+	certificateReloader struct {
+		certFile string
+		keyFile  string
+
+		sync.Mutex
+		cert        *tls.Certificate
+		certModTime time.Time
+		keyModTime  time.Time
 	}
 )
+
+// ToTLSConfig builds the client side TLS configuration, or returns nil if TLS is not enabled.
+// The certificate chain of the server is always verified against the CaFile, or the system roots if it is not set,
+// and its host name is verified as well if EnableHostVerification is set, as the other hosts of the cluster
+// are usually dialed by IP address. The client certificate is reloaded when its files are rotated.
+func (config TLS) ToTLSConfig() (*tls.Config, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: config.ServerName,
+	}
+	if config.CaFile != "" {
+		caCertPool, err := loadCertPool(config.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+	if !config.EnableHostVerification {
+		// the default verification is replaced by the verification of the certificate chain only
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = verifyCertificateChain(tlsConfig.RootCAs)
+	}
+	if config.CertFile != "" && config.KeyFile != "" {
+		reloader, err := newCertificateReloader(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return reloader.getCertificate(), nil
+		}
+	}
+	return tlsConfig, nil
+}
+
+// ToServerTLSConfig builds the server side TLS configuration, or returns nil if TLS is not enabled.
+// The server certificate is reloaded when its files are rotated.
+func (config TLS) ToServerTLSConfig() (*tls.Config, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, errors.New("server TLS requires both certFile and keyFile")
+	}
+
+	reloader, err := newCertificateReloader(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return reloader.getCertificate(), nil
+		},
+	}
+	if config.RequireClientAuth {
+		if config.CaFile == "" {
+			return nil, errors.New("server TLS requires caFile to verify the client certificates")
+		}
+		caCertPool, err := loadCertPool(config.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = caCertPool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// verifyCertificateChain returns the verification of the peer certificate chain against the roots,
+// or the system roots if roots is nil, without verifying the host name
+func verifyCertificateChain(roots *x509.CertPool) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("no certificate presented by the server")
+		}
+		certs := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, rawCert := range rawCerts {
+			cert, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+		})
+		return err
+	}
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pemData, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(pemData) {
+		return nil, fmt.Errorf("no valid certificate found in %v", caFile)
+	}
+	return caCertPool, nil
+}
+
+func newCertificateReloader(certFile string, keyFile string) (*certificateReloader, error) {
+	reloader := &certificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := reloader.reload(); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+// getCertificate returns the latest certificate, the previous certificate is kept
+// if the rotated files cannot be loaded, e.g. when only one of them is written yet
+func (r *certificateReloader) getCertificate() *tls.Certificate {
+	r.Lock()
+	defer r.Unlock()
+
+	_ = r.reloadLocked()
+	return r.cert
+}
+
+func (r *certificateReloader) reload() error {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.reloadLocked()
+}
+
+func (r *certificateReloader) reloadLocked() error {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return err
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return err
+	}
+	if r.cert != nil && certInfo.ModTime().Equal(r.certModTime) && keyInfo.ModTime().Equal(r.keyModTime) {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.cert = &cert
+	r.certModTime = certInfo.ModTime()
+	r.keyModTime = keyInfo.ModTime()
+	return nil
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	tlsSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		dir    string
+		caCert *x509.Certificate
+		caKey  *ecdsa.PrivateKey
+	}
+)
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(tlsSuite))
+}
+
+func (s *tlsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "cadence-tls-test")
+	s.NoError(err)
+
+	s.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &s.caKey.PublicKey, s.caKey)
+	s.NoError(err)
+	s.caCert, err = x509.ParseCertificate(der)
+	s.NoError(err)
+	s.writePEM("ca.pem", "CERTIFICATE", der)
+}
+
+func (s *tlsSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *tlsSuite) TestDisabled() {
+	cfg, err := TLS{}.ToTLSConfig()
+	s.NoError(err)
+	s.Nil(cfg)
+	cfg, err = TLS{}.ToServerTLSConfig()
+	s.NoError(err)
+	s.Nil(cfg)
+}
+
+func (s *tlsSuite) TestServerConfigValidation() {
+	s.issueCertificate("server", 2)
+
+	_, err := TLS{Enabled: true, CertFile: s.path("server.pem")}.ToServerTLSConfig()
+	s.Error(err)
+	_, err = TLS{Enabled: true, CertFile: s.path("server.pem"), KeyFile: s.path("server.key"), RequireClientAuth: true}.ToServerTLSConfig()
+	s.Error(err)
+	_, err = TLS{Enabled: true, CertFile: s.path("missing.pem"), KeyFile: s.path("server.key")}.ToServerTLSConfig()
+	s.Error(err)
+}
+
+func (s *tlsSuite) TestMutualTLS() {
+	s.issueCertificate("server", 2)
+	s.issueCertificate("client", 3)
+
+	serverConfig, err := TLS{
+		Enabled:           true,
+		CertFile:          s.path("server.pem"),
+		KeyFile:           s.path("server.key"),
+		CaFile:            s.path("ca.pem"),
+		RequireClientAuth: true,
+	}.ToServerTLSConfig()
+	s.NoError(err)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	s.NoError(err)
+	defer listener.Close()
+	go s.acceptConnections(listener)
+
+	clientConfig, err := TLS{
+		Enabled:                true,
+		CertFile:               s.path("client.pem"),
+		KeyFile:                s.path("client.key"),
+		CaFile:                 s.path("ca.pem"),
+		EnableHostVerification: true,
+		ServerName:             "server",
+	}.ToTLSConfig()
+	s.NoError(err)
+	s.Equal(big.NewInt(2), s.handshake(listener.Addr().String(), clientConfig))
+
+	// the client certificate is required
+	noCertConfig, err := TLS{Enabled: true, CaFile: s.path("ca.pem"), EnableHostVerification: true, ServerName: "server"}.ToTLSConfig()
+	s.NoError(err)
+	conn, err := tls.Dial("tcp", listener.Addr().String(), noCertConfig)
+	if err == nil {
+		// with TLS 1.3 the client certificate is verified after the client handshake completes
+		_, err = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	s.Error(err)
+
+	// the rotated server certificate is used by the new connections
+	s.issueCertificate("server", 4)
+	future := time.Now().Add(time.Minute)
+	s.NoError(os.Chtimes(s.path("server.pem"), future, future))
+	s.NoError(os.Chtimes(s.path("server.key"), future, future))
+	s.Equal(big.NewInt(4), s.handshake(listener.Addr().String(), clientConfig))
+}
+
+func (s *tlsSuite) TestServerVerification() {
+	s.issueCertificate("server", 2)
+
+	serverConfig, err := TLS{
+		Enabled:  true,
+		CertFile: s.path("server.pem"),
+		KeyFile:  s.path("server.key"),
+	}.ToServerTLSConfig()
+	s.NoError(err)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	s.NoError(err)
+	defer listener.Close()
+	go s.acceptConnections(listener)
+
+	// the certificate chain is verified without the host name, as the server is dialed by IP address
+	clientConfig, err := TLS{Enabled: true, CaFile: s.path("ca.pem")}.ToTLSConfig()
+	s.NoError(err)
+	s.Equal(big.NewInt(2), s.handshake(listener.Addr().String(), clientConfig))
+
+	// the host name is verified with host verification
+	hostConfig, err := TLS{Enabled: true, CaFile: s.path("ca.pem"), EnableHostVerification: true, ServerName: "other"}.ToTLSConfig()
+	s.NoError(err)
+	_, err = tls.Dial("tcp", listener.Addr().String(), hostConfig)
+	s.Error(err)
+
+	// the server certificate isn't trusted by the system roots
+	systemConfig, err := TLS{Enabled: true}.ToTLSConfig()
+	s.NoError(err)
+	_, err = tls.Dial("tcp", listener.Addr().String(), systemConfig)
+	s.Error(err)
+}
+
+func (s *tlsSuite) handshake(address string, config *tls.Config) *big.Int {
+	conn, err := tls.Dial("tcp", address, config)
+	s.NoError(err)
+	defer conn.Close()
+	s.NoError(conn.Handshake())
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber
+}
+
+func (s *tlsSuite) acceptConnections(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			_ = conn.(*tls.Conn).Handshake()
+			_, _ = conn.Write([]byte{0})
+		}()
+	}
+}
+
+func (s *tlsSuite) issueCertificate(name string, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, &key.PublicKey, s.caKey)
+	s.NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	s.NoError(err)
+	s.writePEM(name+".pem", "CERTIFICATE", der)
+	s.writePEM(name+".key", "EC PRIVATE KEY", keyDER)
+}
+
+func (s *tlsSuite) writePEM(file string, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	s.NoError(ioutil.WriteFile(s.path(file), data, 0600))
+}
+
+func (s *tlsSuite) path(file string) string {
+	return filepath.Join(s.dir, file)
+}
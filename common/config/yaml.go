@@ -0,0 +1,45 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+type (
+	// YamlNode holds a config block whose structure is only known by the component consuming it,
+	// e.g. the config of an archiver linked into the server build. The block is decoded lazily by Decode.
+	YamlNode struct {
+		unmarshal func(interface{}) error
+	}
+)
+
+// UnmarshalYAML is called by the yaml package to keep the config block for later decoding
+func (n *YamlNode) UnmarshalYAML(
+	unmarshal func(interface{}) error,
+) error {
+	n.unmarshal = unmarshal
+	return nil
+}
+
+// Decode decodes the config block into out, it's a no-op for an empty node
+func (n *YamlNode) Decode(out interface{}) error {
+	if n == nil || n.unmarshal == nil {
+		return nil
+	}
+	return n.unmarshal(out)
+}
@@ -97,6 +97,8 @@ const (
 const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName = "visibility"
+	// DomainReplicationAppName is used to find the queue of the domain replication tasks
+	DomainReplicationAppName = "domain-replication"
 )
 
 // This was flagged by salus as potentially hardcoded credentials. This is a false positive by the scanner and should be
@@ -118,6 +120,10 @@ const (
 	// BatcherLocalDomainName is domain name for batcher workflows running in local cluster
 	// Batcher cannot use SystemLocalDomain because auth
 	BatcherLocalDomainName = "cadence-batcher"
+	// SchedulerDomainID is domain id for scheduler local domain
+	SchedulerDomainID = "dae3b808-7a05-4b6f-9e87-59088b6a3539"
+	// SchedulerLocalDomainName is domain name for schedule workflows running in local cluster
+	SchedulerLocalDomainName = "cadence-scheduler"
 	// ShadowerDomainID is domain id for workflow shadower local domain
 	ShadowerDomainID = "59c51119-1b41-4a28-986d-d6e377716f82"
 	// ShadowerLocalDomainName
@@ -173,6 +179,8 @@ const (
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
+	// DomainDataKeyForRoleBindings stores the JSON encoded role bindings used by the RBAC authorizer
+	DomainDataKeyForRoleBindings = "ROLE_BINDINGS"
 )
 
 type (
@@ -35,8 +35,7 @@ const (
 	DynamicConfigNopClient         = "nop"
 )
 
-// Client allows fetching values from a dynamic configuration system NOTE: This does not have async
-// options right now. In the interest of keeping it minimal, we can add when requirement arises.
+// Client allows fetching values from a dynamic configuration system, and subscribing to their changes
 type Client interface {
 	GetValue(name Key, defaultValue interface{}) (interface{}, error)
 	GetValueWithFilters(name Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error)
@@ -51,10 +50,34 @@ type Client interface {
 	GetDurationValue(
 		name Key, filters map[Filter]interface{}, defaultValue time.Duration,
 	) (time.Duration, error)
-	// UpdateValue takes value as map and updates by overriding. It doesn't support update with filters.
+	// UpdateValue updates the value of the key. The config store client takes a list of values and upserts
+	// each of them: a value replaces the existing value with the same filters, the other values are kept.
+	// An empty list removes the key.
 	UpdateValue(name Key, value interface{}) error
+	// RestoreValue removes the value which has exactly the given filters, so the key falls back to
+	// the less specific values. Nil filters remove the value without filters.
 	RestoreValue(name Key, filters map[Filter]interface{}) error
 	ListValue(name Key) ([]*types.DynamicConfigEntry, error)
+	// Subscribe registers the callback to be called once the value of the key for the given filters changes.
+	// It returns the function which cancels the subscription.
+	Subscribe(name Key, filters map[Filter]interface{}, callback SubscriptionCallback) (func(), error)
+}
+
+// HistoryClient is implemented by the clients which keep the previous values of the keys
+type HistoryClient interface {
+	// ListValueHistory returns the versions in which the values of the key changed, the latest first.
+	// At most maxCount of the latest versions are inspected.
+	ListValueHistory(name Key, maxCount int) ([]*ValueRevision, error)
+	// RollbackValue sets the values of the key back to the ones it had in the given version
+	RollbackValue(name Key, version int64) error
+}
+
+// ValueRevision is the values of a key at a given version of the dynamic config
+type ValueRevision struct {
+	Version   int64
+	Timestamp time.Time
+	// Values is empty if the key was not set in this version
+	Values []*types.DynamicConfigValue
 }
 
 var NotFoundError = &types.EntityNotExistsError{
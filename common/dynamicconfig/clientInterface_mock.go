@@ -220,3 +220,70 @@ func (mr *MockClientMockRecorder) ListValue(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValue", reflect.TypeOf((*MockClient)(nil).ListValue), name)
 }
+
+// Subscribe mocks base method
+func (m *MockClient) Subscribe(name Key, filters map[Filter]interface{}, callback SubscriptionCallback) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", name, filters, callback)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockClientMockRecorder) Subscribe(name, filters, callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockClient)(nil).Subscribe), name, filters, callback)
+}
+
+// MockHistoryClient is a mock of HistoryClient interface
+type MockHistoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryClientMockRecorder
+}
+
+// MockHistoryClientMockRecorder is the mock recorder for MockHistoryClient
+type MockHistoryClientMockRecorder struct {
+	mock *MockHistoryClient
+}
+
+// NewMockHistoryClient creates a new mock instance
+func NewMockHistoryClient(ctrl *gomock.Controller) *MockHistoryClient {
+	mock := &MockHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockHistoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockHistoryClient) EXPECT() *MockHistoryClientMockRecorder {
+	return m.recorder
+}
+
+// ListValueHistory mocks base method
+func (m *MockHistoryClient) ListValueHistory(name Key, maxCount int) ([]*ValueRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListValueHistory", name, maxCount)
+	ret0, _ := ret[0].([]*ValueRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListValueHistory indicates an expected call of ListValueHistory
+func (mr *MockHistoryClientMockRecorder) ListValueHistory(name, maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValueHistory", reflect.TypeOf((*MockHistoryClient)(nil).ListValueHistory), name, maxCount)
+}
+
+// RollbackValue mocks base method
+func (m *MockHistoryClient) RollbackValue(name Key, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValue", name, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackValue indicates an expected call of RollbackValue
+func (mr *MockHistoryClientMockRecorder) RollbackValue(name, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValue", reflect.TypeOf((*MockHistoryClient)(nil).RollbackValue), name, version)
+}
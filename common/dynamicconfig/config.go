@@ -35,6 +35,9 @@ import (
 
 const (
 	errCountLogThreshold = 1000
+
+	// DefaultValueDriftMessage is logged when the default value given for a key differs from the registered one
+	DefaultValueDriftMessage = "Dynamic config default value differs from the registered default value"
 )
 
 // NewCollection creates a new collection
@@ -48,6 +51,7 @@ func NewCollection(
 		client:        client,
 		logger:        logger,
 		logKeys:       &sync.Map{},
+		driftKeys:     &sync.Map{},
 		errCount:      -1,
 		filterOptions: filterOptions,
 	}
@@ -60,6 +64,7 @@ type Collection struct {
 	client        Client
 	logger        log.Logger
 	logKeys       *sync.Map // map of config Keys for logging to capture changes
+	driftKeys     *sync.Map // map of config Keys whose default value differs from the registered one
 	errCount      int64
 	filterOptions []FilterOption
 }
@@ -104,6 +109,77 @@ func (c *Collection) logValue(
 	}
 }
 
+// registeredDefault returns the default value given by the caller, and logs a warning if it differs from the
+// default value declared in the registry so that the drift is caught. The keys whose default value depends on
+// the static config or on the caller don't declare a default value.
+func (c *Collection) registeredDefault(key Key, defaultValue interface{}) interface{} {
+	if definition, ok := keyDefinitions[key]; ok && definition.DefaultValue != nil {
+		c.checkDefault(key, defaultValue, definition.DefaultValue, reflect.DeepEqual)
+	}
+	return defaultValue
+}
+
+func (c *Collection) intDefault(key Key, defaultValue int) int {
+	if value, ok := toFloat(getRegisteredDefault(key)); ok {
+		c.checkDefault(key, defaultValue, int(value), intCompareEquals)
+	}
+	return defaultValue
+}
+
+func (c *Collection) floatDefault(key Key, defaultValue float64) float64 {
+	if value, ok := toFloat(getRegisteredDefault(key)); ok {
+		c.checkDefault(key, defaultValue, value, float64CompareEquals)
+	}
+	return defaultValue
+}
+
+func (c *Collection) durationDefault(key Key, defaultValue time.Duration) time.Duration {
+	if value, ok := getRegisteredDefault(key).(time.Duration); ok {
+		c.checkDefault(key, defaultValue, value, durationCompareEquals)
+	}
+	return defaultValue
+}
+
+func (c *Collection) boolDefault(key Key, defaultValue bool) bool {
+	if value, ok := getRegisteredDefault(key).(bool); ok {
+		c.checkDefault(key, defaultValue, value, boolCompareEquals)
+	}
+	return defaultValue
+}
+
+func (c *Collection) stringDefault(key Key, defaultValue string) string {
+	if value, ok := getRegisteredDefault(key).(string); ok {
+		c.checkDefault(key, defaultValue, value, stringCompareEquals)
+	}
+	return defaultValue
+}
+
+func (c *Collection) mapDefault(key Key, defaultValue map[string]interface{}) map[string]interface{} {
+	if value, ok := getRegisteredDefault(key).(map[string]interface{}); ok {
+		c.checkDefault(key, defaultValue, value, reflect.DeepEqual)
+	}
+	return defaultValue
+}
+
+// checkDefault logs a warning once per key when the default value given by the caller differs from the registered one
+func (c *Collection) checkDefault(
+	key Key,
+	defaultValue, registeredValue interface{},
+	cmpValueEquals func(interface{}, interface{}) bool,
+) {
+	if cmpValueEquals(defaultValue, registeredValue) {
+		return
+	}
+	if _, logged := c.driftKeys.LoadOrStore(key, struct{}{}); !logged {
+		c.logger.Warn(DefaultValueDriftMessage,
+			tag.Key(key.String()), tag.Value(defaultValue), tag.DefaultValue(registeredValue))
+	}
+}
+
+func getRegisteredDefault(key Key) interface{} {
+	return keyDefinitions[key].DefaultValue
+}
+
 // PropertyFn is a wrapper to get property from dynamic config
 type PropertyFn func() interface{}
 
@@ -161,8 +237,13 @@ type BoolPropertyFnWithDomainIDFilter func(domainID string) bool
 // BoolPropertyFnWithTaskListInfoFilters is a wrapper to get bool property from dynamic config with three filters: domain, taskList, taskType
 type BoolPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) bool
 
+// IntPropertySubscriptionFn is a wrapper to subscribe to the changes of an int property from dynamic config.
+// It returns the function which cancels the subscription.
+type IntPropertySubscriptionFn func(callback func(int)) (func(), error)
+
 // GetProperty gets a interface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
+	defaultValue = c.registeredDefault(key, defaultValue)
 	return func() interface{} {
 		val, err := c.client.GetValue(key, defaultValue)
 		if err != nil {
@@ -175,6 +256,7 @@ func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
 
 // GetIntProperty gets property and asserts that it's an integer
 func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
+	defaultValue = c.intDefault(key, defaultValue)
 	return func(opts ...FilterOption) int {
 		filters := c.toFilterMap(opts...)
 		val, err := c.client.GetIntValue(
@@ -192,6 +274,7 @@ func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
 
 // GetIntPropertyFilteredByDomain gets property with domain filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByDomain(key Key, defaultValue int) IntPropertyFnWithDomainFilter {
+	defaultValue = c.intDefault(key, defaultValue)
 	return func(domain string) int {
 		filters := c.toFilterMap(DomainFilter(domain))
 		val, err := c.client.GetIntValue(
@@ -209,6 +292,7 @@ func (c *Collection) GetIntPropertyFilteredByDomain(key Key, defaultValue int) I
 
 // GetIntPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByTaskListInfo(key Key, defaultValue int) IntPropertyFnWithTaskListInfoFilters {
+	defaultValue = c.intDefault(key, defaultValue)
 	return func(domain string, taskList string, taskType int) int {
 		filters := c.toFilterMap(
 			DomainFilter(domain),
@@ -230,6 +314,7 @@ func (c *Collection) GetIntPropertyFilteredByTaskListInfo(key Key, defaultValue
 
 // GetIntPropertyFilteredByShardID gets property with shardID as filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue int) IntPropertyFnWithShardIDFilter {
+	defaultValue = c.intDefault(key, defaultValue)
 	return func(shardID int) int {
 		filters := c.toFilterMap(ShardIDFilter(shardID))
 		val, err := c.client.GetIntValue(
@@ -247,6 +332,7 @@ func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue int)
 
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatPropertyFn {
+	defaultValue = c.floatDefault(key, defaultValue)
 	return func(opts ...FilterOption) float64 {
 		filters := c.toFilterMap(opts...)
 		val, err := c.client.GetFloatValue(
@@ -264,6 +350,7 @@ func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatProp
 
 // GetFloat64PropertyFilteredByShardID gets property with shardID filter and asserts that it's a float64
 func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue float64) FloatPropertyFnWithShardIDFilter {
+	defaultValue = c.floatDefault(key, defaultValue)
 	return func(shardID int) float64 {
 		filters := c.toFilterMap(ShardIDFilter(shardID))
 		val, err := c.client.GetFloatValue(
@@ -281,6 +368,7 @@ func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue f
 
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
+	defaultValue = c.durationDefault(key, defaultValue)
 	return func(opts ...FilterOption) time.Duration {
 		filters := c.toFilterMap(opts...)
 		val, err := c.client.GetDurationValue(
@@ -298,6 +386,7 @@ func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) Du
 
 // GetDurationPropertyFilteredByDomain gets property with domain filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByDomain(key Key, defaultValue time.Duration) DurationPropertyFnWithDomainFilter {
+	defaultValue = c.durationDefault(key, defaultValue)
 	return func(domain string) time.Duration {
 		filters := c.toFilterMap(DomainFilter(domain))
 		val, err := c.client.GetDurationValue(
@@ -315,6 +404,7 @@ func (c *Collection) GetDurationPropertyFilteredByDomain(key Key, defaultValue t
 
 // GetDurationPropertyFilteredByDomainID gets property with domainID filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByDomainID(key Key, defaultValue time.Duration) DurationPropertyFnWithDomainIDFilter {
+	defaultValue = c.durationDefault(key, defaultValue)
 	return func(domainID string) time.Duration {
 		filters := c.toFilterMap(DomainIDFilter(domainID))
 		val, err := c.client.GetDurationValue(
@@ -332,6 +422,7 @@ func (c *Collection) GetDurationPropertyFilteredByDomainID(key Key, defaultValue
 
 // GetDurationPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByTaskListInfo(key Key, defaultValue time.Duration) DurationPropertyFnWithTaskListInfoFilters {
+	defaultValue = c.durationDefault(key, defaultValue)
 	return func(domain string, taskList string, taskType int) time.Duration {
 		filters := c.toFilterMap(
 			DomainFilter(domain),
@@ -353,6 +444,7 @@ func (c *Collection) GetDurationPropertyFilteredByTaskListInfo(key Key, defaultV
 
 // GetDurationPropertyFilteredByShardID gets property with shardID id as filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue time.Duration) DurationPropertyFnWithShardIDFilter {
+	defaultValue = c.durationDefault(key, defaultValue)
 	return func(shardID int) time.Duration {
 		filters := c.toFilterMap(ShardIDFilter(shardID))
 		val, err := c.client.GetDurationValue(
@@ -370,6 +462,7 @@ func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue
 
 // GetBoolProperty gets property and asserts that it's an bool
 func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn {
+	defaultValue = c.boolDefault(key, defaultValue)
 	return func(opts ...FilterOption) bool {
 		filters := c.toFilterMap(opts...)
 		opts = append(opts, c.filterOptions...)
@@ -388,6 +481,7 @@ func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn
 
 // GetStringProperty gets property and asserts that it's an string
 func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPropertyFn {
+	defaultValue = c.stringDefault(key, defaultValue)
 	return func(opts ...FilterOption) string {
 		filters := c.toFilterMap(opts...)
 		val, err := c.client.GetStringValue(
@@ -405,6 +499,7 @@ func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPrope
 
 // GetMapProperty gets property and asserts that it's a map
 func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}) MapPropertyFn {
+	defaultValue = c.mapDefault(key, defaultValue)
 	return func(opts ...FilterOption) map[string]interface{} {
 		filters := c.toFilterMap(opts...)
 		val, err := c.client.GetMapValue(
@@ -422,6 +517,7 @@ func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}
 
 // GetStringPropertyFilteredByDomain gets property with domain filter and asserts that it's a string
 func (c *Collection) GetStringPropertyFilteredByDomain(key Key, defaultValue string) StringPropertyFnWithDomainFilter {
+	defaultValue = c.stringDefault(key, defaultValue)
 	return func(domain string) string {
 		filters := c.toFilterMap(DomainFilter(domain))
 		val, err := c.client.GetStringValue(
@@ -439,6 +535,7 @@ func (c *Collection) GetStringPropertyFilteredByDomain(key Key, defaultValue str
 
 // GetBoolPropertyFilteredByDomain gets property with domain filter and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFilteredByDomain(key Key, defaultValue bool) BoolPropertyFnWithDomainFilter {
+	defaultValue = c.boolDefault(key, defaultValue)
 	return func(domain string) bool {
 		filters := c.toFilterMap(DomainFilter(domain))
 		val, err := c.client.GetBoolValue(
@@ -456,6 +553,7 @@ func (c *Collection) GetBoolPropertyFilteredByDomain(key Key, defaultValue bool)
 
 // GetBoolPropertyFilteredByDomainID gets property with domainID filter and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFilteredByDomainID(key Key, defaultValue bool) BoolPropertyFnWithDomainIDFilter {
+	defaultValue = c.boolDefault(key, defaultValue)
 	return func(domainID string) bool {
 		filters := c.toFilterMap(DomainIDFilter(domainID))
 		val, err := c.client.GetBoolValue(
@@ -473,6 +571,7 @@ func (c *Collection) GetBoolPropertyFilteredByDomainID(key Key, defaultValue boo
 
 // GetBoolPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's an bool
 func (c *Collection) GetBoolPropertyFilteredByTaskListInfo(key Key, defaultValue bool) BoolPropertyFnWithTaskListInfoFilters {
+	defaultValue = c.boolDefault(key, defaultValue)
 	return func(domain string, taskList string, taskType int) bool {
 		filters := c.toFilterMap(
 			DomainFilter(domain),
@@ -492,6 +591,97 @@ func (c *Collection) GetBoolPropertyFilteredByTaskListInfo(key Key, defaultValue
 	}
 }
 
+// SubscribeIntProperty calls the callback with the value of the int property each time it changes.
+// It returns the function which cancels the subscription.
+func (c *Collection) SubscribeIntProperty(key Key, defaultValue int, callback func(int), opts ...FilterOption) (func(), error) {
+	defaultValue = c.intDefault(key, defaultValue)
+	filters := c.toFilterMap(opts...)
+	return c.client.Subscribe(key, filters, func(interface{}) {
+		val, err := c.client.GetIntValue(key, filters, defaultValue)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		callback(val)
+	})
+}
+
+// GetIntPropertySubscription returns a function to subscribe to the changes of an int property
+func (c *Collection) GetIntPropertySubscription(key Key, defaultValue int, opts ...FilterOption) IntPropertySubscriptionFn {
+	return func(callback func(int)) (func(), error) {
+		return c.SubscribeIntProperty(key, defaultValue, callback, opts...)
+	}
+}
+
+// SubscribeFloat64Property calls the callback with the value of the float property each time it changes.
+// It returns the function which cancels the subscription.
+func (c *Collection) SubscribeFloat64Property(key Key, defaultValue float64, callback func(float64), opts ...FilterOption) (func(), error) {
+	defaultValue = c.floatDefault(key, defaultValue)
+	filters := c.toFilterMap(opts...)
+	return c.client.Subscribe(key, filters, func(interface{}) {
+		val, err := c.client.GetFloatValue(key, filters, defaultValue)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		callback(val)
+	})
+}
+
+// SubscribeDurationProperty calls the callback with the value of the duration property each time it changes.
+// It returns the function which cancels the subscription.
+func (c *Collection) SubscribeDurationProperty(key Key, defaultValue time.Duration, callback func(time.Duration), opts ...FilterOption) (func(), error) {
+	defaultValue = c.durationDefault(key, defaultValue)
+	filters := c.toFilterMap(opts...)
+	return c.client.Subscribe(key, filters, func(interface{}) {
+		val, err := c.client.GetDurationValue(key, filters, defaultValue)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		callback(val)
+	})
+}
+
+// SubscribeBoolProperty calls the callback with the value of the bool property each time it changes.
+// It returns the function which cancels the subscription.
+func (c *Collection) SubscribeBoolProperty(key Key, defaultValue bool, callback func(bool), opts ...FilterOption) (func(), error) {
+	defaultValue = c.boolDefault(key, defaultValue)
+	filters := c.toFilterMap(opts...)
+	return c.client.Subscribe(key, filters, func(interface{}) {
+		val, err := c.client.GetBoolValue(key, filters, defaultValue)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		callback(val)
+	})
+}
+
+// SubscribeStringProperty calls the callback with the value of the string property each time it changes.
+// It returns the function which cancels the subscription.
+func (c *Collection) SubscribeStringProperty(key Key, defaultValue string, callback func(string), opts ...FilterOption) (func(), error) {
+	defaultValue = c.stringDefault(key, defaultValue)
+	filters := c.toFilterMap(opts...)
+	return c.client.Subscribe(key, filters, func(interface{}) {
+		val, err := c.client.GetStringValue(key, filters, defaultValue)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		callback(val)
+	})
+}
+
+// SubscribeMapProperty calls the callback with the value of the map property each time it changes.
+// It returns the function which cancels the subscription.
+func (c *Collection) SubscribeMapProperty(key Key, defaultValue map[string]interface{}, callback func(map[string]interface{}), opts ...FilterOption) (func(), error) {
+	defaultValue = c.mapDefault(key, defaultValue)
+	filters := c.toFilterMap(opts...)
+	return c.client.Subscribe(key, filters, func(interface{}) {
+		val, err := c.client.GetMapValue(key, filters, defaultValue)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		callback(val)
+	})
+}
+
 func (c *Collection) toFilterMap(opts ...FilterOption) map[Filter]interface{} {
 	l := len(opts)
 	m := make(map[Filter]interface{}, l)
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -156,6 +157,46 @@ func (s *configSuite) TestGetDurationPropertyFilteredByTaskListInfo() {
 	s.Equal(time.Minute, value(domain, taskList, taskType))
 }
 
+func (s *configSuite) TestSubscribeIntProperty() {
+	client := NewInMemoryClient().(*inMemoryClient)
+	cln := NewCollection(client, log.NewNoop())
+	key := TestGetIntPropertyKey
+
+	var values []int
+	cancel, err := cln.SubscribeIntProperty(key, 10, func(value int) {
+		values = append(values, value)
+	})
+	s.NoError(err)
+
+	client.SetValue(key, 50)
+	client.SetValue(key, 50)
+	client.SetValue(TestGetFloat64PropertyKey, 0.5)
+	client.SetValue(key, 60)
+	s.Equal([]int{50, 60}, values)
+
+	cancel()
+	client.SetValue(key, 70)
+	s.Equal([]int{50, 60}, values)
+}
+
+func (s *configSuite) TestSubscribeDurationProperty() {
+	client := NewInMemoryClient().(*inMemoryClient)
+	cln := NewCollection(client, log.NewNoop())
+	key := TestGetDurationPropertyKey
+
+	var values []time.Duration
+	cancel, err := cln.SubscribeDurationProperty(key, time.Second, func(value time.Duration) {
+		values = append(values, value)
+		// the callbacks can read the current values
+		s.Equal(value, cln.GetDurationProperty(key, time.Second)())
+	})
+	s.NoError(err)
+	defer cancel()
+
+	client.SetValue(key, time.Minute)
+	s.Equal([]time.Duration{time.Minute}, values)
+}
+
 func (s *configSuite) TestGetMapProperty() {
 	key := TestGetMapPropertyKey
 	val := map[string]interface{}{
@@ -180,6 +221,31 @@ func (s *configSuite) TestUpdateConfig() {
 	s.Equal(true, value())
 }
 
+func (s *configSuite) TestRegisteredDefaultValue() {
+	logger := &log.MockLogger{}
+	logger.On("Warn", mock.Anything, mock.Anything)
+	logger.On("Info", mock.Anything, mock.Anything)
+	logger.On("Debug", mock.Anything, mock.Anything)
+	cln := NewCollection(NewInMemoryClient(), logger)
+
+	// the default value of the caller is used and its drift from the registry is only logged once
+	s.Equal(1, cln.GetIntProperty(FrontendRPS, 1)())
+	s.Equal(1, cln.GetIntPropertyFilteredByDomain(FrontendRPS, 1)("testDomain"))
+	s.Equal(1200, cln.GetIntProperty(FrontendRPS, 1200)())
+	// an untyped registered default value matches the type of the property
+	s.Equal(5.0, cln.GetFloat64Property(ReplicationTaskProcessorShardQPS, 5)())
+	// there is no drift for the keys whose default value depends on the caller
+	s.Equal(true, cln.GetBoolProperty(EnableGlobalDomain, true)())
+
+	drifts := 0
+	for _, call := range logger.Calls {
+		if call.Method == "Warn" && call.Arguments.String(0) == DefaultValueDriftMessage {
+			drifts++
+		}
+	}
+	s.Equal(1, drifts)
+}
+
 func TestDynamicConfigKeyIsMapped(t *testing.T) {
 	for i := UnknownKey; i < LastKeyForTest; i++ {
 		key, ok := Keys[i]
@@ -32,4 +32,6 @@ type ClientConfig struct {
 	UpdateRetryAttempts int           `yaml:"updateRetryAttempts"`
 	FetchTimeout        time.Duration `yaml:"FetchTimeout"`
 	UpdateTimeout       time.Duration `yaml:"UpdateTimeout"`
+	// RollbackHistorySize is how many of the latest versions can be rolled back to, defaults to 100
+	RollbackHistorySize int `yaml:"rollbackHistorySize"`
 }
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"reflect"
 	"sync/atomic"
 	"time"
 
@@ -34,15 +35,18 @@ import (
 	csc "github.com/uber/cadence/common/dynamicconfig/configstore/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql"
 	"github.com/uber/cadence/common/types"
 )
 
 var _ dc.Client = (*configStoreClient)(nil)
+var _ dc.HistoryClient = (*configStoreClient)(nil)
 
 const (
 	configStoreMinPollInterval = time.Second * 2
+	defaultRollbackHistorySize = 100
 )
 
 var defaultConfigValues = &csc.ClientConfig{
@@ -50,6 +54,7 @@ var defaultConfigValues = &csc.ClientConfig{
 	UpdateRetryAttempts: 1,
 	FetchTimeout:        2,
 	UpdateTimeout:       2,
+	RollbackHistorySize: defaultRollbackHistorySize,
 }
 
 type configStoreClient struct {
@@ -59,6 +64,7 @@ type configStoreClient struct {
 	configStoreManager persistence.ConfigStoreManager
 	doneCh             chan struct{}
 	logger             log.Logger
+	subscriptions      *dc.SubscriptionManager
 }
 
 type cacheEntry struct {
@@ -73,7 +79,7 @@ type fetchResult struct {
 }
 
 // NewConfigStoreClient creates a config store client
-func NewConfigStoreClient(clientCfg *csc.ClientConfig, persistenceCfg *config.Persistence, logger log.Logger, doneCh chan struct{}) (dc.Client, error) {
+func NewConfigStoreClient(clientCfg *csc.ClientConfig, persistenceCfg *config.Persistence, logger log.Logger, metricsClient metrics.Client, doneCh chan struct{}) (dc.Client, error) {
 	if err := validateClientConfig(clientCfg); err != nil {
 		logger.Error("Invalid Client Config Values, Using Default Values")
 		clientCfg = defaultConfigValues
@@ -89,7 +95,7 @@ func NewConfigStoreClient(clientCfg *csc.ClientConfig, persistenceCfg *config.Pe
 		return nil, errors.New("NoSQL struct is nil")
 	}
 
-	client, err := newConfigStoreClient(clientCfg, persistenceCfg.DataStores[persistenceCfg.DefaultStore].NoSQL, logger, doneCh)
+	client, err := newConfigStoreClient(clientCfg, persistenceCfg.DataStores[persistenceCfg.DefaultStore].NoSQL, logger, metricsClient, doneCh)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +106,7 @@ func NewConfigStoreClient(clientCfg *csc.ClientConfig, persistenceCfg *config.Pe
 	return client, nil
 }
 
-func newConfigStoreClient(clientCfg *csc.ClientConfig, persistenceCfg *config.NoSQL, logger log.Logger, doneCh chan struct{}) (*configStoreClient, error) {
+func newConfigStoreClient(clientCfg *csc.ClientConfig, persistenceCfg *config.NoSQL, logger log.Logger, metricsClient metrics.Client, doneCh chan struct{}) (*configStoreClient, error) {
 	store, err := nosql.NewNoSQLConfigStore(*persistenceCfg, logger)
 	if err != nil {
 		return nil, err
@@ -111,6 +117,7 @@ func newConfigStoreClient(clientCfg *csc.ClientConfig, persistenceCfg *config.No
 		doneCh:             doneCh,
 		configStoreManager: persistence.NewConfigStoreManagerImpl(store, logger),
 		logger:             logger,
+		subscriptions:      dc.NewSubscriptionManager(logger, metricsClient),
 	}
 
 	return client, nil
@@ -240,12 +247,19 @@ func (csc *configStoreClient) GetDurationValue(
 }
 
 func (csc *configStoreClient) UpdateValue(name dc.Key, value interface{}) error {
-	return csc.updateValue(name, value, csc.config.UpdateRetryAttempts)
+	if err := validateValues(name, value); err != nil {
+		return err
+	}
+	dcValues, _ := value.([]*types.DynamicConfigValue)
+	return csc.updateValue(name, func(currentValues []*types.DynamicConfigValue) []*types.DynamicConfigValue {
+		if len(dcValues) == 0 {
+			return nil
+		}
+		return upsertValues(currentValues, dcValues)
+	}, csc.config.UpdateRetryAttempts)
 }
 
 func (csc *configStoreClient) RestoreValue(name dc.Key, filters map[dc.Filter]interface{}) error {
-	//if empty filter provided, update fallback value.
-	//if u want to remove entire entry, just do update value with empty
 	loaded := csc.values.Load()
 	if loaded == nil {
 		return dc.NotFoundError
@@ -256,27 +270,65 @@ func (csc *configStoreClient) RestoreValue(name dc.Key, filters map[dc.Filter]in
 		return dc.NotFoundError
 	}
 
-	val, ok := currentCached.dcEntries[dc.Keys[name]]
-	if !ok || name == dc.UnknownKey {
+	if _, ok := currentCached.dcEntries[dc.Keys[name]]; !ok || name == dc.UnknownKey {
 		return dc.NotFoundError
 	}
 
-	newValues := make([]*types.DynamicConfigValue, 0, len(val.Values))
-	if filters == nil {
-		for _, dcValue := range val.Values {
-			if dcValue.Filters != nil || len(dcValue.Filters) != 0 {
-				newValues = append(newValues, copyDynamicConfigValue(dcValue))
+	return csc.updateValue(name, func(currentValues []*types.DynamicConfigValue) []*types.DynamicConfigValue {
+		newValues := make([]*types.DynamicConfigValue, 0, len(currentValues))
+		for _, dcValue := range currentValues {
+			if !equalFilters(dcValue.Filters, filters) {
+				newValues = append(newValues, dcValue)
 			}
 		}
-	} else {
-		for _, dcValue := range val.Values {
-			if !matchFilters(dcValue, filters) || dcValue.Filters == nil || len(dcValue.Filters) == 0 {
-				newValues = append(newValues, copyDynamicConfigValue(dcValue))
-			}
+		return newValues
+	}, csc.config.UpdateRetryAttempts)
+}
+
+func (csc *configStoreClient) ListValueHistory(name dc.Key, maxCount int) ([]*dc.ValueRevision, error) {
+	snapshots, err := csc.fetchHistory(maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	keyName := dc.Keys[name]
+	revisions := make([]*dc.ValueRevision, 0, len(snapshots))
+	for i, snapshot := range snapshots {
+		values := snapshotValues(snapshot, keyName)
+		if i+1 < len(snapshots) && reflect.DeepEqual(values, snapshotValues(snapshots[i+1], keyName)) {
+			// the key was not changed by this version
+			continue
 		}
+		revisions = append(revisions, &dc.ValueRevision{
+			Version:   snapshot.Version,
+			Timestamp: snapshot.Timestamp,
+			Values:    values,
+		})
 	}
+	return revisions, nil
+}
 
-	return csc.UpdateValue(name, newValues)
+func (csc *configStoreClient) RollbackValue(name dc.Key, version int64) error {
+	historySize := csc.config.RollbackHistorySize
+	if historySize == 0 {
+		historySize = defaultRollbackHistorySize
+	}
+	snapshots, err := csc.fetchHistory(historySize)
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Version == version {
+			values := snapshotValues(snapshot, dc.Keys[name])
+			return csc.updateValue(name, func(_ []*types.DynamicConfigValue) []*types.DynamicConfigValue {
+				return values
+			}, csc.config.UpdateRetryAttempts)
+		}
+	}
+	return &types.BadRequestError{
+		Message: fmt.Sprintf("version %v is not one of the last %v versions of dynamic config", version, historySize),
+	}
 }
 
 func (csc *configStoreClient) ListValue(name dc.Key) ([]*types.DynamicConfigEntry, error) {
@@ -307,10 +359,25 @@ func (csc *configStoreClient) ListValue(name dc.Key) ([]*types.DynamicConfigEntr
 	return resList, nil
 }
 
-func (csc *configStoreClient) updateValue(name dc.Key, value interface{}, retryAttempts int) error {
-	//since values are not unique, no way to know if you are trying to update a specific value
-	//or if you want to add another of the same value with different filters.
-	//UpdateValue will replace everything associated with dc key.
+func (csc *configStoreClient) Subscribe(
+	name dc.Key, filters map[dc.Filter]interface{}, callback dc.SubscriptionCallback,
+) (func(), error) {
+	return csc.subscriptions.Subscribe(name, filters, callback, csc.lookup), nil
+}
+
+func (csc *configStoreClient) lookup(name dc.Key, filters map[dc.Filter]interface{}) interface{} {
+	val, _ := csc.getValueWithFilters(name, filters, nil)
+	return val
+}
+
+// updateValue replaces the values of the key with the ones returned by update and writes a new version of the snapshot.
+// On version conflict the latest snapshot is fetched and update is applied again, so concurrent updates
+// of other keys or filters are not overwritten.
+func (csc *configStoreClient) updateValue(
+	name dc.Key,
+	update func(currentValues []*types.DynamicConfigValue) []*types.DynamicConfigValue,
+	retryAttempts int,
+) error {
 	loaded := csc.values.Load()
 	var currentCached cacheEntry
 	if loaded == nil {
@@ -324,49 +391,28 @@ func (csc *configStoreClient) updateValue(name dc.Key, value interface{}, retryA
 	}
 
 	keyName := dc.Keys[name]
-	var newEntries []*types.DynamicConfigEntry
-
-	existingEntry, entryExists := currentCached.dcEntries[keyName]
-
-	if value == nil || len(value.([]*types.DynamicConfigValue)) == 0 {
-		newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
-
-		for _, entry := range currentCached.dcEntries {
-			if entryExists && entry == existingEntry {
-				continue
-			} else {
-				newEntries = append(newEntries, copyDynamicConfigEntry(entry))
-			}
-		}
-	} else {
-		dcValues, ok := value.([]*types.DynamicConfigValue)
-		if !ok {
-			return errors.New("invalid value")
-		}
+	var currentValues []*types.DynamicConfigValue
+	if existingEntry, ok := currentCached.dcEntries[keyName]; ok && existingEntry != nil {
+		currentValues = copyDynamicConfigEntry(existingEntry).Values
+	}
 
-		if entryExists {
-			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
-		} else {
-			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries)+1)
-			newEntries = append(newEntries,
-				&types.DynamicConfigEntry{
-					Name:   keyName,
-					Values: dcValues,
-				})
-		}
+	dcValues := update(currentValues)
+	if reflect.DeepEqual(dcValues, currentValues) || (len(dcValues) == 0 && len(currentValues) == 0) {
+		return nil
+	}
 
-		for _, entry := range currentCached.dcEntries {
-			if entryExists && entry.Name == keyName {
-				newEntries = append(newEntries,
-					&types.DynamicConfigEntry{
-						Name:   keyName,
-						Values: dcValues,
-					})
-			} else {
-				newEntries = append(newEntries, copyDynamicConfigEntry(entry))
-			}
+	newEntries := make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries)+1)
+	for _, entry := range currentCached.dcEntries {
+		if entry.Name != keyName {
+			newEntries = append(newEntries, copyDynamicConfigEntry(entry))
 		}
 	}
+	if len(dcValues) != 0 {
+		newEntries = append(newEntries, &types.DynamicConfigEntry{
+			Name:   keyName,
+			Values: dcValues,
+		})
+	}
 
 	newSnapshot := &persistence.DynamicConfigSnapshot{
 		Version: currentCached.cacheVersion + 1,
@@ -398,7 +444,7 @@ func (csc *configStoreClient) updateValue(name dc.Key, value interface{}, retryA
 				if err != nil {
 					return err
 				}
-				return csc.updateValue(name, value, retryAttempts-1)
+				return csc.updateValue(name, update, retryAttempts-1)
 			}
 
 			if retryAttempts == 0 {
@@ -410,6 +456,86 @@ func (csc *configStoreClient) updateValue(name dc.Key, value interface{}, retryA
 	}
 }
 
+func (csc *configStoreClient) fetchHistory(maxCount int) ([]*persistence.DynamicConfigSnapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), csc.config.FetchTimeout)
+	defer cancel()
+
+	res, err := csc.configStoreManager.FetchDynamicConfigHistory(ctx, &persistence.FetchDynamicConfigHistoryRequest{
+		PageSize: maxCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dynamic config history %v", err)
+	}
+	return res.Snapshots, nil
+}
+
+// snapshotValues returns a copy of the values of the key in the snapshot
+func snapshotValues(snapshot *persistence.DynamicConfigSnapshot, keyName string) []*types.DynamicConfigValue {
+	if snapshot.Values == nil {
+		return nil
+	}
+	for _, entry := range snapshot.Values.Entries {
+		if entry.Name == keyName {
+			return copyDynamicConfigEntry(entry).Values
+		}
+	}
+	return nil
+}
+
+// upsertValues replaces the current values with the new values with the same filters and appends the other new values
+func upsertValues(currentValues, newValues []*types.DynamicConfigValue) []*types.DynamicConfigValue {
+	result := make([]*types.DynamicConfigValue, 0, len(currentValues)+len(newValues))
+	result = append(result, currentValues...)
+	for _, newValue := range newValues {
+		replaced := false
+		for i, currentValue := range result {
+			if sameFilters(currentValue.Filters, newValue.Filters) {
+				result[i] = newValue
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			result = append(result, newValue)
+		}
+	}
+	return result
+}
+
+// sameFilters returns true if both lists contain the same filters regardless of their order
+func sameFilters(a, b []*types.DynamicConfigFilter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	filters := make(map[dc.Filter]interface{}, len(b))
+	for _, filter := range b {
+		value, err := convertFromDataBlob(filter.Value)
+		if err != nil {
+			return false
+		}
+		filters[dc.ParseFilter(filter.Name)] = value
+	}
+	return equalFilters(a, filters)
+}
+
+// equalFilters returns true if the value filters are exactly the given filters
+func equalFilters(valueFilters []*types.DynamicConfigFilter, filters map[dc.Filter]interface{}) bool {
+	if len(valueFilters) != len(filters) {
+		return false
+	}
+	for _, valueFilter := range valueFilters {
+		filterValue, ok := filters[dc.ParseFilter(valueFilter.Name)]
+		if !ok {
+			return false
+		}
+		value, err := convertFromDataBlob(valueFilter.Value)
+		if err != nil || !reflect.DeepEqual(value, filterValue) {
+			return false
+		}
+	}
+	return true
+}
+
 func copyDynamicConfigEntry(entry *types.DynamicConfigEntry) *types.DynamicConfigEntry {
 	if entry == nil {
 		return nil
@@ -509,15 +635,36 @@ func (csc *configStoreClient) storeValues(snapshot *persistence.DynamicConfigSna
 		}
 	}
 
+	oldValues, loaded := csc.values.Load().(cacheEntry)
 	csc.values.Store(cacheEntry{
 		cacheVersion:  snapshot.Version,
 		schemaVersion: snapshot.Values.SchemaVersion,
 		dcEntries:     dcEntryMap,
 	})
 	csc.logger.Info("Updated dynamic config")
+
+	if loaded {
+		csc.subscriptions.Notify(diffEntries(oldValues.dcEntries, dcEntryMap), csc.lookup)
+	}
 	return nil
 }
 
+// diffEntries returns the new values of the entries which changed, keyed by entry name
+func diffEntries(oldEntries, newEntries map[string]*types.DynamicConfigEntry) map[string]interface{} {
+	changes := make(map[string]interface{})
+	for name, entry := range newEntries {
+		if !reflect.DeepEqual(oldEntries[name], entry) {
+			changes[name] = entry
+		}
+	}
+	for name := range oldEntries {
+		if _, ok := newEntries[name]; !ok {
+			changes[name] = nil
+		}
+	}
+	return changes
+}
+
 func (csc *configStoreClient) getValueWithFilters(key dc.Key, filters map[dc.Filter]interface{}, defaultValue interface{}) (interface{}, error) {
 	keyName := dc.Keys[key]
 	loaded := csc.values.Load()
@@ -569,6 +716,38 @@ func matchFilters(dcValue *types.DynamicConfigValue, filters map[dc.Filter]inter
 	return true
 }
 
+// validateValues returns a BadRequestError if one of the values does not match the definition of the key
+func validateValues(name dc.Key, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	dcValues, ok := value.([]*types.DynamicConfigValue)
+	if !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("invalid value for dynamic config %v: %T is not a list of values", name.String(), value)}
+	}
+	for _, dcValue := range dcValues {
+		if dcValue == nil || dcValue.Value == nil || dcValue.Value.EncodingType == nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("invalid value for dynamic config %v: value is not set", name.String())}
+		}
+		parsedVal, err := convertFromDataBlob(dcValue.Value)
+		if err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("invalid value for dynamic config %v: %v", name.String(), err)}
+		}
+		filters := make([]dc.Filter, 0, len(dcValue.Filters))
+		for _, filter := range dcValue.Filters {
+			parsedFilter := dc.ParseFilter(filter.Name)
+			if parsedFilter == dc.UnknownFilter {
+				return &types.BadRequestError{Message: fmt.Sprintf("invalid value for dynamic config %v: unknown filter %v", name.String(), filter.Name)}
+			}
+			filters = append(filters, parsedFilter)
+		}
+		if err := dc.ValidateValue(name, parsedVal, filters); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 func validateClientConfig(config *csc.ClientConfig) error {
 	if config == nil {
 		return errors.New("no config found for config store based dynamic config client")
@@ -585,6 +764,9 @@ func validateClientConfig(config *csc.ClientConfig) error {
 	if config.UpdateTimeout <= 0 {
 		return errors.New("UpdateTimeout must be positive")
 	}
+	if config.RollbackHistorySize < 0 {
+		return errors.New("RollbackHistorySize must be non-negative")
+	}
 	return nil
 }
 
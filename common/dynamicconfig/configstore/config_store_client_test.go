@@ -36,6 +36,7 @@ import (
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	c "github.com/uber/cadence/common/dynamicconfig/configstore/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -308,7 +309,7 @@ func (s *configStoreClientSuite) SetupTest() {
 		},
 		&config.NoSQL{
 			PluginName: "cassandra",
-		}, log.NewNoop(), s.doneCh)
+		}, log.NewNoop(), metrics.NewNoopMetricsClient(), s.doneCh)
 	s.Require().NoError(err)
 
 	s.mockManager = p.NewMockConfigStoreManager(s.mockController)
@@ -951,3 +952,346 @@ func (e eqSnapshotVersionMatcher) String() string {
 func EqSnapshotVersion(version int64) gomock.Matcher {
 	return eqSnapshotVersionMatcher{version}
 }
+
+func (s *configStoreClientSuite) TestSubscribe() {
+	intSnapshot := func(version int64, value int) *p.DynamicConfigSnapshot {
+		return &p.DynamicConfigSnapshot{
+			Version: version,
+			Values: &types.DynamicConfigBlob{
+				SchemaVersion: 1,
+				Entries: []*types.DynamicConfigEntry{
+					{
+						Name: dc.Keys[dc.TestGetIntPropertyKey],
+						Values: []*types.DynamicConfigValue{
+							{
+								Value: &types.DataBlob{
+									EncodingType: types.EncodingTypeJSON.Ptr(),
+									Data:         jsonMarshalHelper(value),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	s.NoError(s.client.storeValues(intSnapshot(1, 1000)))
+
+	var values []interface{}
+	cancel, err := s.client.Subscribe(dc.TestGetIntPropertyKey, nil, func(value interface{}) {
+		values = append(values, value)
+	})
+	s.NoError(err)
+
+	s.NoError(s.client.storeValues(intSnapshot(2, 1000)))
+	s.Empty(values)
+
+	s.NoError(s.client.storeValues(intSnapshot(3, 2000)))
+	s.Equal([]interface{}{float64(2000)}, values)
+
+	// the key is removed
+	s.NoError(s.client.storeValues(&p.DynamicConfigSnapshot{
+		Version: 4,
+		Values:  &types.DynamicConfigBlob{SchemaVersion: 1},
+	}))
+	s.Equal([]interface{}{float64(2000), nil}, values)
+
+	cancel()
+	s.NoError(s.client.storeValues(intSnapshot(5, 3000)))
+	s.Len(values, 2)
+}
+
+func (s *configStoreClientSuite) TestUpdateValue_InvalidValue() {
+	valueOf := func(value interface{}, filters ...*types.DynamicConfigFilter) []*types.DynamicConfigValue {
+		return []*types.DynamicConfigValue{
+			{
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         jsonMarshalHelper(value),
+				},
+				Filters: filters,
+			},
+		}
+	}
+	domainFilter := &types.DynamicConfigFilter{
+		Name: "domainName",
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         jsonMarshalHelper("samples-domain"),
+		},
+	}
+
+	for _, values := range [][]*types.DynamicConfigValue{
+		valueOf("10s"),
+		valueOf(10.5),
+		valueOf(-1),
+		valueOf(10, domainFilter),
+	} {
+		err := s.client.UpdateValue(dc.FrontendRPS, values)
+		s.Error(err)
+		s.IsType(&types.BadRequestError{}, err)
+	}
+
+	err := s.client.UpdateValue(dc.FrontendShutdownDrainDuration, valueOf(10))
+	s.IsType(&types.BadRequestError{}, err)
+	err = s.client.UpdateValue(dc.EnableGlobalDomain, valueOf(map[string]interface{}{"enabled": true}))
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+// setupIsolatedManager replaces the manager with one which does not share the expectations of the previous tests
+func (s *configStoreClientSuite) setupIsolatedManager() (*p.MockConfigStoreManager, *gomock.Controller) {
+	controller := gomock.NewController(s.T())
+	mockManager := p.NewMockConfigStoreManager(controller)
+	s.client.configStoreManager = mockManager
+	return mockManager, controller
+}
+
+func (s *configStoreClientSuite) TestUpdateValue_UpsertWithFilters() {
+	mockManager, controller := s.setupIsolatedManager()
+	defer controller.Finish()
+	s.NoError(s.client.storeValues(snapshot1))
+
+	newValues := []*types.DynamicConfigValue{
+		{
+			Value: &types.DataBlob{
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+				Data:         jsonMarshalHelper(false),
+			},
+			Filters: []*types.DynamicConfigFilter{
+				{
+					Name: "domainName",
+					Value: &types.DataBlob{
+						EncodingType: types.EncodingTypeJSON.Ptr(),
+						Data:         jsonMarshalHelper("samples-domain"),
+					},
+				},
+			},
+		},
+		{
+			Value: &types.DataBlob{
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+				Data:         jsonMarshalHelper(false),
+			},
+			Filters: []*types.DynamicConfigFilter{
+				{
+					Name: "domainName",
+					Value: &types.DataBlob{
+						EncodingType: types.EncodingTypeJSON.Ptr(),
+						Data:         jsonMarshalHelper("new-domain"),
+					},
+				},
+			},
+		},
+	}
+
+	var updated *p.DynamicConfigSnapshot
+	mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(2)).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest) error {
+			updated = request.Snapshot
+			return nil
+		}).Times(1)
+
+	err := s.client.UpdateValue(dc.TestGetBoolPropertyKey, newValues)
+	s.NoError(err)
+
+	values := snapshotValues(updated, dc.Keys[dc.TestGetBoolPropertyKey])
+	s.Len(values, 4)
+	expected := map[string][]byte{
+		"":                      jsonMarshalHelper(false),
+		"global-samples-domain": jsonMarshalHelper(true),
+		"samples-domain":        jsonMarshalHelper(false),
+		"new-domain":            jsonMarshalHelper(false),
+	}
+	for _, value := range values {
+		domainName := ""
+		if len(value.Filters) != 0 {
+			domain, err := convertFromDataBlob(value.Filters[0].Value)
+			s.NoError(err)
+			domainName = domain.(string)
+		}
+		s.Equal(expected[domainName], value.Value.Data, domainName)
+	}
+	s.Len(updated.Values.Entries, len(snapshot1.Values.Entries))
+}
+
+func (s *configStoreClientSuite) TestUpdateValue_RetryMergesConcurrentUpdate() {
+	mockManager, controller := s.setupIsolatedManager()
+	defer controller.Finish()
+	s.NoError(s.client.storeValues(snapshot1))
+
+	fallbackValue := []*types.DynamicConfigValue{
+		{
+			Value: &types.DataBlob{
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+				Data:         jsonMarshalHelper(true),
+			},
+		},
+	}
+
+	// another admin added a value for the domain in the meantime
+	concurrentValue := &types.DynamicConfigValue{
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         jsonMarshalHelper(false),
+		},
+		Filters: []*types.DynamicConfigFilter{
+			{
+				Name: "domainName",
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         jsonMarshalHelper("concurrent-domain"),
+				},
+			},
+		},
+	}
+	snapshot2 := &p.DynamicConfigSnapshot{
+		Version: 2,
+		Values: &types.DynamicConfigBlob{
+			SchemaVersion: 1,
+			Entries: []*types.DynamicConfigEntry{
+				{
+					Name:   dc.Keys[dc.TestGetBoolPropertyKey],
+					Values: []*types.DynamicConfigValue{concurrentValue},
+				},
+			},
+		},
+	}
+
+	gomock.InOrder(
+		mockManager.EXPECT().
+			UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(2)).
+			Return(&p.ConditionFailedError{}),
+		mockManager.EXPECT().
+			FetchDynamicConfig(gomock.Any()).
+			Return(&p.FetchDynamicConfigResponse{Snapshot: snapshot2}, nil),
+		mockManager.EXPECT().
+			UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(3)).
+			DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest) error {
+				values := snapshotValues(request.Snapshot, dc.Keys[dc.TestGetBoolPropertyKey])
+				s.Equal([]*types.DynamicConfigValue{concurrentValue, fallbackValue[0]}, values)
+				return nil
+			}),
+	)
+
+	err := s.client.UpdateValue(dc.TestGetBoolPropertyKey, fallbackValue)
+	s.NoError(err)
+}
+
+func (s *configStoreClientSuite) TestRestoreValue_ExactFiltersOnly() {
+	mockManager, controller := s.setupIsolatedManager()
+	defer controller.Finish()
+	s.NoError(s.client.storeValues(snapshot1))
+
+	mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest) error {
+			values := snapshotValues(request.Snapshot, dc.Keys[dc.TestGetDurationPropertyKey])
+			s.Len(values, 2)
+			for _, value := range values {
+				s.NotEqual(2, len(value.Filters))
+			}
+			return nil
+		}).Times(1)
+
+	err := s.client.RestoreValue(dc.TestGetDurationPropertyKey, map[dc.Filter]interface{}{
+		dc.TaskListName: "longIdleTimeTaskList",
+		dc.DomainName:   "samples-domain",
+	})
+	s.NoError(err)
+}
+
+func (s *configStoreClientSuite) TestListValueHistory() {
+	mockManager, controller := s.setupIsolatedManager()
+	defer controller.Finish()
+	boolSnapshot := func(version int64, values ...bool) *p.DynamicConfigSnapshot {
+		snapshot := &p.DynamicConfigSnapshot{
+			Version:   version,
+			Timestamp: time.Unix(version, 0),
+			Values:    &types.DynamicConfigBlob{SchemaVersion: 1},
+		}
+		entry := &types.DynamicConfigEntry{Name: dc.Keys[dc.TestGetIntPropertyKey]}
+		snapshot.Values.Entries = append(snapshot.Values.Entries, entry)
+		for _, value := range values {
+			entry.Values = append(entry.Values, &types.DynamicConfigValue{
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         jsonMarshalHelper(value),
+				},
+			})
+		}
+		if len(values) != 0 {
+			snapshot.Values.Entries = append(snapshot.Values.Entries, &types.DynamicConfigEntry{
+				Name:   dc.Keys[dc.TestGetBoolPropertyKey],
+				Values: entry.Values,
+			})
+		}
+		return snapshot
+	}
+
+	mockManager.EXPECT().
+		FetchDynamicConfigHistory(gomock.Any(), &p.FetchDynamicConfigHistoryRequest{PageSize: 10}).
+		Return(&p.FetchDynamicConfigHistoryResponse{
+			Snapshots: []*p.DynamicConfigSnapshot{
+				boolSnapshot(5, true),
+				boolSnapshot(4, false),
+				boolSnapshot(3, false),
+				boolSnapshot(2),
+				boolSnapshot(1, true),
+			},
+		}, nil)
+
+	revisions, err := s.client.ListValueHistory(dc.TestGetBoolPropertyKey, 10)
+	s.NoError(err)
+	s.Len(revisions, 4)
+	s.Equal([]int64{5, 3, 2, 1}, []int64{revisions[0].Version, revisions[1].Version, revisions[2].Version, revisions[3].Version})
+	s.Equal(time.Unix(3, 0), revisions[1].Timestamp)
+	s.Equal(jsonMarshalHelper(false), revisions[1].Values[0].Value.Data)
+	s.Empty(revisions[2].Values)
+}
+
+func (s *configStoreClientSuite) TestRollbackValue() {
+	mockManager, controller := s.setupIsolatedManager()
+	defer controller.Finish()
+	snapshot1.Version = 2
+	s.NoError(s.client.storeValues(snapshot1))
+
+	previous := &p.DynamicConfigSnapshot{
+		Version: 1,
+		Values: &types.DynamicConfigBlob{
+			SchemaVersion: 1,
+			Entries: []*types.DynamicConfigEntry{
+				{
+					Name: dc.Keys[dc.TestGetBoolPropertyKey],
+					Values: []*types.DynamicConfigValue{
+						{
+							Value: &types.DataBlob{
+								EncodingType: types.EncodingTypeJSON.Ptr(),
+								Data:         jsonMarshalHelper(true),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	mockManager.EXPECT().
+		FetchDynamicConfigHistory(gomock.Any(), &p.FetchDynamicConfigHistoryRequest{PageSize: defaultRollbackHistorySize}).
+		Return(&p.FetchDynamicConfigHistoryResponse{
+			Snapshots: []*p.DynamicConfigSnapshot{snapshot1, previous},
+		}, nil).Times(2)
+	mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(3)).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest) error {
+			s.Equal(previous.Values.Entries[0].Values, snapshotValues(request.Snapshot, dc.Keys[dc.TestGetBoolPropertyKey]))
+			s.Len(request.Snapshot.Values.Entries, len(snapshot1.Values.Entries))
+			return nil
+		}).Times(1)
+
+	err := s.client.RollbackValue(dc.TestGetBoolPropertyKey, 1)
+	s.NoError(err)
+
+	err = s.client.RollbackValue(dc.TestGetBoolPropertyKey, 42)
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
@@ -366,6 +366,39 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	FrontendGlobalDomainRPS
+	// FrontendDomainBurst is the burst of the domain rate limit, the domain rps is used if not set
+	// KeyName: frontend.domainBurst
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	FrontendDomainBurst
+	// FrontendGlobalDomainAPIRPS is the rate limit per second of each API family of a domain for the whole Cadence cluster.
+	// The value maps the API families (start, signal, control, query, describe, history, visibility) to their rps
+	// KeyName: frontend.globalDomainAPIRps
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName
+	FrontendGlobalDomainAPIRPS
+	// FrontendGlobalCallerRPS is the rate limit per second of each caller of a domain, told apart by their TLS client certificate or service name, for the whole Cadence cluster
+	// KeyName: frontend.globalCallerRps
+	// Value type: Int
+	// Default value: 0 (disabled)
+	// Allowed filters: DomainName
+	FrontendGlobalCallerRPS
+	// FrontendGlobalSharedPoolRPS is the rate limit per second for the whole Cadence cluster that domains borrow from
+	// once their own domain rate limit is exceeded
+	// KeyName: frontend.globalSharedPoolRps
+	// Value type: Int
+	// Default value: 0 (borrowing disabled)
+	// Allowed filters: N/A
+	FrontendGlobalSharedPoolRPS
+	// FrontendQuotaShareRefreshInterval is the interval at which the part of the cluster-wide rate limits enforced
+	// by the host is refreshed from the demand published by the other hosts
+	// KeyName: frontend.quotaShareRefreshInterval
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: N/A
+	FrontendQuotaShareRefreshInterval
 	// FrontendDecisionResultCountLimit is max number of decisions per RespondDecisionTaskCompleted request
 	// KeyName: frontend.decisionResultCountLimit
 	// Value type: Int
@@ -798,6 +831,13 @@ const (
 	// Default value: common.ConvertIntMapToDynamicConfigMapProperty(DefaultTaskPriorityWeight)
 	// Allowed filters: N/A
 	TaskSchedulerRoundRobinWeights
+	// TaskSchedulerDomainWeight is the domain weight for hierarchical fair task scheduler,
+	// it is the number of tasks of the domain dispatched per turn within a priority
+	// KeyName: history.taskSchedulerDomainWeight
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: DomainName
+	TaskSchedulerDomainWeight
 	// TaskCriticalRetryCount is the critical retry count for background tasks
 	// when task attempt exceeds this threshold:
 	// - task attempt metrics and additional error logs will be emitted
@@ -1608,6 +1648,21 @@ const (
 	// Default value: 5
 	// Allowed filters: N/A
 	ReplicationTaskProcessorShardQPS
+	// ReplicationTaskProcessorSchedulerType is the scheduler type used to apply the fetched replication tasks.
+	// With task.SchedulerTypeHierarchicalFair the tasks are dispatched through a host level scheduler which serves
+	// the domains by TaskSchedulerDomainWeight, the tasks of a domain are still applied in order.
+	// Otherwise the tasks of a shard are applied sequentially.
+	// KeyName: history.ReplicationTaskProcessorSchedulerType
+	// Value type: Int
+	// Default value: int(task.SchedulerTypeFIFO)
+	// Allowed filters: N/A
+	ReplicationTaskProcessorSchedulerType
+	// ReplicationTaskProcessorSchedulerWorkerCount is the number of workers of the host level replication task scheduler
+	// KeyName: history.ReplicationTaskProcessorSchedulerWorkerCount
+	// Value type: Int
+	// Default value: 200
+	// Allowed filters: N/A
+	ReplicationTaskProcessorSchedulerWorkerCount
 	// ReplicationTaskGenerationQPS is the wait time between each replication task generation qps
 	// KeyName: history.ReplicationTaskGenerationQPS
 	// Value type: Float64
@@ -1731,6 +1786,18 @@ const (
 	// Default value: TRUE
 	// Allowed filters: N/A
 	HistoryScannerEnabled
+	// ArchivalRetentionScannerEnabled is indicates if archival retention scanner should be started as part of worker.Scanner
+	// KeyName: worker.archivalRetentionScannerEnabled
+	// Value type: Bool
+	// Default value: FALSE
+	// Allowed filters: N/A
+	ArchivalRetentionScannerEnabled
+	// ArchivalRetentionDays is the number of days archived histories and visibility records of a domain are kept after the workflow closed
+	// KeyName: worker.archivalRetentionDays
+	// Value type: Int
+	// Default value: 0 (archived workflows are kept forever)
+	// Allowed filters: DomainName
+	ArchivalRetentionDays
 	// ConcreteExecutionsScannerEnabled is indicates if executions scanner should be started as part of worker.Scanner
 	// KeyName: worker.executionsScannerEnabled
 	// Value type: Bool
@@ -1821,6 +1888,12 @@ const (
 	// Default value: TRUE
 	// Allowed filters: N/A
 	EnableBatcher
+	// EnableScheduler is decides whether start scheduler in our worker
+	// KeyName: worker.enableScheduler
+	// Value type: Bool
+	// Default value: TRUE
+	// Allowed filters: N/A
+	EnableScheduler
 	// EnableParentClosePolicyWorker is decides whether or not enable system workers for processing parent close policy task
 	// KeyName: system.enableParentClosePolicyWorker
 	// Value type: Bool
@@ -2007,6 +2080,7 @@ var Keys = map[Key]string{
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
 	DisallowQuery:                       "system.disallowQuery",
 	EnableBatcher:                       "worker.enableBatcher",
+	EnableScheduler:                     "worker.enableScheduler",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
 	EnableFailoverManager:               "system.enableFailoverManager",
 	EnableWorkflowShadower:              "system.enableWorkflowShadower",
@@ -2054,6 +2128,11 @@ var Keys = map[Key]string{
 	FrontendMaxDomainRPSPerInstance:             "frontend.domainrps",
 	FrontendDecisionResultCountLimit:            "frontend.decisionResultCountLimit",
 	FrontendGlobalDomainRPS:                     "frontend.globalDomainrps",
+	FrontendDomainBurst:                         "frontend.domainBurst",
+	FrontendGlobalDomainAPIRPS:                  "frontend.globalDomainAPIRps",
+	FrontendGlobalCallerRPS:                     "frontend.globalCallerRps",
+	FrontendGlobalSharedPoolRPS:                 "frontend.globalSharedPoolRps",
+	FrontendQuotaShareRefreshInterval:           "frontend.quotaShareRefreshInterval",
 	FrontendHistoryMgrNumConns:                  "frontend.historyMgrNumConns",
 	FrontendShutdownDrainDuration:               "frontend.shutdownDrainDuration",
 	DisableListVisibilityByFilter:               "frontend.disableListVisibilityByFilter",
@@ -2127,6 +2206,7 @@ var Keys = map[Key]string{
 	TaskSchedulerShardQueueSize:                        "history.taskSchedulerShardQueueSize",
 	TaskSchedulerDispatcherCount:                       "history.taskSchedulerDispatcherCount",
 	TaskSchedulerRoundRobinWeights:                     "history.taskSchedulerRoundRobinWeight",
+	TaskSchedulerDomainWeight:                          "history.taskSchedulerDomainWeight",
 	TaskCriticalRetryCount:                             "history.taskCriticalRetryCount",
 	ActiveTaskRedispatchInterval:                       "history.activeTaskRedispatchInterval",
 	StandbyTaskRedispatchInterval:                      "history.standbyTaskRedispatchInterval",
@@ -2251,6 +2331,8 @@ var Keys = map[Key]string{
 	ReplicationTaskProcessorStartWaitJitterCoefficient: "history.ReplicationTaskProcessorStartWaitJitterCoefficient",
 	ReplicationTaskProcessorHostQPS:                    "history.ReplicationTaskProcessorHostQPS",
 	ReplicationTaskProcessorShardQPS:                   "history.ReplicationTaskProcessorShardQPS",
+	ReplicationTaskProcessorSchedulerType:              "history.ReplicationTaskProcessorSchedulerType",
+	ReplicationTaskProcessorSchedulerWorkerCount:       "history.ReplicationTaskProcessorSchedulerWorkerCount",
 	ReplicationTaskGenerationQPS:                       "history.ReplicationTaskGenerationQPS",
 	EnableConsistentQuery:                              "history.EnableConsistentQuery",
 	EnableConsistentQueryByDomain:                      "history.EnableConsistentQueryByDomain",
@@ -2286,6 +2368,8 @@ var Keys = map[Key]string{
 	ScannerMaxTasksProcessedPerTasklistJob:                   "worker.scannerMaxTasksProcessedPerTasklistJob",
 	TaskListScannerEnabled:                                   "worker.taskListScannerEnabled",
 	HistoryScannerEnabled:                                    "worker.historyScannerEnabled",
+	ArchivalRetentionScannerEnabled:                          "worker.archivalRetentionScannerEnabled",
+	ArchivalRetentionDays:                                    "worker.archivalRetentionDays",
 	ConcreteExecutionsScannerEnabled:                         "worker.executionsScannerEnabled",
 	ConcreteExecutionsScannerBlobstoreFlushThreshold:         "worker.executionsScannerBlobstoreFlushThreshold",
 	ConcreteExecutionsScannerActivityBatchSize:               "worker.executionsScannerActivityBatchSize",
@@ -2308,8 +2392,8 @@ var Keys = map[Key]string{
 	TimersFixerEnabled:                                       "worker.timersFixerEnabled",
 	TimersScannerConcurrency:                                 "worker.timersScannerConcurrency",
 	TimersScannerPersistencePageSize:                         "worker.timersScannerPersistencePageSize",
-	TimersScannerBlobstoreFlushThreshold:                     "worker.timersScannerConcurrency",
-	TimersScannerActivityBatchSize:                           "worker.timersScannerBlobstoreFlushThreshold",
+	TimersScannerBlobstoreFlushThreshold:                     "worker.timersScannerBlobstoreFlushThreshold",
+	TimersScannerActivityBatchSize:                           "worker.timersScannerActivityBatchSize",
 	TimersScannerPeriodStart:                                 "worker.timersScannerPeriodStart",
 	TimersScannerPeriodEnd:                                   "worker.timersScannerPeriodEnd",
 	TimersFixerDomainAllow:                                   "worker.timersFixerDomainAllow",
@@ -0,0 +1,1984 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import "time"
+
+// keyDefinitions is the registry of the dynamic config keys. Every key added to Keys must be registered here,
+// except the keys for tests whose values are deliberately left unvalidated.
+var keyDefinitions = map[Key]KeyDefinition{
+	EnableGlobalDomain: {
+		Description: "Key for enable global domain, defaults to the static cluster group config",
+		Type:        BoolType,
+	},
+	EnableVisibilitySampling: {
+		Description: "Key for enable visibility sampling for basic(DB based) visibility, defaults to true in frontend and false in history",
+		Type:        BoolType,
+	},
+	EnableReadFromClosedExecutionV2: {
+		Description:  "Key for enable read from cadence_visibility.closed_executions_v2",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	AdvancedVisibilityWritingMode: {
+		Description:   "Key for how to write to advanced visibility",
+		Type:          StringType,
+		AllowedValues: []string{"off", "on", "dual"},
+	},
+	EmitShardDiffLog: {
+		Description:  "Whether emit the shard diff log",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	EnableReadVisibilityFromES: {
+		Description: "Key for enable read from elastic search",
+		Type:        BoolType,
+		Filters:     domainNameFilter,
+	},
+	DisableListVisibilityByFilter: {
+		Description:  "Config to disable list open/close workflow using filter",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	HistoryArchivalStatus: {
+		Description:   "Key for the status of history archival",
+		Type:          StringType,
+		AllowedValues: []string{"enabled", "disabled"},
+	},
+	EnableReadFromHistoryArchival: {
+		Description: "Key for enabling reading history from archival store",
+		Type:        BoolType,
+	},
+	VisibilityArchivalStatus: {
+		Description:   "Key for the status of visibility archival",
+		Type:          StringType,
+		AllowedValues: []string{"enabled", "disabled"},
+	},
+	EnableReadFromVisibilityArchival: {
+		Description: "Key for enabling reading visibility from archival store",
+		Type:        BoolType,
+	},
+	EnableDomainNotActiveAutoForwarding: {
+		Description:  "Whether enabling DC auto forwarding to active cluster for signal / start / signal with start API if domain is not active",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: true,
+	},
+	EnableGracefulFailover: {
+		Description:  "Whether enabling graceful failover",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	TransactionSizeLimit: {
+		Description:  "Largest allowed transaction size to persistence",
+		Type:         IntType,
+		DefaultValue: 14 * 1024 * 1024,
+		Bounds:       nonNegative,
+	},
+	PersistenceErrorInjectionRate: {
+		Description:  "Rate for injecting random error in persistence",
+		Type:         FloatType,
+		DefaultValue: 0.0,
+		Bounds:       unitInterval,
+	},
+	MaxRetentionDays: {
+		Description:  "Maximum allowed retention days for domain",
+		Type:         IntType,
+		DefaultValue: 30,
+		Bounds:       nonNegative,
+	},
+	MinRetentionDays: {
+		Description:  "Minimal allowed retention days for domain",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	MaxDecisionStartToCloseSeconds: {
+		Description:  "Maximum allowed value for decision start to close timeout in seconds",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 240,
+		Bounds:       nonNegative,
+	},
+	DisallowQuery: {
+		Description:  "Key to disallow query for a domain",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	EnableDebugMode: {
+		Description:  "For enabling debugging components, logs and metrics",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	RequiredDomainDataKeys: {
+		Description: "Key for the list of data keys required in domain registeration",
+		Type:        MapType,
+	},
+	EnableGRPCOutbound: {
+		Description:  "Key for enabling outbound GRPC traffic",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	BlobSizeLimitError: {
+		Description:  "Per event blob size limit",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 2 * 1024 * 1024,
+		Bounds:       nonNegative,
+	},
+	BlobSizeLimitWarn: {
+		Description: "Per event blob size limit for warning, defaults to 256KB in frontend and 512KB in history",
+		Type:        IntType,
+		Filters:     domainNameFilter,
+		Bounds:      nonNegative,
+	},
+	HistorySizeLimitError: {
+		Description:  "Per workflow execution history size limit",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 200 * 1024 * 1024,
+		Bounds:       nonNegative,
+	},
+	HistorySizeLimitWarn: {
+		Description:  "Per workflow execution history size limit for warning",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 50 * 1024 * 1024,
+		Bounds:       nonNegative,
+	},
+	HistoryCountLimitError: {
+		Description:  "Per workflow execution history event count limit",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 200 * 1024,
+		Bounds:       nonNegative,
+	},
+	HistoryCountLimitWarn: {
+		Description:  "Per workflow execution history event count limit for warning",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 50 * 1024,
+		Bounds:       nonNegative,
+	},
+	DomainNameMaxLength: {
+		Description:  "Length limit for domain name",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	IdentityMaxLength: {
+		Description:  "Length limit for identity",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	WorkflowIDMaxLength: {
+		Description:  "Length limit for workflowID",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	SignalNameMaxLength: {
+		Description:  "Length limit for signal name",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	WorkflowTypeMaxLength: {
+		Description:  "Length limit for workflow type",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	RequestIDMaxLength: {
+		Description:  "Length limit for requestID",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	TaskListNameMaxLength: {
+		Description:  "Length limit for task list name",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	ActivityIDMaxLength: {
+		Description:  "Length limit for activityID",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	ActivityTypeMaxLength: {
+		Description:  "Length limit for activity type",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	MarkerNameMaxLength: {
+		Description:  "Length limit for marker name",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	TimerIDMaxLength: {
+		Description:  "Length limit for timerID",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	MaxIDLengthWarnLimit: {
+		Description:  "Warn length limit for various IDs, including: Domain, TaskList, WorkflowID, ActivityID, TimerID, WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID",
+		Type:         IntType,
+		DefaultValue: 128,
+		Bounds:       nonNegative,
+	},
+	AdminErrorInjectionRate: {
+		Description:  "Rate for injecting random error in admin client",
+		Type:         FloatType,
+		DefaultValue: 0.0,
+		Bounds:       unitInterval,
+	},
+	FrontendPersistenceMaxQPS: {
+		Description:  "Max qps frontend host can query DB",
+		Type:         IntType,
+		DefaultValue: 2000,
+		Bounds:       nonNegative,
+	},
+	FrontendPersistenceGlobalMaxQPS: {
+		Description:  "Max qps frontend cluster can query DB",
+		Type:         IntType,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	FrontendVisibilityMaxPageSize: {
+		Description:  "Default max size for ListWorkflowExecutions in one page",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	FrontendVisibilityListMaxQPS: {
+		Description: "Max qps frontend can list open/close workflows, defaults to a value based on the number of persistence shards",
+		Type:        IntType,
+		Filters:     domainNameFilter,
+		Bounds:      nonNegative,
+	},
+	FrontendESVisibilityListMaxQPS: {
+		Description:  "Max qps frontend can list open/close workflows from ElasticSearch",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 30,
+		Bounds:       nonNegative,
+	},
+	FrontendESIndexMaxResultWindow: {
+		Description:  "ElasticSearch index setting max_result_window",
+		Type:         IntType,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	FrontendHistoryMaxPageSize: {
+		Description:  "Default max size for GetWorkflowExecutionHistory in one page",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	FrontendRPS: {
+		Description:  "Workflow rate limit per second",
+		Type:         IntType,
+		DefaultValue: 1200,
+		Bounds:       nonNegative,
+	},
+	FrontendMaxDomainRPSPerInstance: {
+		Description:  "Workflow domain rate limit per second",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1200,
+		Bounds:       nonNegative,
+	},
+	FrontendGlobalDomainRPS: {
+		Description:  "Workflow domain rate limit per second for the whole Cadence cluster",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	FrontendDomainBurst: {
+		Description:  "Burst of the domain rate limit, the domain rps is used if not set",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	FrontendGlobalDomainAPIRPS: {
+		Description: "Rate limit per second of each API family of a domain for the whole Cadence cluster. The value maps the API families (start, signal, control, query, describe, history, visibility) to their rps",
+		Type:        MapType,
+		Filters:     domainNameFilter,
+	},
+	FrontendGlobalCallerRPS: {
+		Description:  "Rate limit per second of each caller identity of a domain for the whole Cadence cluster",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	FrontendGlobalSharedPoolRPS: {
+		Description:  "Rate limit per second for the whole Cadence cluster that domains borrow from once their own domain rate limit is exceeded",
+		Type:         IntType,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	FrontendQuotaShareRefreshInterval: {
+		Description:  "Interval at which the part of the cluster-wide rate limits enforced by the host is refreshed from the demand published by the other hosts",
+		Type:         DurationType,
+		DefaultValue: 10 * time.Second,
+		Bounds:       nonNegative,
+	},
+	FrontendDecisionResultCountLimit: {
+		Description:  "Max number of decisions per RespondDecisionTaskCompleted request",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	FrontendHistoryMgrNumConns: {
+		Description:  "For persistence cluster.NumConns",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	FrontendThrottledLogRPS: {
+		Description:  "Rate limit on number of log messages emitted per second for throttled logger",
+		Type:         IntType,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	FrontendShutdownDrainDuration: {
+		Description:  "Duration of traffic drain during shutdown",
+		Type:         DurationType,
+		DefaultValue: time.Duration(0),
+		Bounds:       nonNegative,
+	},
+	EnableClientVersionCheck: {
+		Description:  "Enables client version check for frontend",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	FrontendMaxBadBinaries: {
+		Description:  "Max number of bad binaries in domain config",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	FrontendFailoverCoolDown: {
+		Description:  "Duration between two domain failvoers",
+		Type:         DurationType,
+		Filters:      domainNameFilter,
+		DefaultValue: time.Minute,
+		Bounds:       nonNegative,
+	},
+	ValidSearchAttributes: {
+		Description: "Legal indexed keys that can be used in list APIs",
+		Type:        MapType,
+	},
+	SendRawWorkflowHistory: {
+		Description: "Whether to enable raw history retrieving, defaults to the static config of the frontend service",
+		Type:        BoolType,
+		Filters:     domainNameFilter,
+	},
+	SearchAttributesNumberOfKeysLimit: {
+		Description:  "Limit of number of keys",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	SearchAttributesSizeOfValueLimit: {
+		Description:  "Size limit of each value",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 2 * 1024,
+		Bounds:       nonNegative,
+	},
+	SearchAttributesTotalSizeLimit: {
+		Description:  "Size limit of the whole map",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 40 * 1024,
+		Bounds:       nonNegative,
+	},
+	VisibilityArchivalQueryMaxPageSize: {
+		Description:  "Maximum page size for a visibility archival query",
+		Type:         IntType,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	DomainFailoverRefreshInterval: {
+		Description:  "Domain failover refresh timer",
+		Type:         DurationType,
+		DefaultValue: 10 * time.Second,
+		Bounds:       nonNegative,
+	},
+	DomainFailoverRefreshTimerJitterCoefficient: {
+		Description:  "Jitter for domain failover refresh timer jitter",
+		Type:         FloatType,
+		DefaultValue: 0.1,
+		Bounds:       unitInterval,
+	},
+	FrontendErrorInjectionRate: {
+		Description:  "Rate for injecting random error in frontend client",
+		Type:         FloatType,
+		DefaultValue: 0.0,
+		Bounds:       unitInterval,
+	},
+	FrontendEmitSignalNameMetricsTag: {
+		Description:  "Enables emitting signal name tag in metrics in frontend client",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	MatchingRPS: {
+		Description:  "Request rate per second for each matching host",
+		Type:         IntType,
+		DefaultValue: 1200,
+		Bounds:       nonNegative,
+	},
+	MatchingDomainRPS: {
+		Description:  "Request rate per domain per second for each matching host",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	MatchingPersistenceMaxQPS: {
+		Description:  "Max qps matching host can query DB",
+		Type:         IntType,
+		DefaultValue: 3000,
+		Bounds:       nonNegative,
+	},
+	MatchingPersistenceGlobalMaxQPS: {
+		Description:  "Max qps matching cluster can query DB",
+		Type:         IntType,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	MatchingMinTaskThrottlingBurstSize: {
+		Description:  "Minimum burst size for task list throttling",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	MatchingGetTasksBatchSize: {
+		Description:  "Maximum batch size to fetch from the task buffer",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	MatchingLongPollExpirationInterval: {
+		Description:  "Long poll expiration interval in the matching service",
+		Type:         DurationType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: time.Minute,
+		Bounds:       nonNegative,
+	},
+	MatchingEnableSyncMatch: {
+		Description:  "To enable sync match",
+		Type:         BoolType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: true,
+	},
+	MatchingUpdateAckInterval: {
+		Description:  "Interval for update ack",
+		Type:         DurationType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	MatchingIdleTasklistCheckInterval: {
+		Description:  "IdleTasklistCheckInterval",
+		Type:         DurationType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 5 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	MaxTasklistIdleTime: {
+		Description:  "Max time tasklist being idle",
+		Type:         DurationType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 5 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	MatchingOutstandingTaskAppendsThreshold: {
+		Description:  "Threshold for outstanding task appends",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 250,
+		Bounds:       nonNegative,
+	},
+	MatchingMaxTaskBatchSize: {
+		Description:  "Max batch size for task writer",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	MatchingMaxTaskDeleteBatchSize: {
+		Description:  "Max batch size for range deletion of tasks",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	MatchingThrottledLogRPS: {
+		Description:  "Rate limit on number of log messages emitted per second for throttled logger",
+		Type:         IntType,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	MatchingNumTasklistWritePartitions: {
+		Description:  "Number of write partitions for a task list",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	MatchingNumTasklistReadPartitions: {
+		Description:  "Number of read partitions for a task list",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	MatchingForwarderMaxOutstandingPolls: {
+		Description:  "Max number of inflight polls from the forwarder",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	MatchingForwarderMaxOutstandingTasks: {
+		Description:  "Max number of inflight addTask/queryTask from the forwarder",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	MatchingForwarderMaxRatePerSecond: {
+		Description:  "Max rate at which add/query can be forwarded",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	MatchingForwarderMaxChildrenPerNode: {
+		Description:  "Max number of children per node in the task list partition tree",
+		Type:         IntType,
+		Filters:      taskListInfoFilters,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	MatchingShutdownDrainDuration: {
+		Description:  "Duration of traffic drain during shutdown",
+		Type:         DurationType,
+		DefaultValue: time.Duration(0),
+		Bounds:       nonNegative,
+	},
+	MatchingErrorInjectionRate: {
+		Description:  "Rate for injecting random error in matching client",
+		Type:         FloatType,
+		DefaultValue: 0.0,
+		Bounds:       unitInterval,
+	},
+	MatchingEnableTaskInfoLogByDomainID: {
+		Description:  "Enables info level logs for decision/activity task based on the request domainID",
+		Type:         BoolType,
+		Filters:      domainIDFilter,
+		DefaultValue: false,
+	},
+	HistoryRPS: {
+		Description:  "Request rate per second for each history host",
+		Type:         IntType,
+		DefaultValue: 3000,
+		Bounds:       nonNegative,
+	},
+	HistoryPersistenceMaxQPS: {
+		Description:  "Max qps history host can query DB",
+		Type:         IntType,
+		DefaultValue: 9000,
+		Bounds:       nonNegative,
+	},
+	HistoryPersistenceGlobalMaxQPS: {
+		Description:  "Max qps history cluster can query DB",
+		Type:         IntType,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	HistoryVisibilityOpenMaxQPS: {
+		Description:  "Max qps one history host can write visibility open_executions",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 300,
+		Bounds:       nonNegative,
+	},
+	HistoryVisibilityClosedMaxQPS: {
+		Description:  "Max qps one history host can write visibility closed_executions",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 300,
+		Bounds:       nonNegative,
+	},
+	HistoryLongPollExpirationInterval: {
+		Description:  "Long poll expiration interval in the history service",
+		Type:         DurationType,
+		Filters:      domainNameFilter,
+		DefaultValue: time.Second * 20,
+		Bounds:       nonNegative,
+	},
+	HistoryCacheInitialSize: {
+		Description:  "Initial size of history cache",
+		Type:         IntType,
+		DefaultValue: 128,
+		Bounds:       nonNegative,
+	},
+	HistoryCacheMaxSize: {
+		Description:  "Max size of history cache",
+		Type:         IntType,
+		DefaultValue: 512,
+		Bounds:       nonNegative,
+	},
+	HistoryCacheTTL: {
+		Description:  "TTL of history cache",
+		Type:         DurationType,
+		DefaultValue: time.Hour,
+		Bounds:       nonNegative,
+	},
+	HistoryShutdownDrainDuration: {
+		Description:  "Duration of traffic drain during shutdown",
+		Type:         DurationType,
+		DefaultValue: time.Duration(0),
+		Bounds:       nonNegative,
+	},
+	EventsCacheInitialCount: {
+		Description:  "Initial count of events cache",
+		Type:         IntType,
+		DefaultValue: 128,
+		Bounds:       nonNegative,
+	},
+	EventsCacheMaxCount: {
+		Description:  "Max count of events cache",
+		Type:         IntType,
+		DefaultValue: 512,
+		Bounds:       nonNegative,
+	},
+	EventsCacheMaxSize: {
+		Description:  "Max size of events cache in bytes",
+		Type:         IntType,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	EventsCacheTTL: {
+		Description:  "TTL of events cache",
+		Type:         DurationType,
+		DefaultValue: time.Hour,
+		Bounds:       nonNegative,
+	},
+	EventsCacheGlobalEnable: {
+		Description:  "Enables global cache over all history shards",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	EventsCacheGlobalInitialCount: {
+		Description:  "Initial count of global events cache",
+		Type:         IntType,
+		DefaultValue: 4096,
+		Bounds:       nonNegative,
+	},
+	EventsCacheGlobalMaxCount: {
+		Description:  "Max count of global events cache",
+		Type:         IntType,
+		DefaultValue: 131072,
+		Bounds:       nonNegative,
+	},
+	AcquireShardInterval: {
+		Description:  "Interval that timer used to acquire shard",
+		Type:         DurationType,
+		DefaultValue: time.Minute,
+		Bounds:       nonNegative,
+	},
+	AcquireShardConcurrency: {
+		Description:  "Number of goroutines that can be used to acquire shards in the shard controller.",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	StandbyClusterDelay: {
+		Description:  "Artificial delay added to standby cluster's view of active cluster's time",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	StandbyTaskMissingEventsResendDelay: {
+		Description:  "Amount of time standby cluster's will wait (if events are missing)before calling remote for missing events",
+		Type:         DurationType,
+		DefaultValue: 15 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	StandbyTaskMissingEventsDiscardDelay: {
+		Description:  "Amount of time standby cluster's will wait (if events are missing)before discarding the task",
+		Type:         DurationType,
+		DefaultValue: 25 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	TaskProcessRPS: {
+		Description:  "Task processing rate per second for each domain",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	TaskSchedulerType: {
+		Description:  "Task scheduler type for priority task processor",
+		Type:         IntType,
+		DefaultValue: 2,
+		Bounds:       nonNegative,
+	},
+	TaskSchedulerWorkerCount: {
+		Description:  "Number of workers per host in task scheduler",
+		Type:         IntType,
+		DefaultValue: 200,
+		Bounds:       nonNegative,
+	},
+	TaskSchedulerShardWorkerCount: {
+		Description:  "Number of worker per shard in task scheduler",
+		Type:         IntType,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	TaskSchedulerQueueSize: {
+		Description:  "Size of task channel for host level task scheduler",
+		Type:         IntType,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	TaskSchedulerShardQueueSize: {
+		Description:  "Size of task channel for shard level task scheduler",
+		Type:         IntType,
+		DefaultValue: 200,
+		Bounds:       nonNegative,
+	},
+	TaskSchedulerDispatcherCount: {
+		Description:  "Number of task dispatcher in task scheduler (only applies to host level task scheduler)",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	TaskSchedulerRoundRobinWeights: {
+		Description: "Priority weight for weighted round robin task scheduler",
+		Type:        MapType,
+	},
+	TaskSchedulerDomainWeight: {
+		Description:  "Domain weight for hierarchical fair task scheduler, the number of tasks of the domain dispatched per turn within a priority",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	TaskCriticalRetryCount: {
+		Description:  "Critical retry count for background tasks when task attempt exceeds this threshold: - task attempt metrics and additional error logs will be emitted - task priority will be lowered",
+		Type:         IntType,
+		DefaultValue: 50,
+		Bounds:       nonNegative,
+	},
+	ActiveTaskRedispatchInterval: {
+		Description:  "Active task redispatch interval",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Second,
+		Bounds:       nonNegative,
+	},
+	StandbyTaskRedispatchInterval: {
+		Description:  "Standby task redispatch interval",
+		Type:         DurationType,
+		DefaultValue: 30 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TaskRedispatchIntervalJitterCoefficient: {
+		Description:  "Task redispatch interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	StandbyTaskReReplicationContextTimeout: {
+		Description:  "Context timeout for standby task re-replication",
+		Type:         DurationType,
+		Filters:      domainIDFilter,
+		DefaultValue: 3 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	ResurrectionCheckMinDelay: {
+		Description:  "Minimal timer processing delay before scanning history to see if there's a resurrected timer/activity",
+		Type:         DurationType,
+		DefaultValue: 24 * time.Hour,
+		Bounds:       nonNegative,
+	},
+	QueueProcessorEnableSplit: {
+		Description:  "Indicates whether processing queue split policy should be enabled",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	QueueProcessorSplitMaxLevel: {
+		Description:  "Max processing queue level",
+		Type:         IntType,
+		DefaultValue: 2,
+		Bounds:       nonNegative,
+	},
+	QueueProcessorEnableRandomSplitByDomainID: {
+		Description:  "Indicates whether random queue split policy should be enabled for a domain",
+		Type:         BoolType,
+		Filters:      domainIDFilter,
+		DefaultValue: false,
+	},
+	QueueProcessorRandomSplitProbability: {
+		Description:  "Probability for a domain to be split to a new processing queue",
+		Type:         FloatType,
+		DefaultValue: 0.01,
+		Bounds:       unitInterval,
+	},
+	QueueProcessorEnablePendingTaskSplitByDomainID: {
+		Description:  "Indicates whether pending task split policy should be enabled",
+		Type:         BoolType,
+		Filters:      domainIDFilter,
+		DefaultValue: false,
+	},
+	QueueProcessorPendingTaskSplitThreshold: {
+		Description: "Threshold for the number of pending tasks per domain",
+		Type:        MapType,
+	},
+	QueueProcessorEnableStuckTaskSplitByDomainID: {
+		Description:  "Indicates whether stuck task split policy should be enabled",
+		Type:         BoolType,
+		Filters:      domainIDFilter,
+		DefaultValue: false,
+	},
+	QueueProcessorStuckTaskSplitThreshold: {
+		Description: "Threshold for the number of attempts of a task",
+		Type:        MapType,
+	},
+	QueueProcessorSplitLookAheadDurationByDomainID: {
+		Description:  "Look ahead duration when spliting a domain to a new processing queue",
+		Type:         DurationType,
+		Filters:      domainIDFilter,
+		DefaultValue: 20 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	QueueProcessorPollBackoffInterval: {
+		Description:  "Backoff duration when queue processor is throttled",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Second,
+		Bounds:       nonNegative,
+	},
+	QueueProcessorPollBackoffIntervalJitterCoefficient: {
+		Description:  "Backoff interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	QueueProcessorEnablePersistQueueStates: {
+		Description:  "Indicates whether processing queue states should be persisted",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	QueueProcessorEnableLoadQueueStates: {
+		Description:  "Indicates whether processing queue states should be loaded",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	TimerTaskBatchSize: {
+		Description:  "Batch size for timer processor to process tasks",
+		Type:         IntType,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	TimerTaskDeleteBatchSize: {
+		Description:  "Batch size for timer processor to delete timer tasks",
+		Type:         IntType,
+		DefaultValue: 4000,
+		Bounds:       nonNegative,
+	},
+	TimerTaskWorkerCount: {
+		Description:  "Number of task workers for timer processor",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorGetFailureRetryCount: {
+		Description:  "Retry count for timer processor get failure operation",
+		Type:         IntType,
+		DefaultValue: 5,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorCompleteTimerFailureRetryCount: {
+		Description:  "Retry count for timer processor complete timer operation",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorUpdateAckInterval: {
+		Description:  "Update interval for timer processor",
+		Type:         DurationType,
+		DefaultValue: 30 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorUpdateAckIntervalJitterCoefficient: {
+		Description:  "Update interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	TimerProcessorCompleteTimerInterval: {
+		Description:  "Complete timer interval for timer processor",
+		Type:         DurationType,
+		DefaultValue: 60 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorFailoverMaxPollRPS: {
+		Description:  "Max poll rate per second for timer processor",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorMaxPollRPS: {
+		Description:  "Max poll rate per second for timer processor",
+		Type:         IntType,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorMaxPollInterval: {
+		Description:  "Max poll interval for timer processor",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorMaxPollIntervalJitterCoefficient: {
+		Description:  "Max poll interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	TimerProcessorSplitQueueInterval: {
+		Description:  "Split processing queue interval for timer processor",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorSplitQueueIntervalJitterCoefficient: {
+		Description:  "Split processing queue interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	TimerProcessorMaxRedispatchQueueSize: {
+		Description:  "Threshold of the number of tasks in the redispatch queue for timer processor",
+		Type:         IntType,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorMaxTimeShift: {
+		Description:  "Max shift timer processor can have",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorHistoryArchivalSizeLimit: {
+		Description:  "Max history size for inline archival",
+		Type:         IntType,
+		DefaultValue: 500 * 1024,
+		Bounds:       nonNegative,
+	},
+	TimerProcessorArchivalTimeLimit: {
+		Description:  "Upper time limit for inline history archival",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TransferTaskBatchSize: {
+		Description:  "Batch size for transferQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	TransferTaskDeleteBatchSize: {
+		Description:  "Batch size for transferQueueProcessor to delete transfer tasks",
+		Type:         IntType,
+		DefaultValue: 4000,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorFailoverMaxPollRPS: {
+		Description:  "Max poll rate per second for transferQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorMaxPollRPS: {
+		Description:  "Max poll rate per second for transferQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	TransferTaskWorkerCount: {
+		Description:  "Number of worker for transferQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorCompleteTransferFailureRetryCount: {
+		Description:  "Times of retry for failure",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorMaxPollInterval: {
+		Description:  "Max poll interval for transferQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorMaxPollIntervalJitterCoefficient: {
+		Description:  "Max poll interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	TransferProcessorSplitQueueInterval: {
+		Description:  "Split processing queue interval for transferQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorSplitQueueIntervalJitterCoefficient: {
+		Description:  "Split processing queue interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	TransferProcessorUpdateAckInterval: {
+		Description:  "Update interval for transferQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 30 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorUpdateAckIntervalJitterCoefficient: {
+		Description:  "Update interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	TransferProcessorCompleteTransferInterval: {
+		Description:  "Complete timer interval for transferQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 60 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorMaxRedispatchQueueSize: {
+		Description:  "Threshold of the number of tasks in the redispatch queue for transferQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorEnableValidator: {
+		Description:  "Whether validator should be enabled for transferQueueProcessor",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	TransferProcessorValidationInterval: {
+		Description:  "Interval for performing transfer queue validation",
+		Type:         DurationType,
+		DefaultValue: 30 * time.Second,
+		Bounds:       nonNegative,
+	},
+	TransferProcessorVisibilityArchivalTimeLimit: {
+		Description:  "Upper time limit for archiving visibility records",
+		Type:         DurationType,
+		DefaultValue: 200 * time.Millisecond,
+		Bounds:       nonNegative,
+	},
+	CrossClusterTaskBatchSize: {
+		Description:  "Batch size for crossClusterQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	CrossClusterTaskDeleteBatchSize: {
+		Description:  "Batch size for crossClusterQueueProcessor to delete cross cluster tasks",
+		Type:         IntType,
+		DefaultValue: 4000,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorMaxPollRPS: {
+		Description:  "Max poll rate per second for crossClusterQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	CrossClusterTaskWorkerCount: {
+		Description:  "Number of worker for crossClusterQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorCompleteTaskFailureRetryCount: {
+		Description:  "Times of retry for failure",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorMaxPollInterval: {
+		Description:  "Max poll interval for crossClusterQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorMaxPollIntervalJitterCoefficient: {
+		Description:  "Max poll interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	CrossClusterProcessorSplitQueueInterval: {
+		Description:  "Split processing queue interval for crossClusterQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorSplitQueueIntervalJitterCoefficient: {
+		Description:  "Split processing queue interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	CrossClusterProcessorUpdateAckInterval: {
+		Description:  "Update interval for crossClusterQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 30 * time.Second,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorUpdateAckIntervalJitterCoefficient: {
+		Description:  "Update interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	CrossClusterProcessorCompleteTaskInterval: {
+		Description:  "Complete timer interval for crossClusterQueueProcessor",
+		Type:         DurationType,
+		DefaultValue: 60 * time.Second,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorMaxRedispatchQueueSize: {
+		Description:  "Threshold of the number of tasks in the redispatch queue for crossClusterQueueProcessor",
+		Type:         IntType,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorEnableValidator: {
+		Description:  "Whether validator should be enabled for crossClusterQueueProcessor",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	CrossClusterProcessorValidationInterval: {
+		Description:  "Interval for performing cross cluster queue validation",
+		Type:         DurationType,
+		DefaultValue: 30 * time.Second,
+		Bounds:       nonNegative,
+	},
+	CrossClusterProcessorValidationIntervalJitterCoefficient: {
+		Description:  "Update interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	ReplicatorTaskBatchSize: {
+		Description: "Batch size for ReplicatorProcessor, defaults to 100 for the replicator and 25 for the shard",
+		Type:        IntType,
+		Filters:     shardIDFilter,
+		Bounds:      nonNegative,
+	},
+	ReplicatorTaskDeleteBatchSize: {
+		Description:  "Batch size for ReplicatorProcessor to delete replication tasks",
+		Type:         IntType,
+		DefaultValue: 4000,
+		Bounds:       nonNegative,
+	},
+	ReplicatorTaskWorkerCount: {
+		Description:  "Number of worker for ReplicatorProcessor",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	ReplicatorReadTaskMaxRetryCount: {
+		Description:  "Number of read replication task retry time",
+		Type:         IntType,
+		DefaultValue: 3,
+		Bounds:       nonNegative,
+	},
+	ReplicatorProcessorMaxPollRPS: {
+		Description:  "Max poll rate per second for ReplicatorProcessor",
+		Type:         IntType,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	ReplicatorProcessorMaxPollInterval: {
+		Description:  "Max poll interval for ReplicatorProcessor",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient: {
+		Description:  "Max poll interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	ReplicatorProcessorUpdateAckInterval: {
+		Description:  "Update interval for ReplicatorProcessor",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: {
+		Description:  "Update interval jitter coefficient",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	ReplicatorProcessorMaxRedispatchQueueSize: {
+		Description:  "Threshold of the number of tasks in the redispatch queue for ReplicatorProcessor",
+		Type:         IntType,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	ReplicatorProcessorEnablePriorityTaskProcessor: {
+		Description:  "Indicates whether priority task processor should be used for ReplicatorProcessor",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	ReplicatorUpperLatency: {
+		Description:  "Indicates the max allowed replication latency between clusters",
+		Type:         DurationType,
+		DefaultValue: 40 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ExecutionMgrNumConns: {
+		Description:  "Persistence connections number for ExecutionManager",
+		Type:         IntType,
+		DefaultValue: 50,
+		Bounds:       nonNegative,
+	},
+	HistoryMgrNumConns: {
+		Description:  "Persistence connections number for HistoryManager",
+		Type:         IntType,
+		DefaultValue: 50,
+		Bounds:       nonNegative,
+	},
+	MaximumBufferedEventsBatch: {
+		Description:  "Max number of buffer event in mutable state",
+		Type:         IntType,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	MaximumSignalsPerExecution: {
+		Description:  "Max number of signals supported by single execution",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 10000,
+		Bounds:       nonNegative,
+	},
+	ShardUpdateMinInterval: {
+		Description:  "Minimal time interval which the shard info can be updated",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	ShardSyncMinInterval: {
+		Description:  "Minimal time interval which the shard info should be sync to remote",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	DefaultEventEncoding: {
+		Description:  "Encoding type for history events",
+		Type:         StringType,
+		Filters:      domainNameFilter,
+		DefaultValue: "thriftrw",
+	},
+	NumArchiveSystemWorkflows: {
+		Description:  "Key for number of archive system workflows running in total",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	ArchiveRequestRPS: {
+		Description:  "Rate limit on the number of archive request per second",
+		Type:         IntType,
+		DefaultValue: 300,
+		Bounds:       nonNegative,
+	},
+	EnableAdminProtection: {
+		Description:  "Whether to enable admin checking",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	AdminOperationToken: {
+		Description:  "Token to pass admin checking",
+		Type:         StringType,
+		DefaultValue: "CadenceTeamONLY",
+	},
+	HistoryMaxAutoResetPoints: {
+		Description:  "Key for max number of auto reset points stored in mutableState",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	EnableParentClosePolicy: {
+		Description:  "Whether to  ParentClosePolicy",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: true,
+	},
+	ParentClosePolicyThreshold: {
+		Description:  "Decides that parent close policy will be processed by sys workers(if enabled) ifthe number of children greater than or equal to this threshold",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	NumParentClosePolicySystemWorkflows: {
+		Description:  "Key for number of parentClosePolicy system workflows running in total",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	HistoryThrottledLogRPS: {
+		Description:  "Rate limit on number of log messages emitted per second for throttled logger",
+		Type:         IntType,
+		DefaultValue: 4,
+		Bounds:       nonNegative,
+	},
+	StickyTTL: {
+		Description:  "To expire a sticky tasklist if no update more than this duration",
+		Type:         DurationType,
+		Filters:      domainNameFilter,
+		DefaultValue: time.Hour * 24 * 365,
+		Bounds:       nonNegative,
+	},
+	DecisionHeartbeatTimeout: {
+		Description:  "For decision heartbeat",
+		Type:         DurationType,
+		Filters:      domainNameFilter,
+		DefaultValue: time.Minute * 30,
+		Bounds:       nonNegative,
+	},
+	DecisionRetryCriticalAttempts: {
+		Description:  "Decision attempt threshold for logging and emiting metrics",
+		Type:         IntType,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	EnableDropStuckTaskByDomainID: {
+		Description:  "Whether stuck timer/transfer task should be dropped for a domain",
+		Type:         BoolType,
+		Filters:      domainIDFilter,
+		DefaultValue: false,
+	},
+	EnableConsistentQuery: {
+		Description:  "Indicates if consistent query is enabled for the cluster",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	EnableConsistentQueryByDomain: {
+		Description:  "Indicates if consistent query is enabled for a domain",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	EnableCrossClusterOperations: {
+		Description:  "Indicates if cross cluster operations can be scheduled for a domain",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	MaxBufferedQueryCount: {
+		Description:  "Indicates the maximum number of queries which can be buffered at a given time for a single workflow",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	MutableStateChecksumGenProbability: {
+		Description:  "Probability [0-100] that checksum will be generated for mutable state",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       percentage,
+	},
+	MutableStateChecksumVerifyProbability: {
+		Description:  "Probability [0-100] that checksum will be verified for mutable state",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       percentage,
+	},
+	MutableStateChecksumInvalidateBefore: {
+		Description:  "Epoch timestamp before which all checksums are to be discarded",
+		Type:         FloatType,
+		DefaultValue: 0.0,
+		Bounds:       nonNegative,
+	},
+	NotifyFailoverMarkerInterval: {
+		Description:  "Determines the frequency to notify failover marker",
+		Type:         DurationType,
+		DefaultValue: 5 * time.Second,
+		Bounds:       nonNegative,
+	},
+	NotifyFailoverMarkerTimerJitterCoefficient: {
+		Description:  "Jitter for failover marker notifier timer",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	EnableActivityLocalDispatchByDomain: {
+		Description:  "Allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	HistoryErrorInjectionRate: {
+		Description:  "Rate for injecting random error in history client",
+		Type:         FloatType,
+		DefaultValue: 0.0,
+		Bounds:       unitInterval,
+	},
+	HistoryEnableTaskInfoLogByDomainID: {
+		Description:  "Enables info level logs for decision/activity task based on the request domainID",
+		Type:         BoolType,
+		Filters:      domainIDFilter,
+		DefaultValue: false,
+	},
+	ActivityMaxScheduleToStartTimeoutForRetry: {
+		Description:  "Maximum value allowed when overwritting the schedule to start timeout for activities with retry policy",
+		Type:         DurationType,
+		Filters:      domainNameFilter,
+		DefaultValue: 30 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskFetcherParallelism: {
+		Description:  "Determines how many go routines we spin up for fetching tasks",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskFetcherAggregationInterval: {
+		Description:  "Determines how frequently the fetch requests are sent",
+		Type:         DurationType,
+		DefaultValue: 2 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskFetcherTimerJitterCoefficient: {
+		Description:  "Jitter for fetcher timer",
+		Type:         FloatType,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	ReplicationTaskFetcherErrorRetryWait: {
+		Description:  "Wait time when fetcher encounters error",
+		Type:         DurationType,
+		DefaultValue: time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskFetcherServiceBusyWait: {
+		Description:  "Wait time when fetcher encounters service busy error",
+		Type:         DurationType,
+		DefaultValue: 60 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorErrorRetryWait: {
+		Description:  "Initial retry wait when we see errors in applying replication tasks",
+		Type:         DurationType,
+		Filters:      shardIDFilter,
+		DefaultValue: 50 * time.Millisecond,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorErrorRetryMaxAttempts: {
+		Description:  "Max retry attempts for applying replication tasks",
+		Type:         IntType,
+		Filters:      shardIDFilter,
+		DefaultValue: 10,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorErrorSecondRetryWait: {
+		Description:  "Initial retry wait for the second phase retry",
+		Type:         DurationType,
+		Filters:      shardIDFilter,
+		DefaultValue: 5 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorErrorSecondRetryMaxWait: {
+		Description:  "Max wait time for the second phase retry",
+		Type:         DurationType,
+		Filters:      shardIDFilter,
+		DefaultValue: 30 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorErrorSecondRetryExpiration: {
+		Description:  "Expiration duration for the second phase retry",
+		Type:         DurationType,
+		Filters:      shardIDFilter,
+		DefaultValue: 5 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorNoTaskInitialWait: {
+		Description:  "Wait time when not ask is returned",
+		Type:         DurationType,
+		Filters:      shardIDFilter,
+		DefaultValue: 2 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorCleanupInterval: {
+		Description:  "Determines how frequently the cleanup replication queue",
+		Type:         DurationType,
+		Filters:      shardIDFilter,
+		DefaultValue: 1 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorCleanupJitterCoefficient: {
+		Description:  "Jitter for cleanup timer",
+		Type:         FloatType,
+		Filters:      shardIDFilter,
+		DefaultValue: 0.15,
+		Bounds:       unitInterval,
+	},
+	ReplicationTaskProcessorReadHistoryBatchSize: {
+		Description:  "Batch size to read history events",
+		Type:         IntType,
+		DefaultValue: 5,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorStartWait: {
+		Description:  "Wait time before each task processing batch",
+		Type:         DurationType,
+		Filters:      shardIDFilter,
+		DefaultValue: 5 * time.Second,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorStartWaitJitterCoefficient: {
+		Description:  "Jitter for batch start wait timer",
+		Type:         FloatType,
+		Filters:      shardIDFilter,
+		DefaultValue: 0.9,
+		Bounds:       unitInterval,
+	},
+	ReplicationTaskProcessorHostQPS: {
+		Description:  "Qps of task processing rate limiter on host level",
+		Type:         FloatType,
+		DefaultValue: 1500.0,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorShardQPS: {
+		Description:  "Qps of task processing rate limiter on shard level",
+		Type:         FloatType,
+		DefaultValue: 5.0,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorSchedulerType: {
+		Description:  "Scheduler type used to apply the fetched replication tasks, hierarchical fair applies the tasks of different domains concurrently and fairly",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskProcessorSchedulerWorkerCount: {
+		Description:  "Number of workers of the host level replication task scheduler",
+		Type:         IntType,
+		DefaultValue: 200,
+		Bounds:       nonNegative,
+	},
+	ReplicationTaskGenerationQPS: {
+		Description:  "Wait time between each replication task generation qps",
+		Type:         FloatType,
+		DefaultValue: 100.0,
+		Bounds:       nonNegative,
+	},
+	WorkerPersistenceMaxQPS: {
+		Description:  "Max qps worker host can query DB",
+		Type:         IntType,
+		DefaultValue: 500,
+		Bounds:       nonNegative,
+	},
+	WorkerPersistenceGlobalMaxQPS: {
+		Description:  "Max qps worker cluster can query DB",
+		Type:         IntType,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	WorkerReplicationTaskMaxRetryDuration: {
+		Description:  "Max retry duration for any task",
+		Type:         DurationType,
+		DefaultValue: 10 * time.Minute,
+		Bounds:       nonNegative,
+	},
+	WorkerIndexerConcurrency: {
+		Description:  "Max concurrent messages to be processed at any given time",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	WorkerESProcessorNumOfWorkers: {
+		Description:  "Num of workers for esProcessor",
+		Type:         IntType,
+		DefaultValue: 1,
+		Bounds:       nonNegative,
+	},
+	WorkerESProcessorBulkActions: {
+		Description:  "Max number of requests in bulk for esProcessor",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	WorkerESProcessorBulkSize: {
+		Description:  "Max total size of bulk in bytes for esProcessor",
+		Type:         IntType,
+		DefaultValue: 2 << 24,
+		Bounds:       nonNegative,
+	},
+	WorkerESProcessorFlushInterval: {
+		Description:  "Flush interval for esProcessor",
+		Type:         DurationType,
+		DefaultValue: 1 * time.Second,
+		Bounds:       nonNegative,
+	},
+	WorkerArchiverConcurrency: {
+		Description:  "Controls the number of coroutines handling archival work per archival workflow",
+		Type:         IntType,
+		DefaultValue: 50,
+		Bounds:       nonNegative,
+	},
+	WorkerArchivalsPerIteration: {
+		Description:  "Controls the number of archivals handled in each iteration of archival workflow",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	WorkerTimeLimitPerArchivalIteration: {
+		Description: "Controls the time limit of each iteration of archival workflow, defaults to the max archival iteration timeout",
+		Type:        DurationType,
+		Bounds:      nonNegative,
+	},
+	WorkerThrottledLogRPS: {
+		Description:  "Rate limit on number of log messages emitted per second for throttled logger",
+		Type:         IntType,
+		DefaultValue: 20,
+		Bounds:       nonNegative,
+	},
+	ScannerPersistenceMaxQPS: {
+		Description:  "Maximum rate of persistence calls from worker.Scanner",
+		Type:         IntType,
+		DefaultValue: 5,
+		Bounds:       nonNegative,
+	},
+	ScannerGetOrphanTasksPageSize: {
+		Description:  "Maximum number of orphans to delete in one batch",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	ScannerBatchSizeForTasklistHandler: {
+		Description:  "For: 1. max number of tasks to query per call(get tasks for tasklist) in the scavenger handler. 2. The scavenger then uses the return to decide if a tasklist can be deleted. It's better to keep it a relatively high number to let it be more efficient.",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	EnableCleaningOrphanTaskInTasklistScavenger: {
+		Description:  "Indicates if enabling the scanner to clean up orphan tasks",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	ScannerMaxTasksProcessedPerTasklistJob: {
+		Description:  "Number of tasks to process for a tasklist in each workflow run",
+		Type:         IntType,
+		DefaultValue: 256,
+		Bounds:       nonNegative,
+	},
+	TaskListScannerEnabled: {
+		Description:  "Indicates if task list scanner should be started as part of worker.Scanner",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	HistoryScannerEnabled: {
+		Description:  "Indicates if history scanner should be started as part of worker.Scanner",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	ArchivalRetentionScannerEnabled: {
+		Description:  "Indicates if archival retention scanner should be started as part of worker.Scanner",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	ArchivalRetentionDays: {
+		Description:  "Number of days archived histories and visibility records of a domain are kept after the workflow closed, 0 keeps them forever",
+		Type:         IntType,
+		Filters:      domainNameFilter,
+		DefaultValue: 0,
+		Bounds:       nonNegative,
+	},
+	ConcreteExecutionsScannerEnabled: {
+		Description:  "Indicates if executions scanner should be started as part of worker.Scanner",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	ConcreteExecutionsScannerConcurrency: {
+		Description:  "Indicates the concurrency of concrete execution scanner",
+		Type:         IntType,
+		DefaultValue: 25,
+		Bounds:       nonNegative,
+	},
+	ConcreteExecutionsScannerBlobstoreFlushThreshold: {
+		Description:  "Indicates the flush threshold of blobstore in concrete execution scanner",
+		Type:         IntType,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	ConcreteExecutionsScannerActivityBatchSize: {
+		Description:  "Indicates the batch size of scanner activities",
+		Type:         IntType,
+		DefaultValue: 25,
+		Bounds:       nonNegative,
+	},
+	ConcreteExecutionsScannerPersistencePageSize: {
+		Description:  "Indicates the page size of execution persistence fetches in concrete execution scanner",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	ConcreteExecutionsScannerInvariantCollectionMutableState: {
+		Description:  "Indicates if mutable state invariant checks should be run",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	ConcreteExecutionsScannerInvariantCollectionHistory: {
+		Description:  "Indicates if history invariant checks should be run",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	CurrentExecutionsScannerEnabled: {
+		Description:  "Indicates if current executions scanner should be started as part of worker.Scanner",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	CurrentExecutionsScannerConcurrency: {
+		Description:  "Indicates the concurrency of current executions scanner",
+		Type:         IntType,
+		DefaultValue: 25,
+		Bounds:       nonNegative,
+	},
+	CurrentExecutionsScannerBlobstoreFlushThreshold: {
+		Description:  "Indicates the flush threshold of blobstore in current executions scanner",
+		Type:         IntType,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	CurrentExecutionsScannerActivityBatchSize: {
+		Description:  "Indicates the batch size of scanner activities",
+		Type:         IntType,
+		DefaultValue: 25,
+		Bounds:       nonNegative,
+	},
+	CurrentExecutionsScannerPersistencePageSize: {
+		Description:  "Indicates the page size of execution persistence fetches in current executions scanner",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	CurrentExecutionsScannerInvariantCollectionHistory: {
+		Description:  "Indicates if history invariant checks should be run",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	CurrentExecutionsScannerInvariantCollectionMutableState: {
+		Description:  "Indicates if mutable state invariant checks should be run",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	EnableBatcher: {
+		Description:  "Decides whether start batcher in our worker",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	EnableScheduler: {
+		Description:  "Decides whether start scheduler in our worker",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	EnableParentClosePolicyWorker: {
+		Description:  "Decides whether or not enable system workers for processing parent close policy task",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	EnableStickyQuery: {
+		Description:  "Indicates if sticky query should be enabled per domain",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: true,
+	},
+	EnableFailoverManager: {
+		Description:  "Indicates if failover manager is enabled",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	EnableWorkflowShadower: {
+		Description:  "Indicates if workflow shadower is enabled",
+		Type:         BoolType,
+		DefaultValue: true,
+	},
+	ConcreteExecutionFixerDomainAllow: {
+		Description:  "Which domains are allowed to be fixed by concrete fixer workflow",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	CurrentExecutionFixerDomainAllow: {
+		Description:  "Which domains are allowed to be fixed by current fixer workflow",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	TimersScannerEnabled: {
+		Description:  "If timers scanner should be started as part of worker.Scanner",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	TimersFixerEnabled: {
+		Description:  "If timers fixer should be started as part of worker.Scanner",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	TimersScannerConcurrency: {
+		Description:  "Concurrency of timers scanner",
+		Type:         IntType,
+		DefaultValue: 5,
+		Bounds:       nonNegative,
+	},
+	TimersScannerPersistencePageSize: {
+		Description:  "Page size of timers persistence fetches in timers scanner",
+		Type:         IntType,
+		DefaultValue: 1000,
+		Bounds:       nonNegative,
+	},
+	TimersScannerBlobstoreFlushThreshold: {
+		Description:  "Threshold to flush blob store",
+		Type:         IntType,
+		DefaultValue: 100,
+		Bounds:       nonNegative,
+	},
+	TimersScannerActivityBatchSize: {
+		Description:  "TimersScannerActivityBatchSize",
+		Type:         IntType,
+		DefaultValue: 25,
+		Bounds:       nonNegative,
+	},
+	TimersScannerPeriodStart: {
+		Description:  "Interval start for fetching scheduled timers",
+		Type:         IntType,
+		DefaultValue: 24,
+		Bounds:       nonNegative,
+	},
+	TimersScannerPeriodEnd: {
+		Description:  "Interval end for fetching scheduled timers",
+		Type:         IntType,
+		DefaultValue: 3,
+		Bounds:       nonNegative,
+	},
+	TimersFixerDomainAllow: {
+		Description:  "Which domains are allowed to be fixed by timer fixer workflow",
+		Type:         BoolType,
+		Filters:      domainNameFilter,
+		DefaultValue: false,
+	},
+	ConcreteExecutionFixerEnabled: {
+		Description:  "If concrete execution fixer workflow is enabled",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	CurrentExecutionFixerEnabled: {
+		Description:  "If current execution fixer workflow is enabled",
+		Type:         BoolType,
+		DefaultValue: false,
+	},
+	EnableAuthorization: {
+		Description: "Key to enable authorization for a domain, only for extension binary:",
+		Type:        BoolType,
+	},
+	EnableServiceAuthorization: {
+		Description: "Key to enable authorization for a service, only for extension binary:",
+		Type:        BoolType,
+	},
+	EnableServiceAuthorizationLogOnly: {
+		Description: "Key to enable authorization logging for a service, only for extension binary:",
+		Type:        BoolType,
+	},
+	VisibilityArchivalQueryMaxRangeInDays: {
+		Description: "Usage: VisibilityArchivalQueryMaxRangeInDays is the maximum number of days for a visibility archival query",
+		Type:        IntType,
+		Bounds:      nonNegative,
+	},
+	VisibilityArchivalQueryMaxQPS: {
+		Description: "Usage: VisibilityArchivalQueryMaxQPS is the timeout for a visibility archival query",
+		Type:        IntType,
+		Bounds:      nonNegative,
+	},
+	EnableArchivalCompression: {
+		Description: "Indicates whether blobs are compressed before they are archived",
+		Type:        BoolType,
+	},
+	WorkerDeterministicConstructionCheckProbability: {
+		Description: "Controls the probability of running a deterministic construction check for any given archival",
+		Type:        FloatType,
+		Bounds:      unitInterval,
+	},
+	WorkerBlobIntegrityCheckProbability: {
+		Description: "Controls the probability of running an integrity check for any given archival",
+		Type:        FloatType,
+		Bounds:      unitInterval,
+	},
+}
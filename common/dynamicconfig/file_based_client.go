@@ -25,6 +25,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"reflect"
+	"sort"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -32,6 +35,7 @@ import (
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -61,18 +65,20 @@ type fileBasedClient struct {
 	config          *FileBasedClientConfig
 	doneCh          chan struct{}
 	logger          log.Logger
+	subscriptions   *SubscriptionManager
 }
 
 // NewFileBasedClient creates a file based client.
-func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh chan struct{}) (Client, error) {
+func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, metricsClient metrics.Client, doneCh chan struct{}) (Client, error) {
 	if err := validateConfig(config); err != nil {
 		return nil, err
 	}
 
 	client := &fileBasedClient{
-		config: config,
-		doneCh: doneCh,
-		logger: logger,
+		config:        config,
+		doneCh:        doneCh,
+		logger:        logger,
+		subscriptions: NewSubscriptionManager(logger, metricsClient),
 	}
 	if err := client.update(); err != nil {
 		return nil, err
@@ -187,6 +193,10 @@ func (fc *fileBasedClient) GetDurationValue(
 }
 
 func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
+	if err := ValidateValue(name, value, nil); err != nil {
+		return err
+	}
+
 	keyName := Keys[name]
 	currentValues := make(map[string][]*constrainedValue)
 
@@ -221,6 +231,17 @@ func (fc *fileBasedClient) ListValue(name Key) ([]*types.DynamicConfigEntry, err
 	return nil, errors.New("not supported for file based client")
 }
 
+func (fc *fileBasedClient) Subscribe(
+	name Key, filters map[Filter]interface{}, callback SubscriptionCallback,
+) (func(), error) {
+	return fc.subscriptions.Subscribe(name, filters, callback, fc.lookup), nil
+}
+
+func (fc *fileBasedClient) lookup(name Key, filters map[Filter]interface{}) interface{} {
+	val, _ := fc.getValueWithFilters(name, filters, nil)
+	return val
+}
+
 func (fc *fileBasedClient) update() error {
 	defer func() {
 		fc.lastUpdatedTime = time.Now()
@@ -262,12 +283,40 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 			}
 		}
 	}
+	// an invalid value only drops its key, which falls back to the default value, so that a typo
+	// doesn't prevent the rest of the file from being loaded
+	for keyName, reasons := range invalidValues(newValues) {
+		fc.logger.Warn("Skipped invalid dynamic config values",
+			tag.Key(keyName), tag.Error(errors.New(strings.Join(reasons, "; "))))
+		delete(newValues, keyName)
+	}
 
+	oldValues, _ := fc.values.Load().(map[string][]*constrainedValue)
 	fc.values.Store(newValues)
 	fc.logger.Info("Updated dynamic config")
+
+	if oldValues != nil {
+		fc.subscriptions.Notify(diffValues(oldValues, newValues), fc.lookup)
+	}
 	return nil
 }
 
+// diffValues returns the new values of the keys which changed, keyed by key name
+func diffValues(oldValues, newValues map[string][]*constrainedValue) map[string]interface{} {
+	changes := make(map[string]interface{})
+	for keyName, values := range newValues {
+		if !reflect.DeepEqual(oldValues[keyName], values) {
+			changes[keyName] = values
+		}
+	}
+	for keyName := range oldValues {
+		if _, ok := newValues[keyName]; !ok {
+			changes[keyName] = nil
+		}
+	}
+	return changes
+}
+
 func (fc *fileBasedClient) getValueWithFilters(key Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
 	keyName := Keys[key]
 	values := fc.values.Load().(map[string][]*constrainedValue)
@@ -289,6 +338,35 @@ func (fc *fileBasedClient) getValueWithFilters(key Key, filters map[Filter]inter
 	return defaultValue, nil
 }
 
+// invalidValues returns the reasons why the values do not match the definition of their key, keyed by key name
+func invalidValues(values map[string][]*constrainedValue) map[string][]string {
+	invalid := make(map[string][]string)
+	for keyName, s := range values {
+		key, ok := KeyNames[keyName]
+		if !ok {
+			continue
+		}
+		for _, cv := range s {
+			filters := make([]Filter, 0, len(cv.Constraints))
+			for name := range cv.Constraints {
+				filter := ParseFilter(name)
+				if filter == UnknownFilter {
+					invalid[keyName] = append(invalid[keyName], fmt.Sprintf("unknown filter %v for dynamic config %v", name, keyName))
+					continue
+				}
+				filters = append(filters, filter)
+			}
+			if err := ValidateValue(key, cv.Value, filters); err != nil {
+				invalid[keyName] = append(invalid[keyName], err.Error())
+			}
+		}
+	}
+	for _, reasons := range invalid {
+		sort.Strings(reasons)
+	}
+	return invalid
+}
+
 // match will return true if the constraints matches the filters or any subsets
 func match(v *constrainedValue, filters map[Filter]interface{}) bool {
 	if len(v.Constraints) > len(filters) {
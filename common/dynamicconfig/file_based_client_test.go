@@ -22,13 +22,18 @@ package dynamicconfig
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"gopkg.in/yaml.v2"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 )
 
 type fileBasedClientSuite struct {
@@ -49,7 +54,7 @@ func (s *fileBasedClientSuite) SetupSuite() {
 	s.client, err = NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second * 5,
-	}, log.NewNoop(), s.doneCh)
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), s.doneCh)
 	s.Require().NoError(err)
 }
 
@@ -218,7 +223,7 @@ func (s *fileBasedClientSuite) TestGetDurationValue_ParseFailed() {
 }
 
 func (s *fileBasedClientSuite) TestValidateConfig_ConfigNotExist() {
-	_, err := NewFileBasedClient(nil, nil, nil)
+	_, err := NewFileBasedClient(nil, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -226,7 +231,7 @@ func (s *fileBasedClientSuite) TestValidateConfig_FileNotExist() {
 	_, err := NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "file/not/exist.yaml",
 		PollInterval: time.Second * 10,
-	}, nil, nil)
+	}, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -234,7 +239,7 @@ func (s *fileBasedClientSuite) TestValidateConfig_ShortPollInterval() {
 	_, err := NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second,
-	}, nil, nil)
+	}, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -352,3 +357,128 @@ func (s *fileBasedClientSuite) TestUpdateConfig() {
 	err = client.UpdateValue(key, v)
 	s.NoError(err)
 }
+
+func (s *fileBasedClientSuite) TestSubscribe() {
+	f, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer os.Remove(f.Name())
+	writeConfig := func(content string) {
+		s.NoError(ioutil.WriteFile(f.Name(), []byte(content), fileMode))
+		// make sure the modification is detected even within the time precision of the file system
+		s.NoError(os.Chtimes(f.Name(), time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+	}
+	writeConfig(`
+testGetIntPropertyKey:
+- value: 10
+- value: 20
+  constraints:
+    domainName: samples-domain
+`)
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	scope := tally.NewTestScope("", nil)
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     f.Name(),
+		PollInterval: time.Minute,
+	}, log.NewNoop(), metrics.NewClient(scope, metrics.Common), doneCh)
+	s.NoError(err)
+
+	var values []interface{}
+	cancel, err := client.Subscribe(TestGetIntPropertyKey, map[Filter]interface{}{DomainName: "samples-domain"}, func(value interface{}) {
+		values = append(values, value)
+	})
+	s.NoError(err)
+
+	// the value for other filters changes
+	writeConfig(`
+testGetIntPropertyKey:
+- value: 30
+- value: 20
+  constraints:
+    domainName: samples-domain
+`)
+	s.NoError(client.(*fileBasedClient).update())
+	s.Empty(values)
+
+	writeConfig(`
+testGetIntPropertyKey:
+- value: 30
+`)
+	s.NoError(client.(*fileBasedClient).update())
+	s.Equal([]interface{}{30}, values)
+
+	cancel()
+	writeConfig(`
+testGetIntPropertyKey:
+- value: 40
+`)
+	s.NoError(client.(*fileBasedClient).update())
+	s.Equal([]interface{}{30}, values)
+
+	// the changes are audited whether or not they are subscribed to
+	changes := int64(0)
+	for _, counter := range scope.Snapshot().Counters() {
+		if counter.Name() == "dynamic_config_changes" && counter.Tags()["configKey"] == "testGetIntPropertyKey" {
+			changes += counter.Value()
+		}
+	}
+	s.Equal(int64(3), changes)
+}
+
+func (s *fileBasedClientSuite) TestValidation() {
+	f, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer os.Remove(f.Name())
+	s.NoError(ioutil.WriteFile(f.Name(), []byte(`
+frontend.rps:
+- value: 1200
+- value: 1000
+  constraints:
+    domainName: samples-domain
+system.enableGlobalDomain:
+- value: "yes"
+frontend.visibilityListMaxQPS:
+- value: 5
+`), fileMode))
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     f.Name(),
+		PollInterval: time.Minute,
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), doneCh)
+	s.NoError(err)
+
+	// only the invalid keys are skipped and fall back to their default value
+	rps, err := client.GetIntValue(FrontendRPS, nil, 1200)
+	s.Equal(NotFoundError, err)
+	s.Equal(1200, rps)
+	enabled, err := client.GetBoolValue(EnableGlobalDomain, nil, false)
+	s.Equal(NotFoundError, err)
+	s.False(enabled)
+	qps, err := client.GetIntValue(FrontendVisibilityListMaxQPS, nil, 10)
+	s.NoError(err)
+	s.Equal(5, qps)
+
+	s.Error(client.UpdateValue(FrontendRPS, "1200"))
+	s.NoError(client.UpdateValue(FrontendRPS, 1000))
+}
+
+func (s *fileBasedClientSuite) TestValidation_DevelopmentConfigs() {
+	for _, path := range []string{
+		"../../config/dynamicconfig/development.yaml",
+		"../../config/dynamicconfig/development_es.yaml",
+	} {
+		content, err := ioutil.ReadFile(path)
+		s.NoError(err)
+		values := make(map[string][]*constrainedValue)
+		s.NoError(yaml.Unmarshal(content, values))
+		for _, cvs := range values {
+			for _, cv := range cvs {
+				cv.Value, err = convertKeyTypeToString(cv.Value)
+				s.NoError(err)
+			}
+		}
+		s.Empty(invalidValues(values), path)
+	}
+}
@@ -25,27 +25,32 @@ import (
 	"sync"
 	"time"
 
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 )
 
 type inMemoryClient struct {
 	sync.RWMutex
 
-	globalValues map[Key]interface{}
+	globalValues  map[Key]interface{}
+	subscriptions *SubscriptionManager
 }
 
 // NewInMemoryClient creates a new in memory dynamic config client for testing purpose
 func NewInMemoryClient() Client {
 	return &inMemoryClient{
-		globalValues: make(map[Key]interface{}),
+		globalValues:  make(map[Key]interface{}),
+		subscriptions: NewSubscriptionManager(log.NewNoop(), metrics.NewNoopMetricsClient()),
 	}
 }
 
 func (mc *inMemoryClient) SetValue(key Key, value interface{}) {
 	mc.Lock()
-	defer mc.Unlock()
-
 	mc.globalValues[key] = value
+	mc.Unlock()
+
+	mc.subscriptions.Notify(map[string]interface{}{Keys[key]: value}, mc.lookup)
 }
 
 func (mc *inMemoryClient) GetValue(key Key, defaultValue interface{}) (interface{}, error) {
@@ -143,3 +148,14 @@ func (mc *inMemoryClient) RestoreValue(name Key, filters map[Filter]interface{})
 func (mc *inMemoryClient) ListValue(name Key) ([]*types.DynamicConfigEntry, error) {
 	return nil, errors.New("not supported for file based client")
 }
+
+func (mc *inMemoryClient) Subscribe(
+	name Key, filters map[Filter]interface{}, callback SubscriptionCallback,
+) (func(), error) {
+	return mc.subscriptions.Subscribe(name, filters, callback, mc.lookup), nil
+}
+
+func (mc *inMemoryClient) lookup(name Key, filters map[Filter]interface{}) interface{} {
+	val, _ := mc.GetValue(name, nil)
+	return val
+}
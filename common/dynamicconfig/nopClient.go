@@ -84,6 +84,13 @@ func (mc *nopClient) ListValue(name Key) ([]*types.DynamicConfigEntry, error) {
 	return nil, errors.New("not supported for file based client")
 }
 
+func (mc *nopClient) Subscribe(
+	name Key, filters map[Filter]interface{}, callback SubscriptionCallback,
+) (func(), error) {
+	// values never change
+	return func() {}, nil
+}
+
 // NewNopClient creates a nop client
 func NewNopClient() Client {
 	return &nopClient{}
@@ -0,0 +1,260 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ValueType is the type of the values of a dynamic config key
+type ValueType int
+
+const (
+	// UnknownType is the type of the keys which are not registered
+	UnknownType ValueType = iota
+	// IntType is the type of integer values
+	IntType
+	// FloatType is the type of float values
+	FloatType
+	// BoolType is the type of bool values
+	BoolType
+	// StringType is the type of string values
+	StringType
+	// DurationType is the type of duration values, encoded as strings such as "10s"
+	DurationType
+	// MapType is the type of map values
+	MapType
+)
+
+var valueTypes = []string{
+	"Unknown",
+	"Int",
+	"Float64",
+	"Bool",
+	"String",
+	"Duration",
+	"Map",
+}
+
+func (t ValueType) String() string {
+	if t <= UnknownType || t > MapType {
+		return valueTypes[UnknownType]
+	}
+	return valueTypes[t]
+}
+
+type (
+	// KeyDefinition declares the type, allowed filters, default value and bounds of a dynamic config key
+	KeyDefinition struct {
+		Key         Key
+		Description string
+		Type        ValueType
+		// Filters are the filters the values of the key can be constrained by, in addition to the cluster name
+		Filters []Filter
+		// DefaultValue is the value used when the key is not set, nil if it depends on the static config
+		DefaultValue interface{}
+		// Bounds limits the numeric and duration values, durations being compared in seconds
+		Bounds *Bounds
+		// AllowedValues limits the string values
+		AllowedValues []string
+	}
+
+	// Bounds is the inclusive range of the values of a numeric key
+	Bounds struct {
+		Min float64
+		Max float64
+	}
+)
+
+var (
+	nonNegative  = &Bounds{Min: 0, Max: math.MaxFloat64}
+	unitInterval = &Bounds{Min: 0, Max: 1}
+	percentage   = &Bounds{Min: 0, Max: 100}
+
+	domainNameFilter    = []Filter{DomainName}
+	domainIDFilter      = []Filter{DomainID}
+	taskListInfoFilters = []Filter{DomainName, TaskListName, TaskType}
+	shardIDFilter       = []Filter{ShardID}
+)
+
+func init() {
+	for key, definition := range keyDefinitions {
+		definition.Key = key
+		keyDefinitions[key] = definition
+	}
+}
+
+// GetKeyDefinition returns the definition of the key, and false if the key is not registered
+func GetKeyDefinition(key Key) (KeyDefinition, bool) {
+	definition, ok := keyDefinitions[key]
+	return definition, ok
+}
+
+// ListKeyDefinitions returns the definitions of all the registered keys, sorted by key name
+func ListKeyDefinitions() []KeyDefinition {
+	definitions := make([]KeyDefinition, 0, len(keyDefinitions))
+	for _, definition := range keyDefinitions {
+		definitions = append(definitions, definition)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Key.String() < definitions[j].Key.String()
+	})
+	return definitions
+}
+
+// ValidateValue returns an error if the value, constrained by the filters,
+// does not match the definition of the key. The values of the keys which are not registered are not validated.
+func ValidateValue(key Key, value interface{}, filters []Filter) error {
+	definition, ok := keyDefinitions[key]
+	if !ok {
+		return nil
+	}
+	if err := definition.validateFilters(filters); err != nil {
+		return invalidValueError(key, err)
+	}
+	if err := definition.validateValue(value); err != nil {
+		return invalidValueError(key, err)
+	}
+	return nil
+}
+
+func invalidValueError(key Key, err error) error {
+	return fmt.Errorf("invalid value for dynamic config %v: %v", key.String(), err)
+}
+
+func (d KeyDefinition) validateFilters(filters []Filter) error {
+	for _, filter := range filters {
+		if filter == ClusterName {
+			continue
+		}
+		allowed := false
+		for _, allowedFilter := range d.Filters {
+			if filter == allowedFilter {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("filter %v is not allowed, allowed filters are %v", filter.String(), d.filterNames())
+		}
+	}
+	return nil
+}
+
+func (d KeyDefinition) filterNames() string {
+	names := []string{ClusterName.String()}
+	for _, filter := range d.Filters {
+		names = append(names, filter.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+func (d KeyDefinition) validateValue(value interface{}) error {
+	switch d.Type {
+	case IntType:
+		intVal, ok := toFloat(value)
+		if !ok || intVal != math.Trunc(intVal) {
+			return fmt.Errorf("expected an Int, got %v (%T)", value, value)
+		}
+		return d.validateBounds(intVal)
+	case FloatType:
+		floatVal, ok := toFloat(value)
+		if !ok {
+			return fmt.Errorf("expected a Float64, got %v (%T)", value, value)
+		}
+		return d.validateBounds(floatVal)
+	case BoolType:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected a Bool, got %v (%T)", value, value)
+		}
+	case StringType:
+		stringVal, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a String, got %v (%T)", value, value)
+		}
+		if len(d.AllowedValues) > 0 {
+			for _, allowed := range d.AllowedValues {
+				if stringVal == allowed {
+					return nil
+				}
+			}
+			return fmt.Errorf("%q is not one of %v", stringVal, strings.Join(d.AllowedValues, ", "))
+		}
+	case DurationType:
+		var durationVal time.Duration
+		switch v := value.(type) {
+		case time.Duration:
+			durationVal = v
+		case string:
+			var err error
+			if durationVal, err = time.ParseDuration(v); err != nil {
+				return fmt.Errorf("expected a Duration such as \"10s\", got %q", v)
+			}
+		default:
+			return fmt.Errorf("expected a Duration such as \"10s\", got %v (%T)", value, value)
+		}
+		return d.validateBounds(durationVal.Seconds())
+	case MapType:
+		if value == nil || reflect.TypeOf(value).Kind() != reflect.Map {
+			return fmt.Errorf("expected a Map, got %v (%T)", value, value)
+		}
+	}
+	return nil
+}
+
+func (d KeyDefinition) validateBounds(value float64) error {
+	if d.Bounds == nil {
+		return nil
+	}
+	if value < d.Bounds.Min || value > d.Bounds.Max {
+		return fmt.Errorf("%v is out of bounds %v", value, d.Bounds.String())
+	}
+	return nil
+}
+
+func (b *Bounds) String() string {
+	if b.Max == math.MaxFloat64 {
+		return fmt.Sprintf("[%v, +inf)", b.Min)
+	}
+	return fmt.Sprintf("[%v, %v]", b.Min, b.Max)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
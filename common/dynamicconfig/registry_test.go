@@ -0,0 +1,110 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyDefinitions(t *testing.T) {
+	names := make(map[string]Key, len(Keys))
+	for key, keyName := range Keys {
+		if other, ok := names[keyName]; ok {
+			t.Errorf("keys %v and %v have the same name %v", int(key), int(other), keyName)
+		}
+		names[keyName] = key
+
+		if key == UnknownKey || strings.HasPrefix(keyName, "testGet") {
+			continue
+		}
+		definition, ok := GetKeyDefinition(key)
+		if !assert.True(t, ok, "key %v is not registered", keyName) {
+			continue
+		}
+		assert.Equal(t, key, definition.Key)
+		assert.NotEqual(t, UnknownType, definition.Type, "key %v has no type", keyName)
+		if definition.DefaultValue != nil {
+			assert.NoError(t, ValidateValue(key, definition.DefaultValue, definition.Filters), "default value of key %v is invalid", keyName)
+		}
+	}
+	for key := range keyDefinitions {
+		_, ok := Keys[key]
+		assert.True(t, ok, "registered key %v has no name", key)
+	}
+}
+
+func TestListKeyDefinitions(t *testing.T) {
+	definitions := ListKeyDefinitions()
+	require.Len(t, definitions, len(keyDefinitions))
+	for i := 1; i < len(definitions); i++ {
+		assert.True(t, definitions[i-1].Key.String() < definitions[i].Key.String())
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	tests := map[string]struct {
+		key     Key
+		value   interface{}
+		filters []Filter
+		valid   bool
+	}{
+		"int":                       {key: FrontendRPS, value: 100, valid: true},
+		"int from json":             {key: FrontendRPS, value: float64(100), valid: true},
+		"int with fraction":         {key: FrontendRPS, value: 100.5},
+		"int as string":             {key: FrontendRPS, value: "100"},
+		"negative int":              {key: FrontendRPS, value: -1},
+		"float":                     {key: ReplicationTaskGenerationQPS, value: 0.5, valid: true},
+		"float from int":            {key: ReplicationTaskGenerationQPS, value: 5, valid: true},
+		"probability out of bounds": {key: QueueProcessorRandomSplitProbability, value: 1.5},
+		"bool":                      {key: EnableGlobalDomain, value: true, valid: true},
+		"bool as string":            {key: EnableGlobalDomain, value: "true"},
+		"string":                    {key: DefaultEventEncoding, value: "thriftrw", valid: true},
+		"allowed string":            {key: AdvancedVisibilityWritingMode, value: "dual", valid: true},
+		"not allowed string":        {key: AdvancedVisibilityWritingMode, value: "both"},
+		"duration":                  {key: FrontendShutdownDrainDuration, value: "10s", valid: true},
+		"duration value":            {key: FrontendShutdownDrainDuration, value: 10 * time.Second, valid: true},
+		"duration as int":           {key: FrontendShutdownDrainDuration, value: 10},
+		"invalid duration":          {key: FrontendShutdownDrainDuration, value: "10 seconds"},
+		"negative duration":         {key: FrontendShutdownDrainDuration, value: "-1s"},
+		"map":                       {key: ValidSearchAttributes, value: map[string]interface{}{"DomainID": 1}, valid: true},
+		"map as bool":               {key: ValidSearchAttributes, value: true},
+		"allowed filter":            {key: FrontendMaxDomainRPSPerInstance, value: 10, filters: []Filter{DomainName}, valid: true},
+		"cluster filter":            {key: FrontendRPS, value: 10, filters: []Filter{ClusterName}, valid: true},
+		"not allowed filter":        {key: FrontendRPS, value: 10, filters: []Filter{DomainName}},
+		"unregistered key":          {key: TestGetIntPropertyKey, value: "anything", filters: []Filter{ShardID}, valid: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateValue(test.key, test.value, test.filters)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
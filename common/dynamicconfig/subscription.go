@@ -0,0 +1,143 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// SubscriptionCallback is called with the new value of a subscribed key once it changes.
+	// The value is nil when the key is not set anymore, in which case the default value applies.
+	SubscriptionCallback func(value interface{})
+
+	// LookupFunc returns the value of a key for the given filters, or nil if the key is not set
+	LookupFunc func(name Key, filters map[Filter]interface{}) interface{}
+
+	// SubscriptionManager keeps track of the subscriptions of a Client and notifies them
+	// once the values they subscribed to change. It is shared by the Client implementations.
+	SubscriptionManager struct {
+		logger        log.Logger
+		metricsClient metrics.Client
+
+		// notifyLock serializes the notifications so that the subscribers receive the changes in order
+		notifyLock sync.Mutex
+
+		sync.Mutex
+		nextID        int64
+		subscriptions map[string]map[int64]*subscription
+	}
+
+	subscription struct {
+		name     Key
+		filters  map[Filter]interface{}
+		callback SubscriptionCallback
+		value    interface{}
+	}
+)
+
+// NewSubscriptionManager creates a new subscription manager
+func NewSubscriptionManager(logger log.Logger, metricsClient metrics.Client) *SubscriptionManager {
+	return &SubscriptionManager{
+		logger:        logger,
+		metricsClient: metricsClient,
+		subscriptions: make(map[string]map[int64]*subscription),
+	}
+}
+
+// Subscribe registers the callback to be called once the value of the key for the filters changes.
+// It returns the function which cancels the subscription.
+func (m *SubscriptionManager) Subscribe(
+	name Key,
+	filters map[Filter]interface{},
+	callback SubscriptionCallback,
+	lookup LookupFunc,
+) func() {
+	keyName := Keys[name]
+	// the current value is looked up under the lock, so that a change stored
+	// concurrently is either already part of it or is notified afterwards
+	m.Lock()
+	defer m.Unlock()
+
+	m.nextID++
+	id := m.nextID
+	if _, ok := m.subscriptions[keyName]; !ok {
+		m.subscriptions[keyName] = make(map[int64]*subscription)
+	}
+	m.subscriptions[keyName][id] = &subscription{
+		name:     name,
+		filters:  filters,
+		callback: callback,
+		value:    lookup(name, filters),
+	}
+
+	return func() {
+		m.Lock()
+		defer m.Unlock()
+
+		delete(m.subscriptions[keyName], id)
+		if len(m.subscriptions[keyName]) == 0 {
+			delete(m.subscriptions, keyName)
+		}
+	}
+}
+
+// Notify audits the change of the values of the keys, keyed by key name, and calls back
+// the subscriptions to these keys whose value changed
+func (m *SubscriptionManager) Notify(changes map[string]interface{}, lookup LookupFunc) {
+	if len(changes) == 0 {
+		return
+	}
+
+	m.notifyLock.Lock()
+	defer m.notifyLock.Unlock()
+
+	var notified []func()
+	m.Lock()
+	for keyName, values := range changes {
+		m.logger.Info("Dynamic config key has changed", tag.Key(keyName), tag.Value(values))
+		m.metricsClient.Scope(metrics.DynamicConfigScope, metrics.DynamicConfigKeyTag(keyName)).IncCounter(metrics.DynamicConfigChanges)
+
+		for _, s := range m.subscriptions[keyName] {
+			value := lookup(s.name, s.filters)
+			if reflect.DeepEqual(value, s.value) {
+				continue
+			}
+			m.logger.Info("Notifying dynamic config subscriber",
+				tag.Key(getFilteredKeyAsString(s.name, s.filters)), tag.Value(value), tag.DefaultValue(s.value))
+			s.value = value
+			callback := s.callback
+			notified = append(notified, func() { callback(value) })
+		}
+	}
+	m.Unlock()
+
+	// the callbacks are called without holding the lock so that they can read the values
+	// and update the subscriptions
+	for _, notify := range notified {
+		notify()
+	}
+}
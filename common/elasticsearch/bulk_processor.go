@@ -0,0 +1,377 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+var _ GenericBulkProcessor = (*bulkProcessor)(nil)
+var _ GenericBulkableRequest = (*bulkRequest)(nil)
+
+var errBulkRetryExhausted = errors.New("bulk processor: retries exhausted for items with retriable status")
+
+// bulkRetriableStatusCode are the statuses of a bulk request or a bulk item which will be retried with backoff
+// 408 - Request Timeout
+// 429 - Too Many Requests
+// 500 - Node not connected
+// 503 - Service Unavailable
+// 507 - Insufficient Storage
+var bulkRetriableStatusCode = map[int]struct{}{408: {}, 429: {}, 500: {}, 503: {}, 507: {}}
+
+type (
+	// bulkCommitFunc sends the newline delimited requests to the _bulk endpoint of the cluster
+	bulkCommitFunc func(ctx context.Context, body []byte) (*GenericBulkResponse, *GenericError)
+
+	// bulkProcessor implements GenericBulkProcessor for clients which don't come with a bulk processor.
+	// Requests are batched until BulkActions or BulkSize is reached, or FlushInterval elapses,
+	// then committed by one of the workers.
+	bulkProcessor struct {
+		params     *BulkProcessorParameters
+		commitFunc bulkCommitFunc
+		logger     log.Logger
+
+		executionID int64
+
+		sync.Mutex
+		idle        *sync.Cond
+		started     bool
+		pending     []*bulkRequest
+		pendingSize int
+		inflight    int
+
+		ctx         context.Context
+		cancel      context.CancelFunc
+		batchCh     chan []*bulkRequest
+		stopCh      chan struct{}
+		flusherDone chan struct{}
+		workers     sync.WaitGroup
+	}
+
+	// bulkRequest is an encoded index or delete request
+	bulkRequest struct {
+		lines []string
+		size  int
+	}
+)
+
+func newBulkProcessor(params *BulkProcessorParameters, commitFunc bulkCommitFunc, logger log.Logger) *bulkProcessor {
+	p := &bulkProcessor{
+		params:     params,
+		commitFunc: commitFunc,
+		logger:     logger,
+	}
+	p.idle = sync.NewCond(&p.Mutex)
+	return p
+}
+
+func newBulkRequest(request *GenericBulkableAddRequest) (*bulkRequest, error) {
+	meta := map[string]interface{}{
+		"_index": request.Index,
+		"_id":    request.ID,
+	}
+	if request.VersionType != "" {
+		meta["version_type"] = request.VersionType
+		meta["version"] = request.Version
+	}
+	op := "index"
+	if request.IsDelete {
+		op = "delete"
+	}
+	action, err := json.Marshal(map[string]interface{}{op: meta})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []string{string(action)}
+	if !request.IsDelete {
+		doc, err := json.Marshal(request.Doc)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, string(doc))
+	}
+
+	size := 0
+	for _, line := range lines {
+		size += len(line) + 1
+	}
+	return &bulkRequest{lines: lines, size: size}, nil
+}
+
+func (r *bulkRequest) Source() ([]string, error) {
+	return r.lines, nil
+}
+
+func (r *bulkRequest) String() string {
+	return strings.Join(r.lines, "\n")
+}
+
+func (p *bulkProcessor) Start(ctx context.Context) error {
+	p.Lock()
+	defer p.Unlock()
+	if p.started {
+		return nil
+	}
+
+	p.started = true
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	p.batchCh = make(chan []*bulkRequest)
+	p.stopCh = make(chan struct{})
+	p.flusherDone = make(chan struct{})
+
+	numOfWorkers := p.params.NumOfWorkers
+	if numOfWorkers < 1 {
+		numOfWorkers = 1
+	}
+	p.workers.Add(numOfWorkers)
+	for i := 0; i < numOfWorkers; i++ {
+		go p.worker()
+	}
+	go p.flusher()
+	return nil
+}
+
+func (p *bulkProcessor) Stop() error {
+	p.Lock()
+	if !p.started {
+		p.Unlock()
+		return nil
+	}
+	batch := p.takePendingLocked()
+	p.started = false
+	p.Unlock()
+
+	close(p.stopCh)
+	<-p.flusherDone
+	if batch != nil {
+		p.batchCh <- batch
+	}
+	p.waitIdle()
+	close(p.batchCh)
+	p.workers.Wait()
+	p.cancel()
+	return nil
+}
+
+func (p *bulkProcessor) Close() error {
+	return p.Stop()
+}
+
+func (p *bulkProcessor) Add(request *GenericBulkableAddRequest) {
+	req, err := newBulkRequest(request)
+	if err != nil {
+		p.logger.Error("Unable to encode bulk request.", tag.Error(err), tag.ESDocID(request.ID))
+		return
+	}
+
+	p.Lock()
+	p.pending = append(p.pending, req)
+	p.pendingSize += req.size
+	var batch []*bulkRequest
+	if (p.params.BulkActions > 0 && len(p.pending) >= p.params.BulkActions) ||
+		(p.params.BulkSize > 0 && p.pendingSize >= p.params.BulkSize) {
+		batch = p.takePendingLocked()
+	}
+	p.Unlock()
+
+	if batch != nil {
+		p.batchCh <- batch
+	}
+}
+
+func (p *bulkProcessor) Flush() error {
+	p.dispatch()
+	p.waitIdle()
+	return nil
+}
+
+func (p *bulkProcessor) RetrieveKafkaKey(request GenericBulkableRequest, logger log.Logger, metricsClient metrics.Client) string {
+	return retrieveKafkaKey(request, logger, metricsClient)
+}
+
+// takePendingLocked returns the pending requests as a batch to be committed, the caller must send it to batchCh
+func (p *bulkProcessor) takePendingLocked() []*bulkRequest {
+	if !p.started || len(p.pending) == 0 {
+		return nil
+	}
+	batch := p.pending
+	p.pending = nil
+	p.pendingSize = 0
+	p.inflight++
+	return batch
+}
+
+func (p *bulkProcessor) dispatch() {
+	p.Lock()
+	batch := p.takePendingLocked()
+	p.Unlock()
+
+	if batch != nil {
+		p.batchCh <- batch
+	}
+}
+
+func (p *bulkProcessor) waitIdle() {
+	p.Lock()
+	defer p.Unlock()
+	for p.inflight > 0 {
+		p.idle.Wait()
+	}
+}
+
+func (p *bulkProcessor) flusher() {
+	defer close(p.flusherDone)
+	if p.params.FlushInterval <= 0 {
+		<-p.stopCh
+		return
+	}
+
+	ticker := time.NewTicker(p.params.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			p.dispatch()
+		}
+	}
+}
+
+func (p *bulkProcessor) worker() {
+	defer p.workers.Done()
+	for batch := range p.batchCh {
+		p.commit(batch)
+
+		p.Lock()
+		p.inflight--
+		if p.inflight == 0 {
+			p.idle.Broadcast()
+		}
+		p.Unlock()
+	}
+}
+
+// commit sends the batch to the cluster, the whole batch is retried with backoff on retriable errors,
+// and only the items with retriable status are retried if the bulk request itself succeeded
+func (p *bulkProcessor) commit(requests []*bulkRequest) {
+	for retry := 0; ; retry++ {
+		executionID := atomic.AddInt64(&p.executionID, 1)
+		genericRequests := toGenericBulkableRequests(requests)
+		if p.params.BeforeFunc != nil {
+			p.params.BeforeFunc(executionID, genericRequests)
+		}
+
+		response, err := p.commitFunc(p.ctx, encodeBulkRequests(requests))
+		if err == nil {
+			p.after(executionID, genericRequests, response, nil)
+			if requests = retriableBulkRequests(requests, response); len(requests) == 0 {
+				return
+			}
+		} else if !isBulkStatusRetriable(err.Status) {
+			p.after(executionID, genericRequests, nil, err)
+			return
+		}
+
+		var wait time.Duration
+		ok := false
+		if p.params.Backoff != nil {
+			wait, ok = p.params.Backoff.Next(retry)
+		}
+		if !ok {
+			if err == nil {
+				err = &GenericError{Status: unknownStatusCode, Details: errBulkRetryExhausted}
+			}
+			p.after(executionID, toGenericBulkableRequests(requests), nil, err)
+			return
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *bulkProcessor) after(executionID int64, requests []GenericBulkableRequest, response *GenericBulkResponse, err *GenericError) {
+	if p.params.AfterFunc != nil {
+		p.params.AfterFunc(executionID, requests, response, err)
+	}
+}
+
+func encodeBulkRequests(requests []*bulkRequest) []byte {
+	var body strings.Builder
+	for _, req := range requests {
+		for _, line := range req.lines {
+			body.WriteString(line)
+			body.WriteByte('\n')
+		}
+	}
+	return []byte(body.String())
+}
+
+func retriableBulkRequests(requests []*bulkRequest, response *GenericBulkResponse) []*bulkRequest {
+	if response == nil || !response.Errors {
+		return nil
+	}
+	var result []*bulkRequest
+	for i, item := range response.Items {
+		if i >= len(requests) {
+			break
+		}
+		for _, resp := range item {
+			if _, ok := bulkRetriableStatusCode[resp.Status]; ok {
+				result = append(result, requests[i])
+				break
+			}
+		}
+	}
+	return result
+}
+
+// isBulkStatusRetriable tells if a failed bulk request should be retried, unknownStatusCode means the cluster is unreachable
+func isBulkStatusRetriable(status int) bool {
+	if status == unknownStatusCode {
+		return true
+	}
+	_, ok := bulkRetriableStatusCode[status]
+	return ok
+}
+
+func toGenericBulkableRequests(requests []*bulkRequest) []GenericBulkableRequest {
+	result := make([]GenericBulkableRequest, 0, len(requests))
+	for _, req := range requests {
+		result = append(result, req)
+	}
+	return result
+}
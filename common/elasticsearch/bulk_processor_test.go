@@ -0,0 +1,193 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type bulkCommitRecorder struct {
+	sync.Mutex
+	bodies    []string
+	responses []*GenericBulkResponse
+	errors    []*GenericError
+}
+
+func (r *bulkCommitRecorder) commit(ctx context.Context, body []byte) (*GenericBulkResponse, *GenericError) {
+	r.Lock()
+	defer r.Unlock()
+	i := len(r.bodies)
+	r.bodies = append(r.bodies, string(body))
+	if i < len(r.errors) && r.errors[i] != nil {
+		return nil, r.errors[i]
+	}
+	if i < len(r.responses) && r.responses[i] != nil {
+		return r.responses[i], nil
+	}
+	numOfRequests := strings.Count(string(body), `"_id"`)
+	response := &GenericBulkResponse{}
+	for j := 0; j < numOfRequests; j++ {
+		response.Items = append(response.Items, map[string]*GenericBulkResponseItem{"index": {Status: 201}})
+	}
+	return response, nil
+}
+
+func (r *bulkCommitRecorder) getBodies() []string {
+	r.Lock()
+	defer r.Unlock()
+	return append([]string(nil), r.bodies...)
+}
+
+func newTestIndexRequest(id string) *GenericBulkableAddRequest {
+	return &GenericBulkableAddRequest{
+		Index:       "test-index",
+		ID:          id,
+		VersionType: "external",
+		Version:     1,
+		Doc:         map[string]interface{}{KafkaKey: "key-" + id},
+	}
+}
+
+func Test_BulkProcessor_BulkActions(t *testing.T) {
+	recorder := &bulkCommitRecorder{}
+	var mu sync.Mutex
+	var succeeded int
+	processor := newBulkProcessor(&BulkProcessorParameters{
+		NumOfWorkers: 1,
+		BulkActions:  2,
+		AfterFunc: func(executionID int64, requests []GenericBulkableRequest, response *GenericBulkResponse, err *GenericError) {
+			require.Nil(t, err)
+			mu.Lock()
+			succeeded += len(requests)
+			mu.Unlock()
+		},
+	}, recorder.commit, loggerimpl.NewNopLogger())
+	require.NoError(t, processor.Start(context.Background()))
+
+	processor.Add(newTestIndexRequest("1"))
+	processor.Add(newTestIndexRequest("2"))
+	processor.Add(newTestIndexRequest("3"))
+	require.NoError(t, processor.Flush())
+
+	bodies := recorder.getBodies()
+	require.Len(t, bodies, 2)
+	require.Equal(t,
+		`{"index":{"_id":"1","_index":"test-index","version":1,"version_type":"external"}}`+"\n"+`{"KafkaKey":"key-1"}`+"\n"+
+			`{"index":{"_id":"2","_index":"test-index","version":1,"version_type":"external"}}`+"\n"+`{"KafkaKey":"key-2"}`+"\n",
+		bodies[0])
+	require.NoError(t, processor.Stop())
+	mu.Lock()
+	require.Equal(t, 3, succeeded)
+	mu.Unlock()
+}
+
+func Test_BulkProcessor_FlushInterval(t *testing.T) {
+	recorder := &bulkCommitRecorder{}
+	processor := newBulkProcessor(&BulkProcessorParameters{
+		NumOfWorkers:  2,
+		BulkActions:   100,
+		FlushInterval: 10 * time.Millisecond,
+	}, recorder.commit, loggerimpl.NewNopLogger())
+	require.NoError(t, processor.Start(context.Background()))
+	defer processor.Stop() //nolint:errcheck
+
+	processor.Add(&GenericBulkableAddRequest{Index: "test-index", ID: "1", IsDelete: true})
+	require.Eventually(t, func() bool {
+		return len(recorder.getBodies()) == 1
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, `{"delete":{"_id":"1","_index":"test-index"}}`+"\n", recorder.getBodies()[0])
+}
+
+func Test_BulkProcessor_Retry(t *testing.T) {
+	recorder := &bulkCommitRecorder{
+		errors: []*GenericError{{Status: 503}},
+		responses: []*GenericBulkResponse{nil, {
+			Errors: true,
+			Items: []map[string]*GenericBulkResponseItem{
+				{"index": {Status: 201}},
+				{"index": {Status: 429}},
+			},
+		}},
+	}
+	var afterErrs []*GenericError
+	processor := newBulkProcessor(&BulkProcessorParameters{
+		BulkActions: 2,
+		Backoff:     NewExponentialBackoff(time.Millisecond, time.Second),
+		AfterFunc: func(executionID int64, requests []GenericBulkableRequest, response *GenericBulkResponse, err *GenericError) {
+			afterErrs = append(afterErrs, err)
+		},
+	}, recorder.commit, loggerimpl.NewNopLogger())
+	require.NoError(t, processor.Start(context.Background()))
+
+	processor.Add(newTestIndexRequest("1"))
+	processor.Add(newTestIndexRequest("2"))
+	require.NoError(t, processor.Stop())
+
+	bodies := recorder.getBodies()
+	require.Len(t, bodies, 3)
+	require.Equal(t, bodies[0], bodies[1])
+	require.Equal(t, `{"index":{"_id":"2","_index":"test-index","version":1,"version_type":"external"}}`+"\n"+`{"KafkaKey":"key-2"}`+"\n", bodies[2])
+	require.Equal(t, []*GenericError{nil, nil}, afterErrs)
+}
+
+func Test_BulkProcessor_NonRetriableError(t *testing.T) {
+	recorder := &bulkCommitRecorder{
+		errors: []*GenericError{{Status: 400}},
+	}
+	var afterErr *GenericError
+	processor := newBulkProcessor(&BulkProcessorParameters{
+		BulkActions: 1,
+		Backoff:     NewExponentialBackoff(time.Millisecond, time.Second),
+		AfterFunc: func(executionID int64, requests []GenericBulkableRequest, response *GenericBulkResponse, err *GenericError) {
+			afterErr = err
+		},
+	}, recorder.commit, loggerimpl.NewNopLogger())
+	require.NoError(t, processor.Start(context.Background()))
+
+	processor.Add(newTestIndexRequest("1"))
+	require.NoError(t, processor.Stop())
+
+	require.Len(t, recorder.getBodies(), 1)
+	require.NotNil(t, afterErr)
+	require.Equal(t, 400, afterErr.Status)
+}
+
+func Test_BulkProcessor_RetrieveKafkaKey(t *testing.T) {
+	processor := newBulkProcessor(&BulkProcessorParameters{}, nil, loggerimpl.NewNopLogger())
+	metricsClient := metrics.NewNoopMetricsClient()
+
+	indexRequest, err := newBulkRequest(newTestIndexRequest("1"))
+	require.NoError(t, err)
+	require.Equal(t, "key-1", processor.RetrieveKafkaKey(indexRequest, loggerimpl.NewNopLogger(), metricsClient))
+
+	deleteRequest, err := newBulkRequest(&GenericBulkableAddRequest{Index: "test-index", ID: "2", IsDelete: true})
+	require.NoError(t, err)
+	require.Equal(t, "2", processor.RetrieveKafkaKey(deleteRequest, loggerimpl.NewNopLogger(), metricsClient))
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"github.com/opensearch-project/opensearch-go/v2"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+)
+
+// NewOpenSearchClient returns a new implementation of GenericClient for OpenSearch 1.x and 2.x
+func NewOpenSearchClient(
+	connectConfig *config.ElasticSearchConfig,
+	logger log.Logger,
+) (GenericClient, error) {
+	httpClient, err := buildHTTPClient(connectConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := opensearch.NewClient(opensearch.Config{
+		Addresses:            []string{connectConfig.URL.String()},
+		Username:             connectConfig.Username,
+		Password:             connectConfig.Password,
+		Transport:            httpClient.Transport,
+		DiscoverNodesOnStart: !connectConfig.DisableSniff,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRESTClient(client, logger), nil
+}
@@ -0,0 +1,563 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+var _ GenericClient = (*restClient)(nil)
+
+const (
+	jsonContentType   = "application/json"
+	ndjsonContentType = "application/x-ndjson"
+
+	scrollKeepAlive = "5m"
+)
+
+type (
+	// restTransport sends a request to the cluster,
+	// it's implemented by both the official ElasticSearch client and the OpenSearch client
+	restTransport interface {
+		Perform(req *http.Request) (*http.Response, error)
+	}
+
+	// restClient implements GenericClient on top of the REST API shared by ElasticSearch 8 and OpenSearch,
+	// leaving connection management to the official clients
+	restClient struct {
+		transport restTransport
+		logger    log.Logger
+	}
+
+	// restError is returned when the cluster responds with a non-2xx status
+	restError struct {
+		Status int
+		Body   string
+	}
+
+	restSearchResult struct {
+		ScrollID string         `json:"_scroll_id"`
+		Hits     restSearchHits `json:"hits"`
+	}
+
+	restSearchHits struct {
+		TotalHits struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []*restSearchHit `json:"hits"`
+	}
+
+	restSearchHit struct {
+		ID     string          `json:"_id"`
+		Source json.RawMessage `json:"_source"`
+		Sort   []interface{}   `json:"sort"`
+	}
+)
+
+func newRESTClient(transport restTransport, logger log.Logger) *restClient {
+	return &restClient{
+		transport: transport,
+		logger:    logger,
+	}
+}
+
+func (e *restError) Error() string {
+	return fmt.Sprintf("status %v: %v", e.Status, e.Body)
+}
+
+func (c *restClient) IsNotFoundError(err error) bool {
+	if e, ok := err.(*restError); ok {
+		return e.Status == http.StatusNotFound
+	}
+	return false
+}
+
+// root is for nested object like Attr property for search attributes.
+func (c *restClient) PutMapping(ctx context.Context, index, root, key, valueType string) error {
+	body, err := json.Marshal(buildPutMappingBodyV7(root, key, valueType))
+	if err != nil {
+		return err
+	}
+	return c.perform(ctx, http.MethodPut, indexPath(index, "_mapping"), nil, body, jsonContentType, nil)
+}
+
+func (c *restClient) CreateIndex(ctx context.Context, index string) error {
+	return c.perform(ctx, http.MethodPut, indexPath(index), nil, nil, "", nil)
+}
+
+func (c *restClient) CountByQuery(ctx context.Context, index, query string) (int64, error) {
+	var result struct {
+		Count int64 `json:"count"`
+	}
+	if err := c.perform(ctx, http.MethodPost, indexPath(index, "_count"), nil, []byte(query), jsonContentType, &result); err != nil {
+		return 0, err
+	}
+	return result.Count, nil
+}
+
+func (c *restClient) Search(ctx context.Context, request *SearchRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	token, err := GetNextPageToken(request.ListRequest.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	searchResult, err := c.getSearchResult(
+		ctx,
+		request.Index,
+		request.ListRequest,
+		request.MatchQuery,
+		request.IsOpen,
+		token,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getListWorkflowExecutionsResponse(&searchResult.Hits, token, request.ListRequest.PageSize, request.MaxResultWindow, request.Filter)
+}
+
+func (c *restClient) SearchByQuery(ctx context.Context, request *SearchByQueryRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	searchResult, err := c.search(ctx, request.Index, nil, []byte(request.Query))
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := GetNextPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getListWorkflowExecutionsResponse(&searchResult.Hits, token, request.PageSize, request.MaxResultWindow, request.Filter)
+}
+
+func (c *restClient) ScanByQuery(ctx context.Context, request *ScanByQueryRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	token, err := GetNextPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResult *restSearchResult
+	if len(token.ScrollID) == 0 { // first call
+		searchResult, err = c.search(ctx, request.Index, url.Values{"scroll": []string{scrollKeepAlive}}, []byte(request.Query))
+	} else {
+		searchResult, err = c.scroll(ctx, token.ScrollID)
+	}
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("ScanByQuery failed. Error: %v", err),
+		}
+	}
+
+	isLastPage := false
+	if len(searchResult.Hits.Hits) == 0 { // no more result
+		isLastPage = true
+		if err := c.clearScroll(ctx, searchResult.ScrollID); err != nil {
+			c.logger.Warn("scroll clear fail", tag.Error(err))
+		}
+	}
+
+	return c.getScanWorkflowExecutionsResponse(&searchResult.Hits, request.PageSize, searchResult.ScrollID, isLastPage)
+}
+
+func (c *restClient) RunBulkProcessor(ctx context.Context, parameters *BulkProcessorParameters) (GenericBulkProcessor, error) {
+	processor := newBulkProcessor(parameters, c.bulk, c.logger)
+	if err := processor.Start(ctx); err != nil {
+		return nil, err
+	}
+	return processor, nil
+}
+
+func (c *restClient) SearchForOneClosedExecution(
+	ctx context.Context,
+	index string,
+	request *p.InternalGetClosedWorkflowExecutionRequest,
+) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
+
+	must := []interface{}{
+		matchQuery(DomainID, request.DomainUUID),
+		existsQuery(CloseStatus),
+		matchQuery(WorkflowID, request.Execution.GetWorkflowID()),
+	}
+	rid := request.Execution.GetRunID()
+	if rid != "" {
+		must = append(must, matchQuery(RunID, rid))
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": must,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	searchResult, err := c.search(ctx, index, nil, body)
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("SearchForOneClosedExecution failed. Error: %v", err),
+		}
+	}
+
+	response := &p.InternalGetClosedWorkflowExecutionResponse{}
+	actualHits := searchResult.Hits.Hits
+	if len(actualHits) == 0 {
+		return response, nil
+	}
+	response.Execution = c.convertSearchResultToVisibilityRecord(actualHits[0])
+
+	return response, nil
+}
+
+func (c *restClient) bulk(ctx context.Context, body []byte) (*GenericBulkResponse, *GenericError) {
+	var response GenericBulkResponse
+	if err := c.perform(ctx, http.MethodPost, "/_bulk", nil, body, ndjsonContentType, &response); err != nil {
+		return nil, convertRESTErrorToGenericError(err)
+	}
+	return &response, nil
+}
+
+func convertRESTErrorToGenericError(err error) *GenericError {
+	status := unknownStatusCode
+	if e, ok := err.(*restError); ok {
+		status = e.Status
+	}
+	return &GenericError{
+		Status:  status,
+		Details: err,
+	}
+}
+
+func (c *restClient) search(ctx context.Context, index string, query url.Values, body []byte) (*restSearchResult, error) {
+	var result restSearchResult
+	if err := c.perform(ctx, http.MethodPost, indexPath(index, "_search"), query, body, jsonContentType, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *restClient) scroll(ctx context.Context, scrollID string) (*restSearchResult, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"scroll":    scrollKeepAlive,
+		"scroll_id": scrollID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result restSearchResult
+	if err := c.perform(ctx, http.MethodPost, "/_search/scroll", nil, body, jsonContentType, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *restClient) clearScroll(ctx context.Context, scrollID string) error {
+	if scrollID == "" {
+		return nil
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"scroll_id": []string{scrollID},
+	})
+	if err != nil {
+		return err
+	}
+	return c.perform(ctx, http.MethodDelete, "/_search/scroll", nil, body, jsonContentType, nil)
+}
+
+// perform sends the request and decodes the response into result if it's not nil,
+// numbers are decoded as json.Number to ensure int64 won't lose precision
+func (c *restClient) perform(
+	ctx context.Context,
+	method string,
+	path string,
+	query url.Values,
+	body []byte,
+	contentType string,
+	result interface{},
+) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, path, reader)
+	if err != nil {
+		return err
+	}
+	if query != nil {
+		req.URL.RawQuery = query.Encode()
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.transport.Perform(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &restError{
+			Status: resp.StatusCode,
+			Body:   string(data),
+		}
+	}
+
+	if result == nil {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(result)
+}
+
+func indexPath(index string, endpoint ...string) string {
+	path := "/" + url.PathEscape(index)
+	for _, e := range endpoint {
+		path += "/" + e
+	}
+	return path
+}
+
+func matchQuery(name string, text interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"match": map[string]interface{}{
+			name: map[string]interface{}{
+				"query": text,
+			},
+		},
+	}
+}
+
+func existsQuery(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"exists": map[string]interface{}{
+			"field": name,
+		},
+	}
+}
+
+func descSort(name string) map[string]interface{} {
+	return map[string]interface{}{
+		name: map[string]interface{}{
+			"order": "desc",
+		},
+	}
+}
+
+func (c *restClient) getListWorkflowExecutionsResponse(
+	searchHits *restSearchHits,
+	token *ElasticVisibilityPageToken,
+	pageSize int,
+	maxResultWindow int,
+	isRecordValid func(rec *p.InternalVisibilityWorkflowExecutionInfo) bool,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	actualHits := searchHits.Hits
+	numOfActualHits := len(actualHits)
+
+	response.Executions = make([]*p.InternalVisibilityWorkflowExecutionInfo, 0)
+	for i := 0; i < numOfActualHits; i++ {
+		workflowExecutionInfo := c.convertSearchResultToVisibilityRecord(actualHits[i])
+		if isRecordValid == nil || isRecordValid(workflowExecutionInfo) {
+			// for old APIs like ListOpenWorkflowExecutions, we added 1 ms to range query to overcome ES limitation
+			// (see getSearchResult function), but manually dropped records beyond request range here.
+			response.Executions = append(response.Executions, workflowExecutionInfo)
+		}
+	}
+
+	if numOfActualHits == pageSize { // this means the response is not the last page
+		var nextPageToken []byte
+		var err error
+
+		// ES Search API support pagination using From and PageSize, but has limit that From+PageSize cannot exceed a threshold
+		// to retrieve deeper pages, use ES SearchAfter
+		if searchHits.TotalHits.Value <= int64(maxResultWindow-pageSize) { // use ES Search From+Size
+			nextPageToken, err = SerializePageToken(&ElasticVisibilityPageToken{From: token.From + numOfActualHits})
+		} else { // use ES Search After
+			sortVals := actualHits[numOfActualHits-1].Sort
+			if len(sortVals) < 2 {
+				return nil, &types.InternalServiceError{
+					Message: "unable to paginate with search after, sort values are missing in search result",
+				}
+			}
+			tieBreaker, _ := sortVals[1].(string)
+
+			nextPageToken, err = SerializePageToken(&ElasticVisibilityPageToken{SortValue: sortVals[0], TieBreaker: tieBreaker})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		response.NextPageToken = make([]byte, len(nextPageToken))
+		copy(response.NextPageToken, nextPageToken)
+	}
+
+	return response, nil
+}
+
+func (c *restClient) convertSearchResultToVisibilityRecord(hit *restSearchHit) *p.InternalVisibilityWorkflowExecutionInfo {
+	var source *VisibilityRecord
+	err := json.Unmarshal(hit.Source, &source)
+	if err != nil { // log and skip error
+		c.logger.Error("unable to unmarshal search hit source",
+			tag.Error(err), tag.ESDocID(hit.ID))
+		return nil
+	}
+
+	record := &p.InternalVisibilityWorkflowExecutionInfo{
+		WorkflowID:       source.WorkflowID,
+		RunID:            source.RunID,
+		TypeName:         source.WorkflowType,
+		StartTime:        time.Unix(0, source.StartTime),
+		ExecutionTime:    time.Unix(0, source.ExecutionTime),
+		Memo:             p.NewDataBlob(source.Memo, common.EncodingType(source.Encoding)),
+		TaskList:         source.TaskList,
+		IsCron:           source.IsCron,
+		SearchAttributes: source.Attr,
+	}
+	if source.CloseTime != 0 {
+		record.CloseTime = time.Unix(0, source.CloseTime)
+		record.Status = thrift.ToWorkflowExecutionCloseStatus(&source.CloseStatus)
+		record.HistoryLength = source.HistoryLength
+	}
+
+	return record
+}
+
+func (c *restClient) getScanWorkflowExecutionsResponse(
+	searchHits *restSearchHits,
+	pageSize int, scrollID string,
+	isLastPage bool,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	actualHits := searchHits.Hits
+	numOfActualHits := len(actualHits)
+
+	response.Executions = make([]*p.InternalVisibilityWorkflowExecutionInfo, 0)
+	for i := 0; i < numOfActualHits; i++ {
+		workflowExecutionInfo := c.convertSearchResultToVisibilityRecord(actualHits[i])
+		response.Executions = append(response.Executions, workflowExecutionInfo)
+	}
+
+	if numOfActualHits == pageSize && !isLastPage {
+		nextPageToken, err := SerializePageToken(&ElasticVisibilityPageToken{ScrollID: scrollID})
+		if err != nil {
+			return nil, err
+		}
+		response.NextPageToken = make([]byte, len(nextPageToken))
+		copy(response.NextPageToken, nextPageToken)
+	}
+
+	return response, nil
+}
+
+func (c *restClient) getSearchResult(
+	ctx context.Context,
+	index string,
+	request *p.InternalListWorkflowExecutionsRequest,
+	match *GenericMatch,
+	isOpen bool,
+	token *ElasticVisibilityPageToken,
+) (*restSearchResult, error) {
+
+	timeField := CloseTime
+	if isOpen {
+		timeField = StartTime
+	}
+	// ElasticSearch is unable to precisely compare time, have to manually add resolution 1ms to time range.
+	// Also has to use string instead of int64 to avoid data conversion issue,
+	// 9223372036854775807 to 9223372036854776000 (long overflow)
+	if request.LatestTime.UnixNano() > math.MaxInt64-oneMicroSecondInNano { // prevent latestTime overflow
+		request.LatestTime = time.Unix(0, math.MaxInt64-oneMicroSecondInNano)
+	}
+	if request.EarliestTime.UnixNano() < math.MinInt64+oneMicroSecondInNano { // prevent earliestTime overflow
+		request.EarliestTime = time.Unix(0, math.MinInt64+oneMicroSecondInNano)
+	}
+	earliestTimeStr := strconv.FormatInt(request.EarliestTime.UnixNano()-oneMicroSecondInNano, 10)
+	latestTimeStr := strconv.FormatInt(request.LatestTime.UnixNano()+oneMicroSecondInNano, 10)
+	rangeQuery := map[string]interface{}{
+		"range": map[string]interface{}{
+			timeField: map[string]interface{}{
+				"gte": earliestTimeStr,
+				"lte": latestTimeStr,
+			},
+		},
+	}
+
+	boolQuery := map[string]interface{}{
+		"filter": []interface{}{rangeQuery},
+	}
+	must := []interface{}{matchQuery(DomainID, request.DomainUUID)}
+	if match != nil {
+		must = append(must, matchQuery(match.Name, match.Text))
+	}
+	if isOpen {
+		boolQuery["must_not"] = []interface{}{existsQuery(CloseStatus)}
+	} else {
+		must = append(must, existsQuery(CloseStatus))
+	}
+	boolQuery["must"] = must
+
+	source := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": boolQuery,
+		},
+		"from": token.From,
+		"sort": []interface{}{descSort(timeField), descSort(RunID)},
+	}
+	if request.PageSize != 0 {
+		source["size"] = request.PageSize
+	}
+	if ShouldSearchAfter(token) {
+		source["search_after"] = []interface{}{token.SortValue, token.TieBreaker}
+	}
+
+	body, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
+	return c.search(ctx, index, nil, body)
+}
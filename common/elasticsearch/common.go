@@ -23,11 +23,15 @@ package elasticsearch
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"time"
 
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 )
 
 const unknownStatusCode = -1
@@ -74,3 +78,71 @@ func buildTLSHTTPClient(config config.TLS) (*http.Client, error) {
 
 	return tlsClient, nil
 }
+
+// Build Http Client honoring the TLS and AWS signing settings, used by clients that don't come with their own
+func buildHTTPClient(connectConfig *config.ElasticSearchConfig) (*http.Client, error) {
+	if connectConfig.TLS.Enabled {
+		return buildTLSHTTPClient(connectConfig.TLS)
+	}
+	if connectConfig.AWSSigning.Enable {
+		if err := config.CheckAWSSigningConfig(connectConfig.AWSSigning); err != nil {
+			return nil, err
+		}
+		if connectConfig.AWSSigning.EnvironmentCredential != nil {
+			return buildSigningHTTPClientFromEnvironmentCredentialV7(*connectConfig.AWSSigning.EnvironmentCredential)
+		}
+		return buildSigningHTTPClientFromStaticCredentialV7(*connectConfig.AWSSigning.StaticCredential)
+	}
+	return &http.Client{}, nil
+}
+
+// retrieveKafkaKey returns the KafkaKey from the source of an index request, or the doc ID of a delete request
+func retrieveKafkaKey(request GenericBulkableRequest, logger log.Logger, metricsClient metrics.Client) string {
+	req, err := request.Source()
+	if err != nil {
+		logger.Error("Get request source err.", tag.Error(err), tag.ESRequest(request.String()))
+		metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+		return ""
+	}
+
+	var key string
+	if len(req) == 2 { // index or update requests
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(req[1]), &body); err != nil {
+			logger.Error("Unmarshal index request body err.", tag.Error(err))
+			metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+			return ""
+		}
+
+		k, ok := body[KafkaKey]
+		if !ok {
+			// must be bug in code and bad deployment, check processor that add es requests
+			panic("KafkaKey not found")
+		}
+		key, ok = k.(string)
+		if !ok {
+			// must be bug in code and bad deployment, check processor that add es requests
+			panic("KafkaKey is not string")
+		}
+	} else { // delete requests
+		var body map[string]map[string]interface{}
+		if err := json.Unmarshal([]byte(req[0]), &body); err != nil {
+			logger.Error("Unmarshal delete request body err.", tag.Error(err))
+			metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+			return ""
+		}
+
+		opMap, ok := body["delete"]
+		if !ok {
+			// must be bug, check if dependency changed
+			panic("delete key not found in request")
+		}
+		k, ok := opMap["_id"]
+		if !ok {
+			// must be bug in code and bad deployment, check processor that add es requests
+			panic("_id not found in request opMap")
+		}
+		key, _ = k.(string)
+	}
+	return key
+}
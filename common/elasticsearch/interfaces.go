@@ -28,6 +28,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 )
@@ -38,13 +39,22 @@ func NewGenericClient(
 	logger log.Logger,
 ) (GenericClient, error) {
 	if connectConfig.Version == "" {
-		connectConfig.Version = "v6"
+		version, err := DetectVersion(connectConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to detect ElasticSearch version: %v", err)
+		}
+		logger.Info("Detected ElasticSearch version", tag.Value(version))
+		connectConfig.Version = version
 	}
 	switch connectConfig.Version {
 	case "v6":
 		return NewV6Client(connectConfig, logger)
 	case "v7":
 		return NewV7Client(connectConfig, logger)
+	case "v8":
+		return NewV8Client(connectConfig, logger)
+	case "opensearch":
+		return NewOpenSearchClient(connectConfig, logger)
 	default:
 		return nil, fmt.Errorf("not supported ElasticSearch version: %v", connectConfig.Version)
 	}
@@ -0,0 +1,106 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	// versionDetectionTimeout is the timeout for querying the cluster info endpoint
+	versionDetectionTimeout = 10 * time.Second
+
+	openSearchDistribution = "opensearch"
+)
+
+type (
+	// clusterInfo is the response of the cluster info endpoint (GET /)
+	clusterInfo struct {
+		Version struct {
+			Number       string `json:"number"`
+			Distribution string `json:"distribution"`
+		} `json:"version"`
+	}
+)
+
+// DetectVersion queries the cluster info endpoint and returns the client version to talk to the cluster,
+// one of v6, v7, v8 and opensearch
+func DetectVersion(connectConfig *config.ElasticSearchConfig) (string, error) {
+	httpClient, err := buildHTTPClient(connectConfig)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), versionDetectionTimeout)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, connectConfig.URL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if connectConfig.Username != "" {
+		req.SetBasicAuth(connectConfig.Username, connectConfig.Password)
+	}
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %v from cluster info endpoint: %s", resp.StatusCode, body)
+	}
+	return parseClusterVersion(body)
+}
+
+func parseClusterVersion(body []byte) (string, error) {
+	var info clusterInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return "", fmt.Errorf("unable to parse cluster info: %v", err)
+	}
+
+	// OpenSearch may report a 7.10.2 version number for compatibility, the distribution tells it apart
+	if info.Version.Distribution == openSearchDistribution {
+		return "opensearch", nil
+	}
+	major := strings.SplitN(info.Version.Number, ".", 2)[0]
+	switch major {
+	case "6":
+		return "v6", nil
+	case "7":
+		return "v7", nil
+	case "8":
+		return "v8", nil
+	default:
+		return "", fmt.Errorf("not supported ElasticSearch version: %v", info.Version.Number)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseClusterVersion(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+		hasErr   bool
+	}{
+		{
+			body:     `{"name":"node-1","version":{"number":"6.8.13","build_flavor":"oss"}}`,
+			expected: "v6",
+		},
+		{
+			body:     `{"name":"node-1","version":{"number":"7.9.3","build_flavor":"oss"}}`,
+			expected: "v7",
+		},
+		{
+			body:     `{"name":"node-1","version":{"number":"8.1.0","build_flavor":"default"}}`,
+			expected: "v8",
+		},
+		{
+			body:     `{"name":"node-1","version":{"distribution":"opensearch","number":"2.0.0"}}`,
+			expected: "opensearch",
+		},
+		{
+			body:     `{"name":"node-1","version":{"distribution":"opensearch","number":"7.10.2"}}`,
+			expected: "opensearch",
+		},
+		{
+			body:   `{"name":"node-1","version":{"number":"5.6.16"}}`,
+			hasErr: true,
+		},
+		{
+			body:   `not json`,
+			hasErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		version, err := parseClusterVersion([]byte(test.body))
+		if test.hasErr {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.expected, version)
+	}
+}
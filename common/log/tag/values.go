@@ -122,6 +122,7 @@ var (
 	ComponentESVisibilityManager        = component("es-visibility-manager")
 	ComponentArchiver                   = component("archiver")
 	ComponentBatcher                    = component("batcher")
+	ComponentScheduler                  = component("scheduler")
 	ComponentWorker                     = component("worker")
 	ComponentServiceResolver            = component("service-resolver")
 	ComponentFailoverCoordinator        = component("failover-coordinator")
@@ -267,8 +268,9 @@ var (
 	StoreOperationGetDLQSize                 = storeOperation("get-dlq-size")
 	StoreOperationDeleteMessageFromDLQ       = storeOperation("delete-message-from-dlq")
 
-	StoreOperationFetchDynamicConfig  = storeOperation("fetch-dynamic-config")
-	StoreOperationUpdateDynamicConfig = storeOperation("update-dynamic-config")
+	StoreOperationFetchDynamicConfig        = storeOperation("fetch-dynamic-config")
+	StoreOperationFetchDynamicConfigHistory = storeOperation("fetch-dynamic-config-history")
+	StoreOperationUpdateDynamicConfig       = storeOperation("update-dynamic-config")
 )
 
 // Pre-defined values for TagSysClientOperation
@@ -298,6 +300,7 @@ var (
 	AdminClientOperationRestoreDynamicConfig             = clientOperation("admin-restore-dynamic-config")
 	AdminClientOperationListDynamicConfig                = clientOperation("admin-list-dynamic-config")
 
+	FrontendClientOperationDeleteWorkflowExecution          = clientOperation("frontend-delete-wf-execution")
 	FrontendClientOperationDeprecateDomain                  = clientOperation("frontend-deprecate-domain")
 	FrontendClientOperationDescribeDomain                   = clientOperation("frontend-describe-domain")
 	FrontendClientOperationDescribeTaskList                 = clientOperation("frontend-describe-task-list")
@@ -337,6 +340,19 @@ var (
 	FrontendClientOperationGetClusterInfo                   = clientOperation("frontend-get-cluster-info")
 	FrontendClientOperationListTaskListPartitions           = clientOperation("frontend-list-task-list-partitions")
 	FrontendClientOperationGetTaskListsByDomain             = clientOperation("frontend-get-task-list-for-domain")
+	FrontendClientOperationCreateSchedule                   = clientOperation("frontend-create-schedule")
+	FrontendClientOperationDescribeSchedule                 = clientOperation("frontend-describe-schedule")
+	FrontendClientOperationUpdateSchedule                   = clientOperation("frontend-update-schedule")
+	FrontendClientOperationPauseSchedule                    = clientOperation("frontend-pause-schedule")
+	FrontendClientOperationUnpauseSchedule                  = clientOperation("frontend-unpause-schedule")
+	FrontendClientOperationTriggerSchedule                  = clientOperation("frontend-trigger-schedule")
+	FrontendClientOperationBackfillSchedule                 = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationDeleteSchedule                   = clientOperation("frontend-delete-schedule")
+	FrontendClientOperationListSchedules                    = clientOperation("frontend-list-schedules")
+	FrontendClientOperationStartBatchOperation              = clientOperation("frontend-start-batch-operation")
+	FrontendClientOperationDescribeBatchOperation           = clientOperation("frontend-describe-batch-operation")
+	FrontendClientOperationListBatchOperations              = clientOperation("frontend-list-batch-operations")
+	FrontendClientOperationCancelBatchOperation             = clientOperation("frontend-cancel-batch-operation")
 
 	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
 	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
@@ -362,6 +378,8 @@ var (
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
 	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationDeleteWorkflowExecution           = clientOperation("history-delete-wf-execution")
+	HistoryClientOperationUpsertWorkflowSearchAttributes    = clientOperation("history-upsert-wf-search-attributes")
 	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
 	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
 	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
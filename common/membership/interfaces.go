@@ -73,6 +73,11 @@ type (
 		// GetMemberCount returns the number of reachable members
 		// currently in this node's membership list for the given role
 		GetMemberCount(role string) (int, error)
+		// SetSelfLabel sets a label on this member, which is gossiped to the other members
+		SetSelfLabel(key string, value string) error
+		// GetMemberLabels returns the value of the label for the reachable members
+		// of the given role which have it set, keyed by member address
+		GetMemberLabels(role string, key string) (map[string]string, error)
 	}
 
 	// ServiceResolver provides membership information for a specific cadence service.
@@ -196,6 +196,35 @@ func (mr *MockMonitorMockRecorder) GetMemberCount(role interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberCount", reflect.TypeOf((*MockMonitor)(nil).GetMemberCount), role)
 }
 
+// SetSelfLabel mocks base method
+func (m *MockMonitor) SetSelfLabel(key, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSelfLabel", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSelfLabel indicates an expected call of SetSelfLabel
+func (mr *MockMonitorMockRecorder) SetSelfLabel(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSelfLabel", reflect.TypeOf((*MockMonitor)(nil).SetSelfLabel), key, value)
+}
+
+// GetMemberLabels mocks base method
+func (m *MockMonitor) GetMemberLabels(role, key string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberLabels", role, key)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberLabels indicates an expected call of GetMemberLabels
+func (mr *MockMonitorMockRecorder) GetMemberLabels(role, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberLabels", reflect.TypeOf((*MockMonitor)(nil).GetMemberLabels), role, key)
+}
+
 // MockServiceResolver is a mock of ServiceResolver interface
 type MockServiceResolver struct {
 	ctrl     *gomock.Controller
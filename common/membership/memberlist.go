@@ -0,0 +1,387 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	stdlog "log"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/memberlist"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	memberlistUpdateTimeout    = 5 * time.Second
+	memberlistLeaveTimeout     = 5 * time.Second
+	memberlistJoinRetryInitial = 500 * time.Millisecond
+	memberlistJoinRetryMax     = 5 * time.Second
+)
+
+type (
+	// SeedProvider returns the gossip addresses of the members used to join the cluster,
+	// ringpop discovery providers can be used as seed providers
+	SeedProvider interface {
+		Hosts() ([]string, error)
+	}
+
+	// Memberlist is a wrapper of a hashicorp memberlist instance, it is used as an alternative
+	// to ringpop when TChannel gossip is not available. Each member is named after its rpc address
+	// and gossips its labels as the node metadata.
+	Memberlist struct {
+		status          int32
+		config          *memberlist.Config
+		seeds           SeedProvider
+		maxJoinDuration time.Duration
+		addressProvider func() (string, error)
+		logger          log.Logger
+
+		sync.RWMutex
+		list   *memberlist.Memberlist
+		labels map[string]string
+
+		listenerLock sync.RWMutex
+		listeners    map[memberlistListener]struct{}
+	}
+
+	// memberlistListener is notified whenever the membership of the cluster changes,
+	// it must not block as it is called from the memberlist gossip loop
+	memberlistListener interface {
+		membershipChanged()
+	}
+
+	memberlistDelegate struct {
+		m *Memberlist
+	}
+
+	memberlistLogWriter struct {
+		logger log.Logger
+	}
+)
+
+// NewMemberlist creates a new memberlist wrapper. The name of the member is the address
+// returned by the address provider when the memberlist is started, it binds the gossip
+// listener on the host of this address and on the given bind port.
+func NewMemberlist(
+	clusterName string,
+	bindPort int,
+	seeds SeedProvider,
+	maxJoinDuration time.Duration,
+	addressProvider func() (string, error),
+	logger log.Logger,
+) *Memberlist {
+
+	config := memberlist.DefaultLANConfig()
+	config.Label = clusterName
+	config.BindPort = bindPort
+	config.AdvertisePort = bindPort
+	return newMemberlist(config, seeds, maxJoinDuration, addressProvider, logger)
+}
+
+func newMemberlist(
+	config *memberlist.Config,
+	seeds SeedProvider,
+	maxJoinDuration time.Duration,
+	addressProvider func() (string, error),
+	logger log.Logger,
+) *Memberlist {
+
+	m := &Memberlist{
+		status:          common.DaemonStatusInitialized,
+		config:          config,
+		seeds:           seeds,
+		maxJoinDuration: maxJoinDuration,
+		addressProvider: addressProvider,
+		logger:          logger,
+		labels:          make(map[string]string),
+		listeners:       make(map[memberlistListener]struct{}),
+	}
+	delegate := &memberlistDelegate{m: m}
+	config.Delegate = delegate
+	config.Events = delegate
+	config.LogOutput = nil
+	config.Logger = stdlog.New(&memberlistLogWriter{logger: logger}, "", 0)
+	return m
+}
+
+// Start creates the memberlist and joins the cluster through the seed members
+func (m *Memberlist) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	address, err := m.addressProvider()
+	if err != nil {
+		m.logger.Fatal("unable to get memberlist address", tag.Error(err))
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		m.logger.Fatal("unable to parse memberlist address", tag.Address(address), tag.Error(err))
+	}
+	m.config.Name = address
+	m.config.BindAddr = host
+
+	list, err := memberlist.Create(m.config)
+	if err != nil {
+		m.logger.Fatal("unable to create memberlist", tag.Error(err))
+	}
+	m.Lock()
+	m.list = list
+	m.Unlock()
+
+	m.join()
+}
+
+// Stop leaves the cluster and shuts down the memberlist
+func (m *Memberlist) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	list := m.getList()
+	if err := list.Leave(memberlistLeaveTimeout); err != nil {
+		m.logger.Warn("unable to leave memberlist", tag.Error(err))
+	}
+	if err := list.Shutdown(); err != nil {
+		m.logger.Warn("unable to shutdown memberlist", tag.Error(err))
+	}
+}
+
+func (m *Memberlist) join() {
+	self := m.getList().LocalNode().Address()
+	retryPolicy := backoff.NewExponentialRetryPolicy(memberlistJoinRetryInitial)
+	retryPolicy.SetMaximumInterval(memberlistJoinRetryMax)
+	retryPolicy.SetExpirationInterval(m.maxJoinDuration)
+
+	op := func() error {
+		hosts, err := m.seeds.Hosts()
+		if err != nil {
+			return err
+		}
+		var seeds []string
+		for _, host := range hosts {
+			if host != self {
+				seeds = append(seeds, host)
+			}
+		}
+		if len(seeds) == 0 {
+			// this is the only seed, other members will join it
+			return nil
+		}
+		_, err = m.getList().Join(seeds)
+		return err
+	}
+	if err := backoff.Retry(op, retryPolicy, func(error) bool { return true }); err != nil {
+		// the member starts alone, the other members join it through the seeds once they start
+		m.logger.Warn("unable to join memberlist through the seed members, starting alone", tag.Error(err))
+	}
+}
+
+// WhoAmI returns the address of this member
+func (m *Memberlist) WhoAmI() (string, error) {
+	list := m.getList()
+	if list == nil {
+		return "", errors.New("memberlist is not started")
+	}
+	return list.LocalNode().Name, nil
+}
+
+// Leave gossips that this member is leaving the cluster, the other members remove it from their membership
+func (m *Memberlist) Leave() error {
+	list := m.getList()
+	if list == nil {
+		return errors.New("memberlist is not started")
+	}
+	return list.Leave(memberlistLeaveTimeout)
+}
+
+// Labels returns a copy of the labels of this member
+func (m *Memberlist) Labels() map[string]string {
+	m.RLock()
+	defer m.RUnlock()
+
+	labels := make(map[string]string, len(m.labels))
+	for key, value := range m.labels {
+		labels[key] = value
+	}
+	return labels
+}
+
+// SetLabel sets a label on this member and gossips it to the other members
+func (m *Memberlist) SetLabel(key string, value string) error {
+	m.Lock()
+	previous, existed := m.labels[key]
+	m.labels[key] = value
+	meta, err := json.Marshal(m.labels)
+	if err == nil && len(meta) > memberlist.MetaMaxSize {
+		err = fmt.Errorf("memberlist labels exceed the max size of %v bytes", memberlist.MetaMaxSize)
+	}
+	if err != nil {
+		if existed {
+			m.labels[key] = previous
+		} else {
+			delete(m.labels, key)
+		}
+	}
+	list := m.list
+	m.Unlock()
+
+	if err != nil || list == nil {
+		return err
+	}
+	return list.UpdateNode(memberlistUpdateTimeout)
+}
+
+// GetReachableMembers returns the addresses of the reachable members which have
+// the given label value, or of all the reachable members if the key is empty
+func (m *Memberlist) GetReachableMembers(key string, value string) []string {
+	var addrs []string
+	for addr, labels := range m.GetMemberLabels() {
+		if key == "" || labels[key] == value {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// GetMemberLabels returns the labels of the reachable members keyed by their address
+func (m *Memberlist) GetMemberLabels() map[string]map[string]string {
+	list := m.getList()
+	if list == nil {
+		return nil
+	}
+	members := make(map[string]map[string]string)
+	for _, node := range list.Members() {
+		labels := make(map[string]string)
+		if len(node.Meta) > 0 {
+			if err := json.Unmarshal(node.Meta, &labels); err != nil {
+				m.logger.Warn("unable to decode memberlist labels", tag.Address(node.Name), tag.Error(err))
+				continue
+			}
+		}
+		members[node.Name] = labels
+	}
+	return members
+}
+
+func (m *Memberlist) getList() *memberlist.Memberlist {
+	m.RLock()
+	defer m.RUnlock()
+	return m.list
+}
+
+func (m *Memberlist) addListener(listener memberlistListener) {
+	m.listenerLock.Lock()
+	defer m.listenerLock.Unlock()
+	m.listeners[listener] = struct{}{}
+}
+
+func (m *Memberlist) removeListener(listener memberlistListener) {
+	m.listenerLock.Lock()
+	defer m.listenerLock.Unlock()
+	delete(m.listeners, listener)
+}
+
+func (m *Memberlist) notifyListeners() {
+	m.listenerLock.RLock()
+	defer m.listenerLock.RUnlock()
+	for listener := range m.listeners {
+		listener.membershipChanged()
+	}
+}
+
+// NodeMeta implements memberlist.Delegate
+func (d *memberlistDelegate) NodeMeta(limit int) []byte {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	meta, err := json.Marshal(d.m.labels)
+	if err != nil || len(meta) > limit {
+		// SetLabel guarantees that the labels fit in the limit
+		d.m.logger.Error("unable to encode memberlist labels", tag.Error(err))
+		return nil
+	}
+	return meta
+}
+
+// NotifyMsg implements memberlist.Delegate
+func (d *memberlistDelegate) NotifyMsg([]byte) {}
+
+// GetBroadcasts implements memberlist.Delegate
+func (d *memberlistDelegate) GetBroadcasts(overhead, limit int) [][]byte {
+	return nil
+}
+
+// LocalState implements memberlist.Delegate
+func (d *memberlistDelegate) LocalState(join bool) []byte {
+	return nil
+}
+
+// MergeRemoteState implements memberlist.Delegate
+func (d *memberlistDelegate) MergeRemoteState(buf []byte, join bool) {}
+
+// NotifyJoin implements memberlist.EventDelegate
+func (d *memberlistDelegate) NotifyJoin(*memberlist.Node) {
+	d.m.notifyListeners()
+}
+
+// NotifyLeave implements memberlist.EventDelegate
+func (d *memberlistDelegate) NotifyLeave(*memberlist.Node) {
+	d.m.notifyListeners()
+}
+
+// NotifyUpdate implements memberlist.EventDelegate
+func (d *memberlistDelegate) NotifyUpdate(*memberlist.Node) {
+	d.m.notifyListeners()
+}
+
+// Write forwards the memberlist logs, which are prefixed by their level, to the cadence logger
+func (w *memberlistLogWriter) Write(p []byte) (int, error) {
+	msg := strings.TrimSpace(string(p))
+	switch {
+	case strings.HasPrefix(msg, "[ERR]"):
+		w.logger.Error(msg)
+	case strings.HasPrefix(msg, "[WARN]"):
+		w.logger.Warn(msg)
+	case strings.HasPrefix(msg, "[INFO]"):
+		w.logger.Info(msg)
+	default:
+		w.logger.Debug(msg)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync/atomic"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type memberlistMonitor struct {
+	status int32
+
+	serviceName string
+	services    []string
+	ml          *Memberlist
+	rings       map[string]*memberlistServiceResolver
+	logger      log.Logger
+}
+
+var _ Monitor = (*memberlistMonitor)(nil)
+
+// NewMemberlistMonitor returns a memberlist-based membership monitor
+func NewMemberlistMonitor(
+	serviceName string,
+	services []string,
+	ml *Memberlist,
+	logger log.Logger,
+) Monitor {
+
+	mlo := &memberlistMonitor{
+		status:      common.DaemonStatusInitialized,
+		serviceName: serviceName,
+		services:    services,
+		ml:          ml,
+		logger:      logger,
+		rings:       make(map[string]*memberlistServiceResolver),
+	}
+	for _, service := range services {
+		mlo.rings[service] = newMemberlistServiceResolver(service, ml, logger)
+	}
+	return mlo
+}
+
+func (mlo *memberlistMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&mlo.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	// the role is set before joining so that the other members place this member in the right ring right away
+	if err := mlo.ml.SetLabel(RoleKey, mlo.serviceName); err != nil {
+		mlo.logger.Fatal("unable to set memberlist labels", tag.Error(err))
+	}
+
+	mlo.ml.Start()
+
+	for _, ring := range mlo.rings {
+		ring.Start()
+	}
+}
+
+func (mlo *memberlistMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&mlo.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	for _, ring := range mlo.rings {
+		ring.Stop()
+	}
+
+	mlo.ml.Stop()
+}
+
+func (mlo *memberlistMonitor) WhoAmI() (*HostInfo, error) {
+	address, err := mlo.ml.WhoAmI()
+	if err != nil {
+		return nil, err
+	}
+	return NewHostInfo(address, mlo.ml.Labels()), nil
+}
+
+func (mlo *memberlistMonitor) EvictSelf() error {
+	return mlo.ml.Leave()
+}
+
+func (mlo *memberlistMonitor) GetResolver(service string) (ServiceResolver, error) {
+	ring, found := mlo.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (mlo *memberlistMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	ring, err := mlo.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (mlo *memberlistMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := mlo.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (mlo *memberlistMonitor) RemoveListener(service string, name string) error {
+	ring, err := mlo.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+func (mlo *memberlistMonitor) GetReachableMembers() ([]string, error) {
+	return mlo.ml.GetReachableMembers("", ""), nil
+}
+
+func (mlo *memberlistMonitor) GetMemberCount(service string) (int, error) {
+	ring, err := mlo.GetResolver(service)
+	if err != nil {
+		return 0, err
+	}
+	return ring.MemberCount(), nil
+}
+
+func (mlo *memberlistMonitor) SetSelfLabel(key string, value string) error {
+	return mlo.ml.SetLabel(key, value)
+}
+
+func (mlo *memberlistMonitor) GetMemberLabels(role string, key string) (map[string]string, error) {
+	values := make(map[string]string)
+	for addr, labels := range mlo.ml.GetMemberLabels() {
+		if labels[RoleKey] != role {
+			continue
+		}
+		if value, ok := labels[key]; ok {
+			values[addr] = value
+		}
+	}
+	return values, nil
+}
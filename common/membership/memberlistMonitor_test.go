@@ -0,0 +1,177 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	MemberlistMonitorSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		seeds       staticSeeds
+		addrs       []string
+		monitors    []Monitor
+		lists       []*Memberlist
+		serviceName string
+	}
+
+	staticSeeds []string
+)
+
+func TestMemberlistMonitorSuite(t *testing.T) {
+	suite.Run(t, new(MemberlistMonitorSuite))
+}
+
+func (s *MemberlistMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.serviceName = "mlm-test"
+	s.seeds = nil
+	s.addrs = nil
+	s.monitors = nil
+	s.lists = nil
+
+	for i := 0; i < 3; i++ {
+		addr := fmt.Sprintf("127.0.0.1:%v", 7000+i)
+		ml := NewMemberlist("mlm-test", 0, &s.seeds, 10*time.Second, func() (string, error) {
+			return addr, nil
+		}, loggerimpl.NewNopLogger())
+		monitor := NewMemberlistMonitor(s.serviceName, []string{s.serviceName}, ml, loggerimpl.NewNopLogger())
+		monitor.Start()
+		if i == 0 {
+			s.seeds = staticSeeds{ml.getList().LocalNode().Address()}
+		}
+		s.addrs = append(s.addrs, addr)
+		s.monitors = append(s.monitors, monitor)
+		s.lists = append(s.lists, ml)
+	}
+
+	for _, monitor := range s.monitors {
+		s.Eventually(func() bool {
+			count, err := monitor.GetMemberCount(s.serviceName)
+			return err == nil && count == 3
+		}, 10*time.Second, 100*time.Millisecond, "members did not join the cluster")
+	}
+}
+
+func (s *MemberlistMonitorSuite) TearDownTest() {
+	for _, monitor := range s.monitors {
+		monitor.Stop()
+	}
+}
+
+func (s *MemberlistMonitorSuite) TestWhoAmI() {
+	host, err := s.monitors[1].WhoAmI()
+	s.NoError(err)
+	s.Equal(s.addrs[1], host.GetAddress())
+	role, ok := host.Label(RoleKey)
+	s.True(ok)
+	s.Equal(s.serviceName, role)
+
+	members, err := s.monitors[1].GetReachableMembers()
+	s.NoError(err)
+	s.ElementsMatch(s.addrs, members)
+}
+
+func (s *MemberlistMonitorSuite) TestDemandLabelFitsMetadata() {
+	// the largest demands fitting in the demand label, with long keys and rates
+	requests := map[string]int64{"global": 1 << 40}
+	for i := 0; i < _maxPublishedKeys; i++ {
+		requests[fmt.Sprintf("domain:%v-%v", strings.Repeat("d", 20), i)] = 1<<40 + int64(i)
+	}
+	label, err := json.Marshal(busiestDemands(requests, 1))
+	s.NoError(err)
+	s.NoError(s.monitors[0].SetSelfLabel(DemandLabel, string(label)))
+
+	s.Eventually(func() bool {
+		labels, err := s.monitors[1].GetMemberLabels(s.serviceName, DemandLabel)
+		return err == nil && labels[s.addrs[0]] == string(label)
+	}, 10*time.Second, 100*time.Millisecond, "demand label was not gossiped")
+}
+
+func (s *MemberlistMonitorSuite) TestLookup() {
+	_, err := s.monitors[0].Lookup("unknown", "key")
+	s.Equal(ErrUnknownService, err)
+
+	// all the members agree on the owner of the keys
+	for i := 0; i < 10; i++ {
+		key := fmt.Sprintf("key-%v", i)
+		host, err := s.monitors[0].Lookup(s.serviceName, key)
+		s.NoError(err)
+		for _, monitor := range s.monitors[1:] {
+			other, err := monitor.Lookup(s.serviceName, key)
+			s.NoError(err)
+			s.Equal(host.GetAddress(), other.GetAddress())
+		}
+	}
+}
+
+func (s *MemberlistMonitorSuite) TestListener() {
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(s.monitors[0].AddListener(s.serviceName, "test-listener", listenCh))
+	s.Equal(ErrListenerAlreadyExist, s.monitors[0].AddListener(s.serviceName, "test-listener", listenCh))
+
+	s.NoError(s.monitors[1].EvictSelf())
+
+	select {
+	case e := <-listenCh:
+		s.Equal(1, len(e.HostsRemoved))
+		s.Equal(s.addrs[1], e.HostsRemoved[0].GetAddress())
+		s.Nil(e.HostsAdded)
+		s.Nil(e.HostsUpdated)
+	case <-time.After(10 * time.Second):
+		s.Fail("Timed out waiting for the evicted member to be removed")
+	}
+
+	for i := 0; i < 10; i++ {
+		host, err := s.monitors[0].Lookup(s.serviceName, fmt.Sprintf("key-%v", i))
+		s.NoError(err)
+		s.NotEqual(s.addrs[1], host.GetAddress())
+	}
+	s.NoError(s.monitors[0].RemoveListener(s.serviceName, "test-listener"))
+}
+
+func (s *MemberlistMonitorSuite) TestMemberLabels() {
+	s.NoError(s.monitors[1].SetSelfLabel("test-label", "value"))
+	s.Eventually(func() bool {
+		labels, err := s.monitors[0].GetMemberLabels(s.serviceName, "test-label")
+		return err == nil && labels[s.addrs[1]] == "value" && len(labels) == 1
+	}, 10*time.Second, 100*time.Millisecond, "label was not propagated to the other members")
+
+	labels, err := s.monitors[0].GetMemberLabels("other-service", "test-label")
+	s.NoError(err)
+	s.Empty(labels)
+}
+
+func (seeds *staticSeeds) Hosts() ([]string, error) {
+	return *seeds, nil
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/ringpop-go/hashring"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type memberlistServiceResolver struct {
+	status      int32
+	service     string
+	ml          *Memberlist
+	refreshChan chan struct{}
+	shutdownCh  chan struct{}
+	shutdownWG  sync.WaitGroup
+	logger      log.Logger
+
+	ringValue atomic.Value // this stores the current hashring
+
+	refreshLock sync.Mutex
+	membersMap  map[string]struct{} // for computing change notifications
+
+	listenerLock sync.RWMutex
+	listeners    map[string]chan<- *ChangedEvent
+}
+
+var _ ServiceResolver = (*memberlistServiceResolver)(nil)
+
+func newMemberlistServiceResolver(
+	service string,
+	ml *Memberlist,
+	logger log.Logger,
+) *memberlistServiceResolver {
+
+	resolver := &memberlistServiceResolver{
+		status:      common.DaemonStatusInitialized,
+		service:     service,
+		ml:          ml,
+		refreshChan: make(chan struct{}, 1),
+		shutdownCh:  make(chan struct{}),
+		logger:      logger.WithTags(tag.ComponentServiceResolver),
+		membersMap:  make(map[string]struct{}),
+		listeners:   make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(newHashRing())
+	return resolver
+}
+
+// Start starts the resolver
+func (r *memberlistServiceResolver) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	r.ml.addListener(r)
+	r.refresh()
+
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+}
+
+// Stop stops the resolver
+func (r *memberlistServiceResolver) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	r.ml.removeListener(r)
+	close(r.shutdownCh)
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	r.ringValue.Store(newHashRing())
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *memberlistServiceResolver) Lookup(
+	key string,
+) (*HostInfo, error) {
+
+	addr, found := r.ring().Lookup(key)
+	if !found {
+		r.membershipChanged()
+		return nil, ErrInsufficientHosts
+	}
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *memberlistServiceResolver) AddListener(
+	name string,
+	notifyChannel chan<- *ChangedEvent,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *memberlistServiceResolver) RemoveListener(
+	name string,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	delete(r.listeners, name)
+	return nil
+}
+
+func (r *memberlistServiceResolver) MemberCount() int {
+	return r.ring().ServerCount()
+}
+
+func (r *memberlistServiceResolver) Members() []*HostInfo {
+	var servers []*HostInfo
+	for _, s := range r.ring().Servers() {
+		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+	}
+	return servers
+}
+
+// membershipChanged is called by memberlist while holding its own locks,
+// so the ring is refreshed asynchronously by the refresh worker
+func (r *memberlistServiceResolver) membershipChanged() {
+	select {
+	case r.refreshChan <- struct{}{}:
+	default:
+	}
+}
+
+func (r *memberlistServiceResolver) refresh() {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+
+	addrs := r.ml.GetReachableMembers(RoleKey, r.service)
+	newMembersMap := make(map[string]struct{}, len(addrs))
+	event := &ChangedEvent{}
+	for _, addr := range addrs {
+		newMembersMap[addr] = struct{}{}
+		if _, ok := r.membersMap[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	if len(event.HostsAdded) == 0 && len(event.HostsRemoved) == 0 {
+		return
+	}
+
+	ring := newHashRing()
+	for _, addr := range addrs {
+		ring.AddMembers(NewHostInfo(addr, r.getLabelsMap()))
+	}
+	r.membersMap = newMembersMap
+	r.ringValue.Store(ring)
+	r.logger.Info("Current reachable members", tag.Addresses(addrs))
+	r.emitEvent(event)
+}
+
+func (r *memberlistServiceResolver) emitEvent(
+	event *ChangedEvent,
+) {
+
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *memberlistServiceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(defaultRefreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-r.refreshChan:
+			r.refresh()
+		case <-refreshTicker.C:
+			r.refresh()
+		}
+	}
+}
+
+func (r *memberlistServiceResolver) ring() *hashring.HashRing {
+	return r.ringValue.Load().(*hashring.HashRing)
+}
+
+func (r *memberlistServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = r.service
+	return labels
+}
@@ -0,0 +1,304 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/quotas"
+)
+
+const (
+	// DemandLabel is the membership label in which each host publishes the rate of requests it receives per quota key
+	DemandLabel = "quota_demand"
+	// DemandLabelMaxSize is the size limit of the demand label. Memberlist gossips the JSON encoded labels of
+	// a member in its metadata, which can't exceed memberlist.MetaMaxSize (512 bytes), so the limit leaves room
+	// for the role label and for the escaping of the demand label.
+	DemandLabelMaxSize = 384
+
+	// _minShareRatio is the floor of the share of a host relative to an even split,
+	// so that a host which starts receiving traffic is not starved until the next refresh
+	_minShareRatio = 0.25
+	// _demandChangeRatio is the relative change of the demand for which it is published again
+	_demandChangeRatio = 0.1
+	// _maxRecordedKeys caps the number of quota keys whose requests are counted between two refreshes
+	_maxRecordedKeys = 10000
+	// _maxPublishedKeys caps the number of quota keys whose demand is published, the busiest keys are kept
+	_maxPublishedKeys = 50
+)
+
+type (
+	quotaShare struct {
+		status          int32
+		monitor         Monitor
+		service         string
+		refreshInterval func() time.Duration
+		logger          log.Logger
+		shutdownCh      chan struct{}
+
+		sync.Mutex
+		requests map[string]int64
+
+		// shares holds a map[string]float64 of the share of each published quota key
+		shares      atomic.Value
+		lastRefresh time.Time
+		published   map[string]float64
+	}
+)
+
+var _ quotas.GlobalShare = (*quotaShare)(nil)
+
+// NewQuotaShare returns a GlobalShare coordinated through membership: every host of the service
+// publishes its demand per quota key as a membership label, and the cluster-wide quota of each key is
+// split between the hosts proportionally to their demand for it instead of evenly.
+func NewQuotaShare(
+	monitor Monitor,
+	service string,
+	refreshInterval func() time.Duration,
+	logger log.Logger,
+) quotas.GlobalShare {
+	s := &quotaShare{
+		status:          common.DaemonStatusInitialized,
+		monitor:         monitor,
+		service:         service,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+		shutdownCh:      make(chan struct{}),
+		requests:        make(map[string]int64),
+	}
+	s.storeShares(map[string]float64{quotas.GlobalQuotaKey: 1})
+	return s
+}
+
+func (s *quotaShare) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	s.lastRefresh = time.Now()
+	if count, err := s.monitor.GetMemberCount(s.service); err == nil && count > 0 {
+		s.storeShares(map[string]float64{quotas.GlobalQuotaKey: 1 / float64(count)})
+	}
+	go s.refreshLoop()
+}
+
+func (s *quotaShare) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(s.shutdownCh)
+}
+
+func (s *quotaShare) Share(key string) float64 {
+	shares := s.shares.Load().(map[string]float64)
+	if share, ok := shares[key]; ok {
+		return share
+	}
+	// the demand of the keys which are not published is not known, they follow the demand of the host
+	return shares[quotas.GlobalQuotaKey]
+}
+
+func (s *quotaShare) Record(keys ...string) {
+	s.Lock()
+	defer s.Unlock()
+	for _, key := range keys {
+		if _, ok := s.requests[key]; ok || len(s.requests) < _maxRecordedKeys {
+			s.requests[key]++
+		}
+	}
+}
+
+func (s *quotaShare) refreshLoop() {
+	timer := time.NewTimer(s.refreshInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-timer.C:
+			s.refresh()
+			timer.Reset(s.refreshInterval())
+		}
+	}
+}
+
+func (s *quotaShare) refresh() {
+	now := time.Now()
+	elapsed := now.Sub(s.lastRefresh).Seconds()
+	s.lastRefresh = now
+	if elapsed <= 0 {
+		return
+	}
+	s.Lock()
+	requests := s.requests
+	s.requests = make(map[string]int64, len(requests))
+	s.Unlock()
+
+	demands := busiestDemands(requests, elapsed)
+	if demandChanged(s.published, demands) {
+		label, err := json.Marshal(demands)
+		if err == nil {
+			err = s.monitor.SetSelfLabel(DemandLabel, string(label))
+		}
+		if err != nil {
+			s.logger.Warn("failed to publish quota demand", tag.Error(err))
+		} else {
+			s.published = demands
+		}
+	}
+
+	self, err := s.monitor.WhoAmI()
+	if err != nil {
+		s.logger.Warn("failed to refresh quota share", tag.Error(err))
+		return
+	}
+	labels, err := s.monitor.GetMemberLabels(s.service, DemandLabel)
+	if err != nil {
+		s.logger.Warn("failed to refresh quota share", tag.Error(err))
+		return
+	}
+	count, err := s.monitor.GetMemberCount(s.service)
+	if err != nil {
+		s.logger.Warn("failed to refresh quota share", tag.Error(err))
+		return
+	}
+	s.storeShares(computeShares(demands, self.GetAddress(), labels, count))
+}
+
+func (s *quotaShare) storeShares(shares map[string]float64) {
+	s.shares.Store(shares)
+}
+
+// busiestDemands returns the rate of requests of the busiest quota keys, whose encoding fits in the demand label.
+// The demand of the host is always included.
+func busiestDemands(requests map[string]int64, elapsed float64) map[string]float64 {
+	keys := make([]string, 0, len(requests))
+	for key := range requests {
+		if key != quotas.GlobalQuotaKey {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return requests[keys[i]] > requests[keys[j]]
+	})
+
+	demands := map[string]float64{quotas.GlobalQuotaKey: roundDemand(float64(requests[quotas.GlobalQuotaKey]) / elapsed)}
+	// the encoded size of an entry is its quoted key, a colon, a comma and its demand
+	size := 2 + len(quotas.GlobalQuotaKey) + 4 + 16
+	for _, key := range keys {
+		if len(demands) >= _maxPublishedKeys {
+			break
+		}
+		entrySize := len(key) + 4 + 16
+		if size+entrySize > DemandLabelMaxSize {
+			continue
+		}
+		size += entrySize
+		demands[key] = roundDemand(float64(requests[key]) / elapsed)
+	}
+	return demands
+}
+
+func roundDemand(demand float64) float64 {
+	return math.Round(demand*10) / 10
+}
+
+// demandChanged returns whether the demand of a key changed enough for it to be published again
+func demandChanged(published map[string]float64, demands map[string]float64) bool {
+	if published == nil || len(published) != len(demands) {
+		return true
+	}
+	for key, demand := range demands {
+		previous, ok := published[key]
+		if !ok || math.Abs(demand-previous) > math.Max(previous*_demandChangeRatio, 1) {
+			return true
+		}
+	}
+	return false
+}
+
+// computeShares returns, for each quota key, the share of the cluster-wide quota of this host.
+// Every member is granted at least a floor of an even split, and the shares are normalized
+// after applying the floor so that the shares of all the members add up to the whole quota.
+func computeShares(selfDemands map[string]float64, self string, labels map[string]string, memberCount int) map[string]float64 {
+	shares := make(map[string]float64, len(selfDemands))
+	if memberCount <= 1 {
+		for key := range selfDemands {
+			shares[key] = 1
+		}
+		shares[quotas.GlobalQuotaKey] = 1
+		return shares
+	}
+
+	var others []map[string]float64
+	for address, value := range labels {
+		if address == self {
+			continue
+		}
+		var demands map[string]float64
+		if err := json.Unmarshal([]byte(value), &demands); err != nil {
+			continue
+		}
+		others = append(others, demands)
+	}
+	// members which did not publish their demand yet are assumed to receive the average demand
+	unknown := memberCount - 1 - len(others)
+	if unknown < 0 {
+		unknown = 0
+	}
+
+	even := 1 / float64(memberCount)
+	floor := even * _minShareRatio
+	for key, selfDemand := range selfDemands {
+		memberDemands := []float64{math.Max(selfDemand, 0)}
+		for _, demands := range others {
+			// members which do not publish a key have no or little demand for it
+			memberDemands = append(memberDemands, math.Max(demands[key], 0))
+		}
+		total := 0.0
+		for _, demand := range memberDemands {
+			total += demand
+		}
+		total += float64(unknown) * total / float64(len(memberDemands))
+		if total <= 0 {
+			shares[key] = even
+			continue
+		}
+
+		average := total / float64(memberCount)
+		normalization := float64(unknown) * math.Max(average/total, floor)
+		for _, demand := range memberDemands {
+			normalization += math.Max(demand/total, floor)
+		}
+		shares[key] = math.Min(math.Max(selfDemand/total, floor)/normalization, 1)
+	}
+	if _, ok := shares[quotas.GlobalQuotaKey]; !ok {
+		shares[quotas.GlobalQuotaKey] = even
+	}
+	return shares
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+func TestComputeShares(t *testing.T) {
+	tests := map[string]struct {
+		selfDemands map[string]float64
+		labels      map[string]string
+		memberCount int
+		shares      map[string]float64
+	}{
+		"single member": {
+			selfDemands: map[string]float64{"global": 10, "domain:a": 10},
+			memberCount: 1,
+			shares:      map[string]float64{"global": 1, "domain:a": 1},
+		},
+		"proportional to demand": {
+			selfDemands: map[string]float64{"global": 30},
+			labels:      map[string]string{"self": `{"global":0}`, "b": `{"global":10}`},
+			memberCount: 2,
+			shares:      map[string]float64{"global": 0.75},
+		},
+		"proportional to the demand of each key": {
+			selfDemands: map[string]float64{"global": 10, "domain:a": 10},
+			labels:      map[string]string{"b": `{"global":30,"domain:b":30}`},
+			memberCount: 2,
+			// b has no demand for domain:a, but keeps the floor of its share
+			shares: map[string]float64{"global": 0.25, "domain:a": 1 / (1 + 0.5*_minShareRatio)},
+		},
+		"unknown members receive the average demand": {
+			selfDemands: map[string]float64{"global": 10},
+			labels:      map[string]string{"b": `{"global":30}`},
+			memberCount: 4,
+			shares:      map[string]float64{"global": 0.125},
+		},
+		"no demand is split evenly": {
+			selfDemands: map[string]float64{"global": 0},
+			labels:      map[string]string{"b": `{"global":0}`, "c": `{"global":0}`, "d": `{"global":0}`},
+			memberCount: 4,
+			shares:      map[string]float64{"global": 0.25},
+		},
+		"shares are normalized after applying the floor": {
+			selfDemands: map[string]float64{"global": 0},
+			labels:      map[string]string{"b": `{"global":100}`},
+			memberCount: 2,
+			shares:      map[string]float64{"global": 0.5 * _minShareRatio / (1 + 0.5*_minShareRatio)},
+		},
+		"invalid labels are ignored": {
+			selfDemands: map[string]float64{"global": 10},
+			labels:      map[string]string{"b": "invalid"},
+			memberCount: 2,
+			shares:      map[string]float64{"global": 0.5},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			shares := computeShares(test.selfDemands, "self", test.labels, test.memberCount)
+			assert.Len(t, shares, len(test.shares))
+			for key, share := range test.shares {
+				assert.InDelta(t, share, shares[key], 0.0001, key)
+			}
+		})
+	}
+}
+
+func TestBusiestDemands(t *testing.T) {
+	requests := map[string]int64{"global": 100}
+	for i := 0; i < 2*_maxPublishedKeys; i++ {
+		requests["domain:"+strconv.Itoa(i)] = int64(i)
+	}
+	demands := busiestDemands(requests, 10)
+	assert.Len(t, demands, _maxPublishedKeys)
+	assert.Equal(t, 10.0, demands["global"])
+	assert.Equal(t, 9.9, demands["domain:99"])
+	assert.NotContains(t, demands, "domain:0")
+
+	label, err := json.Marshal(busiestDemands(map[string]int64{strings.Repeat("a", DemandLabelMaxSize): 1}, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"global":0}`, string(label))
+}
+
+func TestQuotaShareRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	monitor := NewMockMonitor(ctrl)
+	monitor.EXPECT().GetMemberCount("frontend").Return(4, nil).AnyTimes()
+	share := NewQuotaShare(monitor, "frontend", func() time.Duration { return time.Hour }, loggerimpl.NewNopLogger()).(*quotaShare)
+	assert.Equal(t, 1.0, share.Share("global"))
+
+	share.Start()
+	defer share.Stop()
+	assert.Equal(t, 0.25, share.Share("global"))
+	assert.Equal(t, 0.25, share.Share("domain:a"))
+
+	for i := 0; i < 300; i++ {
+		share.Record("global", "domain:a")
+	}
+	share.lastRefresh = time.Now().Add(-10 * time.Second)
+	monitor.EXPECT().SetSelfLabel(DemandLabel, `{"domain:a":30,"global":30}`).Return(nil)
+	monitor.EXPECT().WhoAmI().Return(NewHostInfo("self", nil), nil)
+	monitor.EXPECT().GetMemberLabels("frontend", DemandLabel).Return(map[string]string{
+		"b": `{"global":10}`,
+		"c": `{"global":10}`,
+		"d": `{"global":10}`,
+	}, nil)
+	share.refresh()
+	assert.InDelta(t, 0.5, share.Share("global"), 0.01)
+	assert.InDelta(t, 1/(1+3*0.25*_minShareRatio), share.Share("domain:a"), 0.01)
+	// the keys which are not published follow the share of the host
+	assert.InDelta(t, 0.5, share.Share("domain:b"), 0.01)
+
+	// the demand is not published again while it does not change
+	for i := 0; i < 300; i++ {
+		share.Record("global", "domain:a")
+	}
+	share.lastRefresh = time.Now().Add(-10 * time.Second)
+	monitor.EXPECT().WhoAmI().Return(NewHostInfo("self", nil), nil)
+	monitor.EXPECT().GetMemberLabels("frontend", DemandLabel).Return(map[string]string{
+		"b": `{"global":90}`,
+		"c": `{"global":0}`,
+		"d": `{"global":0}`,
+	}, nil)
+	share.refresh()
+	assert.InDelta(t, 0.25/(1+2*0.25*_minShareRatio), share.Share("global"), 0.01)
+}
@@ -23,6 +23,8 @@ package membership
 import (
 	"sync/atomic"
 
+	"github.com/uber/ringpop-go/swim"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -162,3 +164,29 @@ func (rpo *ringpopMonitor) GetMemberCount(service string) (int, error) {
 	}
 	return ring.MemberCount(), nil
 }
+
+func (rpo *ringpopMonitor) SetSelfLabel(key string, value string) error {
+	labels, err := rpo.rp.Labels()
+	if err != nil {
+		return err
+	}
+	return labels.Set(key, value)
+}
+
+func (rpo *ringpopMonitor) GetMemberLabels(role string, key string) (map[string]string, error) {
+	values := make(map[string]string)
+	// the predicate is used to read the gossiped labels of the members as ringpop only returns their addresses
+	_, err := rpo.rp.GetReachableMembers(
+		swim.MemberWithLabelAndValue(RoleKey, role),
+		func(member swim.Member) bool {
+			if value, ok := member.Labels[key]; ok {
+				values[member.Address] = value
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -85,6 +85,19 @@ func (s *RpoSuite) TestRingpopMonitor() {
 	testService.Stop()
 }
 
+func (s *RpoSuite) TestMemberLabels() {
+	testService := NewTestRingpopCluster("rpm-test", 3, "127.0.0.1", "", "rpm-test")
+	s.NotNil(testService, "Failed to create test service")
+
+	s.NoError(testService.rings[1].SetSelfLabel("test-label", "value"))
+	s.Eventually(func() bool {
+		labels, err := testService.rings[0].GetMemberLabels("rpm-test", "test-label")
+		return err == nil && labels[testService.hostAddrs[1]] == "value" && len(labels) == 1
+	}, 30*time.Second, 100*time.Millisecond, "label was not propagated to the other members")
+
+	testService.Stop()
+}
+
 func (s *RpoSuite) TestCompareMembers() {
 	s.testCompareMembers([]string{}, []string{"a"}, true)
 	s.testCompareMembers([]string{}, []string{"a", "b"}, true)
@@ -0,0 +1,150 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencequeue
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	defaultPollInterval           = time.Second
+	defaultBatchSize              = 100
+	defaultAckLevelUpdateInterval = 5 * time.Second
+)
+
+type (
+	// QueueManagerFactory creates the queue manager of a queue type, usually the persistence client factory
+	QueueManagerFactory interface {
+		NewQueueManager(queueType persistence.QueueType) (persistence.QueueManager, error)
+	}
+
+	// clientImpl is an implementation of messaging.Client backed by the queue tables of the persistence store.
+	// Each application is a queue, and each consumer name keeps its own ack level in the queue metadata,
+	// so several consumers can read the same application independently.
+	clientImpl struct {
+		config        config.PersistenceQueueMessaging
+		factory       QueueManagerFactory
+		metricsClient metrics.Client
+		logger        log.Logger
+
+		sync.Mutex
+		queues map[persistence.QueueType]persistence.QueueManager
+	}
+)
+
+// applicationQueueTypes maps the applications to their queues
+var applicationQueueTypes = map[string]persistence.QueueType{
+	common.VisibilityAppName:        persistence.VisibilityQueueType,
+	common.DomainReplicationAppName: persistence.DomainReplicationQueueType,
+}
+
+var _ messaging.Client = (*clientImpl)(nil)
+
+// NewClient is used to create a messaging client backed by the persistence queue
+func NewClient(
+	cfg config.PersistenceQueueMessaging,
+	factory QueueManagerFactory,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) messaging.Client {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.AckLevelUpdateInterval <= 0 {
+		cfg.AckLevelUpdateInterval = defaultAckLevelUpdateInterval
+	}
+	return &clientImpl{
+		config:        cfg,
+		factory:       factory,
+		metricsClient: metricsClient,
+		logger:        logger,
+		queues:        make(map[persistence.QueueType]persistence.QueueManager),
+	}
+}
+
+// NewConsumer is used to create a consumer reading the queue of the application,
+// the consumer name must be declared for the application in the config
+func (c *clientImpl) NewConsumer(app, consumerName string) (messaging.Consumer, error) {
+	consumerNames := c.config.Consumers[app]
+	if !isDeclared(consumerName, consumerNames) {
+		return nil, fmt.Errorf("consumer %v is not declared for application: %v", consumerName, app)
+	}
+	queue, err := c.getQueue(app)
+	if err != nil {
+		return nil, err
+	}
+	return newConsumer(queue, consumerName, consumerNames, c.config, c.metricsClient, c.logger.WithTags(tag.KafkaConsumerName(consumerName))), nil
+}
+
+// NewProducer is used to create a producer writing to the queue of the application
+func (c *clientImpl) NewProducer(app string) (messaging.Producer, error) {
+	queue, err := c.getQueue(app)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.metricsClient != nil {
+		return messaging.NewMetricProducer(newProducer(queue, c.logger), c.metricsClient), nil
+	}
+	return newProducer(queue, c.logger), nil
+}
+
+func (c *clientImpl) getQueue(app string) (persistence.QueueManager, error) {
+	queueType, ok := applicationQueueTypes[app]
+	if !ok {
+		return nil, fmt.Errorf("no persistence queue for application: %v", app)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if queue, ok := c.queues[queueType]; ok {
+		return queue, nil
+	}
+	queue, err := c.factory.NewQueueManager(queueType)
+	if err != nil {
+		return nil, err
+	}
+	c.queues[queueType] = queue
+	return queue, nil
+}
+
+func isDeclared(consumerName string, consumerNames []string) bool {
+	for _, name := range consumerNames {
+		if name == consumerName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencequeue
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	purgeInterval       = 5 * time.Minute
+	persistenceTimeout  = 10 * time.Second
+	dlqPublishTimeout   = time.Minute
+	consumerPartitionID = 0
+)
+
+type (
+	// consumerImpl polls the persistence queue from the ack level of the consumer name.
+	// Delivery is at least once: messages after the persisted ack level are delivered again after a restart,
+	// and consumers sharing a name on different hosts all receive every message.
+	consumerImpl struct {
+		queue         persistence.QueueManager
+		consumerName  string
+		consumerNames []string // all the consumers declared for the queue
+		config        config.PersistenceQueueMessaging
+		ackManager    messaging.AckManager
+		msgChan       chan messaging.Message
+
+		status            int32
+		shutdownCh        chan struct{}
+		shutdownWG        sync.WaitGroup
+		persistedAckLevel int64
+
+		metricsClient metrics.Client
+		logger        log.Logger
+	}
+
+	messageImpl struct {
+		id       int64
+		payload  []byte
+		consumer *consumerImpl
+	}
+)
+
+var _ messaging.Consumer = (*consumerImpl)(nil)
+var _ messaging.Message = (*messageImpl)(nil)
+
+func newConsumer(
+	queue persistence.QueueManager,
+	consumerName string,
+	consumerNames []string,
+	cfg config.PersistenceQueueMessaging,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *consumerImpl {
+	return &consumerImpl{
+		queue:         queue,
+		consumerName:  consumerName,
+		consumerNames: consumerNames,
+		config:        cfg,
+		ackManager:    messaging.NewAckManager(logger),
+		msgChan:       make(chan messaging.Message, cfg.BatchSize),
+		status:        common.DaemonStatusInitialized,
+		shutdownCh:    make(chan struct{}),
+
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+}
+
+// Start loads the ack level of the consumer and starts polling the queue
+func (c *consumerImpl) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	ackLevels, err := c.queue.GetAckLevels(ctx)
+	if err != nil {
+		atomic.StoreInt32(&c.status, common.DaemonStatusInitialized)
+		return err
+	}
+
+	ackLevel, ok := ackLevels[c.consumerName]
+	if !ok {
+		ackLevel = -1
+	}
+	c.persistedAckLevel = ackLevel
+	c.ackManager.SetAckLevel(ackLevel)
+
+	c.shutdownWG.Add(2)
+	go c.pollLoop()
+	go c.ackLevelLoop()
+
+	c.logger.Info("Persistence queue consumer started", tag.ReadLevel(ackLevel))
+	return nil
+}
+
+// Stop stops polling, persists the ack level and closes the message channel
+func (c *consumerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	c.logger.Info("Stopping consumer")
+	close(c.shutdownCh)
+	c.shutdownWG.Wait()
+	close(c.msgChan)
+}
+
+// Messages return the message channel for this consumer
+func (c *consumerImpl) Messages() <-chan messaging.Message {
+	return c.msgChan
+}
+
+func (c *consumerImpl) pollLoop() {
+	defer c.shutdownWG.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+		messages, err := c.queue.ReadMessages(ctx, c.ackManager.GetReadLevel(), c.config.BatchSize)
+		cancel()
+		if err != nil {
+			c.logger.Warn("Failed to read messages from persistence queue", tag.Error(err))
+			timer.Reset(c.config.PollInterval)
+			continue
+		}
+
+		for _, message := range messages {
+			if err := c.ackManager.ReadItem(message.ID); err != nil {
+				c.logger.Warn("Failed to read message into ack manager", tag.KafkaOffset(message.ID), tag.Error(err))
+				continue
+			}
+			c.metricsClient.IncCounter(metrics.MessagingClientConsumerScope, metrics.KafkaConsumerMessageIn)
+
+			select {
+			case c.msgChan <- &messageImpl{id: message.ID, payload: message.Payload, consumer: c}:
+			case <-c.shutdownCh:
+				return
+			}
+		}
+
+		if len(messages) == c.config.BatchSize {
+			// there may be more messages, read them right away
+			timer.Reset(0)
+		} else {
+			timer.Reset(c.config.PollInterval)
+		}
+	}
+}
+
+func (c *consumerImpl) ackLevelLoop() {
+	defer c.shutdownWG.Done()
+
+	updateTicker := time.NewTicker(c.config.AckLevelUpdateInterval)
+	defer updateTicker.Stop()
+	purgeTicker := time.NewTicker(purgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			c.updateAckLevel()
+			return
+		case <-updateTicker.C:
+			c.updateAckLevel()
+		case <-purgeTicker.C:
+			c.purgeAckedMessages()
+		}
+	}
+}
+
+func (c *consumerImpl) updateAckLevel() {
+	ackLevel := c.ackManager.GetAckLevel()
+	if ackLevel <= c.persistedAckLevel {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	if err := c.queue.UpdateAckLevel(ctx, ackLevel, c.consumerName); err != nil {
+		c.logger.Warn("Failed to update ack level of persistence queue consumer", tag.Error(err))
+		return
+	}
+	c.persistedAckLevel = ackLevel
+}
+
+// purgeAckedMessages deletes the messages acked by all the consumers of the queue. The consumers are declared
+// up front, so the messages are kept for the declared consumers which have not started yet, as well as for
+// the other readers of the queue with an ack level, such as the remote clusters reading domain replication tasks.
+func (c *consumerImpl) purgeAckedMessages() {
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+
+	ackLevels, err := c.queue.GetAckLevels(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to purge acked messages of persistence queue", tag.Error(err))
+		return
+	}
+
+	minAckLevel := int64(math.MaxInt64)
+	for _, consumerName := range c.consumerNames {
+		ackLevel, ok := ackLevels[consumerName]
+		if !ok {
+			// the consumer has not acked any message yet
+			return
+		}
+		minAckLevel = common.MinInt64(minAckLevel, ackLevel)
+	}
+	for _, ackLevel := range ackLevels {
+		minAckLevel = common.MinInt64(minAckLevel, ackLevel)
+	}
+	if err := c.queue.DeleteMessagesBefore(ctx, minAckLevel); err != nil {
+		c.logger.Warn("Failed to purge acked messages of persistence queue", tag.Error(err))
+	}
+}
+
+func (c *consumerImpl) completeMessage(message *messageImpl, isAck bool) {
+	if !isAck {
+		op := func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), dlqPublishTimeout)
+			defer cancel()
+			return c.queue.EnqueueMessageToDLQ(ctx, message.payload)
+		}
+		err := backoff.Retry(op, common.CreateDlqPublishRetryPolicy(), nil)
+		if err != nil {
+			c.metricsClient.IncCounter(metrics.MessagingClientConsumerScope, metrics.KafkaConsumerMessageNackDlqErr)
+			c.logger.Error("Fail to publish message to DLQ when nacking message, please take action!!",
+				tag.KafkaOffset(message.id))
+		} else {
+			c.logger.Warn("nack message and publish to DLQ", tag.KafkaOffset(message.id))
+		}
+		c.metricsClient.IncCounter(metrics.MessagingClientConsumerScope, metrics.KafkaConsumerMessageNack)
+	} else {
+		c.metricsClient.IncCounter(metrics.MessagingClientConsumerScope, metrics.KafkaConsumerMessageAck)
+	}
+	c.ackManager.AckItem(message.id)
+}
+
+func (m *messageImpl) Value() []byte {
+	return m.payload
+}
+
+// Partition is always 0 as the persistence queue is not partitioned
+func (m *messageImpl) Partition() int32 {
+	return consumerPartitionID
+}
+
+// Offset is the ID of the message in the queue
+func (m *messageImpl) Offset() int64 {
+	return m.id
+}
+
+func (m *messageImpl) Ack() error {
+	m.consumer.completeMessage(m, true)
+	return nil
+}
+
+func (m *messageImpl) Nack() error {
+	m.consumer.completeMessage(m, false)
+	return nil
+}
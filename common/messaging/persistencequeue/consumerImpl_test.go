@@ -0,0 +1,251 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencequeue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+type (
+	persistenceQueueSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller           *gomock.Controller
+		mockQueue            *persistence.MockQueueManager
+		mockReplicationQueue *persistence.MockQueueManager
+		client               *clientImpl
+	}
+
+	fakeQueueManagerFactory struct {
+		queues map[persistence.QueueType]persistence.QueueManager
+		calls  int
+	}
+)
+
+const (
+	testConsumerName      = "test-consumer"
+	testOtherConsumerName = "other-consumer"
+)
+
+func TestPersistenceQueueSuite(t *testing.T) {
+	suite.Run(t, new(persistenceQueueSuite))
+}
+
+func (s *persistenceQueueSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockQueue = persistence.NewMockQueueManager(s.controller)
+	s.mockReplicationQueue = persistence.NewMockQueueManager(s.controller)
+
+	factory := &fakeQueueManagerFactory{
+		queues: map[persistence.QueueType]persistence.QueueManager{
+			persistence.VisibilityQueueType:        s.mockQueue,
+			persistence.DomainReplicationQueueType: s.mockReplicationQueue,
+		},
+	}
+	s.client = NewClient(
+		config.PersistenceQueueMessaging{
+			PollInterval:           10 * time.Millisecond,
+			BatchSize:              2,
+			AckLevelUpdateInterval: time.Hour,
+			Consumers: map[string][]string{
+				common.VisibilityAppName:        {testConsumerName, testOtherConsumerName},
+				common.DomainReplicationAppName: {testConsumerName},
+			},
+		},
+		factory,
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewNopLogger(),
+	).(*clientImpl)
+}
+
+func (s *persistenceQueueSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *persistenceQueueSuite) TestNewClient_Defaults() {
+	client := NewClient(config.PersistenceQueueMessaging{}, &fakeQueueManagerFactory{}, nil, loggerimpl.NewNopLogger()).(*clientImpl)
+	s.Equal(defaultPollInterval, client.config.PollInterval)
+	s.Equal(defaultBatchSize, client.config.BatchSize)
+	s.Equal(defaultAckLevelUpdateInterval, client.config.AckLevelUpdateInterval)
+}
+
+func (s *persistenceQueueSuite) TestUnknownApplication() {
+	_, err := s.client.NewProducer("unknown")
+	s.Error(err)
+	_, err = s.client.NewConsumer("unknown", testConsumerName)
+	s.Error(err)
+}
+
+func (s *persistenceQueueSuite) TestUndeclaredConsumer() {
+	_, err := s.client.NewConsumer(common.VisibilityAppName, "undeclared-consumer")
+	s.Error(err)
+	_, err = s.client.NewConsumer(common.DomainReplicationAppName, testOtherConsumerName)
+	s.Error(err)
+}
+
+func (s *persistenceQueueSuite) TestQueueIsShared() {
+	_, err := s.client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	_, err = s.client.NewConsumer(common.VisibilityAppName, testConsumerName)
+	s.NoError(err)
+	s.Equal(1, s.client.factory.(*fakeQueueManagerFactory).calls)
+}
+
+func (s *persistenceQueueSuite) TestPublish() {
+	msg := &indexer.Message{
+		MessageType: indexer.MessageTypeIndex.Ptr(),
+		WorkflowID:  common.StringPtr("wid"),
+		RunID:       common.StringPtr("rid"),
+		Version:     common.Int64Ptr(10),
+	}
+	payload, err := codec.NewThriftRWEncoder().Encode(msg)
+	s.NoError(err)
+	s.mockQueue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(nil).Times(1)
+
+	producer, err := s.client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	s.NoError(producer.Publish(context.Background(), msg))
+	s.Error(producer.Publish(context.Background(), "unknown message"))
+}
+
+func (s *persistenceQueueSuite) TestPublish_ReplicationTask() {
+	task := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeDomain.Ptr(),
+		DomainTaskAttributes: &types.DomainTaskAttributes{
+			ID: "domainID",
+		},
+	}
+	payload, err := codec.NewThriftRWEncoder().Encode(thrift.FromReplicationTask(task))
+	s.NoError(err)
+	s.mockReplicationQueue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(nil).Times(1)
+
+	producer, err := s.client.NewProducer(common.DomainReplicationAppName)
+	s.NoError(err)
+	s.NoError(producer.Publish(context.Background(), task))
+}
+
+func (s *persistenceQueueSuite) TestConsume() {
+	s.mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{testConsumerName: 10, testOtherConsumerName: 5}, nil).Times(1)
+	s.mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(10), 2).Return([]*persistence.QueueMessage{
+		{ID: 11, Payload: []byte("11")},
+		{ID: 12, Payload: []byte("12")},
+	}, nil).Times(1)
+	s.mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(12), 2).Return([]*persistence.QueueMessage{
+		{ID: 13, Payload: []byte("13")},
+	}, nil).Times(1)
+	s.mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(13), 2).Return(nil, nil).AnyTimes()
+	s.mockQueue.EXPECT().EnqueueMessageToDLQ(gomock.Any(), []byte("12")).Return(nil).Times(1)
+	// message 13 is not acked, so the ack level stops at 12
+	s.mockQueue.EXPECT().UpdateAckLevel(gomock.Any(), int64(12), testConsumerName).Return(nil).Times(1)
+
+	consumer, err := s.client.NewConsumer(common.VisibilityAppName, testConsumerName)
+	s.NoError(err)
+	s.NoError(consumer.Start())
+
+	for _, offset := range []int64{11, 12, 13} {
+		select {
+		case msg := <-consumer.Messages():
+			s.Equal(offset, msg.Offset())
+			s.Equal(int32(0), msg.Partition())
+			switch offset {
+			case 11:
+				s.NoError(msg.Ack())
+			case 12:
+				s.NoError(msg.Nack())
+			}
+		case <-time.After(time.Second):
+			s.Fail("timed out waiting for message")
+		}
+	}
+
+	consumer.Stop()
+	_, ok := <-consumer.Messages()
+	s.False(ok)
+}
+
+func (s *persistenceQueueSuite) TestConsume_StartFailure() {
+	s.mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(nil, errors.New("some error")).Times(1)
+
+	consumer, err := s.client.NewConsumer(common.VisibilityAppName, testConsumerName)
+	s.NoError(err)
+	s.Error(consumer.Start())
+}
+
+func (s *persistenceQueueSuite) TestPurgeAckedMessages() {
+	testCases := []struct {
+		name      string
+		ackLevels map[string]int64
+		purgedTo  *int64
+	}{
+		{
+			name:      "acked by all consumers",
+			ackLevels: map[string]int64{testConsumerName: 10, testOtherConsumerName: 5},
+			purgedTo:  common.Int64Ptr(5),
+		},
+		{
+			name:      "declared consumer not started",
+			ackLevels: map[string]int64{testConsumerName: 10},
+		},
+		{
+			name:      "other reader of the queue",
+			ackLevels: map[string]int64{testConsumerName: 10, testOtherConsumerName: 5, "remote-cluster": 3},
+			purgedTo:  common.Int64Ptr(3),
+		},
+	}
+
+	consumer, err := s.client.NewConsumer(common.VisibilityAppName, testConsumerName)
+	s.NoError(err)
+	for _, tc := range testCases {
+		s.mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(tc.ackLevels, nil).Times(1)
+		if tc.purgedTo != nil {
+			s.mockQueue.EXPECT().DeleteMessagesBefore(gomock.Any(), *tc.purgedTo).Return(nil).Times(1)
+		}
+		consumer.(*consumerImpl).purgeAckedMessages()
+	}
+}
+
+func (f *fakeQueueManagerFactory) NewQueueManager(queueType persistence.QueueType) (persistence.QueueManager, error) {
+	f.calls++
+	queue, ok := f.queues[queueType]
+	if !ok {
+		return nil, errors.New("unknown queue type")
+	}
+	return queue, nil
+}
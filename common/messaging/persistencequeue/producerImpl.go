@@ -0,0 +1,90 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencequeue
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+type (
+	producerImpl struct {
+		queue      persistence.QueueManager
+		msgEncoder codec.BinaryEncoder
+		logger     log.Logger
+	}
+)
+
+var _ messaging.Producer = (*producerImpl)(nil)
+
+func newProducer(queue persistence.QueueManager, logger log.Logger) messaging.Producer {
+	return &producerImpl{
+		queue:      queue,
+		msgEncoder: codec.NewThriftRWEncoder(),
+		logger:     logger,
+	}
+}
+
+// Publish is used to enqueue messages to the persistence queue.
+// Payloads are encoded the same way as in kafka, so consumers decode them without knowing the client.
+func (p *producerImpl) Publish(ctx context.Context, msg interface{}) error {
+	payload, err := p.getPayload(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := p.queue.EnqueueMessage(ctx, payload); err != nil {
+		p.logger.Warn("Failed to publish message to persistence queue", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *producerImpl) getPayload(message interface{}) ([]byte, error) {
+	switch message := message.(type) {
+	case *indexer.Message:
+		payload, err := p.msgEncoder.Encode(message)
+		if err != nil {
+			p.logger.Error("Failed to serialize thrift object", tag.Error(err))
+			return nil, err
+		}
+		return payload, nil
+	case *types.ReplicationTask:
+		// encoded the same way as the domain replication queue, so the remote clusters can read the tasks
+		payload, err := p.msgEncoder.Encode(thrift.FromReplicationTask(message))
+		if err != nil {
+			p.logger.Error("Failed to serialize thrift object", tag.Error(err))
+			return nil, err
+		}
+		return payload, nil
+	default:
+		return nil, errors.New("unknown producer message type")
+	}
+}
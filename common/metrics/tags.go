@@ -44,6 +44,7 @@ const (
 	kafkaPartition = "kafkaPartition"
 	transport      = "transport"
 	signalName     = "signalName"
+	configKey      = "configKey"
 
 	allValue     = "all"
 	unknownValue = "_unknown_"
@@ -164,3 +165,8 @@ func SignalNameTag(value string) Tag {
 func SignalNameAllTag() Tag {
 	return metricWithUnknown(signalName, allValue)
 }
+
+// DynamicConfigKeyTag returns a new dynamic config key tag
+func DynamicConfigKeyTag(value string) Tag {
+	return metricWithUnknown(configKey, value)
+}
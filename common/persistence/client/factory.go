@@ -62,6 +62,8 @@ type (
 		NewVisibilityManager(params *service.BootstrapParams, resourceConfig *rc.ResourceConfig) (p.VisibilityManager, error)
 		// NewDomainReplicationQueueManager returns a new queue for domain replication
 		NewDomainReplicationQueueManager() (p.QueueManager, error)
+		// NewQueueManager returns a new queue for the given queue type
+		NewQueueManager(queueType p.QueueType) (p.QueueManager, error)
 		// NewConfigStoreManager returns a new config store manager
 		NewConfigStoreManager() (p.ConfigStoreManager, error)
 	}
@@ -274,7 +276,22 @@ func (f *factoryImpl) NewVisibilityManager(
 	}
 	if params.PersistenceConfig.AdvancedVisibilityStore != "" {
 		visibilityIndexName := params.ESConfig.Indices[common.VisibilityAppName]
-		visibilityProducer, err := params.MessagingClient.NewProducer(common.VisibilityAppName)
+		var visibilityProducer messaging.Producer
+		if params.ESConfig.IsDirectIndexing() {
+			// only services writing visibility records need the producer
+			if resourceConfig.AdvancedVisibilityWritingMode != nil {
+				visibilityProducer, err = elasticsearch.NewESVisibilityProducer(
+					params.ESClient,
+					visibilityIndexName,
+					params.ESConfig.DirectIndexing,
+					resourceConfig.ValidSearchAttributes,
+					params.MetricsClient,
+					f.logger,
+				)
+			}
+		} else {
+			visibilityProducer, err = params.MessagingClient.NewProducer(common.VisibilityAppName)
+		}
 		if err != nil {
 			f.logger.Fatal("Creating visibility producer failed", tag.Error(err))
 		}
@@ -292,7 +309,7 @@ func (f *factoryImpl) NewVisibilityManager(
 }
 
 // NewESVisibilityManager create a visibility manager for ElasticSearch
-// In history, it only needs kafka producer for writing data;
+// In history, it only needs a producer for writing data, either to kafka or directly to ElasticSearch;
 // In frontend, it only needs ES client and related config for reading data
 func newESVisibilityManager(
 	indexName string,
@@ -360,8 +377,12 @@ func (f *factoryImpl) newDBVisibilityManager(
 }
 
 func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error) {
+	return f.NewQueueManager(p.DomainReplicationQueueType)
+}
+
+func (f *factoryImpl) NewQueueManager(queueType p.QueueType) (p.QueueManager, error) {
 	ds := f.datastores[storeTypeQueue]
-	store, err := ds.factory.NewQueue(p.DomainReplicationQueueType)
+	store, err := ds.factory.NewQueue(queueType)
 	if err != nil {
 		return nil, err
 	}
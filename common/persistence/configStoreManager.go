@@ -59,15 +59,31 @@ func (m *configStoreManagerImpl) FetchDynamicConfig(ctx context.Context) (*Fetch
 		return nil, err
 	}
 
-	config, err := m.serializer.DeserializeDynamicConfigBlob(values.Values)
+	snapshot, err := m.deserializeSnapshot(values)
+	if err != nil {
+		return nil, err
+	}
+	return &FetchDynamicConfigResponse{Snapshot: snapshot}, nil
+}
+
+func (m *configStoreManagerImpl) FetchDynamicConfigHistory(
+	ctx context.Context,
+	request *FetchDynamicConfigHistoryRequest,
+) (*FetchDynamicConfigHistoryResponse, error) {
+	entries, err := m.persistence.FetchConfigHistory(ctx, DynamicConfig, request.PageSize)
 	if err != nil {
 		return nil, err
 	}
 
-	return &FetchDynamicConfigResponse{Snapshot: &DynamicConfigSnapshot{
-		Version: values.Version,
-		Values:  config,
-	}}, nil
+	snapshots := make([]*DynamicConfigSnapshot, 0, len(entries))
+	for _, entry := range entries {
+		snapshot, err := m.deserializeSnapshot(entry)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return &FetchDynamicConfigHistoryResponse{Snapshots: snapshots}, nil
 }
 
 func (m *configStoreManagerImpl) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) error {
@@ -85,3 +101,15 @@ func (m *configStoreManagerImpl) UpdateDynamicConfig(ctx context.Context, reques
 
 	return m.persistence.UpdateConfig(ctx, entry)
 }
+
+func (m *configStoreManagerImpl) deserializeSnapshot(entry *InternalConfigStoreEntry) (*DynamicConfigSnapshot, error) {
+	config, err := m.serializer.DeserializeDynamicConfigBlob(entry.Values)
+	if err != nil {
+		return nil, err
+	}
+	return &DynamicConfigSnapshot{
+		Version:   entry.Version,
+		Timestamp: entry.Timestamp,
+		Values:    config,
+	}, nil
+}
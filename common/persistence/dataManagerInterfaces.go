@@ -64,6 +64,7 @@ type QueueType int
 // Negative numbers are reserved for DLQ
 const (
 	DomainReplicationQueueType QueueType = iota + 1
+	VisibilityQueueType
 )
 
 // Create Workflow Execution Mode
@@ -179,6 +180,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeDeleteWorkflowExecution
 )
 
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
@@ -516,6 +518,14 @@ type (
 		Version             int64
 	}
 
+	// DeleteWorkflowExecutionTask identifies a timer task for deletion of an execution requested by the
+	// DeleteWorkflowExecution API, it's processed after the close execution transfer task of the run.
+	DeleteWorkflowExecutionTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// DecisionTimeoutTask identifies a timeout task.
 	DecisionTimeoutTask struct {
 		VisibilityTimestamp time.Time
@@ -1648,14 +1658,25 @@ type (
 		Snapshot *DynamicConfigSnapshot
 	}
 
+	// FetchDynamicConfigHistoryRequest is a request to FetchDynamicConfigHistory
+	FetchDynamicConfigHistoryRequest struct {
+		PageSize int
+	}
+
+	// FetchDynamicConfigHistoryResponse is a response to FetchDynamicConfigHistory, the latest snapshot first
+	FetchDynamicConfigHistoryResponse struct {
+		Snapshots []*DynamicConfigSnapshot
+	}
+
 	// UpdateDynamicConfigRequest is a request to update dynamic config with snapshot
 	UpdateDynamicConfigRequest struct {
 		Snapshot *DynamicConfigSnapshot
 	}
 
 	DynamicConfigSnapshot struct {
-		Version int64
-		Values  *types.DynamicConfigBlob
+		Version   int64
+		Timestamp time.Time
+		Values    *types.DynamicConfigBlob
 	}
 
 	// Closeable is an interface for any entity that supports a close operation to release resources
@@ -1808,6 +1829,7 @@ type (
 	ConfigStoreManager interface {
 		Closeable
 		FetchDynamicConfig(ctx context.Context) (*FetchDynamicConfigResponse, error)
+		FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest) (*FetchDynamicConfigHistoryResponse, error)
 		UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) error
 		//can add functions for config types other than dynamic config
 	}
@@ -2061,6 +2083,41 @@ func (a *DeleteHistoryEventTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the delete workflow execution task
+func (a *DeleteWorkflowExecutionTask) GetType() int {
+	return TaskTypeDeleteWorkflowExecution
+}
+
+// GetVersion returns the version of the delete workflow execution task
+func (a *DeleteWorkflowExecutionTask) GetVersion() int64 {
+	return a.Version
+}
+
+// SetVersion returns the version of the delete workflow execution task
+func (a *DeleteWorkflowExecutionTask) SetVersion(version int64) {
+	a.Version = version
+}
+
+// GetTaskID returns the sequence ID of the delete workflow execution task
+func (a *DeleteWorkflowExecutionTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+// SetTaskID sets the sequence ID of the delete workflow execution task
+func (a *DeleteWorkflowExecutionTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (a *DeleteWorkflowExecutionTask) GetVisibilityTimestamp() time.Time {
+	return a.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (a *DeleteWorkflowExecutionTask) SetVisibilityTimestamp(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the timer task
 func (d *DecisionTimeoutTask) GetType() int {
 	return TaskTypeDecisionTimeout
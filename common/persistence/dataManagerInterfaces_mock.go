@@ -1560,6 +1560,21 @@ func (mr *MockConfigStoreManagerMockRecorder) FetchDynamicConfig(ctx interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDynamicConfig", reflect.TypeOf((*MockConfigStoreManager)(nil).FetchDynamicConfig), ctx)
 }
 
+// FetchDynamicConfigHistory mocks base method
+func (m *MockConfigStoreManager) FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest) (*FetchDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDynamicConfigHistory", ctx, request)
+	ret0, _ := ret[0].(*FetchDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDynamicConfigHistory indicates an expected call of FetchDynamicConfigHistory
+func (mr *MockConfigStoreManagerMockRecorder) FetchDynamicConfigHistory(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDynamicConfigHistory", reflect.TypeOf((*MockConfigStoreManager)(nil).FetchDynamicConfigHistory), ctx, request)
+}
+
 // UpdateDynamicConfig mocks base method
 func (m *MockConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) error {
 	m.ctrl.T.Helper()
@@ -182,6 +182,7 @@ type (
 	ConfigStore interface {
 		Closeable
 		FetchConfig(ctx context.Context, configType ConfigType) (*InternalConfigStoreEntry, error)
+		FetchConfigHistory(ctx context.Context, configType ConfigType, maxCount int) ([]*InternalConfigStoreEntry, error)
 		UpdateConfig(ctx context.Context, value *InternalConfigStoreEntry) error
 	}
 
@@ -0,0 +1,354 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	esDocIDDelimiter    = "~"
+	esDocType           = "_doc"
+	esDocIDSizeLimit    = 512
+	versionTypeExternal = "external"
+
+	esProducerProcessorName            = "es-visibility-producer"
+	esProducerInitialRetryInterval     = 200 * time.Millisecond
+	esProducerMaxRetryInterval         = 5 * time.Second
+	defaultDirectIndexingNumOfWorkers  = 1
+	defaultDirectIndexingBulkActions   = 1000
+	defaultDirectIndexingBulkSize      = 16 * 1024 * 1024
+	defaultDirectIndexingFlushInterval = 100 * time.Millisecond
+)
+
+type (
+	// esVisibilityProducer writes visibility messages to ElasticSearch with a bulk processor,
+	// instead of publishing them to Kafka for the worker indexer.
+	// Publish blocks until ElasticSearch acknowledges the document, so a failed write fails the transfer task.
+	esVisibilityProducer struct {
+		index                 string
+		processor             es.GenericBulkProcessor
+		validSearchAttributes dynamicconfig.MapPropertyFn
+		logger                log.Logger
+		metricsClient         metrics.Client
+
+		sequence int64
+
+		sync.Mutex
+		waiters map[string][]chan error
+	}
+)
+
+var _ messaging.CloseableProducer = (*esVisibilityProducer)(nil)
+
+var errUnknownVisibilityMessage = errors.New("unknown visibility message")
+
+// NewESVisibilityProducer creates a producer which writes visibility messages to ElasticSearch directly
+func NewESVisibilityProducer(
+	esClient es.GenericClient,
+	index string,
+	cfg config.DirectIndexingConfig,
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) (messaging.CloseableProducer, error) {
+	producer := &esVisibilityProducer{
+		index:                 index,
+		validSearchAttributes: validSearchAttributes,
+		logger:                logger.WithTags(tag.ComponentESVisibilityManager),
+		metricsClient:         metricsClient,
+		waiters:               make(map[string][]chan error),
+	}
+
+	params := &es.BulkProcessorParameters{
+		Name:          esProducerProcessorName,
+		NumOfWorkers:  cfg.NumOfWorkers,
+		BulkActions:   cfg.BulkActions,
+		BulkSize:      cfg.BulkSize,
+		FlushInterval: cfg.FlushInterval,
+		Backoff:       es.NewExponentialBackoff(esProducerInitialRetryInterval, esProducerMaxRetryInterval),
+		BeforeFunc:    producer.bulkBeforeAction,
+		AfterFunc:     producer.bulkAfterAction,
+	}
+	if params.NumOfWorkers <= 0 {
+		params.NumOfWorkers = defaultDirectIndexingNumOfWorkers
+	}
+	if params.BulkActions <= 0 {
+		params.BulkActions = defaultDirectIndexingBulkActions
+	}
+	if params.BulkSize <= 0 {
+		params.BulkSize = defaultDirectIndexingBulkSize
+	}
+	if params.FlushInterval <= 0 {
+		params.FlushInterval = defaultDirectIndexingFlushInterval
+	}
+
+	processor, err := esClient.RunBulkProcessor(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	producer.processor = processor
+	return producer, nil
+}
+
+// Publish writes the visibility message to ElasticSearch and waits for the result
+func (p *esVisibilityProducer) Publish(ctx context.Context, message interface{}) error {
+	msg, ok := message.(*indexer.Message)
+	if !ok {
+		return errUnknownVisibilityMessage
+	}
+
+	docID := msg.GetWorkflowID() + esDocIDDelimiter + msg.GetRunID()
+	// check and skip invalid docID, same as the indexer does
+	if len(docID) >= esDocIDSizeLimit {
+		p.logger.Error("Index message is too long",
+			tag.WorkflowDomainID(msg.GetDomainID()),
+			tag.WorkflowID(msg.GetWorkflowID()),
+			tag.WorkflowRunID(msg.GetRunID()))
+		p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+		return nil
+	}
+
+	var key string
+	req := &es.GenericBulkableAddRequest{
+		Index:       p.index,
+		Type:        esDocType,
+		ID:          docID,
+		VersionType: versionTypeExternal,
+		Version:     msg.GetVersion(),
+	}
+	switch msg.GetMessageType() {
+	case indexer.MessageTypeIndex:
+		key = strconv.FormatInt(atomic.AddInt64(&p.sequence, 1), 10)
+		req.Doc = p.generateESDoc(msg, key)
+		req.IsDelete = false
+	case indexer.MessageTypeDelete:
+		key = docID
+		req.IsDelete = true
+	default:
+		return errUnknownVisibilityMessage
+	}
+
+	resultCh := make(chan error, 1)
+	p.addWaiter(key, resultCh)
+	p.processor.Add(req)
+
+	select {
+	case err := <-resultCh:
+		return err
+	case <-ctx.Done():
+		p.removeWaiter(key, resultCh)
+		return ctx.Err()
+	}
+}
+
+// Close flushes pending requests and stops the bulk processor
+func (p *esVisibilityProducer) Close() error {
+	return p.processor.Stop()
+}
+
+func (p *esVisibilityProducer) bulkBeforeAction(executionID int64, requests []es.GenericBulkableRequest) {
+	p.metricsClient.AddCounter(metrics.ESProcessorScope, metrics.ESProcessorRequests, int64(len(requests)))
+}
+
+func (p *esVisibilityProducer) bulkAfterAction(id int64, requests []es.GenericBulkableRequest, response *es.GenericBulkResponse, err *es.GenericError) {
+	if err != nil {
+		// the whole bulk failed after the configured retries, fail the callers so their tasks get retried
+		p.logger.Error("Error commit bulk request.", tag.Error(err.Details))
+		for _, request := range requests {
+			p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorFailures)
+			key := p.processor.RetrieveKafkaKey(request, p.logger, p.metricsClient)
+			if key == "" {
+				continue
+			}
+			p.complete(key, &types.InternalServiceError{
+				Message: fmt.Sprintf("failed to write visibility record to ElasticSearch: %v", err.Details),
+			})
+		}
+		return
+	}
+
+	responseItems := response.Items
+	for i := 0; i < len(requests) && i < len(responseItems); i++ {
+		key := p.processor.RetrieveKafkaKey(requests[i], p.logger, p.metricsClient)
+		if key == "" {
+			continue
+		}
+		for _, resp := range responseItems[i] {
+			switch {
+			case isResponseSuccess(resp.Status):
+				p.complete(key, nil)
+			case !isResponseRetriable(resp.Status):
+				// the indexer sends these to the DLQ, there is no point in retrying them from history
+				p.logger.Error("ES request failed.",
+					tag.ESResponseStatus(resp.Status),
+					tag.ESResponseError(getErrorMsgFromESResp(resp)),
+					tag.ESRequest(requests[i].String()))
+				p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorFailures)
+				p.complete(key, nil)
+			default: // bulk processor will retry
+				p.logger.Info("ES request retried.", tag.ESResponseStatus(resp.Status))
+				p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorRetries)
+			}
+		}
+	}
+}
+
+func (p *esVisibilityProducer) addWaiter(key string, ch chan error) {
+	p.Lock()
+	defer p.Unlock()
+	p.waiters[key] = append(p.waiters[key], ch)
+}
+
+func (p *esVisibilityProducer) removeWaiter(key string, ch chan error) {
+	p.Lock()
+	defer p.Unlock()
+	waiters := p.waiters[key]
+	for i, waiter := range waiters {
+		if waiter == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(p.waiters, key)
+	} else {
+		p.waiters[key] = waiters
+	}
+}
+
+func (p *esVisibilityProducer) complete(key string, err error) {
+	p.Lock()
+	waiters := p.waiters[key]
+	delete(p.waiters, key)
+	p.Unlock()
+
+	for _, ch := range waiters {
+		ch <- err
+	}
+}
+
+func (p *esVisibilityProducer) generateESDoc(msg *indexer.Message, key string) map[string]interface{} {
+	doc := p.dumpFieldsToMap(msg.Fields)
+	doc[definition.DomainID] = msg.GetDomainID()
+	doc[definition.WorkflowID] = msg.GetWorkflowID()
+	doc[definition.RunID] = msg.GetRunID()
+	doc[definition.KafkaKey] = key
+	return doc
+}
+
+func (p *esVisibilityProducer) dumpFieldsToMap(fields map[string]*indexer.Field) map[string]interface{} {
+	doc := make(map[string]interface{})
+	attr := make(map[string]interface{})
+	for k, v := range fields {
+		if !p.isValidFieldToES(k) {
+			p.logger.Error("Unregistered field.", tag.ESField(k))
+			p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+			continue
+		}
+
+		switch v.GetType() {
+		case indexer.FieldTypeString:
+			doc[k] = v.GetStringData()
+		case indexer.FieldTypeInt:
+			doc[k] = v.GetIntData()
+		case indexer.FieldTypeBool:
+			doc[k] = v.GetBoolData()
+		case indexer.FieldTypeBinary:
+			if k == definition.Memo {
+				doc[k] = v.GetBinaryData()
+			} else { // custom search attributes
+				attr[k] = p.decodeSearchAttrBinary(v.GetBinaryData(), k)
+			}
+		default:
+			p.logger.Error("Unknown field type", tag.ESField(k))
+			p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+		}
+	}
+	doc[definition.Attr] = attr
+	return doc
+}
+
+func (p *esVisibilityProducer) decodeSearchAttrBinary(bytes []byte, key string) interface{} {
+	var val interface{}
+	err := json.Unmarshal(bytes, &val)
+	if err != nil {
+		p.logger.Error("Error when decode search attributes values.", tag.Error(err), tag.ESField(key))
+		p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorCorruptedData)
+	}
+	return val
+}
+
+func (p *esVisibilityProducer) isValidFieldToES(field string) bool {
+	if field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding {
+		return true
+	}
+	if p.validSearchAttributes == nil {
+		return true
+	}
+	_, ok := p.validSearchAttributes()[field]
+	return ok
+}
+
+// 409 - Version Conflict
+// 404 - Not Found
+func isResponseSuccess(status int) bool {
+	return status >= 200 && status < 300 || status == 409 || status == 404
+}
+
+// responses with these status will be kept in the bulk processor queue and retried
+// 408 - Request Timeout
+// 429 - Too Many Requests
+// 500 - Node not connected
+// 503 - Service Unavailable
+// 507 - Insufficient Storage
+var retryableStatusCode = map[int]struct{}{408: {}, 429: {}, 500: {}, 503: {}, 507: {}}
+
+func isResponseRetriable(status int) bool {
+	_, ok := retryableStatusCode[status]
+	return ok
+}
+
+func getErrorMsgFromESResp(resp *es.GenericBulkResponseItem) string {
+	var errMsg string
+	if resp.Error != nil {
+		errMsg = fmt.Sprintf("%v", resp.Error)
+	}
+	return errMsg
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	es "github.com/uber/cadence/common/elasticsearch"
+	esMocks "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type ESVisibilityProducerSuite struct {
+	suite.Suite
+	*require.Assertions
+	producer      *esVisibilityProducer
+	mockESClient  *esMocks.GenericClient
+	mockProcessor *esMocks.GenericBulkProcessor
+	params        *es.BulkProcessorParameters
+}
+
+func TestESVisibilityProducerSuite(t *testing.T) {
+	suite.Run(t, new(ESVisibilityProducerSuite))
+}
+
+func (s *ESVisibilityProducerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.mockESClient = &esMocks.GenericClient{}
+	s.mockProcessor = &esMocks.GenericBulkProcessor{}
+	s.mockESClient.On("RunBulkProcessor", mock.Anything, mock.AnythingOfType("*elasticsearch.BulkProcessorParameters")).
+		Run(func(args mock.Arguments) {
+			s.params = args.Get(1).(*es.BulkProcessorParameters)
+		}).
+		Return(s.mockProcessor, nil).Once()
+
+	producer, err := NewESVisibilityProducer(
+		s.mockESClient,
+		testIndex,
+		config.DirectIndexingConfig{BulkActions: 10},
+		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewNopLogger(),
+	)
+	s.NoError(err)
+	s.producer = producer.(*esVisibilityProducer)
+}
+
+func (s *ESVisibilityProducerSuite) TearDownTest() {
+	s.mockESClient.AssertExpectations(s.T())
+	s.mockProcessor.AssertExpectations(s.T())
+}
+
+func (s *ESVisibilityProducerSuite) TestNewESVisibilityProducer_Defaults() {
+	s.Equal(esProducerProcessorName, s.params.Name)
+	s.Equal(defaultDirectIndexingNumOfWorkers, s.params.NumOfWorkers)
+	s.Equal(10, s.params.BulkActions)
+	s.Equal(defaultDirectIndexingBulkSize, s.params.BulkSize)
+	s.Equal(defaultDirectIndexingFlushInterval, s.params.FlushInterval)
+	s.NotNil(s.params.AfterFunc)
+}
+
+func (s *ESVisibilityProducerSuite) TestPublish_Index() {
+	msg := s.getIndexMessage()
+	msg.Fields["unknownField"] = &indexer.Field{Type: indexer.FieldTypeString.Ptr(), StringData: common.StringPtr("value")}
+
+	s.mockProcessor.On("Add", mock.AnythingOfType("*elasticsearch.GenericBulkableAddRequest")).
+		Run(func(args mock.Arguments) {
+			req := args.Get(0).(*es.GenericBulkableAddRequest)
+			s.Equal(testIndex, req.Index)
+			s.Equal(esDocType, req.Type)
+			s.Equal(testWorkflowID+esDocIDDelimiter+testRunID, req.ID)
+			s.Equal(versionTypeExternal, req.VersionType)
+			s.Equal(int64(123), req.Version)
+			s.False(req.IsDelete)
+
+			doc := req.Doc.(map[string]interface{})
+			s.Equal(testDomainID, doc[definition.DomainID])
+			s.Equal(testWorkflowID, doc[definition.WorkflowID])
+			s.Equal(testRunID, doc[definition.RunID])
+			s.Equal(testWorkflowType, doc[definition.WorkflowType])
+			s.Equal(map[string]interface{}{"CustomKeywordField": "keyword"}, doc[definition.Attr])
+			s.NotContains(doc, "unknownField")
+			s.Equal("1", doc[definition.KafkaKey])
+
+			s.respond(req, 201)
+		}).Once()
+	s.mockProcessor.On("RetrieveKafkaKey", mock.Anything, mock.Anything, mock.Anything).Return("1").Once()
+
+	s.NoError(s.producer.Publish(context.Background(), msg))
+	s.Empty(s.producer.waiters)
+}
+
+func (s *ESVisibilityProducerSuite) TestPublish_Delete_NonRetriableFailure() {
+	docID := testWorkflowID + esDocIDDelimiter + testRunID
+	msg := &indexer.Message{
+		MessageType: indexer.MessageTypeDelete.Ptr(),
+		DomainID:    common.StringPtr(testDomainID),
+		WorkflowID:  common.StringPtr(testWorkflowID),
+		RunID:       common.StringPtr(testRunID),
+		Version:     common.Int64Ptr(123),
+	}
+
+	s.mockProcessor.On("Add", mock.AnythingOfType("*elasticsearch.GenericBulkableAddRequest")).
+		Run(func(args mock.Arguments) {
+			req := args.Get(0).(*es.GenericBulkableAddRequest)
+			s.True(req.IsDelete)
+			s.Nil(req.Doc)
+			s.respond(req, 400)
+		}).Once()
+	s.mockProcessor.On("RetrieveKafkaKey", mock.Anything, mock.Anything, mock.Anything).Return(docID).Once()
+
+	// the record is dropped like the indexer does, instead of blocking the transfer task
+	s.NoError(s.producer.Publish(context.Background(), msg))
+	s.Empty(s.producer.waiters)
+}
+
+func (s *ESVisibilityProducerSuite) TestPublish_BulkError() {
+	s.mockProcessor.On("Add", mock.AnythingOfType("*elasticsearch.GenericBulkableAddRequest")).
+		Run(func(args mock.Arguments) {
+			go s.params.AfterFunc(0, []es.GenericBulkableRequest{&esMocks.GenericBulkableRequest{}}, nil,
+				&es.GenericError{Status: 503, Details: errors.New("cluster unavailable")})
+		}).Once()
+	s.mockProcessor.On("RetrieveKafkaKey", mock.Anything, mock.Anything, mock.Anything).Return("1").Once()
+
+	s.Error(s.producer.Publish(context.Background(), s.getIndexMessage()))
+	s.Empty(s.producer.waiters)
+}
+
+func (s *ESVisibilityProducerSuite) TestPublish_Timeout() {
+	s.mockProcessor.On("Add", mock.AnythingOfType("*elasticsearch.GenericBulkableAddRequest")).
+		Run(func(args mock.Arguments) {
+			// retriable status keeps the request pending in the bulk processor
+			s.respond(args.Get(0).(*es.GenericBulkableAddRequest), 429)
+		}).Once()
+	s.mockProcessor.On("RetrieveKafkaKey", mock.Anything, mock.Anything, mock.Anything).Return("1").Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	s.Equal(context.DeadlineExceeded, s.producer.Publish(ctx, s.getIndexMessage()))
+	s.Empty(s.producer.waiters)
+}
+
+func (s *ESVisibilityProducerSuite) TestPublish_InvalidMessage() {
+	s.Equal(errUnknownVisibilityMessage, s.producer.Publish(context.Background(), "not a message"))
+
+	msg := s.getIndexMessage()
+	msg.MessageType = indexer.MessageType(-1).Ptr()
+	s.Equal(errUnknownVisibilityMessage, s.producer.Publish(context.Background(), msg))
+
+	msg = s.getIndexMessage()
+	msg.WorkflowID = common.StringPtr(string(make([]byte, esDocIDSizeLimit)))
+	s.NoError(s.producer.Publish(context.Background(), msg))
+}
+
+func (s *ESVisibilityProducerSuite) TestClose() {
+	s.mockProcessor.On("Stop").Return(nil).Once()
+	s.NoError(s.producer.Close())
+}
+
+func (s *ESVisibilityProducerSuite) respond(req *es.GenericBulkableAddRequest, status int) {
+	response := &es.GenericBulkResponse{
+		Items: []map[string]*es.GenericBulkResponseItem{
+			{"index": {Index: req.Index, ID: req.ID, Status: status}},
+		},
+	}
+	go s.params.AfterFunc(0, []es.GenericBulkableRequest{&esMocks.GenericBulkableRequest{}}, response, nil)
+}
+
+func (s *ESVisibilityProducerSuite) getIndexMessage() *indexer.Message {
+	return &indexer.Message{
+		MessageType: indexer.MessageTypeIndex.Ptr(),
+		DomainID:    common.StringPtr(testDomainID),
+		WorkflowID:  common.StringPtr(testWorkflowID),
+		RunID:       common.StringPtr(testRunID),
+		Version:     common.Int64Ptr(123),
+		Fields: map[string]*indexer.Field{
+			definition.WorkflowType: {Type: indexer.FieldTypeString.Ptr(), StringData: common.StringPtr(testWorkflowType)},
+			"CustomKeywordField":    {Type: indexer.FieldTypeBinary.Ptr(), BinaryData: []byte(`"keyword"`)},
+		},
+	}
+}
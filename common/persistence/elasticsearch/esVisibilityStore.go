@@ -81,7 +81,13 @@ func NewElasticSearchVisibilityStore(
 	}
 }
 
-func (v *esVisibilityStore) Close() {}
+func (v *esVisibilityStore) Close() {
+	if closeable, ok := v.producer.(messaging.CloseableProducer); ok {
+		if err := closeable.Close(); err != nil {
+			v.logger.Error("Failed to close visibility producer", tag.Error(err))
+		}
+	}
+}
 
 func (v *esVisibilityStore) GetName() string {
 	return esPersistenceName
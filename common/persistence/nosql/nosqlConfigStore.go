@@ -62,6 +62,14 @@ func (m *nosqlConfigStore) FetchConfig(ctx context.Context, configType p.ConfigT
 	return entry, nil
 }
 
+func (m *nosqlConfigStore) FetchConfigHistory(ctx context.Context, configType p.ConfigType, maxCount int) ([]*p.InternalConfigStoreEntry, error) {
+	entries, err := m.db.SelectConfigHistory(ctx, int(configType), maxCount)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "FetchConfigHistory", err)
+	}
+	return entries, nil
+}
+
 func (m *nosqlConfigStore) UpdateConfig(ctx context.Context, value *p.InternalConfigStoreEntry) error {
 	err := m.db.InsertConfig(ctx, value)
 	if err != nil {
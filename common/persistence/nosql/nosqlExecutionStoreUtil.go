@@ -227,6 +227,9 @@ func (d *nosqlExecutionStore) prepareTimerTasksForWorkflowTxn(
 		case *p.DeleteHistoryEventTask:
 			// noop
 
+		case *p.DeleteWorkflowExecutionTask:
+			// noop
+
 		default:
 			return nil, &types.InternalServiceError{
 				Message: fmt.Sprintf("Unknow timer type: %v", task.GetType()),
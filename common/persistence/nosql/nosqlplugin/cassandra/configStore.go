@@ -22,6 +22,7 @@ package cassandra
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -34,6 +35,8 @@ import (
 const (
 	templateSelectLatestConfig = `SELECT row_type, version, timestamp, values, encoding FROM cluster_config WHERE row_type = ? LIMIT 1;`
 
+	templateSelectConfigHistory = `SELECT row_type, version, timestamp, values, encoding FROM cluster_config WHERE row_type = ? LIMIT ?;`
+
 	templateInsertConfig = `INSERT INTO cluster_config (row_type, version, timestamp, values, encoding) VALUES (?, ?, ?, ?, ?) IF NOT EXISTS;`
 	//for version value, x + 1 where x is the cached copy version.
 )
@@ -75,3 +78,34 @@ func (db *cdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 		},
 	}, err
 }
+
+func (db *cdb) SelectConfigHistory(ctx context.Context, rowType int, maxCount int) ([]*persistence.InternalConfigStoreEntry, error) {
+	var version int64
+	var timestamp time.Time
+	var data []byte
+	var encoding common.EncodingType
+
+	query := db.session.Query(templateSelectConfigHistory, rowType, maxCount).WithContext(ctx)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, fmt.Errorf("SelectConfigHistory operation failed. Not able to create query iterator")
+	}
+
+	var entries []*persistence.InternalConfigStoreEntry
+	for iter.Scan(&rowType, &version, &timestamp, &data, &encoding) {
+		entries = append(entries, &persistence.InternalConfigStoreEntry{
+			RowType:   rowType,
+			Version:   version,
+			Timestamp: timestamp,
+			Values: &persistence.DataBlob{
+				Data:     data,
+				Encoding: encoding,
+			},
+		})
+		data = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -22,15 +22,103 @@
 package dynamodb
 
 import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
+// SetupTestDatabase creates all the tables from scratch, the tables are defined by tableSchemas
+// instead of the schema files, so schemaBaseDir is ignored
 func (db *ddb) SetupTestDatabase(schemaBaseDir string) error {
-	panic("TODO")
+	ctx := context.Background()
+	if err := db.TeardownTestDatabase(); err != nil {
+		return err
+	}
+	for _, schema := range tableSchemas {
+		if err := db.createTable(ctx, schema); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// TeardownTestDatabase deletes all the tables
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	ctx := context.Background()
+	for _, schema := range tableSchemas {
+		if err := db.deleteTable(ctx, schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *ddb) createTable(ctx context.Context, schema tableSchema) error {
+	tableName := aws.String(db.tableName(schema.name))
+	input := &dynamodb.CreateTableInput{
+		TableName:   tableName,
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: aws.String(attrPK), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			{AttributeName: aws.String(attrSK), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String(attrPK), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			{AttributeName: aws.String(attrSK), KeyType: aws.String(dynamodb.KeyTypeRange)},
+		},
+	}
+	for _, attribute := range schema.localIndexes {
+		input.AttributeDefinitions = append(input.AttributeDefinitions, &dynamodb.AttributeDefinition{
+			AttributeName: aws.String(attribute),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		})
+		input.LocalSecondaryIndexes = append(input.LocalSecondaryIndexes, &dynamodb.LocalSecondaryIndex{
+			IndexName: aws.String(indexName(attribute)),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(attrPK), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String(attribute), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+			Projection: &dynamodb.Projection{ProjectionType: aws.String(dynamodb.ProjectionTypeAll)},
+		})
+	}
+
+	if _, err := db.client.CreateTableWithContext(ctx, input); err != nil {
+		return err
+	}
+	if err := db.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: tableName}); err != nil {
+		return err
+	}
+	if schema.ttl {
+		_, err := db.client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: tableName,
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: aws.String(attrTTL),
+				Enabled:       aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	db.logger.Info("created table", tag.Value(*tableName))
+	return nil
+}
+
+func (db *ddb) deleteTable(ctx context.Context, schema tableSchema) error {
+	tableName := aws.String(db.tableName(schema.name))
+	_, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: tableName})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeResourceNotFoundException {
+			return nil
+		}
+		return err
+	}
+	return db.client.WaitUntilTableNotExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: tableName})
 }
@@ -22,15 +22,63 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	data, err := jsonAttr(row)
+	if err != nil {
+		return err
+	}
+	it := primaryKey(configRowTypeKey(row.RowType), encodeInt64(row.Version))
+	it[attrData] = data
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(db.tableName(tableClusterConfig)),
+		Item:                it,
+		ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	query := db.newPartitionQuery(tableClusterConfig, configRowTypeKey(rowType))
+	query.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, query, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	entry := &persistence.InternalConfigStoreEntry{}
+	if err := getJSON(items[0], attrData, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (db *ddb) SelectConfigHistory(ctx context.Context, rowType int, maxCount int) ([]*persistence.InternalConfigStoreEntry, error) {
+	query := db.newPartitionQuery(tableClusterConfig, configRowTypeKey(rowType))
+	query.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, query, maxCount, nil)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*persistence.InternalConfigStoreEntry, 0, len(items))
+	for _, item := range items {
+		entry := &persistence.InternalConfigStoreEntry{}
+		if err := getJSON(item, attrData, entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
 }
@@ -21,9 +21,15 @@
 package dynamodb
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -38,20 +44,46 @@ var (
 	errConditionFailed = errors.New("internal condition fail error")
 )
 
-// ddb represents a logical connection to DynamoDB database
-type ddb struct {
-	logger log.Logger
-}
+type (
+	// ddb represents a logical connection to DynamoDB database
+	ddb struct {
+		logger log.Logger
+		client dynamodbiface.DynamoDBAPI
+		cfg    *config.NoSQL
+	}
+
+	// itemNotFoundError is returned when a single item read doesn't find the item
+	itemNotFoundError struct {
+		table string
+	}
+)
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return newDynamoDB(&cfg, logger)
+}
+
+func newDynamoDB(cfg *config.NoSQL, logger log.Logger) (*ddb, error) {
+	client, err := newDynamoDBClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newDynamoDBFromClient(cfg, client, logger), nil
+}
+
+// newDynamoDBFromClient returns a DB from a client
+func newDynamoDBFromClient(cfg *config.NoSQL, client dynamodbiface.DynamoDBAPI, logger log.Logger) *ddb {
+	return &ddb{
+		logger: logger,
+		client: client,
+		cfg:    cfg,
+	}
 }
 
 func (db *ddb) Close() {
-	panic("TODO")
+	// the AWS client is stateless, there is no connection to close
 }
 
 func (db *ddb) PluginName() string {
@@ -59,20 +91,60 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	_, ok := err.(*itemNotFoundError)
+	return ok
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case request.CanceledErrorCode, request.ErrCodeResponseTimeout, "RequestTimeout", "RequestTimeoutException":
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeProvisionedThroughputExceededException,
+			dynamodb.ErrCodeRequestLimitExceeded,
+			"ThrottlingException":
+			return true
+		}
+	}
+	if txErr, ok := err.(*dynamodb.TransactionCanceledException); ok {
+		for _, reason := range txErr.CancellationReasons {
+			if reason.Code == nil {
+				continue
+			}
+			switch *reason.Code {
+			case "ThrottlingError", "ProvisionedThroughputExceeded":
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsConditionFailedError(err error) bool {
 	if err == errConditionFailed {
 		return true
 	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+	}
 	return false
 }
+
+func newItemNotFoundError(table string) error {
+	return &itemNotFoundError{table: table}
+}
+
+func (e *itemNotFoundError) Error() string {
+	return fmt.Sprintf("item not found in table %v", e.table)
+}
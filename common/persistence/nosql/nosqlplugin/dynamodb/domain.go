@@ -22,9 +22,16 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
-	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"github.com/uber/cadence/common/types"
 )
 
 // Insert a new record to domain, return error if failed or already exists
@@ -33,15 +40,79 @@ func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	newRow := *row
+	newRow.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	newRow.PreviousFailoverVersion = common.InitialPreviousFailoverVersion
+	newRow.NotificationVersion = metadataNotificationVersion
+	byNameItem, err := newDomainItem(&newRow)
+	if err != nil {
+		return err
+	}
+	byIDItem := primaryKey(domainPartitionKey, domainByIDPrefix+row.Info.ID)
+	byIDItem[attrName] = stringAttr(row.Info.Name)
+
+	failures, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName:           aws.String(db.tableName(tableDomains)),
+				Item:                byIDItem,
+				ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+			},
+		},
+		{
+			Put: &dynamodb.Put{
+				TableName:           aws.String(db.tableName(tableDomains)),
+				Item:                byNameItem,
+				ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+			},
+		},
+		db.updateMetadata(metadataNotificationVersion),
+	})
+	if err != nil {
+		return err
+	}
+	if _, failed := failures[0]; failed {
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	}
+	if _, failed := failures[1]; failed {
+		db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	}
+	if len(failures) > 0 {
+		db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
 }
 
-func (db *ddb) updateMetadataBatch(
-	ctx context.Context,
-	batch gocql.Batch,
+// updateMetadata returns an update of the domain metadata record, which bumps the notification version
+// conditioned on the current one
+func (db *ddb) updateMetadata(
 	notificationVersion int64,
-) {
-	panic("TODO")
+) *dynamodb.TransactWriteItem {
+	b := newExpressionBuilder()
+	b.set(b.name(attrNotificationVersion), b.value(numberAttr(notificationVersion+1)))
+	condition := "attribute_not_exists(" + b.name(attrNotificationVersion) + ")"
+	if notificationVersion > 0 {
+		condition = b.name(attrNotificationVersion) + " = " + b.value(numberAttr(notificationVersion))
+	}
+	return &dynamodb.TransactWriteItem{
+		Update: &dynamodb.Update{
+			TableName:                 aws.String(db.tableName(tableDomains)),
+			Key:                       primaryKey(domainPartitionKey, domainMetadataKey),
+			UpdateExpression:          b.updateExpression(),
+			ConditionExpression:       aws.String(condition),
+			ExpressionAttributeNames:  b.attributeNames(),
+			ExpressionAttributeValues: b.attributeValues(),
+		},
+	}
 }
 
 // Update domain
@@ -49,7 +120,26 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	it, err := newDomainItem(row)
+	if err != nil {
+		return err
+	}
+	failures, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableDomains)),
+				Item:      it,
+			},
+		},
+		db.updateMetadata(row.NotificationVersion),
+	})
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
 }
 
 // Get one domain data, either by domainID or domainName
@@ -58,7 +148,25 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	if domainID != nil {
+		name, err := db.selectDomainName(ctx, *domainID)
+		if err != nil {
+			return nil, err
+		}
+		domainName = &name
+	}
+
+	it, err := db.getItem(ctx, tableDomains, primaryKey(domainPartitionKey, domainByNamePrefix+*domainName))
+	if err != nil {
+		return nil, err
+	}
+	return parseDomainRow(it)
 }
 
 // Get all domain data
@@ -67,20 +175,98 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	// the metadata record and the domain ID records are excluded by the key range
+	query := db.newRangeQuery(tableDomains, domainPartitionKey, domainByNamePrefix, prefixUpperBound(domainByNamePrefix))
+	items, nextPageToken, err := db.queryPage(ctx, query, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.DomainRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseDomainRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
-//  Delete a domain, either by domainID or domainName
+// Delete a domain, either by domainID or domainName
 func (db *ddb) DeleteDomain(
 	ctx context.Context,
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	if domainName == nil {
+		name, err := db.selectDomainName(ctx, *domainID)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainName = common.StringPtr(name)
+	} else {
+		row, err := db.SelectDomain(ctx, nil, domainName)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainID = common.StringPtr(row.Info.ID)
+	}
+
+	if err := db.deleteItem(ctx, tableDomains, primaryKey(domainPartitionKey, domainByNamePrefix+*domainName)); err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, tableDomains, primaryKey(domainPartitionKey, domainByIDPrefix+*domainID))
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableDomains, primaryKey(domainPartitionKey, domainMetadataKey))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata record is created along with the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return getInt64(it, attrNotificationVersion)
+}
+
+func (db *ddb) selectDomainName(ctx context.Context, domainID string) (string, error) {
+	it, err := db.getItem(ctx, tableDomains, primaryKey(domainPartitionKey, domainByIDPrefix+domainID))
+	if err != nil {
+		return "", err
+	}
+	return getString(it, attrName), nil
+}
+
+func newDomainItem(row *nosqlplugin.DomainRow) (item, error) {
+	data, err := jsonAttr(row)
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(domainPartitionKey, domainByNamePrefix+row.Info.Name)
+	it[attrData] = data
+	return it, nil
+}
+
+func parseDomainRow(it item) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := getJSON(it, attrData, row); err != nil {
+		return nil, err
+	}
+	if row.Config != nil && row.Config.BadBinaries == nil {
+		row.Config.BadBinaries = persistence.NewDataBlob(nil, common.EncodingTypeEmpty)
+	}
+	return row, nil
 }
@@ -22,31 +22,209 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var items []*dynamodb.TransactWriteItem
+	if treeRow != nil {
+		it, err := newHistoryTreeItem(treeRow)
+		if err != nil {
+			return err
+		}
+		items = append(items, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableHistoryTree)),
+				Item:      it,
+			},
+		})
+	}
+	if nodeRow != nil {
+		it := primaryKey(nodeRow.TreeID, historyNodeKey(nodeRow.BranchID, nodeRow.NodeID, *nodeRow.TxnID))
+		it[attrData] = binaryAttr(nodeRow.Data)
+		it[attrEncoding] = stringAttr(nodeRow.DataEncoding)
+		items = append(items, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableHistoryNode)),
+				Item:      it,
+			},
+		})
+	}
+
+	if len(items) == 1 {
+		// a single item write doesn't need a transaction
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			TableName: items[0].Put.TableName,
+			Item:      items[0].Put.Item,
+		})
+		return err
+	}
+	_, err := db.transactWrite(ctx, items)
+	return err
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	// a node key is the branchID and the encoded nodeID followed by the txnID, so the key of MaxNodeID itself
+	// is greater than all the keys before it and less than all the keys at or after it
+	query := db.newRangeQuery(
+		tableHistoryNode,
+		filter.TreeID,
+		joinKey(filter.BranchID, encodeInt64(filter.MinNodeID)),
+		joinKey(filter.BranchID, encodeInt64(filter.MaxNodeID)),
+	)
+	items, nextPageToken, err := db.queryPage(ctx, query, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseHistoryNodeRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// delete the nodes first, so that a failure can be retried from the branch record
+	for _, nodeFilter := range nodeFilters {
+		query := db.newRangeQuery(
+			tableHistoryNode,
+			nodeFilter.TreeID,
+			joinKey(nodeFilter.BranchID, encodeInt64(nodeFilter.MinNodeID)),
+			prefixUpperBound(joinKey(nodeFilter.BranchID, "")),
+		)
+		if _, err := db.rangeDelete(ctx, tableHistoryNode, query, 0); err != nil {
+			return err
+		}
+	}
+	return db.deleteItem(ctx, tableHistoryTree, primaryKey(treeFilter.TreeID, *treeFilter.BranchID))
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName: aws.String(db.tableName(tableHistoryTree)),
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseHistoryTreeRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	items, _, err := db.queryPage(ctx, db.newPartitionQuery(tableHistoryTree, filter.TreeID), 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseHistoryTreeRow(it)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func newHistoryTreeItem(row *nosqlplugin.HistoryTreeRow) (item, error) {
+	data, err := jsonAttr(&nosqlplugin.HistoryTreeRow{
+		Ancestors:       row.Ancestors,
+		CreateTimestamp: truncateTime(row.CreateTimestamp),
+		Info:            row.Info,
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(row.TreeID, row.BranchID)
+	it[attrData] = data
+	return it, nil
+}
+
+func parseHistoryTreeRow(it item) (*nosqlplugin.HistoryTreeRow, error) {
+	row := &nosqlplugin.HistoryTreeRow{}
+	if err := getJSON(it, attrData, row); err != nil {
+		return nil, err
+	}
+	row.TreeID = getString(it, attrPK)
+	row.BranchID = getString(it, attrSK)
+	row.Ancestors = parseBranchAncestors(row.Ancestors)
+	return row, nil
+}
+
+func parseHistoryNodeRow(it item) (*nosqlplugin.HistoryNodeRow, error) {
+	// the sort key is branchID#nodeID#^txnID
+	parts := strings.Split(getString(it, attrSK), keySeparator)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid history node key: %v", getString(it, attrSK))
+	}
+	nodeID, err := decodeInt64(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	txnID, err := decodeInt64(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.HistoryNodeRow{
+		TreeID:       getString(it, attrPK),
+		BranchID:     parts[0],
+		NodeID:       nodeID,
+		TxnID:        common.Int64Ptr(^txnID),
+		DataEncoding: getString(it, attrEncoding),
+	}
+	if v, ok := it[attrData]; ok {
+		row.Data = v.B
+	}
+	return row, nil
+}
+
+func parseBranchAncestors(
+	ancestors []*types.HistoryBranchRange,
+) []*types.HistoryBranchRange {
+	ans := make([]*types.HistoryBranchRange, 0, len(ancestors))
+	for _, e := range ancestors {
+		ans = append(ans, &types.HistoryBranchRange{
+			BranchID:  e.BranchID,
+			EndNodeID: e.EndNodeID,
+		})
+	}
+
+	if len(ans) > 0 {
+		// sort ans based onf EndNodeID so that we can set BeginNodeID
+		sort.Slice(ans, func(i, j int) bool { return *ans[i].EndNodeID < *ans[j].EndNodeID })
+		ans[0].BeginNodeID = common.Int64Ptr(int64(1))
+		for i := 1; i < len(ans); i++ {
+			ans[i].BeginNodeID = ans[i-1].EndNodeID
+		}
+	}
+	return ans
 }
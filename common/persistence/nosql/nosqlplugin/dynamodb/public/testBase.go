@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package public
+
+import (
+	"github.com/uber/cadence/environment"
+
+	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb" // needed to load dynamodb plugin
+	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
+)
+
+const (
+	// DynamoDB Local accepts any static credentials
+	testUser     = "cadence"
+	testPassword = "cadence"
+)
+
+// NewTestBaseWithDynamoDB returns a persistence test base backed by DynamoDB(or DynamoDB Local)
+func NewTestBaseWithDynamoDB(options *persistencetests.TestBaseOptions) persistencetests.TestBase {
+	if options.DBPluginName == "" {
+		options.DBPluginName = "dynamodb"
+	}
+	if options.DBHost == "" {
+		options.DBHost = environment.GetDynamoDBAddress()
+	}
+	if options.DBPort == 0 {
+		options.DBPort = environment.GetDynamoDBPort()
+	}
+	if options.DBUsername == "" {
+		options.DBUsername = testUser
+		options.DBPassword = testPassword
+	}
+	return persistencetests.NewTestBaseWithNoSQL(options)
+}
@@ -22,18 +22,32 @@ package dynamodb
 
 import (
 	"context"
+	"math"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
-//Insert message into queue, return error if failed or already exists
+// Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	it := primaryKey(queueKey(row.QueueType), encodeInt64(row.ID))
+	it[attrData] = binaryAttr(row.Payload)
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(db.tableName(tableQueueMessages)),
+		Item:                it,
+		ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +55,17 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	query := db.newPartitionQuery(tableQueueMessages, queueKey(queueType))
+	query.ProjectionExpression = aws.String(attrSK)
+	query.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, query, 1, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, newItemNotFoundError(tableQueueMessages)
+	}
+	return decodeInt64(getString(items[0], attrSK))
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +75,15 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	rows, _, err := db.selectMessages(ctx, queueType, exclusiveBeginMessageID, math.MaxInt64, maxRows, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*nosqlplugin.QueueMessageRow, 0, len(rows))
+	for i := range rows {
+		result = append(result, &rows[i])
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +91,21 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	rows, nextPageToken, err := db.selectMessages(
+		ctx,
+		request.QueueType,
+		request.ExclusiveBeginMessageID,
+		request.InclusiveEndMessageID,
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +114,8 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	// i.e. math.MinInt64 < messageID <= exclusiveBeginMessageID-1
+	return db.rangeDeleteTasks(ctx, tableQueueMessages, queueKey(queueType), math.MinInt64, exclusiveBeginMessageID-1)
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +125,7 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, tableQueueMessages, queueKey(queueType), exclusiveBeginMessageID, inclusiveEndMessageID)
 }
 
 // Delete one message
@@ -87,7 +134,7 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableQueueMessages, primaryKey(queueKey(queueType), encodeInt64(messageID)))
 }
 
 // Insert an empty metadata row, starting from a version
@@ -96,7 +143,20 @@ func (db *ddb) InsertQueueMetadata(
 	queueType persistence.QueueType,
 	version int64,
 ) error {
-	panic("TODO")
+	it, err := newQueueMetadataItem(queueType, map[string]int64{}, version)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(db.tableName(tableQueueMetadata)),
+		Item:                it,
+		ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+	})
+	if db.IsConditionFailedError(err) {
+		// it's ok if the item is not written, which means that the record exists already.
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -106,7 +166,23 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	it, err := newQueueMetadataItem(row.QueueType, row.ClusterAckLevels, row.Version)
+	if err != nil {
+		return err
+	}
+	b := newExpressionBuilder()
+	condition := b.name(attrVersion) + " = " + b.value(numberAttr(row.Version-1))
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(db.tableName(tableQueueMetadata)),
+		Item:                      it,
+		ConditionExpression:       aws.String(condition),
+		ExpressionAttributeNames:  b.attributeNames(),
+		ExpressionAttributeValues: b.attributeValues(),
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -114,12 +190,75 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableQueueMetadata, primaryKey(queueKey(queueType), queueMetadataSortKey))
+	if err != nil {
+		return nil, err
+	}
+	version, err := getInt64(it, attrVersion)
+	if err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := getJSON(it, attrData, &ackLevels); err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          version,
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.countQuery(ctx, db.newPartitionQuery(tableQueueMessages, queueKey(queueType)))
+}
+
+// selectMessages reads the messages that exclusiveBeginMessageID < messageID <= inclusiveEndMessageID
+func (db *ddb) selectMessages(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	exclusiveBeginMessageID int64,
+	inclusiveEndMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]nosqlplugin.QueueMessageRow, []byte, error) {
+	lower, upper, ok := taskIDRange(exclusiveBeginMessageID, inclusiveEndMessageID)
+	if !ok {
+		return nil, nil, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, db.newRangeQuery(tableQueueMessages, queueKey(queueType), lower, upper), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]nosqlplugin.QueueMessageRow, 0, len(items))
+	for _, it := range items {
+		id, err := decodeInt64(getString(it, attrSK))
+		if err != nil {
+			return nil, nil, err
+		}
+		var payload []byte
+		if v, ok := it[attrData]; ok {
+			payload = v.B
+		}
+		rows = append(rows, nosqlplugin.QueueMessageRow{ID: id, Payload: payload})
+	}
+	return rows, nextPageToken, nil
+}
+
+func newQueueMetadataItem(queueType persistence.QueueType, clusterAckLevels map[string]int64, version int64) (item, error) {
+	data, err := jsonAttr(clusterAckLevels)
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(queueKey(queueType), queueMetadataSortKey)
+	it[attrVersion] = numberAttr(version)
+	it[attrData] = data
+	return it, nil
 }
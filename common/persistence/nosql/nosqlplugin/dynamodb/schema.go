@@ -0,0 +1,250 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// All tables share the same primary key layout: a string partition key(pk) and a string sort key(sk).
+// Numbers that are part of a sort key are encoded by encodeInt64 so that the lexicographical order
+// of the keys is the same as the numerical order.
+// Every column that is not used in a key, an index or a condition is stored in the opaque data blob.
+const (
+	tableShards              = "shards"
+	tableCurrentExecutions   = "current_executions"
+	tableExecutions          = "executions"
+	tableExecutionMaps       = "execution_maps"
+	tableTransferTasks       = "transfer_tasks"
+	tableTimerTasks          = "timer_tasks"
+	tableReplicationTasks    = "replication_tasks"
+	tableCrossClusterTasks   = "cross_cluster_tasks"
+	tableReplicationDLQTasks = "replication_dlq_tasks"
+	tableTaskLists           = "task_lists"
+	tableTasks               = "tasks"
+	tableQueueMessages       = "queue_messages"
+	tableQueueMetadata       = "queue_metadata"
+	tableDomains             = "domains"
+	tableHistoryTree         = "history_tree"
+	tableHistoryNode         = "history_node"
+	tableVisibility          = "visibility"
+	tableClusterConfig       = "cluster_config"
+)
+
+const (
+	attrPK   = "pk"
+	attrSK   = "sk"
+	attrData = "data"
+	// attrTTL holds the expiry time in epoch seconds, it's the TTL attribute of the tables that support TTL
+	attrTTL = "ttl"
+
+	// shard & workflow
+	attrRangeID          = "range_id"
+	attrRunID            = "run_id"
+	attrCreateRequestID  = "create_request_id"
+	attrState            = "state"
+	attrCloseStatus      = "close_status"
+	attrLastWriteVersion = "last_write_version"
+	attrNextEventID      = "next_event_id"
+	attrActivityMap      = "activity_map"
+	attrTimerMap         = "timer_map"
+	attrChildMap         = "child_executions_map"
+	attrRequestCancelMap = "request_cancel_map"
+	attrSignalMap        = "signal_map"
+	attrSignalRequested  = "signal_requested"
+	attrBufferedEvents   = "buffered_events_list"
+	// attrEntries holds the entries of one of the maps of an execution
+	attrEntries = "entries"
+
+	// history
+	attrEncoding = "encoding"
+
+	// queue & domain
+	attrVersion             = "version"
+	attrNotificationVersion = "notification_version"
+	attrName                = "name"
+
+	// visibility
+	attrWorkflowID       = "workflow_id"
+	attrWorkflowTypeName = "workflow_type_name"
+	attrOpenStartTime    = "open_start_time"
+	attrClosedStartTime  = "closed_start_time"
+	attrCloseTime        = "close_time"
+)
+
+const (
+	keySeparator = "#"
+	// keyUpperBound is greater than any character that is used after a keySeparator in a sort key
+	keyUpperBound = "$"
+
+	shardSortKey         = "shard"
+	taskListSortKey      = "tasklist"
+	queueMetadataSortKey = "metadata"
+	domainPartitionKey   = "domains"
+	domainMetadataKey    = "metadata"
+	domainByNamePrefix   = "name" + keySeparator
+	domainByIDPrefix     = "id" + keySeparator
+
+	// maxTransactionItems is the maximum number of items in a single DynamoDB transaction
+	maxTransactionItems = 100
+	// maxTransactionSize is the maximum total size in bytes of the items in a single DynamoDB transaction
+	maxTransactionSize = 4 * 1024 * 1024
+	// maxBatchWriteItems is the maximum number of items in a single BatchWriteItem request
+	maxBatchWriteItems = 25
+	// maxItemSize is the maximum size in bytes of a single DynamoDB item
+	maxItemSize = 400 * 1024
+)
+
+type (
+	// tableSchema is the definition of a table for AdminDB
+	tableSchema struct {
+		name string
+		// localIndexes are the string attributes that are used as sort keys of local secondary indexes.
+		// The name of the index is indexName(attribute)
+		localIndexes []string
+		ttl          bool
+	}
+)
+
+var tableSchemas = []tableSchema{
+	{name: tableShards},
+	{name: tableCurrentExecutions},
+	{name: tableExecutions},
+	{name: tableExecutionMaps},
+	{name: tableTransferTasks},
+	{name: tableTimerTasks},
+	{name: tableReplicationTasks},
+	{name: tableCrossClusterTasks},
+	{name: tableReplicationDLQTasks},
+	{name: tableTaskLists, ttl: true},
+	{name: tableTasks, ttl: true},
+	{name: tableQueueMessages},
+	{name: tableQueueMetadata},
+	{name: tableDomains},
+	{name: tableHistoryTree},
+	{name: tableHistoryNode},
+	{
+		name:         tableVisibility,
+		localIndexes: []string{attrOpenStartTime, attrClosedStartTime, attrCloseTime},
+		ttl:          true,
+	},
+	{name: tableClusterConfig},
+}
+
+// tableName returns the full table name, the keyspace is used as the prefix of all tables
+// so that multiple clusters can share the same AWS account and region
+func (db *ddb) tableName(table string) string {
+	if db.cfg.Keyspace == "" {
+		return table
+	}
+	return db.cfg.Keyspace + "_" + table
+}
+
+func indexName(attribute string) string {
+	return attribute + "_index"
+}
+
+func joinKey(parts ...string) string {
+	return strings.Join(parts, keySeparator)
+}
+
+// prefixUpperBound returns a key that is greater than all the keys starting with the prefix,
+// the prefix must end with the keySeparator
+func prefixUpperBound(prefix string) string {
+	return strings.TrimSuffix(prefix, keySeparator) + keyUpperBound
+}
+
+// encodeInt64 encodes an int64 to a fixed length string, the order of the strings
+// is the same as the order of the numbers, including the negative numbers
+func encodeInt64(v int64) string {
+	return fmt.Sprintf("%020d", uint64(v)^(1<<63))
+}
+
+// decodeInt64 decodes a string encoded by encodeInt64
+func decodeInt64(s string) (int64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v ^ (1 << 63)), nil
+}
+
+// encodeTime encodes a timestamp with the same precision of the other NoSQL implementation(milliseconds)
+func encodeTime(t time.Time) string {
+	return encodeInt64(persistence.UnixNanoToDBTimestamp(t.UnixNano()))
+}
+
+// truncateTime truncates a timestamp to the same precision of encodeTime
+func truncateTime(t time.Time) time.Time {
+	return time.Unix(0, persistence.DBTimestampToUnixNano(persistence.UnixNanoToDBTimestamp(t.UnixNano())))
+}
+
+func shardKey(shardID int) string {
+	return fmt.Sprintf("%v", shardID)
+}
+
+func shardClusterKey(shardID int, cluster string) string {
+	return joinKey(shardKey(shardID), cluster)
+}
+
+func workflowKey(domainID, workflowID string) string {
+	return joinKey(domainID, workflowID)
+}
+
+func executionKey(domainID, workflowID, runID string) string {
+	return joinKey(domainID, workflowID, runID)
+}
+
+// executionMapKey is the sort key of the item holding one of the maps of an execution,
+// the maps are stored apart from the execution so that each of them can use up to maxItemSize
+func executionMapKey(domainID, workflowID, runID, attribute string) string {
+	return joinKey(executionKey(domainID, workflowID, runID), attribute)
+}
+
+func timerTaskKey(visibilityTimestamp time.Time, taskID int64) string {
+	return joinKey(encodeTime(visibilityTimestamp), encodeInt64(taskID))
+}
+
+func taskListKey(domainID, taskListName string, taskListType int) string {
+	return joinKey(domainID, taskListName, fmt.Sprintf("%v", taskListType))
+}
+
+func historyNodeKey(branchID string, nodeID int64, txnID int64) string {
+	// txnID is in descending order
+	return joinKey(branchID, encodeInt64(nodeID), encodeInt64(^txnID))
+}
+
+func queueKey(queueType persistence.QueueType) string {
+	return fmt.Sprintf("%v", int(queueType))
+}
+
+func configRowTypeKey(rowType int) string {
+	return fmt.Sprintf("%v", rowType)
+}
+
+func visibilityKey(workflowID, runID string) string {
+	return joinKey(workflowID, runID)
+}
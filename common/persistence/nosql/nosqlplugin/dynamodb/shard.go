@@ -22,6 +22,10 @@ package dynamodb
 
 import (
 	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -29,22 +33,145 @@ import (
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	failures, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName:           aws.String(db.tableName(tableShards)),
+				Item:                it,
+				ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return convertToConflictedShardRow(failures, 0)
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableShards, primaryKey(shardKey(shardID), shardSortKey))
+	if err != nil {
+		return 0, nil, err
+	}
+	rangeID, err := getInt64(it, attrRangeID)
+	if err != nil {
+		return 0, nil, err
+	}
+	shard := &nosqlplugin.ShardRow{}
+	if err := getJSON(it, attrData, shard); err != nil {
+		return 0, nil, err
+	}
+
+	if shard.ClusterTransferAckLevel == nil {
+		shard.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: shard.TransferAckLevel,
+		}
+	}
+	if shard.ClusterTimerAckLevel == nil {
+		shard.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: shard.TimerAckLevel,
+		}
+	}
+	if shard.ClusterReplicationLevel == nil {
+		shard.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if shard.ReplicationDLQAckLevel == nil {
+		shard.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return rangeID, shard, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	b := newExpressionBuilder()
+	b.set(b.name(attrRangeID), b.value(numberAttr(rangeID)))
+	condition := rangeIDCondition(b, previousRangeID)
+	failures, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Update: &dynamodb.Update{
+				TableName:                 aws.String(db.tableName(tableShards)),
+				Key:                       primaryKey(shardKey(shardID), shardSortKey),
+				UpdateExpression:          b.updateExpression(),
+				ConditionExpression:       aws.String(condition),
+				ExpressionAttributeNames:  b.attributeNames(),
+				ExpressionAttributeValues: b.attributeValues(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return convertToConflictedShardRow(failures, 0)
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	b := newExpressionBuilder()
+	condition := rangeIDCondition(b, previousRangeID)
+	failures, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName:                 aws.String(db.tableName(tableShards)),
+				Item:                      it,
+				ConditionExpression:       aws.String(condition),
+				ExpressionAttributeNames:  b.attributeNames(),
+				ExpressionAttributeValues: b.attributeValues(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return convertToConflictedShardRow(failures, 0)
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (item, error) {
+	data, err := jsonAttr(row)
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(shardKey(row.ShardID), shardSortKey)
+	it[attrRangeID] = numberAttr(row.RangeID)
+	it[attrData] = data
+	return it, nil
+}
+
+// shardCondition returns a condition check of the shard rangeID, for writing within a shard
+func (db *ddb) shardCondition(condition *nosqlplugin.ShardCondition) *dynamodb.TransactWriteItem {
+	b := newExpressionBuilder()
+	expression := rangeIDCondition(b, condition.RangeID)
+	return &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                 aws.String(db.tableName(tableShards)),
+			Key:                       primaryKey(shardKey(condition.ShardID), shardSortKey),
+			ConditionExpression:       aws.String(expression),
+			ExpressionAttributeNames:  b.attributeNames(),
+			ExpressionAttributeValues: b.attributeValues(),
+		},
+	}
+}
+
+// convertToConflictedShardRow returns ShardOperationConditionFailure if the shard item at the index
+// of the transaction failed on the condition
+func convertToConflictedShardRow(failures map[int]item, index int) error {
+	previous, failed := failures[index]
+	if !failed {
+		return nil
+	}
+	rangeID, _ := getInt64(previous, attrRangeID)
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: describeItem(previous),
+	}
 }
@@ -22,20 +22,58 @@ package dynamodb
 
 import (
 	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
 )
 
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableTaskLists, primaryKey(taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType), taskListSortKey))
+	if err != nil {
+		return nil, err
+	}
+	rangeID, err := getInt64(it, attrRangeID)
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.TaskListRow{}
+	if err := getJSON(it, attrData, row); err != nil {
+		return nil, err
+	}
+	row.DomainID = filter.DomainID
+	row.TaskListName = filter.TaskListName
+	row.TaskListType = filter.TaskListType
+	row.RangeID = rangeID
+	return row, nil
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	newRow := *row
+	newRow.RangeID = initialRangeID
+	newRow.AckLevel = 0
+	it, err := newTaskListItem(&newRow)
+	if err != nil {
+		return err
+	}
+	return db.writeTaskList(ctx, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName:           aws.String(db.tableName(tableTaskLists)),
+			Item:                it,
+			ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+		},
+	})
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +83,13 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	b := newExpressionBuilder()
+	if err := setTaskList(b, row); err != nil {
+		return err
+	}
+	// the tasklist doesn't expire anymore
+	b.remove(b.name(attrTTL))
+	return db.writeTaskList(ctx, db.updateTaskList(b, row, previousRangeID))
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +102,37 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	newRow := *row
+	newRow.LastUpdatedTime = time.Now()
+	b := newExpressionBuilder()
+	if err := setTaskList(b, &newRow); err != nil {
+		return err
+	}
+	b.set(b.name(attrTTL), b.value(ttlAttr(ttlSeconds)))
+	return db.writeTaskList(ctx, db.updateTaskList(b, &newRow, previousRangeID))
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	return nil, &types.InternalServiceError{
+		Message: "unsupported operation",
+	}
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	b := newExpressionBuilder()
+	return db.writeTaskList(ctx, &dynamodb.TransactWriteItem{
+		Delete: &dynamodb.Delete{
+			TableName:                 aws.String(db.tableName(tableTaskLists)),
+			Key:                       primaryKey(taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType), taskListSortKey),
+			ConditionExpression:       aws.String(rangeIDCondition(b, previousRangeID)),
+			ExpressionAttributeNames:  b.attributeNames(),
+			ExpressionAttributeValues: b.attributeValues(),
+		},
+	})
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,18 +142,124 @@ func (db *ddb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	pk := taskListKey(tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+	items := make([]*dynamodb.TransactWriteItem, 0, len(tasksToInsert)+1)
+	for _, task := range tasksToInsert {
+		it, err := newTaskItem(pk, encodeInt64(task.TaskID), &task.TaskRow)
+		if err != nil {
+			return err
+		}
+		if task.TTLSeconds > 0 {
+			it[attrTTL] = ttlAttr(int64(task.TTLSeconds))
+		}
+		items = append(items, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableTasks)),
+				Item:      it,
+			},
+		})
+	}
+
+	// The following update is used to ensure that range_id didn't change
+	row := *tasklistCondition
+	row.LastUpdatedTime = time.Now()
+	b := newExpressionBuilder()
+	if err := setTaskList(b, &row); err != nil {
+		return err
+	}
+	taskListIndex := len(items)
+	items = append(items, db.updateTaskList(b, &row, tasklistCondition.RangeID))
+
+	failures, err := db.transactWrite(ctx, items)
+	if err != nil {
+		return err
+	}
+	return convertToConflictedTaskList(failures, taskListIndex)
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	lower, upper, ok := taskIDRange(filter.MinTaskID, filter.MaxTaskID)
+	if !ok {
+		return nil, nil
+	}
+	pk := taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType)
+	results, _, err := db.selectTasks(ctx, tableTasks, pk, lower, upper, filter.BatchSize, nil, func() interface{} {
+		return &nosqlplugin.TaskRow{}
+	})
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*nosqlplugin.TaskRow, 0, len(results))
+	for _, t := range results {
+		tasks = append(tasks, t.(*nosqlplugin.TaskRow))
+	}
+	return tasks, nil
 }
 
-// DeleteTask delete a batch tasks that taskIDs less than the row
-// If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// RangeDeleteTasks deletes up to BatchSize tasks that MinTaskID < taskID <= MaxTaskID,
+// and returns the number of rows deleted
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	lower, upper, ok := taskIDRange(filter.MinTaskID, filter.MaxTaskID)
+	if !ok {
+		return 0, nil
+	}
+	pk := taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType)
+	return db.rangeDelete(ctx, tableTasks, db.newRangeQuery(tableTasks, pk, lower, upper), filter.BatchSize)
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow) (item, error) {
+	data, err := jsonAttr(row)
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(taskListKey(row.DomainID, row.TaskListName, row.TaskListType), taskListSortKey)
+	it[attrRangeID] = numberAttr(row.RangeID)
+	it[attrData] = data
+	return it, nil
+}
+
+func setTaskList(b *expressionBuilder, row *nosqlplugin.TaskListRow) error {
+	data, err := jsonAttr(row)
+	if err != nil {
+		return err
+	}
+	b.set(b.name(attrRangeID), b.value(numberAttr(row.RangeID)))
+	b.set(b.name(attrData), b.value(data))
+	return nil
+}
+
+func (db *ddb) updateTaskList(b *expressionBuilder, row *nosqlplugin.TaskListRow, previousRangeID int64) *dynamodb.TransactWriteItem {
+	return &dynamodb.TransactWriteItem{
+		Update: &dynamodb.Update{
+			TableName:                 aws.String(db.tableName(tableTaskLists)),
+			Key:                       primaryKey(taskListKey(row.DomainID, row.TaskListName, row.TaskListType), taskListSortKey),
+			UpdateExpression:          b.updateExpression(),
+			ConditionExpression:       aws.String(rangeIDCondition(b, previousRangeID)),
+			ExpressionAttributeNames:  b.attributeNames(),
+			ExpressionAttributeValues: b.attributeValues(),
+		},
+	}
+}
+
+func (db *ddb) writeTaskList(ctx context.Context, it *dynamodb.TransactWriteItem) error {
+	failures, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{it})
+	if err != nil {
+		return err
+	}
+	return convertToConflictedTaskList(failures, 0)
+}
+
+// convertToConflictedTaskList returns TaskOperationConditionFailure if the tasklist item at the index
+// of the transaction failed on the condition
+func convertToConflictedTaskList(failures map[int]item, index int) error {
+	previous, failed := failures[index]
+	if !failed {
+		return nil
+	}
+	rangeID, _ := getInt64(previous, attrRangeID)
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: describeItem(previous),
+	}
 }
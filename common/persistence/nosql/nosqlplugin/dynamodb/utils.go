@@ -0,0 +1,560 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	item = map[string]*dynamodb.AttributeValue
+
+	// expressionBuilder builds update and condition expressions sharing the same attribute names and values
+	expressionBuilder struct {
+		sets    []string
+		removes []string
+		names   map[string]*string
+		values  map[string]*dynamodb.AttributeValue
+		// placeholders of the attribute names
+		nameIndex map[string]string
+	}
+)
+
+func newExpressionBuilder() *expressionBuilder {
+	return &expressionBuilder{
+		names:     make(map[string]*string),
+		values:    make(map[string]*dynamodb.AttributeValue),
+		nameIndex: make(map[string]string),
+	}
+}
+
+// name returns the placeholder of an attribute name or a map key
+func (b *expressionBuilder) name(attribute string) string {
+	if placeholder, ok := b.nameIndex[attribute]; ok {
+		return placeholder
+	}
+	placeholder := fmt.Sprintf("#n%v", len(b.nameIndex))
+	b.nameIndex[attribute] = placeholder
+	b.names[placeholder] = aws.String(attribute)
+	return placeholder
+}
+
+// path returns the document path of a key in a map attribute
+func (b *expressionBuilder) path(attribute, key string) string {
+	return b.name(attribute) + "." + b.name(key)
+}
+
+// value returns the placeholder of a value
+func (b *expressionBuilder) value(v *dynamodb.AttributeValue) string {
+	placeholder := fmt.Sprintf(":v%v", len(b.values))
+	b.values[placeholder] = v
+	return placeholder
+}
+
+func (b *expressionBuilder) set(path string, value string) {
+	b.sets = append(b.sets, path+" = "+value)
+}
+
+func (b *expressionBuilder) remove(path string) {
+	b.removes = append(b.removes, path)
+}
+
+func (b *expressionBuilder) updateExpression() *string {
+	var clauses []string
+	if len(b.sets) > 0 {
+		clauses = append(clauses, "SET "+strings.Join(b.sets, ", "))
+	}
+	if len(b.removes) > 0 {
+		clauses = append(clauses, "REMOVE "+strings.Join(b.removes, ", "))
+	}
+	return aws.String(strings.Join(clauses, " "))
+}
+
+func (b *expressionBuilder) attributeNames() map[string]*string {
+	if len(b.names) == 0 {
+		return nil
+	}
+	return b.names
+}
+
+func (b *expressionBuilder) attributeValues() map[string]*dynamodb.AttributeValue {
+	if len(b.values) == 0 {
+		return nil
+	}
+	return b.values
+}
+
+// rangeIDCondition returns the condition expression of range_id, for the items leased by range IDs
+func rangeIDCondition(b *expressionBuilder, rangeID int64) string {
+	return b.name(attrRangeID) + " = " + b.value(numberAttr(rangeID))
+}
+
+func stringAttr(v string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(v)}
+}
+
+func numberAttr(v int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v, 10))}
+}
+
+func binaryAttr(v []byte) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{B: v}
+}
+
+func mapAttr(v map[string]*dynamodb.AttributeValue) *dynamodb.AttributeValue {
+	if v == nil {
+		v = make(map[string]*dynamodb.AttributeValue)
+	}
+	return &dynamodb.AttributeValue{M: v}
+}
+
+func listAttr(v []*dynamodb.AttributeValue) *dynamodb.AttributeValue {
+	if v == nil {
+		v = make([]*dynamodb.AttributeValue, 0)
+	}
+	return &dynamodb.AttributeValue{L: v}
+}
+
+func jsonAttr(v interface{}) (*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return binaryAttr(data), nil
+}
+
+func ttlAttr(ttlSeconds int64) *dynamodb.AttributeValue {
+	return numberAttr(time.Now().Add(time.Duration(ttlSeconds) * time.Second).Unix())
+}
+
+func primaryKey(pk, sk string) item {
+	return item{
+		attrPK: stringAttr(pk),
+		attrSK: stringAttr(sk),
+	}
+}
+
+func getString(it item, attribute string) string {
+	if v, ok := it[attribute]; ok && v.S != nil {
+		return *v.S
+	}
+	return ""
+}
+
+func getInt64(it item, attribute string) (int64, error) {
+	v, ok := it[attribute]
+	if !ok || v.N == nil {
+		return 0, fmt.Errorf("attribute %v is missing", attribute)
+	}
+	return strconv.ParseInt(*v.N, 10, 64)
+}
+
+func hasAttribute(it item, attribute string) bool {
+	v, ok := it[attribute]
+	return ok && (v.NULL == nil || !*v.NULL)
+}
+
+// getJSON decodes a JSON blob attribute into the given value
+func getJSON(it item, attribute string, v interface{}) error {
+	attr, ok := it[attribute]
+	if !ok || attr.B == nil {
+		return fmt.Errorf("attribute %v is missing", attribute)
+	}
+	return json.Unmarshal(attr.B, v)
+}
+
+// describeItem returns a string of an item for logging and error details
+func describeItem(it item) string {
+	var columns []string
+	for k, v := range it {
+		if v.B != nil || v.M != nil || v.L != nil {
+			// skip the blobs and collections, they are too big to be useful
+			continue
+		}
+		columns = append(columns, fmt.Sprintf("%s=%v", k, strings.TrimSpace(v.String())))
+	}
+	return strings.Join(columns, ",")
+}
+
+func serializePageToken(key item) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(key)
+}
+
+func deserializePageToken(token []byte) (item, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	var key item
+	if err := json.Unmarshal(token, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %v", err)
+	}
+	return key, nil
+}
+
+// getItem reads a single item with strong consistency, returns itemNotFoundError if it doesn't exist
+func (db *ddb) getItem(ctx context.Context, table string, key item) (item, error) {
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(db.tableName(table)),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Item) == 0 {
+		return nil, newItemNotFoundError(table)
+	}
+	return output.Item, nil
+}
+
+func (db *ddb) putItem(ctx context.Context, table string, it item) error {
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(table)),
+		Item:      it,
+	})
+	return err
+}
+
+func (db *ddb) deleteItem(ctx context.Context, table string, key item) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(db.tableName(table)),
+		Key:       key,
+	})
+	return err
+}
+
+// queryPage reads up to pageSize(non-positive means no limit) items of a query with strong consistency.
+// It keeps reading until the page is full or there is no more items, so that a filter expression
+// doesn't shrink the page.
+func (db *ddb) queryPage(
+	ctx context.Context,
+	input *dynamodb.QueryInput,
+	pageSize int,
+	pageToken []byte,
+) ([]item, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	// all the indexes are local secondary indexes, which support strong consistent read
+	input.ConsistentRead = aws.Bool(true)
+
+	var items []item
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, output.Items...)
+		if len(output.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			nextPageToken, err := serializePageToken(output.LastEvaluatedKey)
+			return items, nextPageToken, err
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// scanPage reads up to pageSize(non-positive means no limit) items of a whole table
+func (db *ddb) scanPage(
+	ctx context.Context,
+	input *dynamodb.ScanInput,
+	pageSize int,
+	pageToken []byte,
+) ([]item, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	input.ConsistentRead = aws.Bool(true)
+
+	var items []item
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		output, err := db.client.ScanWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, output.Items...)
+		if len(output.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			nextPageToken, err := serializePageToken(output.LastEvaluatedKey)
+			return items, nextPageToken, err
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// countQuery returns the number of items matching the query
+func (db *ddb) countQuery(ctx context.Context, input *dynamodb.QueryInput) (int64, error) {
+	input.Select = aws.String(dynamodb.SelectCount)
+	input.ConsistentRead = aws.Bool(true)
+	var count int64
+	for {
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(output.Count)
+		if len(output.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// rangeDelete deletes all items matching the query, up to limit(non-positive means no limit) items.
+// It returns the number of deleted items.
+func (db *ddb) rangeDelete(ctx context.Context, table string, input *dynamodb.QueryInput, limit int) (int, error) {
+	input.TableName = aws.String(db.tableName(table))
+	input.ProjectionExpression = aws.String(attrPK + ", " + attrSK)
+	items, _, err := db.queryPage(ctx, input, limit, nil)
+	if err != nil {
+		return 0, err
+	}
+	keys := make([]item, 0, len(items))
+	for _, it := range items {
+		keys = append(keys, primaryKey(getString(it, attrPK), getString(it, attrSK)))
+	}
+	return len(keys), db.batchDelete(ctx, table, keys)
+}
+
+// batchDelete deletes the items by keys, in batches of maxBatchWriteItems
+func (db *ddb) batchDelete(ctx context.Context, table string, keys []item) error {
+	tableName := db.tableName(table)
+	for start := 0; start < len(keys); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(keys) {
+			end = len(keys)
+		}
+		requests := make([]*dynamodb.WriteRequest, 0, end-start)
+		for _, key := range keys[start:end] {
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+			})
+		}
+		pending := map[string][]*dynamodb.WriteRequest{tableName: requests}
+		for len(pending) > 0 {
+			output, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return err
+			}
+			pending = output.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+// transactWriteWithTasks executes the write items in a single transaction together with the task items, so that
+// the tasks are never committed without the workflow write which owns them. A write which doesn't fit into a single
+// transaction fails with a TransactionSizeLimitError.
+func (db *ddb) transactWriteWithTasks(
+	ctx context.Context,
+	items []*dynamodb.TransactWriteItem,
+	taskItems []*dynamodb.TransactWriteItem,
+) (map[int]item, error) {
+	return db.transactWrite(ctx, append(items[:len(items):len(items)], taskItems...))
+}
+
+// transactWrite executes the write items in a single transaction.
+// When the transaction is canceled because some conditions are not met, it returns the current images of
+// the items which failed on the condition, indexed by their positions in the transaction.
+// A nil image means the item doesn't exist.
+func (db *ddb) transactWrite(ctx context.Context, items []*dynamodb.TransactWriteItem) (map[int]item, error) {
+	if len(items) > maxTransactionItems {
+		return nil, &persistence.TransactionSizeLimitError{
+			Msg: fmt.Sprintf("transaction of %v items exceeds limit of %v items", len(items), maxTransactionItems),
+		}
+	}
+	if err := checkTransactionSize(items); err != nil {
+		return nil, err
+	}
+	for _, it := range items {
+		onFailure := aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+		switch {
+		case it.ConditionCheck != nil:
+			it.ConditionCheck.ReturnValuesOnConditionCheckFailure = onFailure
+		case it.Put != nil && it.Put.ConditionExpression != nil:
+			it.Put.ReturnValuesOnConditionCheckFailure = onFailure
+		case it.Update != nil && it.Update.ConditionExpression != nil:
+			it.Update.ReturnValuesOnConditionCheckFailure = onFailure
+		case it.Delete != nil && it.Delete.ConditionExpression != nil:
+			it.Delete.ReturnValuesOnConditionCheckFailure = onFailure
+		}
+	}
+
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	if err == nil {
+		return nil, nil
+	}
+	txErr, ok := err.(*dynamodb.TransactionCanceledException)
+	if !ok {
+		if aerr, ok := err.(awserr.Error); ok && isSizeLimitError(aerr.Code(), aerr.Message()) {
+			return nil, &persistence.TransactionSizeLimitError{Msg: aerr.Message()}
+		}
+		return nil, err
+	}
+	failures := make(map[int]item)
+	for i, reason := range txErr.CancellationReasons {
+		code := aws.StringValue(reason.Code)
+		switch code {
+		case "", "None":
+			continue
+		case "ConditionalCheckFailed":
+			failures[i] = reason.Item
+		case "ValidationError":
+			// e.g. an update that makes an item exceed maxItemSize
+			if isSizeLimitError(code, aws.StringValue(reason.Message)) {
+				return nil, &persistence.TransactionSizeLimitError{Msg: aws.StringValue(reason.Message)}
+			}
+			return nil, err
+		default:
+			// e.g. throttling or conflicting transactions, the caller should retry
+			return nil, err
+		}
+	}
+	if len(failures) == 0 {
+		return nil, err
+	}
+	return failures, nil
+}
+
+// checkTransactionSize returns a TransactionSizeLimitError if an item or the whole transaction exceeds the limits of
+// DynamoDB, so that the caller fails the workflow instead of retrying a write which never succeeds
+func checkTransactionSize(items []*dynamodb.TransactWriteItem) error {
+	for _, it := range items {
+		if it.Put == nil {
+			continue
+		}
+		if size := itemSize(it.Put.Item); size > maxItemSize {
+			return &persistence.TransactionSizeLimitError{
+				Msg: fmt.Sprintf("item size of %v bytes in table %v exceeds limit of %v bytes", size, aws.StringValue(it.Put.TableName), maxItemSize),
+			}
+		}
+	}
+	if size := transactionSize(items); size > maxTransactionSize {
+		return &persistence.TransactionSizeLimitError{
+			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, maxTransactionSize),
+		}
+	}
+	return nil
+}
+
+func isSizeLimitError(code string, message string) bool {
+	switch code {
+	case "ValidationError", "ValidationException":
+		return strings.Contains(strings.ToLower(message), "size")
+	}
+	return false
+}
+
+func transactionSize(items []*dynamodb.TransactWriteItem) int {
+	size := 0
+	for _, it := range items {
+		size += writeItemSize(it)
+	}
+	return size
+}
+
+// writeItemSize estimates the size of a write item, an update is counted by its key and new values
+// as the size of the updated item is unknown until it's applied
+func writeItemSize(it *dynamodb.TransactWriteItem) int {
+	switch {
+	case it.Put != nil:
+		return itemSize(it.Put.Item)
+	case it.Update != nil:
+		size := itemSize(it.Update.Key)
+		for _, v := range it.Update.ExpressionAttributeValues {
+			size += attributeSize(v)
+		}
+		return size
+	case it.Delete != nil:
+		return itemSize(it.Delete.Key)
+	case it.ConditionCheck != nil:
+		return itemSize(it.ConditionCheck.Key)
+	}
+	return 0
+}
+
+// itemSize estimates the size of an item the way DynamoDB measures it,
+// which is the sum of the lengths of the attribute names and the sizes of the values
+func itemSize(it item) int {
+	size := 0
+	for name, v := range it {
+		size += len(name) + attributeSize(v)
+	}
+	return size
+}
+
+func attributeSize(v *dynamodb.AttributeValue) int {
+	switch {
+	case v.S != nil:
+		return len(*v.S)
+	case v.N != nil:
+		return len(*v.N)
+	case v.B != nil:
+		return len(v.B)
+	case v.M != nil:
+		size := 3
+		for name, e := range v.M {
+			size += 1 + len(name) + attributeSize(e)
+		}
+		return size
+	case v.L != nil:
+		size := 3
+		for _, e := range v.L {
+			size += 1 + attributeSize(e)
+		}
+		return size
+	default:
+		// BOOL and NULL
+		return 1
+	}
+}
@@ -22,16 +22,42 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// Open and closed records of an execution share the same item. The start time of an open record is indexed by
+// open_start_time, and the start/close time of a closed record are indexed by closed_start_time/close_time,
+// so that each local secondary index is sparse and only contains the records of the same state.
+// Search attributes are not supported, same as Cassandra.
+
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	it, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	b := newExpressionBuilder()
+	// the record may be already closed if the started and closed events are recorded out of order
+	condition := "attribute_not_exists(" + b.name(attrCloseTime) + ")"
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableVisibility)),
+		Item:                     it,
+		ConditionExpression:      aws.String(condition),
+		ExpressionAttributeNames: b.attributeNames(),
+	})
+	if db.IsConditionFailedError(err) {
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +65,142 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	// UpdateOpenToClose is naturally done by overriding the item
+	it, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, !row.UpdateCloseToOpen, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.putItem(ctx, tableVisibility, it)
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	b := newExpressionBuilder()
+	var index string
+	var filterExpression string
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		index = attrOpenStartTime
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		switch filter.SortType {
+		case nosqlplugin.SortByStartTime:
+			index = attrClosedStartTime
+		case nosqlplugin.SortByClosedTime:
+			index = attrCloseTime
+		default:
+			panic("not supported sorting type")
+		}
+	default:
+		panic("no supported filter type")
+	}
+
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		filterExpression = b.name(attrWorkflowTypeName) + " = " + b.value(stringAttr(filter.WorkflowType))
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		filterExpression = b.name(attrWorkflowID) + " = " + b.value(stringAttr(filter.WorkflowID))
+	case nosqlplugin.ClosedByClosedStatus:
+		filterExpression = b.name(attrCloseStatus) + " = " + b.value(numberAttr(int64(filter.CloseStatus)))
+	}
+
+	request := &filter.ListRequest
+	condition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		b.name(attrPK), b.value(stringAttr(request.DomainUUID)),
+		b.name(index), b.value(stringAttr(encodeTime(request.EarliestTime))), b.value(stringAttr(encodeTime(request.LatestTime))))
+	query := &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableVisibility)),
+		IndexName:                 aws.String(indexName(index)),
+		KeyConditionExpression:    aws.String(condition),
+		ScanIndexForward:          aws.Bool(false),
+		ExpressionAttributeNames:  b.attributeNames(),
+		ExpressionAttributeValues: b.attributeValues(),
+	}
+	if filterExpression != "" {
+		query.FilterExpression = aws.String(filterExpression)
+	}
+
+	items, nextPageToken, err := db.queryPage(ctx, query, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	executions := make([]*nosqlplugin.VisibilityRow, 0, len(items))
+	for _, it := range items {
+		row := &nosqlplugin.VisibilityRow{}
+		if err := getJSON(it, attrData, row); err != nil {
+			return nil, err
+		}
+		executions = append(executions, row)
+	}
+	return &nosqlplugin.SelectVisibilityResponse{
+		Executions:    executions,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	// the records are deleted by TTL
+	return nil
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableVisibility, primaryKey(domainID, visibilityKey(workflowID, runID)))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !hasAttribute(it, attrCloseTime) {
+		return nil, nil
+	}
+	row := &nosqlplugin.VisibilityRow{}
+	if err := getJSON(it, attrData, row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func newVisibilityItem(domainID string, row *nosqlplugin.VisibilityRow, closed bool, ttlSeconds int64) (item, error) {
+	record := *row
+	record.StartTime = truncateTime(row.StartTime)
+	record.ExecutionTime = truncateTime(row.ExecutionTime)
+	record.SearchAttributes = nil
+	if closed {
+		record.CloseTime = truncateTime(row.CloseTime)
+	} else {
+		record.CloseTime = time.Time{}
+		record.Status = nil
+		record.HistoryLength = 0
+	}
+	data, err := jsonAttr(&record)
+	if err != nil {
+		return nil, err
+	}
+
+	it := primaryKey(domainID, visibilityKey(row.WorkflowID, row.RunID))
+	it[attrWorkflowID] = stringAttr(row.WorkflowID)
+	it[attrWorkflowTypeName] = stringAttr(row.TypeName)
+	it[attrData] = data
+	if closed {
+		it[attrClosedStartTime] = stringAttr(encodeTime(row.StartTime))
+		it[attrCloseTime] = stringAttr(encodeTime(row.CloseTime))
+		if row.Status != nil {
+			it[attrCloseStatus] = numberAttr(int64(*row.Status))
+		}
+	} else {
+		it[attrOpenStartTime] = stringAttr(encodeTime(row.StartTime))
+	}
+	if ttlSeconds > 0 {
+		it[attrTTL] = ttlAttr(ttlSeconds)
+	}
+	return it, nil
 }
@@ -23,8 +23,13 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -41,7 +46,53 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	var items []*dynamodb.TransactWriteItem
+	indexes := newTransactionIndexes()
+
+	currentItem, err := db.createOrUpdateCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest)
+	if err != nil {
+		return err
+	}
+	if currentItem != nil {
+		indexes.current = len(items)
+		items = append(items, currentItem)
+	}
+
+	executionItems, err := db.createWorkflowExecutionWithMergeMaps(shardID, execution)
+	if err != nil {
+		return err
+	}
+	indexes.inserted = len(items)
+	items = append(items, executionItems...)
+
+	indexes.shard = len(items)
+	items = append(items, db.shardCondition(shardCondition))
+
+	taskItems, err := db.createTasks(shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	if err != nil {
+		return err
+	}
+
+	failures, err := db.transactWriteWithTasks(ctx, items, taskItems)
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		return db.convertCreateWorkflowFailures(failures, indexes, currentWorkflowRequest, execution, shardCondition)
+	}
+	return nil
+}
+
+func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
+	it, err := db.getItem(ctx, tableCurrentExecutions, primaryKey(shardKey(shardID), workflowKey(domainID, workflowID)))
+	if err != nil {
+		return nil, err
+	}
+	return parseCurrentWorkflowRow(shardID, it), nil
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -56,105 +107,344 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	var items []*dynamodb.TransactWriteItem
+	indexes := newTransactionIndexes()
+
+	currentItem, err := db.createOrUpdateCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest)
+	if err != nil {
+		return err
+	}
+	if currentItem != nil {
+		indexes.current = len(items)
+		items = append(items, currentItem)
+	}
+
+	if mutatedExecution != nil {
+		mutatedItems, err := db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(shardID, mutatedExecution)
+		if err != nil {
+			return err
+		}
+		indexes.mutated = len(items)
+		items = append(items, mutatedItems...)
+	}
+
+	if insertedExecution != nil {
+		insertedItems, err := db.createWorkflowExecutionWithMergeMaps(shardID, insertedExecution)
+		if err != nil {
+			return err
+		}
+		indexes.inserted = len(items)
+		items = append(items, insertedItems...)
+	}
+
+	if resetExecution != nil {
+		resetItems, err := db.resetWorkflowExecutionAndMapsAndEventBuffer(shardID, resetExecution)
+		if err != nil {
+			return err
+		}
+		indexes.reset = len(items)
+		items = append(items, resetItems...)
+	}
+
+	indexes.shard = len(items)
+	items = append(items, db.shardCondition(shardCondition))
+
+	taskItems, err := db.createTasks(shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	if err != nil {
+		return err
+	}
+
+	failures, err := db.transactWriteWithTasks(ctx, items, taskItems)
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		return db.convertUpdateWorkflowFailures(failures, indexes, currentWorkflowRequest, previousNextEventIDCondition, shardCondition)
+	}
+	return nil
 }
 
-func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+// createTasks returns the items of the tasks of a workflow transaction
+func (db *ddb) createTasks(
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) ([]*dynamodb.TransactWriteItem, error) {
+	transferItems, err := db.createTransferTasks(shardID, transferTasks)
+	if err != nil {
+		return nil, err
+	}
+	replicationItems, err := db.createReplicationTasks(shardID, replicationTasks)
+	if err != nil {
+		return nil, err
+	}
+	crossClusterItems, err := db.createCrossClusterTasks(shardID, crossClusterTasks)
+	if err != nil {
+		return nil, err
+	}
+	timerItems, err := db.createTimerTasks(shardID, timerTasks)
+	if err != nil {
+		return nil, err
+	}
+	items := append(replicationItems, crossClusterItems...)
+	items = append(items, transferItems...)
+	return append(items, timerItems...), nil
 }
 
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	// read the execution and its maps in a transaction, so that they are from the same write
+	gets := []*dynamodb.TransactGetItem{{
+		Get: &dynamodb.Get{
+			TableName: aws.String(db.tableName(tableExecutions)),
+			Key:       primaryKey(shardKey(shardID), executionKey(domainID, workflowID, runID)),
+		},
+	}}
+	for _, key := range executionMapKeys(shardID, domainID, workflowID, runID) {
+		gets = append(gets, &dynamodb.TransactGetItem{
+			Get: &dynamodb.Get{
+				TableName: aws.String(db.tableName(tableExecutionMaps)),
+				Key:       key,
+			},
+		})
+	}
+	output, err := db.client.TransactGetItemsWithContext(ctx, &dynamodb.TransactGetItemsInput{
+		TransactItems: gets,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Responses) != len(gets) || len(output.Responses[0].Item) == 0 {
+		return nil, newItemNotFoundError(tableExecutions)
+	}
+	mapItems := make(map[string]item, len(executionMapAttributes))
+	for i, attribute := range executionMapAttributes {
+		mapItems[attribute] = output.Responses[i+1].Item
+	}
+	return parseWorkflowExecution(output.Responses[0].Item, mapItems)
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	b := newExpressionBuilder()
+	condition := b.name(attrRunID) + " = " + b.value(stringAttr(currentRunIDCondition))
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 aws.String(db.tableName(tableCurrentExecutions)),
+		Key:                       primaryKey(shardKey(shardID), workflowKey(domainID, workflowID)),
+		ConditionExpression:       aws.String(condition),
+		ExpressionAttributeNames:  b.attributeNames(),
+		ExpressionAttributeValues: b.attributeValues(),
+	})
+	if db.IsConditionFailedError(err) {
+		// the current workflow has been changed to another run, there is nothing to delete
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	// delete the maps first, so that a failed deletion can be retried while the execution is still there
+	if err := db.batchDelete(ctx, tableExecutionMaps, executionMapKeys(shardID, domainID, workflowID, runID)); err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, tableExecutions, primaryKey(shardKey(shardID), executionKey(domainID, workflowID, runID)))
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, db.newPartitionQuery(tableCurrentExecutions, shardKey(shardID)), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(items))
+	for _, it := range items {
+		row := parseCurrentWorkflowRow(shardID, it)
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     row.DomainID,
+			WorkflowID:   row.WorkflowID,
+			RunID:        row.RunID,
+			State:        row.State,
+			CurrentRunID: row.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	query := db.newPartitionQuery(tableExecutions, shardKey(shardID))
+	// the event buffer is not needed for listing
+	query.ProjectionExpression = aws.String(fmt.Sprintf("%v, %v, %v", attrPK, attrSK, attrData))
+	items, nextPageToken, err := db.queryPage(ctx, query, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(items))
+	for _, it := range items {
+		data := &executionData{}
+		if err := getJSON(it, attrData, data); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	_, err := db.getItem(ctx, tableExecutions, primaryKey(shardKey(shardID), executionKey(domainID, workflowID, runID)))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
-	panic("TODO")
+	return db.selectTransferTasks(ctx, tableTransferTasks, shardKey(shardID), pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableTransferTasks, primaryKey(shardKey(shardID), encodeInt64(taskID)))
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, tableTransferTasks, shardKey(shardID), exclusiveBeginTaskID, inclusiveEndTaskID)
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
-	panic("TODO")
+	// a timer key is the encoded time followed by the task ID, so the encoded max time itself
+	// is greater than all the keys before it and less than all the keys at or after it
+	results, nextPageToken, err := db.selectTasks(
+		ctx, tableTimerTasks, shardKey(shardID), encodeTime(inclusiveMinTime), encodeTime(exclusiveMaxTime), pageSize, pageToken,
+		func() interface{} {
+			return &nosqlplugin.TimerTask{}
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TimerTask, 0, len(results))
+	for _, t := range results {
+		tasks = append(tasks, t.(*nosqlplugin.TimerTask))
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableTimerTasks, primaryKey(shardKey(shardID), timerTaskKey(visibilityTimestamp, taskID)))
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	query := db.newRangeQuery(tableTimerTasks, shardKey(shardID), encodeTime(inclusiveMinTime), encodeTime(exclusiveMaxTime))
+	_, err := db.rangeDelete(ctx, tableTimerTasks, query, 0)
+	return err
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, tableReplicationTasks, shardKey(shardID), pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableReplicationTasks, primaryKey(shardKey(shardID), encodeInt64(taskID)))
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, tableReplicationTasks, shardKey(shardID), math.MinInt64, inclusiveEndTaskID)
 }
 
-func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskItems, err := db.createReplicationTasks(shardCondition.ShardID, tasks)
+	if err != nil {
+		return err
+	}
+	shardIndex := 0
+	items := []*dynamodb.TransactWriteItem{db.shardCondition(&shardCondition)}
+
+	failures, err := db.transactWriteWithTasks(ctx, items, taskItems)
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		if err := convertToConflictedShardRow(failures, shardIndex); err != nil {
+			return err
+		}
+		// It's much safer to return ShardOperationConditionFailure(which will become ShardOwnershipLostError later) as the default to force the application to reload
+		// shard to recover from such errors
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: -1,
+			Details: describeFailures(failures),
+		}
+	}
+	return nil
 }
 
 func (db *ddb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
-	panic("TODO")
+	transferTasks, nextPageToken, err := db.selectTransferTasks(
+		ctx, tableCrossClusterTasks, shardClusterKey(shardID, targetCluster), pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.CrossClusterTask, 0, len(transferTasks))
+	for _, t := range transferTasks {
+		tasks = append(tasks, &nosqlplugin.CrossClusterTask{
+			TransferTask:  *t,
+			TargetCluster: targetCluster,
+		})
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableCrossClusterTasks, primaryKey(shardClusterKey(shardID, targetCluster), encodeInt64(taskID)))
 }
 
 func (db *ddb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, tableCrossClusterTasks, shardClusterKey(shardID, targetCluster), exclusiveBeginTaskID, inclusiveEndTaskID)
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	panic("TODO")
+	it, err := newTaskItem(shardClusterKey(shardID, sourceCluster), encodeInt64(task.TaskID), &task)
+	if err != nil {
+		return err
+	}
+	return db.putItem(ctx, tableReplicationDLQTasks, it)
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, tableReplicationDLQTasks, shardClusterKey(shardID, sourceCluster), pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.countQuery(ctx, db.newPartitionQuery(tableReplicationDLQTasks, shardClusterKey(shardID, sourceCluster)))
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableReplicationDLQTasks, primaryKey(shardClusterKey(shardID, sourceCluster), encodeInt64(taskID)))
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, tableReplicationDLQTasks, shardClusterKey(shardID, sourceCluster), exclusiveBeginTaskID, inclusiveEndTaskID)
 }
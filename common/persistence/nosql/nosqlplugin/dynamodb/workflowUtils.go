@@ -0,0 +1,859 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// executionMapAttributes are the maps of an execution, each of them is stored in its own item of tableExecutionMaps
+var executionMapAttributes = []string{
+	attrActivityMap,
+	attrTimerMap,
+	attrChildMap,
+	attrRequestCancelMap,
+	attrSignalMap,
+	attrSignalRequested,
+}
+
+type (
+	// executionData is the data blob of an execution item
+	executionData struct {
+		ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+		VersionHistories *persistence.DataBlob
+		Checksums        *checksum.Checksum
+		LastWriteVersion int64
+	}
+
+	// transactionIndexes records the positions of the conditional items in a workflow transaction,
+	// -1 means the item is not in the transaction
+	transactionIndexes struct {
+		current  int
+		mutated  int
+		inserted int
+		reset    int
+		shard    int
+	}
+)
+
+func newTransactionIndexes() *transactionIndexes {
+	return &transactionIndexes{
+		current:  -1,
+		mutated:  -1,
+		inserted: -1,
+		reset:    -1,
+		shard:    -1,
+	}
+}
+
+func (db *ddb) createOrUpdateCurrentWorkflow(
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) (*dynamodb.TransactWriteItem, error) {
+	it := primaryKey(shardKey(shardID), workflowKey(domainID, workflowID))
+	it[attrRunID] = stringAttr(request.Row.RunID)
+	it[attrCreateRequestID] = stringAttr(request.Row.CreateRequestID)
+	it[attrState] = numberAttr(int64(request.Row.State))
+	it[attrCloseStatus] = numberAttr(int64(request.Row.CloseStatus))
+	it[attrLastWriteVersion] = numberAttr(request.Row.LastWriteVersion)
+
+	b := newExpressionBuilder()
+	var condition string
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil, nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		condition = "attribute_not_exists(" + attrPK + ")"
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return nil, fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		condition = b.name(attrRunID) + " = " + b.value(stringAttr(*request.Condition.CurrentRunID))
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			condition += " AND " + b.name(attrLastWriteVersion) + " = " + b.value(numberAttr(*request.Condition.LastWriteVersion))
+			condition += " AND " + b.name(attrState) + " = " + b.value(numberAttr(int64(*request.Condition.State)))
+		}
+	default:
+		return nil, fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+	return &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName:                 aws.String(db.tableName(tableCurrentExecutions)),
+			Item:                      it,
+			ConditionExpression:       aws.String(condition),
+			ExpressionAttributeNames:  b.attributeNames(),
+			ExpressionAttributeValues: b.attributeValues(),
+		},
+	}, nil
+}
+
+func (db *ddb) createWorkflowExecutionWithMergeMaps(
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) ([]*dynamodb.TransactWriteItem, error) {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return nil, fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	it, err := newExecutionItem(shardID, execution)
+	if err != nil {
+		return nil, err
+	}
+	mapItems, err := db.putExecutionMaps(shardID, execution)
+	if err != nil {
+		return nil, err
+	}
+	// the execution item must be the first one, the failure of its condition is looked up by its position
+	items := []*dynamodb.TransactWriteItem{{
+		Put: &dynamodb.Put{
+			TableName:           aws.String(db.tableName(tableExecutions)),
+			Item:                it,
+			ConditionExpression: aws.String("attribute_not_exists(" + attrPK + ")"),
+		},
+	}}
+	return append(items, mapItems...), nil
+}
+
+func (db *ddb) resetWorkflowExecutionAndMapsAndEventBuffer(
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) ([]*dynamodb.TransactWriteItem, error) {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return nil, fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+	// overriding the execution item clears the event buffer, and overriding the map items resets all the maps
+	it, err := newExecutionItem(shardID, execution)
+	if err != nil {
+		return nil, err
+	}
+	mapItems, err := db.putExecutionMaps(shardID, execution)
+	if err != nil {
+		return nil, err
+	}
+	b := newExpressionBuilder()
+	items := []*dynamodb.TransactWriteItem{{
+		Put: &dynamodb.Put{
+			TableName:                 aws.String(db.tableName(tableExecutions)),
+			Item:                      it,
+			ConditionExpression:       aws.String(nextEventIDCondition(b, execution)),
+			ExpressionAttributeNames:  b.attributeNames(),
+			ExpressionAttributeValues: b.attributeValues(),
+		},
+	}}
+	return append(items, mapItems...), nil
+}
+
+func (db *ddb) updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) ([]*dynamodb.TransactWriteItem, error) {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	b := newExpressionBuilder()
+	data, err := newExecutionData(execution)
+	if err != nil {
+		return nil, err
+	}
+	b.set(b.name(attrData), b.value(data))
+	b.set(b.name(attrNextEventID), b.value(numberAttr(execution.NextEventID)))
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		b.set(b.name(attrBufferedEvents), b.value(listAttr(nil)))
+	case nosqlplugin.EventBufferWriteModeAppend:
+		event, err := jsonAttr(execution.NewBufferedEventBatch)
+		if err != nil {
+			return nil, err
+		}
+		b.set(
+			b.name(attrBufferedEvents),
+			fmt.Sprintf("list_append(%v, %v)", b.name(attrBufferedEvents), b.value(listAttr([]*dynamodb.AttributeValue{event}))),
+		)
+	}
+
+	// the execution item must be the first one, the failure of its condition is looked up by its position
+	items := []*dynamodb.TransactWriteItem{{
+		Update: &dynamodb.Update{
+			TableName:                 aws.String(db.tableName(tableExecutions)),
+			Key:                       primaryKey(shardKey(shardID), executionKey(execution.DomainID, execution.WorkflowID, execution.RunID)),
+			UpdateExpression:          b.updateExpression(),
+			ConditionExpression:       aws.String(nextEventIDCondition(b, execution)),
+			ExpressionAttributeNames:  b.attributeNames(),
+			ExpressionAttributeValues: b.attributeValues(),
+		},
+	}}
+
+	maps, err := newExecutionMaps(execution)
+	if err != nil {
+		return nil, err
+	}
+	deletes := map[string][]string{
+		attrActivityMap:      formatInt64Keys(execution.ActivityInfoKeysToDelete),
+		attrTimerMap:         execution.TimerInfoKeysToDelete,
+		attrChildMap:         formatInt64Keys(execution.ChildWorkflowInfoKeysToDelete),
+		attrRequestCancelMap: formatInt64Keys(execution.RequestCancelInfoKeysToDelete),
+		attrSignalMap:        formatInt64Keys(execution.SignalInfoKeysToDelete),
+		attrSignalRequested:  execution.SignalRequestedIDsKeysToDelete,
+	}
+	for _, attribute := range executionMapAttributes {
+		values := maps[attribute]
+		if len(values) == 0 && len(deletes[attribute]) == 0 {
+			continue
+		}
+		mb := newExpressionBuilder()
+		// sort the keys so that the expression is deterministic
+		for _, key := range sortedKeys(values) {
+			mb.set(mb.path(attrEntries, key), mb.value(values[key]))
+		}
+		for _, key := range deletes[attribute] {
+			mb.remove(mb.path(attrEntries, key))
+		}
+		items = append(items, &dynamodb.TransactWriteItem{
+			Update: &dynamodb.Update{
+				TableName:                 aws.String(db.tableName(tableExecutionMaps)),
+				Key:                       primaryKey(shardKey(shardID), executionMapKey(execution.DomainID, execution.WorkflowID, execution.RunID, attribute)),
+				UpdateExpression:          mb.updateExpression(),
+				ExpressionAttributeNames:  mb.attributeNames(),
+				ExpressionAttributeValues: mb.attributeValues(),
+			},
+		})
+	}
+	return items, nil
+}
+
+func nextEventIDCondition(b *expressionBuilder, execution *nosqlplugin.WorkflowExecutionRequest) string {
+	return b.name(attrNextEventID) + " = " + b.value(numberAttr(*execution.PreviousNextEventIDCondition))
+}
+
+func newExecutionData(execution *nosqlplugin.WorkflowExecutionRequest) (*dynamodb.AttributeValue, error) {
+	return jsonAttr(&executionData{
+		ExecutionInfo:    &execution.InternalWorkflowExecutionInfo,
+		VersionHistories: execution.VersionHistories,
+		Checksums:        execution.Checksums,
+		LastWriteVersion: execution.LastWriteVersion,
+	})
+}
+
+// newExecutionItem returns an execution item with an empty event buffer, the maps are stored by putExecutionMaps
+func newExecutionItem(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) (item, error) {
+	data, err := newExecutionData(execution)
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(shardKey(shardID), executionKey(execution.DomainID, execution.WorkflowID, execution.RunID))
+	it[attrRunID] = stringAttr(execution.RunID)
+	it[attrNextEventID] = numberAttr(execution.NextEventID)
+	it[attrData] = data
+	it[attrBufferedEvents] = listAttr(nil)
+	return it, nil
+}
+
+// putExecutionMaps returns the items overriding all the maps of an execution
+func (db *ddb) putExecutionMaps(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) ([]*dynamodb.TransactWriteItem, error) {
+	maps, err := newExecutionMaps(execution)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*dynamodb.TransactWriteItem, 0, len(executionMapAttributes))
+	for _, attribute := range executionMapAttributes {
+		it := primaryKey(shardKey(shardID), executionMapKey(execution.DomainID, execution.WorkflowID, execution.RunID, attribute))
+		it[attrEntries] = mapAttr(maps[attribute])
+		items = append(items, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableExecutionMaps)),
+				Item:      it,
+			},
+		})
+	}
+	return items, nil
+}
+
+// executionMapKeys returns the keys of the map items of an execution
+func executionMapKeys(shardID int, domainID, workflowID, runID string) []item {
+	keys := make([]item, 0, len(executionMapAttributes))
+	for _, attribute := range executionMapAttributes {
+		keys = append(keys, primaryKey(shardKey(shardID), executionMapKey(domainID, workflowID, runID, attribute)))
+	}
+	return keys
+}
+
+// newExecutionMaps converts the six maps of an execution to the map attributes
+func newExecutionMaps(execution *nosqlplugin.WorkflowExecutionRequest) (map[string]item, error) {
+	maps := map[string]item{
+		attrActivityMap:      make(item),
+		attrTimerMap:         make(item),
+		attrChildMap:         make(item),
+		attrRequestCancelMap: make(item),
+		attrSignalMap:        make(item),
+		attrSignalRequested:  make(item),
+	}
+	put := func(attribute, key string, value interface{}) error {
+		v, err := jsonAttr(value)
+		if err != nil {
+			return err
+		}
+		maps[attribute][key] = v
+		return nil
+	}
+	for id, info := range execution.ActivityInfos {
+		if err := put(attrActivityMap, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.TimerInfos {
+		if err := put(attrTimerMap, id, info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.ChildWorkflowInfos {
+		if err := put(attrChildMap, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.RequestCancelInfos {
+		if err := put(attrRequestCancelMap, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for id, info := range execution.SignalInfos {
+		if err := put(attrSignalMap, strconv.FormatInt(id, 10), info); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		maps[attrSignalRequested][id] = &dynamodb.AttributeValue{BOOL: aws.Bool(true)}
+	}
+	return maps, nil
+}
+
+func formatInt64Keys(ids []int64) []string {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, strconv.FormatInt(id, 10))
+	}
+	return keys
+}
+
+func sortedKeys(values item) []string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseWorkflowExecution decodes an execution item and its map items, keyed by the map attributes
+func parseWorkflowExecution(it item, mapItems map[string]item) (*nosqlplugin.WorkflowExecution, error) {
+	data := &executionData{}
+	if err := getJSON(it, attrData, data); err != nil {
+		return nil, err
+	}
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       data.ExecutionInfo,
+		VersionHistories:    data.VersionHistories,
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+	}
+	if data.Checksums != nil {
+		state.Checksum = *data.Checksums
+	}
+
+	for key, value := range getMap(mapItems[attrActivityMap], attrEntries) {
+		info := &persistence.InternalActivityInfo{}
+		if err := parseMapValue(key, value, info, func(id int64) { state.ActivityInfos[id] = info }); err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range getMap(mapItems[attrTimerMap], attrEntries) {
+		info := &persistence.TimerInfo{}
+		if err := getJSON(item{attrData: value}, attrData, info); err != nil {
+			return nil, err
+		}
+		state.TimerInfos[key] = info
+	}
+	for key, value := range getMap(mapItems[attrChildMap], attrEntries) {
+		info := &persistence.InternalChildExecutionInfo{}
+		if err := parseMapValue(key, value, info, func(id int64) { state.ChildExecutionInfos[id] = info }); err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range getMap(mapItems[attrRequestCancelMap], attrEntries) {
+		info := &persistence.RequestCancelInfo{}
+		if err := parseMapValue(key, value, info, func(id int64) { state.RequestCancelInfos[id] = info }); err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range getMap(mapItems[attrSignalMap], attrEntries) {
+		info := &persistence.SignalInfo{}
+		if err := parseMapValue(key, value, info, func(id int64) { state.SignalInfos[id] = info }); err != nil {
+			return nil, err
+		}
+	}
+	for key := range getMap(mapItems[attrSignalRequested], attrEntries) {
+		state.SignalRequestedIDs[key] = struct{}{}
+	}
+
+	var bufferedEvents []*dynamodb.AttributeValue
+	if v, ok := it[attrBufferedEvents]; ok {
+		bufferedEvents = v.L
+	}
+	state.BufferedEvents = make([]*persistence.DataBlob, 0, len(bufferedEvents))
+	for _, value := range bufferedEvents {
+		blob := &persistence.DataBlob{}
+		if err := getJSON(item{attrData: value}, attrData, blob); err != nil {
+			return nil, err
+		}
+		state.BufferedEvents = append(state.BufferedEvents, blob)
+	}
+	return state, nil
+}
+
+// parseMapValue decodes a value of a map keyed by int64
+func parseMapValue(key string, value *dynamodb.AttributeValue, info interface{}, add func(int64)) error {
+	id, err := strconv.ParseInt(key, 10, 64)
+	if err != nil {
+		return err
+	}
+	if err := getJSON(item{attrData: value}, attrData, info); err != nil {
+		return err
+	}
+	add(id)
+	return nil
+}
+
+func getMap(it item, attribute string) item {
+	if v, ok := it[attribute]; ok {
+		return v.M
+	}
+	return nil
+}
+
+func parseCurrentWorkflowRow(shardID int, it item) *nosqlplugin.CurrentWorkflowRow {
+	domainID, workflowID := splitWorkflowKey(getString(it, attrSK))
+	state, _ := getInt64(it, attrState)
+	closeStatus, _ := getInt64(it, attrCloseStatus)
+	lastWriteVersion, err := getInt64(it, attrLastWriteVersion)
+	if err != nil {
+		lastWriteVersion = common.EmptyVersion
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            getString(it, attrRunID),
+		CreateRequestID:  getString(it, attrCreateRequestID),
+		State:            int(state),
+		CloseStatus:      int(closeStatus),
+		LastWriteVersion: lastWriteVersion,
+	}
+}
+
+// splitWorkflowKey returns the domainID and workflowID of a workflowKey,
+// domainID is a UUID so it never contains the separator
+func splitWorkflowKey(key string) (string, string) {
+	parts := strings.SplitN(key, keySeparator, 2)
+	if len(parts) != 2 {
+		return key, ""
+	}
+	return parts[0], parts[1]
+}
+
+func (db *ddb) createTransferTasks(
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+) ([]*dynamodb.TransactWriteItem, error) {
+	var items []*dynamodb.TransactWriteItem
+	for _, task := range transferTasks {
+		it, err := newTaskItem(shardKey(shardID), encodeInt64(task.TaskID), task)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, db.putTask(tableTransferTasks, it))
+	}
+	return items, nil
+}
+
+func (db *ddb) createCrossClusterTasks(
+	shardID int,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+) ([]*dynamodb.TransactWriteItem, error) {
+	var items []*dynamodb.TransactWriteItem
+	for _, task := range crossClusterTasks {
+		it, err := newTaskItem(shardClusterKey(shardID, task.TargetCluster), encodeInt64(task.TaskID), &task.TransferTask)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, db.putTask(tableCrossClusterTasks, it))
+	}
+	return items, nil
+}
+
+func (db *ddb) createReplicationTasks(
+	shardID int,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+) ([]*dynamodb.TransactWriteItem, error) {
+	var items []*dynamodb.TransactWriteItem
+	for _, task := range replicationTasks {
+		it, err := newTaskItem(shardKey(shardID), encodeInt64(task.TaskID), task)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, db.putTask(tableReplicationTasks, it))
+	}
+	return items, nil
+}
+
+func (db *ddb) createTimerTasks(
+	shardID int,
+	timerTasks []*nosqlplugin.TimerTask,
+) ([]*dynamodb.TransactWriteItem, error) {
+	var items []*dynamodb.TransactWriteItem
+	for _, task := range timerTasks {
+		it, err := newTaskItem(shardKey(shardID), timerTaskKey(task.VisibilityTimestamp, task.TaskID), task)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, db.putTask(tableTimerTasks, it))
+	}
+	return items, nil
+}
+
+func (db *ddb) putTask(table string, it item) *dynamodb.TransactWriteItem {
+	return &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(table)),
+			Item:      it,
+		},
+	}
+}
+
+func newTaskItem(pk, sk string, task interface{}) (item, error) {
+	data, err := jsonAttr(task)
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(pk, sk)
+	it[attrData] = data
+	return it, nil
+}
+
+// taskIDRange returns the sort key range of (exclusiveMinTaskID, inclusiveMaxTaskID],
+// ok is false if the range is empty
+func taskIDRange(exclusiveMinTaskID, inclusiveMaxTaskID int64) (lower string, upper string, ok bool) {
+	if exclusiveMinTaskID >= inclusiveMaxTaskID {
+		return "", "", false
+	}
+	return encodeInt64(exclusiveMinTaskID + 1), encodeInt64(inclusiveMaxTaskID), true
+}
+
+// newRangeQuery returns a query of the items in a partition whose sort keys are within [lower, upper]
+func (db *ddb) newRangeQuery(table, pk, lower, upper string) *dynamodb.QueryInput {
+	b := newExpressionBuilder()
+	condition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		b.name(attrPK), b.value(stringAttr(pk)),
+		b.name(attrSK), b.value(stringAttr(lower)), b.value(stringAttr(upper)))
+	return &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(table)),
+		KeyConditionExpression:    aws.String(condition),
+		ExpressionAttributeNames:  b.attributeNames(),
+		ExpressionAttributeValues: b.attributeValues(),
+	}
+}
+
+// newPartitionQuery returns a query of all the items in a partition
+func (db *ddb) newPartitionQuery(table, pk string) *dynamodb.QueryInput {
+	b := newExpressionBuilder()
+	condition := b.name(attrPK) + " = " + b.value(stringAttr(pk))
+	return &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(table)),
+		KeyConditionExpression:    aws.String(condition),
+		ExpressionAttributeNames:  b.attributeNames(),
+		ExpressionAttributeValues: b.attributeValues(),
+	}
+}
+
+// selectTasks reads the tasks within [lower, upper] of a partition and decodes them by newTask
+func (db *ddb) selectTasks(
+	ctx context.Context,
+	table, pk, lower, upper string,
+	pageSize int,
+	pageToken []byte,
+	newTask func() interface{},
+) ([]interface{}, []byte, error) {
+	items, nextPageToken, err := db.queryPage(ctx, db.newRangeQuery(table, pk, lower, upper), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]interface{}, 0, len(items))
+	for _, it := range items {
+		task := newTask()
+		if err := getJSON(it, attrData, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *ddb) selectTransferTasks(
+	ctx context.Context,
+	table, pk string,
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID, inclusiveMaxTaskID int64,
+) ([]*nosqlplugin.TransferTask, []byte, error) {
+	lower, upper, ok := taskIDRange(exclusiveMinTaskID, inclusiveMaxTaskID)
+	if !ok {
+		return nil, nil, nil
+	}
+	results, nextPageToken, err := db.selectTasks(ctx, table, pk, lower, upper, pageSize, pageToken, func() interface{} {
+		return &nosqlplugin.TransferTask{}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TransferTask, 0, len(results))
+	for _, t := range results {
+		tasks = append(tasks, t.(*nosqlplugin.TransferTask))
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *ddb) selectReplicationTasks(
+	ctx context.Context,
+	table, pk string,
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID, inclusiveMaxTaskID int64,
+) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	lower, upper, ok := taskIDRange(exclusiveMinTaskID, inclusiveMaxTaskID)
+	if !ok {
+		return nil, nil, nil
+	}
+	results, nextPageToken, err := db.selectTasks(ctx, table, pk, lower, upper, pageSize, pageToken, func() interface{} {
+		return &nosqlplugin.ReplicationTask{}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.ReplicationTask, 0, len(results))
+	for _, t := range results {
+		tasks = append(tasks, t.(*nosqlplugin.ReplicationTask))
+	}
+	return tasks, nextPageToken, nil
+}
+
+// rangeDeleteTasks deletes the tasks within (exclusiveMinTaskID, inclusiveMaxTaskID] of a partition
+func (db *ddb) rangeDeleteTasks(ctx context.Context, table, pk string, exclusiveMinTaskID, inclusiveMaxTaskID int64) error {
+	lower, upper, ok := taskIDRange(exclusiveMinTaskID, inclusiveMaxTaskID)
+	if !ok {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, table, db.newRangeQuery(table, pk, lower, upper), 0)
+	return err
+}
+
+func (db *ddb) convertCreateWorkflowFailures(
+	failures map[int]item,
+	indexes *transactionIndexes,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	if previous, failed := failures[indexes.shard]; failed {
+		if rangeID, err := getInt64(previous, attrRangeID); err == nil && rangeID != shardCondition.RangeID {
+			// CreateWorkflowExecution failed because rangeID was modified
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				ShardRangeIDNotMatch: common.Int64Ptr(rangeID),
+			}
+		}
+	}
+
+	if previous, failed := failures[indexes.current]; failed {
+		columns := describeItem(previous)
+		if previous != nil {
+			current := parseCurrentWorkflowRow(shardCondition.ShardID, previous)
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v, columns: (%v)",
+				execution.WorkflowID, current.RunID, shardCondition.RangeID, columns)
+			if currentWorkflowRequest.WriteMode == nosqlplugin.CurrentWorkflowWriteModeInsert {
+				// CreateWorkflowExecution failed because it already exists
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+						OtherInfo:        msg,
+						CreateRequestID:  current.CreateRequestID,
+						RunID:            current.RunID,
+						State:            current.State,
+						CloseStatus:      current.CloseStatus,
+						LastWriteVersion: current.LastWriteVersion,
+					},
+				}
+			}
+			if current.RunID != currentWorkflowRequest.Condition.GetCurrentRunID() {
+				// currentRunID on previous run has been changed, return to caller to handle
+				msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+					execution.WorkflowID, currentWorkflowRequest.Condition.GetCurrentRunID(), current.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+		}
+		msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, CurrentRunID: %v, columns: (%v)",
+			execution.WorkflowID, execution.RunID, columns)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+
+	if previous, failed := failures[indexes.inserted]; failed {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			execution.WorkflowID, execution.RunID, shardCondition.RangeID)
+		lastWriteVersion := common.EmptyVersion
+		data := &executionData{}
+		if err := getJSON(previous, attrData, data); err == nil {
+			lastWriteVersion = data.LastWriteVersion
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: lastWriteVersion,
+			},
+		}
+	}
+
+	return newUnknownConditionFailureReason(shardCondition.RangeID, failures)
+}
+
+func (db *ddb) convertUpdateWorkflowFailures(
+	failures map[int]item,
+	indexes *transactionIndexes,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previousNextEventIDCondition int64,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	requestConditionalRunID := currentWorkflowRequest.Condition.GetCurrentRunID()
+
+	if previous, failed := failures[indexes.shard]; failed {
+		if actualRangeID, err := getInt64(previous, attrRangeID); err == nil && actualRangeID != shardCondition.RangeID {
+			// UpdateWorkflowExecution failed because rangeID was modified
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID),
+			}
+		}
+	}
+
+	actualNextEventID := int64(0)
+	nextEventIDUnmatch := false
+	for _, index := range []int{indexes.mutated, indexes.reset} {
+		if previous, failed := failures[index]; failed {
+			actualNextEventID, _ = getInt64(previous, attrNextEventID)
+			if actualNextEventID != previousNextEventIDCondition {
+				// UpdateWorkflowExecution failed because next event ID is unexpected
+				nextEventIDUnmatch = true
+			}
+		}
+	}
+
+	actualCurrRunID := ""
+	if previous, failed := failures[indexes.current]; failed {
+		if actualCurrRunID = getString(previous, attrRunID); actualCurrRunID != requestConditionalRunID {
+			// UpdateWorkflowExecution failed because current_run_id is unexpected
+			msg := fmt.Sprintf("Failed to update mutable state.  Request Condition: %v, Actual Value: %v, Request Current RunID: %v, Actual Value: %v",
+				previousNextEventIDCondition, actualNextEventID, requestConditionalRunID, actualCurrRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	}
+
+	if nextEventIDUnmatch {
+		msg := fmt.Sprintf("Failed to update mutable state.  Request Condition: %v, Actual Value: %v, Request Current RunID: %v, Actual Value: %v",
+			previousNextEventIDCondition, actualNextEventID, requestConditionalRunID, actualCurrRunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	// At this point we only know that the write was not applied.
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, Condition: %v, Request Current RunID: %v, columns: (%v)",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, describeFailures(failures))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func newUnknownConditionFailureReason(
+	rangeID int64,
+	failures map[int]item,
+) *nosqlplugin.WorkflowOperationConditionFailure {
+	// At this point we only know that the write was not applied.
+	// It's much safer to return ShardOwnershipLostError as the default to force the application to reload
+	// shard to recover from such errors
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, columns: (%v)",
+		rangeID, describeFailures(failures))
+
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+// describeFailures returns a string of the failed items of a transaction, ordered by their positions
+func describeFailures(failures map[int]item) string {
+	indexes := make([]int, 0, len(failures))
+	for index := range failures {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	var columns []string
+	for _, index := range indexes {
+		columns = append(columns, fmt.Sprintf("%v: %v", index, describeItem(failures[index])))
+	}
+	return strings.Join(columns, ",")
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type fakeTransactionClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	transactions [][]*dynamodb.TransactWriteItem
+	// failAt is the index of the transaction whose first item fails on the condition, -1 means no failure
+	failAt int
+}
+
+func (c *fakeTransactionClient) TransactWriteItemsWithContext(
+	_ aws.Context,
+	input *dynamodb.TransactWriteItemsInput,
+	_ ...request.Option,
+) (*dynamodb.TransactWriteItemsOutput, error) {
+	c.transactions = append(c.transactions, input.TransactItems)
+	if len(c.transactions)-1 != c.failAt {
+		return &dynamodb.TransactWriteItemsOutput{}, nil
+	}
+	reasons := make([]*dynamodb.CancellationReason, len(input.TransactItems))
+	for i := range reasons {
+		reasons[i] = &dynamodb.CancellationReason{Code: aws.String("None")}
+	}
+	reasons[0] = &dynamodb.CancellationReason{
+		Code: aws.String("ConditionalCheckFailed"),
+		Item: item{attrRangeID: numberAttr(2)},
+	}
+	return nil, &dynamodb.TransactionCanceledException{CancellationReasons: reasons}
+}
+
+func newTestTransactionDB(failAt int) (*ddb, *fakeTransactionClient) {
+	client := &fakeTransactionClient{failAt: failAt}
+	return newDynamoDBFromClient(&config.NoSQL{}, client, log.NewNoop()), client
+}
+
+func newTestTransferTasks(count int) []*nosqlplugin.TransferTask {
+	tasks := make([]*nosqlplugin.TransferTask, 0, count)
+	for i := 0; i < count; i++ {
+		tasks = append(tasks, &nosqlplugin.TransferTask{TaskID: int64(i)})
+	}
+	return tasks
+}
+
+func TestTransactWriteWithTasks(t *testing.T) {
+	db, client := newTestTransactionDB(-1)
+	taskItems, err := db.createTransferTasks(1, newTestTransferTasks(10))
+	require.NoError(t, err)
+	items := []*dynamodb.TransactWriteItem{db.shardCondition(&nosqlplugin.ShardCondition{ShardID: 1, RangeID: 1})}
+
+	failures, err := db.transactWriteWithTasks(context.Background(), items, taskItems)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	require.Len(t, client.transactions, 1)
+	assert.Len(t, client.transactions[0], 11)
+}
+
+func TestTransactWriteWithTasks_ItemCountLimit(t *testing.T) {
+	db, client := newTestTransactionDB(-1)
+	taskItems, err := db.createTransferTasks(1, newTestTransferTasks(maxTransactionItems))
+	require.NoError(t, err)
+	items := []*dynamodb.TransactWriteItem{
+		{Put: &dynamodb.Put{Item: primaryKey("1", "execution")}},
+		db.shardCondition(&nosqlplugin.ShardCondition{ShardID: 1, RangeID: 1}),
+	}
+
+	_, err = db.transactWriteWithTasks(context.Background(), items, taskItems)
+	assert.IsType(t, &persistence.TransactionSizeLimitError{}, err)
+	// none of the tasks is written without the workflow write
+	assert.Empty(t, client.transactions)
+}
+
+func TestTransactWriteWithTasks_ShardConditionFailed(t *testing.T) {
+	db, client := newTestTransactionDB(0)
+	taskItems, err := db.createTransferTasks(1, newTestTransferTasks(10))
+	require.NoError(t, err)
+	items := []*dynamodb.TransactWriteItem{
+		db.shardCondition(&nosqlplugin.ShardCondition{ShardID: 1, RangeID: 1}),
+		{Put: &dynamodb.Put{Item: primaryKey("1", "execution")}},
+	}
+
+	failures, err := db.transactWriteWithTasks(context.Background(), items, taskItems)
+	require.NoError(t, err)
+	require.Contains(t, failures, 0)
+	rangeID, err := getInt64(failures[0], attrRangeID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rangeID)
+	assert.Len(t, client.transactions, 1)
+}
+
+func TestTransactWrite_ItemSizeLimit(t *testing.T) {
+	db, client := newTestTransactionDB(-1)
+	it := primaryKey("1", "execution")
+	it[attrData] = binaryAttr(make([]byte, maxItemSize))
+
+	_, err := db.transactWrite(context.Background(), []*dynamodb.TransactWriteItem{{Put: &dynamodb.Put{Item: it}}})
+	assert.IsType(t, &persistence.TransactionSizeLimitError{}, err)
+	assert.Empty(t, client.transactions)
+}
+
+func TestUpdateWorkflowExecution_MapItems(t *testing.T) {
+	db, _ := newTestTransactionDB(-1)
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:   "domain",
+			WorkflowID: "workflow",
+			RunID:      "run",
+		},
+		PreviousNextEventIDCondition: common.Int64Ptr(10),
+		MapsWriteMode:                nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{
+			5: {ScheduleID: 5},
+		},
+		TimerInfoKeysToDelete: []string{"timer"},
+	}
+
+	items, err := db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(1, execution)
+	require.NoError(t, err)
+	// the execution item and the items of the two changed maps
+	require.Len(t, items, 3)
+	assert.Equal(t, executionKey("domain", "workflow", "run"), getString(items[0].Update.Key, attrSK))
+	assert.NotNil(t, items[0].Update.ConditionExpression)
+	assert.Equal(t, executionMapKey("domain", "workflow", "run", attrActivityMap), getString(items[1].Update.Key, attrSK))
+	assert.True(t, strings.HasPrefix(aws.StringValue(items[1].Update.UpdateExpression), "SET "))
+	assert.Equal(t, executionMapKey("domain", "workflow", "run", attrTimerMap), getString(items[2].Update.Key, attrSK))
+	assert.True(t, strings.HasPrefix(aws.StringValue(items[2].Update.UpdateExpression), "REMOVE "))
+}
@@ -520,5 +520,7 @@ type (
 	ConfigStoreCRUD interface {
 		InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error
 		SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error)
+		// SelectConfigHistory returns up to maxCount of the most recent versions, the latest first
+		SelectConfigHistory(ctx context.Context, rowType int, maxCount int) ([]*persistence.InternalConfigStoreEntry, error)
 	}
 )
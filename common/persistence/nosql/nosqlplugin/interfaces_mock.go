@@ -789,6 +789,21 @@ func (mr *MockDBMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, pageToke
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MockDB)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectConfigHistory mocks base method.
+func (m *MockDB) SelectConfigHistory(ctx context.Context, rowType, maxCount int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigHistory", ctx, rowType, maxCount)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigHistory indicates an expected call of SelectConfigHistory.
+func (mr *MockDBMockRecorder) SelectConfigHistory(ctx, rowType, maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigHistory", reflect.TypeOf((*MockDB)(nil).SelectConfigHistory), ctx, rowType, maxCount)
+}
+
 // SelectCrossClusterTasksOrderByTaskID mocks base method.
 func (m *MockDB) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*CrossClusterTask, []byte, error) {
 	m.ctrl.T.Helper()
@@ -1819,6 +1834,21 @@ func (mr *MocktableCRUDMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MocktableCRUD)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectConfigHistory mocks base method.
+func (m *MocktableCRUD) SelectConfigHistory(ctx context.Context, rowType, maxCount int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigHistory", ctx, rowType, maxCount)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigHistory indicates an expected call of SelectConfigHistory.
+func (mr *MocktableCRUDMockRecorder) SelectConfigHistory(ctx, rowType, maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigHistory", reflect.TypeOf((*MocktableCRUD)(nil).SelectConfigHistory), ctx, rowType, maxCount)
+}
+
 // SelectCrossClusterTasksOrderByTaskID mocks base method.
 func (m *MocktableCRUD) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*CrossClusterTask, []byte, error) {
 	m.ctrl.T.Helper()
@@ -3509,6 +3539,21 @@ func (mr *MockConfigStoreCRUDMockRecorder) InsertConfig(ctx, row interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MockConfigStoreCRUD)(nil).InsertConfig), ctx, row)
 }
 
+// SelectConfigHistory mocks base method.
+func (m *MockConfigStoreCRUD) SelectConfigHistory(ctx context.Context, rowType, maxCount int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigHistory", ctx, rowType, maxCount)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigHistory indicates an expected call of SelectConfigHistory.
+func (mr *MockConfigStoreCRUDMockRecorder) SelectConfigHistory(ctx, rowType, maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigHistory", reflect.TypeOf((*MockConfigStoreCRUD)(nil).SelectConfigHistory), ctx, rowType, maxCount)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockConfigStoreCRUD) SelectLatestConfig(ctx context.Context, row_type int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -33,6 +33,11 @@ func convertCommonErrors(
 	operation string,
 	err error,
 ) error {
+	if _, ok := err.(*p.TransactionSizeLimitError); ok {
+		// the plugins return it when a write exceeds the limits of the database
+		return err
+	}
+
 	if errChecker.IsNotFoundError(err) {
 		return &types.EntityNotExistsError{
 			Message: fmt.Sprintf("%v failed. Error: %v ", operation, err),
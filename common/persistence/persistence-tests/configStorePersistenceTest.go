@@ -168,6 +168,31 @@ func (s *ConfigStorePersistenceSuite) TestFetchLatestVersionSuccess() {
 	s.Equal(int64(3), snapshot.Version)
 }
 
+func (s *ConfigStorePersistenceSuite) TestFetchHistorySuccess() {
+	if !validDatabaseCheck(s.Config()) {
+		s.T().Skip()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	s.DefaultTestCluster.TearDownTestDatabase()
+	s.DefaultTestCluster.SetupTestDatabase()
+
+	for version := int64(1); version <= 3; version++ {
+		err := s.UpdateDynamicConfig(ctx, generateRandomSnapshot(version))
+		s.Nil(err)
+	}
+
+	response, err := s.ConfigStoreManager.FetchDynamicConfigHistory(ctx, &p.FetchDynamicConfigHistoryRequest{PageSize: 2})
+	s.Nil(err)
+	s.Len(response.Snapshots, 2)
+	s.Equal(int64(3), response.Snapshots[0].Version)
+	s.Equal(int64(2), response.Snapshots[1].Version)
+	s.False(response.Snapshots[1].Timestamp.IsZero())
+	s.Equal("test_parameter", response.Snapshots[1].Values.Entries[0].Name)
+}
+
 func generateRandomSnapshot(version int64) *p.DynamicConfigSnapshot {
 	data, _ := json.Marshal("test_value")
 
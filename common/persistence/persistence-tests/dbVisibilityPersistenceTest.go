@@ -22,7 +22,9 @@ package persistencetests
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -840,7 +842,164 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 
 	for _, test := range tests {
-		s.Equal(test.expected, s.VisibilityMgr.UpsertWorkflowExecution(ctx, test.request))
+		expected := test.expected
+		if s.isSQLVisibility() { // SQL visibility stores search attributes
+			expected = nil
+		}
+		s.Equal(expected, s.VisibilityMgr.UpsertWorkflowExecution(ctx, test.request))
+	}
+}
+
+// TestListWorkflowExecutionsByQuery test
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	if !s.isSQLVisibility() {
+		// only SQL visibility supports queries without advanced visibility
+		return
+	}
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	var runIDs []string
+	for i := 0; i < 3; i++ {
+		workflowExecution := types.WorkflowExecution{
+			WorkflowID: fmt.Sprintf("visibility-query-workflow-%v", i),
+			RunID:      uuid.New(),
+		}
+		runIDs = append(runIDs, workflowExecution.RunID)
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        workflowExecution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime + int64(i)*int64(time.Second),
+			TaskList:         "visibility-tasklist",
+			SearchAttributes: map[string][]byte{
+				definition.CustomKeywordField: []byte(fmt.Sprintf(`"keyword-%v"`, i)),
+				definition.CustomIntField:     []byte(strconv.Itoa(i)),
+			},
+		})
+		s.Nil(err)
+	}
+	err := s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID: testDomainUUID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: "visibility-query-workflow-0",
+			RunID:      runIDs[0],
+		},
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		TaskList:         "visibility-tasklist",
+		SearchAttributes: map[string][]byte{
+			definition.CustomKeywordField: []byte(`"keyword-upserted"`),
+			definition.CustomIntField:     []byte(`10`),
+			definition.BinaryChecksums:    []byte(`["checksum-1","checksum-2"]`),
+		},
+	})
+	s.Nil(err)
+	err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID: testDomainUUID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: "visibility-query-workflow-1",
+			RunID:      runIDs[1],
+		},
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime + int64(time.Second),
+		Status:           types.WorkflowExecutionCloseStatusFailed,
+		CloseTimestamp:   time.Now().UnixNano(),
+		HistoryLength:    3,
+		TaskList:         "visibility-tasklist",
+		SearchAttributes: map[string][]byte{
+			definition.CustomKeywordField: []byte(`"keyword-1"`),
+			definition.CustomIntField:     []byte(`1`),
+		},
+	})
+	s.Nil(err)
+
+	testCases := []struct {
+		query  string
+		runIDs []string
+	}{
+		{query: "", runIDs: []string{runIDs[2], runIDs[1], runIDs[0]}},
+		{query: "CloseTime = missing", runIDs: []string{runIDs[2], runIDs[0]}},
+		{query: "CloseStatus = 'FAILED' and TaskList = 'visibility-tasklist'", runIDs: []string{runIDs[1]}},
+		{query: "Attr.CustomKeywordField = 'keyword-upserted'", runIDs: []string{runIDs[0]}},
+		{query: "Attr.CustomKeywordField = 'keyword-0'", runIDs: nil},
+		{query: "Attr.CustomIntField >= 2 order by Attr.CustomIntField", runIDs: []string{runIDs[2], runIDs[0]}},
+		{query: "Attr.BinaryChecksums = 'checksum-2' or WorkflowID = 'visibility-query-workflow-2'", runIDs: []string{runIDs[2], runIDs[0]}},
+		{query: "Attr.BinaryChecksums != 'checksum-2' order by WorkflowID desc", runIDs: []string{runIDs[2], runIDs[1]}},
+	}
+	for _, tc := range testCases {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID: testDomainUUID,
+			PageSize:   10,
+			Query:      tc.query,
+		})
+		s.Nil(err, tc.query)
+		var actualRunIDs []string
+		for _, execution := range resp.Executions {
+			actualRunIDs = append(actualRunIDs, execution.GetExecution().GetRunID())
+		}
+		s.Equal(tc.runIDs, actualRunIDs, tc.query)
+
+		countResp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+			DomainUUID: testDomainUUID,
+			Query:      tc.query,
+		})
+		s.Nil(err, tc.query)
+		s.Equal(int64(len(tc.runIDs)), countResp.Count, tc.query)
+	}
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "RunID = '" + runIDs[0] + "'",
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal("visibility-tasklist", resp.Executions[0].TaskList)
+	s.Equal([]byte(`"keyword-upserted"`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomKeywordField])
+	s.Equal([]byte(`10`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomIntField])
+
+	_, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "UnknownField = 'value'",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	// paginate through list and scan results
+	for _, listFn := range []func(context.Context, *p.ListWorkflowExecutionsByQueryRequest) (*p.ListWorkflowExecutionsResponse, error){
+		s.VisibilityMgr.ListWorkflowExecutions,
+		s.VisibilityMgr.ScanWorkflowExecutions,
+	} {
+		var actualRunIDs []string
+		var nextPageToken []byte
+		for {
+			resp, err := listFn(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+				DomainUUID:    testDomainUUID,
+				PageSize:      2,
+				NextPageToken: nextPageToken,
+				Query:         "WorkflowType = 'visibility-workflow'",
+			})
+			s.Nil(err)
+			for _, execution := range resp.Executions {
+				actualRunIDs = append(actualRunIDs, execution.GetExecution().GetRunID())
+			}
+			if nextPageToken = resp.NextPageToken; len(nextPageToken) == 0 {
+				break
+			}
+		}
+		s.ElementsMatch(runIDs, actualRunIDs)
+	}
+}
+
+func (s *DBVisibilityPersistenceSuite) isSQLVisibility() bool {
+	switch s.VisibilityMgr.GetName() {
+	case "mysql", "postgres", "sqlite":
+		return true
+	default:
+		return false
 	}
 }
 
@@ -2306,6 +2306,31 @@ func (p *configStoreErrorInjectionPersistenceClient) FetchDynamicConfig(ctx cont
 	return response, persistenceErr
 }
 
+func (p *configStoreErrorInjectionPersistenceClient) FetchDynamicConfigHistory(
+	ctx context.Context,
+	request *FetchDynamicConfigHistoryRequest,
+) (*FetchDynamicConfigHistoryResponse, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response *FetchDynamicConfigHistoryResponse
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.FetchDynamicConfigHistory(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationFetchDynamicConfigHistory,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
 func (p *configStoreErrorInjectionPersistenceClient) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) error {
 	fakeErr := generateFakeError(p.errorRate)
 
@@ -1757,6 +1757,23 @@ func (p *configStorePersistenceClient) FetchDynamicConfig(ctx context.Context) (
 	return result, err
 }
 
+func (p *configStorePersistenceClient) FetchDynamicConfigHistory(
+	ctx context.Context,
+	request *FetchDynamicConfigHistoryRequest,
+) (*FetchDynamicConfigHistoryResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceFetchDynamicConfigHistoryScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceFetchDynamicConfigHistoryScope, metrics.PersistenceLatency)
+	result, err := p.persistence.FetchDynamicConfigHistory(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceFetchDynamicConfigHistoryScope, metrics.PersistenceFailures)
+	}
+
+	return result, err
+}
+
 func (p *configStorePersistenceClient) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceRequests)
 
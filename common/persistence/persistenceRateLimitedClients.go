@@ -1205,6 +1205,17 @@ func (p *configStoreRateLimitedPersistenceClient) FetchDynamicConfig(ctx context
 	return p.persistence.FetchDynamicConfig(ctx)
 }
 
+func (p *configStoreRateLimitedPersistenceClient) FetchDynamicConfigHistory(
+	ctx context.Context,
+	request *FetchDynamicConfigHistoryRequest,
+) (*FetchDynamicConfigHistoryResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.FetchDynamicConfigHistory(ctx, request)
+}
+
 func (p *configStoreRateLimitedPersistenceClient) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
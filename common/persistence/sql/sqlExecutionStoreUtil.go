@@ -1105,6 +1105,9 @@ func createTimerTasks(
 		case *p.DeleteHistoryEventTask:
 			// noop
 
+		case *p.DeleteWorkflowExecutionTask:
+			// noop
+
 		default:
 			return &types.InternalServiceError{
 				Message: fmt.Sprintf("createTimerTasks failed. Unknown timer task: %v", task.GetType()),
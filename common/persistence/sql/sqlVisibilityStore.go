@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -45,9 +47,13 @@ type (
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
+		// Offset is only used to page through the results of ListWorkflowExecutions
+		Offset int
 	}
 )
 
+const defaultVisibilityQueryPageSize = 1000
+
 // NewSQLVisibilityStore creates an instance of ExecutionStore
 func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
@@ -66,68 +72,115 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest,
 ) error {
-	_, err := s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
-		DomainID:         request.DomainUUID,
-		WorkflowID:       request.WorkflowID,
-		RunID:            request.RunID,
-		StartTime:        request.StartTimestamp,
-		ExecutionTime:    request.ExecutionTimestamp,
-		WorkflowTypeName: request.WorkflowTypeName,
-		Memo:             request.Memo.Data,
-		Encoding:         string(request.Memo.GetEncoding()),
-		IsCron:           request.IsCron,
-	})
-
+	searchAttributes, searchAttributesRows, err := s.serializeSearchAttributes(request.DomainUUID, request.RunID, request.SearchAttributes)
 	if err != nil {
-		return convertCommonErrors(s.db, "RecordWorkflowExecutionStarted", "", err)
+		return err
 	}
-	return nil
+	return s.txExecute(ctx, "RecordWorkflowExecutionStarted", func(tx sqlplugin.Tx) error {
+		result, err := tx.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+			DomainID:         request.DomainUUID,
+			WorkflowID:       request.WorkflowID,
+			RunID:            request.RunID,
+			StartTime:        request.StartTimestamp,
+			ExecutionTime:    request.ExecutionTimestamp,
+			WorkflowTypeName: request.WorkflowTypeName,
+			Memo:             request.Memo.Data,
+			Encoding:         string(request.Memo.GetEncoding()),
+			TaskList:         request.TaskList,
+			IsCron:           request.IsCron,
+			SearchAttributes: searchAttributes,
+		})
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return &types.InternalServiceError{
+				Message: fmt.Sprintf("RecordWorkflowExecutionStarted rowsAffected error: %v", err),
+			}
+		}
+		if rowsAffected == 0 { // the execution is already recorded
+			return nil
+		}
+		return replaceSearchAttributes(ctx, tx, request.DomainUUID, request.RunID, searchAttributesRows)
+	})
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionClosedRequest,
 ) error {
+	searchAttributes, searchAttributesRows, err := s.serializeSearchAttributes(request.DomainUUID, request.RunID, request.SearchAttributes)
+	if err != nil {
+		return err
+	}
 	closeTime := request.CloseTimestamp
-	result, err := s.db.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+	return s.txExecute(ctx, "RecordWorkflowExecutionClosed", func(tx sqlplugin.Tx) error {
+		result, err := tx.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+			DomainID:         request.DomainUUID,
+			WorkflowID:       request.WorkflowID,
+			RunID:            request.RunID,
+			StartTime:        request.StartTimestamp,
+			ExecutionTime:    request.ExecutionTimestamp,
+			WorkflowTypeName: request.WorkflowTypeName,
+			CloseTime:        &closeTime,
+			CloseStatus:      common.Int32Ptr(int32(*thrift.FromWorkflowExecutionCloseStatus(&request.Status))),
+			HistoryLength:    &request.HistoryLength,
+			Memo:             request.Memo.Data,
+			Encoding:         string(request.Memo.GetEncoding()),
+			TaskList:         request.TaskList,
+			IsCron:           request.IsCron,
+			SearchAttributes: searchAttributes,
+		})
+		if err != nil {
+			return err
+		}
+		noRowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return &types.InternalServiceError{
+				Message: fmt.Sprintf("RecordWorkflowExecutionClosed rowsAffected error: %v", err),
+			}
+		}
+		if noRowsAffected > 2 { // either adds a new row or deletes old row and adds new row
+			return &types.InternalServiceError{
+				Message: fmt.Sprintf("RecordWorkflowExecutionClosed unexpected numRows (%v) updated", noRowsAffected),
+			}
+		}
+		return replaceSearchAttributes(ctx, tx, request.DomainUUID, request.RunID, searchAttributesRows)
+	})
+}
+
+func (s *sqlVisibilityStore) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalUpsertWorkflowExecutionRequest,
+) error {
+	searchAttributes, searchAttributesRows, err := s.serializeSearchAttributes(request.DomainUUID, request.RunID, request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	row := &sqlplugin.VisibilityRow{
 		DomainID:         request.DomainUUID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
 		StartTime:        request.StartTimestamp,
 		ExecutionTime:    request.ExecutionTimestamp,
 		WorkflowTypeName: request.WorkflowTypeName,
-		CloseTime:        &closeTime,
-		CloseStatus:      common.Int32Ptr(int32(*thrift.FromWorkflowExecutionCloseStatus(&request.Status))),
-		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
 		IsCron:           request.IsCron,
-	})
-	if err != nil {
-		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
+		SearchAttributes: searchAttributes,
 	}
-	noRowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return &types.InternalServiceError{
-			Message: fmt.Sprintf("RecordWorkflowExecutionClosed rowsAffected error: %v", err),
+	return s.txExecute(ctx, "UpsertWorkflowExecution", func(tx sqlplugin.Tx) error {
+		// the started record may not be written yet, in which case the upsert creates it
+		if _, err := tx.InsertIntoVisibility(ctx, row); err != nil {
+			return err
 		}
-	}
-	if noRowsAffected > 2 { // either adds a new row or deletes old row and adds new row
-		return &types.InternalServiceError{
-			Message: fmt.Sprintf("RecordWorkflowExecutionClosed unexpected numRows (%v) updated", noRowsAffected),
+		if _, err := tx.UpdateVisibility(ctx, row); err != nil {
+			return err
 		}
-	}
-	return nil
-}
-
-func (s *sqlVisibilityStore) UpsertWorkflowExecution(
-	ctx context.Context,
-	request *p.InternalUpsertWorkflowExecutionRequest,
-) error {
-	if p.IsNopUpsertWorkflowRequest(request) {
-		return nil
-	}
-	return p.NewOperationNotSupportErrorForVis()
+		return replaceSearchAttributes(ctx, tx, request.DomainUUID, request.RunID, searchAttributesRows)
+	})
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -280,35 +333,101 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *p.VisibilityDeleteWorkflowExecutionRequest,
 ) error {
-	_, err := s.db.DeleteFromVisibility(ctx, &sqlplugin.VisibilityFilter{
-		DomainID: request.DomainID,
-		RunID:    &request.RunID,
+	return s.txExecute(ctx, "DeleteWorkflowExecution", func(tx sqlplugin.Tx) error {
+		filter := &sqlplugin.VisibilityFilter{
+			DomainID: request.DomainID,
+			RunID:    &request.RunID,
+		}
+		if _, err := tx.DeleteFromVisibility(ctx, filter); err != nil {
+			return err
+		}
+		_, err := tx.DeleteFromVisibilitySearchAttributes(ctx, filter)
+		return err
 	})
-	if err != nil {
-		return convertCommonErrors(s.db, "DeleteWorkflowExecution", "", err)
-	}
-	return nil
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(
 	ctx context.Context,
 	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	token, err := s.deserializeQueryPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := getVisibilityQueryPageSize(request.PageSize)
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: pageSize,
+		Offset:   token.Offset,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "ListWorkflowExecutions", "", err)
+	}
+
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{Offset: token.Offset + len(rows)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.rowsToListResponse(rows, nextPageToken), nil
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
 	ctx context.Context,
 	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	token, err := s.deserializeQueryPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := getVisibilityQueryPageSize(request.PageSize)
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: pageSize,
+		RunID:    &token.RunID,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "ScanWorkflowExecutions", "", err)
+	}
+
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{RunID: rows[len(rows)-1].RunID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.rowsToListResponse(rows, nextPageToken), nil
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
 	ctx context.Context,
 	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	count, err := s.db.CountFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -321,9 +440,21 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 		TypeName:      row.WorkflowTypeName,
 		StartTime:     row.StartTime,
 		ExecutionTime: row.ExecutionTime,
+		TaskList:      row.TaskList,
 		IsCron:        row.IsCron,
 		Memo:          p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
 	}
+	if len(row.SearchAttributes) > 0 {
+		searchAttributes, err := deserializeSearchAttributes(row.SearchAttributes)
+		if err != nil {
+			s.logger.Error("failed to deserialize search attributes",
+				tag.WorkflowID(row.WorkflowID),
+				tag.WorkflowRunID(row.RunID),
+				tag.Error(err))
+		} else {
+			info.SearchAttributes = searchAttributes
+		}
+	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
 		info.Status = thrift.ToWorkflowExecutionCloseStatus(&status)
@@ -352,10 +483,6 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 		return &p.InternalListWorkflowExecutionsResponse{}, nil
 	}
 
-	var infos = make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
-	for i, row := range rows {
-		infos[i] = s.rowToInfo(&row)
-	}
 	var nextPageToken []byte
 	lastRow := rows[len(rows)-1]
 	lastStartTime := lastRow.StartTime
@@ -368,10 +495,29 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 			return nil, err
 		}
 	}
+	return s.rowsToListResponse(rows, nextPageToken), nil
+}
+
+func (s *sqlVisibilityStore) rowsToListResponse(rows []sqlplugin.VisibilityRow, nextPageToken []byte) *p.InternalListWorkflowExecutionsResponse {
+	var infos = make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i] = s.rowToInfo(&row)
+	}
 	return &p.InternalListWorkflowExecutionsResponse{
 		Executions:    infos,
 		NextPageToken: nextPageToken,
-	}, nil
+	}
+}
+
+func (s *sqlVisibilityStore) deserializeQueryPageToken(data []byte) (*visibilityPageToken, error) {
+	if len(data) == 0 {
+		return &visibilityPageToken{}, nil
+	}
+	token, err := s.deserializePageToken(data)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Unable to deserialize page token. err: %v", err)}
+	}
+	return token, nil
 }
 
 func (s *sqlVisibilityStore) deserializePageToken(data []byte) (*visibilityPageToken, error) {
@@ -384,3 +530,72 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+// serializeSearchAttributes returns the search attributes of an execution as stored in executions_visibility,
+// to be returned by list queries, and as the rows of executions_visibility_search_attributes used to filter on them
+func (s *sqlVisibilityStore) serializeSearchAttributes(
+	domainID string,
+	runID string,
+	searchAttributes map[string][]byte,
+) ([]byte, []sqlplugin.VisibilitySearchAttributesRow, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil, nil
+	}
+	fields := make(map[string]json.RawMessage, len(searchAttributes))
+	validSearchAttributes := make(map[string][]byte, len(searchAttributes))
+	for key, value := range searchAttributes {
+		if !json.Valid(value) {
+			s.logger.Warn("skip search attribute with invalid value",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowRunID(runID),
+				tag.Key(key))
+			continue
+		}
+		fields[key] = value
+		validSearchAttributes[key] = value
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, &types.BadRequestError{Message: fmt.Sprintf("Unable to serialize search attributes. err: %v", err)}
+	}
+	rows, err := sqlplugin.ToVisibilitySearchAttributesRows(domainID, runID, validSearchAttributes)
+	if err != nil {
+		return nil, nil, &types.BadRequestError{Message: err.Error()}
+	}
+	return data, rows, nil
+}
+
+func deserializeSearchAttributes(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var searchAttributes map[string]interface{}
+	err := decoder.Decode(&searchAttributes)
+	return searchAttributes, err
+}
+
+func replaceSearchAttributes(
+	ctx context.Context,
+	tx sqlplugin.Tx,
+	domainID string,
+	runID string,
+	rows []sqlplugin.VisibilitySearchAttributesRow,
+) error {
+	if _, err := tx.DeleteFromVisibilitySearchAttributes(ctx, &sqlplugin.VisibilityFilter{
+		DomainID: domainID,
+		RunID:    &runID,
+	}); err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	_, err := tx.InsertIntoVisibilitySearchAttributes(ctx, rows)
+	return err
+}
+
+func getVisibilityQueryPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return defaultVisibilityQueryPageSize
+	}
+	return pageSize
+}
@@ -536,7 +536,21 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		TaskList         string
 		IsCron           bool
+		SearchAttributes []byte
+	}
+
+	// VisibilitySearchAttributesRow represents a row in executions_visibility_search_attributes table,
+	// which holds one value of a custom search attribute of a workflow execution
+	VisibilitySearchAttributesRow struct {
+		DomainID    string
+		RunID       string
+		Name        string
+		ValueIndex  int
+		StringValue *string
+		IntValue    *int64
+		DoubleValue *float64
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -784,6 +798,18 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpdateVisibility updates the memo, task list and search attributes of an existing row in visibility table
+		UpdateVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns the rows of visibility table matching a visibility query
+		// Required filter params - {domainID, query, pageSize}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows of visibility table matching a visibility query
+		// Required filter params - {domainID, query}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
+		InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []VisibilitySearchAttributesRow) (sql.Result, error)
+		// DeleteFromVisibilitySearchAttributes deletes all search attribute values of an execution
+		// Required filter params - {domainID, runID}
+		DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
@@ -25,18 +25,22 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = ?, encoding = ?, task_list = ?, search_attributes = ? ` +
+		`WHERE domain_id = ? AND run_id = ?`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -72,6 +76,12 @@ const (
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateCreateSearchAttributes = `INSERT INTO executions_visibility_search_attributes (` +
+		`domain_id, run_id, name, value_index, string_value, int_value, double_value) ` +
+		`VALUES (:domain_id, :run_id, :name, :value_index, :string_value, :int_value, :double_value)`
+
+	templateDeleteSearchAttributes = "DELETE FROM executions_visibility_search_attributes WHERE domain_id=? AND run_id=?"
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -90,7 +100,9 @@ func (mdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.WorkflowTypeName,
 		row.Memo,
 		row.Encoding,
-		row.IsCron)
+		row.TaskList,
+		row.IsCron,
+		row.SearchAttributes)
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -112,7 +124,9 @@ func (mdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
-			row.IsCron)
+			row.TaskList,
+			row.IsCron,
+			row.SearchAttributes)
 	default:
 		return nil, errCloseParams
 	}
@@ -211,3 +225,61 @@ func (mdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// UpdateVisibility updates the memo, task list and search attributes of an existing row in visibility table
+func (mdb *db) UpdateVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.SearchAttributes,
+		row.DomainID,
+		row.RunID)
+}
+
+// SelectFromVisibilityByQuery reads the rows of visibility table matching a visibility query
+func (mdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, args := sqlplugin.BuildSelectFromVisibilityByQuery(filter)
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.conn.SelectContext(ctx, &rows, query, mdb.convertQueryArgs(args)...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows of visibility table matching a visibility query
+func (mdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, args := sqlplugin.BuildCountFromVisibilityByQuery(filter)
+	var count int64
+	err := mdb.conn.GetContext(ctx, &count, query, mdb.convertQueryArgs(args)...)
+	return count, err
+}
+
+// InsertIntoVisibilitySearchAttributes inserts one or more rows into executions_visibility_search_attributes table
+func (mdb *db) InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []sqlplugin.VisibilitySearchAttributesRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, templateCreateSearchAttributes, rows)
+}
+
+// DeleteFromVisibilitySearchAttributes deletes all the search attribute values of an execution
+func (mdb *db) DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, templateDeleteSearchAttributes, filter.DomainID, filter.RunID)
+}
+
+// convertQueryArgs converts the time values of a visibility query to mysql datetime
+func (mdb *db) convertQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = mdb.converter.ToMySQLDateTime(t)
+		}
+	}
+	return args
+}
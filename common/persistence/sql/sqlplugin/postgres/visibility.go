@@ -26,19 +26,22 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
          ON CONFLICT (domain_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		ON CONFLICT (domain_id, run_id) DO UPDATE
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -49,7 +52,12 @@ const (
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
 			  encoding = excluded.encoding,
-				is_cron = excluded.is_cron`
+			  task_list = excluded.task_list,
+				is_cron = excluded.is_cron,
+			  search_attributes = excluded.search_attributes`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = $1, encoding = $2, task_list = $3, search_attributes = $4 ` +
+		`WHERE domain_id = $5 AND run_id = $6`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
@@ -92,6 +100,12 @@ const (
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=$1 AND run_id=$2"
+
+	templateCreateSearchAttributes = `INSERT INTO executions_visibility_search_attributes (` +
+		`domain_id, run_id, name, value_index, string_value, int_value, double_value) ` +
+		`VALUES (:domain_id, :run_id, :name, :value_index, :string_value, :int_value, :double_value)`
+
+	templateDeleteSearchAttributes = "DELETE FROM executions_visibility_search_attributes WHERE domain_id=$1 AND run_id=$2"
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -109,7 +123,9 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.WorkflowTypeName,
 		row.Memo,
 		row.Encoding,
-		row.IsCron)
+		row.TaskList,
+		row.IsCron,
+		row.SearchAttributes)
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -130,7 +146,9 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
-			row.IsCron)
+			row.TaskList,
+			row.IsCron,
+			row.SearchAttributes)
 	default:
 		return nil, errCloseParams
 	}
@@ -229,3 +247,62 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// UpdateVisibility updates the memo, task list and search attributes of an existing row in visibility table
+func (pdb *db) UpdateVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx, templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.SearchAttributes,
+		row.DomainID,
+		row.RunID)
+}
+
+// SelectFromVisibilityByQuery reads the rows of visibility table matching a visibility query
+func (pdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, args := sqlplugin.BuildSelectFromVisibilityByQuery(filter)
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.conn.SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, query), pdb.convertQueryArgs(args)...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows of visibility table matching a visibility query
+func (pdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, args := sqlplugin.BuildCountFromVisibilityByQuery(filter)
+	var count int64
+	err := pdb.conn.GetContext(ctx, &count, sqlx.Rebind(sqlx.DOLLAR, query), pdb.convertQueryArgs(args)...)
+	return count, err
+}
+
+// InsertIntoVisibilitySearchAttributes inserts one or more rows into executions_visibility_search_attributes table
+func (pdb *db) InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []sqlplugin.VisibilitySearchAttributesRow) (sql.Result, error) {
+	return pdb.conn.NamedExecContext(ctx, templateCreateSearchAttributes, rows)
+}
+
+// DeleteFromVisibilitySearchAttributes deletes all the search attribute values of an execution
+func (pdb *db) DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx, templateDeleteSearchAttributes, filter.DomainID, filter.RunID)
+}
+
+// convertQueryArgs converts the time values of a visibility query to postgres timestamp
+func (pdb *db) convertQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = pdb.converter.ToPostgresDateTime(t)
+		}
+	}
+	return args
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/uber/cadence/common/config"
+	pt "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "sqlite"
+	// driverName is the name of the driver registered by go-sqlite3
+	driverName = "sqlite3"
+	dsnFmt     = "file:%v"
+	// inMemoryDatabase is used when connecting without a database name, e.g. to create or drop a database
+	inMemoryDatabase = ":memory:"
+)
+
+// dsnAttrDefaults are only applied if not specified in the connect attributes
+var dsnAttrDefaults = map[string]string{
+	"_busy_timeout": "10000",
+	"_journal_mode": "WAL",
+}
+
+// dsnAttrOverrides are always applied.
+// SQLite has no row level locks, all the write transactions are started with a database level
+// write lock so that the concurrent transactions are serialized instead of failing on commit
+var dsnAttrOverrides = map[string]string{
+	"_txlock": "immediate",
+}
+
+type plugin struct{}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{})
+}
+
+func buildDSN(cfg *config.SQL) string {
+	dbName := cfg.DatabaseName
+	if dbName == "" {
+		dbName = inMemoryDatabase
+	}
+	dsn := fmt.Sprintf(dsnFmt, dbName)
+	if attrs := buildDSNAttrs(cfg); attrs != "" {
+		dsn = dsn + "?" + attrs
+	}
+	return dsn
+}
+
+func buildDSNAttrs(cfg *config.SQL) string {
+	attrs := url.Values{}
+	for k, v := range dsnAttrDefaults {
+		attrs.Set(k, v)
+	}
+	for k, v := range cfg.ConnectAttributes {
+		attrs.Set(k, v)
+	}
+	for k, v := range dsnAttrOverrides {
+		attrs.Set(k, v)
+	}
+	return attrs.Encode()
+}
+
+const (
+	testSchemaDir = "schema/sqlite"
+)
+
+// GetTestClusterOption return test options
+func GetTestClusterOption() *pt.TestBaseOptions {
+	return &pt.TestBaseOptions{
+		DBPluginName: PluginName,
+		// each test cluster uses its own temp database file
+		DBName:    filepath.Join(os.TempDir(), "cadence_test_"+pt.GenerateRandomDBName(10)+".db"),
+		SchemaDir: testSchemaDir,
+		StoreType: config.StoreTypeSQL,
+	}
+}
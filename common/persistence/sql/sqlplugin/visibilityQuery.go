@@ -0,0 +1,661 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+const (
+	// VisibilityQueryFieldNames are the executions_visibility columns returned by SelectFromVisibilityByQuery
+	VisibilityQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_status, close_time, ` +
+		`history_length, memo, encoding, task_list, is_cron, search_attributes`
+
+	visibilityQueryDefaultOrderBy = `start_time DESC, run_id`
+	visibilityQueryScanOrderBy    = `run_id`
+
+	// custom search attributes live in executions_visibility_search_attributes, one row per value,
+	// so every condition on them is an EXISTS on the rows of the execution being filtered
+	templateSearchAttributeExists = `EXISTS (SELECT 1 FROM executions_visibility_search_attributes sa ` +
+		`WHERE sa.domain_id = executions_visibility.domain_id AND sa.run_id = executions_visibility.run_id AND sa.name = ?%s)`
+	templateSearchAttributeSortKey = `(SELECT %s(sa.%s) FROM executions_visibility_search_attributes sa ` +
+		`WHERE sa.domain_id = executions_visibility.domain_id AND sa.run_id = executions_visibility.run_id AND sa.name = ?) %s`
+
+	visibilityQueryMissingValue = "missing"
+)
+
+type (
+	// VisibilityQuery is a visibility query, as accepted by ListWorkflowExecutions,
+	// translated into conditions on the executions_visibility table.
+	// The translated SQL uses ? as bind variable, plugins that need a different
+	// bind variable style rebind the statements built from it.
+	VisibilityQuery struct {
+		where     string
+		whereArgs []interface{}
+		orderBy   string
+		orderArgs []interface{}
+	}
+
+	// VisibilityQueryFilter contains the parameters to select or count the rows of
+	// executions_visibility which match a visibility query
+	VisibilityQueryFilter struct {
+		DomainID string
+		Query    *VisibilityQuery
+		PageSize int
+		// Offset pages through the rows in the order requested by the query
+		Offset int
+		// RunID, if set, ignores the order requested by the query and returns
+		// the rows ordered by run_id, starting after the given run_id
+		RunID *string
+	}
+
+	visibilityColumnKind int
+
+	visibilityColumn struct {
+		name string
+		kind visibilityColumnKind
+	}
+
+	visibilityLiteralKind int
+
+	visibilityLiteral struct {
+		kind        visibilityLiteralKind
+		stringValue string
+		intValue    int64
+		doubleValue float64
+		boolValue   bool
+	}
+
+	visibilityQueryBuilder struct {
+		args []interface{}
+	}
+)
+
+const (
+	columnKindString visibilityColumnKind = iota
+	columnKindInt
+	columnKindBool
+	columnKindTime
+	columnKindCloseStatus
+)
+
+const (
+	literalKindString visibilityLiteralKind = iota
+	literalKindInt
+	literalKindDouble
+	literalKindBool
+)
+
+var visibilityQueryColumns = map[string]visibilityColumn{
+	definition.DomainID:      {name: "domain_id", kind: columnKindString},
+	definition.WorkflowID:    {name: "workflow_id", kind: columnKindString},
+	definition.RunID:         {name: "run_id", kind: columnKindString},
+	definition.WorkflowType:  {name: "workflow_type_name", kind: columnKindString},
+	definition.StartTime:     {name: "start_time", kind: columnKindTime},
+	definition.ExecutionTime: {name: "execution_time", kind: columnKindTime},
+	definition.CloseTime:     {name: "close_time", kind: columnKindTime},
+	definition.CloseStatus:   {name: "close_status", kind: columnKindCloseStatus},
+	definition.HistoryLength: {name: "history_length", kind: columnKindInt},
+	definition.TaskList:      {name: "task_list", kind: columnKindString},
+	definition.IsCron:        {name: "is_cron", kind: columnKindBool},
+}
+
+var errOnlyOneSortField = errors.New("only one field can be used to sort")
+
+// ParseVisibilityQuery translates a visibility query into SQL conditions on executions_visibility.
+// System search attributes map to columns of executions_visibility, custom search attributes
+// (prefixed with Attr. by the frontend query validator) to rows of executions_visibility_search_attributes.
+func ParseVisibilityQuery(query string) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &VisibilityQuery{}, nil
+	}
+
+	// IMPORTANT: this statement is never executed, it is just used to parse the query
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("select * from dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("select * from dummy where %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, errors.New("invalid select query")
+	}
+
+	result := &VisibilityQuery{}
+	if sel.Where != nil {
+		builder := &visibilityQueryBuilder{}
+		if result.where, err = builder.convertExpr(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+		result.whereArgs = builder.args
+	}
+	switch len(sel.OrderBy) {
+	case 0:
+	case 1:
+		builder := &visibilityQueryBuilder{}
+		if result.orderBy, err = builder.convertOrderBy(sel.OrderBy[0]); err != nil {
+			return nil, err
+		}
+		result.orderArgs = builder.args
+	default:
+		return nil, errOnlyOneSortField
+	}
+	return result, nil
+}
+
+// BuildSelectFromVisibilityByQuery returns the statement, and its arguments, that selects
+// a page of the rows of executions_visibility matching the filter
+func BuildSelectFromVisibilityByQuery(filter *VisibilityQueryFilter) (string, []interface{}) {
+	where, args := filter.conditions()
+	orderBy := visibilityQueryDefaultOrderBy
+	switch {
+	case filter.RunID != nil:
+		where += " AND run_id > ?"
+		args = append(args, *filter.RunID)
+		orderBy = visibilityQueryScanOrderBy
+	case filter.Query != nil && filter.Query.orderBy != "":
+		orderBy = filter.Query.orderBy
+		args = append(args, filter.Query.orderArgs...)
+	}
+	args = append(args, filter.PageSize, filter.Offset)
+	return fmt.Sprintf(`SELECT %s FROM executions_visibility WHERE %s ORDER BY %s LIMIT ? OFFSET ?`,
+		VisibilityQueryFieldNames, where, orderBy), args
+}
+
+// BuildCountFromVisibilityByQuery returns the statement, and its arguments, that counts
+// the rows of executions_visibility matching the filter
+func BuildCountFromVisibilityByQuery(filter *VisibilityQueryFilter) (string, []interface{}) {
+	where, args := filter.conditions()
+	return fmt.Sprintf(`SELECT COUNT(*) FROM executions_visibility WHERE %s`, where), args
+}
+
+// ToVisibilitySearchAttributesRows converts the search attributes of an execution into the rows of
+// executions_visibility_search_attributes. Values which are lists are stored as one row per element,
+// values which are neither strings, numbers, booleans nor lists of them are not searchable and skipped.
+func ToVisibilitySearchAttributesRows(
+	domainID string,
+	runID string,
+	searchAttributes map[string][]byte,
+) ([]VisibilitySearchAttributesRow, error) {
+	var rows []VisibilitySearchAttributesRow
+	for name, data := range searchAttributes {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("invalid value of search attribute %v: %v", name, err)
+		}
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		for i, v := range values {
+			row := VisibilitySearchAttributesRow{
+				DomainID:   domainID,
+				RunID:      runID,
+				Name:       name,
+				ValueIndex: i,
+			}
+			switch v := v.(type) {
+			case string:
+				row.StringValue = common.StringPtr(v)
+				if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+					row.IntValue = common.Int64Ptr(t.UnixNano())
+				}
+			case json.Number:
+				if i, err := v.Int64(); err == nil {
+					row.IntValue = common.Int64Ptr(i)
+				}
+				f, err := v.Float64()
+				if err != nil {
+					return nil, fmt.Errorf("invalid value of search attribute %v: %v", name, err)
+				}
+				row.DoubleValue = common.Float64Ptr(f)
+			case bool:
+				row.IntValue = common.Int64Ptr(boolToInt64(v))
+			default:
+				continue
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *VisibilityQueryFilter) conditions() (string, []interface{}) {
+	where := "domain_id = ?"
+	args := []interface{}{f.DomainID}
+	if f.Query != nil && f.Query.where != "" {
+		where += " AND " + f.Query.where
+		args = append(args, f.Query.whereArgs...)
+	}
+	return where, args
+}
+
+func (b *visibilityQueryBuilder) convertExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return b.convertBinaryExpr("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return b.convertBinaryExpr("OR", expr.Left, expr.Right)
+	case *sqlparser.NotExpr:
+		cond, err := b.convertExpr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("NOT (%s)", cond), nil
+	case *sqlparser.ParenExpr:
+		return b.convertExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return b.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return b.convertRangeCond(expr)
+	default:
+		return "", fmt.Errorf("unsupported expression: %v", sqlparser.String(expr))
+	}
+}
+
+func (b *visibilityQueryBuilder) convertBinaryExpr(op string, left, right sqlparser.Expr) (string, error) {
+	leftCond, err := b.convertExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightCond, err := b.convertExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftCond, op, rightCond), nil
+}
+
+func (b *visibilityQueryBuilder) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	column, attr, err := resolveVisibilityField(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	if column != nil {
+		return b.convertColumnComparison(column, expr.Operator, expr.Right)
+	}
+	return b.convertSearchAttributeComparison(attr, expr.Operator, expr.Right)
+}
+
+func (b *visibilityQueryBuilder) convertColumnComparison(column *visibilityColumn, op string, right sqlparser.Expr) (string, error) {
+	if isMissingValue(right) {
+		switch op {
+		case sqlparser.EqualStr:
+			return column.name + " IS NULL", nil
+		case sqlparser.NotEqualStr:
+			return column.name + " IS NOT NULL", nil
+		default:
+			return "", fmt.Errorf("operator %v not supported with missing", op)
+		}
+	}
+
+	switch op {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		value, err := column.convertValue(right)
+		if err != nil {
+			return "", err
+		}
+		b.args = append(b.args, value)
+		return fmt.Sprintf("%s %s ?", column.name, op), nil
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := right.(sqlparser.ValTuple)
+		if !ok {
+			return "", fmt.Errorf("invalid value for %v: %v", op, sqlparser.String(right))
+		}
+		placeholders := make([]string, len(tuple))
+		for i, expr := range tuple {
+			value, err := column.convertValue(expr)
+			if err != nil {
+				return "", err
+			}
+			b.args = append(b.args, value)
+			placeholders[i] = "?"
+		}
+		return fmt.Sprintf("%s %s (%s)", column.name, strings.ToUpper(op), strings.Join(placeholders, ", ")), nil
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		if column.kind != columnKindString {
+			return "", fmt.Errorf("operator %v not supported on %v", op, column.name)
+		}
+		value, err := column.convertValue(right)
+		if err != nil {
+			return "", err
+		}
+		b.args = append(b.args, value)
+		return fmt.Sprintf("%s %s ?", column.name, strings.ToUpper(op)), nil
+	default:
+		return "", fmt.Errorf("operator %v not supported", op)
+	}
+}
+
+func (b *visibilityQueryBuilder) convertSearchAttributeComparison(attr string, op string, right sqlparser.Expr) (string, error) {
+	if isMissingValue(right) {
+		b.args = append(b.args, attr)
+		switch op {
+		case sqlparser.EqualStr:
+			return "NOT " + fmt.Sprintf(templateSearchAttributeExists, ""), nil
+		case sqlparser.NotEqualStr:
+			return fmt.Sprintf(templateSearchAttributeExists, ""), nil
+		default:
+			return "", fmt.Errorf("operator %v not supported with missing", op)
+		}
+	}
+
+	// negative conditions match the executions where no value of the search attribute matches,
+	// including the executions without the search attribute
+	var cond string
+	var args []interface{}
+	negate := false
+	switch op {
+	case sqlparser.NotEqualStr:
+		negate = true
+		op = sqlparser.EqualStr
+		fallthrough
+	case sqlparser.EqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		literal, err := parseVisibilityLiteral(right)
+		if err != nil {
+			return "", err
+		}
+		cond, args = searchAttributeValueCondition(op, literal)
+	case sqlparser.NotInStr:
+		negate = true
+		fallthrough
+	case sqlparser.InStr:
+		tuple, ok := right.(sqlparser.ValTuple)
+		if !ok {
+			return "", fmt.Errorf("invalid value for %v: %v", op, sqlparser.String(right))
+		}
+		conds := make([]string, len(tuple))
+		for i, expr := range tuple {
+			literal, err := parseVisibilityLiteral(expr)
+			if err != nil {
+				return "", err
+			}
+			var valueArgs []interface{}
+			conds[i], valueArgs = searchAttributeValueCondition(sqlparser.EqualStr, literal)
+			args = append(args, valueArgs...)
+		}
+		cond = "(" + strings.Join(conds, " OR ") + ")"
+	case sqlparser.NotLikeStr:
+		negate = true
+		fallthrough
+	case sqlparser.LikeStr:
+		literal, err := parseVisibilityLiteral(right)
+		if err != nil {
+			return "", err
+		}
+		if literal.kind != literalKindString {
+			return "", fmt.Errorf("invalid value for %v: %v", op, sqlparser.String(right))
+		}
+		cond = "sa.string_value LIKE ?"
+		args = []interface{}{literal.stringValue}
+	default:
+		return "", fmt.Errorf("operator %v not supported", op)
+	}
+
+	b.args = append(b.args, attr)
+	b.args = append(b.args, args...)
+	result := fmt.Sprintf(templateSearchAttributeExists, " AND "+cond)
+	if negate {
+		result = "NOT " + result
+	}
+	return result, nil
+}
+
+func (b *visibilityQueryBuilder) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	column, attr, err := resolveVisibilityField(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	if column != nil {
+		from, err := column.convertValue(expr.From)
+		if err != nil {
+			return "", err
+		}
+		to, err := column.convertValue(expr.To)
+		if err != nil {
+			return "", err
+		}
+		b.args = append(b.args, from, to)
+		return fmt.Sprintf("%s %s ? AND ?", column.name, strings.ToUpper(expr.Operator)), nil
+	}
+
+	from, err := parseVisibilityLiteral(expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := parseVisibilityLiteral(expr.To)
+	if err != nil {
+		return "", err
+	}
+	fromCond, fromArgs := searchAttributeValueCondition(sqlparser.GreaterEqualStr, from)
+	toCond, toArgs := searchAttributeValueCondition(sqlparser.LessEqualStr, to)
+	b.args = append(b.args, attr)
+	b.args = append(b.args, fromArgs...)
+	b.args = append(b.args, toArgs...)
+	result := fmt.Sprintf(templateSearchAttributeExists, " AND "+fromCond+" AND "+toCond)
+	if expr.Operator == sqlparser.NotBetweenStr {
+		result = "NOT " + result
+	}
+	return result, nil
+}
+
+func (b *visibilityQueryBuilder) convertOrderBy(order *sqlparser.Order) (string, error) {
+	column, attr, err := resolveVisibilityField(order.Expr)
+	if err != nil {
+		return "", err
+	}
+	direction := "ASC"
+	if order.Direction == sqlparser.DescScr {
+		direction = "DESC"
+	}
+	if column != nil {
+		return fmt.Sprintf("%s %s, run_id", column.name, direction), nil
+	}
+
+	// the value of a custom search attribute is in one of the value columns depending on its type,
+	// sorting on all of them in turn sorts by whichever is set
+	aggregate := "MIN"
+	if direction == "DESC" {
+		aggregate = "MAX"
+	}
+	var sortKeys []string
+	for _, valueColumn := range []string{"int_value", "double_value", "string_value"} {
+		sortKeys = append(sortKeys, fmt.Sprintf(templateSearchAttributeSortKey, aggregate, valueColumn, direction))
+		b.args = append(b.args, attr)
+	}
+	return strings.Join(sortKeys, ", ") + ", run_id", nil
+}
+
+// searchAttributeValueCondition returns the condition on a row of executions_visibility_search_attributes
+// for a literal, the value column compared depends on the type of the literal and must agree with
+// the columns set by ToVisibilitySearchAttributesRows
+func searchAttributeValueCondition(op string, literal *visibilityLiteral) (string, []interface{}) {
+	switch literal.kind {
+	case literalKindInt:
+		return fmt.Sprintf("(sa.int_value %[1]s ? OR (sa.int_value IS NULL AND sa.double_value %[1]s ?))", op),
+			[]interface{}{literal.intValue, float64(literal.intValue)}
+	case literalKindDouble:
+		return fmt.Sprintf("sa.double_value %s ?", op), []interface{}{literal.doubleValue}
+	case literalKindBool:
+		return fmt.Sprintf("sa.int_value %s ?", op), []interface{}{boolToInt64(literal.boolValue)}
+	default:
+		if t, err := time.Parse(time.RFC3339Nano, literal.stringValue); err == nil {
+			return fmt.Sprintf("sa.int_value %s ?", op), []interface{}{t.UnixNano()}
+		}
+		return fmt.Sprintf("sa.string_value %s ?", op), []interface{}{literal.stringValue}
+	}
+}
+
+// resolveVisibilityField returns the column of a system search attribute or the name of a custom search attribute
+func resolveVisibilityField(expr sqlparser.Expr) (*visibilityColumn, string, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, "", fmt.Errorf("invalid search attribute: %v", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	if colName.Qualifier.Name.String() == definition.Attr {
+		return nil, name, nil
+	}
+	if colName.Qualifier.IsEmpty() {
+		if strings.HasPrefix(name, definition.Attr+".") {
+			return nil, name[len(definition.Attr)+1:], nil
+		}
+		if column, ok := visibilityQueryColumns[name]; ok {
+			return &column, "", nil
+		}
+	}
+	return nil, "", fmt.Errorf("invalid search attribute: %v", sqlparser.String(expr))
+}
+
+func (c *visibilityColumn) convertValue(expr sqlparser.Expr) (interface{}, error) {
+	literal, err := parseVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	switch c.kind {
+	case columnKindString:
+		if literal.kind == literalKindString {
+			return literal.stringValue, nil
+		}
+	case columnKindInt:
+		switch literal.kind {
+		case literalKindInt:
+			return literal.intValue, nil
+		case literalKindString:
+			if i, err := strconv.ParseInt(literal.stringValue, 10, 64); err == nil {
+				return i, nil
+			}
+		}
+	case columnKindBool:
+		switch literal.kind {
+		case literalKindBool:
+			return literal.boolValue, nil
+		case literalKindString:
+			if v, err := strconv.ParseBool(literal.stringValue); err == nil {
+				return v, nil
+			}
+		}
+	case columnKindTime:
+		switch literal.kind {
+		case literalKindInt:
+			return time.Unix(0, literal.intValue).UTC(), nil
+		case literalKindString:
+			if i, err := strconv.ParseInt(literal.stringValue, 10, 64); err == nil {
+				return time.Unix(0, i).UTC(), nil
+			}
+			if t, err := time.Parse(time.RFC3339, literal.stringValue); err == nil {
+				return t.UTC(), nil
+			}
+		}
+	case columnKindCloseStatus:
+		var status types.WorkflowExecutionCloseStatus
+		switch literal.kind {
+		case literalKindInt:
+			status = types.WorkflowExecutionCloseStatus(literal.intValue)
+		case literalKindString:
+			if i, err := strconv.ParseInt(literal.stringValue, 10, 32); err == nil {
+				status = types.WorkflowExecutionCloseStatus(i)
+			} else if err := status.UnmarshalText([]byte(literal.stringValue)); err != nil {
+				return nil, fmt.Errorf("invalid value for %v: %v", c.name, literal.stringValue)
+			}
+		default:
+			return nil, fmt.Errorf("invalid value for %v: %v", c.name, sqlparser.String(expr))
+		}
+		return int32(*thrift.FromWorkflowExecutionCloseStatus(&status)), nil
+	}
+	return nil, fmt.Errorf("invalid value for %v: %v", c.name, sqlparser.String(expr))
+}
+
+func parseVisibilityLiteral(expr sqlparser.Expr) (*visibilityLiteral, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return &visibilityLiteral{kind: literalKindString, stringValue: string(expr.Val)}, nil
+		case sqlparser.IntVal:
+			i, err := strconv.ParseInt(string(expr.Val), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return &visibilityLiteral{kind: literalKindInt, intValue: i}, nil
+		case sqlparser.FloatVal:
+			f, err := strconv.ParseFloat(string(expr.Val), 64)
+			if err != nil {
+				return nil, err
+			}
+			return &visibilityLiteral{kind: literalKindDouble, doubleValue: f}, nil
+		}
+	case sqlparser.BoolVal:
+		return &visibilityLiteral{kind: literalKindBool, boolValue: bool(expr)}, nil
+	case *sqlparser.UnaryExpr:
+		if expr.Operator == sqlparser.UMinusStr {
+			literal, err := parseVisibilityLiteral(expr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			switch literal.kind {
+			case literalKindInt:
+				literal.intValue = -literal.intValue
+				return literal, nil
+			case literalKindDouble:
+				literal.doubleValue = -literal.doubleValue
+				return literal, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid value: %v", sqlparser.String(expr))
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.EqualString(visibilityQueryMissingValue)
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,291 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	visibilityQuerySuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+const (
+	testSearchAttributeExists = "EXISTS (SELECT 1 FROM executions_visibility_search_attributes sa " +
+		"WHERE sa.domain_id = executions_visibility.domain_id AND sa.run_id = executions_visibility.run_id AND sa.name = ?"
+)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *visibilityQuerySuite) TestParseVisibilityQuery_SystemSearchAttributes() {
+	closeTime := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	testCases := []struct {
+		query string
+		where string
+		args  []interface{}
+	}{
+		{
+			query: "",
+			where: "",
+		},
+		{
+			query: "WorkflowID = 'wid'",
+			where: "workflow_id = ?",
+			args:  []interface{}{"wid"},
+		},
+		{
+			query: "WorkflowType = 'type' and CloseTime = missing",
+			where: "(workflow_type_name = ? AND close_time IS NULL)",
+			args:  []interface{}{"type"},
+		},
+		{
+			query: "CloseStatus = 'TIMED_OUT' or CloseStatus = 1",
+			where: "(close_status = ? OR close_status = ?)",
+			args:  []interface{}{int32(5), int32(1)},
+		},
+		{
+			query: "CloseTime > '2021-02-03T04:05:06Z' and StartTime <= " + "1612325106000000000",
+			where: "(close_time > ? AND start_time <= ?)",
+			args:  []interface{}{closeTime, closeTime},
+		},
+		{
+			query: "RunID in ('r1', 'r2') and IsCron = true and HistoryLength between 10 and 20",
+			where: "((run_id IN (?, ?) AND is_cron = ?) AND history_length BETWEEN ? AND ?)",
+			args:  []interface{}{"r1", "r2", true, int64(10), int64(20)},
+		},
+		{
+			query: "(TaskList = 'tl' or TaskList like 'prefix%') and WorkflowID != 'wid'",
+			where: "((task_list = ? OR task_list LIKE ?) AND workflow_id != ?)",
+			args:  []interface{}{"tl", "prefix%", "wid"},
+		},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.where, query.where, tc.query)
+		s.Equal(tc.args, query.whereArgs, tc.query)
+		s.Empty(query.orderBy, tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseVisibilityQuery_CustomSearchAttributes() {
+	datetime := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	testCases := []struct {
+		query string
+		where string
+		args  []interface{}
+	}{
+		{
+			query: "`Attr.CustomKeywordField` = 'keyword'",
+			where: testSearchAttributeExists + " AND sa.string_value = ?)",
+			args:  []interface{}{"CustomKeywordField", "keyword"},
+		},
+		{
+			query: "Attr.CustomIntField > 10",
+			where: testSearchAttributeExists + " AND (sa.int_value > ? OR (sa.int_value IS NULL AND sa.double_value > ?)))",
+			args:  []interface{}{"CustomIntField", int64(10), float64(10)},
+		},
+		{
+			query: "Attr.CustomDoubleField <= -1.5 and Attr.CustomBoolField = false",
+			where: "(" + testSearchAttributeExists + " AND sa.double_value <= ?) AND " + testSearchAttributeExists + " AND sa.int_value = ?))",
+			args:  []interface{}{"CustomDoubleField", -1.5, "CustomBoolField", int64(0)},
+		},
+		{
+			query: "Attr.CustomDatetimeField >= '2021-02-03T04:05:06Z'",
+			where: testSearchAttributeExists + " AND sa.int_value >= ?)",
+			args:  []interface{}{"CustomDatetimeField", datetime.UnixNano()},
+		},
+		{
+			query: "Attr.BinaryChecksums != 'checksum'",
+			where: "NOT " + testSearchAttributeExists + " AND sa.string_value = ?)",
+			args:  []interface{}{"BinaryChecksums", "checksum"},
+		},
+		{
+			query: "Attr.CustomKeywordField in ('a', 'b')",
+			where: testSearchAttributeExists + " AND (sa.string_value = ? OR sa.string_value = ?))",
+			args:  []interface{}{"CustomKeywordField", "a", "b"},
+		},
+		{
+			query: "Attr.CustomKeywordField not like 'a%'",
+			where: "NOT " + testSearchAttributeExists + " AND sa.string_value LIKE ?)",
+			args:  []interface{}{"CustomKeywordField", "a%"},
+		},
+		{
+			query: "Attr.CustomIntField between 1 and 2",
+			where: testSearchAttributeExists + " AND (sa.int_value >= ? OR (sa.int_value IS NULL AND sa.double_value >= ?))" +
+				" AND (sa.int_value <= ? OR (sa.int_value IS NULL AND sa.double_value <= ?)))",
+			args: []interface{}{"CustomIntField", int64(1), float64(1), int64(2), float64(2)},
+		},
+		{
+			query: "Attr.CustomKeywordField = missing",
+			where: "NOT " + testSearchAttributeExists + ")",
+			args:  []interface{}{"CustomKeywordField"},
+		},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.where, query.where, tc.query)
+		s.Equal(tc.args, query.whereArgs, tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseVisibilityQuery_OrderBy() {
+	query, err := ParseVisibilityQuery("order by CloseTime desc")
+	s.NoError(err)
+	s.Empty(query.where)
+	s.Equal("close_time DESC, run_id", query.orderBy)
+	s.Empty(query.orderArgs)
+
+	query, err = ParseVisibilityQuery("WorkflowID = 'wid' order by Attr.CustomIntField")
+	s.NoError(err)
+	s.Equal("workflow_id = ?", query.where)
+	s.Contains(query.orderBy, "(SELECT MIN(sa.int_value) FROM executions_visibility_search_attributes sa")
+	s.Contains(query.orderBy, "(SELECT MIN(sa.string_value) FROM executions_visibility_search_attributes sa")
+	s.Equal([]interface{}{"CustomIntField", "CustomIntField", "CustomIntField"}, query.orderArgs)
+
+	_, err = ParseVisibilityQuery("order by StartTime, CloseTime")
+	s.Equal(errOnlyOneSortField, err)
+}
+
+func (s *visibilityQuerySuite) TestParseVisibilityQuery_Invalid() {
+	invalidQueries := []string{
+		"WorkflowID = ",
+		"UnknownField = 'value'",
+		"WorkflowID = 1",
+		"StartTime > 'yesterday'",
+		"CloseStatus = 'UNKNOWN'",
+		"HistoryLength like '1%'",
+		"Attr.CustomIntField like 1",
+		"StartTime < missing",
+		"WorkflowID = 'wid' limit 10",
+		"WorkflowID = 'wid' group by RunID",
+		"WorkflowID regexp 'wid'",
+	}
+	for _, query := range invalidQueries {
+		_, err := ParseVisibilityQuery(query)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestBuildSelectFromVisibilityByQuery() {
+	query, err := ParseVisibilityQuery("WorkflowID = 'wid' order by CloseTime")
+	s.NoError(err)
+
+	stmt, args := BuildSelectFromVisibilityByQuery(&VisibilityQueryFilter{
+		DomainID: "domain",
+		Query:    query,
+		PageSize: 10,
+		Offset:   20,
+	})
+	s.Equal("SELECT "+VisibilityQueryFieldNames+" FROM executions_visibility "+
+		"WHERE domain_id = ? AND workflow_id = ? ORDER BY close_time ASC, run_id LIMIT ? OFFSET ?", stmt)
+	s.Equal([]interface{}{"domain", "wid", 10, 20}, args)
+
+	stmt, args = BuildSelectFromVisibilityByQuery(&VisibilityQueryFilter{
+		DomainID: "domain",
+		Query:    query,
+		PageSize: 10,
+		RunID:    common.StringPtr("run"),
+	})
+	s.Equal("SELECT "+VisibilityQueryFieldNames+" FROM executions_visibility "+
+		"WHERE domain_id = ? AND workflow_id = ? AND run_id > ? ORDER BY run_id LIMIT ? OFFSET ?", stmt)
+	s.Equal([]interface{}{"domain", "wid", "run", 10, 0}, args)
+
+	stmt, args = BuildSelectFromVisibilityByQuery(&VisibilityQueryFilter{
+		DomainID: "domain",
+		Query:    &VisibilityQuery{},
+		PageSize: 10,
+	})
+	s.Equal("SELECT "+VisibilityQueryFieldNames+" FROM executions_visibility "+
+		"WHERE domain_id = ? ORDER BY start_time DESC, run_id LIMIT ? OFFSET ?", stmt)
+	s.Equal([]interface{}{"domain", 10, 0}, args)
+}
+
+func (s *visibilityQuerySuite) TestBuildCountFromVisibilityByQuery() {
+	query, err := ParseVisibilityQuery("CloseTime = missing order by StartTime")
+	s.NoError(err)
+
+	stmt, args := BuildCountFromVisibilityByQuery(&VisibilityQueryFilter{
+		DomainID: "domain",
+		Query:    query,
+	})
+	s.Equal("SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ? AND close_time IS NULL", stmt)
+	s.Equal([]interface{}{"domain"}, args)
+}
+
+func (s *visibilityQuerySuite) TestToVisibilitySearchAttributesRows() {
+	datetime := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	rows, err := ToVisibilitySearchAttributesRows("domain", "run", map[string][]byte{
+		"CustomKeywordField":  []byte(`"keyword"`),
+		"CustomIntField":      []byte(`7`),
+		"CustomDoubleField":   []byte(`1.5`),
+		"CustomBoolField":     []byte(`true`),
+		"CustomDatetimeField": []byte(`"2021-02-03T04:05:06Z"`),
+		"BinaryChecksums":     []byte(`["c1","c2"]`),
+		"CustomObjectField":   []byte(`{"key":"value"}`),
+	})
+	s.NoError(err)
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Name != rows[j].Name {
+			return rows[i].Name < rows[j].Name
+		}
+		return rows[i].ValueIndex < rows[j].ValueIndex
+	})
+	s.Equal([]VisibilitySearchAttributesRow{
+		{DomainID: "domain", RunID: "run", Name: "BinaryChecksums", ValueIndex: 0, StringValue: common.StringPtr("c1")},
+		{DomainID: "domain", RunID: "run", Name: "BinaryChecksums", ValueIndex: 1, StringValue: common.StringPtr("c2")},
+		{DomainID: "domain", RunID: "run", Name: "CustomBoolField", IntValue: common.Int64Ptr(1)},
+		{
+			DomainID:    "domain",
+			RunID:       "run",
+			Name:        "CustomDatetimeField",
+			StringValue: common.StringPtr("2021-02-03T04:05:06Z"),
+			IntValue:    common.Int64Ptr(datetime.UnixNano()),
+		},
+		{DomainID: "domain", RunID: "run", Name: "CustomDoubleField", DoubleValue: common.Float64Ptr(1.5)},
+		{DomainID: "domain", RunID: "run", Name: "CustomIntField", IntValue: common.Int64Ptr(7), DoubleValue: common.Float64Ptr(7)},
+		{DomainID: "domain", RunID: "run", Name: "CustomKeywordField", StringValue: common.StringPtr("keyword")},
+	}, rows)
+
+	_, err = ToVisibilitySearchAttributesRows("domain", "run", map[string][]byte{
+		"CustomKeywordField": []byte(`keyword`),
+	})
+	s.Error(err)
+}
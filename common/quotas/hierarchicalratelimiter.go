@@ -0,0 +1,269 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// LimitGlobal is the limit of all the requests received by the host
+	LimitGlobal = "global"
+	// LimitDomain is the capacity reserved to a domain
+	LimitDomain = "domain"
+	// LimitDomainAPI is the limit of an API family in a domain
+	LimitDomainAPI = "domain-api"
+	// LimitCaller is the limit of a caller in a domain
+	LimitCaller = "caller"
+	// LimitSharedPool is the capacity shared by the domains
+	LimitSharedPool = "shared-pool"
+
+	// GlobalQuotaKey is the quota key of the limits applying to all the requests
+	GlobalQuotaKey = LimitGlobal
+
+	// _maxBuckets caps the number of buckets, the least recently used ones are evicted first
+	// so that the buckets of short lived callers do not accumulate
+	_maxBuckets = 10000
+)
+
+type (
+	// Quota is the token bucket setting of a limit.
+	// A non positive RPS disables the limit, and a non positive Burst defaults to the RPS.
+	Quota struct {
+		RPS   float64
+		Burst int
+	}
+
+	// QuotaFunc returns the quota applying to a request
+	QuotaFunc func(info Info) Quota
+
+	// HierarchicalRateLimiterConfig holds the quotas of the HierarchicalRateLimiter
+	HierarchicalRateLimiterConfig struct {
+		// Global limits all the requests
+		Global QuotaFunc
+		// Domain is the capacity reserved to each domain
+		Domain QuotaFunc
+		// DomainAPI limits each API family of a domain
+		DomainAPI QuotaFunc
+		// Caller limits each caller of a domain
+		Caller QuotaFunc
+		// SharedPool is the capacity domains borrow from once their reserved capacity is used
+		SharedPool QuotaFunc
+	}
+
+	// HierarchicalRateLimiter is a policy keyed by domain, API family and caller.
+	// A request is charged against, in order, the caller, domain API and domain buckets,
+	// borrowing from the shared pool when the domain bucket is empty, and then against the global bucket.
+	HierarchicalRateLimiter struct {
+		config     HierarchicalRateLimiterConfig
+		maxBuckets int
+
+		sync.Mutex
+		buckets  map[bucketKey]*list.Element
+		byAccess *list.List
+	}
+
+	// LimitExceededError is returned when a request is throttled
+	LimitExceededError struct {
+		// Limit is the name of the limit hit
+		Limit string
+		// Key is the domain, API family or caller the limit applies to
+		Key string
+	}
+
+	bucketKey struct {
+		limit string
+		key   string
+	}
+
+	bucket struct {
+		sync.Mutex
+		key     bucketKey
+		limiter *rate.Limiter
+		quota   Quota
+	}
+)
+
+var _ ExplainedPolicy = (*HierarchicalRateLimiter)(nil)
+
+// NewHierarchicalRateLimiter returns a new rate limiter keyed by domain, API family and caller
+func NewHierarchicalRateLimiter(config HierarchicalRateLimiterConfig) *HierarchicalRateLimiter {
+	return newHierarchicalRateLimiter(config, _maxBuckets)
+}
+
+func newHierarchicalRateLimiter(config HierarchicalRateLimiterConfig, maxBuckets int) *HierarchicalRateLimiter {
+	return &HierarchicalRateLimiter{
+		config:     config,
+		maxBuckets: maxBuckets,
+		buckets:    make(map[bucketKey]*list.Element),
+		byAccess:   list.New(),
+	}
+}
+
+// QuotaKey returns the key of the quota of a limit applying to a request,
+// the demand for which is shared between the hosts enforcing a cluster-wide quota
+func QuotaKey(limit string, info Info) string {
+	switch limit {
+	case LimitDomain:
+		return limit + ":" + info.Domain
+	case LimitDomainAPI:
+		return limit + ":" + info.Domain + "/" + info.API
+	case LimitCaller:
+		return limit + ":" + info.Domain + "/" + info.Caller
+	default:
+		return GlobalQuotaKey
+	}
+}
+
+func (e *LimitExceededError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("%v rate limit exceeded", e.Limit)
+	}
+	return fmt.Sprintf("%v rate limit exceeded for %v", e.Limit, e.Key)
+}
+
+// Allow attempts to allow a request to go through. The method returns
+// immediately with a true or false indicating if the request can make
+// progress
+func (h *HierarchicalRateLimiter) Allow(info Info) bool {
+	return h.Check(info) == nil
+}
+
+// Check attempts to allow a request to go through. The method returns
+// immediately with a LimitExceededError if the request can't make progress
+func (h *HierarchicalRateLimiter) Check(info Info) error {
+	// all the reservations are made and canceled at the same time,
+	// otherwise canceling does not give the tokens back
+	now := time.Now()
+	var reservations []*rate.Reservation
+	throttle := func(limit string, key string) error {
+		// give back the tokens taken from the other buckets
+		for _, rsv := range reservations {
+			rsv.CancelAt(now)
+		}
+		return &LimitExceededError{Limit: limit, Key: key}
+	}
+	reserve := func(limit string, key string, quotaFn QuotaFunc) (reserved bool, enabled bool) {
+		if quotaFn == nil {
+			return false, false
+		}
+		quota := quotaFn(info)
+		if quota.RPS <= 0 {
+			return false, false
+		}
+		rsv := h.getBucket(limit, key, quota, now).reserve(now)
+		if rsv == nil {
+			return false, true
+		}
+		reservations = append(reservations, rsv)
+		return true, true
+	}
+
+	if info.Domain != "" {
+		if info.Caller != "" {
+			if reserved, enabled := reserve(LimitCaller, info.Domain+"/"+info.Caller, h.config.Caller); enabled && !reserved {
+				return throttle(LimitCaller, info.Caller)
+			}
+		}
+		if info.API != "" {
+			if reserved, enabled := reserve(LimitDomainAPI, info.Domain+"/"+info.API, h.config.DomainAPI); enabled && !reserved {
+				return throttle(LimitDomainAPI, info.Domain+"/"+info.API)
+			}
+		}
+		if reserved, enabled := reserve(LimitDomain, info.Domain, h.config.Domain); enabled && !reserved {
+			if borrowed, _ := reserve(LimitSharedPool, "", h.config.SharedPool); !borrowed {
+				return throttle(LimitDomain, info.Domain)
+			}
+		}
+	}
+	if reserved, enabled := reserve(LimitGlobal, "", h.config.Global); enabled && !reserved {
+		return throttle(LimitGlobal, "")
+	}
+	return nil
+}
+
+func (h *HierarchicalRateLimiter) getBucket(limit string, key string, quota Quota, now time.Time) *bucket {
+	k := bucketKey{limit: limit, key: key}
+	h.Lock()
+	element, ok := h.buckets[k]
+	if ok {
+		h.byAccess.MoveToFront(element)
+	} else {
+		element = h.byAccess.PushFront(newBucket(k, quota))
+		h.buckets[k] = element
+		for h.byAccess.Len() > h.maxBuckets {
+			oldest := h.byAccess.Remove(h.byAccess.Back()).(*bucket)
+			delete(h.buckets, oldest.key)
+		}
+	}
+	h.Unlock()
+
+	b := element.Value.(*bucket)
+	b.update(quota, now)
+	return b
+}
+
+func newBucket(key bucketKey, quota Quota) *bucket {
+	return &bucket{
+		key:     key,
+		limiter: rate.NewLimiter(rate.Limit(quota.RPS), quota.burst()),
+		quota:   quota,
+	}
+}
+
+func (b *bucket) update(quota Quota, now time.Time) {
+	b.Lock()
+	defer b.Unlock()
+	if b.quota == quota {
+		return
+	}
+	b.limiter.SetLimitAt(now, rate.Limit(quota.RPS))
+	b.limiter.SetBurstAt(now, quota.burst())
+	b.quota = quota
+}
+
+// reserve takes a token if one is available right away, and returns nil otherwise
+func (b *bucket) reserve(now time.Time) *rate.Reservation {
+	rsv := b.limiter.ReserveN(now, 1)
+	if !rsv.OK() {
+		return nil
+	}
+	if rsv.DelayFrom(now) != 0 {
+		rsv.CancelAt(now)
+		return nil
+	}
+	return rsv
+}
+
+func (q Quota) burst() int {
+	if q.Burst > 0 {
+		return q.Burst
+	}
+	if burst := int(q.RPS); burst > _burstSize {
+		return burst
+	}
+	return _burstSize
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// _slowRPS makes the buckets refill slowly enough for the tests to only consume their burst
+const _slowRPS = 0.001
+
+func fixedQuota(burst int) QuotaFunc {
+	return func(Info) Quota {
+		return Quota{RPS: _slowRPS, Burst: burst}
+	}
+}
+
+func countAllowed(policy Policy, info Info, attempts int) int {
+	allowed := 0
+	for i := 0; i < attempts; i++ {
+		if policy.Allow(info) {
+			allowed++
+		}
+	}
+	return allowed
+}
+
+func TestHierarchicalRateLimiterDomainLimit(t *testing.T) {
+	policy := NewHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Global: fixedQuota(100),
+		Domain: fixedQuota(3),
+	})
+	assert.Equal(t, 3, countAllowed(policy, Info{Domain: defaultDomain}, 10))
+	// other domains have their own capacity
+	assert.Equal(t, 3, countAllowed(policy, Info{Domain: "other"}, 10))
+
+	err := policy.Check(Info{Domain: defaultDomain})
+	require.Error(t, err)
+	assert.Equal(t, &LimitExceededError{Limit: LimitDomain, Key: defaultDomain}, err)
+}
+
+func TestHierarchicalRateLimiterGlobalLimit(t *testing.T) {
+	policy := NewHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Global: fixedQuota(2),
+		Domain: fixedQuota(100),
+	})
+	assert.Equal(t, 2, countAllowed(policy, Info{Domain: defaultDomain}, 10))
+	assert.Equal(t, &LimitExceededError{Limit: LimitGlobal}, policy.Check(Info{Domain: "other"}))
+}
+
+func TestHierarchicalRateLimiterDomainAPILimit(t *testing.T) {
+	policy := NewHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Domain: fixedQuota(10),
+		DomainAPI: func(info Info) Quota {
+			if info.API == "start" {
+				return Quota{RPS: _slowRPS, Burst: 2}
+			}
+			return Quota{}
+		},
+	})
+	assert.Equal(t, 2, countAllowed(policy, Info{Domain: defaultDomain, API: "start"}, 5))
+	assert.Equal(t, &LimitExceededError{Limit: LimitDomainAPI, Key: defaultDomain + "/start"},
+		policy.Check(Info{Domain: defaultDomain, API: "start"}))
+
+	// the tokens of the domain bucket are given back when the API limit is hit,
+	// so the remaining capacity is available to the other API families
+	assert.Equal(t, 8, countAllowed(policy, Info{Domain: defaultDomain, API: "signal"}, 20))
+}
+
+func TestHierarchicalRateLimiterCallerLimit(t *testing.T) {
+	policy := NewHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Domain: fixedQuota(10),
+		Caller: fixedQuota(1),
+	})
+	assert.Equal(t, 1, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-1"}, 5))
+	assert.Equal(t, 1, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-2"}, 5))
+	assert.Equal(t, &LimitExceededError{Limit: LimitCaller, Key: "worker-1"},
+		policy.Check(Info{Domain: defaultDomain, Caller: "worker-1"}))
+	// requests without a caller are only limited by the domain
+	assert.Equal(t, 8, countAllowed(policy, Info{Domain: defaultDomain}, 20))
+}
+
+func TestHierarchicalRateLimiterSharedPool(t *testing.T) {
+	policy := NewHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Domain:     fixedQuota(2),
+		SharedPool: fixedQuota(3),
+	})
+	// the domain borrows from the shared pool once its own capacity is used
+	assert.Equal(t, 5, countAllowed(policy, Info{Domain: defaultDomain}, 10))
+	// the shared pool is exhausted for the other domains as well
+	assert.Equal(t, 2, countAllowed(policy, Info{Domain: "other"}, 10))
+	assert.Equal(t, &LimitExceededError{Limit: LimitDomain, Key: "other"}, policy.Check(Info{Domain: "other"}))
+}
+
+func TestHierarchicalRateLimiterDisabledLimits(t *testing.T) {
+	policy := NewHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Domain: func(Info) Quota { return Quota{} },
+	})
+	assert.Equal(t, 100, countAllowed(policy, Info{Domain: defaultDomain, API: "start", Caller: "worker"}, 100))
+}
+
+func TestHierarchicalRateLimiterQuotaUpdate(t *testing.T) {
+	burst := 5
+	policy := NewHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Domain: func(Info) Quota { return Quota{RPS: _slowRPS, Burst: burst} },
+	})
+	assert.Equal(t, 1, countAllowed(policy, Info{Domain: defaultDomain}, 1))
+
+	// the bucket is not recreated, but its capacity follows the new quota
+	burst = 2
+	assert.Equal(t, 2, countAllowed(policy, Info{Domain: defaultDomain}, 10))
+}
+
+func TestHierarchicalRateLimiterBucketEviction(t *testing.T) {
+	policy := newHierarchicalRateLimiter(HierarchicalRateLimiterConfig{
+		Caller: fixedQuota(1),
+	}, 2)
+	assert.Equal(t, 1, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-1"}, 5))
+	assert.Equal(t, 1, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-2"}, 5))
+	assert.Equal(t, 1, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-3"}, 5))
+	assert.Equal(t, 2, policy.byAccess.Len())
+
+	// the least recently used bucket was evicted, the others are kept
+	assert.Equal(t, 0, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-3"}, 5))
+	assert.Equal(t, 0, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-2"}, 5))
+	assert.Equal(t, 1, countAllowed(policy, Info{Domain: defaultDomain, Caller: "worker-1"}, 5))
+	assert.Equal(t, 2, policy.byAccess.Len())
+}
+
+func TestLimitExceededError(t *testing.T) {
+	assert.Equal(t, "global rate limit exceeded", (&LimitExceededError{Limit: LimitGlobal}).Error())
+	assert.Equal(t, "domain rate limit exceeded for test", (&LimitExceededError{Limit: LimitDomain, Key: "test"}).Error())
+}
@@ -20,7 +20,11 @@
 
 package quotas
 
-import "context"
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+)
 
 // RPSFunc returns a float64 as the RPS
 type RPSFunc func() float64
@@ -31,6 +35,10 @@ type RPSKeyFunc func(key string) float64
 // Info corresponds to information required to determine rate limits
 type Info struct {
 	Domain string
+	// API is the family of the API called, e.g. start or visibility
+	API string
+	// Caller is the authenticated principal or the service name of the caller
+	Caller string
 }
 
 // Limiter corresponds to basic rate limiting functionality.
@@ -53,3 +61,23 @@ type Policy interface {
 	// progress
 	Allow(info Info) bool
 }
+
+// ExplainedPolicy is a Policy which tells which limit throttled the request
+type ExplainedPolicy interface {
+	Policy
+
+	// Check attempts to allow a request to go through like Allow does.
+	// The method returns a LimitExceededError naming the limit hit
+	// if the request can't make progress
+	Check(info Info) error
+}
+
+// GlobalShare provides the part of the cluster-wide quotas enforced by this host
+type GlobalShare interface {
+	common.Daemon
+
+	// Share returns the ratio of the cluster-wide quota of a key, as returned by QuotaKey, this host enforces
+	Share(key string) float64
+	// Record records a request received by this host for each of the quota keys it is charged against
+	Record(keys ...string)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"container/list"
+)
+
+type (
+	// FairQueue is a deficit round robin queue. Items are queued per key and
+	// the keys are served in turn, each key dequeuing up to its weight of items per turn,
+	// so a key with many items does not delay the items of the other keys.
+	// Items of the same key are dequeued in the order they were queued.
+	// FairQueue is not safe for concurrent use.
+	FairQueue struct {
+		weight  func(key string) int
+		queues  map[string]*keyQueue
+		active  []*keyQueue // keys with queued items, in serving order
+		current int
+		size    int
+	}
+
+	keyQueue struct {
+		key     string
+		items   *list.List
+		deficit int
+	}
+)
+
+// NewFairQueue creates a new FairQueue, weight returns the number of items a key
+// can dequeue per turn. Weights lower than 1 are treated as 1.
+func NewFairQueue(weight func(key string) int) *FairQueue {
+	return &FairQueue{
+		weight: weight,
+		queues: make(map[string]*keyQueue),
+	}
+}
+
+// Push queues the item under the key
+func (q *FairQueue) Push(key string, item interface{}) {
+	queue, ok := q.queues[key]
+	if !ok {
+		queue = &keyQueue{
+			key:   key,
+			items: list.New(),
+		}
+		q.queues[key] = queue
+		q.active = append(q.active, queue)
+		if len(q.active) == 1 {
+			// the only key starts its turn right away
+			q.current = 0
+			queue.deficit = q.keyWeight(key)
+		}
+	}
+	queue.items.PushBack(item)
+	q.size++
+}
+
+// Pop dequeues the next item and returns its key, it returns false if the queue is empty
+func (q *FairQueue) Pop() (string, interface{}, bool) {
+	if q.size == 0 {
+		return "", nil, false
+	}
+
+	for {
+		queue := q.active[q.current]
+		if queue.deficit > 0 {
+			queue.deficit--
+			item := queue.items.Remove(queue.items.Front())
+			q.size--
+			if queue.items.Len() == 0 {
+				q.remove(q.current)
+			}
+			return queue.key, item, true
+		}
+
+		// the turn of the current key is over
+		q.current = (q.current + 1) % len(q.active)
+		next := q.active[q.current]
+		next.deficit += q.keyWeight(next.key)
+	}
+}
+
+// Len returns the number of queued items
+func (q *FairQueue) Len() int {
+	return q.size
+}
+
+// KeyLen returns the number of items queued under the key
+func (q *FairQueue) KeyLen(key string) int {
+	if queue, ok := q.queues[key]; ok {
+		return queue.items.Len()
+	}
+	return 0
+}
+
+func (q *FairQueue) remove(index int) {
+	delete(q.queues, q.active[index].key)
+	q.active = append(q.active[:index], q.active[index+1:]...)
+	if len(q.active) == 0 {
+		q.current = 0
+		return
+	}
+	// move back to the previous key whose turn is over, so the key now at
+	// the index starts a new turn on the next Pop
+	q.current = (index - 1 + len(q.active)) % len(q.active)
+}
+
+func (q *FairQueue) keyWeight(key string) int {
+	if weight := q.weight(key); weight > 1 {
+		return weight
+	}
+	return 1
+}
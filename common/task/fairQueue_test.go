@@ -0,0 +1,87 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFairQueue_WeightedOrder(t *testing.T) {
+	weights := map[string]int{"a": 2, "b": 1}
+	queue := NewFairQueue(func(key string) int {
+		return weights[key]
+	})
+
+	for _, item := range []string{"a1", "a2", "a3", "a4"} {
+		queue.Push("a", item)
+	}
+	for _, item := range []string{"b1", "b2"} {
+		queue.Push("b", item)
+	}
+	require.Equal(t, 6, queue.Len())
+	require.Equal(t, 4, queue.KeyLen("a"))
+	require.Equal(t, 2, queue.KeyLen("b"))
+
+	expected := []string{"a1", "a2", "b1", "a3", "a4", "b2"}
+	for _, expectedItem := range expected {
+		key, item, ok := queue.Pop()
+		require.True(t, ok)
+		require.Equal(t, expectedItem, item)
+		require.Equal(t, expectedItem[:1], key)
+	}
+
+	_, _, ok := queue.Pop()
+	require.False(t, ok)
+	require.Equal(t, 0, queue.Len())
+	require.Equal(t, 0, queue.KeyLen("a"))
+}
+
+func TestFairQueue_PushWhilePopping(t *testing.T) {
+	queue := NewFairQueue(func(key string) int {
+		return 0 // treated as 1
+	})
+
+	queue.Push("a", "a1")
+	queue.Push("a", "a2")
+	_, item, ok := queue.Pop()
+	require.True(t, ok)
+	require.Equal(t, "a1", item)
+
+	queue.Push("b", "b1")
+	queue.Push("c", "c1")
+
+	expected := []string{"b1", "c1", "a2"}
+	for _, expectedItem := range expected {
+		_, item, ok := queue.Pop()
+		require.True(t, ok)
+		require.Equal(t, expectedItem, item)
+	}
+
+	// the queue starts over after being drained
+	queue.Push("c", "c2")
+	_, item, ok = queue.Pop()
+	require.True(t, ok)
+	require.Equal(t, "c2", item)
+	_, _, ok = queue.Pop()
+	require.False(t, ok)
+}
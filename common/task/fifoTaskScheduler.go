@@ -40,6 +40,8 @@ type (
 		WorkerCount     dynamicconfig.IntPropertyFn
 		DispatcherCount int
 		RetryPolicy     backoff.RetryPolicy
+		// WorkerCountSubscription is optional, it updates the number of workers as soon as the worker count changes
+		WorkerCountSubscription dynamicconfig.IntPropertySubscriptionFn
 	}
 
 	fifoTaskSchedulerImpl struct {
@@ -75,9 +77,10 @@ func NewFIFOTaskScheduler(
 			logger,
 			metricsClient,
 			&ParallelTaskProcessorOptions{
-				QueueSize:   options.QueueSize,
-				WorkerCount: options.WorkerCount,
-				RetryPolicy: options.RetryPolicy,
+				QueueSize:               options.QueueSize,
+				WorkerCount:             options.WorkerCount,
+				WorkerCountSubscription: options.WorkerCountSubscription,
+				RetryPolicy:             options.RetryPolicy,
 			},
 		),
 	}
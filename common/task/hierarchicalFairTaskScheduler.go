@@ -0,0 +1,399 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// HierarchicalFairTaskSchedulerOptions configs hierarchical fair task scheduler
+	HierarchicalFairTaskSchedulerOptions struct {
+		// PriorityWeights is the number of tasks dispatched per round for each priority
+		PriorityWeights dynamicconfig.MapPropertyFn
+		// DomainWeight is the number of tasks of a domain dispatched per turn within a priority
+		DomainWeight dynamicconfig.IntPropertyFnWithDomainFilter
+		// TaskDomain returns the domain name of the task
+		TaskDomain func(task PriorityTask) string
+		// QueueSize is the max number of queued tasks per domain and priority
+		QueueSize       int
+		WorkerCount     dynamicconfig.IntPropertyFn
+		DispatcherCount int
+		RetryPolicy     backoff.RetryPolicy
+		// WorkerCountSubscription is optional, it updates the number of workers as soon as the worker count changes
+		WorkerCountSubscription dynamicconfig.IntPropertySubscriptionFn
+	}
+
+	hierarchicalFairTaskSchedulerImpl struct {
+		sync.Mutex
+
+		status        int32
+		weights       atomic.Value // store the currently used priority weights
+		queues        map[int]*FairQueue
+		priorities    []int // priorities with a queue in ascending order
+		current       int   // index of the priority currently dispatched
+		credit        int   // number of tasks the current priority can still dispatch in this round
+		domainSizes   map[string]int
+		domainScopes  map[string]metrics.Scope
+		spaceCond     *sync.Cond
+		shutdownCh    chan struct{}
+		notifyCh      chan struct{}
+		dispatcherWG  sync.WaitGroup
+		logger        log.Logger
+		metricsClient metrics.Client
+		metricsScope  metrics.Scope
+		options       *HierarchicalFairTaskSchedulerOptions
+
+		processor Processor
+	}
+
+	fairQueueItem struct {
+		task        PriorityTask
+		enqueueTime time.Time
+	}
+)
+
+const (
+	hierarchicalFairTaskProcessorQueueSize = 1
+)
+
+var (
+	errNoDomainWeight = errors.New("domain weight is not specified in the scheduler option")
+)
+
+// NewHierarchicalFairTaskScheduler creates a new task scheduler which does fair queuing
+// on two levels: priorities are served by their weights as in the weighted round robin scheduler,
+// and within a priority the domains are served in turn by their weights, so a domain
+// with a large backlog does not starve the other domains of the same priority.
+func NewHierarchicalFairTaskScheduler(
+	logger log.Logger,
+	metricsClient metrics.Client,
+	options *HierarchicalFairTaskSchedulerOptions,
+) (Scheduler, error) {
+	weights, err := common.ConvertDynamicConfigMapPropertyToIntMap(options.PriorityWeights())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(weights) == 0 {
+		return nil, errors.New("weight is not specified in the scheduler option")
+	}
+	if options.DomainWeight == nil || options.TaskDomain == nil {
+		return nil, errNoDomainWeight
+	}
+
+	scheduler := &hierarchicalFairTaskSchedulerImpl{
+		status:        common.DaemonStatusInitialized,
+		queues:        make(map[int]*FairQueue),
+		domainSizes:   make(map[string]int),
+		domainScopes:  make(map[string]metrics.Scope),
+		shutdownCh:    make(chan struct{}),
+		notifyCh:      make(chan struct{}, 1),
+		logger:        logger,
+		metricsClient: metricsClient,
+		metricsScope:  metricsClient.Scope(metrics.TaskSchedulerScope),
+		options:       options,
+		processor: NewParallelTaskProcessor(
+			logger,
+			metricsClient,
+			&ParallelTaskProcessorOptions{
+				QueueSize:               hierarchicalFairTaskProcessorQueueSize,
+				WorkerCount:             options.WorkerCount,
+				WorkerCountSubscription: options.WorkerCountSubscription,
+				RetryPolicy:             options.RetryPolicy,
+			},
+		),
+	}
+	scheduler.spaceCond = sync.NewCond(&scheduler.Mutex)
+	scheduler.weights.Store(weights)
+
+	return scheduler, nil
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&h.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	h.processor.Start()
+
+	h.dispatcherWG.Add(h.options.DispatcherCount)
+	for i := 0; i != h.options.DispatcherCount; i++ {
+		go h.dispatcher()
+	}
+	go h.updateWeights()
+
+	h.logger.Info("Hierarchical fair task scheduler started.")
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&h.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(h.shutdownCh)
+
+	h.processor.Stop()
+
+	h.Lock()
+	for _, queue := range h.queues {
+		for {
+			_, item, ok := queue.Pop()
+			if !ok {
+				break
+			}
+			item.(*fairQueueItem).task.Nack()
+		}
+	}
+	h.spaceCond.Broadcast()
+	h.Unlock()
+
+	if success := common.AwaitWaitGroup(&h.dispatcherWG, time.Minute); !success {
+		h.logger.Warn("Hierarchical fair task scheduler timedout on shutdown.")
+	}
+
+	h.logger.Info("Hierarchical fair task scheduler shutdown.")
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) Submit(task PriorityTask) error {
+	h.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+	sw := h.metricsScope.StartTimer(metrics.PriorityTaskSubmitLatency)
+	defer sw.Stop()
+
+	_, err := h.submit(task, true)
+	return err
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) TrySubmit(
+	task PriorityTask,
+) (bool, error) {
+	submitted, err := h.submit(task, false)
+	if submitted {
+		h.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+	}
+	return submitted, err
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) submit(
+	task PriorityTask,
+	blocking bool,
+) (bool, error) {
+	if h.isStopped() {
+		return false, ErrTaskSchedulerClosed
+	}
+
+	priority := task.Priority()
+	if _, ok := h.getWeights()[priority]; !ok {
+		return false, fmt.Errorf("unknown task priority: %v", priority)
+	}
+	domain := h.options.TaskDomain(task)
+
+	h.Lock()
+	queue := h.getOrCreateQueueLocked(priority)
+	for queue.KeyLen(domain) >= h.options.QueueSize && !h.isStopped() {
+		if !blocking {
+			h.Unlock()
+			return false, nil
+		}
+		h.spaceCond.Wait()
+	}
+	if h.isStopped() {
+		h.Unlock()
+		return false, ErrTaskSchedulerClosed
+	}
+
+	queue.Push(domain, &fairQueueItem{
+		task:        task,
+		enqueueTime: time.Now(),
+	})
+	h.domainSizes[domain]++
+	h.getDomainScopeLocked(domain).UpdateGauge(metrics.PriorityTaskDomainQueueSize, float64(h.domainSizes[domain]))
+	h.Unlock()
+
+	h.notifyDispatcher()
+	return true, nil
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) dispatcher() {
+	defer h.dispatcherWG.Done()
+
+	for {
+		item, ok := h.dequeue()
+		if !ok {
+			select {
+			case <-h.notifyCh:
+				// a new task may have been submitted
+				continue
+			case <-h.shutdownCh:
+				return
+			}
+		}
+
+		if err := h.processor.Submit(item.task); err != nil {
+			h.logger.Error("fail to submit task to processor", tag.Error(err))
+			item.task.Nack()
+		}
+
+		select {
+		case <-h.shutdownCh:
+			return
+		default:
+		}
+	}
+}
+
+// dequeue returns the next task to dispatch, priorities are served in a weighted round robin manner
+// and the domains within a priority are served by the fair queue of the priority
+func (h *hierarchicalFairTaskSchedulerImpl) dequeue() (*fairQueueItem, bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	if len(h.priorities) == 0 {
+		return nil, false
+	}
+
+	weights := h.getWeights()
+	// every priority is visited at most twice, once to use the credit left and once with a new credit
+	for i := 0; i <= 2*len(h.priorities); i++ {
+		queue := h.queues[h.priorities[h.current]]
+		if h.credit > 0 && queue.Len() > 0 {
+			h.credit--
+			domain, item, _ := queue.Pop()
+			fairItem := item.(*fairQueueItem)
+
+			h.domainSizes[domain]--
+			scope := h.getDomainScopeLocked(domain)
+			scope.UpdateGauge(metrics.PriorityTaskDomainQueueSize, float64(h.domainSizes[domain]))
+			scope.RecordTimer(metrics.PriorityTaskDomainQueueLatency, time.Since(fairItem.enqueueTime))
+			if h.domainSizes[domain] == 0 {
+				delete(h.domainSizes, domain)
+				delete(h.domainScopes, domain)
+			}
+
+			h.spaceCond.Broadcast()
+			if h.hasTasksLocked() {
+				// let other dispatchers pick up the remaining tasks
+				h.notifyDispatcher()
+			}
+			return fairItem, true
+		}
+
+		h.current = (h.current + 1) % len(h.priorities)
+		h.credit = priorityCredit(weights, h.priorities[h.current])
+	}
+	return nil, false
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) hasTasksLocked() bool {
+	for _, queue := range h.queues {
+		if queue.Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) getOrCreateQueueLocked(
+	priority int,
+) *FairQueue {
+	if queue, ok := h.queues[priority]; ok {
+		return queue
+	}
+
+	queue := NewFairQueue(h.options.DomainWeight)
+	h.queues[priority] = queue
+	h.priorities = append(h.priorities, priority)
+	sort.Ints(h.priorities)
+	// restart the round from the lowest priority
+	h.current = 0
+	h.credit = priorityCredit(h.getWeights(), h.priorities[0])
+	return queue
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) getDomainScopeLocked(
+	domain string,
+) metrics.Scope {
+	scope, ok := h.domainScopes[domain]
+	if !ok {
+		scope = h.metricsClient.Scope(metrics.TaskSchedulerScope, metrics.DomainTag(domain))
+		h.domainScopes[domain] = scope
+	}
+	return scope
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) notifyDispatcher() {
+	select {
+	case h.notifyCh <- struct{}{}:
+		// sent a notification to the dispatcher
+	default:
+		// do not block if there's already a notification
+	}
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) getWeights() map[int]int {
+	return h.weights.Load().(map[int]int)
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) updateWeights() {
+	ticker := time.NewTicker(defaultUpdateWeightsInterval)
+	for {
+		select {
+		case <-ticker.C:
+			weights, err := common.ConvertDynamicConfigMapPropertyToIntMap(h.options.PriorityWeights())
+			if err != nil {
+				h.logger.Error("failed to update weight for hierarchical fair task scheduler", tag.Error(err))
+			} else {
+				h.weights.Store(weights)
+			}
+		case <-h.shutdownCh:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// priorityCredit returns the weight of the priority, a priority removed from the weights
+// still gets one task per round so its queued tasks are not stuck
+func priorityCredit(
+	weights map[int]int,
+	priority int,
+) int {
+	if weight := weights[priority]; weight > 0 {
+		return weight
+	}
+	return 1
+}
+
+func (h *hierarchicalFairTaskSchedulerImpl) isStopped() bool {
+	return atomic.LoadInt32(&h.status) == common.DaemonStatusStopped
+}
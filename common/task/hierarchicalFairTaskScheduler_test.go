@@ -0,0 +1,272 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	hierarchicalFairTaskSchedulerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller    *gomock.Controller
+		mockProcessor *MockProcessor
+
+		queueSize   int
+		taskDomains map[PriorityTask]string
+
+		scheduler *hierarchicalFairTaskSchedulerImpl
+	}
+)
+
+var (
+	testSchedulerDomainWeights = map[string]int{
+		"domain-a": 2,
+		"domain-b": 1,
+	}
+)
+
+func TestHierarchicalFairTaskSchedulerSuite(t *testing.T) {
+	s := new(hierarchicalFairTaskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockProcessor = NewMockProcessor(s.controller)
+
+	s.queueSize = 100
+	s.taskDomains = make(map[PriorityTask]string)
+	s.scheduler = s.newTestHierarchicalFairTaskScheduler(s.queueSize)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestNewScheduler_NoDomainWeight() {
+	_, err := NewHierarchicalFairTaskScheduler(
+		loggerimpl.NewLoggerForTest(s.Suite),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		&HierarchicalFairTaskSchedulerOptions{
+			PriorityWeights: testSchedulerWeights,
+			QueueSize:       s.queueSize,
+			WorkerCount:     dynamicconfig.GetIntPropertyFn(1),
+			DispatcherCount: 1,
+			RetryPolicy:     backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+	s.Equal(errNoDomainWeight, err)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestSubmit_Success() {
+	mockTask := s.newMockTask(1, "domain-a")
+
+	err := s.scheduler.Submit(mockTask)
+	s.NoError(err)
+
+	s.Equal(1, s.scheduler.queues[1].KeyLen("domain-a"))
+	s.Equal(1, s.scheduler.domainSizes["domain-a"])
+
+	item, ok := s.scheduler.dequeue()
+	s.True(ok)
+	s.Equal(mockTask, item.task)
+	s.Empty(s.scheduler.domainSizes)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestSubmit_Fail_SchedulerShutDown() {
+	scheduler := s.newTestHierarchicalFairTaskScheduler(0)
+
+	mockTask := NewMockPriorityTask(s.controller)
+	scheduler.Start()
+	scheduler.Stop()
+	err := scheduler.Submit(mockTask)
+	s.Equal(ErrTaskSchedulerClosed, err)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestSubmit_Fail_UnknownPriority() {
+	mockTask := s.newMockTask(5, "domain-a") // make sure the number is not in testSchedulerWeights
+	err := s.scheduler.Submit(mockTask)
+	s.Error(err)
+	s.NotEqual(ErrTaskSchedulerClosed, err)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestTrySubmit_DomainIsolation() {
+	for i := 0; i != s.queueSize; i++ {
+		submitted, err := s.scheduler.TrySubmit(s.newMockTask(1, "domain-a"))
+		s.NoError(err)
+		s.True(submitted)
+	}
+
+	// the queue of domain-a is full, submit one more task, should be non-blocking
+	submitted, err := s.scheduler.TrySubmit(s.newMockTask(1, "domain-a"))
+	s.NoError(err)
+	s.False(submitted)
+
+	// other domains and other priorities of the same domain are not affected
+	submitted, err = s.scheduler.TrySubmit(s.newMockTask(1, "domain-b"))
+	s.NoError(err)
+	s.True(submitted)
+	submitted, err = s.scheduler.TrySubmit(s.newMockTask(2, "domain-a"))
+	s.NoError(err)
+	s.True(submitted)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestDequeue_HierarchicalOrder() {
+	// priority 0 has weight 3 and priority 1 has weight 2
+	for _, domain := range []string{"domain-a", "domain-a", "domain-a", "domain-b", "domain-b"} {
+		s.NoError(s.scheduler.Submit(s.newMockTask(0, domain)))
+	}
+	for _, domain := range []string{"domain-b", "domain-b", "domain-b"} {
+		s.NoError(s.scheduler.Submit(s.newMockTask(1, domain)))
+	}
+
+	type dispatched struct {
+		priority int
+		domain   string
+	}
+	expected := []dispatched{
+		{0, "domain-a"}, {0, "domain-a"}, {0, "domain-b"},
+		{1, "domain-b"}, {1, "domain-b"},
+		{0, "domain-a"}, {0, "domain-b"},
+		{1, "domain-b"},
+	}
+	for _, e := range expected {
+		item, ok := s.scheduler.dequeue()
+		s.True(ok)
+		s.Equal(e.priority, item.task.Priority())
+		s.Equal(e.domain, s.taskDomains[item.task])
+	}
+
+	_, ok := s.scheduler.dequeue()
+	s.False(ok)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestSubmit_BlockedUntilSpaceAvailable() {
+	scheduler := s.newTestHierarchicalFairTaskScheduler(1)
+	s.NoError(scheduler.Submit(s.newMockTask(0, "domain-a")))
+
+	submitted := make(chan error, 1)
+	blockedTask := s.newMockTask(0, "domain-a")
+	go func() {
+		submitted <- scheduler.Submit(blockedTask)
+	}()
+
+	select {
+	case <-submitted:
+		s.Fail("submit should be blocked when the domain queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	_, ok := scheduler.dequeue()
+	s.True(ok)
+	s.NoError(<-submitted)
+
+	item, ok := scheduler.dequeue()
+	s.True(ok)
+	s.Equal(blockedTask, item.task)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestHierarchicalFair() {
+	numTasks := 1000
+	var taskWG sync.WaitGroup
+
+	s.mockProcessor.EXPECT().Start()
+	s.mockProcessor.EXPECT().Stop()
+
+	tasks := []PriorityTask{}
+	mockFn := func(_ Task) error {
+		taskWG.Done()
+		return nil
+	}
+	for i := 0; i != numTasks; i++ {
+		mockTask := s.newMockTask(rand.Intn(len(testSchedulerWeights())), "domain-a")
+		if rand.Intn(2) == 0 {
+			s.taskDomains[mockTask] = "domain-b"
+		}
+		tasks = append(tasks, mockTask)
+		taskWG.Add(1)
+		s.mockProcessor.EXPECT().Submit(newMockPriorityTaskMatcher(mockTask.(*MockPriorityTask))).DoAndReturn(mockFn)
+	}
+
+	s.scheduler.processor = s.mockProcessor
+	s.scheduler.Start()
+	for _, task := range tasks {
+		s.NoError(s.scheduler.Submit(task))
+	}
+	taskWG.Wait()
+	s.scheduler.Stop()
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) TestSchedulerContract() {
+	testSchedulerContract(s.Assertions, s.controller, s.scheduler)
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) newMockTask(
+	priority int,
+	domain string,
+) PriorityTask {
+	mockTask := NewMockPriorityTask(s.controller)
+	mockTask.EXPECT().Priority().Return(priority).AnyTimes()
+	s.taskDomains[mockTask] = domain
+	return mockTask
+}
+
+func (s *hierarchicalFairTaskSchedulerSuite) newTestHierarchicalFairTaskScheduler(
+	queueSize int,
+) *hierarchicalFairTaskSchedulerImpl {
+	scheduler, err := NewHierarchicalFairTaskScheduler(
+		loggerimpl.NewLoggerForTest(s.Suite),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		&HierarchicalFairTaskSchedulerOptions{
+			PriorityWeights: testSchedulerWeights,
+			DomainWeight: func(domain string) int {
+				return testSchedulerDomainWeights[domain]
+			},
+			TaskDomain: func(task PriorityTask) string {
+				return s.taskDomains[task]
+			},
+			QueueSize:       queueSize,
+			WorkerCount:     dynamicconfig.GetIntPropertyFn(1),
+			DispatcherCount: 3,
+			RetryPolicy:     backoff.NewExponentialRetryPolicy(time.Millisecond),
+		},
+	)
+	s.NoError(err)
+	return scheduler.(*hierarchicalFairTaskSchedulerImpl)
+}
@@ -98,6 +98,8 @@ const (
 	SchedulerTypeFIFO SchedulerType = iota + 1
 	// SchedulerTypeWRR is the scheduler type for weighted round robin scheduler implementation
 	SchedulerTypeWRR
+	// SchedulerTypeHierarchicalFair is the scheduler type for hierarchical fair scheduler implementation
+	SchedulerTypeHierarchicalFair
 )
 
 const (
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 )
 
@@ -39,6 +40,9 @@ type (
 		QueueSize   int
 		WorkerCount dynamicconfig.IntPropertyFn
 		RetryPolicy backoff.RetryPolicy
+		// WorkerCountSubscription is optional, when set the number of workers is updated
+		// as soon as the worker count changes instead of at the next check
+		WorkerCountSubscription dynamicconfig.IntPropertySubscriptionFn
 	}
 
 	parallelTaskProcessorImpl struct {
@@ -46,6 +50,8 @@ type (
 		tasksCh          chan Task
 		shutdownCh       chan struct{}
 		workerShutdownCh []chan struct{}
+		workerCountCh    chan struct{}
+		unsubscribe      func()
 		shutdownWG       sync.WaitGroup
 		logger           log.Logger
 		metricsScope     metrics.Scope
@@ -73,6 +79,7 @@ func NewParallelTaskProcessor(
 		tasksCh:          make(chan Task, options.QueueSize),
 		shutdownCh:       make(chan struct{}),
 		workerShutdownCh: make([]chan struct{}, 0, options.WorkerCount()),
+		workerCountCh:    make(chan struct{}, 1),
 		logger:           logger,
 		metricsScope:     metricsClient.Scope(metrics.ParallelTaskProcessingScope),
 		options:          options,
@@ -93,6 +100,20 @@ func (p *parallelTaskProcessorImpl) Start() {
 		go p.taskWorker(shutdownCh)
 	}
 
+	if p.options.WorkerCountSubscription != nil {
+		unsubscribe, err := p.options.WorkerCountSubscription(func(int) {
+			select {
+			case p.workerCountCh <- struct{}{}:
+			default:
+			}
+		})
+		if err != nil {
+			p.logger.Warn("Parallel task processor failed to subscribe to the worker count.", tag.Error(err))
+		} else {
+			p.unsubscribe = unsubscribe
+		}
+	}
+
 	p.shutdownWG.Add(1)
 	go p.workerMonitor(defaultMonitorTickerDuration)
 
@@ -104,6 +125,9 @@ func (p *parallelTaskProcessorImpl) Stop() {
 		return
 	}
 
+	if p.unsubscribe != nil {
+		p.unsubscribe()
+	}
 	close(p.shutdownCh)
 
 	p.drainAndNackTasks()
@@ -190,14 +214,20 @@ func (p *parallelTaskProcessorImpl) workerMonitor(tickerDuration time.Duration)
 			p.removeWorker(len(p.workerShutdownCh))
 			return
 		case <-ticker.C:
-			targetWorkerCount := p.options.WorkerCount()
-			currentWorkerCount := len(p.workerShutdownCh)
-			p.addWorker(targetWorkerCount - currentWorkerCount)
-			p.removeWorker(currentWorkerCount - targetWorkerCount)
+			p.updateWorkerCount()
+		case <-p.workerCountCh:
+			p.updateWorkerCount()
 		}
 	}
 }
 
+func (p *parallelTaskProcessorImpl) updateWorkerCount() {
+	targetWorkerCount := p.options.WorkerCount()
+	currentWorkerCount := len(p.workerShutdownCh)
+	p.addWorker(targetWorkerCount - currentWorkerCount)
+	p.removeWorker(currentWorkerCount - targetWorkerCount)
+}
+
 func (p *parallelTaskProcessorImpl) addWorker(count int) {
 	for i := 0; i < count; i++ {
 		shutdownCh := make(chan struct{})
@@ -242,6 +242,29 @@ func (s *parallelTaskProcessorSuite) TestMonitor() {
 	s.processor.shutdownWG.Wait()
 }
 
+func (s *parallelTaskProcessorSuite) TestMonitor_WorkerCountSubscription() {
+	workerCount := 5
+
+	dcClient := dynamicconfig.NewInMemoryClient()
+	dcCollection := dynamicconfig.NewCollection(dcClient, s.processor.logger)
+	s.processor.options.WorkerCount = dcCollection.GetIntProperty(dynamicconfig.TaskSchedulerWorkerCount, workerCount)
+	s.processor.options.WorkerCountSubscription = dcCollection.GetIntPropertySubscription(dynamicconfig.TaskSchedulerWorkerCount, workerCount)
+	s.processor.Start()
+
+	// the workers are removed once the change is pushed, well before the monitor ticks
+	newWorkerCount := 3
+	dcClient.UpdateValue(dynamicconfig.TaskSchedulerWorkerCount, newWorkerCount)
+
+	time.Sleep(100 * time.Millisecond)
+	for i := 0; i != newWorkerCount+1; i++ {
+		s.processor.shutdownWG.Done()
+	}
+	s.processor.shutdownWG.Wait()
+	s.processor.shutdownWG.Add(newWorkerCount + 1)
+
+	s.processor.Stop()
+}
+
 func (s *parallelTaskProcessorSuite) TestProcessorContract() {
 	numTasks := 10000
 	var taskWG sync.WaitGroup
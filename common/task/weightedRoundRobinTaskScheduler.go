@@ -43,6 +43,8 @@ type (
 		WorkerCount     dynamicconfig.IntPropertyFn
 		DispatcherCount int
 		RetryPolicy     backoff.RetryPolicy
+		// WorkerCountSubscription is optional, it updates the number of workers as soon as the worker count changes
+		WorkerCountSubscription dynamicconfig.IntPropertySubscriptionFn
 	}
 
 	weightedRoundRobinTaskSchedulerImpl struct {
@@ -99,9 +101,10 @@ func NewWeightedRoundRobinTaskScheduler(
 			logger,
 			metricsClient,
 			&ParallelTaskProcessorOptions{
-				QueueSize:   wRRTaskProcessorQueueSize,
-				WorkerCount: options.WorkerCount,
-				RetryPolicy: options.RetryPolicy,
+				QueueSize:               wRRTaskProcessorQueueSize,
+				WorkerCount:             options.WorkerCount,
+				WorkerCountSubscription: options.WorkerCountSubscription,
+				RetryPolicy:             options.RetryPolicy,
 			},
 		),
 	}
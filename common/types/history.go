@@ -64,6 +64,28 @@ func (v *DescribeMutableStateResponse) GetMutableStateInDatabase() (o string) {
 	return
 }
 
+// HistoryDeleteWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryDeleteWorkflowExecutionRequest struct {
+	DomainUUID    string                          `json:"domainUUID,omitempty"`
+	DeleteRequest *DeleteWorkflowExecutionRequest `json:"deleteRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryDeleteWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetDeleteRequest is an internal getter (TBD...)
+func (v *HistoryDeleteWorkflowExecutionRequest) GetDeleteRequest() (o *DeleteWorkflowExecutionRequest) {
+	if v != nil && v.DeleteRequest != nil {
+		return v.DeleteRequest
+	}
+	return
+}
+
 // HistoryDescribeWorkflowExecutionRequest is an internal type (TBD...)
 type HistoryDescribeWorkflowExecutionRequest struct {
 	DomainUUID string                            `json:"domainUUID,omitempty"`
@@ -1807,6 +1829,37 @@ func (v *HistoryTerminateWorkflowExecutionRequest) GetTerminateRequest() (o *Ter
 	return
 }
 
+// UpsertWorkflowSearchAttributesRequest is an internal type (TBD...)
+type UpsertWorkflowSearchAttributesRequest struct {
+	DomainUUID        string             `json:"domainUUID,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	SearchAttributes  *SearchAttributes  `json:"searchAttributes,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesRequest) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
 // GetFailoverInfoRequest is an internal type (TBD...)
 type GetFailoverInfoRequest struct {
 	DomainID string `json:"domainID,omitempty"`
@@ -1300,6 +1300,191 @@ func ToEventFilterType(t apiv1.EventFilterType) *types.HistoryEventFilterType {
 	panic("unexpected enum value")
 }
 
+func FromEventType(t *types.EventType) apiv1.EventType {
+	if t == nil {
+		return apiv1.EventType_EVENT_TYPE_INVALID
+	}
+	switch *t {
+	case types.EventTypeWorkflowExecutionStarted:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED
+	case types.EventTypeWorkflowExecutionCompleted:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	case types.EventTypeWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_FAILED
+	case types.EventTypeWorkflowExecutionTimedOut:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT
+	case types.EventTypeDecisionTaskScheduled:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_SCHEDULED
+	case types.EventTypeDecisionTaskStarted:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_STARTED
+	case types.EventTypeDecisionTaskCompleted:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_COMPLETED
+	case types.EventTypeDecisionTaskTimedOut:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_TIMED_OUT
+	case types.EventTypeDecisionTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_FAILED
+	case types.EventTypeActivityTaskScheduled:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_SCHEDULED
+	case types.EventTypeActivityTaskStarted:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_STARTED
+	case types.EventTypeActivityTaskCompleted:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_COMPLETED
+	case types.EventTypeActivityTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_FAILED
+	case types.EventTypeActivityTaskTimedOut:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT
+	case types.EventTypeActivityTaskCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED
+	case types.EventTypeRequestCancelActivityTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED
+	case types.EventTypeActivityTaskCanceled:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCELED
+	case types.EventTypeTimerStarted:
+		return apiv1.EventType_EVENT_TYPE_TIMER_STARTED
+	case types.EventTypeTimerFired:
+		return apiv1.EventType_EVENT_TYPE_TIMER_FIRED
+	case types.EventTypeCancelTimerFailed:
+		return apiv1.EventType_EVENT_TYPE_CANCEL_TIMER_FAILED
+	case types.EventTypeTimerCanceled:
+		return apiv1.EventType_EVENT_TYPE_TIMER_CANCELED
+	case types.EventTypeWorkflowExecutionCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case types.EventTypeWorkflowExecutionCanceled:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED
+	case types.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case types.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case types.EventTypeExternalWorkflowExecutionCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case types.EventTypeMarkerRecorded:
+		return apiv1.EventType_EVENT_TYPE_MARKER_RECORDED
+	case types.EventTypeWorkflowExecutionSignaled:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED
+	case types.EventTypeWorkflowExecutionTerminated:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED
+	case types.EventTypeWorkflowExecutionContinuedAsNew:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW
+	case types.EventTypeStartChildWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED
+	case types.EventTypeStartChildWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED
+	case types.EventTypeChildWorkflowExecutionStarted:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED
+	case types.EventTypeChildWorkflowExecutionCompleted:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED
+	case types.EventTypeChildWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED
+	case types.EventTypeChildWorkflowExecutionCanceled:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED
+	case types.EventTypeChildWorkflowExecutionTimedOut:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT
+	case types.EventTypeChildWorkflowExecutionTerminated:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED
+	case types.EventTypeSignalExternalWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case types.EventTypeSignalExternalWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case types.EventTypeExternalWorkflowExecutionSignaled:
+		return apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED
+	case types.EventTypeUpsertWorkflowSearchAttributes:
+		return apiv1.EventType_EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES
+	}
+	panic("unexpected enum value")
+}
+
+func ToEventType(t apiv1.EventType) *types.EventType {
+	switch t {
+	case apiv1.EventType_EVENT_TYPE_INVALID:
+		return nil
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		return types.EventTypeWorkflowExecutionStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return types.EventTypeWorkflowExecutionCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return types.EventTypeWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return types.EventTypeWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_SCHEDULED:
+		return types.EventTypeDecisionTaskScheduled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_STARTED:
+		return types.EventTypeDecisionTaskStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_COMPLETED:
+		return types.EventTypeDecisionTaskCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_TIMED_OUT:
+		return types.EventTypeDecisionTaskTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_FAILED:
+		return types.EventTypeDecisionTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		return types.EventTypeActivityTaskScheduled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_STARTED:
+		return types.EventTypeActivityTaskStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+		return types.EventTypeActivityTaskCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		return types.EventTypeActivityTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+		return types.EventTypeActivityTaskTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED:
+		return types.EventTypeActivityTaskCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED:
+		return types.EventTypeRequestCancelActivityTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+		return types.EventTypeActivityTaskCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_STARTED:
+		return types.EventTypeTimerStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_FIRED:
+		return types.EventTypeTimerFired.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CANCEL_TIMER_FAILED:
+		return types.EventTypeCancelTimerFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_CANCELED:
+		return types.EventTypeTimerCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return types.EventTypeWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return types.EventTypeWorkflowExecutionCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return types.EventTypeRequestCancelExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return types.EventTypeRequestCancelExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return types.EventTypeExternalWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_MARKER_RECORDED:
+		return types.EventTypeMarkerRecorded.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		return types.EventTypeWorkflowExecutionSignaled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return types.EventTypeWorkflowExecutionTerminated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return types.EventTypeWorkflowExecutionContinuedAsNew.Ptr()
+	case apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		return types.EventTypeStartChildWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return types.EventTypeStartChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+		return types.EventTypeChildWorkflowExecutionStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+		return types.EventTypeChildWorkflowExecutionCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return types.EventTypeChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+		return types.EventTypeChildWorkflowExecutionCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+		return types.EventTypeChildWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+		return types.EventTypeChildWorkflowExecutionTerminated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return types.EventTypeSignalExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return types.EventTypeSignalExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED:
+		return types.EventTypeExternalWorkflowExecutionSignaled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		return types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromExternalWorkflowExecutionCancelRequestedEventAttributes(t *types.ExternalWorkflowExecutionCancelRequestedEventAttributes) *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes {
 	if t == nil {
 		return nil
@@ -2672,6 +2857,35 @@ func ToResetStickyTaskListRequest(t *apiv1.ResetStickyTaskListRequest) *types.Re
 	}
 }
 
+func FromResetChildWorkflowPolicy(t *types.ResetChildWorkflowPolicy) apiv1.ResetChildWorkflowPolicy {
+	if t == nil {
+		return apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_INVALID
+	}
+	switch *t {
+	case types.ResetChildWorkflowPolicyReject:
+		return apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_REJECT
+	case types.ResetChildWorkflowPolicyTerminate:
+		return apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_TERMINATE
+	case types.ResetChildWorkflowPolicyReattach:
+		return apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_REATTACH
+	}
+	panic("unexpected enum value")
+}
+
+func ToResetChildWorkflowPolicy(t apiv1.ResetChildWorkflowPolicy) *types.ResetChildWorkflowPolicy {
+	switch t {
+	case apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_INVALID:
+		return nil
+	case apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_REJECT:
+		return types.ResetChildWorkflowPolicyReject.Ptr()
+	case apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_TERMINATE:
+		return types.ResetChildWorkflowPolicyTerminate.Ptr()
+	case apiv1.ResetChildWorkflowPolicy_RESET_CHILD_WORKFLOW_POLICY_REATTACH:
+		return types.ResetChildWorkflowPolicyReattach.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *apiv1.ResetWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -2683,6 +2897,8 @@ func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *
 		DecisionFinishEventId: t.DecisionFinishEventID,
 		RequestId:             t.RequestID,
 		SkipSignalReapply:     t.SkipSignalReapply,
+		ChildWorkflowPolicy:   FromResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:     FromEventTypeArray(t.ReapplyEventTypes),
 	}
 }
 
@@ -2697,6 +2913,8 @@ func ToResetWorkflowExecutionRequest(t *apiv1.ResetWorkflowExecutionRequest) *ty
 		DecisionFinishEventID: t.DecisionFinishEventId,
 		RequestID:             t.RequestId,
 		SkipSignalReapply:     t.SkipSignalReapply,
+		ChildWorkflowPolicy:   ToResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:     ToEventTypeArray(t.ReapplyEventTypes),
 	}
 }
 
@@ -3783,6 +4001,28 @@ func ToTaskListType(t apiv1.TaskListType) *types.TaskListType {
 	panic("unexpected enum value")
 }
 
+func FromDeleteWorkflowExecutionRequest(t *types.DeleteWorkflowExecutionRequest) *apiv1.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeleteWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+	}
+}
+
+func ToDeleteWorkflowExecutionRequest(t *apiv1.DeleteWorkflowExecutionRequest) *types.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+	}
+}
+
 func FromTerminateWorkflowExecutionRequest(t *types.TerminateWorkflowExecutionRequest) *apiv1.TerminateWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -5007,6 +5247,30 @@ func ToDescribeDomainResponseArray(t []*apiv1.Domain) []*types.DescribeDomainRes
 	return v
 }
 
+func FromEventTypeArray(t []types.EventType) []apiv1.EventType {
+	if t == nil {
+		return nil
+	}
+	v := make([]apiv1.EventType, len(t))
+	for i := range t {
+		v[i] = FromEventType(&t[i])
+	}
+	return v
+}
+
+func ToEventTypeArray(t []apiv1.EventType) []types.EventType {
+	if t == nil {
+		return nil
+	}
+	v := make([]types.EventType, 0, len(t))
+	for i := range t {
+		if eventType := ToEventType(t[i]); eventType != nil {
+			v = append(v, *eventType)
+		}
+	}
+	return v
+}
+
 func FromResetPointInfoArray(t []*types.ResetPointInfo) []*apiv1.ResetPointInfo {
 	if t == nil {
 		return nil
@@ -5780,3 +6044,721 @@ func ToListOpenWorkflowExecutionsRequest(r *apiv1.ListOpenWorkflowExecutionsRequ
 	}
 	return &request
 }
+
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) apiv1.ScheduleOverlapPolicy {
+	if t == nil {
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
+	}
+	switch *t {
+	case types.ScheduleOverlapPolicySkip:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP
+	case types.ScheduleOverlapPolicyBuffer:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER
+	case types.ScheduleOverlapPolicyCancelOther:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER
+	case types.ScheduleOverlapPolicyAllowAll:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL
+	}
+	panic("unexpected enum value")
+}
+
+func ToScheduleOverlapPolicy(t apiv1.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	switch t {
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID:
+		return nil
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP:
+		return types.ScheduleOverlapPolicySkip.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+		return types.ScheduleOverlapPolicyCancelOther.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL:
+		return types.ScheduleOverlapPolicyAllowAll.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromScheduleSpec(t *types.ScheduleSpec) *apiv1.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleSpec{
+		CronSchedule:  t.CronSchedule,
+		StartTime:     unixNanoToTime(t.StartTimeNano),
+		EndTime:       unixNanoToTime(t.EndTimeNano),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchupWindow: secondsToDuration(t.CatchupWindowSeconds),
+	}
+}
+
+func ToScheduleSpec(t *apiv1.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronSchedule:         t.CronSchedule,
+		StartTimeNano:        timeToUnixNano(t.StartTime),
+		EndTimeNano:          timeToUnixNano(t.EndTime),
+		OverlapPolicy:        ToScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchupWindowSeconds: durationToSeconds(t.CatchupWindow),
+	}
+}
+
+func FromScheduleStartWorkflowAction(t *types.ScheduleStartWorkflowAction) *apiv1.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleStartWorkflowAction{
+		WorkflowType:                 FromWorkflowType(t.WorkflowType),
+		TaskList:                     FromTaskList(t.TaskList),
+		Input:                        FromPayload(t.Input),
+		WorkflowIdPrefix:             t.WorkflowIDPrefix,
+		ExecutionStartToCloseTimeout: secondsToDuration(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeout:      secondsToDuration(t.TaskStartToCloseTimeoutSeconds),
+	}
+}
+
+func ToScheduleStartWorkflowAction(t *apiv1.ScheduleStartWorkflowAction) *types.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleStartWorkflowAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               ToPayload(t.Input),
+		WorkflowIDPrefix:                    t.WorkflowIdPrefix,
+		ExecutionStartToCloseTimeoutSeconds: durationToSeconds(t.ExecutionStartToCloseTimeout),
+		TaskStartToCloseTimeoutSeconds:      durationToSeconds(t.TaskStartToCloseTimeout),
+	}
+}
+
+func FromScheduleActionResult(t *types.ScheduleActionResult) *apiv1.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleActionResult{
+		ScheduledTime:     unixNanoToTime(t.ScheduledTimeNano),
+		ActualTime:        unixNanoToTime(t.ActualTimeNano),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func ToScheduleActionResult(t *apiv1.ScheduleActionResult) *types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleActionResult{
+		ScheduledTimeNano: timeToUnixNano(t.ScheduledTime),
+		ActualTimeNano:    timeToUnixNano(t.ActualTime),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func FromScheduleState(t *types.ScheduleState) *apiv1.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleState{
+		Paused:              t.Paused,
+		Notes:               t.Notes,
+		LastProcessedTime:   unixNanoToTime(t.LastProcessedTimeNano),
+		RecentActions:       FromScheduleActionResultArray(t.RecentActions),
+		TotalActions:        t.TotalActions,
+		SkippedActions:      t.SkippedActions,
+		MissedCatchupWindow: t.MissedCatchupWindow,
+		FailedActions:       t.FailedActions,
+	}
+}
+
+func ToScheduleState(t *apiv1.ScheduleState) *types.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleState{
+		Paused:                t.Paused,
+		Notes:                 t.Notes,
+		LastProcessedTimeNano: timeToUnixNano(t.LastProcessedTime),
+		RecentActions:         ToScheduleActionResultArray(t.RecentActions),
+		TotalActions:          t.TotalActions,
+		SkippedActions:        t.SkippedActions,
+		MissedCatchupWindow:   t.MissedCatchupWindow,
+		FailedActions:         t.FailedActions,
+	}
+}
+
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *apiv1.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CreateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+func ToCreateScheduleRequest(t *apiv1.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *apiv1.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToDescribeScheduleRequest(t *apiv1.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *apiv1.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleResponse{
+		Spec:            FromScheduleSpec(t.Spec),
+		Action:          FromScheduleStartWorkflowAction(t.Action),
+		State:           FromScheduleState(t.State),
+		NextActionTimes: unixNanoArrayToTimes(t.NextActionTimesNano),
+	}
+}
+
+func ToDescribeScheduleResponse(t *apiv1.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:                ToScheduleSpec(t.Spec),
+		Action:              ToScheduleStartWorkflowAction(t.Action),
+		State:               ToScheduleState(t.State),
+		NextActionTimesNano: timesToUnixNanoArray(t.NextActionTimes),
+	}
+}
+
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *apiv1.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+func ToUpdateScheduleRequest(t *apiv1.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *apiv1.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Reason:     t.Reason,
+	}
+}
+
+func ToPauseScheduleRequest(t *apiv1.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Reason:     t.Reason,
+	}
+}
+
+func FromUnpauseScheduleRequest(t *types.UnpauseScheduleRequest) *apiv1.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Reason:     t.Reason,
+	}
+}
+
+func ToUnpauseScheduleRequest(t *apiv1.UnpauseScheduleRequest) *types.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Reason:     t.Reason,
+	}
+}
+
+func FromTriggerScheduleRequest(t *types.TriggerScheduleRequest) *apiv1.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TriggerScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func ToTriggerScheduleRequest(t *apiv1.TriggerScheduleRequest) *types.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleID:    t.ScheduleId,
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *apiv1.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BackfillScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		StartTime:     unixNanoToTime(t.StartTimeNano),
+		EndTime:       unixNanoToTime(t.EndTimeNano),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func ToBackfillScheduleRequest(t *apiv1.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleID:    t.ScheduleId,
+		StartTimeNano: timeToUnixNano(t.StartTime),
+		EndTimeNano:   timeToUnixNano(t.EndTime),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func FromDeleteScheduleRequest(t *types.DeleteScheduleRequest) *apiv1.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeleteScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToDeleteScheduleRequest(t *apiv1.DeleteScheduleRequest) *types.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *apiv1.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSchedulesRequest(t *apiv1.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *apiv1.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListSchedulesResponse{
+		ScheduleIds:   t.ScheduleIDs,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSchedulesResponse(t *apiv1.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		ScheduleIDs:   t.ScheduleIds,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromScheduleActionResultArray(t []*types.ScheduleActionResult) []*apiv1.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = FromScheduleActionResult(t[i])
+	}
+	return v
+}
+
+func ToScheduleActionResultArray(t []*apiv1.ScheduleActionResult) []*types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = ToScheduleActionResult(t[i])
+	}
+	return v
+}
+
+func FromBatchOperationType(t *types.BatchOperationType) apiv1.BatchOperationType {
+	if t == nil {
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_INVALID
+	}
+	switch *t {
+	case types.BatchOperationTypeTerminate:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_TERMINATE
+	case types.BatchOperationTypeCancel:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_CANCEL
+	case types.BatchOperationTypeSignal:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_SIGNAL
+	case types.BatchOperationTypeReset:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_RESET
+	case types.BatchOperationTypeDelete:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_DELETE
+	case types.BatchOperationTypeUpsertSearchAttributes:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_UPSERT_SEARCH_ATTRIBUTES
+	}
+	panic("unexpected enum value")
+}
+
+func ToBatchOperationType(t apiv1.BatchOperationType) *types.BatchOperationType {
+	switch t {
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_INVALID:
+		return nil
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_TERMINATE:
+		return types.BatchOperationTypeTerminate.Ptr()
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_CANCEL:
+		return types.BatchOperationTypeCancel.Ptr()
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_SIGNAL:
+		return types.BatchOperationTypeSignal.Ptr()
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_RESET:
+		return types.BatchOperationTypeReset.Ptr()
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_DELETE:
+		return types.BatchOperationTypeDelete.Ptr()
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_UPSERT_SEARCH_ATTRIBUTES:
+		return types.BatchOperationTypeUpsertSearchAttributes.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromBatchOperationResetParams(t *types.BatchOperationResetParams) *apiv1.BatchOperationResetParams {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BatchOperationResetParams{
+		ResetType:            t.ResetType,
+		BadBinaryChecksum:    t.BadBinaryChecksum,
+		EarliestTime:         unixNanoToTime(t.EarliestTimeNano),
+		SkipCurrentOpen:      t.SkipCurrentOpen,
+		SkipBaseIsNotCurrent: t.SkipBaseIsNotCurrent,
+		NonDeterministicOnly: t.NonDeterministicOnly,
+		SkipSignalReapply:    t.SkipSignalReapply,
+		ChildWorkflowPolicy:  FromResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:    FromEventTypeArray(t.ReapplyEventTypes),
+	}
+}
+
+func ToBatchOperationResetParams(t *apiv1.BatchOperationResetParams) *types.BatchOperationResetParams {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationResetParams{
+		ResetType:            t.ResetType,
+		BadBinaryChecksum:    t.BadBinaryChecksum,
+		EarliestTimeNano:     timeToUnixNano(t.EarliestTime),
+		SkipCurrentOpen:      t.SkipCurrentOpen,
+		SkipBaseIsNotCurrent: t.SkipBaseIsNotCurrent,
+		NonDeterministicOnly: t.NonDeterministicOnly,
+		SkipSignalReapply:    t.SkipSignalReapply,
+		ChildWorkflowPolicy:  ToResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:    ToEventTypeArray(t.ReapplyEventTypes),
+	}
+}
+
+func FromBatchOperationInfo(t *types.BatchOperationInfo) *apiv1.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BatchOperationInfo{
+		JobId:       t.JobID,
+		CloseStatus: FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		Reason:      t.Reason,
+		Identity:    t.Identity,
+		StartTime:   unixNanoToTime(t.StartTimeNano),
+		CloseTime:   unixNanoToTime(t.CloseTimeNano),
+	}
+}
+
+func ToBatchOperationInfo(t *apiv1.BatchOperationInfo) *types.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationInfo{
+		JobID:         t.JobId,
+		CloseStatus:   ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		Reason:        t.Reason,
+		Identity:      t.Identity,
+		StartTimeNano: timeToUnixNano(t.StartTime),
+		CloseTimeNano: timeToUnixNano(t.CloseTime),
+	}
+}
+
+func FromBatchOperationProgress(t *types.BatchOperationProgress) *apiv1.BatchOperationProgress {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BatchOperationProgress{
+		TotalEstimate: t.TotalEstimate,
+		SuccessCount:  t.SuccessCount,
+		ErrorCount:    t.ErrorCount,
+	}
+}
+
+func ToBatchOperationProgress(t *apiv1.BatchOperationProgress) *types.BatchOperationProgress {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationProgress{
+		TotalEstimate: t.TotalEstimate,
+		SuccessCount:  t.SuccessCount,
+		ErrorCount:    t.ErrorCount,
+	}
+}
+
+func FromStartBatchOperationRequest(t *types.StartBatchOperationRequest) *apiv1.StartBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartBatchOperationRequest{
+		Domain:           t.Domain,
+		Query:            t.Query,
+		Reason:           t.Reason,
+		BatchType:        FromBatchOperationType(t.BatchType),
+		DryRun:           t.DryRun,
+		Rps:              t.RPS,
+		Concurrency:      t.Concurrency,
+		SkipChildren:     t.SkipChildren,
+		SignalName:       t.SignalName,
+		SignalInput:      FromPayload(t.SignalInput),
+		ResetParams:      FromBatchOperationResetParams(t.ResetParams),
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+		Identity:         t.Identity,
+	}
+}
+
+func ToStartBatchOperationRequest(t *apiv1.StartBatchOperationRequest) *types.StartBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StartBatchOperationRequest{
+		Domain:           t.Domain,
+		Query:            t.Query,
+		Reason:           t.Reason,
+		BatchType:        ToBatchOperationType(t.BatchType),
+		DryRun:           t.DryRun,
+		RPS:              t.Rps,
+		Concurrency:      t.Concurrency,
+		SkipChildren:     t.SkipChildren,
+		SignalName:       t.SignalName,
+		SignalInput:      ToPayload(t.SignalInput),
+		ResetParams:      ToBatchOperationResetParams(t.ResetParams),
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+		Identity:         t.Identity,
+	}
+}
+
+func FromStartBatchOperationResponse(t *types.StartBatchOperationResponse) *apiv1.StartBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartBatchOperationResponse{
+		JobId: t.JobID,
+	}
+}
+
+func ToStartBatchOperationResponse(t *apiv1.StartBatchOperationResponse) *types.StartBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StartBatchOperationResponse{
+		JobID: t.JobId,
+	}
+}
+
+func FromDescribeBatchOperationRequest(t *types.DescribeBatchOperationRequest) *apiv1.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeBatchOperationRequest{
+		Domain: t.Domain,
+		JobId:  t.JobID,
+	}
+}
+
+func ToDescribeBatchOperationRequest(t *apiv1.DescribeBatchOperationRequest) *types.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchOperationRequest{
+		Domain: t.Domain,
+		JobID:  t.JobId,
+	}
+}
+
+func FromDescribeBatchOperationResponse(t *types.DescribeBatchOperationResponse) *apiv1.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeBatchOperationResponse{
+		Info:     FromBatchOperationInfo(t.Info),
+		Progress: FromBatchOperationProgress(t.Progress),
+	}
+}
+
+func ToDescribeBatchOperationResponse(t *apiv1.DescribeBatchOperationResponse) *types.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchOperationResponse{
+		Info:     ToBatchOperationInfo(t.Info),
+		Progress: ToBatchOperationProgress(t.Progress),
+	}
+}
+
+func FromListBatchOperationsRequest(t *types.ListBatchOperationsRequest) *apiv1.ListBatchOperationsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListBatchOperationsRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListBatchOperationsRequest(t *apiv1.ListBatchOperationsRequest) *types.ListBatchOperationsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListBatchOperationsRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromListBatchOperationsResponse(t *types.ListBatchOperationsResponse) *apiv1.ListBatchOperationsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListBatchOperationsResponse{
+		Operations:    FromBatchOperationInfoArray(t.Operations),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListBatchOperationsResponse(t *apiv1.ListBatchOperationsResponse) *types.ListBatchOperationsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListBatchOperationsResponse{
+		Operations:    ToBatchOperationInfoArray(t.Operations),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromCancelBatchOperationRequest(t *types.CancelBatchOperationRequest) *apiv1.CancelBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelBatchOperationRequest{
+		Domain: t.Domain,
+		JobId:  t.JobID,
+	}
+}
+
+func ToCancelBatchOperationRequest(t *apiv1.CancelBatchOperationRequest) *types.CancelBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CancelBatchOperationRequest{
+		Domain: t.Domain,
+		JobID:  t.JobId,
+	}
+}
+
+func FromBatchOperationInfoArray(t []*types.BatchOperationInfo) []*apiv1.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.BatchOperationInfo, len(t))
+	for i := range t {
+		v[i] = FromBatchOperationInfo(t[i])
+	}
+	return v
+}
+
+func ToBatchOperationInfoArray(t []*apiv1.BatchOperationInfo) []*types.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.BatchOperationInfo, len(t))
+	for i := range t {
+		v[i] = ToBatchOperationInfo(t[i])
+	}
+	return v
+}
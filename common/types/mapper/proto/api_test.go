@@ -665,6 +665,11 @@ func TestTaskListStatus(t *testing.T) {
 		assert.Equal(t, item, ToTaskListStatus(FromTaskListStatus(item)))
 	}
 }
+func TestDeleteWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.DeleteWorkflowExecutionRequest{nil, {}, &testdata.DeleteWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToDeleteWorkflowExecutionRequest(FromDeleteWorkflowExecutionRequest(item)))
+	}
+}
 func TestTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.TerminateWorkflowExecutionRequest{nil, {}, &testdata.TerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToTerminateWorkflowExecutionRequest(FromTerminateWorkflowExecutionRequest(item)))
@@ -829,6 +834,11 @@ func TestDataBlobArray(t *testing.T) {
 		assert.Equal(t, item, ToDataBlobArray(FromDataBlobArray(item)))
 	}
 }
+func TestEventTypeArray(t *testing.T) {
+	for _, item := range [][]types.EventType{nil, {}, testdata.EventTypeArray} {
+		assert.Equal(t, item, ToEventTypeArray(FromEventTypeArray(item)))
+	}
+}
 func TestHistoryEventArray(t *testing.T) {
 	for _, item := range [][]*types.HistoryEvent{nil, {}, testdata.HistoryEventArray} {
 		assert.Equal(t, item, ToHistoryEventArray(FromHistoryEventArray(item)))
@@ -1018,3 +1028,138 @@ func TestListOpenWorkflowExecutionsRequest(t *testing.T) {
 		assert.Equal(t, item, ToListOpenWorkflowExecutionsRequest(FromListOpenWorkflowExecutionsRequest(item)))
 	}
 }
+func TestScheduleSpec(t *testing.T) {
+	for _, item := range []*types.ScheduleSpec{nil, {}, &testdata.ScheduleSpec} {
+		assert.Equal(t, item, ToScheduleSpec(FromScheduleSpec(item)))
+	}
+}
+func TestScheduleStartWorkflowAction(t *testing.T) {
+	for _, item := range []*types.ScheduleStartWorkflowAction{nil, {}, &testdata.ScheduleStartWorkflowAction} {
+		assert.Equal(t, item, ToScheduleStartWorkflowAction(FromScheduleStartWorkflowAction(item)))
+	}
+}
+func TestScheduleActionResult(t *testing.T) {
+	for _, item := range []*types.ScheduleActionResult{nil, {}, &testdata.ScheduleActionResult} {
+		assert.Equal(t, item, ToScheduleActionResult(FromScheduleActionResult(item)))
+	}
+}
+func TestScheduleState(t *testing.T) {
+	for _, item := range []*types.ScheduleState{nil, {}, &testdata.ScheduleState} {
+		assert.Equal(t, item, ToScheduleState(FromScheduleState(item)))
+	}
+}
+func TestCreateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.CreateScheduleRequest{nil, {}, &testdata.CreateScheduleRequest} {
+		assert.Equal(t, item, ToCreateScheduleRequest(FromCreateScheduleRequest(item)))
+	}
+}
+func TestDescribeScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleRequest{nil, {}, &testdata.DescribeScheduleRequest} {
+		assert.Equal(t, item, ToDescribeScheduleRequest(FromDescribeScheduleRequest(item)))
+	}
+}
+func TestDescribeScheduleResponse(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleResponse{nil, {}, &testdata.DescribeScheduleResponse} {
+		assert.Equal(t, item, ToDescribeScheduleResponse(FromDescribeScheduleResponse(item)))
+	}
+}
+func TestUpdateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UpdateScheduleRequest{nil, {}, &testdata.UpdateScheduleRequest} {
+		assert.Equal(t, item, ToUpdateScheduleRequest(FromUpdateScheduleRequest(item)))
+	}
+}
+func TestPauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.PauseScheduleRequest{nil, {}, &testdata.PauseScheduleRequest} {
+		assert.Equal(t, item, ToPauseScheduleRequest(FromPauseScheduleRequest(item)))
+	}
+}
+func TestUnpauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseScheduleRequest{nil, {}, &testdata.UnpauseScheduleRequest} {
+		assert.Equal(t, item, ToUnpauseScheduleRequest(FromUnpauseScheduleRequest(item)))
+	}
+}
+func TestTriggerScheduleRequest(t *testing.T) {
+	for _, item := range []*types.TriggerScheduleRequest{nil, {}, &testdata.TriggerScheduleRequest} {
+		assert.Equal(t, item, ToTriggerScheduleRequest(FromTriggerScheduleRequest(item)))
+	}
+}
+func TestBackfillScheduleRequest(t *testing.T) {
+	for _, item := range []*types.BackfillScheduleRequest{nil, {}, &testdata.BackfillScheduleRequest} {
+		assert.Equal(t, item, ToBackfillScheduleRequest(FromBackfillScheduleRequest(item)))
+	}
+}
+func TestDeleteScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DeleteScheduleRequest{nil, {}, &testdata.DeleteScheduleRequest} {
+		assert.Equal(t, item, ToDeleteScheduleRequest(FromDeleteScheduleRequest(item)))
+	}
+}
+func TestListSchedulesRequest(t *testing.T) {
+	for _, item := range []*types.ListSchedulesRequest{nil, {}, &testdata.ListSchedulesRequest} {
+		assert.Equal(t, item, ToListSchedulesRequest(FromListSchedulesRequest(item)))
+	}
+}
+func TestListSchedulesResponse(t *testing.T) {
+	for _, item := range []*types.ListSchedulesResponse{nil, {}, &testdata.ListSchedulesResponse} {
+		assert.Equal(t, item, ToListSchedulesResponse(FromListSchedulesResponse(item)))
+	}
+}
+func TestScheduleActionResultArray(t *testing.T) {
+	for _, item := range [][]*types.ScheduleActionResult{nil, {}, testdata.ScheduleActionResultArray} {
+		assert.Equal(t, item, ToScheduleActionResultArray(FromScheduleActionResultArray(item)))
+	}
+}
+func TestBatchOperationResetParams(t *testing.T) {
+	for _, item := range []*types.BatchOperationResetParams{nil, {}, &testdata.BatchOperationResetParams} {
+		assert.Equal(t, item, ToBatchOperationResetParams(FromBatchOperationResetParams(item)))
+	}
+}
+func TestBatchOperationInfo(t *testing.T) {
+	for _, item := range []*types.BatchOperationInfo{nil, {}, &testdata.BatchOperationInfo} {
+		assert.Equal(t, item, ToBatchOperationInfo(FromBatchOperationInfo(item)))
+	}
+}
+func TestBatchOperationProgress(t *testing.T) {
+	for _, item := range []*types.BatchOperationProgress{nil, {}, &testdata.BatchOperationProgress} {
+		assert.Equal(t, item, ToBatchOperationProgress(FromBatchOperationProgress(item)))
+	}
+}
+func TestStartBatchOperationRequest(t *testing.T) {
+	for _, item := range []*types.StartBatchOperationRequest{nil, {}, &testdata.StartBatchOperationRequest} {
+		assert.Equal(t, item, ToStartBatchOperationRequest(FromStartBatchOperationRequest(item)))
+	}
+}
+func TestStartBatchOperationResponse(t *testing.T) {
+	for _, item := range []*types.StartBatchOperationResponse{nil, {}, &testdata.StartBatchOperationResponse} {
+		assert.Equal(t, item, ToStartBatchOperationResponse(FromStartBatchOperationResponse(item)))
+	}
+}
+func TestDescribeBatchOperationRequest(t *testing.T) {
+	for _, item := range []*types.DescribeBatchOperationRequest{nil, {}, &testdata.DescribeBatchOperationRequest} {
+		assert.Equal(t, item, ToDescribeBatchOperationRequest(FromDescribeBatchOperationRequest(item)))
+	}
+}
+func TestDescribeBatchOperationResponse(t *testing.T) {
+	for _, item := range []*types.DescribeBatchOperationResponse{nil, {}, &testdata.DescribeBatchOperationResponse} {
+		assert.Equal(t, item, ToDescribeBatchOperationResponse(FromDescribeBatchOperationResponse(item)))
+	}
+}
+func TestListBatchOperationsRequest(t *testing.T) {
+	for _, item := range []*types.ListBatchOperationsRequest{nil, {}, &testdata.ListBatchOperationsRequest} {
+		assert.Equal(t, item, ToListBatchOperationsRequest(FromListBatchOperationsRequest(item)))
+	}
+}
+func TestListBatchOperationsResponse(t *testing.T) {
+	for _, item := range []*types.ListBatchOperationsResponse{nil, {}, &testdata.ListBatchOperationsResponse} {
+		assert.Equal(t, item, ToListBatchOperationsResponse(FromListBatchOperationsResponse(item)))
+	}
+}
+func TestCancelBatchOperationRequest(t *testing.T) {
+	for _, item := range []*types.CancelBatchOperationRequest{nil, {}, &testdata.CancelBatchOperationRequest} {
+		assert.Equal(t, item, ToCancelBatchOperationRequest(FromCancelBatchOperationRequest(item)))
+	}
+}
+func TestBatchOperationInfoArray(t *testing.T) {
+	for _, item := range [][]*types.BatchOperationInfo{nil, {}, testdata.BatchOperationInfoArray} {
+		assert.Equal(t, item, ToBatchOperationInfoArray(FromBatchOperationInfoArray(item)))
+	}
+}
@@ -198,6 +198,57 @@ func TestEventFilterType(t *testing.T) {
 	assert.Panics(t, func() { ToEventFilterType(apiv1.EventFilterType(UnknownValue)) })
 	assert.Panics(t, func() { FromEventFilterType(types.HistoryEventFilterType(UnknownValue).Ptr()) })
 }
+func TestEventType(t *testing.T) {
+	for _, item := range []*types.EventType{
+		nil,
+		types.EventTypeWorkflowExecutionStarted.Ptr(),
+		types.EventTypeWorkflowExecutionCompleted.Ptr(),
+		types.EventTypeWorkflowExecutionFailed.Ptr(),
+		types.EventTypeWorkflowExecutionTimedOut.Ptr(),
+		types.EventTypeDecisionTaskScheduled.Ptr(),
+		types.EventTypeDecisionTaskStarted.Ptr(),
+		types.EventTypeDecisionTaskCompleted.Ptr(),
+		types.EventTypeDecisionTaskTimedOut.Ptr(),
+		types.EventTypeDecisionTaskFailed.Ptr(),
+		types.EventTypeActivityTaskScheduled.Ptr(),
+		types.EventTypeActivityTaskStarted.Ptr(),
+		types.EventTypeActivityTaskCompleted.Ptr(),
+		types.EventTypeActivityTaskFailed.Ptr(),
+		types.EventTypeActivityTaskTimedOut.Ptr(),
+		types.EventTypeActivityTaskCancelRequested.Ptr(),
+		types.EventTypeRequestCancelActivityTaskFailed.Ptr(),
+		types.EventTypeActivityTaskCanceled.Ptr(),
+		types.EventTypeTimerStarted.Ptr(),
+		types.EventTypeTimerFired.Ptr(),
+		types.EventTypeCancelTimerFailed.Ptr(),
+		types.EventTypeTimerCanceled.Ptr(),
+		types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+		types.EventTypeWorkflowExecutionCanceled.Ptr(),
+		types.EventTypeRequestCancelExternalWorkflowExecutionInitiated.Ptr(),
+		types.EventTypeRequestCancelExternalWorkflowExecutionFailed.Ptr(),
+		types.EventTypeExternalWorkflowExecutionCancelRequested.Ptr(),
+		types.EventTypeMarkerRecorded.Ptr(),
+		types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		types.EventTypeWorkflowExecutionTerminated.Ptr(),
+		types.EventTypeWorkflowExecutionContinuedAsNew.Ptr(),
+		types.EventTypeStartChildWorkflowExecutionInitiated.Ptr(),
+		types.EventTypeStartChildWorkflowExecutionFailed.Ptr(),
+		types.EventTypeChildWorkflowExecutionStarted.Ptr(),
+		types.EventTypeChildWorkflowExecutionCompleted.Ptr(),
+		types.EventTypeChildWorkflowExecutionFailed.Ptr(),
+		types.EventTypeChildWorkflowExecutionCanceled.Ptr(),
+		types.EventTypeChildWorkflowExecutionTimedOut.Ptr(),
+		types.EventTypeChildWorkflowExecutionTerminated.Ptr(),
+		types.EventTypeSignalExternalWorkflowExecutionInitiated.Ptr(),
+		types.EventTypeSignalExternalWorkflowExecutionFailed.Ptr(),
+		types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
+		types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+	} {
+		assert.Equal(t, item, ToEventType(FromEventType(item)))
+	}
+	assert.Panics(t, func() { ToEventType(apiv1.EventType(UnknownValue)) })
+	assert.Panics(t, func() { FromEventType(types.EventType(UnknownValue).Ptr()) })
+}
 func TestIndexedValueType(t *testing.T) {
 	for _, item := range []types.IndexedValueType{
 		types.IndexedValueTypeString,
@@ -292,6 +343,18 @@ func TestQueryTaskCompletedType(t *testing.T) {
 	assert.Panics(t, func() { ToQueryTaskCompletedType(apiv1.QueryResultType(UnknownValue)) })
 	assert.Panics(t, func() { FromQueryTaskCompletedType(types.QueryTaskCompletedType(UnknownValue).Ptr()) })
 }
+func TestResetChildWorkflowPolicy(t *testing.T) {
+	for _, item := range []*types.ResetChildWorkflowPolicy{
+		nil,
+		types.ResetChildWorkflowPolicyReject.Ptr(),
+		types.ResetChildWorkflowPolicyTerminate.Ptr(),
+		types.ResetChildWorkflowPolicyReattach.Ptr(),
+	} {
+		assert.Equal(t, item, ToResetChildWorkflowPolicy(FromResetChildWorkflowPolicy(item)))
+	}
+	assert.Panics(t, func() { ToResetChildWorkflowPolicy(apiv1.ResetChildWorkflowPolicy(UnknownValue)) })
+	assert.Panics(t, func() { FromResetChildWorkflowPolicy(types.ResetChildWorkflowPolicy(UnknownValue).Ptr()) })
+}
 func TestSignalExternalWorkflowExecutionFailedCause(t *testing.T) {
 	for _, item := range []*types.SignalExternalWorkflowExecutionFailedCause{
 		nil,
@@ -408,3 +471,31 @@ func TestTaskType(t *testing.T) {
 	assert.Panics(t, func() { ToTaskType(sharedv1.TaskType(UnknownValue)) })
 	assert.Panics(t, func() { FromTaskType(common.Int32Ptr(UnknownValue)) })
 }
+func TestScheduleOverlapPolicy(t *testing.T) {
+	for _, item := range []*types.ScheduleOverlapPolicy{
+		nil,
+		types.ScheduleOverlapPolicySkip.Ptr(),
+		types.ScheduleOverlapPolicyBuffer.Ptr(),
+		types.ScheduleOverlapPolicyCancelOther.Ptr(),
+		types.ScheduleOverlapPolicyAllowAll.Ptr(),
+	} {
+		assert.Equal(t, item, ToScheduleOverlapPolicy(FromScheduleOverlapPolicy(item)))
+	}
+	assert.Panics(t, func() { ToScheduleOverlapPolicy(apiv1.ScheduleOverlapPolicy(UnknownValue)) })
+	assert.Panics(t, func() { FromScheduleOverlapPolicy(types.ScheduleOverlapPolicy(UnknownValue).Ptr()) })
+}
+func TestBatchOperationType(t *testing.T) {
+	for _, item := range []*types.BatchOperationType{
+		nil,
+		types.BatchOperationTypeTerminate.Ptr(),
+		types.BatchOperationTypeCancel.Ptr(),
+		types.BatchOperationTypeSignal.Ptr(),
+		types.BatchOperationTypeReset.Ptr(),
+		types.BatchOperationTypeDelete.Ptr(),
+		types.BatchOperationTypeUpsertSearchAttributes.Ptr(),
+	} {
+		assert.Equal(t, item, ToBatchOperationType(FromBatchOperationType(item)))
+	}
+	assert.Panics(t, func() { ToBatchOperationType(apiv1.BatchOperationType(UnknownValue)) })
+	assert.Panics(t, func() { FromBatchOperationType(types.BatchOperationType(UnknownValue).Ptr()) })
+}
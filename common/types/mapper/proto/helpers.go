@@ -79,6 +79,28 @@ func timeToUnixNano(t *gogo.Timestamp) *int64 {
 	return common.Int64Ptr(timestamp.UnixNano())
 }
 
+func unixNanoArrayToTimes(t []int64) []*gogo.Timestamp {
+	if t == nil {
+		return nil
+	}
+	v := make([]*gogo.Timestamp, len(t))
+	for i := range t {
+		v[i] = unixNanoToTime(&t[i])
+	}
+	return v
+}
+
+func timesToUnixNanoArray(t []*gogo.Timestamp) []int64 {
+	if t == nil {
+		return nil
+	}
+	v := make([]int64, len(t))
+	for i := range t {
+		v[i] = *timeToUnixNano(t[i])
+	}
+	return v
+}
+
 func daysToDuration(d *int32) *gogo.Duration {
 	if d == nil {
 		return nil
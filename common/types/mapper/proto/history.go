@@ -1411,6 +1411,48 @@ func ToHistorySyncShardStatusRequest(t *historyv1.SyncShardStatusRequest) *types
 	}
 }
 
+func FromHistoryDeleteWorkflowExecutionRequest(t *types.HistoryDeleteWorkflowExecutionRequest) *historyv1.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.DeleteWorkflowExecutionRequest{
+		Request:  FromDeleteWorkflowExecutionRequest(t.DeleteRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryDeleteWorkflowExecutionRequest(t *historyv1.DeleteWorkflowExecutionRequest) *types.HistoryDeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowExecutionRequest{
+		DeleteRequest: ToDeleteWorkflowExecutionRequest(t.Request),
+		DomainUUID:    t.DomainId,
+	}
+}
+
+func FromHistoryUpsertWorkflowSearchAttributesRequest(t *types.UpsertWorkflowSearchAttributesRequest) *historyv1.UpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpsertWorkflowSearchAttributesRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+func ToHistoryUpsertWorkflowSearchAttributesRequest(t *historyv1.UpsertWorkflowSearchAttributesRequest) *types.UpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID:        t.DomainId,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
 func FromHistoryTerminateWorkflowExecutionRequest(t *types.HistoryTerminateWorkflowExecutionRequest) *historyv1.TerminateWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -312,6 +312,16 @@ func TestHistorySyncShardStatusRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistorySyncShardStatusRequest(FromHistorySyncShardStatusRequest(item)))
 	}
 }
+func TestHistoryDeleteWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryDeleteWorkflowExecutionRequest{nil, {}, &testdata.HistoryDeleteWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryDeleteWorkflowExecutionRequest(FromHistoryDeleteWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryUpsertWorkflowSearchAttributesRequest(t *testing.T) {
+	for _, item := range []*types.UpsertWorkflowSearchAttributesRequest{nil, {}, &testdata.HistoryUpsertWorkflowSearchAttributesRequest} {
+		assert.Equal(t, item, ToHistoryUpsertWorkflowSearchAttributesRequest(FromHistoryUpsertWorkflowSearchAttributesRequest(item)))
+	}
+}
 func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
@@ -1152,6 +1152,52 @@ func ToSyncShardStatusRequest(t *history.SyncShardStatusRequest) *types.SyncShar
 	}
 }
 
+// FromHistoryDeleteWorkflowExecutionRequest converts internal DeleteWorkflowExecutionRequest type to thrift
+func FromHistoryDeleteWorkflowExecutionRequest(t *types.HistoryDeleteWorkflowExecutionRequest) *history.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.DeleteWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		DeleteRequest: FromDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
+// ToHistoryDeleteWorkflowExecutionRequest converts thrift DeleteWorkflowExecutionRequest type to internal
+func ToHistoryDeleteWorkflowExecutionRequest(t *history.DeleteWorkflowExecutionRequest) *types.HistoryDeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		DeleteRequest: ToDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
+// FromUpsertWorkflowSearchAttributesRequest converts internal UpsertWorkflowSearchAttributesRequest type to thrift
+func FromUpsertWorkflowSearchAttributesRequest(t *types.UpsertWorkflowSearchAttributesRequest) *history.UpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID:        &t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToUpsertWorkflowSearchAttributesRequest converts thrift UpsertWorkflowSearchAttributesRequest type to internal
+func ToUpsertWorkflowSearchAttributesRequest(t *history.UpsertWorkflowSearchAttributesRequest) *types.UpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID:        t.GetDomainUUID(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
 // FromHistoryTerminateWorkflowExecutionRequest converts internal TerminateWorkflowExecutionRequest type to thrift
 func FromHistoryTerminateWorkflowExecutionRequest(t *types.HistoryTerminateWorkflowExecutionRequest) *history.TerminateWorkflowExecutionRequest {
 	if t == nil {
@@ -4292,6 +4292,44 @@ func ToResetStickyTaskListResponse(t *shared.ResetStickyTaskListResponse) *types
 	return &types.ResetStickyTaskListResponse{}
 }
 
+// FromResetChildWorkflowPolicy converts internal ResetChildWorkflowPolicy type to thrift
+func FromResetChildWorkflowPolicy(t *types.ResetChildWorkflowPolicy) *shared.ResetChildWorkflowPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ResetChildWorkflowPolicyReject:
+		v := shared.ResetChildWorkflowPolicyReject
+		return &v
+	case types.ResetChildWorkflowPolicyTerminate:
+		v := shared.ResetChildWorkflowPolicyTerminate
+		return &v
+	case types.ResetChildWorkflowPolicyReattach:
+		v := shared.ResetChildWorkflowPolicyReattach
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToResetChildWorkflowPolicy converts thrift ResetChildWorkflowPolicy type to internal
+func ToResetChildWorkflowPolicy(t *shared.ResetChildWorkflowPolicy) *types.ResetChildWorkflowPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ResetChildWorkflowPolicyReject:
+		v := types.ResetChildWorkflowPolicyReject
+		return &v
+	case shared.ResetChildWorkflowPolicyTerminate:
+		v := types.ResetChildWorkflowPolicyTerminate
+		return &v
+	case shared.ResetChildWorkflowPolicyReattach:
+		v := types.ResetChildWorkflowPolicyReattach
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromResetWorkflowExecutionRequest converts internal ResetWorkflowExecutionRequest type to thrift
 func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionRequest {
 	if t == nil {
@@ -4304,6 +4342,8 @@ func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *
 		DecisionFinishEventId: &t.DecisionFinishEventID,
 		RequestId:             &t.RequestID,
 		SkipSignalReapply:     &t.SkipSignalReapply,
+		ChildWorkflowPolicy:   FromResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:     FromEventTypeArray(t.ReapplyEventTypes),
 	}
 }
 
@@ -4319,6 +4359,8 @@ func ToResetWorkflowExecutionRequest(t *shared.ResetWorkflowExecutionRequest) *t
 		DecisionFinishEventID: t.GetDecisionFinishEventId(),
 		RequestID:             t.GetRequestId(),
 		SkipSignalReapply:     t.GetSkipSignalReapply(),
+		ChildWorkflowPolicy:   ToResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:     ToEventTypeArray(t.ReapplyEventTypes),
 	}
 }
 
@@ -5438,6 +5480,30 @@ func ToTaskListType(t *shared.TaskListType) *types.TaskListType {
 	panic("unexpected enum value")
 }
 
+// FromDeleteWorkflowExecutionRequest converts internal DeleteWorkflowExecutionRequest type to thrift
+func FromDeleteWorkflowExecutionRequest(t *types.DeleteWorkflowExecutionRequest) *shared.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          &t.Identity,
+	}
+}
+
+// ToDeleteWorkflowExecutionRequest converts thrift DeleteWorkflowExecutionRequest type to internal
+func ToDeleteWorkflowExecutionRequest(t *shared.DeleteWorkflowExecutionRequest) *types.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromTerminateWorkflowExecutionRequest converts internal TerminateWorkflowExecutionRequest type to thrift
 func FromTerminateWorkflowExecutionRequest(t *types.TerminateWorkflowExecutionRequest) *shared.TerminateWorkflowExecutionRequest {
 	if t == nil {
@@ -6488,6 +6554,30 @@ func ToHistoryEventArray(t []*shared.HistoryEvent) []*types.HistoryEvent {
 	return v
 }
 
+// FromEventTypeArray converts internal EventType type array to thrift
+func FromEventTypeArray(t []types.EventType) []shared.EventType {
+	if t == nil {
+		return nil
+	}
+	v := make([]shared.EventType, len(t))
+	for i := range t {
+		v[i] = *FromEventType(&t[i])
+	}
+	return v
+}
+
+// ToEventTypeArray converts thrift EventType type array to internal
+func ToEventTypeArray(t []shared.EventType) []types.EventType {
+	if t == nil {
+		return nil
+	}
+	v := make([]types.EventType, len(t))
+	for i := range t {
+		v[i] = *ToEventType(&t[i])
+	}
+	return v
+}
+
 // FromWorkflowExecutionInfoArray converts internal WorkflowExecutionInfo type array to thrift
 func FromWorkflowExecutionInfoArray(t []*types.WorkflowExecutionInfo) []*shared.WorkflowExecutionInfo {
 	if t == nil {
@@ -7479,3 +7569,801 @@ func ToRespondCrossClusterTasksCompletedResponse(t *shared.RespondCrossClusterTa
 		Tasks: ToCrossClusterTaskRequestArray(t.Tasks),
 	}
 }
+
+// FromScheduleOverlapPolicy converts internal ScheduleOverlapPolicy type to thrift
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) *shared.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ScheduleOverlapPolicySkip:
+		v := shared.ScheduleOverlapPolicySkip
+		return &v
+	case types.ScheduleOverlapPolicyBuffer:
+		v := shared.ScheduleOverlapPolicyBuffer
+		return &v
+	case types.ScheduleOverlapPolicyCancelOther:
+		v := shared.ScheduleOverlapPolicyCancelOther
+		return &v
+	case types.ScheduleOverlapPolicyAllowAll:
+		v := shared.ScheduleOverlapPolicyAllowAll
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToScheduleOverlapPolicy converts thrift ScheduleOverlapPolicy type to internal
+func ToScheduleOverlapPolicy(t *shared.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ScheduleOverlapPolicySkip:
+		v := types.ScheduleOverlapPolicySkip
+		return &v
+	case shared.ScheduleOverlapPolicyBuffer:
+		v := types.ScheduleOverlapPolicyBuffer
+		return &v
+	case shared.ScheduleOverlapPolicyCancelOther:
+		v := types.ScheduleOverlapPolicyCancelOther
+		return &v
+	case shared.ScheduleOverlapPolicyAllowAll:
+		v := types.ScheduleOverlapPolicyAllowAll
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromScheduleSpec converts internal ScheduleSpec type to thrift
+func FromScheduleSpec(t *types.ScheduleSpec) *shared.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleSpec{
+		CronSchedule:         &t.CronSchedule,
+		StartTimeNano:        t.StartTimeNano,
+		EndTimeNano:          t.EndTimeNano,
+		OverlapPolicy:        FromScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchupWindowSeconds: t.CatchupWindowSeconds,
+	}
+}
+
+// ToScheduleSpec converts thrift ScheduleSpec type to internal
+func ToScheduleSpec(t *shared.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronSchedule:         t.GetCronSchedule(),
+		StartTimeNano:        t.StartTimeNano,
+		EndTimeNano:          t.EndTimeNano,
+		OverlapPolicy:        ToScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchupWindowSeconds: t.CatchupWindowSeconds,
+	}
+}
+
+// FromScheduleStartWorkflowAction converts internal ScheduleStartWorkflowAction type to thrift
+func FromScheduleStartWorkflowAction(t *types.ScheduleStartWorkflowAction) *shared.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleStartWorkflowAction{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		WorkflowIdPrefix:                    &t.WorkflowIDPrefix,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// ToScheduleStartWorkflowAction converts thrift ScheduleStartWorkflowAction type to internal
+func ToScheduleStartWorkflowAction(t *shared.ScheduleStartWorkflowAction) *types.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleStartWorkflowAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		WorkflowIDPrefix:                    t.GetWorkflowIdPrefix(),
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// FromScheduleActionResult converts internal ScheduleActionResult type to thrift
+func FromScheduleActionResult(t *types.ScheduleActionResult) *shared.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleActionResult{
+		ScheduledTimeNano: t.ScheduledTimeNano,
+		ActualTimeNano:    t.ActualTimeNano,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToScheduleActionResult converts thrift ScheduleActionResult type to internal
+func ToScheduleActionResult(t *shared.ScheduleActionResult) *types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleActionResult{
+		ScheduledTimeNano: t.ScheduledTimeNano,
+		ActualTimeNano:    t.ActualTimeNano,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromScheduleState converts internal ScheduleState type to thrift
+func FromScheduleState(t *types.ScheduleState) *shared.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleState{
+		Paused:                &t.Paused,
+		Notes:                 &t.Notes,
+		LastProcessedTimeNano: t.LastProcessedTimeNano,
+		RecentActions:         FromScheduleActionResultArray(t.RecentActions),
+		TotalActions:          &t.TotalActions,
+		SkippedActions:        &t.SkippedActions,
+		MissedCatchupWindow:   &t.MissedCatchupWindow,
+		FailedActions:         &t.FailedActions,
+	}
+}
+
+// ToScheduleState converts thrift ScheduleState type to internal
+func ToScheduleState(t *shared.ScheduleState) *types.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleState{
+		Paused:                t.GetPaused(),
+		Notes:                 t.GetNotes(),
+		LastProcessedTimeNano: t.LastProcessedTimeNano,
+		RecentActions:         ToScheduleActionResultArray(t.RecentActions),
+		TotalActions:          t.GetTotalActions(),
+		SkippedActions:        t.GetSkippedActions(),
+		MissedCatchupWindow:   t.GetMissedCatchupWindow(),
+		FailedActions:         t.GetFailedActions(),
+	}
+}
+
+// FromCreateScheduleRequest converts internal CreateScheduleRequest type to thrift
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *shared.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CreateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+// ToCreateScheduleRequest converts thrift CreateScheduleRequest type to internal
+func ToCreateScheduleRequest(t *shared.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+// FromDescribeScheduleRequest converts internal DescribeScheduleRequest type to thrift
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *shared.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+	}
+}
+
+// ToDescribeScheduleRequest converts thrift DescribeScheduleRequest type to internal
+func ToDescribeScheduleRequest(t *shared.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+	}
+}
+
+// FromDescribeScheduleResponse converts internal DescribeScheduleResponse type to thrift
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *shared.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleResponse{
+		Spec:                FromScheduleSpec(t.Spec),
+		Action:              FromScheduleStartWorkflowAction(t.Action),
+		State:               FromScheduleState(t.State),
+		NextActionTimesNano: t.NextActionTimesNano,
+	}
+}
+
+// ToDescribeScheduleResponse converts thrift DescribeScheduleResponse type to internal
+func ToDescribeScheduleResponse(t *shared.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:                ToScheduleSpec(t.Spec),
+		Action:              ToScheduleStartWorkflowAction(t.Action),
+		State:               ToScheduleState(t.State),
+		NextActionTimesNano: t.NextActionTimesNano,
+	}
+}
+
+// FromUpdateScheduleRequest converts internal UpdateScheduleRequest type to thrift
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *shared.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+// ToUpdateScheduleRequest converts thrift UpdateScheduleRequest type to internal
+func ToUpdateScheduleRequest(t *shared.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+	}
+}
+
+// FromPauseScheduleRequest converts internal PauseScheduleRequest type to thrift
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *shared.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Reason:     &t.Reason,
+	}
+}
+
+// ToPauseScheduleRequest converts thrift PauseScheduleRequest type to internal
+func ToPauseScheduleRequest(t *shared.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// FromUnpauseScheduleRequest converts internal UnpauseScheduleRequest type to thrift
+func FromUnpauseScheduleRequest(t *types.UnpauseScheduleRequest) *shared.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Reason:     &t.Reason,
+	}
+}
+
+// ToUnpauseScheduleRequest converts thrift UnpauseScheduleRequest type to internal
+func ToUnpauseScheduleRequest(t *shared.UnpauseScheduleRequest) *types.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// FromTriggerScheduleRequest converts internal TriggerScheduleRequest type to thrift
+func FromTriggerScheduleRequest(t *types.TriggerScheduleRequest) *shared.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.TriggerScheduleRequest{
+		Domain:        &t.Domain,
+		ScheduleId:    &t.ScheduleID,
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// ToTriggerScheduleRequest converts thrift TriggerScheduleRequest type to internal
+func ToTriggerScheduleRequest(t *shared.TriggerScheduleRequest) *types.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerScheduleRequest{
+		Domain:        t.GetDomain(),
+		ScheduleID:    t.GetScheduleId(),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// FromBackfillScheduleRequest converts internal BackfillScheduleRequest type to thrift
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *shared.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BackfillScheduleRequest{
+		Domain:        &t.Domain,
+		ScheduleId:    &t.ScheduleID,
+		StartTimeNano: t.StartTimeNano,
+		EndTimeNano:   t.EndTimeNano,
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// ToBackfillScheduleRequest converts thrift BackfillScheduleRequest type to internal
+func ToBackfillScheduleRequest(t *shared.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:        t.GetDomain(),
+		ScheduleID:    t.GetScheduleId(),
+		StartTimeNano: t.StartTimeNano,
+		EndTimeNano:   t.EndTimeNano,
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// FromDeleteScheduleRequest converts internal DeleteScheduleRequest type to thrift
+func FromDeleteScheduleRequest(t *types.DeleteScheduleRequest) *shared.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+	}
+}
+
+// ToDeleteScheduleRequest converts thrift DeleteScheduleRequest type to internal
+func ToDeleteScheduleRequest(t *shared.DeleteScheduleRequest) *types.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+	}
+}
+
+// FromListSchedulesRequest converts internal ListSchedulesRequest type to thrift
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *shared.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesRequest converts thrift ListSchedulesRequest type to internal
+func ToListSchedulesRequest(t *shared.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListSchedulesResponse converts internal ListSchedulesResponse type to thrift
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *shared.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesResponse{
+		ScheduleIds:   t.ScheduleIDs,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesResponse converts thrift ListSchedulesResponse type to internal
+func ToListSchedulesResponse(t *shared.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		ScheduleIDs:   t.ScheduleIds,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromScheduleActionResultArray converts internal ScheduleActionResult type array to thrift
+func FromScheduleActionResultArray(t []*types.ScheduleActionResult) []*shared.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = FromScheduleActionResult(t[i])
+	}
+	return v
+}
+
+// ToScheduleActionResultArray converts thrift ScheduleActionResult type array to internal
+func ToScheduleActionResultArray(t []*shared.ScheduleActionResult) []*types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = ToScheduleActionResult(t[i])
+	}
+	return v
+}
+
+// FromBatchOperationType converts internal BatchOperationType type to thrift
+func FromBatchOperationType(t *types.BatchOperationType) *shared.BatchOperationType {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.BatchOperationTypeTerminate:
+		v := shared.BatchOperationTypeTerminate
+		return &v
+	case types.BatchOperationTypeCancel:
+		v := shared.BatchOperationTypeCancel
+		return &v
+	case types.BatchOperationTypeSignal:
+		v := shared.BatchOperationTypeSignal
+		return &v
+	case types.BatchOperationTypeReset:
+		v := shared.BatchOperationTypeReset
+		return &v
+	case types.BatchOperationTypeDelete:
+		v := shared.BatchOperationTypeDelete
+		return &v
+	case types.BatchOperationTypeUpsertSearchAttributes:
+		v := shared.BatchOperationTypeUpsertSearchAttributes
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToBatchOperationType converts thrift BatchOperationType type to internal
+func ToBatchOperationType(t *shared.BatchOperationType) *types.BatchOperationType {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.BatchOperationTypeTerminate:
+		v := types.BatchOperationTypeTerminate
+		return &v
+	case shared.BatchOperationTypeCancel:
+		v := types.BatchOperationTypeCancel
+		return &v
+	case shared.BatchOperationTypeSignal:
+		v := types.BatchOperationTypeSignal
+		return &v
+	case shared.BatchOperationTypeReset:
+		v := types.BatchOperationTypeReset
+		return &v
+	case shared.BatchOperationTypeDelete:
+		v := types.BatchOperationTypeDelete
+		return &v
+	case shared.BatchOperationTypeUpsertSearchAttributes:
+		v := types.BatchOperationTypeUpsertSearchAttributes
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromBatchOperationResetParams converts internal BatchOperationResetParams type to thrift
+func FromBatchOperationResetParams(t *types.BatchOperationResetParams) *shared.BatchOperationResetParams {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchOperationResetParams{
+		ResetType:            &t.ResetType,
+		BadBinaryChecksum:    &t.BadBinaryChecksum,
+		EarliestTimeNano:     t.EarliestTimeNano,
+		SkipCurrentOpen:      &t.SkipCurrentOpen,
+		SkipBaseIsNotCurrent: &t.SkipBaseIsNotCurrent,
+		NonDeterministicOnly: &t.NonDeterministicOnly,
+		SkipSignalReapply:    &t.SkipSignalReapply,
+		ChildWorkflowPolicy:  FromResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:    FromEventTypeArray(t.ReapplyEventTypes),
+	}
+}
+
+// ToBatchOperationResetParams converts thrift BatchOperationResetParams type to internal
+func ToBatchOperationResetParams(t *shared.BatchOperationResetParams) *types.BatchOperationResetParams {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationResetParams{
+		ResetType:            t.GetResetType(),
+		BadBinaryChecksum:    t.GetBadBinaryChecksum(),
+		EarliestTimeNano:     t.EarliestTimeNano,
+		SkipCurrentOpen:      t.GetSkipCurrentOpen(),
+		SkipBaseIsNotCurrent: t.GetSkipBaseIsNotCurrent(),
+		NonDeterministicOnly: t.GetNonDeterministicOnly(),
+		SkipSignalReapply:    t.GetSkipSignalReapply(),
+		ChildWorkflowPolicy:  ToResetChildWorkflowPolicy(t.ChildWorkflowPolicy),
+		ReapplyEventTypes:    ToEventTypeArray(t.ReapplyEventTypes),
+	}
+}
+
+// FromBatchOperationInfo converts internal BatchOperationInfo type to thrift
+func FromBatchOperationInfo(t *types.BatchOperationInfo) *shared.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchOperationInfo{
+		JobId:         &t.JobID,
+		CloseStatus:   FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		Reason:        &t.Reason,
+		Identity:      &t.Identity,
+		StartTimeNano: t.StartTimeNano,
+		CloseTimeNano: t.CloseTimeNano,
+	}
+}
+
+// ToBatchOperationInfo converts thrift BatchOperationInfo type to internal
+func ToBatchOperationInfo(t *shared.BatchOperationInfo) *types.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationInfo{
+		JobID:         t.GetJobId(),
+		CloseStatus:   ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		Reason:        t.GetReason(),
+		Identity:      t.GetIdentity(),
+		StartTimeNano: t.StartTimeNano,
+		CloseTimeNano: t.CloseTimeNano,
+	}
+}
+
+// FromBatchOperationProgress converts internal BatchOperationProgress type to thrift
+func FromBatchOperationProgress(t *types.BatchOperationProgress) *shared.BatchOperationProgress {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchOperationProgress{
+		TotalEstimate: &t.TotalEstimate,
+		SuccessCount:  &t.SuccessCount,
+		ErrorCount:    &t.ErrorCount,
+	}
+}
+
+// ToBatchOperationProgress converts thrift BatchOperationProgress type to internal
+func ToBatchOperationProgress(t *shared.BatchOperationProgress) *types.BatchOperationProgress {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationProgress{
+		TotalEstimate: t.GetTotalEstimate(),
+		SuccessCount:  t.GetSuccessCount(),
+		ErrorCount:    t.GetErrorCount(),
+	}
+}
+
+// FromStartBatchOperationRequest converts internal StartBatchOperationRequest type to thrift
+func FromStartBatchOperationRequest(t *types.StartBatchOperationRequest) *shared.StartBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartBatchOperationRequest{
+		Domain:           &t.Domain,
+		Query:            &t.Query,
+		Reason:           &t.Reason,
+		BatchType:        FromBatchOperationType(t.BatchType),
+		DryRun:           &t.DryRun,
+		Rps:              &t.RPS,
+		Concurrency:      &t.Concurrency,
+		SkipChildren:     &t.SkipChildren,
+		SignalName:       &t.SignalName,
+		SignalInput:      t.SignalInput,
+		ResetParams:      FromBatchOperationResetParams(t.ResetParams),
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+		Identity:         &t.Identity,
+	}
+}
+
+// ToStartBatchOperationRequest converts thrift StartBatchOperationRequest type to internal
+func ToStartBatchOperationRequest(t *shared.StartBatchOperationRequest) *types.StartBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StartBatchOperationRequest{
+		Domain:           t.GetDomain(),
+		Query:            t.GetQuery(),
+		Reason:           t.GetReason(),
+		BatchType:        ToBatchOperationType(t.BatchType),
+		DryRun:           t.GetDryRun(),
+		RPS:              t.GetRps(),
+		Concurrency:      t.GetConcurrency(),
+		SkipChildren:     t.GetSkipChildren(),
+		SignalName:       t.GetSignalName(),
+		SignalInput:      t.SignalInput,
+		ResetParams:      ToBatchOperationResetParams(t.ResetParams),
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// FromStartBatchOperationResponse converts internal StartBatchOperationResponse type to thrift
+func FromStartBatchOperationResponse(t *types.StartBatchOperationResponse) *shared.StartBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartBatchOperationResponse{
+		JobId: &t.JobID,
+	}
+}
+
+// ToStartBatchOperationResponse converts thrift StartBatchOperationResponse type to internal
+func ToStartBatchOperationResponse(t *shared.StartBatchOperationResponse) *types.StartBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StartBatchOperationResponse{
+		JobID: t.GetJobId(),
+	}
+}
+
+// FromDescribeBatchOperationRequest converts internal DescribeBatchOperationRequest type to thrift
+func FromDescribeBatchOperationRequest(t *types.DescribeBatchOperationRequest) *shared.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeBatchOperationRequest{
+		Domain: &t.Domain,
+		JobId:  &t.JobID,
+	}
+}
+
+// ToDescribeBatchOperationRequest converts thrift DescribeBatchOperationRequest type to internal
+func ToDescribeBatchOperationRequest(t *shared.DescribeBatchOperationRequest) *types.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchOperationRequest{
+		Domain: t.GetDomain(),
+		JobID:  t.GetJobId(),
+	}
+}
+
+// FromDescribeBatchOperationResponse converts internal DescribeBatchOperationResponse type to thrift
+func FromDescribeBatchOperationResponse(t *types.DescribeBatchOperationResponse) *shared.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeBatchOperationResponse{
+		Info:     FromBatchOperationInfo(t.Info),
+		Progress: FromBatchOperationProgress(t.Progress),
+	}
+}
+
+// ToDescribeBatchOperationResponse converts thrift DescribeBatchOperationResponse type to internal
+func ToDescribeBatchOperationResponse(t *shared.DescribeBatchOperationResponse) *types.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchOperationResponse{
+		Info:     ToBatchOperationInfo(t.Info),
+		Progress: ToBatchOperationProgress(t.Progress),
+	}
+}
+
+// FromListBatchOperationsRequest converts internal ListBatchOperationsRequest type to thrift
+func FromListBatchOperationsRequest(t *types.ListBatchOperationsRequest) *shared.ListBatchOperationsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListBatchOperationsRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListBatchOperationsRequest converts thrift ListBatchOperationsRequest type to internal
+func ToListBatchOperationsRequest(t *shared.ListBatchOperationsRequest) *types.ListBatchOperationsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListBatchOperationsRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListBatchOperationsResponse converts internal ListBatchOperationsResponse type to thrift
+func FromListBatchOperationsResponse(t *types.ListBatchOperationsResponse) *shared.ListBatchOperationsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListBatchOperationsResponse{
+		Operations:    FromBatchOperationInfoArray(t.Operations),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListBatchOperationsResponse converts thrift ListBatchOperationsResponse type to internal
+func ToListBatchOperationsResponse(t *shared.ListBatchOperationsResponse) *types.ListBatchOperationsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListBatchOperationsResponse{
+		Operations:    ToBatchOperationInfoArray(t.Operations),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromCancelBatchOperationRequest converts internal CancelBatchOperationRequest type to thrift
+func FromCancelBatchOperationRequest(t *types.CancelBatchOperationRequest) *shared.CancelBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CancelBatchOperationRequest{
+		Domain: &t.Domain,
+		JobId:  &t.JobID,
+	}
+}
+
+// ToCancelBatchOperationRequest converts thrift CancelBatchOperationRequest type to internal
+func ToCancelBatchOperationRequest(t *shared.CancelBatchOperationRequest) *types.CancelBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CancelBatchOperationRequest{
+		Domain: t.GetDomain(),
+		JobID:  t.GetJobId(),
+	}
+}
+
+// FromBatchOperationInfoArray converts internal BatchOperationInfo type array to thrift
+func FromBatchOperationInfoArray(t []*types.BatchOperationInfo) []*shared.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.BatchOperationInfo, len(t))
+	for i := range t {
+		v[i] = FromBatchOperationInfo(t[i])
+	}
+	return v
+}
+
+// ToBatchOperationInfoArray converts thrift BatchOperationInfo type array to internal
+func ToBatchOperationInfoArray(t []*shared.BatchOperationInfo) []*types.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.BatchOperationInfo, len(t))
+	for i := range t {
+		v[i] = ToBatchOperationInfo(t[i])
+	}
+	return v
+}
@@ -143,3 +143,159 @@ func TestGetFailoverInfoResponse(t *testing.T) {
 		assert.Equal(t, item, thrift.ToGetFailoverInfoResponse(thrift.FromGetFailoverInfoResponse(item)))
 	}
 }
+
+func TestScheduleSpec(t *testing.T) {
+	for _, item := range []*types.ScheduleSpec{nil, {}, &testdata.ScheduleSpec} {
+		assert.Equal(t, item, thrift.ToScheduleSpec(thrift.FromScheduleSpec(item)))
+	}
+}
+
+func TestScheduleStartWorkflowAction(t *testing.T) {
+	for _, item := range []*types.ScheduleStartWorkflowAction{nil, {}, &testdata.ScheduleStartWorkflowAction} {
+		assert.Equal(t, item, thrift.ToScheduleStartWorkflowAction(thrift.FromScheduleStartWorkflowAction(item)))
+	}
+}
+
+func TestScheduleActionResult(t *testing.T) {
+	for _, item := range []*types.ScheduleActionResult{nil, {}, &testdata.ScheduleActionResult} {
+		assert.Equal(t, item, thrift.ToScheduleActionResult(thrift.FromScheduleActionResult(item)))
+	}
+}
+
+func TestScheduleState(t *testing.T) {
+	for _, item := range []*types.ScheduleState{nil, {}, &testdata.ScheduleState} {
+		assert.Equal(t, item, thrift.ToScheduleState(thrift.FromScheduleState(item)))
+	}
+}
+
+func TestCreateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.CreateScheduleRequest{nil, {}, &testdata.CreateScheduleRequest} {
+		assert.Equal(t, item, thrift.ToCreateScheduleRequest(thrift.FromCreateScheduleRequest(item)))
+	}
+}
+
+func TestDescribeScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleRequest{nil, {}, &testdata.DescribeScheduleRequest} {
+		assert.Equal(t, item, thrift.ToDescribeScheduleRequest(thrift.FromDescribeScheduleRequest(item)))
+	}
+}
+
+func TestDescribeScheduleResponse(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleResponse{nil, {}, &testdata.DescribeScheduleResponse} {
+		assert.Equal(t, item, thrift.ToDescribeScheduleResponse(thrift.FromDescribeScheduleResponse(item)))
+	}
+}
+
+func TestUpdateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UpdateScheduleRequest{nil, {}, &testdata.UpdateScheduleRequest} {
+		assert.Equal(t, item, thrift.ToUpdateScheduleRequest(thrift.FromUpdateScheduleRequest(item)))
+	}
+}
+
+func TestPauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.PauseScheduleRequest{nil, {}, &testdata.PauseScheduleRequest} {
+		assert.Equal(t, item, thrift.ToPauseScheduleRequest(thrift.FromPauseScheduleRequest(item)))
+	}
+}
+
+func TestUnpauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseScheduleRequest{nil, {}, &testdata.UnpauseScheduleRequest} {
+		assert.Equal(t, item, thrift.ToUnpauseScheduleRequest(thrift.FromUnpauseScheduleRequest(item)))
+	}
+}
+
+func TestTriggerScheduleRequest(t *testing.T) {
+	for _, item := range []*types.TriggerScheduleRequest{nil, {}, &testdata.TriggerScheduleRequest} {
+		assert.Equal(t, item, thrift.ToTriggerScheduleRequest(thrift.FromTriggerScheduleRequest(item)))
+	}
+}
+
+func TestBackfillScheduleRequest(t *testing.T) {
+	for _, item := range []*types.BackfillScheduleRequest{nil, {}, &testdata.BackfillScheduleRequest} {
+		assert.Equal(t, item, thrift.ToBackfillScheduleRequest(thrift.FromBackfillScheduleRequest(item)))
+	}
+}
+
+func TestDeleteScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DeleteScheduleRequest{nil, {}, &testdata.DeleteScheduleRequest} {
+		assert.Equal(t, item, thrift.ToDeleteScheduleRequest(thrift.FromDeleteScheduleRequest(item)))
+	}
+}
+
+func TestListSchedulesRequest(t *testing.T) {
+	for _, item := range []*types.ListSchedulesRequest{nil, {}, &testdata.ListSchedulesRequest} {
+		assert.Equal(t, item, thrift.ToListSchedulesRequest(thrift.FromListSchedulesRequest(item)))
+	}
+}
+
+func TestListSchedulesResponse(t *testing.T) {
+	for _, item := range []*types.ListSchedulesResponse{nil, {}, &testdata.ListSchedulesResponse} {
+		assert.Equal(t, item, thrift.ToListSchedulesResponse(thrift.FromListSchedulesResponse(item)))
+	}
+}
+
+func TestBatchOperationResetParams(t *testing.T) {
+	for _, item := range []*types.BatchOperationResetParams{nil, {}, &testdata.BatchOperationResetParams} {
+		assert.Equal(t, item, thrift.ToBatchOperationResetParams(thrift.FromBatchOperationResetParams(item)))
+	}
+}
+
+func TestBatchOperationInfo(t *testing.T) {
+	for _, item := range []*types.BatchOperationInfo{nil, {}, &testdata.BatchOperationInfo} {
+		assert.Equal(t, item, thrift.ToBatchOperationInfo(thrift.FromBatchOperationInfo(item)))
+	}
+}
+
+func TestBatchOperationProgress(t *testing.T) {
+	for _, item := range []*types.BatchOperationProgress{nil, {}, &testdata.BatchOperationProgress} {
+		assert.Equal(t, item, thrift.ToBatchOperationProgress(thrift.FromBatchOperationProgress(item)))
+	}
+}
+
+func TestStartBatchOperationRequest(t *testing.T) {
+	for _, item := range []*types.StartBatchOperationRequest{nil, {}, &testdata.StartBatchOperationRequest} {
+		assert.Equal(t, item, thrift.ToStartBatchOperationRequest(thrift.FromStartBatchOperationRequest(item)))
+	}
+}
+
+func TestStartBatchOperationResponse(t *testing.T) {
+	for _, item := range []*types.StartBatchOperationResponse{nil, {}, &testdata.StartBatchOperationResponse} {
+		assert.Equal(t, item, thrift.ToStartBatchOperationResponse(thrift.FromStartBatchOperationResponse(item)))
+	}
+}
+
+func TestDescribeBatchOperationRequest(t *testing.T) {
+	for _, item := range []*types.DescribeBatchOperationRequest{nil, {}, &testdata.DescribeBatchOperationRequest} {
+		assert.Equal(t, item, thrift.ToDescribeBatchOperationRequest(thrift.FromDescribeBatchOperationRequest(item)))
+	}
+}
+
+func TestDescribeBatchOperationResponse(t *testing.T) {
+	for _, item := range []*types.DescribeBatchOperationResponse{nil, {}, &testdata.DescribeBatchOperationResponse} {
+		assert.Equal(t, item, thrift.ToDescribeBatchOperationResponse(thrift.FromDescribeBatchOperationResponse(item)))
+	}
+}
+
+func TestListBatchOperationsRequest(t *testing.T) {
+	for _, item := range []*types.ListBatchOperationsRequest{nil, {}, &testdata.ListBatchOperationsRequest} {
+		assert.Equal(t, item, thrift.ToListBatchOperationsRequest(thrift.FromListBatchOperationsRequest(item)))
+	}
+}
+
+func TestListBatchOperationsResponse(t *testing.T) {
+	for _, item := range []*types.ListBatchOperationsResponse{nil, {}, &testdata.ListBatchOperationsResponse} {
+		assert.Equal(t, item, thrift.ToListBatchOperationsResponse(thrift.FromListBatchOperationsResponse(item)))
+	}
+}
+
+func TestCancelBatchOperationRequest(t *testing.T) {
+	for _, item := range []*types.CancelBatchOperationRequest{nil, {}, &testdata.CancelBatchOperationRequest} {
+		assert.Equal(t, item, thrift.ToCancelBatchOperationRequest(thrift.FromCancelBatchOperationRequest(item)))
+	}
+}
+
+func TestResetWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.ResetWorkflowExecutionRequest{nil, {}, &testdata.ResetWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToResetWorkflowExecutionRequest(thrift.FromResetWorkflowExecutionRequest(item)))
+	}
+}
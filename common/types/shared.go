@@ -2263,6 +2263,37 @@ const (
 	DecisionTypeUpsertWorkflowSearchAttributes
 )
 
+// DeleteWorkflowExecutionRequest is an internal type (TBD...)
+type DeleteWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // DeprecateDomainRequest is an internal type (TBD...)
 type DeprecateDomainRequest struct {
 	Name          string `json:"name,omitempty"`
@@ -6536,14 +6567,78 @@ func (v *ResetStickyTaskListRequest) GetExecution() (o *WorkflowExecution) {
 type ResetStickyTaskListResponse struct {
 }
 
+// ResetChildWorkflowPolicy is an internal type (TBD...)
+type ResetChildWorkflowPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e ResetChildWorkflowPolicy) Ptr() *ResetChildWorkflowPolicy {
+	return &e
+}
+
+// String returns a readable string representation of ResetChildWorkflowPolicy.
+func (e ResetChildWorkflowPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "REJECT"
+	case 1:
+		return "TERMINATE"
+	case 2:
+		return "REATTACH"
+	}
+	return fmt.Sprintf("ResetChildWorkflowPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ResetChildWorkflowPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "REJECT":
+		*e = ResetChildWorkflowPolicyReject
+		return nil
+	case "TERMINATE":
+		*e = ResetChildWorkflowPolicyTerminate
+		return nil
+	case "REATTACH":
+		*e = ResetChildWorkflowPolicyReattach
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ResetChildWorkflowPolicy", err)
+		}
+		*e = ResetChildWorkflowPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ResetChildWorkflowPolicy to text.
+func (e ResetChildWorkflowPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ResetChildWorkflowPolicyReject is an option for ResetChildWorkflowPolicy,
+	// reset is rejected if child workflows are still running at the reset point
+	ResetChildWorkflowPolicyReject ResetChildWorkflowPolicy = iota
+	// ResetChildWorkflowPolicyTerminate is an option for ResetChildWorkflowPolicy,
+	// running child workflows are terminated and the reset workflow sees them terminated
+	ResetChildWorkflowPolicyTerminate
+	// ResetChildWorkflowPolicyReattach is an option for ResetChildWorkflowPolicy,
+	// running child workflows keep running and report their completion to the reset workflow.
+	// Children started after the reset point are terminated with any policy, as the reset workflow starts them again
+	ResetChildWorkflowPolicyReattach
+)
+
 // ResetWorkflowExecutionRequest is an internal type (TBD...)
 type ResetWorkflowExecutionRequest struct {
-	Domain                string             `json:"domain,omitempty"`
-	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Reason                string             `json:"reason,omitempty"`
-	DecisionFinishEventID int64              `json:"decisionFinishEventId,omitempty"`
-	RequestID             string             `json:"requestId,omitempty"`
-	SkipSignalReapply     bool               `json:"skipSignalReapply,omitempty"`
+	Domain                string                    `json:"domain,omitempty"`
+	WorkflowExecution     *WorkflowExecution        `json:"workflowExecution,omitempty"`
+	Reason                string                    `json:"reason,omitempty"`
+	DecisionFinishEventID int64                     `json:"decisionFinishEventId,omitempty"`
+	RequestID             string                    `json:"requestId,omitempty"`
+	SkipSignalReapply     bool                      `json:"skipSignalReapply,omitempty"`
+	ChildWorkflowPolicy   *ResetChildWorkflowPolicy `json:"childWorkflowPolicy,omitempty"`
+	ReapplyEventTypes     []EventType               `json:"reapplyEventTypes,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -6594,6 +6689,22 @@ func (v *ResetWorkflowExecutionRequest) GetSkipSignalReapply() (o bool) {
 	return
 }
 
+// GetChildWorkflowPolicy is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetChildWorkflowPolicy() (o ResetChildWorkflowPolicy) {
+	if v != nil && v.ChildWorkflowPolicy != nil {
+		return *v.ChildWorkflowPolicy
+	}
+	return
+}
+
+// GetReapplyEventTypes is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetReapplyEventTypes() (o []EventType) {
+	if v != nil && v.ReapplyEventTypes != nil {
+		return v.ReapplyEventTypes
+	}
+	return
+}
+
 // ResetWorkflowExecutionResponse is an internal type (TBD...)
 type ResetWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -10990,3 +11101,1147 @@ func (v *RespondCrossClusterTasksCompletedResponse) GetTasks() (o []*CrossCluste
 	}
 	return
 }
+
+// ScheduleOverlapPolicy is an internal type (TBD...)
+type ScheduleOverlapPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e ScheduleOverlapPolicy) Ptr() *ScheduleOverlapPolicy {
+	return &e
+}
+
+// String returns a readable string representation of ScheduleOverlapPolicy.
+func (e ScheduleOverlapPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "SKIP"
+	case 1:
+		return "BUFFER"
+	case 2:
+		return "CANCEL_OTHER"
+	case 3:
+		return "ALLOW_ALL"
+	}
+	return fmt.Sprintf("ScheduleOverlapPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ScheduleOverlapPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "SKIP":
+		*e = ScheduleOverlapPolicySkip
+		return nil
+	case "BUFFER":
+		*e = ScheduleOverlapPolicyBuffer
+		return nil
+	case "CANCEL_OTHER":
+		*e = ScheduleOverlapPolicyCancelOther
+		return nil
+	case "ALLOW_ALL":
+		*e = ScheduleOverlapPolicyAllowAll
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleOverlapPolicy", err)
+		}
+		*e = ScheduleOverlapPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ScheduleOverlapPolicy to text.
+func (e ScheduleOverlapPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ScheduleOverlapPolicySkip is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicySkip ScheduleOverlapPolicy = iota
+	// ScheduleOverlapPolicyBuffer is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyBuffer
+	// ScheduleOverlapPolicyCancelOther is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyCancelOther
+	// ScheduleOverlapPolicyAllowAll is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyAllowAll
+)
+
+// ScheduleSpec is an internal type (TBD...)
+type ScheduleSpec struct {
+	CronSchedule         string                 `json:"cronSchedule,omitempty"`
+	StartTimeNano        *int64                 `json:"startTimeNano,omitempty"`
+	EndTimeNano          *int64                 `json:"endTimeNano,omitempty"`
+	OverlapPolicy        *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	CatchupWindowSeconds *int32                 `json:"catchupWindowSeconds,omitempty"`
+}
+
+// GetCronSchedule is an internal getter (TBD...)
+func (v *ScheduleSpec) GetCronSchedule() (o string) {
+	if v != nil {
+		return v.CronSchedule
+	}
+	return
+}
+
+// GetStartTimeNano is an internal getter (TBD...)
+func (v *ScheduleSpec) GetStartTimeNano() (o int64) {
+	if v != nil && v.StartTimeNano != nil {
+		return *v.StartTimeNano
+	}
+	return
+}
+
+// GetEndTimeNano is an internal getter (TBD...)
+func (v *ScheduleSpec) GetEndTimeNano() (o int64) {
+	if v != nil && v.EndTimeNano != nil {
+		return *v.EndTimeNano
+	}
+	return
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *ScheduleSpec) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// GetCatchupWindowSeconds is an internal getter (TBD...)
+func (v *ScheduleSpec) GetCatchupWindowSeconds() (o int32) {
+	if v != nil && v.CatchupWindowSeconds != nil {
+		return *v.CatchupWindowSeconds
+	}
+	return
+}
+
+// ScheduleStartWorkflowAction is an internal type (TBD...)
+type ScheduleStartWorkflowAction struct {
+	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
+	TaskList                            *TaskList     `json:"taskList,omitempty"`
+	Input                               []byte        `json:"input,omitempty"`
+	WorkflowIDPrefix                    string        `json:"workflowIdPrefix,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetWorkflowIDPrefix is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetWorkflowIDPrefix() (o string) {
+	if v != nil {
+		return v.WorkflowIDPrefix
+	}
+	return
+}
+
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// ScheduleActionResult is an internal type (TBD...)
+type ScheduleActionResult struct {
+	ScheduledTimeNano *int64             `json:"scheduledTimeNano,omitempty"`
+	ActualTimeNano    *int64             `json:"actualTimeNano,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetScheduledTimeNano is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetScheduledTimeNano() (o int64) {
+	if v != nil && v.ScheduledTimeNano != nil {
+		return *v.ScheduledTimeNano
+	}
+	return
+}
+
+// GetActualTimeNano is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetActualTimeNano() (o int64) {
+	if v != nil && v.ActualTimeNano != nil {
+		return *v.ActualTimeNano
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// ScheduleState is an internal type (TBD...)
+type ScheduleState struct {
+	Paused                bool                    `json:"paused,omitempty"`
+	Notes                 string                  `json:"notes,omitempty"`
+	LastProcessedTimeNano *int64                  `json:"lastProcessedTimeNano,omitempty"`
+	RecentActions         []*ScheduleActionResult `json:"recentActions,omitempty"`
+	TotalActions          int64                   `json:"totalActions,omitempty"`
+	SkippedActions        int64                   `json:"skippedActions,omitempty"`
+	MissedCatchupWindow   int64                   `json:"missedCatchupWindow,omitempty"`
+	FailedActions         int64                   `json:"failedActions,omitempty"`
+}
+
+// GetPaused is an internal getter (TBD...)
+func (v *ScheduleState) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
+// GetNotes is an internal getter (TBD...)
+func (v *ScheduleState) GetNotes() (o string) {
+	if v != nil {
+		return v.Notes
+	}
+	return
+}
+
+// GetLastProcessedTimeNano is an internal getter (TBD...)
+func (v *ScheduleState) GetLastProcessedTimeNano() (o int64) {
+	if v != nil && v.LastProcessedTimeNano != nil {
+		return *v.LastProcessedTimeNano
+	}
+	return
+}
+
+// GetRecentActions is an internal getter (TBD...)
+func (v *ScheduleState) GetRecentActions() (o []*ScheduleActionResult) {
+	if v != nil && v.RecentActions != nil {
+		return v.RecentActions
+	}
+	return
+}
+
+// GetTotalActions is an internal getter (TBD...)
+func (v *ScheduleState) GetTotalActions() (o int64) {
+	if v != nil {
+		return v.TotalActions
+	}
+	return
+}
+
+// GetSkippedActions is an internal getter (TBD...)
+func (v *ScheduleState) GetSkippedActions() (o int64) {
+	if v != nil {
+		return v.SkippedActions
+	}
+	return
+}
+
+// GetMissedCatchupWindow is an internal getter (TBD...)
+func (v *ScheduleState) GetMissedCatchupWindow() (o int64) {
+	if v != nil {
+		return v.MissedCatchupWindow
+	}
+	return
+}
+
+// GetFailedActions is an internal getter (TBD...)
+func (v *ScheduleState) GetFailedActions() (o int64) {
+	if v != nil {
+		return v.FailedActions
+	}
+	return
+}
+
+// CreateScheduleRequest is an internal type (TBD...)
+type CreateScheduleRequest struct {
+	Domain     string                       `json:"domain,omitempty"`
+	ScheduleID string                       `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec                `json:"spec,omitempty"`
+	Action     *ScheduleStartWorkflowAction `json:"action,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetAction() (o *ScheduleStartWorkflowAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// DescribeScheduleRequest is an internal type (TBD...)
+type DescribeScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// DescribeScheduleResponse is an internal type (TBD...)
+type DescribeScheduleResponse struct {
+	Spec                *ScheduleSpec                `json:"spec,omitempty"`
+	Action              *ScheduleStartWorkflowAction `json:"action,omitempty"`
+	State               *ScheduleState               `json:"state,omitempty"`
+	NextActionTimesNano []int64                      `json:"nextActionTimesNano,omitempty"`
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetAction() (o *ScheduleStartWorkflowAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetState() (o *ScheduleState) {
+	if v != nil && v.State != nil {
+		return v.State
+	}
+	return
+}
+
+// GetNextActionTimesNano is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetNextActionTimesNano() (o []int64) {
+	if v != nil && v.NextActionTimesNano != nil {
+		return v.NextActionTimesNano
+	}
+	return
+}
+
+// UpdateScheduleRequest is an internal type (TBD...)
+type UpdateScheduleRequest struct {
+	Domain     string                       `json:"domain,omitempty"`
+	ScheduleID string                       `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec                `json:"spec,omitempty"`
+	Action     *ScheduleStartWorkflowAction `json:"action,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetAction() (o *ScheduleStartWorkflowAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// PauseScheduleRequest is an internal type (TBD...)
+type PauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// UnpauseScheduleRequest is an internal type (TBD...)
+type UnpauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// TriggerScheduleRequest is an internal type (TBD...)
+type TriggerScheduleRequest struct {
+	Domain        string                 `json:"domain,omitempty"`
+	ScheduleID    string                 `json:"scheduleId,omitempty"`
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *TriggerScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *TriggerScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *TriggerScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// BackfillScheduleRequest is an internal type (TBD...)
+type BackfillScheduleRequest struct {
+	Domain        string                 `json:"domain,omitempty"`
+	ScheduleID    string                 `json:"scheduleId,omitempty"`
+	StartTimeNano *int64                 `json:"startTimeNano,omitempty"`
+	EndTimeNano   *int64                 `json:"endTimeNano,omitempty"`
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetStartTimeNano is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetStartTimeNano() (o int64) {
+	if v != nil && v.StartTimeNano != nil {
+		return *v.StartTimeNano
+	}
+	return
+}
+
+// GetEndTimeNano is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetEndTimeNano() (o int64) {
+	if v != nil && v.EndTimeNano != nil {
+		return *v.EndTimeNano
+	}
+	return
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// DeleteScheduleRequest is an internal type (TBD...)
+type DeleteScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// ListSchedulesRequest is an internal type (TBD...)
+type ListSchedulesRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListSchedulesResponse is an internal type (TBD...)
+type ListSchedulesResponse struct {
+	ScheduleIDs   []string `json:"scheduleIds,omitempty"`
+	NextPageToken []byte   `json:"nextPageToken,omitempty"`
+}
+
+// GetScheduleIDs is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetScheduleIDs() (o []string) {
+	if v != nil && v.ScheduleIDs != nil {
+		return v.ScheduleIDs
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// BatchOperationType is an internal type (TBD...)
+type BatchOperationType int32
+
+// Ptr is a helper function for getting pointer value
+func (e BatchOperationType) Ptr() *BatchOperationType {
+	return &e
+}
+
+// String returns a readable string representation of BatchOperationType.
+func (e BatchOperationType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "TERMINATE"
+	case 1:
+		return "CANCEL"
+	case 2:
+		return "SIGNAL"
+	case 3:
+		return "RESET"
+	case 4:
+		return "DELETE"
+	case 5:
+		return "UPSERT_SEARCH_ATTRIBUTES"
+	}
+	return fmt.Sprintf("BatchOperationType(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *BatchOperationType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "TERMINATE":
+		*e = BatchOperationTypeTerminate
+		return nil
+	case "CANCEL":
+		*e = BatchOperationTypeCancel
+		return nil
+	case "SIGNAL":
+		*e = BatchOperationTypeSignal
+		return nil
+	case "RESET":
+		*e = BatchOperationTypeReset
+		return nil
+	case "DELETE":
+		*e = BatchOperationTypeDelete
+		return nil
+	case "UPSERT_SEARCH_ATTRIBUTES":
+		*e = BatchOperationTypeUpsertSearchAttributes
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "BatchOperationType", err)
+		}
+		*e = BatchOperationType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes BatchOperationType to text.
+func (e BatchOperationType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// BatchOperationTypeTerminate is an option for BatchOperationType
+	BatchOperationTypeTerminate BatchOperationType = iota
+	// BatchOperationTypeCancel is an option for BatchOperationType
+	BatchOperationTypeCancel
+	// BatchOperationTypeSignal is an option for BatchOperationType
+	BatchOperationTypeSignal
+	// BatchOperationTypeReset is an option for BatchOperationType
+	BatchOperationTypeReset
+	// BatchOperationTypeDelete is an option for BatchOperationType
+	BatchOperationTypeDelete
+	// BatchOperationTypeUpsertSearchAttributes is an option for BatchOperationType
+	BatchOperationTypeUpsertSearchAttributes
+)
+
+// BatchOperationResetParams is an internal type (TBD...)
+type BatchOperationResetParams struct {
+	ResetType            string                    `json:"resetType,omitempty"`
+	BadBinaryChecksum    string                    `json:"badBinaryChecksum,omitempty"`
+	EarliestTimeNano     *int64                    `json:"earliestTimeNano,omitempty"`
+	SkipCurrentOpen      bool                      `json:"skipCurrentOpen,omitempty"`
+	SkipBaseIsNotCurrent bool                      `json:"skipBaseIsNotCurrent,omitempty"`
+	NonDeterministicOnly bool                      `json:"nonDeterministicOnly,omitempty"`
+	SkipSignalReapply    bool                      `json:"skipSignalReapply,omitempty"`
+	ChildWorkflowPolicy  *ResetChildWorkflowPolicy `json:"childWorkflowPolicy,omitempty"`
+	ReapplyEventTypes    []EventType               `json:"reapplyEventTypes,omitempty"`
+}
+
+// GetResetType is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetResetType() (o string) {
+	if v != nil {
+		return v.ResetType
+	}
+	return
+}
+
+// GetBadBinaryChecksum is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetBadBinaryChecksum() (o string) {
+	if v != nil {
+		return v.BadBinaryChecksum
+	}
+	return
+}
+
+// GetEarliestTimeNano is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetEarliestTimeNano() (o int64) {
+	if v != nil && v.EarliestTimeNano != nil {
+		return *v.EarliestTimeNano
+	}
+	return
+}
+
+// GetSkipCurrentOpen is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetSkipCurrentOpen() (o bool) {
+	if v != nil {
+		return v.SkipCurrentOpen
+	}
+	return
+}
+
+// GetSkipBaseIsNotCurrent is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetSkipBaseIsNotCurrent() (o bool) {
+	if v != nil {
+		return v.SkipBaseIsNotCurrent
+	}
+	return
+}
+
+// GetNonDeterministicOnly is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetNonDeterministicOnly() (o bool) {
+	if v != nil {
+		return v.NonDeterministicOnly
+	}
+	return
+}
+
+// GetSkipSignalReapply is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetSkipSignalReapply() (o bool) {
+	if v != nil {
+		return v.SkipSignalReapply
+	}
+	return
+}
+
+// GetChildWorkflowPolicy is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetChildWorkflowPolicy() (o ResetChildWorkflowPolicy) {
+	if v != nil && v.ChildWorkflowPolicy != nil {
+		return *v.ChildWorkflowPolicy
+	}
+	return
+}
+
+// GetReapplyEventTypes is an internal getter (TBD...)
+func (v *BatchOperationResetParams) GetReapplyEventTypes() (o []EventType) {
+	if v != nil && v.ReapplyEventTypes != nil {
+		return v.ReapplyEventTypes
+	}
+	return
+}
+
+// BatchOperationInfo is an internal type (TBD...)
+type BatchOperationInfo struct {
+	JobID         string                        `json:"jobId,omitempty"`
+	CloseStatus   *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	Reason        string                        `json:"reason,omitempty"`
+	Identity      string                        `json:"identity,omitempty"`
+	StartTimeNano *int64                        `json:"startTimeNano,omitempty"`
+	CloseTimeNano *int64                        `json:"closeTimeNano,omitempty"`
+}
+
+// GetJobID is an internal getter (TBD...)
+func (v *BatchOperationInfo) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+// GetCloseStatus is an internal getter (TBD...)
+func (v *BatchOperationInfo) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.CloseStatus != nil {
+		return *v.CloseStatus
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *BatchOperationInfo) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *BatchOperationInfo) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetStartTimeNano is an internal getter (TBD...)
+func (v *BatchOperationInfo) GetStartTimeNano() (o int64) {
+	if v != nil && v.StartTimeNano != nil {
+		return *v.StartTimeNano
+	}
+	return
+}
+
+// GetCloseTimeNano is an internal getter (TBD...)
+func (v *BatchOperationInfo) GetCloseTimeNano() (o int64) {
+	if v != nil && v.CloseTimeNano != nil {
+		return *v.CloseTimeNano
+	}
+	return
+}
+
+// BatchOperationProgress is an internal type (TBD...)
+type BatchOperationProgress struct {
+	TotalEstimate int64 `json:"totalEstimate,omitempty"`
+	SuccessCount  int64 `json:"successCount,omitempty"`
+	ErrorCount    int64 `json:"errorCount,omitempty"`
+}
+
+// GetTotalEstimate is an internal getter (TBD...)
+func (v *BatchOperationProgress) GetTotalEstimate() (o int64) {
+	if v != nil {
+		return v.TotalEstimate
+	}
+	return
+}
+
+// GetSuccessCount is an internal getter (TBD...)
+func (v *BatchOperationProgress) GetSuccessCount() (o int64) {
+	if v != nil {
+		return v.SuccessCount
+	}
+	return
+}
+
+// GetErrorCount is an internal getter (TBD...)
+func (v *BatchOperationProgress) GetErrorCount() (o int64) {
+	if v != nil {
+		return v.ErrorCount
+	}
+	return
+}
+
+// StartBatchOperationRequest is an internal type (TBD...)
+type StartBatchOperationRequest struct {
+	Domain           string                     `json:"domain,omitempty"`
+	Query            string                     `json:"query,omitempty"`
+	Reason           string                     `json:"reason,omitempty"`
+	BatchType        *BatchOperationType        `json:"batchType,omitempty"`
+	DryRun           bool                       `json:"dryRun,omitempty"`
+	RPS              int32                      `json:"rps,omitempty"`
+	Concurrency      int32                      `json:"concurrency,omitempty"`
+	SkipChildren     bool                       `json:"skipChildren,omitempty"`
+	SignalName       string                     `json:"signalName,omitempty"`
+	SignalInput      []byte                     `json:"signalInput,omitempty"`
+	ResetParams      *BatchOperationResetParams `json:"resetParams,omitempty"`
+	SearchAttributes *SearchAttributes          `json:"searchAttributes,omitempty"`
+	Identity         string                     `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetQuery is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetQuery() (o string) {
+	if v != nil {
+		return v.Query
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetBatchType is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetBatchType() (o BatchOperationType) {
+	if v != nil && v.BatchType != nil {
+		return *v.BatchType
+	}
+	return
+}
+
+// GetDryRun is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetDryRun() (o bool) {
+	if v != nil {
+		return v.DryRun
+	}
+	return
+}
+
+// GetRPS is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetRPS() (o int32) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
+// GetConcurrency is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetConcurrency() (o int32) {
+	if v != nil {
+		return v.Concurrency
+	}
+	return
+}
+
+// GetSkipChildren is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetSkipChildren() (o bool) {
+	if v != nil {
+		return v.SkipChildren
+	}
+	return
+}
+
+// GetSignalName is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+// GetSignalInput is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetSignalInput() (o []byte) {
+	if v != nil && v.SignalInput != nil {
+		return v.SignalInput
+	}
+	return
+}
+
+// GetResetParams is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetResetParams() (o *BatchOperationResetParams) {
+	if v != nil && v.ResetParams != nil {
+		return v.ResetParams
+	}
+	return
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *StartBatchOperationRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// StartBatchOperationResponse is an internal type (TBD...)
+type StartBatchOperationResponse struct {
+	JobID string `json:"jobId,omitempty"`
+}
+
+// GetJobID is an internal getter (TBD...)
+func (v *StartBatchOperationResponse) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+// DescribeBatchOperationRequest is an internal type (TBD...)
+type DescribeBatchOperationRequest struct {
+	Domain string `json:"domain,omitempty"`
+	JobID  string `json:"jobId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeBatchOperationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetJobID is an internal getter (TBD...)
+func (v *DescribeBatchOperationRequest) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+// DescribeBatchOperationResponse is an internal type (TBD...)
+type DescribeBatchOperationResponse struct {
+	Info     *BatchOperationInfo     `json:"info,omitempty"`
+	Progress *BatchOperationProgress `json:"progress,omitempty"`
+}
+
+// GetInfo is an internal getter (TBD...)
+func (v *DescribeBatchOperationResponse) GetInfo() (o *BatchOperationInfo) {
+	if v != nil && v.Info != nil {
+		return v.Info
+	}
+	return
+}
+
+// GetProgress is an internal getter (TBD...)
+func (v *DescribeBatchOperationResponse) GetProgress() (o *BatchOperationProgress) {
+	if v != nil && v.Progress != nil {
+		return v.Progress
+	}
+	return
+}
+
+// ListBatchOperationsRequest is an internal type (TBD...)
+type ListBatchOperationsRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListBatchOperationsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *ListBatchOperationsRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListBatchOperationsRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListBatchOperationsResponse is an internal type (TBD...)
+type ListBatchOperationsResponse struct {
+	Operations    []*BatchOperationInfo `json:"operations,omitempty"`
+	NextPageToken []byte                `json:"nextPageToken,omitempty"`
+}
+
+// GetOperations is an internal getter (TBD...)
+func (v *ListBatchOperationsResponse) GetOperations() (o []*BatchOperationInfo) {
+	if v != nil && v.Operations != nil {
+		return v.Operations
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListBatchOperationsResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// CancelBatchOperationRequest is an internal type (TBD...)
+type CancelBatchOperationRequest struct {
+	Domain string `json:"domain,omitempty"`
+	JobID  string `json:"jobId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *CancelBatchOperationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetJobID is an internal getter (TBD...)
+func (v *CancelBatchOperationRequest) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
@@ -37,6 +37,8 @@ const (
 	ActivityID = "ActivityID"
 	RequestID  = "RequestID"
 	TimerID    = "TimerID"
+	ScheduleID = "ScheduleID"
+	JobID      = "JobID"
 
 	WorkflowTypeName     = "WorkflowTypeName"
 	ActivityTypeName     = "ActivityTypeName"
@@ -49,6 +51,7 @@ const (
 	CronSchedule         = "CronSchedule"
 	Checksum             = "Checksum"
 	Reason               = "Reason"
+	ResetType            = "ResetType"
 	Cause                = "Cause"
 	SecurityToken        = "SecurityToken"
 	VisibilityQuery      = "VisibilityQuery"
@@ -192,6 +195,10 @@ var (
 	DataBlobArray = []*types.DataBlob{
 		&DataBlob,
 	}
+	EventTypeArray = []types.EventType{
+		types.EventTypeWorkflowExecutionSignaled,
+		types.EventTypeWorkflowExecutionCancelRequested,
+	}
 	StickyExecutionAttributes = types.StickyExecutionAttributes{
 		WorkerTaskList:                &TaskList,
 		ScheduleToStartTimeoutSeconds: &Duration1,
@@ -24,6 +24,7 @@ import "github.com/uber/cadence/common/types"
 
 var (
 	ArchivalStatus                             = types.ArchivalStatusEnabled
+	BatchOperationType                         = types.BatchOperationTypeReset
 	CancelExternalWorkflowExecutionFailedCause = types.CancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
 	ChildWorkflowExecutionFailedCause          = types.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning
 	ContinueAsNewInitiator                     = types.ContinueAsNewInitiatorRetryPolicy
@@ -42,6 +43,8 @@ var (
 	QueryRejectCondition                       = types.QueryRejectConditionNotCompletedCleanly
 	QueryResultType                            = types.QueryResultTypeFailed
 	QueryTaskCompletedType                     = types.QueryTaskCompletedTypeFailed
+	ResetChildWorkflowPolicy                   = types.ResetChildWorkflowPolicyTerminate
+	ScheduleOverlapPolicy                      = types.ScheduleOverlapPolicyCancelOther
 	SignalExternalWorkflowExecutionFailedCause = types.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
 	TaskListKind                               = types.TaskListKindSticky
 	TaskListType                               = types.TaskListTypeActivity
@@ -359,10 +359,17 @@ var (
 		DecisionFinishEventID: EventID1,
 		RequestID:             RequestID,
 		SkipSignalReapply:     true,
+		ChildWorkflowPolicy:   &ResetChildWorkflowPolicy,
+		ReapplyEventTypes:     EventTypeArray,
 	}
 	ResetWorkflowExecutionResponse = types.ResetWorkflowExecutionResponse{
 		RunID: RunID,
 	}
+	DeleteWorkflowExecutionRequest = types.DeleteWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Identity:          Identity,
+	}
 	TerminateWorkflowExecutionRequest = types.TerminateWorkflowExecutionRequest{
 		Domain:            DomainName,
 		WorkflowExecution: &WorkflowExecution,
@@ -430,6 +437,162 @@ var (
 		NextPageToken: NextPageToken,
 		Archived:      true,
 	}
+	ScheduleSpec = types.ScheduleSpec{
+		CronSchedule:         CronSchedule,
+		StartTimeNano:        &Timestamp1,
+		EndTimeNano:          &Timestamp2,
+		OverlapPolicy:        &ScheduleOverlapPolicy,
+		CatchupWindowSeconds: &Duration1,
+	}
+	ScheduleStartWorkflowAction = types.ScheduleStartWorkflowAction{
+		WorkflowType:                        &WorkflowType,
+		TaskList:                            &TaskList,
+		Input:                               Payload1,
+		WorkflowIDPrefix:                    WorkflowID,
+		ExecutionStartToCloseTimeoutSeconds: &Duration2,
+		TaskStartToCloseTimeoutSeconds:      &Duration3,
+	}
+	ScheduleActionResult = types.ScheduleActionResult{
+		ScheduledTimeNano: &Timestamp3,
+		ActualTimeNano:    &Timestamp4,
+		WorkflowExecution: &WorkflowExecution,
+	}
+	ScheduleActionResultArray = []*types.ScheduleActionResult{
+		&ScheduleActionResult,
+	}
+	ScheduleState = types.ScheduleState{
+		Paused:                true,
+		Notes:                 Reason,
+		LastProcessedTimeNano: &Timestamp5,
+		RecentActions:         ScheduleActionResultArray,
+		TotalActions:          1,
+		SkippedActions:        2,
+		MissedCatchupWindow:   3,
+		FailedActions:         4,
+	}
+	CreateScheduleRequest = types.CreateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleStartWorkflowAction,
+	}
+	DescribeScheduleRequest = types.DescribeScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+	}
+	DescribeScheduleResponse = types.DescribeScheduleResponse{
+		Spec:                &ScheduleSpec,
+		Action:              &ScheduleStartWorkflowAction,
+		State:               &ScheduleState,
+		NextActionTimesNano: []int64{Timestamp1, Timestamp2},
+	}
+	UpdateScheduleRequest = types.UpdateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleStartWorkflowAction,
+	}
+	PauseScheduleRequest = types.PauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Reason:     Reason,
+	}
+	UnpauseScheduleRequest = types.UnpauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Reason:     Reason,
+	}
+	TriggerScheduleRequest = types.TriggerScheduleRequest{
+		Domain:        DomainName,
+		ScheduleID:    ScheduleID,
+		OverlapPolicy: &ScheduleOverlapPolicy,
+	}
+	BackfillScheduleRequest = types.BackfillScheduleRequest{
+		Domain:        DomainName,
+		ScheduleID:    ScheduleID,
+		StartTimeNano: &Timestamp1,
+		EndTimeNano:   &Timestamp2,
+		OverlapPolicy: &ScheduleOverlapPolicy,
+	}
+	DeleteScheduleRequest = types.DeleteScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+	}
+	ListSchedulesRequest = types.ListSchedulesRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	ListSchedulesResponse = types.ListSchedulesResponse{
+		ScheduleIDs:   []string{ScheduleID},
+		NextPageToken: NextPageToken,
+	}
+	BatchOperationResetParams = types.BatchOperationResetParams{
+		ResetType:            ResetType,
+		BadBinaryChecksum:    Checksum,
+		EarliestTimeNano:     &Timestamp1,
+		SkipCurrentOpen:      true,
+		SkipBaseIsNotCurrent: true,
+		NonDeterministicOnly: true,
+		SkipSignalReapply:    true,
+		ChildWorkflowPolicy:  &ResetChildWorkflowPolicy,
+		ReapplyEventTypes:    EventTypeArray,
+	}
+	BatchOperationInfo = types.BatchOperationInfo{
+		JobID:         JobID,
+		CloseStatus:   &WorkflowExecutionCloseStatus,
+		Reason:        Reason,
+		Identity:      Identity,
+		StartTimeNano: &Timestamp1,
+		CloseTimeNano: &Timestamp2,
+	}
+	BatchOperationInfoArray = []*types.BatchOperationInfo{
+		&BatchOperationInfo,
+	}
+	BatchOperationProgress = types.BatchOperationProgress{
+		TotalEstimate: 1,
+		SuccessCount:  2,
+		ErrorCount:    3,
+	}
+	StartBatchOperationRequest = types.StartBatchOperationRequest{
+		Domain:           DomainName,
+		Query:            VisibilityQuery,
+		Reason:           Reason,
+		BatchType:        &BatchOperationType,
+		DryRun:           true,
+		RPS:              1,
+		Concurrency:      2,
+		SkipChildren:     true,
+		SignalName:       SignalName,
+		SignalInput:      Payload1,
+		ResetParams:      &BatchOperationResetParams,
+		SearchAttributes: &SearchAttributes,
+		Identity:         Identity,
+	}
+	StartBatchOperationResponse = types.StartBatchOperationResponse{
+		JobID: JobID,
+	}
+	DescribeBatchOperationRequest = types.DescribeBatchOperationRequest{
+		Domain: DomainName,
+		JobID:  JobID,
+	}
+	DescribeBatchOperationResponse = types.DescribeBatchOperationResponse{
+		Info:     &BatchOperationInfo,
+		Progress: &BatchOperationProgress,
+	}
+	ListBatchOperationsRequest = types.ListBatchOperationsRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	ListBatchOperationsResponse = types.ListBatchOperationsResponse{
+		Operations:    BatchOperationInfoArray,
+		NextPageToken: NextPageToken,
+	}
+	CancelBatchOperationRequest = types.CancelBatchOperationRequest{
+		Domain: DomainName,
+		JobID:  JobID,
+	}
 
 	DescribeDomainResponseArray = []*types.DescribeDomainResponse{
 		&DescribeDomainResponse,
@@ -285,6 +285,15 @@ var (
 		ShardID:       ShardID,
 		Timestamp:     &Timestamp1,
 	}
+	HistoryDeleteWorkflowExecutionRequest = types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		DeleteRequest: &DeleteWorkflowExecutionRequest,
+	}
+	HistoryUpsertWorkflowSearchAttributesRequest = types.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID:        DomainID,
+		WorkflowExecution: &WorkflowExecution,
+		SearchAttributes:  &SearchAttributes,
+	}
 	HistoryTerminateWorkflowExecutionRequest = types.HistoryTerminateWorkflowExecutionRequest{
 		DomainUUID:       DomainID,
 		TerminateRequest: &TerminateWorkflowExecutionRequest,
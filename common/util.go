@@ -79,6 +79,10 @@ const (
 	replicationServiceBusyMaxInterval        = 10 * time.Second
 	replicationServiceBusyExpirationInterval = 5 * time.Minute
 
+	blobstoreClientOperationInitialInterval    = 100 * time.Millisecond
+	blobstoreClientOperationMaxInterval        = 5 * time.Second
+	blobstoreClientOperationExpirationInterval = 30 * time.Second
+
 	contextExpireThreshold = 10 * time.Millisecond
 
 	// FailureReasonCompleteResultExceedsLimit is failureReason for complete result exceeds limit
@@ -223,6 +227,15 @@ func CreateReplicationServiceBusyRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
+// CreateBlobstoreClientRetryPolicy creates a retry policy for blobstore client
+func CreateBlobstoreClientRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(blobstoreClientOperationInitialInterval)
+	policy.SetMaximumInterval(blobstoreClientOperationMaxInterval)
+	policy.SetExpirationInterval(blobstoreClientOperationExpirationInterval)
+
+	return policy
+}
+
 // ValidIDLength checks if id is valid according to its length
 func ValidIDLength(
 	id string,
@@ -74,6 +74,13 @@ const (
 	PostgresPort = "POSTGRES_PORT"
 	// PostgresDefaultPort Postgres default port
 	PostgresDefaultPort = "5432"
+
+	// DynamoDBSeeds env
+	DynamoDBSeeds = "DYNAMODB_SEEDS"
+	// DynamoDBPort env
+	DynamoDBPort = "DYNAMODB_PORT"
+	// DynamoDBDefaultPort DynamoDB local default port
+	DynamoDBDefaultPort = "8000"
 )
 
 // SetupEnv setup the necessary env
@@ -127,6 +134,20 @@ func SetupEnv() {
 		}
 	}
 
+	if os.Getenv(DynamoDBSeeds) == "" {
+		err := os.Setenv(DynamoDBSeeds, Localhost)
+		if err != nil {
+			panic(fmt.Sprintf("error setting env %v", DynamoDBSeeds))
+		}
+	}
+
+	if os.Getenv(DynamoDBPort) == "" {
+		err := os.Setenv(DynamoDBPort, DynamoDBDefaultPort)
+		if err != nil {
+			panic(fmt.Sprintf("error setting env %v", DynamoDBPort))
+		}
+	}
+
 	if os.Getenv(KafkaSeeds) == "" {
 		err := os.Setenv(KafkaSeeds, Localhost)
 		if err != nil {
@@ -191,6 +212,28 @@ func GetCassandraProtoVersion() int {
 	return p
 }
 
+// GetDynamoDBAddress return the DynamoDB address
+func GetDynamoDBAddress() string {
+	addr := os.Getenv(DynamoDBSeeds)
+	if addr == "" {
+		addr = Localhost
+	}
+	return addr
+}
+
+// GetDynamoDBPort return the DynamoDB port
+func GetDynamoDBPort() int {
+	port := os.Getenv(DynamoDBPort)
+	if port == "" {
+		port = DynamoDBDefaultPort
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		panic(fmt.Sprintf("error getting env %v", DynamoDBPort))
+	}
+	return p
+}
+
 // GetMySQLAddress return the MySQL address
 func GetMySQLAddress() string {
 	addr := os.Getenv(MySQLSeeds)
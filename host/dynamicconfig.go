@@ -191,3 +191,9 @@ func newIntegrationConfigClient(client dynamicconfig.Client) *dynamicClient {
 	}
 	return integrationClient
 }
+
+func (d *dynamicClient) Subscribe(
+	name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, callback dynamicconfig.SubscriptionCallback,
+) (func(), error) {
+	return d.client.Subscribe(name, filters, callback)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package esutils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	openSearchClient struct {
+		client *opensearch.Client
+	}
+)
+
+func newOpenSearchClient(url string) (*openSearchClient, error) {
+	osClient, err := opensearch.NewClient(opensearch.Config{
+		Addresses: []string{url},
+	})
+	return &openSearchClient{
+		client: osClient,
+	}, err
+}
+
+func (c *openSearchClient) PutIndexTemplate(s suite.Suite, templateConfigFile, templateName string) {
+	// This function is used exclusively in tests. Excluding it from security checks.
+	// #nosec
+	template, err := os.Open(templateConfigFile)
+	s.Require().NoError(err)
+	defer template.Close()
+	resp, err := c.client.Indices.PutTemplate(templateName, template, c.client.Indices.PutTemplate.WithContext(createContext()))
+	s.Require().NoError(checkOpenSearchResponse(resp, err))
+}
+
+func (c *openSearchClient) CreateIndex(s suite.Suite, indexName string) {
+	resp, err := c.client.Indices.Exists([]string{indexName}, c.client.Indices.Exists.WithContext(createContext()))
+	s.Require().NoError(err)
+	resp.Body.Close()
+	if resp.StatusCode == 200 {
+		resp, err := c.client.Indices.Delete([]string{indexName}, c.client.Indices.Delete.WithContext(createContext()))
+		s.Require().NoError(checkOpenSearchResponse(resp, err))
+	}
+
+	resp, err = c.client.Indices.Create(indexName, c.client.Indices.Create.WithContext(createContext()))
+	s.Require().NoError(checkOpenSearchResponse(resp, err))
+}
+
+func (c *openSearchClient) DeleteIndex(s suite.Suite, indexName string) {
+	resp, err := c.client.Indices.Delete([]string{indexName}, c.client.Indices.Delete.WithContext(createContext()))
+	s.NoError(checkOpenSearchResponse(resp, err))
+}
+
+func (c *openSearchClient) PutMaxResultWindow(indexName string, maxResultWindow int) error {
+	resp, err := c.client.Indices.PutSettings(
+		strings.NewReader(fmt.Sprintf(`{"max_result_window" : %d}`, maxResultWindow)),
+		c.client.Indices.PutSettings.WithIndex(indexName),
+		c.client.Indices.PutSettings.WithContext(createContext()),
+	)
+	return checkOpenSearchResponse(resp, err)
+}
+
+func (c *openSearchClient) GetMaxResultWindow(indexName string) (string, error) {
+	resp, err := c.client.Indices.GetSettings(
+		c.client.Indices.GetSettings.WithIndex(indexName),
+		c.client.Indices.GetSettings.WithContext(createContext()),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return "", fmt.Errorf("get settings failed: %v", resp.String())
+	}
+	return decodeMaxResultWindow(resp.Body, indexName)
+}
+
+func checkOpenSearchResponse(resp *opensearchapi.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return fmt.Errorf("request failed: %v", resp.String())
+	}
+	return nil
+}
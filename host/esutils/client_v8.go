@@ -0,0 +1,109 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package esutils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	v8Client struct {
+		client *elasticsearch.Client
+	}
+)
+
+func newV8Client(url string) (*v8Client, error) {
+	esClient, err := elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{url},
+	})
+	return &v8Client{
+		client: esClient,
+	}, err
+}
+
+func (es *v8Client) PutIndexTemplate(s suite.Suite, templateConfigFile, templateName string) {
+	// This function is used exclusively in tests. Excluding it from security checks.
+	// #nosec
+	template, err := os.Open(templateConfigFile)
+	s.Require().NoError(err)
+	defer template.Close()
+	resp, err := es.client.Indices.PutTemplate(templateName, template, es.client.Indices.PutTemplate.WithContext(createContext()))
+	s.Require().NoError(checkV8Response(resp, err))
+}
+
+func (es *v8Client) CreateIndex(s suite.Suite, indexName string) {
+	resp, err := es.client.Indices.Exists([]string{indexName}, es.client.Indices.Exists.WithContext(createContext()))
+	s.Require().NoError(err)
+	resp.Body.Close()
+	if resp.StatusCode == 200 {
+		resp, err := es.client.Indices.Delete([]string{indexName}, es.client.Indices.Delete.WithContext(createContext()))
+		s.Require().NoError(checkV8Response(resp, err))
+	}
+
+	resp, err = es.client.Indices.Create(indexName, es.client.Indices.Create.WithContext(createContext()))
+	s.Require().NoError(checkV8Response(resp, err))
+}
+
+func (es *v8Client) DeleteIndex(s suite.Suite, indexName string) {
+	resp, err := es.client.Indices.Delete([]string{indexName}, es.client.Indices.Delete.WithContext(createContext()))
+	s.NoError(checkV8Response(resp, err))
+}
+
+func (es *v8Client) PutMaxResultWindow(indexName string, maxResultWindow int) error {
+	resp, err := es.client.Indices.PutSettings(
+		strings.NewReader(fmt.Sprintf(`{"max_result_window" : %d}`, maxResultWindow)),
+		es.client.Indices.PutSettings.WithIndex(indexName),
+		es.client.Indices.PutSettings.WithContext(createContext()),
+	)
+	return checkV8Response(resp, err)
+}
+
+func (es *v8Client) GetMaxResultWindow(indexName string) (string, error) {
+	resp, err := es.client.Indices.GetSettings(
+		es.client.Indices.GetSettings.WithIndex(indexName),
+		es.client.Indices.GetSettings.WithContext(createContext()),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return "", fmt.Errorf("get settings failed: %v", resp.String())
+	}
+	return decodeMaxResultWindow(resp.Body, indexName)
+}
+
+func checkV8Response(resp *esapi.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return fmt.Errorf("request failed: %v", resp.String())
+	}
+	return nil
+}
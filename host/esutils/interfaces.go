@@ -22,6 +22,9 @@ package esutils
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/stretchr/testify/suite"
@@ -48,6 +51,10 @@ func CreateESClient(s suite.Suite, url string, version string) ESClient {
 		client, err = newV6Client(url)
 	case "v7":
 		client, err = newV7Client(url)
+	case "v8":
+		client, err = newV8Client(url)
+	case "opensearch":
+		client, err = newOpenSearchClient(url)
 	default:
 		s.Fail("not supported ES version")
 	}
@@ -59,3 +66,22 @@ func createContext() context.Context {
 	ctx, _ := context.WithTimeout(context.Background(), 90*time.Second)
 	return ctx
 }
+
+// decodeMaxResultWindow reads max_result_window from the get index settings response
+func decodeMaxResultWindow(body io.Reader, indexName string) (string, error) {
+	var settings map[string]struct {
+		Settings struct {
+			Index struct {
+				MaxResultWindow string `json:"max_result_window"`
+			} `json:"index"`
+		} `json:"settings"`
+	}
+	if err := json.NewDecoder(body).Decode(&settings); err != nil {
+		return "", err
+	}
+	index, ok := settings[indexName]
+	if !ok {
+		return "", fmt.Errorf("settings of index %v not found", indexName)
+	}
+	return index.Settings.Index.MaxResultWindow, nil
+}
@@ -410,7 +410,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.ESConfig = c.esConfig
 	params.ESClient = c.esClient
 	var err error
-	authorizer, err := authorization.NewAuthorizer(c.authorizationConfig, params.Logger, nil)
+	authorizer, err := authorization.NewAuthorizer(c.authorizationConfig, params.Logger, nil, params.MetricsClient)
 	if err != nil {
 		c.logger.Fatal("Unable to create authorizer", tag.Error(err))
 	}
@@ -616,7 +616,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 		c.startWorkerClientWorker(params, service, clientWorkerDomainCache)
 	}
 
-	if c.workerConfig.EnableIndexer {
+	if c.workerConfig.EnableIndexer && !c.esConfig.IsDirectIndexing() {
 		c.startWorkerIndexer(params, service)
 	}
 
@@ -82,3 +82,18 @@ func (s *simpleMonitor) GetReachableMembers() ([]string, error) {
 func (s *simpleMonitor) GetMemberCount(service string) (int, error) {
 	return 0, nil
 }
+
+func (s *simpleMonitor) SetSelfLabel(key string, value string) error {
+	s.hostInfo.SetLabel(key, value)
+	return nil
+}
+
+func (s *simpleMonitor) GetMemberLabels(service string, key string) (map[string]string, error) {
+	values := make(map[string]string)
+	if role, _ := s.hostInfo.Label(membership.RoleKey); role == service {
+		if value, ok := s.hostInfo.Label(key); ok {
+			values[s.hostInfo.GetAddress()] = value
+		}
+	}
+	return values, nil
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/messaging/kafka"
+	"github.com/uber/cadence/common/messaging/persistencequeue"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -55,6 +56,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
 )
 
 type (
@@ -92,8 +94,10 @@ type (
 
 	// MessagingClientConfig is the config for messaging config
 	MessagingClientConfig struct {
-		UseMock     bool
-		KafkaConfig *config.KafkaConfig
+		UseMock bool
+		// PersistenceQueue uses the queue tables of the test persistence instead of kafka if not nil
+		PersistenceQueue *config.PersistenceQueueMessaging
+		KafkaConfig      *config.KafkaConfig
 	}
 
 	// WorkerConfig is the config for enabling/disabling cadence worker
@@ -115,7 +119,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger, params persistenc
 	testBase.Setup()
 	setupShards(testBase, options.HistoryConfig.NumHistoryShards, logger)
 	archiverBase := newArchiverBase(options.EnableArchival, logger)
-	messagingClient := getMessagingClient(options.MessagingClientConfig, logger)
+	messagingClient := getMessagingClient(options.MessagingClientConfig, testBase.ExecutionMgrFactory, logger)
 	var esClient elasticsearch.GenericClient
 	if options.WorkerConfig.EnableIndexer {
 		var err error
@@ -212,6 +216,10 @@ func NewPersistenceTestCluster(clusterConfig *TestClusterConfig) testcluster.Per
 			ops = mysql.GetTestClusterOption()
 		} else if TestFlags.SQLPluginName == postgres.PluginName {
 			ops = postgres.GetTestClusterOption()
+		} else if TestFlags.SQLPluginName == sqlite.PluginName {
+			ops = sqlite.GetTestClusterOption()
+			// the database name is the path of a temp database file
+			clusterConfig.Persistence.DBName = ops.DBName
 		} else {
 			panic("not supported plugin " + TestFlags.SQLPluginName)
 		}
@@ -283,12 +291,15 @@ func newArchiverBase(enabled bool, logger log.Logger) *ArchiverBase {
 	}
 }
 
-func getMessagingClient(config *MessagingClientConfig, logger log.Logger) messaging.Client {
-	if config == nil || config.UseMock {
+func getMessagingClient(cfg *MessagingClientConfig, factory persistencequeue.QueueManagerFactory, logger log.Logger) messaging.Client {
+	if cfg == nil || cfg.UseMock {
 		return mocks.NewMockMessagingClient(&mocks.KafkaProducer{}, nil)
 	}
-	checkApp := len(config.KafkaConfig.Applications) != 0
-	return kafka.NewKafkaClient(config.KafkaConfig, metrics.NewNoopMetricsClient(), logger, tally.NoopScope, checkApp)
+	if cfg.PersistenceQueue != nil {
+		return persistencequeue.NewClient(*cfg.PersistenceQueue, factory, metrics.NewNoopMetricsClient(), logger)
+	}
+	checkApp := len(cfg.KafkaConfig.Applications) != 0
+	return kafka.NewKafkaClient(cfg.KafkaConfig, metrics.NewNoopMetricsClient(), logger, tally.NoopScope, checkApp)
 }
 
 // TearDownCluster tears down the test cluster
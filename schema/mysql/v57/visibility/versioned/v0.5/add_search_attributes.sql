@@ -0,0 +1,17 @@
+ALTER TABLE executions_visibility ADD search_attributes BLOB;
+
+CREATE TABLE executions_visibility_search_attributes (
+  domain_id            CHAR(64) NOT NULL,
+  run_id               CHAR(64) NOT NULL,
+  name                 VARCHAR(255) NOT NULL,
+  value_index          INT NOT NULL, -- position of the value when the search attribute holds a list
+  string_value         TEXT,
+  int_value            BIGINT,
+  double_value         DOUBLE,
+
+  PRIMARY KEY  (domain_id, run_id, name, value_index)
+);
+
+CREATE INDEX by_string_value ON executions_visibility_search_attributes (domain_id, name, string_value(255));
+CREATE INDEX by_int_value ON executions_visibility_search_attributes (domain_id, name, int_value);
+CREATE INDEX by_double_value ON executions_visibility_search_attributes (domain_id, name, double_value);
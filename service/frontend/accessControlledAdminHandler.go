@@ -44,7 +44,7 @@ var _ AdminHandler = (*AccessControlledWorkflowAdminHandler)(nil)
 func NewAccessControlledAdminHandlerImpl(adminHandler AdminHandler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) *AccessControlledWorkflowAdminHandler {
 	if authorizer == nil {
 		var err error
-		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
+		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache(), resource.GetMetricsClient())
 		if err != nil {
 			resource.GetLogger().Fatal("Error when initiating the Authorizer", tag.Error(err))
 		}
@@ -155,6 +155,7 @@ func (a *AccessControlledWorkflowAdminHandler) DescribeQueue(ctx context.Context
 func (a *AccessControlledWorkflowAdminHandler) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	attr := &authorization.Attributes{
 		APIName:    "DescribeWorkflowExecution",
+		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionAdmin,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr)
@@ -219,6 +220,7 @@ func (a *AccessControlledWorkflowAdminHandler) GetReplicationMessages(ctx contex
 func (a *AccessControlledWorkflowAdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	attr := &authorization.Attributes{
 		APIName:    "GetWorkflowExecutionRawHistoryV2",
+		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionAdmin,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr)
@@ -283,6 +285,7 @@ func (a *AccessControlledWorkflowAdminHandler) ReadDLQMessages(ctx context.Conte
 func (a *AccessControlledWorkflowAdminHandler) ReapplyEvents(ctx context.Context, request *types.ReapplyEventsRequest) error {
 	attr := &authorization.Attributes{
 		APIName:    "ReapplyEvents",
+		DomainName: request.GetDomainName(),
 		Permission: authorization.PermissionAdmin,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr)
@@ -299,6 +302,7 @@ func (a *AccessControlledWorkflowAdminHandler) ReapplyEvents(ctx context.Context
 func (a *AccessControlledWorkflowAdminHandler) RefreshWorkflowTasks(ctx context.Context, request *types.RefreshWorkflowTasksRequest) error {
 	attr := &authorization.Attributes{
 		APIName:    "RefreshWorkflowTasks",
+		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionAdmin,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr)
@@ -47,7 +47,7 @@ var _ Handler = (*AccessControlledWorkflowHandler)(nil)
 func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) *AccessControlledWorkflowHandler {
 	if authorizer == nil {
 		var err error
-		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
+		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache(), resource.GetMetricsClient())
 		if err != nil {
 			resource.GetLogger().Fatal("Error when initiating the Authorizer", tag.Error(err))
 		}
@@ -64,6 +64,30 @@ func (a *AccessControlledWorkflowHandler) Health(ctx context.Context) (*types.He
 	return a.frontendHandler.Health(ctx)
 }
 
+// BackfillSchedule API call
+func (a *AccessControlledWorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendBackfillScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "BackfillSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.BackfillSchedule(ctx, request)
+}
+
 // CountWorkflowExecutions API call
 func (a *AccessControlledWorkflowHandler) CountWorkflowExecutions(
 	ctx context.Context,
@@ -88,6 +112,54 @@ func (a *AccessControlledWorkflowHandler) CountWorkflowExecutions(
 	return a.frontendHandler.CountWorkflowExecutions(ctx, request)
 }
 
+// CreateSchedule API call
+func (a *AccessControlledWorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendCreateScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "CreateSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.CreateSchedule(ctx, request)
+}
+
+// DeleteSchedule API call
+func (a *AccessControlledWorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	request *types.DeleteScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDeleteScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DeleteSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.DeleteSchedule(ctx, request)
+}
+
 // DeprecateDomain API call
 func (a *AccessControlledWorkflowHandler) DeprecateDomain(
 	ctx context.Context,
@@ -136,6 +208,30 @@ func (a *AccessControlledWorkflowHandler) DescribeDomain(
 	return a.frontendHandler.DescribeDomain(ctx, request)
 }
 
+// DescribeSchedule API call
+func (a *AccessControlledWorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (*types.DescribeScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DescribeSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DescribeSchedule(ctx, request)
+}
+
 // DescribeTaskList API call
 func (a *AccessControlledWorkflowHandler) DescribeTaskList(
 	ctx context.Context,
@@ -147,6 +243,7 @@ func (a *AccessControlledWorkflowHandler) DescribeTaskList(
 	attr := &authorization.Attributes{
 		APIName:    "DescribeTaskList",
 		DomainName: request.GetDomain(),
+		TaskList:   request.TaskList,
 		Permission: authorization.PermissionRead,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
@@ -309,6 +406,126 @@ func (a *AccessControlledWorkflowHandler) ListOpenWorkflowExecutions(
 	return a.frontendHandler.ListOpenWorkflowExecutions(ctx, request)
 }
 
+// ListSchedules API call
+func (a *AccessControlledWorkflowHandler) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (*types.ListSchedulesResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListSchedulesScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "ListSchedules",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.ListSchedules(ctx, request)
+}
+
+// StartBatchOperation API call
+func (a *AccessControlledWorkflowHandler) StartBatchOperation(
+	ctx context.Context,
+	request *types.StartBatchOperationRequest,
+) (*types.StartBatchOperationResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendStartBatchOperationScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "StartBatchOperation",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.StartBatchOperation(ctx, request)
+}
+
+// DescribeBatchOperation API call
+func (a *AccessControlledWorkflowHandler) DescribeBatchOperation(
+	ctx context.Context,
+	request *types.DescribeBatchOperationRequest,
+) (*types.DescribeBatchOperationResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeBatchOperationScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DescribeBatchOperation",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DescribeBatchOperation(ctx, request)
+}
+
+// ListBatchOperations API call
+func (a *AccessControlledWorkflowHandler) ListBatchOperations(
+	ctx context.Context,
+	request *types.ListBatchOperationsRequest,
+) (*types.ListBatchOperationsResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListBatchOperationsScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "ListBatchOperations",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.ListBatchOperations(ctx, request)
+}
+
+// CancelBatchOperation API call
+func (a *AccessControlledWorkflowHandler) CancelBatchOperation(
+	ctx context.Context,
+	request *types.CancelBatchOperationRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendCancelBatchOperationScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "CancelBatchOperation",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.CancelBatchOperation(ctx, request)
+}
+
 // ListWorkflowExecutions API call
 func (a *AccessControlledWorkflowHandler) ListWorkflowExecutions(
 	ctx context.Context,
@@ -333,6 +550,30 @@ func (a *AccessControlledWorkflowHandler) ListWorkflowExecutions(
 	return a.frontendHandler.ListWorkflowExecutions(ctx, request)
 }
 
+// PauseSchedule API call
+func (a *AccessControlledWorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "PauseSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.PauseSchedule(ctx, request)
+}
+
 // PollForActivityTask API call
 func (a *AccessControlledWorkflowHandler) PollForActivityTask(
 	ctx context.Context,
@@ -632,9 +873,11 @@ func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalWithStartWorkflowExecutionScope, request)
 
 	attr := &authorization.Attributes{
-		APIName:    "SignalWithStartWorkflowExecution",
-		DomainName: request.GetDomain(),
-		Permission: authorization.PermissionWrite,
+		APIName:      "SignalWithStartWorkflowExecution",
+		DomainName:   request.GetDomain(),
+		WorkflowType: request.WorkflowType,
+		TaskList:     request.TaskList,
+		Permission:   authorization.PermissionWrite,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -684,6 +927,7 @@ func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 		DomainName:   request.GetDomain(),
 		Permission:   authorization.PermissionWrite,
 		WorkflowType: request.WorkflowType,
+		TaskList:     request.TaskList,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -720,6 +964,30 @@ func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	return a.frontendHandler.TerminateWorkflowExecution(ctx, request)
 }
 
+// DeleteWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDeleteWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DeleteWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.DeleteWorkflowExecution(ctx, request)
+}
+
 // ListTaskListPartitions API call
 func (a *AccessControlledWorkflowHandler) ListTaskListPartitions(
 	ctx context.Context,
@@ -731,6 +999,7 @@ func (a *AccessControlledWorkflowHandler) ListTaskListPartitions(
 	attr := &authorization.Attributes{
 		APIName:    "ListTaskListPartitions",
 		DomainName: request.GetDomain(),
+		TaskList:   request.TaskList,
 		Permission: authorization.PermissionRead,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
@@ -768,6 +1037,54 @@ func (a *AccessControlledWorkflowHandler) GetTaskListsByDomain(
 	return a.frontendHandler.GetTaskListsByDomain(ctx, request)
 }
 
+// TriggerSchedule API call
+func (a *AccessControlledWorkflowHandler) TriggerSchedule(
+	ctx context.Context,
+	request *types.TriggerScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendTriggerScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "TriggerSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.TriggerSchedule(ctx, request)
+}
+
+// UnpauseSchedule API call
+func (a *AccessControlledWorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UnpauseSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseSchedule(ctx, request)
+}
+
 // UpdateDomain API call
 func (a *AccessControlledWorkflowHandler) UpdateDomain(
 	ctx context.Context,
@@ -841,3 +1158,27 @@ func (a *AccessControlledWorkflowHandler) getMetricsScopeWithDomainName(
 ) metrics.Scope {
 	return a.GetMetricsClient().Scope(scope).Tagged(metrics.DomainTag(domainName))
 }
+
+// UpdateSchedule API call
+func (a *AccessControlledWorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UpdateSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateSchedule(ctx, request)
+}
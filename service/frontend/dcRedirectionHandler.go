@@ -76,6 +76,96 @@ func (handler *DCRedirectionHandlerImpl) Health(ctx context.Context) (*types.Hea
 
 // Domain APIs, domain APIs does not require redirection
 
+// BackfillSchedule API call
+func (handler *DCRedirectionHandlerImpl) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) (retError error) {
+
+	var apiName = "BackfillSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBackfillScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.BackfillSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.BackfillSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// CreateSchedule API call
+func (handler *DCRedirectionHandlerImpl) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) (retError error) {
+
+	var apiName = "CreateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.CreateSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.CreateSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// DeleteSchedule API call
+func (handler *DCRedirectionHandlerImpl) DeleteSchedule(
+	ctx context.Context,
+	request *types.DeleteScheduleRequest,
+) (retError error) {
+
+	var apiName = "DeleteSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.DeleteSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.DeleteSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 // DeprecateDomain API call
 func (handler *DCRedirectionHandlerImpl) DeprecateDomain(
 	ctx context.Context,
@@ -108,6 +198,36 @@ func (handler *DCRedirectionHandlerImpl) DescribeDomain(
 	return handler.frontendHandler.DescribeDomain(ctx, request)
 }
 
+// DescribeSchedule API call
+func (handler *DCRedirectionHandlerImpl) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (resp *types.DescribeScheduleResponse, retError error) {
+
+	var apiName = "DescribeSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.DescribeSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.DescribeSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // ListDomains API call
 func (handler *DCRedirectionHandlerImpl) ListDomains(
 	ctx context.Context,
@@ -124,6 +244,186 @@ func (handler *DCRedirectionHandlerImpl) ListDomains(
 	return handler.frontendHandler.ListDomains(ctx, request)
 }
 
+// ListSchedules API call
+func (handler *DCRedirectionHandlerImpl) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (resp *types.ListSchedulesResponse, retError error) {
+
+	var apiName = "ListSchedules"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSchedulesScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.ListSchedules(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListSchedules(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// StartBatchOperation API call
+func (handler *DCRedirectionHandlerImpl) StartBatchOperation(
+	ctx context.Context,
+	request *types.StartBatchOperationRequest,
+) (resp *types.StartBatchOperationResponse, retError error) {
+
+	var apiName = "StartBatchOperation"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionStartBatchOperationScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.StartBatchOperation(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.StartBatchOperation(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// DescribeBatchOperation API call
+func (handler *DCRedirectionHandlerImpl) DescribeBatchOperation(
+	ctx context.Context,
+	request *types.DescribeBatchOperationRequest,
+) (resp *types.DescribeBatchOperationResponse, retError error) {
+
+	var apiName = "DescribeBatchOperation"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeBatchOperationScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.DescribeBatchOperation(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.DescribeBatchOperation(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// ListBatchOperations API call
+func (handler *DCRedirectionHandlerImpl) ListBatchOperations(
+	ctx context.Context,
+	request *types.ListBatchOperationsRequest,
+) (resp *types.ListBatchOperationsResponse, retError error) {
+
+	var apiName = "ListBatchOperations"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListBatchOperationsScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.ListBatchOperations(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListBatchOperations(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// CancelBatchOperation API call
+func (handler *DCRedirectionHandlerImpl) CancelBatchOperation(
+	ctx context.Context,
+	request *types.CancelBatchOperationRequest,
+) (retError error) {
+
+	var apiName = "CancelBatchOperation"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCancelBatchOperationScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.CancelBatchOperation(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.CancelBatchOperation(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// PauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) (retError error) {
+
+	var apiName = "PauseSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 // RegisterDomain API call
 func (handler *DCRedirectionHandlerImpl) RegisterDomain(
 	ctx context.Context,
@@ -140,6 +440,66 @@ func (handler *DCRedirectionHandlerImpl) RegisterDomain(
 	return handler.frontendHandler.RegisterDomain(ctx, request)
 }
 
+// TriggerSchedule API call
+func (handler *DCRedirectionHandlerImpl) TriggerSchedule(
+	ctx context.Context,
+	request *types.TriggerScheduleRequest,
+) (retError error) {
+
+	var apiName = "TriggerSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionTriggerScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.TriggerSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.TriggerSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UnpauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) (retError error) {
+
+	var apiName = "UnpauseSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 // UpdateDomain API call
 func (handler *DCRedirectionHandlerImpl) UpdateDomain(
 	ctx context.Context,
@@ -1113,6 +1473,36 @@ func (handler *DCRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return err
 }
 
+// DeleteWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "DeleteWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.DeleteWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.DeleteWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 // ListTaskListPartitions API call
 func (handler *DCRedirectionHandlerImpl) ListTaskListPartitions(
 	ctx context.Context,
@@ -1203,3 +1593,33 @@ func (handler *DCRedirectionHandlerImpl) afterCall(
 		scope.IncCounter(metrics.CadenceDcRedirectionClientFailures)
 	}
 }
+
+// UpdateSchedule API call
+func (handler *DCRedirectionHandlerImpl) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) (retError error) {
+
+	var apiName = "UpdateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UpdateSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UpdateSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
@@ -45,6 +45,7 @@ const (
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
 	// 6. QueryWorkflow
+	// 7. DeleteWorkflowExecution
 	// please also reference selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 )
@@ -79,6 +80,22 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflow":                    {},
 	"ResetWorkflowExecution":           {},
+	"DeleteWorkflowExecution":          {},
+	// schedules of a domain are run by the scheduler of its active cluster
+	"CreateSchedule":   {},
+	"DescribeSchedule": {},
+	"UpdateSchedule":   {},
+	"PauseSchedule":    {},
+	"UnpauseSchedule":  {},
+	"TriggerSchedule":  {},
+	"BackfillSchedule": {},
+	"DeleteSchedule":   {},
+	"ListSchedules":    {},
+	// batch operations of a domain are run by the batcher of its active cluster
+	"StartBatchOperation":    {},
+	"DescribeBatchOperation": {},
+	"ListBatchOperations":    {},
+	"CancelBatchOperation":   {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
@@ -38,6 +38,71 @@ func newGrpcHandler(h Handler) grpcHandler {
 	return grpcHandler{h}
 }
 
+func (g grpcHandler) BackfillSchedule(ctx context.Context, request *apiv1.BackfillScheduleRequest) (*apiv1.BackfillScheduleResponse, error) {
+	err := g.h.BackfillSchedule(withGRPCTag(ctx), proto.ToBackfillScheduleRequest(request))
+	return &apiv1.BackfillScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) CreateSchedule(ctx context.Context, request *apiv1.CreateScheduleRequest) (*apiv1.CreateScheduleResponse, error) {
+	err := g.h.CreateSchedule(withGRPCTag(ctx), proto.ToCreateScheduleRequest(request))
+	return &apiv1.CreateScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) DeleteSchedule(ctx context.Context, request *apiv1.DeleteScheduleRequest) (*apiv1.DeleteScheduleResponse, error) {
+	err := g.h.DeleteSchedule(withGRPCTag(ctx), proto.ToDeleteScheduleRequest(request))
+	return &apiv1.DeleteScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) DescribeSchedule(ctx context.Context, request *apiv1.DescribeScheduleRequest) (*apiv1.DescribeScheduleResponse, error) {
+	response, err := g.h.DescribeSchedule(withGRPCTag(ctx), proto.ToDescribeScheduleRequest(request))
+	return proto.FromDescribeScheduleResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) ListSchedules(ctx context.Context, request *apiv1.ListSchedulesRequest) (*apiv1.ListSchedulesResponse, error) {
+	response, err := g.h.ListSchedules(withGRPCTag(ctx), proto.ToListSchedulesRequest(request))
+	return proto.FromListSchedulesResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) StartBatchOperation(ctx context.Context, request *apiv1.StartBatchOperationRequest) (*apiv1.StartBatchOperationResponse, error) {
+	response, err := g.h.StartBatchOperation(withGRPCTag(ctx), proto.ToStartBatchOperationRequest(request))
+	return proto.FromStartBatchOperationResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) DescribeBatchOperation(ctx context.Context, request *apiv1.DescribeBatchOperationRequest) (*apiv1.DescribeBatchOperationResponse, error) {
+	response, err := g.h.DescribeBatchOperation(withGRPCTag(ctx), proto.ToDescribeBatchOperationRequest(request))
+	return proto.FromDescribeBatchOperationResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) ListBatchOperations(ctx context.Context, request *apiv1.ListBatchOperationsRequest) (*apiv1.ListBatchOperationsResponse, error) {
+	response, err := g.h.ListBatchOperations(withGRPCTag(ctx), proto.ToListBatchOperationsRequest(request))
+	return proto.FromListBatchOperationsResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) CancelBatchOperation(ctx context.Context, request *apiv1.CancelBatchOperationRequest) (*apiv1.CancelBatchOperationResponse, error) {
+	err := g.h.CancelBatchOperation(withGRPCTag(ctx), proto.ToCancelBatchOperationRequest(request))
+	return &apiv1.CancelBatchOperationResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) PauseSchedule(ctx context.Context, request *apiv1.PauseScheduleRequest) (*apiv1.PauseScheduleResponse, error) {
+	err := g.h.PauseSchedule(withGRPCTag(ctx), proto.ToPauseScheduleRequest(request))
+	return &apiv1.PauseScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) TriggerSchedule(ctx context.Context, request *apiv1.TriggerScheduleRequest) (*apiv1.TriggerScheduleResponse, error) {
+	err := g.h.TriggerSchedule(withGRPCTag(ctx), proto.ToTriggerScheduleRequest(request))
+	return &apiv1.TriggerScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseSchedule(ctx context.Context, request *apiv1.UnpauseScheduleRequest) (*apiv1.UnpauseScheduleResponse, error) {
+	err := g.h.UnpauseSchedule(withGRPCTag(ctx), proto.ToUnpauseScheduleRequest(request))
+	return &apiv1.UnpauseScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UpdateSchedule(ctx context.Context, request *apiv1.UpdateScheduleRequest) (*apiv1.UpdateScheduleResponse, error) {
+	err := g.h.UpdateSchedule(withGRPCTag(ctx), proto.ToUpdateScheduleRequest(request))
+	return &apiv1.UpdateScheduleResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(apiv1.BuildDomainAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildWorkflowAPIYARPCProcedures(g))
@@ -56,6 +121,11 @@ func (g grpcHandler) CountWorkflowExecutions(ctx context.Context, request *apiv1
 	return proto.FromCountWorkflowExecutionsResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) DeleteWorkflowExecution(ctx context.Context, request *apiv1.DeleteWorkflowExecutionRequest) (*apiv1.DeleteWorkflowExecutionResponse, error) {
+	err := g.h.DeleteWorkflowExecution(withGRPCTag(ctx), proto.ToDeleteWorkflowExecutionRequest(request))
+	return &apiv1.DeleteWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) DeprecateDomain(ctx context.Context, request *apiv1.DeprecateDomainRequest) (*apiv1.DeprecateDomainResponse, error) {
 	err := g.h.DeprecateDomain(withGRPCTag(ctx), proto.ToDeprecateDomainRequest(request))
 	return &apiv1.DeprecateDomainResponse{}, proto.FromError(err)
@@ -54,6 +54,11 @@ type Config struct {
 	RPS                             dynamicconfig.IntPropertyFn
 	MaxDomainRPSPerInstance         dynamicconfig.IntPropertyFnWithDomainFilter
 	GlobalDomainRPS                 dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainBurst                     dynamicconfig.IntPropertyFnWithDomainFilter
+	GlobalDomainAPIRPS              dynamicconfig.MapPropertyFn
+	GlobalCallerRPS                 dynamicconfig.IntPropertyFnWithDomainFilter
+	GlobalSharedPoolRPS             dynamicconfig.IntPropertyFn
+	QuotaShareRefreshInterval       dynamicconfig.DurationPropertyFn
 	EnableClientVersionCheck        dynamicconfig.BoolPropertyFn
 	DisallowQuery                   dynamicconfig.BoolPropertyFnWithDomainFilter
 	ShutdownDrainDuration           dynamicconfig.DurationPropertyFn
@@ -125,6 +130,11 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		RPS:                                         dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
 		MaxDomainRPSPerInstance:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxDomainRPSPerInstance, 1200),
 		GlobalDomainRPS:                             dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainRPS, 0),
+		DomainBurst:                                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainBurst, 0),
+		GlobalDomainAPIRPS:                          dc.GetMapProperty(dynamicconfig.FrontendGlobalDomainAPIRPS, map[string]interface{}{}),
+		GlobalCallerRPS:                             dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalCallerRPS, 0),
+		GlobalSharedPoolRPS:                         dc.GetIntProperty(dynamicconfig.FrontendGlobalSharedPoolRPS, 0),
+		QuotaShareRefreshInterval:                   dc.GetDurationProperty(dynamicconfig.FrontendQuotaShareRefreshInterval, 10*time.Second),
 		MaxIDLengthWarnLimit:                        dc.GetIntProperty(dynamicconfig.MaxIDLengthWarnLimit, common.DefaultIDLengthWarnLimit),
 		DomainNameMaxLength:                         dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainNameMaxLength, common.DefaultIDLengthErrorLimit),
 		IdentityMaxLength:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.IdentityMaxLength, common.DefaultIDLengthErrorLimit),
@@ -56,12 +56,36 @@ func (t ThriftHandler) Health(ctx context.Context) (*health.HealthStatus, error)
 	return thrift.FromHealthStatus(response), thrift.FromError(err)
 }
 
+// BackfillSchedule forwards request to the underlying handler
+func (t ThriftHandler) BackfillSchedule(ctx context.Context, request *shared.BackfillScheduleRequest) error {
+	err := t.h.BackfillSchedule(withThriftTag(ctx), thrift.ToBackfillScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
 // CountWorkflowExecutions forwards request to the underlying handler
 func (t ThriftHandler) CountWorkflowExecutions(ctx context.Context, request *shared.CountWorkflowExecutionsRequest) (*shared.CountWorkflowExecutionsResponse, error) {
 	response, err := t.h.CountWorkflowExecutions(withThriftTag(ctx), thrift.ToCountWorkflowExecutionsRequest(request))
 	return thrift.FromCountWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+// CreateSchedule forwards request to the underlying handler
+func (t ThriftHandler) CreateSchedule(ctx context.Context, request *shared.CreateScheduleRequest) error {
+	err := t.h.CreateSchedule(withThriftTag(ctx), thrift.ToCreateScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// DeleteSchedule forwards request to the underlying handler
+func (t ThriftHandler) DeleteSchedule(ctx context.Context, request *shared.DeleteScheduleRequest) error {
+	err := t.h.DeleteSchedule(withThriftTag(ctx), thrift.ToDeleteScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// DeleteWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) DeleteWorkflowExecution(ctx context.Context, request *shared.DeleteWorkflowExecutionRequest) error {
+	err := t.h.DeleteWorkflowExecution(withThriftTag(ctx), thrift.ToDeleteWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
 // DeprecateDomain forwards request to the underlying handler
 func (t ThriftHandler) DeprecateDomain(ctx context.Context, request *shared.DeprecateDomainRequest) error {
 	err := t.h.DeprecateDomain(withThriftTag(ctx), thrift.ToDeprecateDomainRequest(request))
@@ -74,6 +98,12 @@ func (t ThriftHandler) DescribeDomain(ctx context.Context, request *shared.Descr
 	return thrift.FromDescribeDomainResponse(response), thrift.FromError(err)
 }
 
+// DescribeSchedule forwards request to the underlying handler
+func (t ThriftHandler) DescribeSchedule(ctx context.Context, request *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse, error) {
+	response, err := t.h.DescribeSchedule(withThriftTag(ctx), thrift.ToDescribeScheduleRequest(request))
+	return thrift.FromDescribeScheduleResponse(response), thrift.FromError(err)
+}
+
 // DescribeTaskList forwards request to the underlying handler
 func (t ThriftHandler) DescribeTaskList(ctx context.Context, request *shared.DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error) {
 	response, err := t.h.DescribeTaskList(withThriftTag(ctx), thrift.ToDescribeTaskListRequest(request))
@@ -128,6 +158,36 @@ func (t ThriftHandler) ListOpenWorkflowExecutions(ctx context.Context, request *
 	return thrift.FromListOpenWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+// ListSchedules forwards request to the underlying handler
+func (t ThriftHandler) ListSchedules(ctx context.Context, request *shared.ListSchedulesRequest) (*shared.ListSchedulesResponse, error) {
+	response, err := t.h.ListSchedules(withThriftTag(ctx), thrift.ToListSchedulesRequest(request))
+	return thrift.FromListSchedulesResponse(response), thrift.FromError(err)
+}
+
+// StartBatchOperation forwards request to the underlying handler
+func (t ThriftHandler) StartBatchOperation(ctx context.Context, request *shared.StartBatchOperationRequest) (*shared.StartBatchOperationResponse, error) {
+	response, err := t.h.StartBatchOperation(withThriftTag(ctx), thrift.ToStartBatchOperationRequest(request))
+	return thrift.FromStartBatchOperationResponse(response), thrift.FromError(err)
+}
+
+// DescribeBatchOperation forwards request to the underlying handler
+func (t ThriftHandler) DescribeBatchOperation(ctx context.Context, request *shared.DescribeBatchOperationRequest) (*shared.DescribeBatchOperationResponse, error) {
+	response, err := t.h.DescribeBatchOperation(withThriftTag(ctx), thrift.ToDescribeBatchOperationRequest(request))
+	return thrift.FromDescribeBatchOperationResponse(response), thrift.FromError(err)
+}
+
+// ListBatchOperations forwards request to the underlying handler
+func (t ThriftHandler) ListBatchOperations(ctx context.Context, request *shared.ListBatchOperationsRequest) (*shared.ListBatchOperationsResponse, error) {
+	response, err := t.h.ListBatchOperations(withThriftTag(ctx), thrift.ToListBatchOperationsRequest(request))
+	return thrift.FromListBatchOperationsResponse(response), thrift.FromError(err)
+}
+
+// CancelBatchOperation forwards request to the underlying handler
+func (t ThriftHandler) CancelBatchOperation(ctx context.Context, request *shared.CancelBatchOperationRequest) error {
+	err := t.h.CancelBatchOperation(withThriftTag(ctx), thrift.ToCancelBatchOperationRequest(request))
+	return thrift.FromError(err)
+}
+
 // ListTaskListPartitions forwards request to the underlying handler
 func (t ThriftHandler) ListTaskListPartitions(ctx context.Context, request *shared.ListTaskListPartitionsRequest) (*shared.ListTaskListPartitionsResponse, error) {
 	response, err := t.h.ListTaskListPartitions(withThriftTag(ctx), thrift.ToListTaskListPartitionsRequest(request))
@@ -146,6 +206,12 @@ func (t ThriftHandler) ListWorkflowExecutions(ctx context.Context, request *shar
 	return thrift.FromListWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+// PauseSchedule forwards request to the underlying handler
+func (t ThriftHandler) PauseSchedule(ctx context.Context, request *shared.PauseScheduleRequest) error {
+	err := t.h.PauseSchedule(withThriftTag(ctx), thrift.ToPauseScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
 // PollForActivityTask forwards request to the underlying handler
 func (t ThriftHandler) PollForActivityTask(ctx context.Context, request *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error) {
 	response, err := t.h.PollForActivityTask(withThriftTag(ctx), thrift.ToPollForActivityTaskRequest(request))
@@ -284,6 +350,18 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// TriggerSchedule forwards request to the underlying handler
+func (t ThriftHandler) TriggerSchedule(ctx context.Context, request *shared.TriggerScheduleRequest) error {
+	err := t.h.TriggerSchedule(withThriftTag(ctx), thrift.ToTriggerScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseSchedule forwards request to the underlying handler
+func (t ThriftHandler) UnpauseSchedule(ctx context.Context, request *shared.UnpauseScheduleRequest) error {
+	err := t.h.UnpauseSchedule(withThriftTag(ctx), thrift.ToUnpauseScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
 // UpdateDomain forwards request to the underlying handler
 func (t ThriftHandler) UpdateDomain(ctx context.Context, request *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	response, err := t.h.UpdateDomain(withThriftTag(ctx), thrift.ToUpdateDomainRequest(request))
@@ -293,3 +371,9 @@ func (t ThriftHandler) UpdateDomain(ctx context.Context, request *shared.UpdateD
 func withThriftTag(ctx context.Context) context.Context {
 	return metrics.TagContext(ctx, metrics.ThriftTransportTag())
 }
+
+// UpdateSchedule forwards request to the underlying handler
+func (t ThriftHandler) UpdateSchedule(ctx context.Context, request *shared.UpdateScheduleRequest) error {
+	err := t.h.UpdateSchedule(withThriftTag(ctx), thrift.ToUpdateScheduleRequest(request))
+	return thrift.FromError(err)
+}
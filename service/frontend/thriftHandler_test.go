@@ -59,6 +59,11 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("DeleteWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowExecution(taggedCtx, &types.DeleteWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowExecution(ctx, &shared.DeleteWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DeprecateDomain", func(t *testing.T) {
 		h.EXPECT().DeprecateDomain(taggedCtx, &types.DeprecateDomainRequest{}).Return(internalErr).Times(1)
 		err := th.DeprecateDomain(ctx, &shared.DeprecateDomainRequest{})
@@ -25,24 +25,29 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
+	cclient "go.uber.org/cadence/client"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -51,6 +56,8 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 const (
@@ -67,6 +74,18 @@ const (
 	HealthStatusShuttingDown
 )
 
+// API families used as keys of the frontend.globalDomainAPIRps quotas
+const (
+	apiFamilyStart      = "start"
+	apiFamilySignal     = "signal"
+	apiFamilyHistory    = "history"
+	apiFamilyControl    = "control"
+	apiFamilyVisibility = "visibility"
+	apiFamilyQuery      = "query"
+	apiFamilyDescribe   = "describe"
+	apiFamilyCluster    = "cluster"
+)
+
 var _ Handler = (*WorkflowHandler)(nil)
 
 type (
@@ -77,12 +96,15 @@ type (
 		shuttingDown              int32
 		healthStatus              int32
 		tokenSerializer           common.TaskTokenSerializer
-		rateLimiter               quotas.Policy
+		rateLimiter               quotas.ExplainedPolicy
+		quotaShare                quotas.GlobalShare
 		config                    *Config
 		versionChecker            client.VersionChecker
 		domainHandler             domain.Handler
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
+		scheduleClient            scheduler.Client
+		batchClient               batcher.Client
 	}
 
 	getHistoryContinuationToken struct {
@@ -99,6 +121,16 @@ type (
 		GetDomain() string
 	}
 
+	scheduleRequest interface {
+		domainGetter
+		GetScheduleID() string
+	}
+
+	batchOperationRequest interface {
+		domainGetter
+		GetJobID() string
+	}
+
 	// HealthStatus is an enum that refers to the rpc handler health status
 	HealthStatus int32
 )
@@ -131,6 +163,13 @@ var (
 	errEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
 	errEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
 	errShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
+	errScheduleIDNotSet                           = &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	errScheduleSpecNotSet                         = &types.BadRequestError{Message: "Spec is not set on request."}
+	errScheduleActionNotSet                       = &types.BadRequestError{Message: "Action is not set on request."}
+	errScheduleUpdateNotSet                       = &types.BadRequestError{Message: "Spec or Action must be set on request."}
+	errJobIDNotSet                                = &types.BadRequestError{Message: "JobID is not set on request."}
+	errBatchTypeNotSet                            = &types.BadRequestError{Message: "BatchType is not set on request."}
+	errBatchOperationNotExists                    = &types.EntityNotExistsError{Message: "Batch operation not found."}
 
 	// err for archival
 	errHistoryNotFound = &types.BadRequestError{Message: "Requested workflow history not found, may have passed retention period."}
@@ -154,27 +193,23 @@ func NewWorkflowHandler(
 	replicationMessageSink messaging.Producer,
 	versionChecker client.VersionChecker,
 ) *WorkflowHandler {
+	var quotaShare quotas.GlobalShare
+	if monitor := resource.GetMembershipMonitor(); monitor != nil {
+		quotaShare = membership.NewQuotaShare(
+			monitor,
+			common.FrontendServiceName,
+			func() time.Duration { return config.QuotaShareRefreshInterval() },
+			resource.GetLogger(),
+		)
+	}
 	return &WorkflowHandler{
 		Resource:        resource,
 		config:          config,
 		healthStatus:    int32(HealthStatusWarmingUp),
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		rateLimiter: quotas.NewMultiStageRateLimiter(
-			func() float64 {
-				return float64(config.RPS())
-			},
-			func(domain string) float64 {
-				if monitor := resource.GetMembershipMonitor(); monitor != nil && config.GlobalDomainRPS(domain) > 0 {
-					ringSize, err := monitor.GetMemberCount(common.FrontendServiceName)
-					if err == nil && ringSize > 0 {
-						avgQuota := common.MaxInt(config.GlobalDomainRPS(domain)/ringSize, 1)
-						return float64(common.MinInt(avgQuota, config.MaxDomainRPSPerInstance(domain)))
-					}
-				}
-				return float64(config.MaxDomainRPSPerInstance(domain))
-			},
-		),
-		versionChecker: versionChecker,
+		rateLimiter:     newRateLimiter(config, quotaShare),
+		quotaShare:      quotaShare,
+		versionChecker:  versionChecker,
 		domainHandler: domain.NewHandler(
 			config.domainConfig,
 			resource.GetLogger(),
@@ -193,6 +228,57 @@ func NewWorkflowHandler(
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
 		),
+		// schedules are run by workflows in the scheduler system domain
+		scheduleClient: scheduler.NewClient(
+			cclient.NewClient(resource.GetSDKClient(), common.SchedulerLocalDomainName, &cclient.Options{}),
+		),
+		// batch operations are run by workflows in the batcher system domain
+		batchClient: batcher.NewClient(
+			cclient.NewClient(resource.GetSDKClient(), common.BatcherLocalDomainName, &cclient.Options{}),
+		),
+	}
+}
+
+func newRateLimiter(config *Config, share quotas.GlobalShare) quotas.ExplainedPolicy {
+	// scale returns the part of a cluster-wide rps enforced by this host
+	scale := func(rps float64, key string) float64 {
+		if rps <= 0 || share == nil {
+			return rps
+		}
+		return math.Max(rps*share.Share(key), 1)
+	}
+	return quotas.NewHierarchicalRateLimiter(quotas.HierarchicalRateLimiterConfig{
+		Global: func(info quotas.Info) quotas.Quota {
+			return quotas.Quota{RPS: float64(config.RPS())}
+		},
+		Domain: func(info quotas.Info) quotas.Quota {
+			rps := float64(config.MaxDomainRPSPerInstance(info.Domain))
+			if global := config.GlobalDomainRPS(info.Domain); global > 0 && share != nil {
+				rps = math.Min(scale(float64(global), quotas.QuotaKey(quotas.LimitDomain, info)), rps)
+			}
+			return quotas.Quota{RPS: rps, Burst: config.DomainBurst(info.Domain)}
+		},
+		DomainAPI: func(info quotas.Info) quotas.Quota {
+			rps := domainAPIRPS(config.GlobalDomainAPIRPS(dynamicconfig.DomainFilter(info.Domain)), info.API)
+			return quotas.Quota{RPS: scale(rps, quotas.QuotaKey(quotas.LimitDomainAPI, info))}
+		},
+		Caller: func(info quotas.Info) quotas.Quota {
+			return quotas.Quota{RPS: scale(float64(config.GlobalCallerRPS(info.Domain)), quotas.QuotaKey(quotas.LimitCaller, info))}
+		},
+		SharedPool: func(info quotas.Info) quotas.Quota {
+			return quotas.Quota{RPS: scale(float64(config.GlobalSharedPoolRPS()), quotas.GlobalQuotaKey)}
+		},
+	})
+}
+
+func domainAPIRPS(limits map[string]interface{}, api string) float64 {
+	switch rps := limits[api].(type) {
+	case int:
+		return float64(rps)
+	case float64:
+		return rps
+	default:
+		return 0
 	}
 }
 
@@ -212,11 +298,17 @@ func (wh *WorkflowHandler) Start() {
 			wh.GetLogger().Warn(fmt.Sprintf("Warmup time has elapsed. Service status is: %v", status.String()))
 		}
 	}()
+	if wh.quotaShare != nil {
+		wh.quotaShare.Start()
+	}
 }
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
 	atomic.StoreInt32(&wh.shuttingDown, 1)
+	if wh.quotaShare != nil {
+		wh.quotaShare.Stop()
+	}
 }
 
 // UpdateHealthStatus sets the health status for this rpc handler.
@@ -1891,8 +1983,8 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(startRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyStart, startRequest); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
 	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
@@ -2043,8 +2135,8 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(getRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyHistory, getRequest); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2316,8 +2408,8 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(signalRequest); !ok {
-		return wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilySignal, signalRequest); err != nil {
+		return wh.error(err, scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2424,8 +2516,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(signalWithStartRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyStart, signalWithStartRequest); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
 	if signalWithStartRequest.GetWorkflowID() == "" {
@@ -2587,8 +2679,8 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(terminateRequest); !ok {
-		return wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyControl, terminateRequest); err != nil {
+		return wh.error(err, scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2611,6 +2703,61 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// DeleteWorkflowExecution terminates the workflow execution if it's still running and
+// deletes its mutable state, history and visibility records.
+func (wh *WorkflowHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	deleteRequest *types.DeleteWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDeleteWorkflowExecutionScope, deleteRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if deleteRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := deleteRequest.GetDomain()
+	wfExecution := deleteRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if deleteRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if err := wh.checkQuota(ctx, apiFamilyControl, deleteRequest); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	if err := validateExecution(wfExecution); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	err = wh.GetHistoryClient().DeleteWorkflowExecution(ctx, &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		DeleteRequest: deleteRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -2642,8 +2789,8 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(resetRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyControl, resetRequest); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2696,8 +2843,8 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(cancelRequest); !ok {
-		return wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyControl, cancelRequest); err != nil {
+		return wh.error(err, scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2746,8 +2893,8 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, listRequest); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if listRequest.StartTimeFilter == nil {
@@ -2863,8 +3010,8 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, listRequest); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if listRequest.GetPageSize() <= 0 {
@@ -2955,8 +3102,8 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, listRequest); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if listRequest.StartTimeFilter == nil {
@@ -3095,8 +3242,8 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, listRequest); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if listRequest.GetPageSize() <= 0 {
@@ -3163,8 +3310,8 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, listRequest); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if listRequest.GetPageSize() <= 0 {
@@ -3231,8 +3378,8 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(countRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, countRequest); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(countRequest.GetQuery())
@@ -3363,8 +3510,8 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(queryRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyQuery, queryRequest); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -3445,8 +3592,8 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+	if err := wh.checkQuota(ctx, apiFamilyDescribe, request); err != nil {
+		return nil, wh.error(err, scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -3498,8 +3645,8 @@ func (wh *WorkflowHandler) DescribeTaskList(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyDescribe, request); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
@@ -3548,8 +3695,8 @@ func (wh *WorkflowHandler) ListTaskListPartitions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyDescribe, request); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if err := wh.validateTaskList(request.TaskList, scope, request.GetDomain()); err != nil {
@@ -3585,8 +3732,8 @@ func (wh *WorkflowHandler) GetTaskListsByDomain(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyDescribe, request); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	resp, err := wh.GetMatchingClient().GetTaskListsByDomain(ctx, &types.GetTaskListsByDomainRequest{
@@ -3595,6 +3742,523 @@ func (wh *WorkflowHandler) GetTaskListsByDomain(
 	return resp, err
 }
 
+// CreateSchedule creates a schedule, which starts workflows in the domain at the times of its cron schedule
+func (wh *WorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendCreateScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyStart, request); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if request.Spec == nil {
+		return wh.error(errScheduleSpecNotSet, scope)
+	}
+
+	if request.Action == nil {
+		return wh.error(errScheduleActionNotSet, scope)
+	}
+
+	params := scheduler.ScheduleParams{
+		DomainName: request.GetDomain(),
+		ScheduleID: request.GetScheduleID(),
+		Spec:       toSchedulerSpec(request.Spec),
+		Action:     toSchedulerAction(request.Action),
+	}
+	if err := wh.scheduleClient.CreateSchedule(ctx, params); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeSchedule returns the spec, action and progress of a schedule
+func (wh *WorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (resp *types.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDescribeScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyDescribe, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	description, err := wh.scheduleClient.DescribeSchedule(ctx, request.GetDomain(), request.GetScheduleID())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return fromSchedulerDescription(description), nil
+}
+
+// UpdateSchedule replaces the spec and/or the action of a schedule
+func (wh *WorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUpdateScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyControl, request); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if request.Spec == nil && request.Action == nil {
+		return wh.error(errScheduleUpdateNotSet, scope)
+	}
+
+	var update scheduler.UpdateRequest
+	if request.Spec != nil {
+		spec := toSchedulerSpec(request.Spec)
+		update.Spec = &spec
+	}
+	if request.Action != nil {
+		action := toSchedulerAction(request.Action)
+		update.Action = &action
+	}
+	if err := wh.scheduleClient.UpdateSchedule(ctx, request.GetDomain(), request.GetScheduleID(), update); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// PauseSchedule stops a schedule from taking actions until it is unpaused
+func (wh *WorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendPauseScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyControl, request); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.scheduleClient.PauseSchedule(ctx, request.GetDomain(), request.GetScheduleID(), request.GetReason()); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// UnpauseSchedule resumes a paused schedule
+func (wh *WorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUnpauseScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyControl, request); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.scheduleClient.UnpauseSchedule(ctx, request.GetDomain(), request.GetScheduleID(), request.GetReason()); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// TriggerSchedule makes a schedule take an action immediately
+func (wh *WorkflowHandler) TriggerSchedule(
+	ctx context.Context,
+	request *types.TriggerScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendTriggerScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyControl, request); err != nil {
+		return wh.error(err, scope)
+	}
+
+	trigger := scheduler.TriggerRequest{
+		OverlapPolicy: toSchedulerOverlapPolicy(request.OverlapPolicy),
+	}
+	if err := wh.scheduleClient.TriggerSchedule(ctx, request.GetDomain(), request.GetScheduleID(), trigger); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// BackfillSchedule makes a schedule take the actions of a past time range
+func (wh *WorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendBackfillScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyControl, request); err != nil {
+		return wh.error(err, scope)
+	}
+
+	backfill := scheduler.BackfillRequest{
+		StartTime:     unixNanoToScheduleTime(request.StartTimeNano),
+		EndTime:       unixNanoToScheduleTime(request.EndTimeNano),
+		OverlapPolicy: toSchedulerOverlapPolicy(request.OverlapPolicy),
+	}
+	if err := wh.scheduleClient.BackfillSchedule(ctx, request.GetDomain(), request.GetScheduleID(), backfill); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// DeleteSchedule deletes a schedule, the workflows it started are not affected
+func (wh *WorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	request *types.DeleteScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDeleteScheduleScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, apiFamilyControl, request); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.scheduleClient.DeleteSchedule(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// ListSchedules returns the IDs of the schedules of a domain
+func (wh *WorkflowHandler) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (resp *types.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListSchedulesScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if _, err := wh.GetDomainCache().GetDomainID(domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = int32(wh.config.VisibilityMaxPageSize(domainName))
+	}
+	scheduleIDs, nextPageToken, err := wh.scheduleClient.ListSchedules(ctx, domainName, pageSize, request.NextPageToken)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &types.ListSchedulesResponse{
+		ScheduleIDs:   scheduleIDs,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// validateScheduleRequest checks the fields and the quota of a request on a schedule, and that the domain
+// owning the schedule exists, as schedules run in the scheduler system domain rather than in it
+func (wh *WorkflowHandler) validateScheduleRequest(ctx context.Context, apiFamily string, request scheduleRequest) error {
+	if request.GetDomain() == "" {
+		return errDomainNotSet
+	}
+	if request.GetScheduleID() == "" {
+		return errScheduleIDNotSet
+	}
+	if err := wh.checkQuota(ctx, apiFamily, request); err != nil {
+		return err
+	}
+	_, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	return err
+}
+
+// StartBatchOperation starts a batch operation on the workflows of a domain matching a visibility query
+func (wh *WorkflowHandler) StartBatchOperation(
+	ctx context.Context,
+	request *types.StartBatchOperationRequest,
+) (resp *types.StartBatchOperationResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendStartBatchOperationScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if request.GetQuery() == "" {
+		return nil, wh.error(errQueryNotSet, scope)
+	}
+
+	if request.BatchType == nil {
+		return nil, wh.error(errBatchTypeNotSet, scope)
+	}
+
+	if err := wh.checkQuota(ctx, apiFamilyStart, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if _, err := wh.GetDomainCache().GetDomainID(domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// the batch operation lists the workflows to process with the query, so it's validated upfront
+	if _, err := wh.visibilityQueryValidator.ValidateQuery(request.GetQuery()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	params, err := toBatchParams(request)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	jobID, err := wh.batchClient.StartBatchOperation(ctx, params, request.GetIdentity())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &types.StartBatchOperationResponse{JobID: jobID}, nil
+}
+
+// DescribeBatchOperation returns the status and the progress of a batch operation
+func (wh *WorkflowHandler) DescribeBatchOperation(
+	ctx context.Context,
+	request *types.DescribeBatchOperationRequest,
+) (resp *types.DescribeBatchOperationResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDescribeBatchOperationScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	info, err := wh.getBatchOperation(ctx, apiFamilyDescribe, request)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	resp = &types.DescribeBatchOperationResponse{
+		Info: fromBatcherOperationInfo(info),
+	}
+	if info.Progress != nil {
+		resp.Progress = &types.BatchOperationProgress{
+			TotalEstimate: info.Progress.TotalEstimate,
+			SuccessCount:  int64(info.Progress.SuccessCount),
+			ErrorCount:    int64(info.Progress.ErrorCount),
+		}
+	}
+	return resp, nil
+}
+
+// ListBatchOperations returns the batch operations of a domain
+func (wh *WorkflowHandler) ListBatchOperations(
+	ctx context.Context,
+	request *types.ListBatchOperationsRequest,
+) (resp *types.ListBatchOperationsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListBatchOperationsScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.checkQuota(ctx, apiFamilyVisibility, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if _, err := wh.GetDomainCache().GetDomainID(domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = int32(wh.config.VisibilityMaxPageSize(domainName))
+	}
+	operations, nextPageToken, err := wh.batchClient.ListBatchOperations(ctx, domainName, pageSize, request.NextPageToken)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	resp = &types.ListBatchOperationsResponse{
+		Operations:    make([]*types.BatchOperationInfo, 0, len(operations)),
+		NextPageToken: nextPageToken,
+	}
+	for _, operation := range operations {
+		resp.Operations = append(resp.Operations, fromBatcherOperationInfo(operation))
+	}
+	return resp, nil
+}
+
+// CancelBatchOperation requests cancellation of a running batch operation, workflows already processed are not reverted
+func (wh *WorkflowHandler) CancelBatchOperation(
+	ctx context.Context,
+	request *types.CancelBatchOperationRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendCancelBatchOperationScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if _, err := wh.getBatchOperation(ctx, apiFamilyControl, request); err != nil {
+		return wh.error(err, scope)
+	}
+	if err := wh.batchClient.CancelBatchOperation(ctx, request.GetJobID()); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// getBatchOperation checks the fields and the quota of a request on a batch operation, and returns the
+// batch operation if it belongs to the domain of the request. Batch operations of all the domains run in
+// the batcher system domain, so the ones of other domains are reported as not found.
+func (wh *WorkflowHandler) getBatchOperation(
+	ctx context.Context,
+	apiFamily string,
+	request batchOperationRequest,
+) (*batcher.BatchOperationInfo, error) {
+	if request.GetDomain() == "" {
+		return nil, errDomainNotSet
+	}
+	if request.GetJobID() == "" {
+		return nil, errJobIDNotSet
+	}
+	if err := wh.checkQuota(ctx, apiFamily, request); err != nil {
+		return nil, err
+	}
+	if _, err := wh.GetDomainCache().GetDomainID(request.GetDomain()); err != nil {
+		return nil, err
+	}
+	info, err := wh.batchClient.DescribeBatchOperation(ctx, request.GetJobID())
+	if err != nil {
+		return nil, err
+	}
+	if info.DomainName != request.GetDomain() {
+		return nil, errBatchOperationNotExists
+	}
+	return info, nil
+}
+
 func (wh *WorkflowHandler) getRawHistory(
 	ctx context.Context,
 	scope metrics.Scope,
@@ -4192,11 +4856,56 @@ func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, domain
 }
 
 func (wh *WorkflowHandler) allow(d domainGetter) bool {
-	domain := ""
+	info := quotas.Info{}
+	if d != nil {
+		info.Domain = d.GetDomain()
+	}
+	wh.recordDemand(info)
+	return wh.rateLimiter.Allow(info)
+}
+
+// recordDemand records a request against the quota keys it is charged against,
+// for the cluster-wide quotas to be shared between the hosts according to their demand
+func (wh *WorkflowHandler) recordDemand(info quotas.Info) {
+	if wh.quotaShare == nil {
+		return
+	}
+	if info.Domain == "" {
+		wh.quotaShare.Record(quotas.GlobalQuotaKey)
+		return
+	}
+	wh.quotaShare.Record(
+		quotas.GlobalQuotaKey,
+		quotas.QuotaKey(quotas.LimitDomain, info),
+		quotas.QuotaKey(quotas.LimitDomainAPI, info),
+		quotas.QuotaKey(quotas.LimitCaller, info),
+	)
+}
+
+// checkQuota charges the request against the quotas of its domain, API family and caller,
+// and returns a ServiceBusyError naming the limit hit if it is throttled
+func (wh *WorkflowHandler) checkQuota(ctx context.Context, api string, d domainGetter) error {
+	info := quotas.Info{API: api}
 	if d != nil {
-		domain = d.GetDomain()
+		info.Domain = d.GetDomain()
+	}
+	// the identity field of the requests is set by the clients, so callers are told apart
+	// by their verified client certificate, or otherwise by the name of their service
+	if info.Caller = authorization.GetTLSIdentity(ctx); info.Caller == "" {
+		info.Caller = yarpc.CallFromContext(ctx).Caller()
+	}
+	wh.recordDemand(info)
+
+	err := wh.rateLimiter.Check(info)
+	if err == nil {
+		return nil
+	}
+	if limitErr, ok := err.(*quotas.LimitExceededError); ok {
+		return &types.ServiceBusyError{
+			Message: fmt.Sprintf("Too many outstanding requests to the cadence service: %v", limitErr.Error()),
+		}
 	}
-	return wh.rateLimiter.Allow(quotas.Info{Domain: domain})
+	return createServiceBusyError()
 }
 
 // GetClusterInfo return information about cadence deployment
@@ -4206,8 +4915,8 @@ func (wh *WorkflowHandler) GetClusterInfo(
 	defer log.CapturePanic(wh.GetLogger(), &err)
 
 	scope := wh.getDefaultScope(ctx, metrics.FrontendClientGetClusterInfoScope)
-	if ok := wh.allow(nil); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.checkQuota(ctx, apiFamilyCluster, nil); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	return &types.ClusterInfo{
@@ -4301,3 +5010,208 @@ func (wh *WorkflowHandler) normalizeVersionedErrors(ctx context.Context, err err
 		return err
 	}
 }
+
+func toSchedulerSpec(spec *types.ScheduleSpec) scheduler.ScheduleSpec {
+	return scheduler.ScheduleSpec{
+		CronSchedule:  spec.GetCronSchedule(),
+		StartTime:     unixNanoToScheduleTime(spec.StartTimeNano),
+		EndTime:       unixNanoToScheduleTime(spec.EndTimeNano),
+		OverlapPolicy: toSchedulerOverlapPolicy(spec.OverlapPolicy),
+		CatchupWindow: common.SecondsToDuration(int64(spec.GetCatchupWindowSeconds())),
+	}
+}
+
+func fromSchedulerSpec(spec scheduler.ScheduleSpec) *types.ScheduleSpec {
+	return &types.ScheduleSpec{
+		CronSchedule:         spec.CronSchedule,
+		StartTimeNano:        scheduleTimeToUnixNano(spec.StartTime),
+		EndTimeNano:          scheduleTimeToUnixNano(spec.EndTime),
+		OverlapPolicy:        fromSchedulerOverlapPolicy(spec.OverlapPolicy),
+		CatchupWindowSeconds: common.Int32Ptr(int32(common.DurationToSeconds(spec.CatchupWindow))),
+	}
+}
+
+func toSchedulerAction(action *types.ScheduleStartWorkflowAction) scheduler.StartWorkflowAction {
+	return scheduler.StartWorkflowAction{
+		WorkflowType:                 action.GetWorkflowType().GetName(),
+		TaskList:                     action.GetTaskList().GetName(),
+		Input:                        action.GetInput(),
+		WorkflowIDPrefix:             action.GetWorkflowIDPrefix(),
+		ExecutionStartToCloseTimeout: common.SecondsToDuration(int64(action.GetExecutionStartToCloseTimeoutSeconds())),
+		TaskStartToCloseTimeout:      common.SecondsToDuration(int64(action.GetTaskStartToCloseTimeoutSeconds())),
+	}
+}
+
+func fromSchedulerAction(action scheduler.StartWorkflowAction) *types.ScheduleStartWorkflowAction {
+	return &types.ScheduleStartWorkflowAction{
+		WorkflowType:                        &types.WorkflowType{Name: action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: action.TaskList},
+		Input:                               action.Input,
+		WorkflowIDPrefix:                    action.WorkflowIDPrefix,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(common.DurationToSeconds(action.ExecutionStartToCloseTimeout))),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(common.DurationToSeconds(action.TaskStartToCloseTimeout))),
+	}
+}
+
+func fromSchedulerDescription(description *scheduler.Description) *types.DescribeScheduleResponse {
+	state := description.State
+	recentActions := make([]*types.ScheduleActionResult, 0, len(state.RecentActions))
+	for _, action := range state.RecentActions {
+		recentActions = append(recentActions, &types.ScheduleActionResult{
+			ScheduledTimeNano: scheduleTimeToUnixNano(action.ScheduledTime),
+			ActualTimeNano:    scheduleTimeToUnixNano(action.ActualTime),
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: action.WorkflowID,
+				RunID:      action.RunID,
+			},
+		})
+	}
+	nextActionTimes := make([]int64, 0, len(description.NextActionTimes))
+	for _, t := range description.NextActionTimes {
+		nextActionTimes = append(nextActionTimes, t.UnixNano())
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:   fromSchedulerSpec(description.Spec),
+		Action: fromSchedulerAction(description.Action),
+		State: &types.ScheduleState{
+			Paused:                state.Paused,
+			Notes:                 state.Notes,
+			LastProcessedTimeNano: scheduleTimeToUnixNano(state.LastProcessedTime),
+			RecentActions:         recentActions,
+			TotalActions:          state.TotalActions,
+			SkippedActions:        state.SkippedActions,
+			MissedCatchupWindow:   state.MissedCatchupWindow,
+			FailedActions:         state.FailedActions,
+		},
+		NextActionTimesNano: nextActionTimes,
+	}
+}
+
+// toSchedulerOverlapPolicy leaves an unknown policy to be rejected by the validation of the scheduler
+func toSchedulerOverlapPolicy(policy *types.ScheduleOverlapPolicy) string {
+	if policy == nil {
+		return ""
+	}
+	switch *policy {
+	case types.ScheduleOverlapPolicySkip:
+		return scheduler.OverlapPolicySkip
+	case types.ScheduleOverlapPolicyBuffer:
+		return scheduler.OverlapPolicyBuffer
+	case types.ScheduleOverlapPolicyCancelOther:
+		return scheduler.OverlapPolicyCancelOther
+	case types.ScheduleOverlapPolicyAllowAll:
+		return scheduler.OverlapPolicyAllowAll
+	}
+	return policy.String()
+}
+
+func fromSchedulerOverlapPolicy(policy string) *types.ScheduleOverlapPolicy {
+	switch policy {
+	case scheduler.OverlapPolicySkip:
+		return types.ScheduleOverlapPolicySkip.Ptr()
+	case scheduler.OverlapPolicyBuffer:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case scheduler.OverlapPolicyCancelOther:
+		return types.ScheduleOverlapPolicyCancelOther.Ptr()
+	case scheduler.OverlapPolicyAllowAll:
+		return types.ScheduleOverlapPolicyAllowAll.Ptr()
+	}
+	return nil
+}
+
+// the zero time stands for an unset time in schedules
+func unixNanoToScheduleTime(t *int64) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return time.Unix(0, *t)
+}
+
+func scheduleTimeToUnixNano(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	return common.Int64Ptr(t.UnixNano())
+}
+
+func toBatchParams(request *types.StartBatchOperationRequest) (batcher.BatchParams, error) {
+	params := batcher.BatchParams{
+		DomainName:  request.GetDomain(),
+		Query:       request.GetQuery(),
+		Reason:      request.GetReason(),
+		BatchType:   toBatcherBatchType(request.GetBatchType()),
+		DryRun:      request.GetDryRun(),
+		RPS:         int(request.GetRPS()),
+		Concurrency: int(request.GetConcurrency()),
+	}
+	switch request.GetBatchType() {
+	case types.BatchOperationTypeTerminate:
+		params.TerminateParams.TerminateChildren = common.BoolPtr(!request.GetSkipChildren())
+	case types.BatchOperationTypeCancel:
+		params.CancelParams.CancelChildren = common.BoolPtr(!request.GetSkipChildren())
+	case types.BatchOperationTypeSignal:
+		params.SignalParams = batcher.SignalParams{
+			SignalName: request.GetSignalName(),
+			Input:      string(request.GetSignalInput()),
+		}
+	case types.BatchOperationTypeReset:
+		resetParams := request.GetResetParams()
+		params.ResetParams = batcher.ResetParams{
+			ResetType:            resetParams.GetResetType(),
+			BadBinaryChecksum:    resetParams.GetBadBinaryChecksum(),
+			EarliestTime:         resetParams.GetEarliestTimeNano(),
+			SkipCurrentOpen:      resetParams.GetSkipCurrentOpen(),
+			SkipBaseIsNotCurrent: resetParams.GetSkipBaseIsNotCurrent(),
+			NonDeterministicOnly: resetParams.GetNonDeterministicOnly(),
+			SkipSignalReapply:    resetParams.GetSkipSignalReapply(),
+			ChildWorkflowPolicy:  resetParams.ChildWorkflowPolicy,
+			ReapplyEventTypes:    resetParams.GetReapplyEventTypes(),
+		}
+	case types.BatchOperationTypeUpsertSearchAttributes:
+		// the batch workflow takes decoded values, which it encodes again for each workflow
+		searchAttributes := make(map[string]interface{}, len(request.GetSearchAttributes().GetIndexedFields()))
+		for key, value := range request.GetSearchAttributes().GetIndexedFields() {
+			var decoded interface{}
+			if err := json.Unmarshal(value, &decoded); err != nil {
+				return batcher.BatchParams{}, &types.BadRequestError{
+					Message: fmt.Sprintf("Value of search attribute %v is not valid JSON: %v", key, err),
+				}
+			}
+			searchAttributes[key] = decoded
+		}
+		params.UpsertSearchAttributesParams.SearchAttributes = searchAttributes
+	}
+	return params, nil
+}
+
+func toBatcherBatchType(batchType types.BatchOperationType) string {
+	switch batchType {
+	case types.BatchOperationTypeTerminate:
+		return batcher.BatchTypeTerminate
+	case types.BatchOperationTypeCancel:
+		return batcher.BatchTypeCancel
+	case types.BatchOperationTypeSignal:
+		return batcher.BatchTypeSignal
+	case types.BatchOperationTypeReset:
+		return batcher.BatchTypeReset
+	case types.BatchOperationTypeDelete:
+		return batcher.BatchTypeDelete
+	case types.BatchOperationTypeUpsertSearchAttributes:
+		return batcher.BatchTypeUpsertSearchAttributes
+	}
+	return batchType.String()
+}
+
+func fromBatcherOperationInfo(info *batcher.BatchOperationInfo) *types.BatchOperationInfo {
+	result := &types.BatchOperationInfo{
+		JobID:         info.JobID,
+		CloseStatus:   info.CloseStatus,
+		Reason:        info.Reason,
+		Identity:      info.Operator,
+		StartTimeNano: common.Int64Ptr(info.StartTime.UnixNano()),
+	}
+	if info.CloseTime != nil {
+		result.CloseTimeNano = common.Int64Ptr(info.CloseTime.UnixNano())
+	}
+	return result
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/batcher"
 )
 
 const (
@@ -485,6 +486,140 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	s.Equal(errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_ScheduleIDNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain: s.testDomain,
+		Spec:   &types.ScheduleSpec{CronSchedule: "* * * * *"},
+		Action: &types.ScheduleStartWorkflowAction{},
+	})
+	s.Error(err)
+	s.Equal(errScheduleIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_SpecNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain:     s.testDomain,
+		ScheduleID: "test-schedule",
+		Action:     &types.ScheduleStartWorkflowAction{},
+	})
+	s.Error(err)
+	s.Equal(errScheduleSpecNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateSchedule_Failed_DomainNotExists() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", &types.EntityNotExistsError{Message: "domain not exists"})
+
+	err := wh.UpdateSchedule(context.Background(), &types.UpdateScheduleRequest{
+		Domain:     s.testDomain,
+		ScheduleID: "test-schedule",
+		Spec:       &types.ScheduleSpec{CronSchedule: "* * * * *"},
+	})
+	s.Error(err)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestStartBatchOperation_Failed_BatchTypeNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.StartBatchOperation(context.Background(), &types.StartBatchOperationRequest{
+		Domain: s.testDomain,
+		Query:  "WorkflowType = 'test-workflow-type'",
+		Reason: "test-reason",
+	})
+	s.Error(err)
+	s.Equal(errBatchTypeNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDescribeBatchOperation_Failed_JobIDNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.DescribeBatchOperation(context.Background(), &types.DescribeBatchOperationRequest{
+		Domain: s.testDomain,
+	})
+	s.Error(err)
+	s.Equal(errJobIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDescribeBatchOperation_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	mockBatchClient := batcher.NewMockClient(s.controller)
+	wh.batchClient = mockBatchClient
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	mockBatchClient.EXPECT().DescribeBatchOperation(gomock.Any(), "test-job").Return(&batcher.BatchOperationInfo{
+		JobID:      "test-job",
+		DomainName: s.testDomain,
+		Reason:     "test-reason",
+		Operator:   "test-operator",
+		StartTime:  time.Unix(0, 100),
+		Progress:   &batcher.HeartBeatDetails{TotalEstimate: 10, SuccessCount: 3, ErrorCount: 1},
+	}, nil)
+
+	resp, err := wh.DescribeBatchOperation(context.Background(), &types.DescribeBatchOperationRequest{
+		Domain: s.testDomain,
+		JobID:  "test-job",
+	})
+	s.NoError(err)
+	s.Equal("test-job", resp.Info.GetJobID())
+	s.Nil(resp.Info.CloseStatus)
+	s.Equal(&types.BatchOperationProgress{TotalEstimate: 10, SuccessCount: 3, ErrorCount: 1}, resp.Progress)
+}
+
+func (s *workflowHandlerSuite) TestDescribeBatchOperation_Failed_OtherDomain() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	mockBatchClient := batcher.NewMockClient(s.controller)
+	wh.batchClient = mockBatchClient
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	mockBatchClient.EXPECT().DescribeBatchOperation(gomock.Any(), "test-job").Return(&batcher.BatchOperationInfo{
+		JobID:      "test-job",
+		DomainName: "other-domain",
+	}, nil)
+
+	_, err := wh.DescribeBatchOperation(context.Background(), &types.DescribeBatchOperationRequest{
+		Domain: s.testDomain,
+		JobID:  "test-job",
+	})
+	s.Error(err)
+	s.Equal(errBatchOperationNotExists, err)
+}
+
+func (s *workflowHandlerSuite) TestCancelBatchOperation_Failed_OtherDomain() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	mockBatchClient := batcher.NewMockClient(s.controller)
+	wh.batchClient = mockBatchClient
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	mockBatchClient.EXPECT().DescribeBatchOperation(gomock.Any(), "test-job").Return(&batcher.BatchOperationInfo{
+		JobID:      "test-job",
+		DomainName: "other-domain",
+	}, nil)
+	mockBatchClient.EXPECT().CancelBatchOperation(gomock.Any(), gomock.Any()).Times(0)
+
+	err := wh.CancelBatchOperation(context.Background(), &types.CancelBatchOperationRequest{
+		Domain: s.testDomain,
+		JobID:  "test-job",
+	})
+	s.Error(err)
+	s.Equal(errBatchOperationNotExists, err)
+}
+
 func (s *workflowHandlerSuite) TestRegisterDomain_Failure_MissingDomainDataKey() {
 	dynamicClient := dc.NewInMemoryClient()
 	dynamicClient.UpdateValue(dc.RequiredDomainDataKeys, map[string]interface{}{"Tier": true})
@@ -1458,6 +1593,42 @@ func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	s.True(expectedMetrics["test.cadence_errors_bad_request"])
 }
 
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution_InvalidRequest() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	err := wh.DeleteWorkflowExecution(context.Background(), &types.DeleteWorkflowExecutionRequest{
+		Domain: s.testDomain,
+	})
+	s.Equal(errExecutionNotSet, err)
+
+	err = wh.DeleteWorkflowExecution(context.Background(), &types.DeleteWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{},
+	})
+	s.Equal(errWorkflowIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution_Success() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	request := &types.DeleteWorkflowExecutionRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "workflow-id",
+			RunID:      uuid.New(),
+		},
+		Identity: "identity",
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    s.testDomainID,
+		DeleteRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.DeleteWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
 func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 	config := NewConfig(
 		dc.NewCollection(
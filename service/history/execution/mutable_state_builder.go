@@ -1362,6 +1362,24 @@ func (e *mutableStateBuilder) GetUserTimerInfoByEventID(
 	return e.GetUserTimerInfo(timerID)
 }
 
+// UpdateChildExecution updates the child execution in progress.
+func (e *mutableStateBuilder) UpdateChildExecution(
+	ci *persistence.ChildExecutionInfo,
+) error {
+
+	if _, ok := e.pendingChildExecutionInfoIDs[ci.InitiatedID]; !ok {
+		e.logError(
+			fmt.Sprintf("unable to find child workflow event ID: %v in mutable state", ci.InitiatedID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingChildWorkflowInfo
+	}
+
+	e.pendingChildExecutionInfoIDs[ci.InitiatedID] = ci
+	e.updateChildExecutionInfos[ci.InitiatedID] = ci
+	return nil
+}
+
 // UpdateUserTimer updates the user timer in progress.
 func (e *mutableStateBuilder) UpdateUserTimer(
 	ti *persistence.TimerInfo,
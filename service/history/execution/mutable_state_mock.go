@@ -2323,6 +2323,20 @@ func (mr *MockMutableStateMockRecorder) UpdateActivityProgress(ai, request inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityProgress", reflect.TypeOf((*MockMutableState)(nil).UpdateActivityProgress), ai, request)
 }
 
+// UpdateChildExecution mocks base method
+func (m *MockMutableState) UpdateChildExecution(arg0 *persistence.ChildExecutionInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChildExecution", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChildExecution indicates an expected call of UpdateChildExecution
+func (mr *MockMutableStateMockRecorder) UpdateChildExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChildExecution", reflect.TypeOf((*MockMutableState)(nil).UpdateChildExecution), arg0)
+}
+
 // UpdateDecision mocks base method
 func (m *MockMutableState) UpdateDecision(arg0 *DecisionInfo) {
 	m.ctrl.T.Helper()
@@ -78,6 +78,9 @@ type (
 		) error
 		GenerateWorkflowSearchAttrTasks() error
 		GenerateWorkflowResetTasks() error
+		GenerateWorkflowDeletionTasks(
+			now time.Time,
+		) error
 		GenerateCrossClusterTaskFromTransferTask(
 			transferTask *persistence.TransferTaskInfo,
 			targetCluster string,
@@ -558,6 +561,28 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowResetTasks() error {
 	return nil
 }
 
+func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowDeletionTasks(
+	now time.Time,
+) error {
+
+	// the task is verified against the last write version, which for a closed
+	// workflow can be older than the current version of its domain
+	lastWriteVersion, err := r.mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+
+	// being a timer task, its taskID is allocated after the transfer tasks of the
+	// same transaction, including the close execution task of a terminated run
+	r.mutableState.AddTimerTasks(&persistence.DeleteWorkflowExecutionTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: now,
+		Version:             lastWriteVersion,
+	})
+
+	return nil
+}
+
 func (r *mutableStateTaskGeneratorImpl) GenerateCrossClusterApplyParentClosePolicyTask(
 	task *persistence.TransferTaskInfo,
 	targetCluster string,
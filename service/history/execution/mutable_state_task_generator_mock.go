@@ -241,6 +241,20 @@ func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowResetTasks(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowResetTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowResetTasks))
 }
 
+// GenerateWorkflowDeletionTasks mocks base method
+func (m *MockMutableStateTaskGenerator) GenerateWorkflowDeletionTasks(now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWorkflowDeletionTasks", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateWorkflowDeletionTasks indicates an expected call of GenerateWorkflowDeletionTasks
+func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowDeletionTasks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowDeletionTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowDeletionTasks), now)
+}
+
 // GenerateCrossClusterTaskFromTransferTask mocks base method
 func (m *MockMutableStateTaskGenerator) GenerateCrossClusterTaskFromTransferTask(transferTask *persistence.TransferTaskInfo, targetCluster string) error {
 	m.ctrl.T.Helper()
@@ -259,6 +259,16 @@ func (g grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) DeleteWorkflowExecution(ctx context.Context, request *historyv1.DeleteWorkflowExecutionRequest) (*historyv1.DeleteWorkflowExecutionResponse, error) {
+	err := g.h.DeleteWorkflowExecution(withGRPCTag(ctx), proto.ToHistoryDeleteWorkflowExecutionRequest(request))
+	return &historyv1.DeleteWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UpsertWorkflowSearchAttributes(ctx context.Context, request *historyv1.UpsertWorkflowSearchAttributesRequest) (*historyv1.UpsertWorkflowSearchAttributesResponse, error) {
+	err := g.h.UpsertWorkflowSearchAttributes(withGRPCTag(ctx), proto.ToHistoryUpsertWorkflowSearchAttributesRequest(request))
+	return &historyv1.UpsertWorkflowSearchAttributesResponse{}, proto.FromError(err)
+}
+
 func withGRPCTag(ctx context.Context) context.Context {
 	return metrics.TagContext(ctx, metrics.GPRCTransportTag())
 }
@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -62,6 +63,7 @@ type (
 		PrepareToStop(time.Duration) time.Duration
 		Health(context.Context) (*types.HealthStatus, error)
 		CloseShard(context.Context, *types.CloseShardRequest) error
+		DeleteWorkflowExecution(context.Context, *types.HistoryDeleteWorkflowExecutionRequest) error
 		DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error)
 		DescribeMutableState(context.Context, *types.DescribeMutableStateRequest) (*types.DescribeMutableStateResponse, error)
 		DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
@@ -102,6 +104,7 @@ type (
 		SyncActivity(context.Context, *types.SyncActivityRequest) error
 		SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 		TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+		UpsertWorkflowSearchAttributes(context.Context, *types.UpsertWorkflowSearchAttributesRequest) error
 		GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	}
 
@@ -118,7 +121,9 @@ type (
 		rateLimiter             quotas.Limiter
 		replicationTaskFetchers replication.TaskFetchers
 		queueTaskProcessor      task.Processor
-		failoverCoordinator     failover.Coordinator
+		// replicationTaskScheduler is only set with the hierarchical fair replication task scheduler
+		replicationTaskScheduler ctask.Scheduler
+		failoverCoordinator      failover.Coordinator
 	}
 )
 
@@ -191,6 +196,18 @@ func (h *handlerImpl) Start() {
 	}
 	h.queueTaskProcessor.Start()
 
+	if ctask.SchedulerType(h.config.ReplicationTaskProcessorSchedulerType()) == ctask.SchedulerTypeHierarchicalFair {
+		h.replicationTaskScheduler, err = replication.NewTaskScheduler(
+			h.config,
+			h.GetLogger(),
+			h.GetMetricsClient(),
+		)
+		if err != nil {
+			h.GetLogger().Fatal("Creating replication task scheduler failed", tag.Error(err))
+		}
+		h.replicationTaskScheduler.Start()
+	}
+
 	h.controller = shard.NewShardController(
 		h.Resource,
 		h,
@@ -223,6 +240,9 @@ func (h *handlerImpl) Stop() {
 	h.prepareToShutDown()
 	h.replicationTaskFetchers.Stop()
 	h.queueTaskProcessor.Stop()
+	if h.replicationTaskScheduler != nil {
+		h.replicationTaskScheduler.Stop()
+	}
 	h.controller.Stop()
 	h.historyEventNotifier.Stop()
 	h.failoverCoordinator.Stop()
@@ -261,6 +281,7 @@ func (h *handlerImpl) CreateEngine(
 		h.replicationTaskFetchers,
 		h.GetMatchingRawClient(),
 		h.queueTaskProcessor,
+		h.replicationTaskScheduler,
 		h.failoverCoordinator,
 	)
 }
@@ -1214,6 +1235,86 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	return nil
 }
 
+// DeleteWorkflowExecution terminates the workflow execution if it's running and
+// deletes its mutable state, history and visibility records
+func (h *handlerImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryDeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDeleteWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.DeleteRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UpsertWorkflowSearchAttributes merges search attributes into a running workflow execution
+func (h *handlerImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *types.UpsertWorkflowSearchAttributesRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpsertWorkflowSearchAttributesScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetWorkflowExecution().GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UpsertWorkflowSearchAttributes(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *handlerImpl) ResetWorkflowExecution(
@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/elasticsearch/validator"
 	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -48,6 +49,7 @@ import (
 	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/decision"
@@ -76,6 +78,8 @@ const (
 	TerminateIfRunningReason = "TerminateIfRunning Policy"
 	// TerminateIfRunningDetailsTemplate details template for terminateIfRunning
 	TerminateIfRunningDetailsTemplate = "New runID: %s"
+	// DeleteWorkflowExecutionReason reason for terminating a running workflow before deleting it
+	DeleteWorkflowExecutionReason = "DeleteWorkflowExecution"
 )
 
 var (
@@ -119,6 +123,7 @@ type (
 		clientChecker             client.VersionChecker
 		replicationDLQHandler     replication.DLQHandler
 		failoverMarkerNotifier    failover.MarkerNotifier
+		searchAttributesValidator *validator.SearchAttributesValidator
 	}
 )
 
@@ -146,6 +151,7 @@ func NewEngineWithShardContext(
 	replicationTaskFetchers replication.TaskFetchers,
 	rawMatchingClient matching.Client,
 	queueTaskProcessor task.Processor,
+	replicationTaskScheduler ctask.Scheduler,
 	failoverCoordinator failover.Coordinator,
 ) engine.Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
@@ -193,6 +199,13 @@ func NewEngineWithShardContext(
 			shard,
 			executionCache,
 		),
+		searchAttributesValidator: validator.NewSearchAttributesValidator(
+			logger,
+			config.ValidSearchAttributes,
+			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesSizeOfValueLimit,
+			config.SearchAttributesTotalSizeLimit,
+		),
 	}
 	historyEngImpl.decisionHandler = decision.NewHandler(
 		shard,
@@ -297,6 +310,7 @@ func NewEngineWithShardContext(
 			shard.GetMetricsClient(),
 			replicationTaskFetcher,
 			replicationTaskExecutor,
+			replicationTaskScheduler,
 		)
 		replicationTaskProcessors = append(replicationTaskProcessors, replicationTaskProcessor)
 	}
@@ -2425,6 +2439,155 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// UpsertWorkflowSearchAttributes merges search attributes into a running workflow execution.
+// Unlike the upsert decision no history event is written, so workflow code doesn't need to handle it,
+// but the change isn't replicated to other clusters either.
+func (e *historyEngineImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *types.UpsertWorkflowSearchAttributesRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	if len(request.GetSearchAttributes().GetIndexedFields()) == 0 {
+		return &types.BadRequestError{Message: "SearchAttributes is not set on request."}
+	}
+	if err := e.searchAttributesValidator.ValidateSearchAttributes(
+		request.SearchAttributes,
+		domainEntry.GetInfo().Name,
+	); err != nil {
+		return err
+	}
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			executionInfo := mutableState.GetExecutionInfo()
+			if executionInfo.SearchAttributes == nil {
+				executionInfo.SearchAttributes = make(map[string][]byte)
+			}
+			for key, value := range request.SearchAttributes.GetIndexedFields() {
+				executionInfo.SearchAttributes[key] = value
+			}
+
+			return workflow.UpdateWithoutDecision, execution.NewMutableStateTaskGenerator(
+				e.shard.GetClusterMetadata(),
+				e.shard.GetDomainCache(),
+				e.logger,
+				mutableState,
+			).GenerateWorkflowSearchAttrTasks()
+		})
+}
+
+// DeleteWorkflowExecution terminates the workflow execution if it's still running and
+// schedules a timer task which deletes its mutable state, history and visibility records
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	deleteRequest *types.HistoryDeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(deleteRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := deleteRequest.DeleteRequest
+	workflowContext, err := workflow.Load(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		request.WorkflowExecution.GetWorkflowID(),
+		request.WorkflowExecution.GetRunID(),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { workflowContext.GetReleaseFn()(retError) }()
+
+	for attempt := 0; attempt < workflow.ConditionalRetryCount; attempt++ {
+		mutableState := workflowContext.GetMutableState()
+		updateMode := persistence.UpdateWorkflowModeUpdateCurrent
+		transactionPolicy := execution.TransactionPolicyActive
+
+		if mutableState.IsWorkflowExecutionRunning() {
+			eventBatchFirstEventID := mutableState.GetNextEventID()
+			if err := execution.TerminateWorkflow(
+				mutableState,
+				eventBatchFirstEventID,
+				DeleteWorkflowExecutionReason,
+				nil,
+				request.GetIdentity(),
+			); err != nil {
+				return err
+			}
+		} else {
+			// no event is written for a closed run, and it may have been closed
+			// by another cluster, so the update is not checked for being active
+			transactionPolicy = execution.TransactionPolicyPassive
+			resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+				DomainID:   domainID,
+				WorkflowID: workflowContext.GetWorkflowID(),
+			})
+			if err != nil {
+				return err
+			}
+			if resp.RunID != workflowContext.GetRunID() {
+				updateMode = persistence.UpdateWorkflowModeBypassCurrent
+			}
+		}
+
+		taskGenerator := execution.NewMutableStateTaskGenerator(
+			e.shard.GetClusterMetadata(),
+			e.shard.GetDomainCache(),
+			e.logger,
+			mutableState,
+		)
+		if err := taskGenerator.GenerateWorkflowDeletionTasks(e.timeSource.Now()); err != nil {
+			return err
+		}
+
+		err = workflowContext.GetContext().UpdateWorkflowExecutionWithNew(
+			ctx,
+			e.timeSource.Now(),
+			updateMode,
+			nil,
+			nil,
+			transactionPolicy,
+			nil,
+		)
+		if err == execution.ErrConflict {
+			if attempt != workflow.ConditionalRetryCount-1 {
+				if _, err := workflowContext.ReloadMutableState(ctx); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		return err
+	}
+	return workflow.ErrMaxAttemptsExceeded
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx context.Context,
@@ -2442,7 +2605,7 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(
 		RunID:      completionRequest.WorkflowExecution.RunID,
 	}
 
-	return workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
+	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return workflow.ErrNotExists
@@ -2450,7 +2613,6 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(
 
 			initiatedID := completionRequest.InitiatedID
 			completedExecution := completionRequest.CompletedExecution
-			completionEvent := completionRequest.CompletionEvent
 
 			// Check mutable state to make sure child execution is in pending child executions
 			ci, isRunning := mutableState.GetChildExecutionInfo(initiatedID)
@@ -2461,28 +2623,80 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(
 				return &types.EntityNotExistsError{Message: "Pending child execution not found."}
 			}
 
-			switch *completionEvent.EventType {
-			case types.EventTypeWorkflowExecutionCompleted:
-				attributes := completionEvent.WorkflowExecutionCompletedEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionCompletedEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionFailed:
-				attributes := completionEvent.WorkflowExecutionFailedEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionFailedEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionCanceled:
-				attributes := completionEvent.WorkflowExecutionCanceledEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionCanceledEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionTerminated:
-				attributes := completionEvent.WorkflowExecutionTerminatedEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionTerminatedEvent(initiatedID, completedExecution, attributes)
-			case types.EventTypeWorkflowExecutionTimedOut:
-				attributes := completionEvent.WorkflowExecutionTimedOutEventAttributes
-				_, err = mutableState.AddChildWorkflowExecutionTimedOutEvent(initiatedID, completedExecution, attributes)
+			return addChildExecutionCompletionEvent(mutableState, initiatedID, completedExecution, completionRequest.CompletionEvent)
+		})
+	if _, ok := err.(*types.EntityNotExistsError); ok && workflowExecution.RunID != "" {
+		// the parent may have been reset with its child workflows reattached to the reset run
+		return e.recordChildExecutionCompletedToCurrentRun(ctx, domainID, completionRequest)
+	}
+	return err
+}
+
+// recordChildExecutionCompletedToCurrentRun records the completion of a child workflow
+// reattached to the current run of its parent by a reset
+func (e *historyEngineImpl) recordChildExecutionCompletedToCurrentRun(
+	ctx context.Context,
+	domainID string,
+	completionRequest *types.RecordChildExecutionCompletedRequest,
+) error {
+
+	parentRunID := completionRequest.WorkflowExecution.RunID
+	parentExecution := types.WorkflowExecution{
+		WorkflowID: completionRequest.WorkflowExecution.WorkflowID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, parentExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() || mutableState.GetExecutionInfo().RunID == parentRunID {
+				return nil, workflow.ErrNotExists
 			}
 
-			return err
+			initiatedID := completionRequest.InitiatedID
+			completedExecution := completionRequest.CompletedExecution
+
+			// the run ID of the child identifies the reattached child, the initiated ID is kept by reset
+			ci, isRunning := mutableState.GetChildExecutionInfo(initiatedID)
+			if !isRunning || ci.StartedID == common.EmptyEventID ||
+				ci.StartedWorkflowID != completedExecution.GetWorkflowID() ||
+				ci.StartedRunID != completedExecution.GetRunID() {
+				return nil, &types.EntityNotExistsError{Message: "Pending child execution not found."}
+			}
+
+			if err := addChildExecutionCompletionEvent(mutableState, initiatedID, completedExecution, completionRequest.CompletionEvent); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithNewDecision, nil
 		})
 }
 
+func addChildExecutionCompletionEvent(
+	mutableState execution.MutableState,
+	initiatedID int64,
+	completedExecution *types.WorkflowExecution,
+	completionEvent *types.HistoryEvent,
+) error {
+
+	var err error
+	switch *completionEvent.EventType {
+	case types.EventTypeWorkflowExecutionCompleted:
+		attributes := completionEvent.WorkflowExecutionCompletedEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionCompletedEvent(initiatedID, completedExecution, attributes)
+	case types.EventTypeWorkflowExecutionFailed:
+		attributes := completionEvent.WorkflowExecutionFailedEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionFailedEvent(initiatedID, completedExecution, attributes)
+	case types.EventTypeWorkflowExecutionCanceled:
+		attributes := completionEvent.WorkflowExecutionCanceledEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionCanceledEvent(initiatedID, completedExecution, attributes)
+	case types.EventTypeWorkflowExecutionTerminated:
+		attributes := completionEvent.WorkflowExecutionTerminatedEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionTerminatedEvent(initiatedID, completedExecution, attributes)
+	case types.EventTypeWorkflowExecutionTimedOut:
+		attributes := completionEvent.WorkflowExecutionTimedOutEventAttributes
+		_, err = mutableState.AddChildWorkflowExecutionTimedOutEvent(initiatedID, completedExecution, attributes)
+	}
+	return err
+}
+
 func (e *historyEngineImpl) ReplicateEventsV2(
 	ctx context.Context,
 	replicateRequest *types.ReplicateEventsV2Request,
@@ -2646,7 +2860,11 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		),
 		request.GetReason(),
 		nil,
-		request.GetSkipSignalReapply(),
+		reset.ResetOptions{
+			SkipSignalReapply:   request.GetSkipSignalReapply(),
+			ReapplyEventTypes:   request.GetReapplyEventTypes(),
+			ChildWorkflowPolicy: request.GetChildWorkflowPolicy(),
+		},
 	); err != nil {
 		return nil, err
 	}
@@ -3154,7 +3372,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 					),
 					ndc.EventsReapplicationResetWorkflowReason,
 					toReapplyEvents,
-					false,
+					reset.ResetOptions{},
 				); err != nil {
 					return nil, err
 				}
@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -5235,6 +5236,163 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.NoError(err)
 }
 
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.Mode == p.UpdateWorkflowModeUpdateCurrent &&
+			containsTaskType(request.UpdateWorkflowMutation.TransferTasks, p.TransferTaskTypeCloseExecution) &&
+			containsTaskType(request.UpdateWorkflowMutation.TimerTasks, p.TaskTypeDeleteWorkflowExecution)
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		DeleteRequest: &types.DeleteWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.GetExecutionInfo().State)
+	s.Equal(persistence.WorkflowCloseStatusTerminated, executionBuilder.GetExecutionInfo().CloseStatus)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_ClosedNotCurrent() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.EventID, nil, identity)
+	test.AddCompleteWorkflowEvent(msBuilder, decisionCompletedEvent.EventID, nil)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(&p.GetCurrentExecutionResponse{
+		RunID: "anotherRunID",
+	}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.Mode == p.UpdateWorkflowModeBypassCurrent &&
+			len(request.UpdateWorkflowMutation.TransferTasks) == 0 &&
+			containsTaskType(request.UpdateWorkflowMutation.TimerTasks, p.TaskTypeDeleteWorkflowExecution)
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		DeleteRequest: &types.DeleteWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUpsertWorkflowSearchAttributes() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	s.mockHistoryEngine.searchAttributesValidator = s.newSearchAttributesValidator()
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	// no history event is written
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return len(request.UpdateWorkflowMutation.ExecutionInfo.SearchAttributes) == 1 &&
+			containsTaskType(request.UpdateWorkflowMutation.TransferTasks, p.TransferTaskTypeUpsertWorkflowSearchAttributes)
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpsertWorkflowSearchAttributes(context.Background(), &types.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID:        constants.TestDomainID,
+		WorkflowExecution: &we,
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal([]byte(`"value"`), executionBuilder.GetExecutionInfo().SearchAttributes["CustomKeywordField"])
+	s.Equal(int64(3), executionBuilder.GetNextEventID())
+}
+
+func (s *engineSuite) TestUpsertWorkflowSearchAttributes_InvalidKey() {
+
+	s.mockHistoryEngine.searchAttributesValidator = s.newSearchAttributesValidator()
+
+	err := s.mockHistoryEngine.UpsertWorkflowSearchAttributes(context.Background(), &types.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID: constants.TestDomainID,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "wId",
+			RunID:      constants.TestRunID,
+		},
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: map[string][]byte{"UnknownKey": []byte(`"value"`)},
+		},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *engineSuite) newSearchAttributesValidator() *validator.SearchAttributesValidator {
+	return validator.NewSearchAttributesValidator(
+		s.mockHistoryEngine.logger,
+		s.mockHistoryEngine.config.ValidSearchAttributes,
+		s.mockHistoryEngine.config.SearchAttributesNumberOfKeysLimit,
+		s.mockHistoryEngine.config.SearchAttributesSizeOfValueLimit,
+		s.mockHistoryEngine.config.SearchAttributesTotalSizeLimit,
+	)
+}
+
 func (s *engineSuite) getBuilder(testDomainID string, we types.WorkflowExecution) execution.MutableState {
 	context, release, err := s.mockHistoryEngine.executionCache.GetOrCreateWorkflowExecutionForBackground(testDomainID, we)
 	if err != nil {
@@ -5256,3 +5414,12 @@ func (s *engineSuite) getActivityScheduledEvent(
 func (s *engineSuite) printHistory(builder execution.MutableState) string {
 	return thrift.FromHistory(builder.GetHistoryBuilder().GetHistory()).String()
 }
+
+func containsTaskType(tasks []p.Task, taskType int) bool {
+	for _, task := range tasks {
+		if task.GetType() == taskType {
+			return true
+		}
+	}
+	return false
+}
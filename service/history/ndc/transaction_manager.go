@@ -356,7 +356,7 @@ func (r *transactionManagerImpl) backfillWorkflowEventsReapply(
 			targetWorkflow,
 			EventsReapplicationResetWorkflowReason,
 			targetWorkflowEvents.Events,
-			false,
+			reset.ResetOptions{},
 		); err != nil {
 			return 0, execution.TransactionPolicyActive, err
 		}
@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/reconciliation"
 	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -82,6 +83,7 @@ type (
 		metricsClient     metrics.Client
 		logger            log.Logger
 		taskExecutor      TaskExecutor
+		taskScheduler     task.Scheduler
 		hostRateLimiter   *quotas.DynamicRateLimiter
 		shardRateLimiter  *quotas.DynamicRateLimiter
 
@@ -106,6 +108,7 @@ type (
 var _ TaskProcessor = (*taskProcessorImpl)(nil)
 
 // NewTaskProcessor creates a new replication task processor.
+// The task scheduler is optional, without it the tasks are applied sequentially.
 func NewTaskProcessor(
 	shard shard.Context,
 	historyEngine engine.Engine,
@@ -113,6 +116,7 @@ func NewTaskProcessor(
 	metricsClient metrics.Client,
 	taskFetcher TaskFetcher,
 	taskExecutor TaskExecutor,
+	taskScheduler task.Scheduler,
 ) TaskProcessor {
 	shardID := shard.GetShardID()
 	firstRetryPolicy := backoff.NewExponentialRetryPolicy(config.ReplicationTaskProcessorErrorRetryWait(shardID))
@@ -140,6 +144,7 @@ func NewTaskProcessor(
 		metricsClient:     metricsClient,
 		logger:            shard.GetLogger(),
 		taskExecutor:      taskExecutor,
+		taskScheduler:     taskScheduler,
 		hostRateLimiter:   taskFetcher.GetRateLimiter(),
 		shardRateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
 			return config.ReplicationTaskProcessorShardQPS()
@@ -309,15 +314,22 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	scope := p.metricsClient.Scope(metrics.ReplicationTaskFetcherScope, metrics.TargetClusterTag(p.sourceCluster))
 	batchRequestStartTime := time.Now()
 	ctx := context.Background()
-	for _, replicationTask := range response.ReplicationTasks {
-		// TODO: move to MultiStageRateLimiter
-		_ = p.hostRateLimiter.Wait(ctx)
-		_ = p.shardRateLimiter.Wait(ctx)
-		err := p.processSingleTask(replicationTask)
-		if err != nil {
+	if p.taskScheduler != nil {
+		if err := p.scheduleTasks(ctx, response.ReplicationTasks); err != nil {
 			// Encounter error and skip updating ack levels
 			return
 		}
+	} else {
+		for _, replicationTask := range response.ReplicationTasks {
+			// TODO: move to MultiStageRateLimiter
+			_ = p.hostRateLimiter.Wait(ctx)
+			_ = p.shardRateLimiter.Wait(ctx)
+			err := p.processSingleTask(replicationTask)
+			if err != nil {
+				// Encounter error and skip updating ack levels
+				return
+			}
+		}
 	}
 
 	// Note here we check replication tasks instead of hasMore. The expectation is that in a steady state
@@ -336,6 +348,72 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	p.noTaskRetrier.Reset()
 }
 
+// scheduleTasks applies the replication tasks through the host level task scheduler. The tasks of a domain are
+// submitted one at a time in their order, so the tasks of a domain, e.g. a failover marker and the history
+// replicated before it, keep their order, while the tasks of different domains are applied concurrently
+// and fairly across the shards of the host. It returns an error if any of the tasks was not applied.
+func (p *taskProcessorImpl) scheduleTasks(
+	ctx context.Context,
+	replicationTasks []*types.ReplicationTask,
+) error {
+	var domainIDs []string
+	domainTasks := make(map[string][]*types.ReplicationTask)
+	for _, replicationTask := range replicationTasks {
+		domainID := getReplicationTaskDomainID(replicationTask)
+		if _, ok := domainTasks[domainID]; !ok {
+			domainIDs = append(domainIDs, domainID)
+		}
+		domainTasks[domainID] = append(domainTasks[domainID], replicationTask)
+	}
+
+	errCh := make(chan error, len(domainIDs))
+	for _, domainID := range domainIDs {
+		go func(domainName string, replicationTasks []*types.ReplicationTask) {
+			for _, replicationTask := range replicationTasks {
+				if err := p.scheduleTask(ctx, domainName, replicationTask); err != nil {
+					errCh <- err
+					return
+				}
+			}
+			errCh <- nil
+		}(p.getDomainName(domainID), domainTasks[domainID])
+	}
+
+	var scheduleErr error
+	for range domainIDs {
+		if err := <-errCh; err != nil {
+			scheduleErr = err
+		}
+	}
+	return scheduleErr
+}
+
+func (p *taskProcessorImpl) scheduleTask(
+	ctx context.Context,
+	domainName string,
+	replicationTask *types.ReplicationTask,
+) error {
+	// TODO: move to MultiStageRateLimiter
+	_ = p.hostRateLimiter.Wait(ctx)
+	_ = p.shardRateLimiter.Wait(ctx)
+	schedulerTask := newSchedulerTask(p, domainName, replicationTask)
+	if err := p.taskScheduler.Submit(schedulerTask); err != nil {
+		return err
+	}
+	return <-schedulerTask.doneCh
+}
+
+// getDomainName returns the name of the domain, or its ID if the domain name can't be found in the domain cache
+func (p *taskProcessorImpl) getDomainName(
+	domainID string,
+) string {
+	domainName, err := p.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return domainID
+	}
+	return domainName
+}
+
 func (p *taskProcessorImpl) syncShardStatusLoop() {
 
 	timer := time.NewTimer(backoff.JitDuration(
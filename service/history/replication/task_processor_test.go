@@ -23,6 +23,7 @@ package replication
 import (
 	"context"
 	"encoding/json"
+	"sync"
 	"testing"
 	"time"
 
@@ -132,6 +133,7 @@ func (s *taskProcessorSuite) SetupTest() {
 		metricsClient,
 		s.taskFetcher,
 		s.taskExecutor,
+		nil,
 	).(*taskProcessorImpl)
 }
 
@@ -150,6 +152,77 @@ func (s *taskProcessorSuite) TestProcessResponse_NoTask() {
 	s.Equal(int64(100), s.taskProcessor.lastRetrievedMessageID)
 }
 
+func (s *taskProcessorSuite) TestProcessResponse_TaskScheduler() {
+	s.config.ReplicationTaskProcessorSchedulerWorkerCount = dynamicconfig.GetIntPropertyFn(4)
+	scheduler, err := NewTaskScheduler(s.config, s.mockShard.GetLogger(), metrics.NewClient(tally.NoopScope, metrics.History))
+	s.NoError(err)
+	scheduler.Start()
+	defer scheduler.Stop()
+	s.taskProcessor.taskScheduler = scheduler
+
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).DoAndReturn(func(domainID string) (string, error) {
+		return domainID + "-name", nil
+	}).AnyTimes()
+	var lock sync.Mutex
+	applied := make(map[string][]int64)
+	s.taskExecutor.EXPECT().execute(gomock.Any(), false).DoAndReturn(
+		func(replicationTask *types.ReplicationTask, forceApply bool) (int, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			domainID := getReplicationTaskDomainID(replicationTask)
+			applied[domainID] = append(applied[domainID], replicationTask.GetSourceTaskID())
+			return metrics.SyncActivityTaskScope, nil
+		},
+	).Times(6)
+
+	s.taskProcessor.processResponse(&types.ReplicationMessages{
+		ReplicationTasks:       s.newDomainReplicationTasks("domain-a", "domain-b", "domain-a", "domain-a", "domain-b", "domain-a"),
+		LastRetrievedMessageID: 100,
+	})
+	// the tasks of a domain are applied in order
+	s.Equal(map[string][]int64{
+		"domain-a": {0, 2, 3, 5},
+		"domain-b": {1, 4},
+	}, applied)
+	s.Equal(int64(100), s.taskProcessor.lastProcessedMessageID)
+	s.Equal(int64(100), s.taskProcessor.lastRetrievedMessageID)
+}
+
+func (s *taskProcessorSuite) TestProcessResponse_TaskSchedulerStopped() {
+	scheduler, err := NewTaskScheduler(s.config, s.mockShard.GetLogger(), metrics.NewClient(tally.NoopScope, metrics.History))
+	s.NoError(err)
+	scheduler.Start()
+	scheduler.Stop()
+	s.taskProcessor.taskScheduler = scheduler
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("", &types.EntityNotExistsError{}).AnyTimes()
+
+	s.taskProcessor.processResponse(&types.ReplicationMessages{
+		ReplicationTasks:       s.newDomainReplicationTasks("domain-a", "domain-b"),
+		LastRetrievedMessageID: 100,
+	})
+	// the ack levels are not updated as the tasks were not applied
+	s.Equal(common.EmptyMessageID, s.taskProcessor.lastProcessedMessageID)
+	s.Equal(common.EmptyMessageID, s.taskProcessor.lastRetrievedMessageID)
+}
+
+func (s *taskProcessorSuite) newDomainReplicationTasks(
+	domainIDs ...string,
+) []*types.ReplicationTask {
+	var replicationTasks []*types.ReplicationTask
+	for i, domainID := range domainIDs {
+		replicationTasks = append(replicationTasks, &types.ReplicationTask{
+			TaskType:     types.ReplicationTaskTypeSyncActivity.Ptr(),
+			SourceTaskID: int64(i),
+			SyncActivityTaskAttributes: &types.SyncActivityTaskAttributes{
+				DomainID:   domainID,
+				WorkflowID: "workflowID",
+				RunID:      "runID",
+			},
+		})
+	}
+	return replicationTasks
+}
+
 func (s *taskProcessorSuite) TestSendFetchMessageRequest() {
 	s.taskProcessor.sendFetchMessageRequest()
 	requestMessage := <-s.requestChan
@@ -0,0 +1,152 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/task"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+const (
+	// schedulerTaskPriority is the only priority of the replication tasks in the scheduler
+	schedulerTaskPriority = 0
+)
+
+type (
+	// schedulerTask applies a replication task of a shard through the host level replication task scheduler
+	schedulerTask struct {
+		processor       *taskProcessorImpl
+		replicationTask *types.ReplicationTask
+		domainName      string
+		state           int32
+		priority        int32
+		doneCh          chan error
+	}
+)
+
+var (
+	errSchedulerTaskNacked = errors.New("replication task was not applied by the scheduler")
+)
+
+var _ task.PriorityTask = (*schedulerTask)(nil)
+
+// NewTaskScheduler creates the host level hierarchical fair scheduler which applies the replication tasks
+// of all the shards, the domains are served by history.taskSchedulerDomainWeight
+func NewTaskScheduler(
+	config *config.Config,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) (task.Scheduler, error) {
+	priorityWeights := common.ConvertIntMapToDynamicConfigMapProperty(map[int]int{schedulerTaskPriority: 1})
+	return task.NewHierarchicalFairTaskScheduler(
+		logger,
+		metricsClient,
+		&task.HierarchicalFairTaskSchedulerOptions{
+			PriorityWeights: func(...dynamicconfig.FilterOption) map[string]interface{} {
+				return priorityWeights
+			},
+			DomainWeight: config.TaskSchedulerDomainWeight,
+			TaskDomain: func(priorityTask task.PriorityTask) string {
+				return priorityTask.(*schedulerTask).domainName
+			},
+			QueueSize:       config.TaskSchedulerQueueSize(),
+			WorkerCount:     config.ReplicationTaskProcessorSchedulerWorkerCount,
+			DispatcherCount: config.TaskSchedulerDispatcherCount(),
+			// the replication tasks are retried and put into DLQ by the task processor
+			RetryPolicy: common.CreateTaskProcessingRetryPolicy(),
+		},
+	)
+}
+
+func newSchedulerTask(
+	processor *taskProcessorImpl,
+	domainName string,
+	replicationTask *types.ReplicationTask,
+) *schedulerTask {
+	return &schedulerTask{
+		processor:       processor,
+		replicationTask: replicationTask,
+		domainName:      domainName,
+		state:           int32(task.TaskStatePending),
+		priority:        schedulerTaskPriority,
+		doneCh:          make(chan error, 1),
+	}
+}
+
+func (t *schedulerTask) Execute() error {
+	return t.processor.processSingleTask(t.replicationTask)
+}
+
+func (t *schedulerTask) HandleErr(err error) error {
+	return err
+}
+
+func (t *schedulerTask) RetryErr(err error) bool {
+	return false
+}
+
+func (t *schedulerTask) Ack() {
+	atomic.StoreInt32(&t.state, int32(task.TaskStateAcked))
+	t.doneCh <- nil
+}
+
+func (t *schedulerTask) Nack() {
+	atomic.StoreInt32(&t.state, int32(task.TaskStateNacked))
+	t.doneCh <- errSchedulerTaskNacked
+}
+
+func (t *schedulerTask) State() task.State {
+	return task.State(atomic.LoadInt32(&t.state))
+}
+
+func (t *schedulerTask) Priority() int {
+	return int(atomic.LoadInt32(&t.priority))
+}
+
+func (t *schedulerTask) SetPriority(priority int) {
+	atomic.StoreInt32(&t.priority, int32(priority))
+}
+
+// getReplicationTaskDomainID returns the ID of the domain of the replication task
+func getReplicationTaskDomainID(
+	replicationTask *types.ReplicationTask,
+) string {
+	switch {
+	case replicationTask.GetHistoryTaskV2Attributes() != nil:
+		return replicationTask.GetHistoryTaskV2Attributes().GetDomainID()
+	case replicationTask.GetSyncActivityTaskAttributes() != nil:
+		return replicationTask.GetSyncActivityTaskAttributes().GetDomainID()
+	case replicationTask.GetFailoverMarkerAttributes() != nil:
+		return replicationTask.GetFailoverMarkerAttributes().GetDomainID()
+	case replicationTask.GetDomainTaskAttributes() != nil:
+		return replicationTask.GetDomainTaskAttributes().GetID()
+	default:
+		return ""
+	}
+}
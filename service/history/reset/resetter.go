@@ -56,10 +56,21 @@ type (
 			currentWorkflow execution.Workflow,
 			resetReason string,
 			additionalReapplyEvents []*types.HistoryEvent,
-			skipSignalReapply bool,
+			options ResetOptions,
 		) error
 	}
 
+	// ResetOptions are the options of a workflow reset
+	ResetOptions struct {
+		// SkipSignalReapply skips reapplying the signals after the reset point
+		SkipSignalReapply bool
+		// ReapplyEventTypes are the event types after the reset point reapplied in addition to signals,
+		// only WorkflowExecutionSignaled and WorkflowExecutionCancelRequested are supported
+		ReapplyEventTypes []types.EventType
+		// ChildWorkflowPolicy decides what to do with the child workflows still running at the reset point
+		ChildWorkflowPolicy types.ResetChildWorkflowPolicy
+	}
+
 	workflowResetterImpl struct {
 		shard             shard.Context
 		domainCache       cache.DomainCache
@@ -75,6 +86,10 @@ type (
 
 var _ WorkflowResetter = (*workflowResetterImpl)(nil)
 
+var defaultReapplyEventTypes = map[types.EventType]struct{}{
+	types.EventTypeWorkflowExecutionSignaled: {},
+}
+
 // NewWorkflowResetter creates a workflow resetter
 func NewWorkflowResetter(
 	shard shard.Context,
@@ -108,9 +123,14 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	currentWorkflow execution.Workflow,
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
-	skipSignalReapply bool,
+	options ResetOptions,
 ) (retError error) {
 
+	reapplyEventTypes, err := getReapplyEventTypes(options)
+	if err != nil {
+		return err
+	}
+
 	domainEntry, err := r.domainCache.GetDomainByID(domainID)
 	if err != nil {
 		return err
@@ -120,6 +140,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	currentMutableState := currentWorkflow.GetMutableState()
 	currentWorkflowTerminated := false
 	if currentMutableState.IsWorkflowExecutionRunning() {
+
 		if err := r.terminateWorkflow(
 			currentMutableState,
 			resetReason,
@@ -130,6 +151,12 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		currentWorkflowTerminated = true
 	}
 
+	// the base workflow closed by this reset, its children are terminated by its close execution task
+	var baseMutableState execution.MutableState
+	if currentWorkflowTerminated && currentMutableState.GetExecutionInfo().RunID == baseRunID {
+		baseMutableState = currentMutableState
+	}
+
 	resetWorkflow, err := r.prepareResetWorkflow(
 		ctx,
 		domainID,
@@ -144,13 +171,25 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		resetWorkflowVersion,
 		resetReason,
 		additionalReapplyEvents,
-		skipSignalReapply,
+		reapplyEventTypes,
+		baseMutableState,
+		options.ChildWorkflowPolicy,
 	)
 	if err != nil {
 		return err
 	}
 	defer resetWorkflow.GetReleaseFn()(retError)
 
+	if baseMutableState != nil {
+		if err := r.terminateChildrenStartedAfterResetPoint(
+			baseMutableState,
+			baseRebuildLastEventID,
+			options.ChildWorkflowPolicy,
+		); err != nil {
+			return err
+		}
+	}
+
 	return r.persistToDB(
 		ctx,
 		currentWorkflowTerminated,
@@ -173,7 +212,9 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	resetWorkflowVersion int64,
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
-	skipSignalReapply bool,
+	reapplyEventTypes map[types.EventType]struct{},
+	baseMutableState execution.MutableState,
+	childWorkflowPolicy types.ResetChildWorkflowPolicy,
 ) (execution.Workflow, error) {
 
 	resetWorkflow, err := r.replayResetWorkflow(
@@ -221,10 +262,29 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
-	// TODO right now only signals are eligible for reapply, so we can directly skip the whole reapply process
-	// for the sake of performance. In the future, if there are other events that need to be reapplied, remove this check
+	if err := r.reconcilePendingCrossWorkflowOperations(
+		ctx,
+		resetMutableState,
+		baseBranchToken,
+		baseRebuildLastEventID+1,
+		baseNextEventID,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := r.closePendingChildWorkflows(
+		resetMutableState,
+		baseMutableState,
+		childWorkflowPolicy,
+		resetReason,
+	); err != nil {
+		return nil, err
+	}
+
+	// only a few event types are eligible for reapply, so we can directly skip the whole reapply process
+	// for the sake of performance if none of them is selected.
 	// For example, we may want to re-apply activity/timer results for https://github.com/uber/cadence/issues/2934
-	if !skipSignalReapply {
+	if len(reapplyEventTypes) > 0 {
 		if err := r.reapplyResetAndContinueAsNewWorkflowEvents(
 			ctx,
 			resetMutableState,
@@ -234,6 +294,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 			baseBranchToken,
 			baseRebuildLastEventID+1,
 			baseNextEventID,
+			reapplyEventTypes,
 		); err != nil {
 			return nil, err
 		}
@@ -241,7 +302,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	}
 
 	// NOTE: this is reapplying events that are passing into the API that we shouldn't skip
-	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
+	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents, defaultReapplyEventTypes); err != nil {
 		return nil, err
 	}
 
@@ -455,6 +516,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 	baseBranchToken []byte,
 	baseRebuildNextEventID int64,
 	baseNextEventID int64,
+	reapplyEventTypes map[types.EventType]struct{},
 ) error {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -470,6 +532,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 		baseRebuildNextEventID,
 		baseNextEventID,
 		baseBranchToken,
+		reapplyEventTypes,
 	); err != nil {
 		return err
 	}
@@ -515,6 +578,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 			common.FirstEventID,
 			nextWorkflowNextEventID,
 			nextWorkflowBranchToken,
+			reapplyEventTypes,
 		); err != nil {
 			return err
 		}
@@ -528,6 +592,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	firstEventID int64,
 	nextEventID int64,
 	branchToken []byte,
+	reapplyEventTypes map[types.EventType]struct{},
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -556,7 +621,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 			return "", err
 		}
 		lastEvents = batch.(*types.History).Events
-		if err := r.reapplyEvents(mutableState, lastEvents); err != nil {
+		if err := r.reapplyEvents(mutableState, lastEvents, reapplyEventTypes); err != nil {
 			return "", err
 		}
 	}
@@ -573,10 +638,31 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 func (r *workflowResetterImpl) reapplyEvents(
 	mutableState execution.MutableState,
 	events []*types.HistoryEvent,
+	reapplyEventTypes map[types.EventType]struct{},
 ) error {
 
 	for _, event := range events {
+		if _, ok := reapplyEventTypes[event.GetEventType()]; !ok {
+			continue
+		}
 		switch event.GetEventType() {
+		case types.EventTypeWorkflowExecutionCancelRequested:
+			attr := event.GetWorkflowExecutionCancelRequestedEventAttributes()
+			if isCancelRequested, _ := mutableState.IsCancelRequested(); isCancelRequested {
+				continue
+			}
+			if _, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+				attr.GetCause(),
+				&types.HistoryRequestCancelWorkflowExecutionRequest{
+					CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+						Identity: attr.GetIdentity(),
+					},
+					ExternalInitiatedEventID:  attr.ExternalInitiatedEventID,
+					ExternalWorkflowExecution: attr.ExternalWorkflowExecution,
+				},
+			); err != nil {
+				return err
+			}
 		case types.EventTypeWorkflowExecutionSignaled:
 			attr := event.GetWorkflowExecutionSignaledEventAttributes()
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
@@ -587,7 +673,7 @@ func (r *workflowResetterImpl) reapplyEvents(
 				return err
 			}
 		default:
-			// events other than signal will be ignored
+			// events other than signal and cancel request will be ignored
 		}
 	}
 	return nil
@@ -633,12 +719,6 @@ func (r *workflowResetterImpl) closePendingDecisionTask(
 	resetReason string,
 ) (execution.MutableState, error) {
 
-	if len(resetMutableState.GetPendingChildExecutionInfos()) > 0 {
-		return nil, &types.BadRequestError{
-			Message: fmt.Sprintf("Can not reset workflow with pending child workflows"),
-		}
-	}
-
 	if decision, ok := resetMutableState.GetInFlightDecision(); ok {
 		// reset workflow has decision task start
 		_, err := resetMutableState.AddDecisionTaskFailedEvent(
@@ -673,3 +753,304 @@ func (r *workflowResetterImpl) closePendingDecisionTask(
 	}
 	return resetMutableState, nil
 }
+
+func getReapplyEventTypes(
+	options ResetOptions,
+) (map[types.EventType]struct{}, error) {
+
+	reapplyEventTypes := make(map[types.EventType]struct{})
+	if !options.SkipSignalReapply {
+		reapplyEventTypes[types.EventTypeWorkflowExecutionSignaled] = struct{}{}
+	}
+	for _, eventType := range options.ReapplyEventTypes {
+		switch eventType {
+		case types.EventTypeWorkflowExecutionSignaled, types.EventTypeWorkflowExecutionCancelRequested:
+			reapplyEventTypes[eventType] = struct{}{}
+		default:
+			return nil, &types.BadRequestError{
+				Message: fmt.Sprintf("Event type %v can not be reapplied by reset", eventType),
+			}
+		}
+	}
+	return reapplyEventTypes, nil
+}
+
+// terminateChildrenStartedAfterResetPoint terminates the running children started by the base workflow
+// after the reset point, the reset workflow will start them again when it makes the same decisions.
+// With the REJECT policy they are left to their parent close policy.
+func (r *workflowResetterImpl) terminateChildrenStartedAfterResetPoint(
+	baseMutableState execution.MutableState,
+	baseRebuildLastEventID int64,
+	childWorkflowPolicy types.ResetChildWorkflowPolicy,
+) error {
+
+	if childWorkflowPolicy == types.ResetChildWorkflowPolicyReject {
+		return nil
+	}
+	for _, childInfo := range baseMutableState.GetPendingChildExecutionInfos() {
+		if childInfo.InitiatedID <= baseRebuildLastEventID || childInfo.StartedID == common.EmptyEventID {
+			continue
+		}
+		if err := terminateChildOnBaseClose(baseMutableState, childInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcilePendingCrossWorkflowOperations applies the outcome of the child workflows, external signals and
+// cancellations pending at the reset point, if the base workflow recorded them after the reset point.
+// The ones still pending are re-issued by the tasks generated when the reset workflow is rebuilt.
+func (r *workflowResetterImpl) reconcilePendingCrossWorkflowOperations(
+	ctx context.Context,
+	resetMutableState execution.MutableState,
+	baseBranchToken []byte,
+	baseRebuildNextEventID int64,
+	baseNextEventID int64,
+) error {
+
+	if len(resetMutableState.GetPendingChildExecutionInfos()) == 0 &&
+		len(resetMutableState.GetPendingSignalExternalInfos()) == 0 &&
+		len(resetMutableState.GetPendingRequestCancelExternalInfos()) == 0 {
+		return nil
+	}
+	if baseRebuildNextEventID == baseNextEventID {
+		return nil
+	}
+
+	iter := collection.NewPagingIterator(r.getPaginationFn(
+		ctx,
+		baseRebuildNextEventID,
+		baseNextEventID,
+		baseBranchToken,
+	))
+	for iter.HasNext() {
+		batch, err := iter.Next()
+		if err != nil {
+			return err
+		}
+		for _, event := range batch.(*types.History).Events {
+			if err := r.reconcileCrossWorkflowEvent(ctx, resetMutableState, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *workflowResetterImpl) reconcileCrossWorkflowEvent(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	event *types.HistoryEvent,
+) error {
+
+	isChildStarted := func(initiatedID int64) bool {
+		childInfo, ok := mutableState.GetChildExecutionInfo(initiatedID)
+		return ok && childInfo.StartedID != common.EmptyEventID
+	}
+	isSignalPending := func(initiatedID int64) bool {
+		_, ok := mutableState.GetSignalInfo(initiatedID)
+		return ok
+	}
+	isCancelPending := func(initiatedID int64) bool {
+		_, ok := mutableState.GetRequestCancelInfo(initiatedID)
+		return ok
+	}
+
+	var err error
+	switch event.GetEventType() {
+	case types.EventTypeChildWorkflowExecutionStarted:
+		attr := event.ChildWorkflowExecutionStartedEventAttributes
+		if childInfo, ok := mutableState.GetChildExecutionInfo(attr.GetInitiatedEventID()); ok && childInfo.StartedID == common.EmptyEventID {
+			_, err = mutableState.AddChildWorkflowExecutionStartedEvent(
+				attr.GetDomain(),
+				attr.WorkflowExecution,
+				attr.WorkflowType,
+				attr.GetInitiatedEventID(),
+				attr.Header,
+			)
+		}
+	case types.EventTypeStartChildWorkflowExecutionFailed:
+		attr := event.StartChildWorkflowExecutionFailedEventAttributes
+		if childInfo, ok := mutableState.GetChildExecutionInfo(attr.GetInitiatedEventID()); ok && childInfo.StartedID == common.EmptyEventID {
+			initiatedEvent, err := mutableState.GetChildExecutionInitiatedEvent(ctx, attr.GetInitiatedEventID())
+			if err != nil {
+				return err
+			}
+			_, err = mutableState.AddStartChildWorkflowExecutionFailedEvent(
+				attr.GetInitiatedEventID(),
+				attr.GetCause(),
+				initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes,
+			)
+			return err
+		}
+	case types.EventTypeChildWorkflowExecutionCompleted:
+		attr := event.ChildWorkflowExecutionCompletedEventAttributes
+		if isChildStarted(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddChildWorkflowExecutionCompletedEvent(
+				attr.GetInitiatedEventID(),
+				attr.WorkflowExecution,
+				&types.WorkflowExecutionCompletedEventAttributes{Result: attr.Result},
+			)
+		}
+	case types.EventTypeChildWorkflowExecutionFailed:
+		attr := event.ChildWorkflowExecutionFailedEventAttributes
+		if isChildStarted(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddChildWorkflowExecutionFailedEvent(
+				attr.GetInitiatedEventID(),
+				attr.WorkflowExecution,
+				&types.WorkflowExecutionFailedEventAttributes{Reason: attr.Reason, Details: attr.Details},
+			)
+		}
+	case types.EventTypeChildWorkflowExecutionCanceled:
+		attr := event.ChildWorkflowExecutionCanceledEventAttributes
+		if isChildStarted(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddChildWorkflowExecutionCanceledEvent(
+				attr.GetInitiatedEventID(),
+				attr.WorkflowExecution,
+				&types.WorkflowExecutionCanceledEventAttributes{Details: attr.Details},
+			)
+		}
+	case types.EventTypeChildWorkflowExecutionTerminated:
+		attr := event.ChildWorkflowExecutionTerminatedEventAttributes
+		if isChildStarted(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddChildWorkflowExecutionTerminatedEvent(
+				attr.GetInitiatedEventID(),
+				attr.WorkflowExecution,
+				&types.WorkflowExecutionTerminatedEventAttributes{},
+			)
+		}
+	case types.EventTypeChildWorkflowExecutionTimedOut:
+		attr := event.ChildWorkflowExecutionTimedOutEventAttributes
+		if isChildStarted(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddChildWorkflowExecutionTimedOutEvent(
+				attr.GetInitiatedEventID(),
+				attr.WorkflowExecution,
+				&types.WorkflowExecutionTimedOutEventAttributes{TimeoutType: attr.TimeoutType},
+			)
+		}
+	case types.EventTypeExternalWorkflowExecutionSignaled:
+		attr := event.ExternalWorkflowExecutionSignaledEventAttributes
+		if isSignalPending(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddExternalWorkflowExecutionSignaled(
+				attr.GetInitiatedEventID(),
+				attr.GetDomain(),
+				attr.GetWorkflowExecution().GetWorkflowID(),
+				attr.GetWorkflowExecution().GetRunID(),
+				attr.Control,
+			)
+		}
+	case types.EventTypeSignalExternalWorkflowExecutionFailed:
+		attr := event.SignalExternalWorkflowExecutionFailedEventAttributes
+		if isSignalPending(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddSignalExternalWorkflowExecutionFailedEvent(
+				common.EmptyEventID, // the decision completed event is not used
+				attr.GetInitiatedEventID(),
+				attr.GetDomain(),
+				attr.GetWorkflowExecution().GetWorkflowID(),
+				attr.GetWorkflowExecution().GetRunID(),
+				attr.Control,
+				attr.GetCause(),
+			)
+		}
+	case types.EventTypeExternalWorkflowExecutionCancelRequested:
+		attr := event.ExternalWorkflowExecutionCancelRequestedEventAttributes
+		if isCancelPending(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddExternalWorkflowExecutionCancelRequested(
+				attr.GetInitiatedEventID(),
+				attr.GetDomain(),
+				attr.GetWorkflowExecution().GetWorkflowID(),
+				attr.GetWorkflowExecution().GetRunID(),
+			)
+		}
+	case types.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		attr := event.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+		if isCancelPending(attr.GetInitiatedEventID()) {
+			_, err = mutableState.AddRequestCancelExternalWorkflowExecutionFailedEvent(
+				common.EmptyEventID, // the decision completed event is not used
+				attr.GetInitiatedEventID(),
+				attr.GetDomain(),
+				attr.GetWorkflowExecution().GetWorkflowID(),
+				attr.GetWorkflowExecution().GetRunID(),
+				attr.GetCause(),
+			)
+		}
+	default:
+		// other events are not related to cross workflow operations pending at the reset point
+	}
+	return err
+}
+
+// closePendingChildWorkflows applies the child workflow policy to the children still running at the reset point
+func (r *workflowResetterImpl) closePendingChildWorkflows(
+	resetMutableState execution.MutableState,
+	baseMutableState execution.MutableState,
+	childWorkflowPolicy types.ResetChildWorkflowPolicy,
+	resetReason string,
+) error {
+
+	for _, childInfo := range resetMutableState.GetPendingChildExecutionInfos() {
+		switch childWorkflowPolicy {
+		case types.ResetChildWorkflowPolicyReject:
+			return &types.BadRequestError{
+				Message: "Can not reset workflow with pending child workflows, use a child workflow policy other than REJECT",
+			}
+		case types.ResetChildWorkflowPolicyTerminate:
+			if childInfo.StartedID == common.EmptyEventID {
+				// the reset workflow starts the child again
+				continue
+			}
+			if baseMutableState != nil {
+				if err := terminateChildOnBaseClose(baseMutableState, childInfo); err != nil {
+					return err
+				}
+			} else if childInfo.ParentClosePolicy != types.ParentClosePolicyTerminate {
+				// the base workflow is already closed and left the child running
+				return &types.BadRequestError{
+					Message: "Can not terminate child workflows left running by a closed workflow, use the REATTACH child workflow policy",
+				}
+			}
+			if _, err := resetMutableState.AddChildWorkflowExecutionTerminatedEvent(
+				childInfo.InitiatedID,
+				&types.WorkflowExecution{
+					WorkflowID: childInfo.StartedWorkflowID,
+					RunID:      childInfo.StartedRunID,
+				},
+				&types.WorkflowExecutionTerminatedEventAttributes{
+					Reason:   resetReason,
+					Identity: execution.IdentityHistoryService,
+				},
+			); err != nil {
+				return err
+			}
+		case types.ResetChildWorkflowPolicyReattach:
+			// the child reports its completion to the reset workflow when the base workflow is closed,
+			// see historyEngineImpl.RecordChildExecutionCompleted
+		default:
+			return &types.BadRequestError{
+				Message: fmt.Sprintf("Unknown reset child workflow policy %v", childWorkflowPolicy),
+			}
+		}
+	}
+	return nil
+}
+
+// terminateChildOnBaseClose sets the parent close policy of the child to TERMINATE, so the close execution
+// task of the base workflow, generated in the same transaction as the reset, terminates the child
+func terminateChildOnBaseClose(
+	baseMutableState execution.MutableState,
+	childInfo *persistence.ChildExecutionInfo,
+) error {
+
+	baseChildInfo, ok := baseMutableState.GetChildExecutionInfo(childInfo.InitiatedID)
+	if !ok {
+		// the child is already closed
+		return nil
+	}
+	if baseChildInfo.ParentClosePolicy == types.ParentClosePolicyTerminate {
+		return nil
+	}
+	updatedChildInfo := *baseChildInfo
+	updatedChildInfo.ParentClosePolicy = types.ParentClosePolicyTerminate
+	return baseMutableState.UpdateChildExecution(&updatedChildInfo)
+}
@@ -466,6 +466,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 		baseBranchToken,
 		baseFirstEventID,
 		baseNextEventID,
+		defaultReapplyEventTypes,
 	)
 	s.NoError(err)
 }
@@ -524,6 +525,7 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 		firstEventID,
 		nextEventID,
 		branchToken,
+		defaultReapplyEventTypes,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
@@ -543,7 +545,6 @@ func (s *workflowResetterSuite) TestClosePendingDecisionTask() {
 		ScheduleID: decisionScheduleEventID,
 		StartedID:  decisionStartEventID,
 	}, true).Times(1)
-	sourceMutableState.EXPECT().GetPendingChildExecutionInfos().Return(make(map[int64]*persistence.ChildExecutionInfo)).Times(1)
 	sourceMutableState.EXPECT().AddDecisionTaskFailedEvent(
 		decisionScheduleEventID,
 		decisionStartEventID,
@@ -569,7 +570,6 @@ func (s *workflowResetterSuite) TestClosePendingDecisionTask() {
 	// The workflow has only decision schedule
 	sourceMutableState.EXPECT().GetInFlightDecision().Return(nil, false).Times(1)
 	sourceMutableState.EXPECT().GetPendingDecision().Return(&execution.DecisionInfo{ScheduleID: decisionScheduleEventID}, true).Times(1)
-	sourceMutableState.EXPECT().GetPendingChildExecutionInfos().Return(make(map[int64]*persistence.ChildExecutionInfo)).Times(1)
 	sourceMutableState.EXPECT().AddDecisionTaskResetTimeoutEvent(
 		decisionScheduleEventID,
 		baseRunID,
@@ -627,7 +627,247 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 		}
 	}
 
-	err := s.workflowResetter.reapplyEvents(mutableState, events)
+	err := s.workflowResetter.reapplyEvents(mutableState, events, defaultReapplyEventTypes)
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestReapplyEvents_CancelRequested() {
+	signalEvent := &types.HistoryEvent{
+		EventID:   101,
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some random signal name",
+		},
+	}
+	cancelEvent := &types.HistoryEvent{
+		EventID:   102,
+		EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+		WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+			Cause:    "some random cancel cause",
+			Identity: "some random cancel identity",
+		},
+	}
+	reapplyEventTypes, err := getReapplyEventTypes(ResetOptions{
+		SkipSignalReapply: true,
+		ReapplyEventTypes: []types.EventType{types.EventTypeWorkflowExecutionCancelRequested},
+	})
+	s.NoError(err)
+
+	mutableState := execution.NewMockMutableState(s.controller)
+	mutableState.EXPECT().IsCancelRequested().Return(false, "").Times(1)
+	mutableState.EXPECT().AddWorkflowExecutionCancelRequestedEvent(
+		"some random cancel cause",
+		&types.HistoryRequestCancelWorkflowExecutionRequest{
+			CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+				Identity: "some random cancel identity",
+			},
+		},
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+
+	err = s.workflowResetter.reapplyEvents(mutableState, []*types.HistoryEvent{signalEvent, cancelEvent}, reapplyEventTypes)
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestGetReapplyEventTypes() {
+	reapplyEventTypes, err := getReapplyEventTypes(ResetOptions{})
+	s.NoError(err)
+	s.Equal(defaultReapplyEventTypes, reapplyEventTypes)
+
+	reapplyEventTypes, err = getReapplyEventTypes(ResetOptions{SkipSignalReapply: true})
+	s.NoError(err)
+	s.Empty(reapplyEventTypes)
+
+	_, err = getReapplyEventTypes(ResetOptions{
+		ReapplyEventTypes: []types.EventType{types.EventTypeActivityTaskCompleted},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowResetterSuite) TestReconcilePendingCrossWorkflowOperations() {
+	firstEventID := int64(10)
+	nextEventID := int64(20)
+	branchToken := []byte("some random branch token")
+
+	childExecution := &types.WorkflowExecution{
+		WorkflowID: "some random child workflow ID",
+		RunID:      uuid.New(),
+	}
+	signaledExecution := &types.WorkflowExecution{
+		WorkflowID: "some random signaled workflow ID",
+		RunID:      uuid.New(),
+	}
+	childInfo := &persistence.ChildExecutionInfo{
+		InitiatedID:       5,
+		StartedID:         6,
+		StartedWorkflowID: childExecution.WorkflowID,
+		StartedRunID:      childExecution.RunID,
+	}
+	signalInfo := &persistence.SignalInfo{
+		InitiatedID: 7,
+	}
+	events := []*types.HistoryEvent{
+		{
+			EventID:   11,
+			EventType: types.EventTypeChildWorkflowExecutionCompleted.Ptr(),
+			ChildWorkflowExecutionCompletedEventAttributes: &types.ChildWorkflowExecutionCompletedEventAttributes{
+				InitiatedEventID:  childInfo.InitiatedID,
+				WorkflowExecution: childExecution,
+				Result:            []byte("some random child result"),
+			},
+		},
+		{
+			EventID:   12,
+			EventType: types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
+			ExternalWorkflowExecutionSignaledEventAttributes: &types.ExternalWorkflowExecutionSignaledEventAttributes{
+				InitiatedEventID:  signalInfo.InitiatedID,
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: signaledExecution,
+				Control:           []byte("some random control"),
+			},
+		},
+		{
+			EventID:   13,
+			EventType: types.EventTypeExternalWorkflowExecutionCancelRequested.Ptr(),
+			ExternalWorkflowExecutionCancelRequestedEventAttributes: &types.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+				InitiatedEventID: 8,
+			},
+		},
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, &persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      execution.NDCDefaultPageSize,
+		NextPageToken: nil,
+		ShardID:       common.IntPtr(s.mockShard.GetShardID()),
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:       []*types.History{{Events: events}},
+		NextPageToken: nil,
+	}, nil).Once()
+
+	mutableState := execution.NewMockMutableState(s.controller)
+	mutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{
+		childInfo.InitiatedID: childInfo,
+	}).AnyTimes()
+	mutableState.EXPECT().GetChildExecutionInfo(childInfo.InitiatedID).Return(childInfo, true).Times(1)
+	mutableState.EXPECT().AddChildWorkflowExecutionCompletedEvent(
+		childInfo.InitiatedID,
+		childExecution,
+		&types.WorkflowExecutionCompletedEventAttributes{Result: []byte("some random child result")},
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	mutableState.EXPECT().GetSignalInfo(signalInfo.InitiatedID).Return(signalInfo, true).Times(1)
+	mutableState.EXPECT().AddExternalWorkflowExecutionSignaled(
+		signalInfo.InitiatedID,
+		constants.TestDomainName,
+		signaledExecution.WorkflowID,
+		signaledExecution.RunID,
+		[]byte("some random control"),
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	// the cancellation is not pending in the reset workflow
+	mutableState.EXPECT().GetRequestCancelInfo(int64(8)).Return(nil, false).Times(1)
+
+	err := s.workflowResetter.reconcilePendingCrossWorkflowOperations(
+		context.Background(),
+		mutableState,
+		branchToken,
+		firstEventID,
+		nextEventID,
+	)
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestClosePendingChildWorkflows() {
+	reason := "some random reset reason"
+	childInfo := &persistence.ChildExecutionInfo{
+		InitiatedID:       5,
+		StartedID:         6,
+		StartedWorkflowID: "some random child workflow ID",
+		StartedRunID:      uuid.New(),
+		ParentClosePolicy: types.ParentClosePolicyAbandon,
+	}
+	childExecution := &types.WorkflowExecution{
+		WorkflowID: childInfo.StartedWorkflowID,
+		RunID:      childInfo.StartedRunID,
+	}
+
+	mutableState := execution.NewMockMutableState(s.controller)
+	mutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{
+		childInfo.InitiatedID: childInfo,
+	}).AnyTimes()
+
+	err := s.workflowResetter.closePendingChildWorkflows(
+		mutableState, nil, types.ResetChildWorkflowPolicyReject, reason,
+	)
+	s.IsType(&types.BadRequestError{}, err)
+
+	err = s.workflowResetter.closePendingChildWorkflows(
+		mutableState, nil, types.ResetChildWorkflowPolicyReattach, reason,
+	)
+	s.NoError(err)
+
+	// the closed base workflow left the child running
+	err = s.workflowResetter.closePendingChildWorkflows(
+		mutableState, nil, types.ResetChildWorkflowPolicyTerminate, reason,
+	)
+	s.IsType(&types.BadRequestError{}, err)
+
+	baseMutableState := execution.NewMockMutableState(s.controller)
+	baseMutableState.EXPECT().GetChildExecutionInfo(childInfo.InitiatedID).Return(childInfo, true).Times(1)
+	updatedChildInfo := *childInfo
+	updatedChildInfo.ParentClosePolicy = types.ParentClosePolicyTerminate
+	baseMutableState.EXPECT().UpdateChildExecution(&updatedChildInfo).Return(nil).Times(1)
+	mutableState.EXPECT().AddChildWorkflowExecutionTerminatedEvent(
+		childInfo.InitiatedID,
+		childExecution,
+		&types.WorkflowExecutionTerminatedEventAttributes{
+			Reason:   reason,
+			Identity: execution.IdentityHistoryService,
+		},
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+	err = s.workflowResetter.closePendingChildWorkflows(
+		mutableState, baseMutableState, types.ResetChildWorkflowPolicyTerminate, reason,
+	)
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestTerminateChildrenStartedAfterResetPoint() {
+	baseRebuildLastEventID := int64(10)
+	pendingChildInfo := &persistence.ChildExecutionInfo{
+		InitiatedID:       5,
+		StartedID:         6,
+		ParentClosePolicy: types.ParentClosePolicyAbandon,
+	}
+	startedChildInfo := &persistence.ChildExecutionInfo{
+		InitiatedID:       11,
+		StartedID:         12,
+		ParentClosePolicy: types.ParentClosePolicyAbandon,
+	}
+	notStartedChildInfo := &persistence.ChildExecutionInfo{
+		InitiatedID:       13,
+		StartedID:         common.EmptyEventID,
+		ParentClosePolicy: types.ParentClosePolicyAbandon,
+	}
+
+	baseMutableState := execution.NewMockMutableState(s.controller)
+	baseMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{
+		pendingChildInfo.InitiatedID:    pendingChildInfo,
+		startedChildInfo.InitiatedID:    startedChildInfo,
+		notStartedChildInfo.InitiatedID: notStartedChildInfo,
+	}).AnyTimes()
+
+	// the children are left to their parent close policy
+	err := s.workflowResetter.terminateChildrenStartedAfterResetPoint(
+		baseMutableState, baseRebuildLastEventID, types.ResetChildWorkflowPolicyReject,
+	)
+	s.NoError(err)
+
+	baseMutableState.EXPECT().GetChildExecutionInfo(startedChildInfo.InitiatedID).Return(startedChildInfo, true).Times(1)
+	updatedChildInfo := *startedChildInfo
+	updatedChildInfo.ParentClosePolicy = types.ParentClosePolicyTerminate
+	baseMutableState.EXPECT().UpdateChildExecution(&updatedChildInfo).Return(nil).Times(1)
+	err = s.workflowResetter.terminateChildrenStartedAfterResetPoint(
+		baseMutableState, baseRebuildLastEventID, types.ResetChildWorkflowPolicyReattach,
+	)
 	s.NoError(err)
 }
 
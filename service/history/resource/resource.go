@@ -105,7 +105,9 @@ func New(
 
 			ESVisibilityListMaxQPS: nil, // history service never read,
 			ESIndexMaxResultWindow: nil, // history service never read,
-			ValidSearchAttributes:  nil, // history service never read,
+
+			// history never reads, but filters indexed fields with it when writing to ElasticSearch directly
+			ValidSearchAttributes: config.ValidSearchAttributes,
 		},
 	)
 	if err != nil {
@@ -40,6 +40,7 @@ type (
 		schedulerType        task.SchedulerType
 		fifoSchedulerOptions *task.FIFOTaskSchedulerOptions
 		wrrSchedulerOptions  *task.WeightedRoundRobinTaskSchedulerOptions
+		hfSchedulerOptions   *task.HierarchicalFairTaskSchedulerOptions
 	}
 
 	processorImpl struct {
@@ -72,8 +73,10 @@ func NewProcessor(
 		config.TaskSchedulerType(),
 		config.TaskSchedulerQueueSize(),
 		config.TaskSchedulerWorkerCount,
+		config.TaskSchedulerWorkerCountSubscription,
 		config.TaskSchedulerDispatcherCount(),
 		config.TaskSchedulerRoundRobinWeights,
+		config.TaskSchedulerDomainWeight,
 	)
 	if err != nil {
 		return nil, err
@@ -85,8 +88,10 @@ func NewProcessor(
 			config.TaskSchedulerType(),
 			config.TaskSchedulerShardQueueSize(),
 			config.TaskSchedulerShardWorkerCount,
+			nil,
 			1,
 			config.TaskSchedulerRoundRobinWeights,
+			config.TaskSchedulerDomainWeight,
 		)
 		if err != nil {
 			return nil, err
@@ -260,8 +265,10 @@ func newSchedulerOptions(
 	schedulerType int,
 	queueSize int,
 	workerCount dynamicconfig.IntPropertyFn,
+	workerCountSubscription dynamicconfig.IntPropertySubscriptionFn,
 	dispatcherCount int,
 	weights dynamicconfig.MapPropertyFn,
+	domainWeights dynamicconfig.IntPropertyFnWithDomainFilter,
 ) (*schedulerOptions, error) {
 	options := &schedulerOptions{
 		schedulerType: task.SchedulerType(schedulerType),
@@ -269,18 +276,31 @@ func newSchedulerOptions(
 	switch task.SchedulerType(schedulerType) {
 	case task.SchedulerTypeFIFO:
 		options.fifoSchedulerOptions = &task.FIFOTaskSchedulerOptions{
-			QueueSize:       queueSize,
-			WorkerCount:     workerCount,
-			DispatcherCount: dispatcherCount,
-			RetryPolicy:     common.CreateTaskProcessingRetryPolicy(),
+			QueueSize:               queueSize,
+			WorkerCount:             workerCount,
+			DispatcherCount:         dispatcherCount,
+			RetryPolicy:             common.CreateTaskProcessingRetryPolicy(),
+			WorkerCountSubscription: workerCountSubscription,
 		}
 	case task.SchedulerTypeWRR:
 		options.wrrSchedulerOptions = &task.WeightedRoundRobinTaskSchedulerOptions{
-			Weights:         weights,
-			QueueSize:       queueSize,
-			WorkerCount:     workerCount,
-			DispatcherCount: dispatcherCount,
-			RetryPolicy:     common.CreateTaskProcessingRetryPolicy(),
+			Weights:                 weights,
+			QueueSize:               queueSize,
+			WorkerCount:             workerCount,
+			DispatcherCount:         dispatcherCount,
+			RetryPolicy:             common.CreateTaskProcessingRetryPolicy(),
+			WorkerCountSubscription: workerCountSubscription,
+		}
+	case task.SchedulerTypeHierarchicalFair:
+		options.hfSchedulerOptions = &task.HierarchicalFairTaskSchedulerOptions{
+			PriorityWeights:         weights,
+			DomainWeight:            domainWeights,
+			TaskDomain:              getTaskDomainName,
+			QueueSize:               queueSize,
+			WorkerCount:             workerCount,
+			DispatcherCount:         dispatcherCount,
+			RetryPolicy:             common.CreateTaskProcessingRetryPolicy(),
+			WorkerCountSubscription: workerCountSubscription,
 		}
 	default:
 		return nil, fmt.Errorf("unknown task scheduler type: %v", schedulerType)
@@ -308,6 +328,12 @@ func createTaskScheduler(
 			metricsClient,
 			options.wrrSchedulerOptions,
 		)
+	case task.SchedulerTypeHierarchicalFair:
+		scheduler, err = task.NewHierarchicalFairTaskScheduler(
+			logger,
+			metricsClient,
+			options.hfSchedulerOptions,
+		)
 	default:
 		// the scheduler type has already been verified when initializing the processor
 		panic(fmt.Sprintf("Unknown task scheduler type, %v", options.schedulerType))
@@ -315,3 +341,21 @@ func createTaskScheduler(
 
 	return scheduler, err
 }
+
+// getTaskDomainName returns the domain name of the task for hierarchical fair task scheduler,
+// domain ID is used if the domain name can't be found in the domain cache
+func getTaskDomainName(
+	priorityTask task.PriorityTask,
+) string {
+	historyTask, ok := priorityTask.(Task)
+	if !ok {
+		return ""
+	}
+
+	domainID := historyTask.GetDomainID()
+	domainName, err := historyTask.GetShard().GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return domainID
+	}
+	return domainName
+}
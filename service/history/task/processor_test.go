@@ -205,7 +205,7 @@ func (s *queueTaskProcessorSuite) TestTrySubmit_Fail() {
 }
 
 func (s *queueTaskProcessorSuite) TestNewSchedulerOptions_UnknownSchedulerType() {
-	options, err := newSchedulerOptions(0, 100, dynamicconfig.GetIntPropertyFn(10), 1, nil)
+	options, err := newSchedulerOptions(0, 100, dynamicconfig.GetIntPropertyFn(10), nil, 1, nil, nil)
 	s.Error(err)
 	s.Nil(options)
 }
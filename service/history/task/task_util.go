@@ -166,6 +166,11 @@ func GetTimerTaskMetricScope(
 			return metrics.TimerActiveTaskDeleteHistoryEventScope
 		}
 		return metrics.TimerStandbyTaskDeleteHistoryEventScope
+	case persistence.TaskTypeDeleteWorkflowExecution:
+		if isActive {
+			return metrics.TimerActiveTaskDeleteWorkflowExecutionScope
+		}
+		return metrics.TimerStandbyTaskDeleteWorkflowExecutionScope
 	case persistence.TaskTypeActivityRetryTimer:
 		if isActive {
 			return metrics.TimerActiveTaskActivityRetryTimerScope
@@ -102,6 +102,8 @@ func (t *timerActiveTaskExecutor) Execute(
 		return t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case persistence.TaskTypeDeleteHistoryEvent:
 		return t.executeDeleteHistoryEventTask(ctx, timerTask)
+	case persistence.TaskTypeDeleteWorkflowExecution:
+		return t.executeDeleteWorkflowExecutionTask(ctx, timerTask)
 	default:
 		return errUnknownTimerTask
 	}
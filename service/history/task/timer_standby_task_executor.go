@@ -89,7 +89,8 @@ func (t *timerStandbyTaskExecutor) Execute(
 
 	if !shouldProcessTask &&
 		timerTask.TaskType != persistence.TaskTypeWorkflowTimeout &&
-		timerTask.TaskType != persistence.TaskTypeDeleteHistoryEvent {
+		timerTask.TaskType != persistence.TaskTypeDeleteHistoryEvent &&
+		timerTask.TaskType != persistence.TaskTypeDeleteWorkflowExecution {
 		// guarantee the processing of workflow execution history deletion
 		return nil
 	}
@@ -114,6 +115,8 @@ func (t *timerStandbyTaskExecutor) Execute(
 		return t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case persistence.TaskTypeDeleteHistoryEvent:
 		return t.executeDeleteHistoryEventTask(ctx, timerTask)
+	case persistence.TaskTypeDeleteWorkflowExecution:
+		return t.executeDeleteWorkflowExecutionTask(ctx, timerTask)
 	default:
 		return errUnknownTimerTask
 	}
@@ -119,6 +119,50 @@ func (t *timerTaskExecutorBase) executeDeleteHistoryEventTask(
 	return t.deleteWorkflow(ctx, task, wfContext, mutableState)
 }
 
+func (t *timerTaskExecutorBase) executeDeleteWorkflowExecutionTask(
+	ctx context.Context,
+	task *persistence.TimerTaskInfo,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTimerTask(ctx, wfContext, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, lastWriteVersion, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	// the close execution task of the run has a smaller taskID than this task and
+	// it still needs the mutable state and visibility records, so wait until
+	// all transfer tasks up to this task are acked before deleting them
+	transferAckLevel := t.shard.GetTransferAckLevel()
+	if transferAckLevel < task.TaskID && transferAckLevel < t.shard.GetTransferMaxReadLevel() {
+		return ErrTaskRedispatch
+	}
+
+	t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
+	return t.deleteWorkflow(ctx, task, wfContext, mutableState)
+}
+
 func (t *timerTaskExecutorBase) deleteWorkflow(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
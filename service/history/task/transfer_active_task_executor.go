@@ -380,6 +380,14 @@ func (t *transferActiveTaskExecutor) processCloseExecution(
 		return err
 	}
 
+	// a workflow is terminated when it's reset, its children may be reattached to the reset run
+	if executionInfo.CloseStatus == persistence.WorkflowCloseStatusTerminated && len(children) > 0 {
+		children, err = t.filterChildrenReattachedByReset(ctx, task, children)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = t.processParentClosePolicy(ctx, wfContext, task, domainName, children, &crossClusterTaskGenerators)
 	if err != nil {
 		return err
@@ -1605,7 +1613,7 @@ func (t *transferActiveTaskExecutor) resetWorkflow(
 		),
 		reason,
 		nil,
-		false,
+		reset.ResetOptions{},
 	)
 
 	switch err.(type) {
@@ -1717,6 +1725,56 @@ func (t *transferActiveTaskExecutor) processParentClosePolicy(
 	return nil
 }
 
+// filterChildrenReattachedByReset removes the children reattached to the current run by a reset,
+// the parent close policy of the reset run should not be applied to them
+func (t *transferActiveTaskExecutor) filterChildrenReattachedByReset(
+	ctx context.Context,
+	task *persistence.TransferTaskInfo,
+	children map[int64]*persistence.ChildExecutionInfo,
+) (filtered map[int64]*persistence.ChildExecutionInfo, retError error) {
+
+	resp, err := t.shard.GetExecutionManager().GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   task.DomainID,
+		WorkflowID: task.WorkflowID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.RunID == task.RunID {
+		return children, nil
+	}
+
+	currentContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		types.WorkflowExecution{
+			WorkflowID: task.WorkflowID,
+			RunID:      resp.RunID,
+		},
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	currentMutableState, err := currentContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered = make(map[int64]*persistence.ChildExecutionInfo, len(children))
+	for initiatedID, childInfo := range children {
+		// the run ID of the child identifies the reattached child, the initiated ID is kept by reset
+		if reattached, ok := currentMutableState.GetChildExecutionInfo(initiatedID); ok &&
+			childInfo.StartedRunID != "" &&
+			reattached.StartedRunID == childInfo.StartedRunID {
+			continue
+		}
+		filtered[initiatedID] = childInfo
+	}
+	return filtered, nil
+}
+
 func (t *transferActiveTaskExecutor) applyParentClosePolicy(
 	ctx context.Context,
 	domainID string,
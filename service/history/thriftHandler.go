@@ -302,6 +302,18 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// DeleteWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) DeleteWorkflowExecution(ctx context.Context, request *h.DeleteWorkflowExecutionRequest) error {
+	err := t.h.DeleteWorkflowExecution(withThriftTag(ctx), thrift.ToHistoryDeleteWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// UpsertWorkflowSearchAttributes forwards request to the underlying handler
+func (t ThriftHandler) UpsertWorkflowSearchAttributes(ctx context.Context, request *h.UpsertWorkflowSearchAttributesRequest) error {
+	err := t.h.UpsertWorkflowSearchAttributes(withThriftTag(ctx), thrift.ToUpsertWorkflowSearchAttributesRequest(request))
+	return thrift.FromError(err)
+}
+
 // GetFailoverInfo forwards request to the underlying handler
 func (t ThriftHandler) GetFailoverInfo(ctx context.Context, request *h.GetFailoverInfoRequest) (*h.GetFailoverInfoResponse, error) {
 	response, err := t.h.GetFailoverInfo(withThriftTag(ctx), thrift.ToGetFailoverInfoRequest(request))
@@ -301,4 +301,14 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("DeleteWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowExecution(taggedCtx, &types.HistoryDeleteWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowExecution(ctx, &hist.DeleteWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpsertWorkflowSearchAttributes", func(t *testing.T) {
+		h.EXPECT().UpsertWorkflowSearchAttributes(taggedCtx, &types.UpsertWorkflowSearchAttributesRequest{}).Return(internalErr).Times(1)
+		err := th.UpsertWorkflowSearchAttributes(ctx, &hist.UpsertWorkflowSearchAttributesRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
@@ -0,0 +1,192 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go -self_package github.com/uber/cadence/service/worker/batcher
+
+package batcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	reasonMemoKey            = "Reason"
+	domainSearchAttributeKey = "CustomDomain"
+	operatorSearchAttribute  = "Operator"
+)
+
+type (
+	// Client is used to manage batch operations. Batch operations target workflows of a domain,
+	// but they run as workflows of the batcher system domain. It backs the batch operation APIs
+	// of the frontend, so its errors are the ones of the service.
+	Client interface {
+		// StartBatchOperation starts a batch operation and returns its job ID
+		StartBatchOperation(ctx context.Context, params BatchParams, operator string) (string, error)
+		DescribeBatchOperation(ctx context.Context, jobID string) (*BatchOperationInfo, error)
+		ListBatchOperations(ctx context.Context, domainName string, pageSize int32, nextPageToken []byte) ([]*BatchOperationInfo, []byte, error)
+		// CancelBatchOperation requests cancellation of a running batch operation, which stops after the
+		// workflows in flight are processed. Workflows already processed are not reverted
+		CancelBatchOperation(ctx context.Context, jobID string) error
+	}
+
+	// BatchOperationInfo describes a batch operation
+	BatchOperationInfo struct {
+		JobID      string
+		DomainName string
+		Reason     string
+		Operator   string
+		StartTime  time.Time
+		// CloseStatus and CloseTime are only set once the batch operation is closed
+		CloseStatus *types.WorkflowExecutionCloseStatus `json:",omitempty"`
+		CloseTime   *time.Time                          `json:",omitempty"`
+		// Progress is reported by the batch activity through heartbeats, it's only set by DescribeBatchOperation
+		Progress *HeartBeatDetails `json:",omitempty"`
+	}
+
+	clientImpl struct {
+		client cclient.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new batch operation Client on top of a cadence client of the batcher system domain
+func NewClient(svcClient cclient.Client) Client {
+	return &clientImpl{
+		client: svcClient,
+	}
+}
+
+func (c *clientImpl) StartBatchOperation(ctx context.Context, params BatchParams, operator string) (string, error) {
+	if err := validateParams(setDefaultParams(params)); err != nil {
+		return "", &types.BadRequestError{Message: err.Error()}
+	}
+	options := cclient.StartWorkflowOptions{
+		ID:                           uuid.New().String(),
+		TaskList:                     BatcherTaskListName,
+		ExecutionStartToCloseTimeout: InfiniteDuration,
+		Memo: map[string]interface{}{
+			reasonMemoKey: params.Reason,
+		},
+		SearchAttributes: map[string]interface{}{
+			domainSearchAttributeKey: params.DomainName,
+			operatorSearchAttribute:  operator,
+		},
+	}
+	wf, err := c.client.StartWorkflow(ctx, options, BatchWFTypeName, params)
+	if err != nil {
+		return "", client.ToServiceError(err)
+	}
+	return wf.ID, nil
+}
+
+func (c *clientImpl) DescribeBatchOperation(ctx context.Context, jobID string) (*BatchOperationInfo, error) {
+	resp, err := c.client.DescribeWorkflowExecution(ctx, jobID, "")
+	if err != nil {
+		return nil, client.ToServiceError(err)
+	}
+	info, err := toBatchOperationInfo(resp.GetWorkflowExecutionInfo())
+	if err != nil {
+		return nil, err
+	}
+	if info.CloseStatus == nil && len(resp.PendingActivities) > 0 {
+		var progress HeartBeatDetails
+		if details := resp.PendingActivities[0].HeartbeatDetails; len(details) > 0 {
+			if err := json.Unmarshal(details, &progress); err != nil {
+				return nil, err
+			}
+		}
+		info.Progress = &progress
+	}
+	return info, nil
+}
+
+func (c *clientImpl) ListBatchOperations(
+	ctx context.Context,
+	domainName string,
+	pageSize int32,
+	nextPageToken []byte,
+) ([]*BatchOperationInfo, []byte, error) {
+	resp, err := c.client.ListWorkflow(ctx, &shared.ListWorkflowExecutionsRequest{
+		Domain:        common.StringPtr(common.BatcherLocalDomainName),
+		PageSize:      common.Int32Ptr(pageSize),
+		NextPageToken: nextPageToken,
+		Query:         common.StringPtr(fmt.Sprintf("%v = '%v'", domainSearchAttributeKey, domainName)),
+	})
+	if err != nil {
+		return nil, nil, client.ToServiceError(err)
+	}
+	operations := make([]*BatchOperationInfo, 0, len(resp.GetExecutions()))
+	for _, execution := range resp.GetExecutions() {
+		info, err := toBatchOperationInfo(execution)
+		if err != nil {
+			return nil, nil, err
+		}
+		operations = append(operations, info)
+	}
+	return operations, resp.GetNextPageToken(), nil
+}
+
+func (c *clientImpl) CancelBatchOperation(ctx context.Context, jobID string) error {
+	return client.ToServiceError(c.client.CancelWorkflow(ctx, jobID, ""))
+}
+
+func toBatchOperationInfo(execution *shared.WorkflowExecutionInfo) (*BatchOperationInfo, error) {
+	info := &BatchOperationInfo{
+		JobID:     execution.GetExecution().GetWorkflowId(),
+		StartTime: time.Unix(0, execution.GetStartTime()),
+	}
+	if execution.CloseStatus != nil {
+		var closeStatus types.WorkflowExecutionCloseStatus
+		if err := closeStatus.UnmarshalText([]byte(execution.GetCloseStatus().String())); err != nil {
+			return nil, err
+		}
+		info.CloseStatus = &closeStatus
+		closeTime := time.Unix(0, execution.GetCloseTime())
+		info.CloseTime = &closeTime
+	}
+	if reason, ok := execution.GetMemo().GetFields()[reasonMemoKey]; ok {
+		if err := json.Unmarshal(reason, &info.Reason); err != nil {
+			return nil, err
+		}
+	}
+	if domainName, ok := execution.GetSearchAttributes().GetIndexedFields()[domainSearchAttributeKey]; ok {
+		if err := json.Unmarshal(domainName, &info.DomainName); err != nil {
+			return nil, err
+		}
+	}
+	if operator, ok := execution.GetSearchAttributes().GetIndexedFields()[operatorSearchAttribute]; ok {
+		if err := json.Unmarshal(operator, &info.Operator); err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+const (
+	// ResetTypeFirstDecisionCompleted resets to the first DecisionTaskCompleted event
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeLastDecisionCompleted resets to the last DecisionTaskCompleted event
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeLastContinuedAsNew resets to the last DecisionTaskCompleted event of the run that continued as new
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets to the auto reset point of a bad binary checksum
+	ResetTypeBadBinary = "BadBinary"
+	// ResetTypeDecisionCompletedTime resets to the first DecisionTaskCompleted event after a given time
+	ResetTypeDecisionCompletedTime = "DecisionCompletedTime"
+	// ResetTypeFirstDecisionScheduled resets to the first DecisionTaskScheduled event
+	ResetTypeFirstDecisionScheduled = "FirstDecisionScheduled"
+	// ResetTypeLastDecisionScheduled resets to the last DecisionTaskScheduled event
+	ResetTypeLastDecisionScheduled = "LastDecisionScheduled"
+)
+
+// AllResetTypes is the reset types we supported, same as the CLI reset-batch command
+var AllResetTypes = []string{
+	ResetTypeFirstDecisionCompleted,
+	ResetTypeLastDecisionCompleted,
+	ResetTypeLastContinuedAsNew,
+	ResetTypeBadBinary,
+	ResetTypeDecisionCompletedTime,
+	ResetTypeFirstDecisionScheduled,
+	ResetTypeLastDecisionScheduled,
+}
+
+type (
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// one of AllResetTypes
+		ResetType string
+		// required for ResetTypeBadBinary
+		BadBinaryChecksum string
+		// required for ResetTypeDecisionCompletedTime, in unix nanoseconds
+		EarliestTime int64
+		// skip the workflow if the current run is open
+		SkipCurrentOpen bool
+		// skip the workflow if the base run is not the current run
+		SkipBaseIsNotCurrent bool
+		// only reset the workflow if the last decision failed with non-determinism
+		NonDeterministicOnly bool
+		// skip reapplying the signals after the reset point
+		SkipSignalReapply bool
+		// policy for the child workflows still running at the reset point, default to reject
+		ChildWorkflowPolicy *types.ResetChildWorkflowPolicy
+		// event types after the reset point to reapply in addition to signals
+		ReapplyEventTypes []types.EventType
+	}
+)
+
+func validateResetParams(params ResetParams) error {
+	switch params.ResetType {
+	case ResetTypeBadBinary:
+		if params.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum for reset type %v", params.ResetType)
+		}
+	case ResetTypeDecisionCompletedTime:
+		if params.EarliestTime <= 0 {
+			return fmt.Errorf("must provide earliest time for reset type %v", params.ResetType)
+		}
+	case ResetTypeFirstDecisionCompleted,
+		ResetTypeLastDecisionCompleted,
+		ResetTypeLastContinuedAsNew,
+		ResetTypeFirstDecisionScheduled,
+		ResetTypeLastDecisionScheduled:
+	default:
+		return fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+	return nil
+}
+
+// resetWorkflow resets a single workflow according to the reset params. The reset point is
+// still computed in dry run mode so that invalid targets surface as errors.
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	yarpcCallOptions ...yarpc.CallOption,
+) error {
+	params := batchParams.ResetParams
+	domain := batchParams.DomainName
+	logger := getActivityLogger(ctx).WithTags(tag.WorkflowID(workflowID), tag.WorkflowRunID(runID))
+
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	currentRunID := resp.WorkflowExecutionInfo.Execution.GetRunID()
+	if currentRunID != runID && params.SkipBaseIsNotCurrent {
+		logger.Info("Skip reset because base run is not the current run")
+		return nil
+	}
+	if runID == "" {
+		runID = currentRunID
+	}
+	if resp.WorkflowExecutionInfo.CloseStatus == nil && params.SkipCurrentOpen {
+		logger.Info("Skip reset because current run is open")
+		return nil
+	}
+
+	if params.NonDeterministicOnly {
+		isNonDeterministic, err := isLastDecisionFailedWithNonDeterminism(ctx, client, domain, workflowID, runID)
+		if err != nil {
+			return err
+		}
+		if !isNonDeterministic {
+			logger.Info("Skip reset because last decision did not fail with non-determinism")
+			return nil
+		}
+	}
+
+	resetBaseRunID, decisionFinishID, err := getResetPoint(ctx, client, params, domain, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	if batchParams.DryRun {
+		logger.Info("Dry run of batch reset",
+			tag.WorkflowResetBaseRunID(resetBaseRunID),
+			tag.WorkflowEventID(decisionFinishID))
+		return nil
+	}
+
+	_, err = client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      resetBaseRunID,
+		},
+		Reason:                batchParams.Reason,
+		DecisionFinishEventID: decisionFinishID,
+		RequestID:             uuid.New().String(),
+		SkipSignalReapply:     params.SkipSignalReapply,
+		ChildWorkflowPolicy:   params.ChildWorkflowPolicy,
+		ReapplyEventTypes:     params.ReapplyEventTypes,
+	}, yarpcCallOptions...)
+	return err
+}
+
+// getResetPoint returns the base run and the DecisionFinishEventID to reset to
+func getResetPoint(
+	ctx context.Context,
+	client frontend.Client,
+	params ResetParams,
+	domain string,
+	workflowID string,
+	runID string,
+) (resetBaseRunID string, decisionFinishID int64, err error) {
+	// default to the same runID
+	resetBaseRunID = runID
+
+	switch params.ResetType {
+	case ResetTypeFirstDecisionCompleted:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskCompleted, true, 0)
+	case ResetTypeLastDecisionCompleted:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskCompleted, false, 0)
+	case ResetTypeLastContinuedAsNew:
+		// this reset type changes the base run to the run that continued as new
+		resetBaseRunID, err = getContinuedExecutionRunID(ctx, client, domain, workflowID, runID)
+		if err != nil {
+			return
+		}
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, resetBaseRunID, types.EventTypeDecisionTaskCompleted, false, 0)
+	case ResetTypeBadBinary:
+		decisionFinishID, err = getBadBinaryResetEventID(ctx, client, domain, workflowID, runID, params.BadBinaryChecksum)
+	case ResetTypeDecisionCompletedTime:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskCompleted, true, params.EarliestTime)
+	case ResetTypeFirstDecisionScheduled:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskScheduled, true, 0)
+		// decisionFinishID is exclusive in reset API
+		decisionFinishID++
+	case ResetTypeLastDecisionScheduled:
+		decisionFinishID, err = findDecisionEventID(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskScheduled, false, 0)
+		// decisionFinishID is exclusive in reset API
+		decisionFinishID++
+	default:
+		err = fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+	return
+}
+
+// findDecisionEventID returns the ID of the first or the last event of the given type
+// whose timestamp is no earlier than earliestTime
+func findDecisionEventID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	eventType types.EventType,
+	first bool,
+	earliestTime int64,
+) (int64, error) {
+	var eventID int64
+	err := iterateHistory(ctx, client, domain, workflowID, runID, func(e *types.HistoryEvent) bool {
+		if e.GetEventType() != eventType || e.GetTimestamp() < earliestTime {
+			return true
+		}
+		eventID = e.GetEventID()
+		return !first
+	})
+	if err != nil {
+		return 0, err
+	}
+	if eventID == 0 {
+		return 0, &types.BadRequestError{Message: fmt.Sprintf("no %v event found to reset to", eventType)}
+	}
+	return eventID, nil
+}
+
+func getContinuedExecutionRunID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+) (string, error) {
+	resp, err := client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return "", &types.BadRequestError{Message: "workflow history is empty"}
+	}
+	continuedRunID := events[0].GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunID()
+	if continuedRunID == "" {
+		return "", &types.BadRequestError{Message: "workflow is not continued as new"}
+	}
+	return continuedRunID, nil
+}
+
+func getBadBinaryResetEventID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	binaryChecksum string,
+) (int64, error) {
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	_, point := execution.FindAutoResetPoint(clock.NewRealTimeSource(), &types.BadBinaries{
+		Binaries: map[string]*types.BadBinaryInfo{
+			binaryChecksum: {},
+		},
+	}, resp.WorkflowExecutionInfo.AutoResetPoints)
+	if point == nil || point.GetFirstDecisionCompletedID() == 0 {
+		return 0, &types.BadRequestError{Message: fmt.Sprintf("no auto reset point found for binary checksum %v", binaryChecksum)}
+	}
+	return point.GetFirstDecisionCompletedID(), nil
+}
+
+func isLastDecisionFailedWithNonDeterminism(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+	var decisionFailed *types.HistoryEvent
+	err := iterateHistory(ctx, client, domain, workflowID, runID, func(e *types.HistoryEvent) bool {
+		switch e.GetEventType() {
+		case types.EventTypeDecisionTaskFailed:
+			decisionFailed = e
+		case types.EventTypeDecisionTaskCompleted:
+			decisionFailed = nil
+		}
+		return true
+	})
+	if err != nil || decisionFailed == nil {
+		return false, err
+	}
+	attr := decisionFailed.GetDecisionTaskFailedEventAttributes()
+	return attr.GetCause() == types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure ||
+		strings.Contains(string(attr.GetDetails()), "nondeterministic"), nil
+}
+
+// iterateHistory calls fn on the history events of a run in order until fn returns false
+func iterateHistory(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	fn func(*types.HistoryEvent) bool,
+) error {
+	req := &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: pageSize,
+	}
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if !fn(e) {
+				return nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
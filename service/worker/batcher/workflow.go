@@ -22,6 +22,7 @@ package batcher
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -72,10 +74,25 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows, running workflows are terminated first
+	BatchTypeDelete = "delete"
+	// BatchTypeUpsertSearchAttributes is batch type for upserting search attributes of running workflows.
+	// No history event is written, so the workflows don't need to handle it, but the search attributes
+	// are only updated in the active cluster.
+	BatchTypeUpsertSearchAttributes = "upsert_search_attributes"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{
+	BatchTypeTerminate,
+	BatchTypeCancel,
+	BatchTypeSignal,
+	BatchTypeReset,
+	BatchTypeDelete,
+	BatchTypeUpsertSearchAttributes,
+}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -100,6 +117,11 @@ type (
 		Input      string
 	}
 
+	// UpsertSearchAttributesParams is the parameters for upserting search attributes of workflow
+	UpsertSearchAttributesParams struct {
+		SearchAttributes map[string]interface{}
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target domain to execute batch operation
@@ -108,8 +130,10 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: reset,terminate
+		// One of AllBatchTypes
 		BatchType string
+		// DryRun only logs the workflows that would be processed without mutating them
+		DryRun bool
 
 		// Below are all optional
 		// TerminateParams is params only for BatchTypeTerminate
@@ -118,6 +142,10 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// UpsertSearchAttributesParams is params only for BatchTypeUpsertSearchAttributes
+		UpsertSearchAttributesParams UpsertSearchAttributesParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 		RPS int
@@ -131,6 +159,8 @@ type (
 		NonRetryableErrors []string
 		// internal conversion for NonRetryableErrors
 		_nonRetryableErrors map[string]struct{}
+		// ID of the target domain, it's only resolved by the activity for BatchTypeUpsertSearchAttributes
+		_domainID string
 	}
 
 	// HeartBeatDetails is the struct for heartbeat details
@@ -200,9 +230,15 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeReset:
+		return validateResetParams(params.ResetParams)
+	case BatchTypeUpsertSearchAttributes:
+		if len(params.UpsertSearchAttributesParams.SearchAttributes) == 0 {
+			return fmt.Errorf("must provide search attributes")
+		}
+		_, err := encodeSearchAttributes(params.UpsertSearchAttributesParams.SearchAttributes)
+		return err
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -261,6 +297,16 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
+	if batchParams.BatchType == BatchTypeUpsertSearchAttributes {
+		// search attributes are upserted by history directly, which needs the domain ID
+		resp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
+			Name: common.StringPtr(batchParams.DomainName),
+		})
+		if err != nil {
+			return HeartBeatDetails{}, err
+		}
+		batchParams._domainID = resp.GetDomainInfo().GetUUID()
+	}
 	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
@@ -353,6 +399,29 @@ func startTaskProcessor(
 			}
 
 			switch batchParams.BatchType {
+			case BatchTypeReset:
+				// resetting children with their parent is not supported, they are reset separately if matched by the query
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, yarpcCallOptions...)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.DeleteWorkflowExecution(ctx, &types.DeleteWorkflowExecutionRequest{
+							Domain: batchParams.DomainName,
+							WorkflowExecution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							Identity: BatchWFTypeName,
+						}, yarpcCallOptions...)
+					})
+			case BatchTypeUpsertSearchAttributes:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return upsertSearchAttributes(ctx, batcher.clientBean.GetHistoryClient(), batchParams, workflowID, runID)
+					})
 			case BatchTypeTerminate:
 				err = processTask(ctx, limiter, task, batchParams, client,
 					batchParams.TerminateParams.TerminateChildren,
@@ -437,7 +506,14 @@ func processTask(
 		}
 		activity.RecordHeartbeat(ctx, task.hbd)
 
-		err = procFn(wf.GetWorkflowID(), wf.GetRunID())
+		// reset computes the reset point in dry run mode, so it handles DryRun by itself
+		if batchParams.DryRun && batchParams.BatchType != BatchTypeReset {
+			getActivityLogger(ctx).Info("Dry run of batch operation",
+				tag.WorkflowID(wf.GetWorkflowID()),
+				tag.WorkflowRunID(wf.GetRunID()))
+		} else {
+			err = procFn(wf.GetWorkflowID(), wf.GetRunID())
+		}
 		if err != nil {
 			// EntityNotExistsError means wf is not running or deleted
 			if _, ok := err.(*types.EntityNotExistsError); ok {
@@ -476,6 +552,41 @@ func processTask(
 	return nil
 }
 
+func upsertSearchAttributes(
+	ctx context.Context,
+	client history.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+	indexedFields, err := encodeSearchAttributes(batchParams.UpsertSearchAttributesParams.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	return client.UpsertWorkflowSearchAttributes(ctx, &types.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID: batchParams._domainID,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: indexedFields,
+		},
+	})
+}
+
+func encodeSearchAttributes(searchAttributes map[string]interface{}) (map[string][]byte, error) {
+	indexedFields := make(map[string][]byte, len(searchAttributes))
+	for key, value := range searchAttributes {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode search attribute %v: %v", key, err)
+		}
+		indexedFields[key] = data
+	}
+	return indexedFields, nil
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
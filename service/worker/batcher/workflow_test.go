@@ -0,0 +1,353 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+type batcherWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestBatcherWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(batcherWorkflowTestSuite))
+}
+
+func (s *batcherWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	// the batch workflow and activity are registered globally by the package
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+}
+
+func (s *batcherWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *batcherWorkflowTestSuite) TestValidateParams() {
+	params := BatchParams{}
+	s.Error(validateParams(params))
+	params.DomainName = "d"
+	params.Query = "q"
+	params.Reason = "r"
+	params.BatchType = "unknown"
+	s.Error(validateParams(params))
+	params.BatchType = BatchTypeReset
+	s.Error(validateParams(params))
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.Error(validateParams(params))
+	params.ResetParams.BadBinaryChecksum = "checksum"
+	s.NoError(validateParams(params))
+	params.ResetParams.ResetType = ResetTypeDecisionCompletedTime
+	s.Error(validateParams(params))
+	params.ResetParams.EarliestTime = time.Now().UnixNano()
+	s.NoError(validateParams(params))
+	params.ResetParams.ResetType = ResetTypeLastDecisionCompleted
+	s.NoError(validateParams(params))
+	params.BatchType = BatchTypeUpsertSearchAttributes
+	s.Error(validateParams(params))
+	params.UpsertSearchAttributesParams.SearchAttributes = map[string]interface{}{"CustomKeywordField": "value"}
+	s.NoError(validateParams(params))
+}
+
+func (s *batcherWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(BatchWFTypeName, BatchParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *batcherWorkflowTestSuite) TestWorkflow_Reset() {
+	s.workflowEnv.OnActivity(batchActivityName, mock.Anything, mock.Anything).Return(HeartBeatDetails{SuccessCount: 1}, nil)
+	s.workflowEnv.ExecuteWorkflow(BatchWFTypeName, s.newResetParams(ResetTypeLastDecisionCompleted, false))
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var result HeartBeatDetails
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(1, result.SuccessCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestResetActivity_LastDecisionCompleted() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, true)
+	s.mockHistory(mockResource)
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.ResetWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error) {
+			s.Equal("wid", request.WorkflowExecution.GetWorkflowID())
+			s.Equal("rid", request.WorkflowExecution.GetRunID())
+			s.Equal(int64(7), request.GetDecisionFinishEventID())
+			s.Equal("r", request.GetReason())
+			return &types.ResetWorkflowExecutionResponse{RunID: "new-rid"}, nil
+		})
+
+	result := s.executeActivity(env, s.newResetParams(ResetTypeLastDecisionCompleted, false))
+	s.Equal(1, result.SuccessCount)
+	s.Equal(0, result.ErrorCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestResetActivity_FirstDecisionScheduled() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, true)
+	s.mockHistory(mockResource)
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.ResetWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error) {
+			// the decision finish event ID is exclusive, so it points right after the scheduled event
+			s.Equal(int64(3), request.GetDecisionFinishEventID())
+			return &types.ResetWorkflowExecutionResponse{RunID: "new-rid"}, nil
+		})
+
+	result := s.executeActivity(env, s.newResetParams(ResetTypeFirstDecisionScheduled, false))
+	s.Equal(1, result.SuccessCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestResetActivity_SkipCurrentOpen() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, false)
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	params := s.newResetParams(ResetTypeLastDecisionCompleted, false)
+	params.ResetParams.SkipCurrentOpen = true
+	result := s.executeActivity(env, params)
+	s.Equal(1, result.SuccessCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestResetActivity_NoResetPoint() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, true)
+	mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&types.GetWorkflowExecutionHistoryResponse{History: &types.History{}}, nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	params := s.newResetParams(ResetTypeLastDecisionCompleted, false)
+	params.AttemptsOnRetryableError = 1
+	result := s.executeActivity(env, params)
+	s.Equal(0, result.SuccessCount)
+	s.Equal(1, result.ErrorCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestResetActivity_DryRun() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, true)
+	// the reset point is still resolved in dry run mode
+	s.mockHistory(mockResource)
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result := s.executeActivity(env, s.newResetParams(ResetTypeLastDecisionCompleted, true))
+	s.Equal(1, result.SuccessCount)
+	s.Equal(0, result.ErrorCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestTerminateActivity_DryRun() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, false)
+	mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	params := setDefaultParams(BatchParams{
+		DomainName: "d",
+		Query:      "q",
+		Reason:     "r",
+		BatchType:  BatchTypeTerminate,
+		DryRun:     true,
+	})
+	result := s.executeActivity(env, params)
+	s.Equal(1, result.SuccessCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestDeleteActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, false)
+	mockResource.FrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.DeleteWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal("d", request.GetDomain())
+			s.Equal("wid", request.WorkflowExecution.GetWorkflowID())
+			s.Equal("rid", request.WorkflowExecution.GetRunID())
+			return nil
+		})
+
+	params := setDefaultParams(BatchParams{
+		DomainName: "d",
+		Query:      "q",
+		Reason:     "r",
+		BatchType:  BatchTypeDelete,
+	})
+	result := s.executeActivity(env, params)
+	s.Equal(1, result.SuccessCount)
+	s.Equal(0, result.ErrorCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestUpsertSearchAttributesActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	s.mockScan(mockResource)
+	s.mockDescribe(mockResource, false)
+	mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeDomainResponse{DomainInfo: &types.DomainInfo{Name: "d", UUID: "domain-id"}}, nil)
+	mockResource.HistoryClient.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.UpsertWorkflowSearchAttributesRequest, _ ...yarpc.CallOption) error {
+			s.Equal("domain-id", request.GetDomainUUID())
+			s.Equal("wid", request.WorkflowExecution.GetWorkflowID())
+			s.Equal("rid", request.WorkflowExecution.GetRunID())
+			s.Equal(map[string][]byte{"CustomKeywordField": []byte(`"value"`)}, request.SearchAttributes.GetIndexedFields())
+			return nil
+		})
+
+	params := setDefaultParams(BatchParams{
+		DomainName: "d",
+		Query:      "q",
+		Reason:     "r",
+		BatchType:  BatchTypeUpsertSearchAttributes,
+		UpsertSearchAttributesParams: UpsertSearchAttributesParams{
+			SearchAttributes: map[string]interface{}{"CustomKeywordField": "value"},
+		},
+	})
+	result := s.executeActivity(env, params)
+	s.Equal(1, result.SuccessCount)
+	s.Equal(0, result.ErrorCount)
+}
+
+func (s *batcherWorkflowTestSuite) newResetParams(resetType string, dryRun bool) BatchParams {
+	return setDefaultParams(BatchParams{
+		DomainName: "d",
+		Query:      "q",
+		Reason:     "r",
+		BatchType:  BatchTypeReset,
+		DryRun:     dryRun,
+		ResetParams: ResetParams{
+			ResetType: resetType,
+		},
+	})
+}
+
+func (s *batcherWorkflowTestSuite) executeActivity(env *testsuite.TestActivityEnvironment, params BatchParams) HeartBeatDetails {
+	value, err := env.ExecuteActivity(batchActivityName, params)
+	s.NoError(err)
+	var result HeartBeatDetails
+	s.NoError(value.Get(&result))
+	return result
+}
+
+func (s *batcherWorkflowTestSuite) mockScan(mockResource *resource.Test) {
+	mockResource.FrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.CountWorkflowExecutionsResponse{Count: 1}, nil)
+	mockResource.FrontendClient.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.ListWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{
+				{Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}},
+			},
+		}, nil)
+}
+
+func (s *batcherWorkflowTestSuite) mockDescribe(mockResource *resource.Test, closed bool) {
+	info := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}
+	if closed {
+		info.CloseStatus = types.WorkflowExecutionCloseStatusFailed.Ptr()
+	}
+	mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: info}, nil).AnyTimes()
+}
+
+func (s *batcherWorkflowTestSuite) mockHistory(mockResource *resource.Test) {
+	eventTypes := []types.EventType{
+		types.EventTypeWorkflowExecutionStarted,
+		types.EventTypeDecisionTaskScheduled,
+		types.EventTypeDecisionTaskStarted,
+		types.EventTypeDecisionTaskCompleted,
+		types.EventTypeDecisionTaskScheduled,
+		types.EventTypeDecisionTaskStarted,
+		types.EventTypeDecisionTaskCompleted,
+		types.EventTypeWorkflowExecutionFailed,
+	}
+	events := make([]*types.HistoryEvent, 0, len(eventTypes))
+	for i, eventType := range eventTypes {
+		events = append(events, &types.HistoryEvent{
+			EventID:   int64(i + 1),
+			Timestamp: common.Int64Ptr(int64(i + 1)),
+			EventType: eventType.Ptr(),
+		})
+	}
+	mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&types.GetWorkflowExecutionHistoryResponse{History: &types.History{Events: events}}, nil).AnyTimes()
+}
+
+func (s *batcherWorkflowTestSuite) prepareTestActivityEnv() (*testsuite.TestActivityEnvironment, *resource.Test, *gomock.Controller) {
+	controller := gomock.NewController(s.T())
+	mockResource := resource.NewTest(controller, metrics.Worker)
+
+	ctx := &Batcher{
+		svcClient:     mockResource.GetSDKClient(),
+		clientBean:    mockResource.ClientBean,
+		metricsClient: metrics.NewNoopMetricsClient(),
+		logger:        log.NewNoop(),
+	}
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), batcherContextKey, ctx),
+	})
+	return s.activityEnv, mockResource, controller
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// ScavengerHeartbeatDetails is the heartbeat detail for ArchivalRetentionScavengerActivity
+	ScavengerHeartbeatDetails struct {
+		NextPageToken []byte
+		CurrentPage   int
+		SkipCount     int
+		ErrorCount    int
+		SuccCount     int
+	}
+
+	// Scavenger is the type that holds the state for archival retention scavenger daemon
+	Scavenger struct {
+		domainManager    p.DomainManager
+		archiverProvider provider.ArchiverProvider
+		hbd              ScavengerHeartbeatDetails
+		limiter          *rate.Limiter
+		retentionInDays  dynamicconfig.IntPropertyFnWithDomainFilter
+		metrics          metrics.Client
+		logger           log.Logger
+		isInTest         bool
+	}
+
+	// domainArchivers holds the deleters of the archival stores used by a domain,
+	// historyDeleter is nil if the domain has no history archival URI
+	domainArchivers struct {
+		historyURI        archiver.URI
+		historyDeleter    archiver.HistoryDeleter
+		visibilityURI     archiver.URI
+		visibilityDeleter archiver.VisibilityDeleter
+	}
+)
+
+const (
+	domainPageSize    = 100
+	executionPageSize = 100
+)
+
+var errDeleteNotSupported = errors.New("archiver does not support deletion")
+
+// NewScavenger returns an instance of archival retention scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the domains in the system. For
+// each domain with a positive archival retention, the scavenger will
+//   - query the archived visibility records of workflows closed before the retention
+//   - delete the archived history and then the visibility records of each such workflow
+func NewScavenger(
+	domainManager p.DomainManager,
+	archiverProvider provider.ArchiverProvider,
+	rps int,
+	hbd ScavengerHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+	retentionInDays dynamicconfig.IntPropertyFnWithDomainFilter,
+) *Scavenger {
+
+	rateLimiter := rate.NewLimiter(rate.Limit(rps), rps)
+
+	return &Scavenger{
+		domainManager:    domainManager,
+		archiverProvider: archiverProvider,
+		hbd:              hbd,
+		limiter:          rateLimiter,
+		retentionInDays:  retentionInDays,
+		metrics:          metricsClient,
+		logger:           logger,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error) {
+	for {
+		resp, err := s.domainManager.ListDomains(ctx, &p.ListDomainsRequest{
+			PageSize:      domainPageSize,
+			NextPageToken: s.hbd.NextPageToken,
+		})
+		if err != nil {
+			return s.hbd, err
+		}
+
+		for _, domain := range resp.Domains {
+			if err := s.scavengeDomain(ctx, domain); err != nil {
+				return s.hbd, err
+			}
+		}
+
+		s.hbd.CurrentPage++
+		s.hbd.NextPageToken = resp.NextPageToken
+		s.recordHeartbeat(ctx)
+
+		if len(s.hbd.NextPageToken) == 0 {
+			break
+		}
+	}
+	return s.hbd, nil
+}
+
+// scavengeDomain deletes the expired archived workflows of the domain,
+// only an error which should stop the scavenger is returned
+func (s *Scavenger) scavengeDomain(ctx context.Context, domain *p.GetDomainResponse) error {
+	retentionInDays := s.retentionInDays(domain.Info.Name)
+	if retentionInDays <= 0 || domain.Config.VisibilityArchivalURI == "" {
+		return nil
+	}
+
+	logger := s.logger.WithTags(tag.WorkflowDomainID(domain.Info.ID), tag.WorkflowDomainName(domain.Info.Name))
+	archivers, err := s.getDomainArchivers(domain)
+	if err != nil {
+		s.hbd.SkipCount++
+		s.metrics.IncCounter(metrics.ArchivalRetentionScavengerScope, metrics.ArchivalRetentionScavengerSkipCount)
+		logger.Warn("archival retention scavenger: skipping domain", tag.Error(err))
+		return nil
+	}
+
+	request := &archiver.QueryExpiredVisibilityRequest{
+		DomainID:            domain.Info.ID,
+		ExpirationTimestamp: time.Now().Add(-common.DaysToDuration(int32(retentionInDays))).UnixNano(),
+		PageSize:            executionPageSize,
+	}
+	for {
+		resp, err := archivers.visibilityDeleter.QueryExpired(ctx, archivers.visibilityURI, request)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.hbd.ErrorCount++
+			s.metrics.IncCounter(metrics.ArchivalRetentionScavengerScope, metrics.ArchivalRetentionScavengerErrorCount)
+			logger.Error("archival retention scavenger: unable to query expired visibility records", tag.Error(err))
+			return nil
+		}
+
+		for _, execution := range resp.Executions {
+			if err := s.limiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			workflowLogger := logger.WithTags(
+				tag.WorkflowID(execution.Execution.GetWorkflowID()),
+				tag.WorkflowRunID(execution.Execution.GetRunID()),
+			)
+			if err := s.deleteExecution(ctx, domain.Info.ID, execution.Execution, archivers); err != nil {
+				s.hbd.ErrorCount++
+				s.metrics.IncCounter(metrics.ArchivalRetentionScavengerScope, metrics.ArchivalRetentionScavengerErrorCount)
+				workflowLogger.Error("archival retention scavenger: unable to delete archived workflow", tag.Error(err))
+				continue
+			}
+			s.hbd.SuccCount++
+			s.metrics.IncCounter(metrics.ArchivalRetentionScavengerScope, metrics.ArchivalRetentionScavengerSuccessCount)
+		}
+		s.recordHeartbeat(ctx)
+
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// deleteExecution deletes the archived history before the visibility records,
+// so that a failed deletion is retried the next time the scavenger runs
+func (s *Scavenger) deleteExecution(
+	ctx context.Context,
+	domainID string,
+	execution *types.WorkflowExecution,
+	archivers *domainArchivers,
+) error {
+	if archivers.historyDeleter != nil {
+		if err := archivers.historyDeleter.Delete(ctx, archivers.historyURI, &archiver.DeleteHistoryRequest{
+			DomainID:   domainID,
+			WorkflowID: execution.GetWorkflowID(),
+			RunID:      execution.GetRunID(),
+		}); err != nil {
+			return err
+		}
+	}
+	return archivers.visibilityDeleter.Delete(ctx, archivers.visibilityURI, &archiver.DeleteVisibilityRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+	})
+}
+
+func (s *Scavenger) getDomainArchivers(domain *p.GetDomainResponse) (*domainArchivers, error) {
+	archivers := &domainArchivers{}
+
+	visibilityURI, err := archiver.NewURI(domain.Config.VisibilityArchivalURI)
+	if err != nil {
+		return nil, err
+	}
+	visibilityArchiver, err := s.archiverProvider.GetVisibilityArchiver(visibilityURI.Scheme(), common.WorkerServiceName)
+	if err != nil {
+		return nil, err
+	}
+	visibilityDeleter, ok := visibilityArchiver.(archiver.VisibilityDeleter)
+	if !ok {
+		return nil, errDeleteNotSupported
+	}
+	archivers.visibilityURI = visibilityURI
+	archivers.visibilityDeleter = visibilityDeleter
+
+	if domain.Config.HistoryArchivalURI == "" {
+		return archivers, nil
+	}
+	historyURI, err := archiver.NewURI(domain.Config.HistoryArchivalURI)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := s.archiverProvider.GetHistoryArchiver(historyURI.Scheme(), common.WorkerServiceName)
+	if err != nil {
+		return nil, err
+	}
+	// deleting only the visibility records would leave the archived history behind without a way to find it
+	historyDeleter, ok := historyArchiver.(archiver.HistoryDeleter)
+	if !ok {
+		return nil, errDeleteNotSupported
+	}
+	archivers.historyURI = historyURI
+	archivers.historyDeleter = historyDeleter
+	return archivers, nil
+}
+
+func (s *Scavenger) recordHeartbeat(ctx context.Context) {
+	if !s.isInTest {
+		activity.RecordHeartbeat(ctx, s.hbd)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	scavengerSuite struct {
+		suite.Suite
+
+		controller        *gomock.Controller
+		domainManager     *p.MockDomainManager
+		archiverProvider  *provider.MockArchiverProvider
+		historyDeleter    *archiver.HistoryDeleterMock
+		visibilityDeleter *archiver.VisibilityDeleterMock
+		scavenger         *Scavenger
+	}
+
+	testHistoryArchiver struct {
+		*archiver.HistoryArchiverMock
+		*archiver.HistoryDeleterMock
+	}
+
+	testVisibilityArchiver struct {
+		*archiver.VisibilityArchiverMock
+		*archiver.VisibilityDeleterMock
+	}
+)
+
+const (
+	testHistoryURI    = "file:///tmp/history"
+	testVisibilityURI = "file:///tmp/visibility"
+)
+
+func TestScavengerSuite(t *testing.T) {
+	suite.Run(t, new(scavengerSuite))
+}
+
+func (s *scavengerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.domainManager = p.NewMockDomainManager(s.controller)
+	s.archiverProvider = &provider.MockArchiverProvider{}
+	s.historyDeleter = &archiver.HistoryDeleterMock{}
+	s.visibilityDeleter = &archiver.VisibilityDeleterMock{}
+
+	retentionInDays := func(domain string) int {
+		if domain == "no-retention-domain" {
+			return 0
+		}
+		return 30
+	}
+	s.scavenger = NewScavenger(
+		s.domainManager,
+		s.archiverProvider,
+		100,
+		ScavengerHeartbeatDetails{},
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+		retentionInDays,
+	)
+	s.scavenger.isInTest = true
+}
+
+func (s *scavengerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.archiverProvider.AssertExpectations(s.T())
+	s.historyDeleter.AssertExpectations(s.T())
+	s.visibilityDeleter.AssertExpectations(s.T())
+}
+
+func (s *scavengerSuite) TestRun() {
+	s.domainManager.EXPECT().ListDomains(gomock.Any(), &p.ListDomainsRequest{PageSize: domainPageSize}).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{
+			newTestDomain("no-retention-domain", testHistoryURI, testVisibilityURI),
+			newTestDomain("no-visibility-archival-domain", testHistoryURI, ""),
+			newTestDomain("test-domain", testHistoryURI, testVisibilityURI),
+		},
+		NextPageToken: []byte("next-page"),
+	}, nil)
+	s.domainManager.EXPECT().ListDomains(gomock.Any(), &p.ListDomainsRequest{PageSize: domainPageSize, NextPageToken: []byte("next-page")}).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{
+			newTestDomain("unsupported-domain", testHistoryURI, "unsupported:///tmp/visibility"),
+		},
+	}, nil)
+
+	s.archiverProvider.On("GetVisibilityArchiver", "file", common.WorkerServiceName).
+		Return(&testVisibilityArchiver{&archiver.VisibilityArchiverMock{}, s.visibilityDeleter}, nil)
+	s.archiverProvider.On("GetHistoryArchiver", "file", common.WorkerServiceName).
+		Return(&testHistoryArchiver{&archiver.HistoryArchiverMock{}, s.historyDeleter}, nil)
+	s.archiverProvider.On("GetVisibilityArchiver", "unsupported", common.WorkerServiceName).
+		Return(&archiver.VisibilityArchiverMock{}, nil)
+
+	expirationTime := time.Now().Add(-30 * 24 * time.Hour).UnixNano()
+	isFirstPage := func(request *archiver.QueryExpiredVisibilityRequest) bool {
+		return request.DomainID == "test-domain-id" && request.NextPageToken == nil && request.ExpirationTimestamp >= expirationTime
+	}
+	s.visibilityDeleter.On("QueryExpired", mock.Anything, mock.Anything, mock.MatchedBy(isFirstPage)).Return(&archiver.QueryVisibilityResponse{
+		Executions:    []*types.WorkflowExecutionInfo{newTestExecution("run-1"), newTestExecution("run-2")},
+		NextPageToken: []byte("next-page"),
+	}, nil).Once()
+	s.visibilityDeleter.On("QueryExpired", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.QueryExpiredVisibilityRequest) bool {
+		return string(request.NextPageToken) == "next-page"
+	})).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*types.WorkflowExecutionInfo{newTestExecution("run-3")},
+	}, nil).Once()
+
+	for _, runID := range []string{"run-1", "run-2", "run-3"} {
+		var err error
+		if runID == "run-2" {
+			err = errors.New("some random error")
+		}
+		s.historyDeleter.On("Delete", mock.Anything, mock.Anything, &archiver.DeleteHistoryRequest{
+			DomainID:   "test-domain-id",
+			WorkflowID: "test-workflow-id",
+			RunID:      runID,
+		}).Return(err).Once()
+		if err == nil {
+			s.visibilityDeleter.On("Delete", mock.Anything, mock.Anything, &archiver.DeleteVisibilityRequest{
+				DomainID:   "test-domain-id",
+				WorkflowID: "test-workflow-id",
+				RunID:      runID,
+			}).Return(nil).Once()
+		}
+	}
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.NoError(err)
+	s.Equal(ScavengerHeartbeatDetails{
+		CurrentPage: 2,
+		SkipCount:   1,
+		ErrorCount:  1,
+		SuccCount:   2,
+	}, hbd)
+}
+
+func (s *scavengerSuite) TestRun_QueryExpiredError() {
+	s.domainManager.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{
+			newTestDomain("test-domain", "", testVisibilityURI),
+		},
+	}, nil)
+	s.archiverProvider.On("GetVisibilityArchiver", "file", common.WorkerServiceName).
+		Return(&testVisibilityArchiver{&archiver.VisibilityArchiverMock{}, s.visibilityDeleter}, nil)
+	s.visibilityDeleter.On("QueryExpired", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("some random error")).Once()
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.NoError(err)
+	s.Equal(ScavengerHeartbeatDetails{
+		CurrentPage: 1,
+		ErrorCount:  1,
+	}, hbd)
+}
+
+func (s *scavengerSuite) TestRun_ListDomainsError() {
+	s.domainManager.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(nil, errors.New("some random error"))
+
+	_, err := s.scavenger.Run(context.Background())
+	s.Error(err)
+}
+
+func newTestDomain(name, historyURI, visibilityURI string) *p.GetDomainResponse {
+	return &p.GetDomainResponse{
+		Info: &p.DomainInfo{
+			ID:   name + "-id",
+			Name: name,
+		},
+		Config: &p.DomainConfig{
+			HistoryArchivalURI:    historyURI,
+			VisibilityArchivalURI: visibilityURI,
+		},
+	}
+}
+
+func newTestExecution(runID string) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      runID,
+		},
+	}
+}
@@ -67,6 +67,10 @@ type (
 		ClusterMetadata cluster.Metadata
 		// HistoryScannerEnabled indicates if history scanner should be started as part of scanner
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalRetentionScannerEnabled indicates if archival retention scanner should be started as part of scanner
+		ArchivalRetentionScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalRetentionInDays is the number of days archived workflows of a domain are kept, 0 keeps them forever
+		ArchivalRetentionInDays dynamicconfig.IntPropertyFnWithDomainFilter
 		// ShardScanners is a list of shard scanner configs
 		ShardScanners              []*shardscanner.ScannerConfig
 		MaxWorkflowRetentionInDays dynamicconfig.IntPropertyFn
@@ -150,6 +154,13 @@ func (s *Scanner) Start() error {
 			historyScannerWFTypeName)
 		workerTaskListNames = append(workerTaskListNames, historyScannerTaskListName)
 	}
+	if s.context.cfg.ArchivalRetentionScannerEnabled() {
+		ctx = s.startScanner(
+			ctx,
+			archivalRetentionScannerWFStartOptions,
+			archivalRetentionScannerWFTypeName)
+		workerTaskListNames = append(workerTaskListNames, archivalRetentionScannerTaskListName)
+	}
 
 	workerOpts := worker.Options{
 		Logger:                                 s.zapLogger,
@@ -30,6 +30,7 @@ import (
 	"go.uber.org/cadence/workflow"
 
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/worker/scanner/archival"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/history"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
@@ -50,6 +51,11 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+
+	archivalRetentionScannerWFID           = "cadence-sys-archival-retention-scanner"
+	archivalRetentionScannerWFTypeName     = "cadence-sys-archival-retention-scanner-workflow"
+	archivalRetentionScannerTaskListName   = "cadence-sys-archival-retention-scanner-tasklist-0"
+	archivalRetentionScavengerActivityName = "cadence-sys-archival-retention-scanner-scvg-activity"
 )
 
 var (
@@ -81,6 +87,13 @@ var (
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 		CronSchedule:                 "0 */12 * * *",
 	}
+	archivalRetentionScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           archivalRetentionScannerWFID,
+		TaskList:                     archivalRetentionScannerTaskListName,
+		ExecutionStartToCloseTimeout: infiniteDuration,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 */12 * * *",
+	}
 )
 
 func init() {
@@ -90,6 +103,9 @@ func init() {
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 
+	workflow.RegisterWithOptions(ArchivalRetentionScannerWorkflow, workflow.RegisterOptions{Name: archivalRetentionScannerWFTypeName})
+	activity.RegisterWithOptions(ArchivalRetentionScavengerActivity, activity.RegisterOptions{Name: archivalRetentionScavengerActivityName})
+
 	workflow.RegisterWithOptions(executions.ConcreteScannerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.CurrentScannerWorkflow, workflow.RegisterOptions{Name: executions.CurrentExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.ConcreteFixerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsFixerWFTypeName})
@@ -119,6 +135,18 @@ func HistoryScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// ArchivalRetentionScannerWorkflow is the workflow that runs the archival retention scanner background daemon
+func ArchivalRetentionScannerWorkflow(
+	ctx workflow.Context,
+) error {
+
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		archivalRetentionScavengerActivityName,
+	)
+	return future.Get(ctx, nil)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -151,6 +179,38 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// ArchivalRetentionScavengerActivity is the activity that runs archival retention scavenger
+func ArchivalRetentionScavengerActivity(
+	activityCtx context.Context,
+) (archival.ScavengerHeartbeatDetails, error) {
+
+	ctx, err := getScannerContext(activityCtx)
+	if err != nil {
+		return archival.ScavengerHeartbeatDetails{}, err
+	}
+
+	rps := ctx.cfg.ScannerPersistenceMaxQPS()
+	res := ctx.resource
+
+	hbd := archival.ScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			res.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	scavenger := archival.NewScavenger(
+		res.GetDomainManager(),
+		res.GetArchiverProvider(),
+		rps,
+		hbd,
+		res.GetMetricsClient(),
+		res.GetLogger(),
+		ctx.cfg.ArchivalRetentionInDays,
+	)
+	return scavenger.Run(activityCtx)
+}
+
 // TaskListScavengerActivity is the activity that runs task list scavenger
 func TaskListScavengerActivity(
 	activityCtx context.Context,
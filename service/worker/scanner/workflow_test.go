@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/scanner/archival"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 
 	"go.uber.org/cadence/testsuite"
@@ -55,6 +56,14 @@ func (s *scannerWorkflowTestSuite) TestWorkflow() {
 	s.True(env.IsWorkflowCompleted())
 }
 
+func (s *scannerWorkflowTestSuite) TestArchivalRetentionScannerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(archivalRetentionScavengerActivityName, mock.Anything).Return(archival.ScavengerHeartbeatDetails{}, nil)
+	env.ExecuteWorkflow(archivalRetentionScannerWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
 func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	env := s.NewTestActivityEnvironment()
 	controller := gomock.NewController(s.T())
@@ -0,0 +1,116 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/cadence"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	startWorkflowRequest struct {
+		DomainName string
+		WorkflowID string
+		Action     StartWorkflowAction
+	}
+
+	executionRequest struct {
+		DomainName string
+		WorkflowID string
+		RunID      string
+	}
+)
+
+// startWorkflowActivity starts the workflow of a schedule action and returns its run ID
+func startWorkflowActivity(ctx context.Context, request startWorkflowRequest) (string, error) {
+	action := request.Action
+	resp, err := getClient(ctx).StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              request.DomainName,
+		WorkflowID:                          request.WorkflowID,
+		WorkflowType:                        &types.WorkflowType{Name: action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: action.TaskList},
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(action.ExecutionStartToCloseTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(action.TaskStartToCloseTimeout.Seconds())),
+		Identity:                            WorkflowTypeName,
+		// derived from the workflow ID so that a retried start returns the run started by the previous attempt
+		RequestID:             uuid.NewSHA1(uuid.NameSpaceOID, []byte(request.WorkflowID)).String(),
+		WorkflowIDReusePolicy: types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+	}, yarpc.WithHeader(common.EnforceDCRedirection, "true"))
+	if err != nil {
+		switch err.(type) {
+		case *types.BadRequestError, *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyStartedError:
+			return "", cadence.NewCustomError(errReasonNonRetryable, err.Error())
+		}
+		return "", err
+	}
+	return resp.GetRunID(), nil
+}
+
+// describeWorkflowActivity returns whether the workflow of a schedule action is still running
+func describeWorkflowActivity(ctx context.Context, request executionRequest) (bool, error) {
+	resp, err := getClient(ctx).DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: request.DomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+		},
+	})
+	if err != nil {
+		// EntityNotExistsError means wf is deleted
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	return info != nil && info.CloseStatus == nil, nil
+}
+
+// cancelWorkflowActivity requests cancellation of the workflow of a schedule action
+func cancelWorkflowActivity(ctx context.Context, request executionRequest) error {
+	err := getClient(ctx).RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+		Domain: request.DomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+		},
+		Identity:  WorkflowTypeName,
+		RequestID: uuid.New().String(),
+	}, yarpc.WithHeader(common.EnforceDCRedirection, "true"))
+	switch err.(type) {
+	case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.CancellationAlreadyRequestedError:
+		return nil
+	}
+	return err
+}
+
+func getClient(ctx context.Context) frontend.Client {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	return scheduler.clientBean.GetFrontendClient()
+}
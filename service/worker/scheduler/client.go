@@ -0,0 +1,190 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Client is used to manage schedules. Schedules belong to a domain, but their
+	// workflows run in the scheduler system domain. It backs the schedule APIs of
+	// the frontend, so its errors are the ones of the service.
+	Client interface {
+		CreateSchedule(ctx context.Context, params ScheduleParams) error
+		DescribeSchedule(ctx context.Context, domainName, scheduleID string) (*Description, error)
+		UpdateSchedule(ctx context.Context, domainName, scheduleID string, request UpdateRequest) error
+		PauseSchedule(ctx context.Context, domainName, scheduleID, reason string) error
+		UnpauseSchedule(ctx context.Context, domainName, scheduleID, reason string) error
+		TriggerSchedule(ctx context.Context, domainName, scheduleID string, request TriggerRequest) error
+		BackfillSchedule(ctx context.Context, domainName, scheduleID string, request BackfillRequest) error
+		DeleteSchedule(ctx context.Context, domainName, scheduleID string) error
+		// ListSchedules returns the IDs of the schedules of a domain
+		ListSchedules(ctx context.Context, domainName string, pageSize int32, nextPageToken []byte) ([]string, []byte, error)
+	}
+
+	clientImpl struct {
+		client cclient.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new schedule Client on top of a cadence client of the scheduler system domain
+func NewClient(svcClient cclient.Client) Client {
+	return &clientImpl{
+		client: svcClient,
+	}
+}
+
+// WorkflowID returns the ID of the workflow running a schedule
+func WorkflowID(domainName, scheduleID string) string {
+	return workflowIDPrefix(domainName) + scheduleID
+}
+
+func workflowIDPrefix(domainName string) string {
+	return fmt.Sprintf("%v:%v:", WorkflowTypeName, domainName)
+}
+
+func (c *clientImpl) CreateSchedule(ctx context.Context, params ScheduleParams) error {
+	if err := validateParams(&params); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	params.State = ScheduleState{}
+	options := cclient.StartWorkflowOptions{
+		ID:                           WorkflowID(params.DomainName, params.ScheduleID),
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		TaskList:                     TaskListName,
+		ExecutionStartToCloseTimeout: InfiniteDuration,
+	}
+	_, err := c.client.StartWorkflow(ctx, options, WorkflowTypeName, params)
+	return client.ToServiceError(err)
+}
+
+func (c *clientImpl) DescribeSchedule(ctx context.Context, domainName, scheduleID string) (*Description, error) {
+	value, err := c.client.QueryWorkflow(ctx, WorkflowID(domainName, scheduleID), "", QueryType)
+	if err != nil {
+		return nil, client.ToServiceError(err)
+	}
+	var description Description
+	if err := value.Get(&description); err != nil {
+		return nil, err
+	}
+	return &description, nil
+}
+
+func (c *clientImpl) UpdateSchedule(ctx context.Context, domainName, scheduleID string, request UpdateRequest) error {
+	if request.Spec != nil {
+		spec := *request.Spec
+		setDefaultSpec(&spec)
+		if err := validateSpec(spec); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	if request.Action != nil {
+		action := *request.Action
+		setDefaultAction(&action, scheduleID)
+		if err := validateAction(action); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	return c.signal(ctx, domainName, scheduleID, UpdateSignal, request)
+}
+
+func (c *clientImpl) PauseSchedule(ctx context.Context, domainName, scheduleID, reason string) error {
+	return c.signal(ctx, domainName, scheduleID, PauseSignal, PauseRequest{Reason: reason})
+}
+
+func (c *clientImpl) UnpauseSchedule(ctx context.Context, domainName, scheduleID, reason string) error {
+	return c.signal(ctx, domainName, scheduleID, UnpauseSignal, PauseRequest{Reason: reason})
+}
+
+func (c *clientImpl) TriggerSchedule(ctx context.Context, domainName, scheduleID string, request TriggerRequest) error {
+	if request.OverlapPolicy != "" {
+		if err := validateOverlapPolicy(request.OverlapPolicy); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	return c.signal(ctx, domainName, scheduleID, TriggerSignal, request)
+}
+
+func (c *clientImpl) BackfillSchedule(ctx context.Context, domainName, scheduleID string, request BackfillRequest) error {
+	if request.StartTime.IsZero() || request.EndTime.Before(request.StartTime) {
+		return &types.BadRequestError{Message: "must provide start time no later than end time"}
+	}
+	if request.OverlapPolicy != "" {
+		if err := validateOverlapPolicy(request.OverlapPolicy); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	return c.signal(ctx, domainName, scheduleID, BackfillSignal, request)
+}
+
+func (c *clientImpl) DeleteSchedule(ctx context.Context, domainName, scheduleID string) error {
+	return c.signal(ctx, domainName, scheduleID, DeleteSignal, nil)
+}
+
+func (c *clientImpl) ListSchedules(
+	ctx context.Context,
+	domainName string,
+	pageSize int32,
+	nextPageToken []byte,
+) ([]string, []byte, error) {
+	resp, err := c.client.ListOpenWorkflow(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(common.SchedulerLocalDomainName),
+		MaximumPageSize: common.Int32Ptr(pageSize),
+		NextPageToken:   nextPageToken,
+		StartTimeFilter: &shared.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+		TypeFilter: &shared.WorkflowTypeFilter{
+			Name: common.StringPtr(WorkflowTypeName),
+		},
+	})
+	if err != nil {
+		return nil, nil, client.ToServiceError(err)
+	}
+	// schedules of all domains share the system domain, so a page may contain fewer than pageSize results
+	prefix := workflowIDPrefix(domainName)
+	var scheduleIDs []string
+	for _, execution := range resp.GetExecutions() {
+		workflowID := execution.GetExecution().GetWorkflowId()
+		if strings.HasPrefix(workflowID, prefix) {
+			scheduleIDs = append(scheduleIDs, strings.TrimPrefix(workflowID, prefix))
+		}
+	}
+	return scheduleIDs, resp.GetNextPageToken(), nil
+}
+
+func (c *clientImpl) signal(ctx context.Context, domainName, scheduleID, signalName string, arg interface{}) error {
+	return client.ToServiceError(c.client.SignalWorkflow(ctx, WorkflowID(domainName, scheduleID), "", signalName, arg))
+}
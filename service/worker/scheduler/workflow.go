@@ -0,0 +1,668 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	schedulerContextKey contextKey = "schedulerContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type
+	WorkflowTypeName             = "cadence-sys-schedule-workflow"
+	startWorkflowActivityName    = "cadence-sys-schedule-startWorkflow-activity"
+	describeWorkflowActivityName = "cadence-sys-schedule-describeWorkflow-activity"
+	cancelWorkflowActivityName   = "cadence-sys-schedule-cancelWorkflow-activity"
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+
+	// QueryType for describing a schedule
+	QueryType = "describe"
+	// UpdateSignal signal name for updating the spec or action of a schedule
+	UpdateSignal = "update"
+	// PauseSignal signal name for pause
+	PauseSignal = "pause"
+	// UnpauseSignal signal name for unpause
+	UnpauseSignal = "unpause"
+	// TriggerSignal signal name for taking an action immediately
+	TriggerSignal = "trigger"
+	// BackfillSignal signal name for taking the actions of a past time range
+	BackfillSignal = "backfill"
+	// DeleteSignal signal name for delete
+	DeleteSignal = "delete"
+
+	// DefaultCatchupWindow is the default value for CatchupWindow
+	DefaultCatchupWindow = 10 * time.Minute
+	// DefaultTaskStartToCloseTimeout is the default value for TaskStartToCloseTimeout
+	DefaultTaskStartToCloseTimeout = 10 * time.Second
+
+	// maximum number of actions waiting for the running workflow with OverlapPolicyBuffer
+	maxBufferedActions = 100
+	// maximum number of actions taken in one iteration, or by one backfill request
+	maxActionsPerIteration = 100
+	// maximum number of actions dropped in one iteration because they are out of the catch-up window
+	maxMissedActionsPerIteration = 10000
+	maxRecentActions             = 10
+	numNextActionTimes           = 5
+	// number of iterations before continue as new, which keeps the history size bounded
+	maxIterationsBeforeContinueAsNew = 500
+	// how often to check whether buffered actions can be taken
+	bufferCheckInterval = time.Minute
+
+	errReasonNonRetryable = "cadence-sys-schedule-non-retryable-error"
+)
+
+const (
+	// OverlapPolicySkip skips the action if the previous workflow is still running
+	OverlapPolicySkip = "skip"
+	// OverlapPolicyBuffer takes the action after the previous workflow closes
+	OverlapPolicyBuffer = "buffer"
+	// OverlapPolicyCancelOther requests cancellation of the previous workflow and takes the action
+	OverlapPolicyCancelOther = "cancel-other"
+	// OverlapPolicyAllowAll takes the action regardless of the previous workflow
+	OverlapPolicyAllowAll = "allow-all"
+)
+
+// AllOverlapPolicies is the overlap policies we supported
+var AllOverlapPolicies = []string{OverlapPolicySkip, OverlapPolicyBuffer, OverlapPolicyCancelOther, OverlapPolicyAllowAll}
+
+type (
+	// ScheduleSpec describes when a schedule takes actions
+	ScheduleSpec struct {
+		// CronSchedule is a standard cron expression, evaluated in UTC
+		CronSchedule string
+		// StartTime and EndTime optionally bound the times at which actions are taken
+		StartTime time.Time
+		EndTime   time.Time
+		// OverlapPolicy decides what to do when an action is due while the previous workflow is still running.
+		// Default to OverlapPolicySkip
+		OverlapPolicy string
+		// CatchupWindow is how late an action can be taken, e.g. after an outage of the scheduler.
+		// Actions missed for longer are dropped. Default to DefaultCatchupWindow
+		CatchupWindow time.Duration
+	}
+
+	// StartWorkflowAction is the workflow started every time a schedule takes an action
+	StartWorkflowAction struct {
+		WorkflowType string
+		TaskList     string
+		Input        []byte
+		// WorkflowIDPrefix is combined with the scheduled time and a sequence number to form the workflow ID. Default to the schedule ID
+		WorkflowIDPrefix             string
+		ExecutionStartToCloseTimeout time.Duration
+		// Default to DefaultTaskStartToCloseTimeout
+		TaskStartToCloseTimeout time.Duration
+	}
+
+	// ScheduleParams is the parameters for schedule workflow
+	ScheduleParams struct {
+		// DomainName is the domain owning the schedule, workflows are started in it
+		DomainName string
+		// ScheduleID identifies the schedule in its domain
+		ScheduleID string
+		Spec       ScheduleSpec
+		Action     StartWorkflowAction
+		// State carries the progress of the schedule across continue as new, leave it empty when creating a schedule
+		State ScheduleState
+	}
+
+	// ScheduleState is the progress of a schedule
+	ScheduleState struct {
+		Paused bool
+		// Notes is the reason given with the last pause or unpause
+		Notes string
+		// LastProcessedTime is the last scheduled time which has been processed, actions due after it are pending
+		LastProcessedTime time.Time
+		// LastAction is the most recently started workflow, which overlap policies apply to
+		LastAction *ScheduleAction
+		// RecentActions are the most recently started workflows, newest last
+		RecentActions []ScheduleAction
+		// BufferedActions are the scheduled times waiting for LastAction to close
+		BufferedActions []time.Time
+		// TotalActions is the number of workflows started
+		TotalActions int64
+		// SkippedActions is the number of actions skipped because of the overlap policy
+		SkippedActions int64
+		// MissedCatchupWindow is the number of actions dropped because they are out of the catch-up window
+		MissedCatchupWindow int64
+		// FailedActions is the number of workflows which failed to start
+		FailedActions int64
+		// ActionSequence is the number of start attempts, it keeps the workflow IDs of actions
+		// with the same scheduled time, e.g. triggers in the same second, apart
+		ActionSequence int64
+	}
+
+	// ScheduleAction is a workflow started by a schedule
+	ScheduleAction struct {
+		ScheduledTime time.Time
+		ActualTime    time.Time
+		WorkflowID    string
+		RunID         string
+	}
+
+	// Description is the result of the describe query
+	Description struct {
+		ScheduleParams
+		// NextActionTimes are the upcoming scheduled times
+		NextActionTimes []time.Time
+	}
+
+	// UpdateRequest is the arg for UpdateSignal, nil fields are left unchanged
+	UpdateRequest struct {
+		Spec   *ScheduleSpec
+		Action *StartWorkflowAction
+	}
+
+	// PauseRequest is the arg for PauseSignal and UnpauseSignal
+	PauseRequest struct {
+		Reason string
+	}
+
+	// TriggerRequest is the arg for TriggerSignal
+	TriggerRequest struct {
+		// OverlapPolicy overrides the one of the schedule if set
+		OverlapPolicy string
+	}
+
+	// BackfillRequest is the arg for BackfillSignal
+	BackfillRequest struct {
+		StartTime time.Time
+		EndTime   time.Time
+		// OverlapPolicy overrides the one of the schedule if set
+		OverlapPolicy string
+	}
+
+	scheduleRunner struct {
+		ctx         workflow.Context
+		activityCtx workflow.Context
+		logger      *zap.Logger
+		params      ScheduleParams
+		schedule    cron.Schedule
+		handlers    []signalHandler
+		deleted     bool
+	}
+
+	signalHandler struct {
+		ch workflow.Channel
+		// receive handles one signal, it returns false when async is set and there is no signal
+		receive func(ch workflow.Channel, async bool) bool
+	}
+)
+
+var (
+	scheduleActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    10 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          time.Minute,
+			ExpirationInterval:       10 * time.Minute,
+			NonRetriableErrorReasons: []string{errReasonNonRetryable},
+		},
+	}
+)
+
+// ScheduleWorkflow is the workflow that takes the actions of a schedule until the schedule is deleted
+func ScheduleWorkflow(ctx workflow.Context, params ScheduleParams) error {
+	if err := validateParams(&params); err != nil {
+		return err
+	}
+	schedule, err := cron.ParseStandard(params.Spec.CronSchedule)
+	if err != nil {
+		return err
+	}
+	r := &scheduleRunner{
+		ctx:         ctx,
+		activityCtx: workflow.WithActivityOptions(ctx, scheduleActivityOptions),
+		logger: workflow.GetLogger(ctx).With(
+			zap.String("schedule-domain", params.DomainName),
+			zap.String("schedule-id", params.ScheduleID),
+		),
+		params:   params,
+		schedule: schedule,
+	}
+	r.registerSignalHandlers()
+	if err := workflow.SetQueryHandler(ctx, QueryType, r.describe); err != nil {
+		return err
+	}
+	if r.params.State.LastProcessedTime.IsZero() {
+		r.params.State.LastProcessedTime = workflow.Now(ctx)
+	}
+
+	for i := 0; i < maxIterationsBeforeContinueAsNew; i++ {
+		r.run()
+		r.wait()
+		if r.deleted {
+			return nil
+		}
+	}
+
+	// signals not received yet would be lost with continue as new
+	r.drainSignals()
+	if r.deleted {
+		return nil
+	}
+	return workflow.NewContinueAsNewError(ctx, WorkflowTypeName, r.params)
+}
+
+func (r *scheduleRunner) registerSignalHandlers() {
+	r.handlers = []signalHandler{
+		{
+			ch: workflow.GetSignalChannel(r.ctx, UpdateSignal),
+			receive: func(ch workflow.Channel, async bool) bool {
+				var request UpdateRequest
+				if !r.receive(ch, async, &request) {
+					return false
+				}
+				r.update(request)
+				return true
+			},
+		},
+		{
+			ch: workflow.GetSignalChannel(r.ctx, PauseSignal),
+			receive: func(ch workflow.Channel, async bool) bool {
+				var request PauseRequest
+				if !r.receive(ch, async, &request) {
+					return false
+				}
+				r.params.State.Paused = true
+				r.params.State.Notes = request.Reason
+				return true
+			},
+		},
+		{
+			ch: workflow.GetSignalChannel(r.ctx, UnpauseSignal),
+			receive: func(ch workflow.Channel, async bool) bool {
+				var request PauseRequest
+				if !r.receive(ch, async, &request) {
+					return false
+				}
+				if r.params.State.Paused {
+					r.params.State.Paused = false
+					r.params.State.Notes = request.Reason
+					// actions due while paused are not taken
+					r.params.State.LastProcessedTime = workflow.Now(r.ctx)
+				}
+				return true
+			},
+		},
+		{
+			ch: workflow.GetSignalChannel(r.ctx, TriggerSignal),
+			receive: func(ch workflow.Channel, async bool) bool {
+				var request TriggerRequest
+				if !r.receive(ch, async, &request) {
+					return false
+				}
+				r.takeAction(workflow.Now(r.ctx), r.overlapPolicy(request.OverlapPolicy))
+				return true
+			},
+		},
+		{
+			ch: workflow.GetSignalChannel(r.ctx, BackfillSignal),
+			receive: func(ch workflow.Channel, async bool) bool {
+				var request BackfillRequest
+				if !r.receive(ch, async, &request) {
+					return false
+				}
+				r.backfill(request)
+				return true
+			},
+		},
+		{
+			ch: workflow.GetSignalChannel(r.ctx, DeleteSignal),
+			receive: func(ch workflow.Channel, async bool) bool {
+				if !r.receive(ch, async, nil) {
+					return false
+				}
+				r.deleted = true
+				return true
+			},
+		},
+	}
+}
+
+func (r *scheduleRunner) receive(ch workflow.Channel, async bool, valuePtr interface{}) bool {
+	if async {
+		return ch.ReceiveAsync(valuePtr)
+	}
+	ch.Receive(r.ctx, valuePtr)
+	return true
+}
+
+func (r *scheduleRunner) drainSignals() {
+	for _, h := range r.handlers {
+		for h.receive(h.ch, true) {
+		}
+	}
+}
+
+// run takes the buffered actions and the actions which are due
+func (r *scheduleRunner) run() {
+	if r.params.State.Paused {
+		return
+	}
+	r.takeBufferedAction()
+
+	state := &r.params.State
+	now := workflow.Now(r.ctx)
+	catchupStart := now.Add(-r.params.Spec.CatchupWindow)
+	for i := 0; i < maxMissedActionsPerIteration; i++ {
+		next := r.nextActionTime(state.LastProcessedTime)
+		if next.IsZero() || !next.Before(catchupStart) {
+			break
+		}
+		state.LastProcessedTime = next
+		state.MissedCatchupWindow++
+	}
+	if state.LastProcessedTime.Before(catchupStart) {
+		state.LastProcessedTime = catchupStart
+	}
+
+	for i := 0; i < maxActionsPerIteration; i++ {
+		next := r.nextActionTime(state.LastProcessedTime)
+		if next.IsZero() || next.After(now) {
+			return
+		}
+		state.LastProcessedTime = next
+		r.takeAction(next, r.params.Spec.OverlapPolicy)
+	}
+}
+
+// wait blocks until the next action is due, a buffered action may be taken, or a signal is received
+func (r *scheduleRunner) wait() {
+	ctx, cancel := workflow.WithCancel(r.ctx)
+	defer cancel()
+
+	selector := workflow.NewSelector(ctx)
+	for _, h := range r.handlers {
+		h := h
+		selector.AddReceive(h.ch, func(ch workflow.Channel, more bool) {
+			h.receive(ch, false)
+		})
+	}
+
+	if !r.params.State.Paused {
+		timeout := time.Duration(-1)
+		if next := r.nextActionTime(r.params.State.LastProcessedTime); !next.IsZero() {
+			timeout = next.Sub(workflow.Now(ctx))
+			if timeout < 0 {
+				timeout = 0
+			}
+		}
+		if len(r.params.State.BufferedActions) > 0 && (timeout < 0 || timeout > bufferCheckInterval) {
+			timeout = bufferCheckInterval
+		}
+		if timeout >= 0 {
+			selector.AddFuture(workflow.NewTimer(ctx, timeout), func(f workflow.Future) {})
+		}
+	}
+
+	selector.Select(ctx)
+}
+
+// nextActionTime returns the first scheduled time after the given time, or zero time if there is none
+func (r *scheduleRunner) nextActionTime(after time.Time) time.Time {
+	spec := r.params.Spec
+	if after.Before(spec.StartTime) {
+		after = spec.StartTime.Add(-time.Second)
+	}
+	next := r.schedule.Next(after.UTC())
+	if !spec.EndTime.IsZero() && next.After(spec.EndTime) {
+		return time.Time{}
+	}
+	return next
+}
+
+func (r *scheduleRunner) takeAction(scheduledTime time.Time, overlapPolicy string) {
+	state := &r.params.State
+	switch overlapPolicy {
+	case OverlapPolicyAllowAll:
+	case OverlapPolicyBuffer:
+		// keep the buffered actions in order even if the last workflow has closed
+		if len(state.BufferedActions) > 0 || r.isLastActionRunning() {
+			if len(state.BufferedActions) >= maxBufferedActions {
+				state.SkippedActions++
+				return
+			}
+			state.BufferedActions = append(state.BufferedActions, scheduledTime)
+			return
+		}
+	case OverlapPolicyCancelOther:
+		if r.isLastActionRunning() {
+			r.cancelLastAction()
+		}
+	default:
+		if r.isLastActionRunning() {
+			state.SkippedActions++
+			return
+		}
+	}
+	r.startWorkflow(scheduledTime)
+}
+
+func (r *scheduleRunner) takeBufferedAction() {
+	state := &r.params.State
+	if len(state.BufferedActions) == 0 || r.isLastActionRunning() {
+		return
+	}
+	scheduledTime := state.BufferedActions[0]
+	state.BufferedActions = state.BufferedActions[1:]
+	r.startWorkflow(scheduledTime)
+}
+
+func (r *scheduleRunner) backfill(request BackfillRequest) {
+	overlapPolicy := r.overlapPolicy(request.OverlapPolicy)
+	scheduledTime := request.StartTime.Add(-time.Second)
+	for i := 0; i < maxActionsPerIteration; i++ {
+		scheduledTime = r.schedule.Next(scheduledTime.UTC())
+		if scheduledTime.IsZero() || scheduledTime.After(request.EndTime) {
+			return
+		}
+		r.takeAction(scheduledTime, overlapPolicy)
+	}
+	r.logger.Warn("Backfill stopped at the maximum number of actions", zap.Time("last-scheduled-time", scheduledTime))
+}
+
+func (r *scheduleRunner) update(request UpdateRequest) {
+	if request.Spec != nil {
+		spec := *request.Spec
+		setDefaultSpec(&spec)
+		if err := validateSpec(spec); err != nil {
+			r.logger.Warn("Ignored invalid schedule spec", zap.Error(err))
+		} else {
+			r.params.Spec = spec
+			r.schedule, _ = cron.ParseStandard(spec.CronSchedule)
+			// the new spec applies from now on
+			r.params.State.LastProcessedTime = workflow.Now(r.ctx)
+		}
+	}
+	if request.Action != nil {
+		action := *request.Action
+		setDefaultAction(&action, r.params.ScheduleID)
+		if err := validateAction(action); err != nil {
+			r.logger.Warn("Ignored invalid schedule action", zap.Error(err))
+		} else {
+			r.params.Action = action
+		}
+	}
+}
+
+func (r *scheduleRunner) overlapPolicy(override string) string {
+	if override != "" && validateOverlapPolicy(override) == nil {
+		return override
+	}
+	return r.params.Spec.OverlapPolicy
+}
+
+func (r *scheduleRunner) isLastActionRunning() bool {
+	lastAction := r.params.State.LastAction
+	if lastAction == nil {
+		return false
+	}
+	var running bool
+	err := workflow.ExecuteActivity(r.activityCtx, describeWorkflowActivityName, executionRequest{
+		DomainName: r.params.DomainName,
+		WorkflowID: lastAction.WorkflowID,
+		RunID:      lastAction.RunID,
+	}).Get(r.ctx, &running)
+	if err != nil {
+		// assume it is running so that workflows don't overlap
+		r.logger.Error("Failed to describe last started workflow", zap.Error(err))
+		return true
+	}
+	return running
+}
+
+func (r *scheduleRunner) cancelLastAction() {
+	lastAction := r.params.State.LastAction
+	err := workflow.ExecuteActivity(r.activityCtx, cancelWorkflowActivityName, executionRequest{
+		DomainName: r.params.DomainName,
+		WorkflowID: lastAction.WorkflowID,
+		RunID:      lastAction.RunID,
+	}).Get(r.ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to cancel last started workflow", zap.Error(err))
+	}
+}
+
+func (r *scheduleRunner) startWorkflow(scheduledTime time.Time) {
+	state := &r.params.State
+	// the workflow ID is derived from the scheduled time and the sequence carried in the state, so that
+	// retries of the start activity are deduplicated while every action gets its own workflow
+	state.ActionSequence++
+	workflowID := fmt.Sprintf("%v-%v-%v", r.params.Action.WorkflowIDPrefix, scheduledTime.UTC().Format(time.RFC3339), state.ActionSequence)
+	var runID string
+	err := workflow.ExecuteActivity(r.activityCtx, startWorkflowActivityName, startWorkflowRequest{
+		DomainName: r.params.DomainName,
+		WorkflowID: workflowID,
+		Action:     r.params.Action,
+	}).Get(r.ctx, &runID)
+	if err != nil {
+		state.FailedActions++
+		r.logger.Error("Failed to start workflow", zap.String("workflow-id", workflowID), zap.Error(err))
+		return
+	}
+
+	action := ScheduleAction{
+		ScheduledTime: scheduledTime,
+		ActualTime:    workflow.Now(r.ctx),
+		WorkflowID:    workflowID,
+		RunID:         runID,
+	}
+	state.LastAction = &action
+	state.RecentActions = append(state.RecentActions, action)
+	if len(state.RecentActions) > maxRecentActions {
+		state.RecentActions = state.RecentActions[len(state.RecentActions)-maxRecentActions:]
+	}
+	state.TotalActions++
+}
+
+func (r *scheduleRunner) describe() (*Description, error) {
+	var nextActionTimes []time.Time
+	next := r.params.State.LastProcessedTime
+	for i := 0; i < numNextActionTimes; i++ {
+		next = r.nextActionTime(next)
+		if next.IsZero() {
+			break
+		}
+		nextActionTimes = append(nextActionTimes, next)
+	}
+	return &Description{
+		ScheduleParams:  r.params,
+		NextActionTimes: nextActionTimes,
+	}, nil
+}
+
+func validateParams(params *ScheduleParams) error {
+	if params.DomainName == "" || params.ScheduleID == "" {
+		return errors.New("must provide required parameters: DomainName/ScheduleID")
+	}
+	setDefaultSpec(&params.Spec)
+	setDefaultAction(&params.Action, params.ScheduleID)
+	if err := validateSpec(params.Spec); err != nil {
+		return err
+	}
+	return validateAction(params.Action)
+}
+
+func setDefaultSpec(spec *ScheduleSpec) {
+	if spec.OverlapPolicy == "" {
+		spec.OverlapPolicy = OverlapPolicySkip
+	}
+	if spec.CatchupWindow <= 0 {
+		spec.CatchupWindow = DefaultCatchupWindow
+	}
+}
+
+func setDefaultAction(action *StartWorkflowAction, scheduleID string) {
+	if action.WorkflowIDPrefix == "" {
+		action.WorkflowIDPrefix = scheduleID
+	}
+	if action.TaskStartToCloseTimeout <= 0 {
+		action.TaskStartToCloseTimeout = DefaultTaskStartToCloseTimeout
+	}
+}
+
+func validateSpec(spec ScheduleSpec) error {
+	if spec.CronSchedule == "" {
+		return errors.New("must provide cron schedule")
+	}
+	if _, err := cron.ParseStandard(spec.CronSchedule); err != nil {
+		return fmt.Errorf("invalid cron schedule: %v", err)
+	}
+	if !spec.EndTime.IsZero() && spec.EndTime.Before(spec.StartTime) {
+		return errors.New("end time must not be before start time")
+	}
+	return validateOverlapPolicy(spec.OverlapPolicy)
+}
+
+func validateAction(action StartWorkflowAction) error {
+	if action.WorkflowType == "" || action.TaskList == "" {
+		return errors.New("must provide required parameters: WorkflowType/TaskList")
+	}
+	if action.ExecutionStartToCloseTimeout < time.Second {
+		return errors.New("must provide execution start to close timeout of at least one second")
+	}
+	return nil
+}
+
+func validateOverlapPolicy(overlapPolicy string) error {
+	for _, p := range AllOverlapPolicies {
+		if p == overlapPolicy {
+			return nil
+		}
+	}
+	return fmt.Errorf("not supported overlap policy: %v", overlapPolicy)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type scheduleWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestScheduleWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(scheduleWorkflowTestSuite))
+}
+
+func (s *scheduleWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(startWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(describeWorkflowActivity, activity.RegisterOptions{Name: describeWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(cancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	s.workflowEnv.SetStartTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func (s *scheduleWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestValidateParams() {
+	params := &ScheduleParams{}
+	s.Error(validateParams(params))
+	params.DomainName = "d"
+	params.ScheduleID = "s"
+	s.Error(validateParams(params))
+	params.Spec.CronSchedule = "not a cron"
+	s.Error(validateParams(params))
+	params.Spec.CronSchedule = "*/10 * * * *"
+	s.Error(validateParams(params))
+	params.Action = StartWorkflowAction{
+		WorkflowType:                 "wt",
+		TaskList:                     "tl",
+		ExecutionStartToCloseTimeout: time.Minute,
+	}
+	s.NoError(validateParams(params))
+	s.Equal(OverlapPolicySkip, params.Spec.OverlapPolicy)
+	s.Equal(DefaultCatchupWindow, params.Spec.CatchupWindow)
+	s.Equal("s", params.Action.WorkflowIDPrefix)
+	params.Spec.OverlapPolicy = "unknown"
+	s.Error(validateParams(params))
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, ScheduleParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_TakesActionsUntilDeleted() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Times(3)
+	s.workflowEnv.OnActivity(describeWorkflowActivityName, mock.Anything, mock.Anything).Return(false, nil)
+
+	description := s.executeAndDescribe(s.newParams(OverlapPolicySkip), 35*time.Minute)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal(int64(3), description.State.TotalActions)
+	s.Equal("s-2021-01-01T00:30:00Z-3", description.State.LastAction.WorkflowID)
+	s.Equal(time.Date(2021, 1, 1, 0, 40, 0, 0, time.UTC), description.NextActionTimes[0].UTC())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapPolicySkip() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Once()
+	s.workflowEnv.OnActivity(describeWorkflowActivityName, mock.Anything, mock.Anything).Return(true, nil)
+
+	description := s.executeAndDescribe(s.newParams(OverlapPolicySkip), 35*time.Minute)
+	s.Equal(int64(1), description.State.TotalActions)
+	s.Equal(int64(2), description.State.SkippedActions)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapPolicyBuffer() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Once()
+	s.workflowEnv.OnActivity(describeWorkflowActivityName, mock.Anything, mock.Anything).Return(true, nil)
+
+	description := s.executeAndDescribe(s.newParams(OverlapPolicyBuffer), 35*time.Minute)
+	s.Equal(int64(1), description.State.TotalActions)
+	s.Equal([]time.Time{
+		time.Date(2021, 1, 1, 0, 20, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 30, 0, 0, time.UTC),
+	}, toUTC(description.State.BufferedActions))
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapPolicyCancelOther() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Times(3)
+	s.workflowEnv.OnActivity(describeWorkflowActivityName, mock.Anything, mock.Anything).Return(true, nil)
+	s.workflowEnv.OnActivity(cancelWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).Twice()
+
+	description := s.executeAndDescribe(s.newParams(OverlapPolicyCancelOther), 35*time.Minute)
+	s.Equal(int64(3), description.State.TotalActions)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_PauseAndTrigger() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(PauseSignal, PauseRequest{Reason: "maintenance"})
+	}, time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(TriggerSignal, TriggerRequest{})
+	}, 2*time.Minute)
+
+	description := s.executeAndDescribe(s.newParams(OverlapPolicySkip), 35*time.Minute)
+	s.True(description.State.Paused)
+	s.Equal("maintenance", description.State.Notes)
+	s.Equal(int64(1), description.State.TotalActions)
+	s.Equal("s-2021-01-01T00:02:00Z-1", description.State.LastAction.WorkflowID)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_TriggersInSameSecond() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Twice()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(PauseSignal, PauseRequest{})
+	}, time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(TriggerSignal, TriggerRequest{OverlapPolicy: OverlapPolicyAllowAll})
+		s.workflowEnv.SignalWorkflow(TriggerSignal, TriggerRequest{OverlapPolicy: OverlapPolicyAllowAll})
+	}, 2*time.Minute)
+
+	description := s.executeAndDescribe(s.newParams(OverlapPolicySkip), 5*time.Minute)
+	s.Equal(int64(2), description.State.TotalActions)
+	s.Equal("s-2021-01-01T00:02:00Z-1", description.State.RecentActions[0].WorkflowID)
+	s.Equal("s-2021-01-01T00:02:00Z-2", description.State.RecentActions[1].WorkflowID)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Backfill() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Times(3)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(BackfillSignal, BackfillRequest{
+			StartTime:     time.Date(2020, 12, 31, 23, 30, 0, 0, time.UTC),
+			EndTime:       time.Date(2020, 12, 31, 23, 50, 0, 0, time.UTC),
+			OverlapPolicy: OverlapPolicyAllowAll,
+		})
+	}, time.Minute)
+
+	description := s.executeAndDescribe(s.newParams(OverlapPolicyAllowAll), 5*time.Minute)
+	s.Equal(int64(3), description.State.TotalActions)
+	s.Equal("s-2020-12-31T23:50:00Z-3", description.State.LastAction.WorkflowID)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_CatchupWindow() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return("rid", nil).Twice()
+
+	params := s.newParams(OverlapPolicyAllowAll)
+	// the schedule last ran an hour ago, only the actions within the catch-up window are taken
+	params.State.LastProcessedTime = time.Date(2020, 12, 31, 23, 0, 0, 0, time.UTC)
+	params.Spec.CatchupWindow = 15 * time.Minute
+
+	description := s.executeAndDescribe(params, 5*time.Minute)
+	s.Equal(int64(2), description.State.TotalActions)
+	s.Equal(int64(4), description.State.MissedCatchupWindow)
+	s.Equal(time.Date(2020, 12, 31, 23, 50, 0, 0, time.UTC), description.State.RecentActions[0].ScheduledTime.UTC())
+	s.Equal("s-2021-01-01T00:00:00Z-2", description.State.LastAction.WorkflowID)
+}
+
+func (s *scheduleWorkflowTestSuite) newParams(overlapPolicy string) ScheduleParams {
+	return ScheduleParams{
+		DomainName: "d",
+		ScheduleID: "s",
+		Spec: ScheduleSpec{
+			CronSchedule:  "*/10 * * * *",
+			OverlapPolicy: overlapPolicy,
+		},
+		Action: StartWorkflowAction{
+			WorkflowType:                 "wt",
+			TaskList:                     "tl",
+			ExecutionStartToCloseTimeout: time.Hour,
+		},
+	}
+}
+
+// executeAndDescribe runs the schedule workflow, describes it after the given duration and then deletes it
+func (s *scheduleWorkflowTestSuite) executeAndDescribe(params ScheduleParams, duration time.Duration) *Description {
+	var description Description
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		value, err := s.workflowEnv.QueryWorkflow(QueryType)
+		s.NoError(err)
+		s.NoError(value.Get(&description))
+		s.workflowEnv.SignalWorkflow(DeleteSignal, nil)
+	}, duration)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	return &description
+}
+
+func toUTC(times []time.Time) []time.Time {
+	result := make([]time.Time, 0, len(times))
+	for _, t := range times {
+		result = append(result, t.UTC())
+	}
+	return result
+}
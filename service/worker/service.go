@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scheduler"
 	"github.com/uber/cadence/service/worker/shadower"
 )
 
@@ -78,6 +79,7 @@ type (
 		PersistenceGlobalMaxQPS           dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS                 dynamicconfig.IntPropertyFn
 		EnableBatcher                     dynamicconfig.BoolPropertyFn
+		EnableScheduler                   dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker     dynamicconfig.BoolPropertyFn
 		EnableFailoverManager             dynamicconfig.BoolPropertyFn
 		EnableWorkflowShadower            dynamicconfig.BoolPropertyFn
@@ -136,10 +138,12 @@ func NewConfig(params *service.BootstrapParams) *Config {
 				EnableCleaning:           dc.GetBoolProperty(dynamicconfig.EnableCleaningOrphanTaskInTasklistScavenger, false),
 				MaxTasksPerJobFn:         dc.GetIntProperty(dynamicconfig.ScannerMaxTasksProcessedPerTasklistJob, tasklist.DefaultScannerMaxTasksProcessedPerTasklistJob),
 			},
-			Persistence:            &params.PersistenceConfig,
-			ClusterMetadata:        params.ClusterMetadata,
-			TaskListScannerEnabled: dc.GetBoolProperty(dynamicconfig.TaskListScannerEnabled, true),
-			HistoryScannerEnabled:  dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, false),
+			Persistence:                     &params.PersistenceConfig,
+			ClusterMetadata:                 params.ClusterMetadata,
+			TaskListScannerEnabled:          dc.GetBoolProperty(dynamicconfig.TaskListScannerEnabled, true),
+			HistoryScannerEnabled:           dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, false),
+			ArchivalRetentionScannerEnabled: dc.GetBoolProperty(dynamicconfig.ArchivalRetentionScannerEnabled, false),
+			ArchivalRetentionInDays:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.ArchivalRetentionDays, 0),
 			ShardScanners: []*shardscanner.ScannerConfig{
 				executions.ConcreteExecutionScannerConfig(dc),
 				executions.CurrentExecutionScannerConfig(dc),
@@ -156,6 +160,7 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ClusterMetadata:     params.ClusterMetadata,
 		},
 		EnableBatcher:                     dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
+		EnableScheduler:                   dc.GetBoolProperty(dynamicconfig.EnableScheduler, true),
 		EnableParentClosePolicyWorker:     dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		EnableFailoverManager:             dc.GetBoolProperty(dynamicconfig.EnableFailoverManager, true),
 		EnableWorkflowShadower:            dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower, true),
@@ -168,7 +173,8 @@ func NewConfig(params *service.BootstrapParams) *Config {
 		dynamicconfig.AdvancedVisibilityWritingMode,
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)
-	if advancedVisWritingMode() != common.AdvancedVisibilityWritingModeOff {
+	// in direct indexing mode history writes to ElasticSearch, so there is nothing to consume from kafka
+	if advancedVisWritingMode() != common.AdvancedVisibilityWritingModeOff && !params.ESConfig.IsDirectIndexing() {
 		config.IndexerCfg = &indexer.Config{
 			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 1000),
 			ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
@@ -208,6 +214,10 @@ func (s *Service) Start() {
 		s.ensureDomainExists(common.BatcherLocalDomainName)
 		s.startBatcher()
 	}
+	if s.config.EnableScheduler() {
+		s.ensureDomainExists(common.SchedulerLocalDomainName)
+		s.startScheduler()
+	}
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
@@ -265,6 +275,19 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config:     *s.config.ScannerCfg,
@@ -405,6 +428,8 @@ func getDomainID(domain string) string {
 		domainID = common.SystemDomainID
 	case common.BatcherLocalDomainName:
 		domainID = common.BatcherDomainID
+	case common.SchedulerLocalDomainName:
+		domainID = common.SchedulerDomainID
 	case common.ShadowerLocalDomainName:
 		domainID = common.ShadowerDomainID
 	}
@@ -120,6 +120,24 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:    "purge-archive",
+			Aliases: []string{"pa"},
+			Usage:   "Permanently delete the archived history and visibility records of a workflow run",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			}, adminDomainCommonFlags...),
+			Action: func(c *cli.Context) {
+				AdminPurgeArchivedWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -259,7 +277,7 @@ func newAdminShardManagementCommands() []cli.Command {
 					Name: FlagTimerType,
 					Usage: "timer types: 0 - DecisionTimeoutTask, 1 - TaskTypeActivityTimeout, " +
 						"2 - TaskTypeUserTimer, 3 - TaskTypeWorkflowTimeout, 4 - TaskTypeDeleteHistoryEvent, " +
-						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer",
+						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer, 7 - TaskTypeDeleteWorkflowExecution",
 					Value: &cli.IntSlice{-1},
 				},
 				cli.BoolFlag{
@@ -1313,7 +1331,7 @@ func newAdminConfigStoreCommands() []cli.Command {
 				},
 				cli.StringSliceFlag{
 					Name:  FlagDynamicConfigValue,
-					Usage: `Optional. Can be specified multiple times for multiple values. ex: --dynamic-config-value '{"Value":true,"Filters":[]}'`,
+					Usage: `Optional. Can be specified multiple times for multiple values. A value replaces the stored value with the same filters, the other stored values are kept. Without values the parameter is removed. ex: --dynamic-config-value '{"Value":true,"Filters":[]}'`,
 				},
 			},
 			Action: func(c *cli.Context) {
@@ -1342,10 +1360,37 @@ func newAdminConfigStoreCommands() []cli.Command {
 			Name:    "list-dynamic-config",
 			Aliases: []string{"listdc", "l"},
 			Usage:   "List Dynamic Config Value",
-			Flags:   []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigName,
+					Usage: "Optional. Name of Dynamic Config parameter to list the values of",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagDynamicConfigFilter,
+					Usage: `Optional. Only lists the values constrained by the filter. Can be specified multiple times for multiple filters. ex: --dynamic-config-filter '{"Name":"domainName","Value":"global-samples-domain"}'`,
+				},
+			},
 			Action: func(c *cli.Context) {
 				AdminListDynamicConfig(c)
 			},
 		},
+		{
+			Name:    "list-keys",
+			Aliases: []string{"lk"},
+			Usage:   "List the registered Dynamic Config keys with their type, allowed filters, default value and bounds",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagPrefix,
+					Usage: "Optional. Only list the keys starting with the prefix, ex: --prefix frontend.",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfigKeys(c)
+			},
+		},
 	}
 }
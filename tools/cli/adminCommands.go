@@ -35,6 +35,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/config"
@@ -284,6 +285,78 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	fmt.Println("delete current row successfully")
 }
 
+// AdminPurgeArchivedWorkflow deletes the archived history and visibility records of a workflow run
+func AdminPurgeArchivedWorkflow(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+
+	configuration := loadConfig(c)
+	metricsClient := initializeMetricsClient()
+	logger := initializeLogger(configuration)
+	clusterMetadata := initializeClusterMetadata(configuration, logger)
+	domainMgr := initializeDomainMgr(configuration, clusterMetadata, metricsClient, logger)
+	defer domainMgr.Close()
+	archiverProvider := initializeArchivalProvider(configuration, clusterMetadata, metricsClient, logger)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := domainMgr.GetDomain(ctx, &persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		ErrorAndExit("Failed to get domain.", err)
+	}
+	domainID := resp.Info.ID
+
+	// delete the history first so that a failed purge can still be found through visibility and retried
+	if resp.Config.HistoryArchivalURI != "" {
+		URI, err := archiver.NewURI(resp.Config.HistoryArchivalURI)
+		if err != nil {
+			ErrorAndExit("Invalid history archival URI.", err)
+		}
+		historyArchiver, err := archiverProvider.GetHistoryArchiver(URI.Scheme(), common.FrontendServiceName)
+		if err != nil {
+			ErrorAndExit("Failed to get history archiver.", err)
+		}
+		historyDeleter, ok := historyArchiver.(archiver.HistoryDeleter)
+		if !ok {
+			ErrorAndExit(fmt.Sprintf("History archiver of scheme %v does not support deletion.", URI.Scheme()), nil)
+		}
+		err = historyDeleter.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+			DomainID:   domainID,
+			WorkflowID: wid,
+			RunID:      rid,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to delete archived history.", err)
+		}
+		fmt.Println("deleted archived history")
+	}
+
+	if resp.Config.VisibilityArchivalURI != "" {
+		URI, err := archiver.NewURI(resp.Config.VisibilityArchivalURI)
+		if err != nil {
+			ErrorAndExit("Invalid visibility archival URI.", err)
+		}
+		visibilityArchiver, err := archiverProvider.GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
+		if err != nil {
+			ErrorAndExit("Failed to get visibility archiver.", err)
+		}
+		visibilityDeleter, ok := visibilityArchiver.(archiver.VisibilityDeleter)
+		if !ok {
+			ErrorAndExit(fmt.Sprintf("Visibility archiver of scheme %v does not support deletion.", URI.Scheme()), nil)
+		}
+		err = visibilityDeleter.Delete(ctx, URI, &archiver.DeleteVisibilityRequest{
+			DomainID:   domainID,
+			WorkflowID: wid,
+			RunID:      rid,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to delete archived visibility records.", err)
+		}
+		fmt.Println("deleted archived visibility records")
+	}
+}
+
 func connectToCassandra(c *cli.Context) (nosqlplugin.DB, nosqlplugin.AdminDB) {
 	host := getRequiredOption(c, FlagDBAddress)
 	if !c.IsSet(FlagDBPort) {
@@ -23,7 +23,12 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
 
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -116,6 +121,11 @@ func AdminUpdateDynamicConfig(c *cli.Context) {
 	dcName := getRequiredOption(c, FlagDynamicConfigName)
 	dcValues := c.StringSlice(FlagDynamicConfigValue)
 
+	key, ok := dynamicconfig.KeyNames[dcName]
+	if !ok {
+		ErrorAndExit(fmt.Sprintf("Unknown dynamic config %v, see the list-keys command for the registered keys", dcName), nil)
+	}
+
 	ctx, cancel := newContext(c)
 	defer cancel()
 
@@ -130,6 +140,9 @@ func AdminUpdateDynamicConfig(c *cli.Context) {
 			if err != nil {
 				ErrorAndExit("Unable to unmarshal value to inputValue", err)
 			}
+			if err := validateInputValue(key, parsedInputValue); err != nil {
+				ErrorAndExit("Invalid dynamic config value", err)
+			}
 			parsedValue, err := convertFromInputValue(parsedInputValue)
 			if err != nil {
 				ErrorAndExit("Unable to convert from inputValue to DynamicConfigValue", err)
@@ -183,11 +196,20 @@ func AdminRestoreDynamicConfig(c *cli.Context) {
 func AdminListDynamicConfig(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
 
+	dcName := c.String(FlagDynamicConfigName)
+	if dcName == "" {
+		dcName = dynamicconfig.UnknownKey.String()
+	}
+	filters, err := parseInputFilters(c.StringSlice(FlagDynamicConfigFilter))
+	if err != nil {
+		ErrorAndExit("Failed to parse filters", err)
+	}
+
 	ctx, cancel := newContext(c)
 	defer cancel()
 
 	req := &types.ListDynamicConfigRequest{
-		ConfigName: dynamicconfig.UnknownKey.String(),
+		ConfigName: dcName,
 	}
 
 	val, err := adminClient.ListDynamicConfig(ctx, req)
@@ -195,21 +217,119 @@ func AdminListDynamicConfig(c *cli.Context) {
 		ErrorAndExit("Failed to list dynamic config value(s)", err)
 	}
 
-	if val == nil || val.Entries == nil || len(val.Entries) == 0 {
-		fmt.Printf("No dynamic config values stored to list.\n")
-	} else {
-		cliEntries := make([]*cliEntry, 0, len(val.Entries))
+	var cliEntries []*cliEntry
+	if val != nil {
+		cliEntries = make([]*cliEntry, 0, len(val.Entries))
 		for _, dcEntry := range val.Entries {
 			cliEntry, err := convertToInputEntry(dcEntry)
 			if err != nil {
 				fmt.Printf("Cannot parse list response.\n")
+				continue
+			}
+			if cliEntry = filterInputEntry(cliEntry, filters); cliEntry != nil {
+				cliEntries = append(cliEntries, cliEntry)
 			}
-			cliEntries = append(cliEntries, cliEntry)
 		}
+	}
+
+	if len(cliEntries) == 0 {
+		fmt.Printf("No dynamic config values stored to list.\n")
+	} else {
 		prettyPrintJSONObject(cliEntries)
 	}
 }
 
+// AdminListDynamicConfigKeys lists the registered dynamic config keys
+func AdminListDynamicConfigKeys(c *cli.Context) {
+	prefix := c.String(FlagPrefix)
+
+	var definitions []dynamicconfig.KeyDefinition
+	for _, definition := range dynamicconfig.ListKeyDefinitions() {
+		if strings.HasPrefix(definition.Key.String(), prefix) {
+			definitions = append(definitions, definition)
+		}
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		entries := make([]*cliKeyDefinition, 0, len(definitions))
+		for _, definition := range definitions {
+			entries = append(entries, newCLIKeyDefinition(definition))
+		}
+		prettyPrintJSONObject(entries)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Name", "Type", "Filters", "Default Value", "Bounds"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, definition := range definitions {
+		entry := newCLIKeyDefinition(definition)
+		defaultValue := ""
+		if entry.DefaultValue != nil {
+			defaultValue = fmt.Sprintf("%v", entry.DefaultValue)
+		}
+		bounds := entry.Bounds
+		if len(entry.AllowedValues) > 0 {
+			bounds = strings.Join(entry.AllowedValues, ", ")
+		}
+		table.Append([]string{entry.Name, entry.Type, strings.Join(entry.Filters, ", "), defaultValue, bounds})
+	}
+	table.Render()
+}
+
+type cliKeyDefinition struct {
+	Name          string
+	Description   string
+	Type          string
+	Filters       []string
+	DefaultValue  interface{} `json:"defaultValue,omitempty"`
+	Bounds        string      `json:"bounds,omitempty"`
+	AllowedValues []string    `json:"allowedValues,omitempty"`
+}
+
+func newCLIKeyDefinition(definition dynamicconfig.KeyDefinition) *cliKeyDefinition {
+	filters := make([]string, 0, len(definition.Filters))
+	for _, filter := range definition.Filters {
+		filters = append(filters, filter.String())
+	}
+	defaultValue := definition.DefaultValue
+	if duration, ok := defaultValue.(time.Duration); ok {
+		// durations are set as strings
+		defaultValue = duration.String()
+	}
+	bounds := ""
+	if definition.Bounds != nil {
+		bounds = definition.Bounds.String()
+	}
+	return &cliKeyDefinition{
+		Name:          definition.Key.String(),
+		Description:   definition.Description,
+		Type:          definition.Type.String(),
+		Filters:       filters,
+		DefaultValue:  defaultValue,
+		Bounds:        bounds,
+		AllowedValues: definition.AllowedValues,
+	}
+}
+
+func validateInputValue(key dynamicconfig.Key, inputValue *cliValue) error {
+	if inputValue == nil {
+		return fmt.Errorf("value is not set")
+	}
+	filters := make([]dynamicconfig.Filter, 0, len(inputValue.Filters))
+	for _, inputFilter := range inputValue.Filters {
+		filter := dynamicconfig.ParseFilter(inputFilter.Name)
+		if filter == dynamicconfig.UnknownFilter {
+			return fmt.Errorf("unknown filter %v", inputFilter.Name)
+		}
+		filters = append(filters, filter)
+	}
+	return dynamicconfig.ValidateValue(key, inputValue.Value, filters)
+}
+
 func convertToInputEntry(dcEntry *types.DynamicConfigEntry) (*cliEntry, error) {
 	newValues := make([]*cliValue, 0, len(dcEntry.Values))
 	for _, value := range dcEntry.Values {
@@ -301,6 +421,60 @@ func convertFromInputFilter(inputFilter *cliFilter) (*types.DynamicConfigFilter,
 	}, nil
 }
 
+func parseInputFilters(inputFilters []string) ([]*cliFilter, error) {
+	parsedFilters := make([]*cliFilter, 0, len(inputFilters))
+	for _, filterString := range inputFilters {
+		if filterString == "" || filterString == "{}" {
+			continue
+		}
+		var parsedInputFilter *cliFilter
+		if err := json.Unmarshal([]byte(filterString), &parsedInputFilter); err != nil {
+			return nil, err
+		}
+		parsedFilters = append(parsedFilters, parsedInputFilter)
+	}
+	return parsedFilters, nil
+}
+
+// filterInputEntry keeps the values of the entry which are constrained by all the filters,
+// it returns nil if none of the values is
+func filterInputEntry(entry *cliEntry, filters []*cliFilter) *cliEntry {
+	if len(filters) == 0 {
+		return entry
+	}
+
+	values := make([]*cliValue, 0, len(entry.Values))
+	for _, value := range entry.Values {
+		if containsInputFilters(value.Filters, filters) {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return &cliEntry{
+		Name:         entry.Name,
+		DefaultValue: entry.DefaultValue,
+		Values:       values,
+	}
+}
+
+func containsInputFilters(valueFilters []*cliFilter, filters []*cliFilter) bool {
+	for _, filter := range filters {
+		found := false
+		for _, valueFilter := range valueFilters {
+			if valueFilter.Name == filter.Name && reflect.DeepEqual(valueFilter.Value, filter.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func parseInputFilterArray(inputFilters []string) ([]*types.DynamicConfigFilter, error) {
 	var parsedFilters []*types.DynamicConfigFilter
 
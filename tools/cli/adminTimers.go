@@ -155,6 +155,7 @@ func AdminTimers(c *cli.Context) {
 			persistence.TaskTypeDeleteHistoryEvent,
 			persistence.TaskTypeActivityRetryTimer,
 			persistence.TaskTypeWorkflowBackoffTimer,
+			persistence.TaskTypeDeleteWorkflowExecution,
 		}
 	}
 
@@ -402,6 +402,18 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestResetWorkflow() {
+	var request *types.ResetWorkflowExecutionRequest
+	s.serverFrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Do(func(_ interface{}, req *types.ResetWorkflowExecutionRequest) {
+		request = req
+	}).Return(&types.ResetWorkflowExecutionResponse{RunID: uuid.New()}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "reset", "-w", "wid", "-r", "rid", "--reason", "test-reason", "--event_id", "3",
+		"--child_workflow_policy", "TERMINATE", "--reapply_event_types", "WorkflowExecutionSignaled, WorkflowExecutionCancelRequested"})
+	s.Nil(err)
+	s.Equal(types.ResetChildWorkflowPolicyTerminate.Ptr(), request.ChildWorkflowPolicy)
+	s.Equal([]types.EventType{types.EventTypeWorkflowExecutionSignaled, types.EventTypeWorkflowExecutionCancelRequested}, request.ReapplyEventTypes)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.clientFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -610,6 +622,68 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminUpdateDynamicConfig() {
+	request := &types.UpdateDynamicConfigRequest{
+		ConfigName: "frontend.rps",
+		ConfigValues: []*types.DynamicConfigValue{
+			{
+				Value:   &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte("1000")},
+				Filters: []*types.DynamicConfigFilter{},
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), request).Return(nil)
+
+	err := s.app.Run([]string{"", "admin", "config", "update-dynamic-config", "--dynamic_config_name", "frontend.rps", "--dynamic_config_value", `{"Value":1000,"Filters":[]}`})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateDynamicConfig_InvalidValue() {
+	// osExit is stubbed in tests so the command keeps running after reporting the error
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "config", "update-dynamic-config", "--dynamic_config_name", "frontend.rps", "--dynamic_config_value", `{"Value":"abc","Filters":[]}`})
+	s.Equal(1, errorCode)
+	errorCode = s.RunErrorExitCode([]string{"", "admin", "config", "update-dynamic-config", "--dynamic_config_name", "frontend.rps", "--dynamic_config_value", `{"Value":-1,"Filters":[]}`})
+	s.Equal(1, errorCode)
+	errorCode = s.RunErrorExitCode([]string{"", "admin", "config", "update-dynamic-config", "--dynamic_config_name", "frontend.unknownKey", "--dynamic_config_value", `{"Value":1,"Filters":[]}`})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminListDynamicConfig_WithFilters() {
+	domainFilter := &types.DynamicConfigFilter{
+		Name:  "domainName",
+		Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(`"samples-domain"`)},
+	}
+	resp := &types.ListDynamicConfigResponse{
+		Entries: []*types.DynamicConfigEntry{
+			{
+				Name: "frontend.rps",
+				Values: []*types.DynamicConfigValue{
+					{Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte("1000")}},
+					{Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte("10")}, Filters: []*types.DynamicConfigFilter{domainFilter}},
+				},
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), &types.ListDynamicConfigRequest{ConfigName: "frontend.rps"}).Return(resp, nil)
+
+	err := s.app.Run([]string{"", "admin", "config", "list-dynamic-config", "--dynamic_config_name", "frontend.rps", "--dynamic_config_filter", `{"Name":"domainName","Value":"samples-domain"}`})
+	s.Nil(err)
+
+	entry, err := convertToInputEntry(resp.Entries[0])
+	s.NoError(err)
+	filtered := filterInputEntry(entry, []*cliFilter{{Name: "domainName", Value: "samples-domain"}})
+	s.Len(filtered.Values, 1)
+	s.Equal(float64(10), filtered.Values[0].Value)
+	s.Nil(filterInputEntry(entry, []*cliFilter{{Name: "domainName", Value: "other-domain"}}))
+}
+
+func (s *cliAppSuite) TestAdminListDynamicConfigKeys() {
+	s.Nil(s.app.Run([]string{"", "admin", "config", "list-keys", "--prefix", "frontend."}))
+	s.Nil(s.app.Run([]string{"", "admin", "config", "list-keys", "--pjson"}))
+}
+
 func (s *cliAppSuite) TestAdminFailover() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -632,6 +706,103 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestCreateSchedule() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), &types.CreateScheduleRequest{
+		Domain:     domainName,
+		ScheduleID: "test-schedule",
+		Spec: &types.ScheduleSpec{
+			CronSchedule:  "* * * * *",
+			OverlapPolicy: types.ScheduleOverlapPolicyCancelOther.Ptr(),
+		},
+		Action: &types.ScheduleStartWorkflowAction{
+			WorkflowType:                        &types.WorkflowType{Name: "test-workflow-type"},
+			TaskList:                            &types.TaskList{Name: "test-taskList"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		},
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "create", "--sid", "test-schedule", "--cron", "* * * * *",
+		"--op", "cancel-other", "-wt", "test-workflow-type", "-tl", "test-taskList", "-et", "60"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_Failed() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "fake error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sid", "test-schedule", "--cron", "* * * * *",
+		"-wt", "test-workflow-type", "-tl", "test-taskList", "-et", "60"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDescribeSchedule() {
+	s.serverFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), &types.DescribeScheduleRequest{
+		Domain:     domainName,
+		ScheduleID: "test-schedule",
+	}).Return(&types.DescribeScheduleResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "describe", "--sid", "test-schedule"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListSchedules() {
+	s.serverFrontendClient.EXPECT().ListSchedules(gomock.Any(), gomock.Any()).Return(&types.ListSchedulesResponse{
+		ScheduleIDs:   []string{"test-schedule-1"},
+		NextPageToken: []byte("token"),
+	}, nil)
+	s.serverFrontendClient.EXPECT().ListSchedules(gomock.Any(), gomock.Any()).Return(&types.ListSchedulesResponse{
+		ScheduleIDs: []string{"test-schedule-2"},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "list"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestStartBatchJob() {
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &types.CountWorkflowExecutionsRequest{
+		Domain: domainName,
+		Query:  "WorkflowType='test-type'",
+	}).Return(&types.CountWorkflowExecutionsResponse{Count: 10}, nil)
+	var request *types.StartBatchOperationRequest
+	s.serverFrontendClient.EXPECT().StartBatchOperation(gomock.Any(), gomock.Any()).Do(func(_ interface{}, req *types.StartBatchOperationRequest) {
+		request = req
+	}).Return(&types.StartBatchOperationResponse{JobID: "test-job"}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "batch", "start", "--q", "WorkflowType='test-type'", "--re", "test-reason", "--bt", "terminate", "--yes"})
+	s.Nil(err)
+	s.Equal(domainName, request.GetDomain())
+	s.Equal("WorkflowType='test-type'", request.GetQuery())
+	s.Equal("test-reason", request.GetReason())
+	s.Equal(types.BatchOperationTypeTerminate, request.GetBatchType())
+}
+
+func (s *cliAppSuite) TestDescribeBatchJob() {
+	s.serverFrontendClient.EXPECT().DescribeBatchOperation(gomock.Any(), &types.DescribeBatchOperationRequest{
+		Domain: domainName,
+		JobID:  "test-job",
+	}).Return(&types.DescribeBatchOperationResponse{
+		Info:     &types.BatchOperationInfo{JobID: "test-job"},
+		Progress: &types.BatchOperationProgress{TotalEstimate: 10, SuccessCount: 5},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "batch", "describe", "--jid", "test-job"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListBatchJobs() {
+	s.serverFrontendClient.EXPECT().ListBatchOperations(gomock.Any(), gomock.Any()).Return(&types.ListBatchOperationsResponse{
+		Operations: []*types.BatchOperationInfo{
+			{JobID: "test-job-1"},
+			{JobID: "test-job-2", CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr()},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "batch", "list"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestTerminateBatchJob() {
+	s.serverFrontendClient.EXPECT().CancelBatchOperation(gomock.Any(), &types.CancelBatchOperationRequest{
+		Domain: domainName,
+		JobID:  "test-job",
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "batch", "terminate", "--jid", "test-job"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(history, nil).Times(2)
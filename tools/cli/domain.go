@@ -83,5 +83,38 @@ func newDomainCommands() []cli.Command {
 				newDomainCLI(c, false).DescribeDomain(c)
 			},
 		},
+		{
+			Name:    "rolebinding",
+			Aliases: []string{"rb"},
+			Usage:   "Manage the role bindings of the domain used by the RBAC authorizer",
+			Subcommands: []cli.Command{
+				{
+					Name:    "list",
+					Aliases: []string{"l"},
+					Usage:   "List role bindings of the domain",
+					Action: func(c *cli.Context) {
+						newDomainCLI(c, false).ListRoleBindings(c)
+					},
+				},
+				{
+					Name:    "add",
+					Aliases: []string{"a"},
+					Usage:   "Bind a role to a principal in the domain",
+					Flags:   addRoleBindingFlags,
+					Action: func(c *cli.Context) {
+						newDomainCLI(c, false).AddRoleBinding(c)
+					},
+				},
+				{
+					Name:    "remove",
+					Aliases: []string{"rm"},
+					Usage:   "Remove the role bindings of a principal in the domain",
+					Flags:   removeRoleBindingFlags,
+					Action: func(c *cli.Context) {
+						newDomainCLI(c, false).RemoveRoleBinding(c)
+					},
+				},
+			},
+		},
 	}
 }
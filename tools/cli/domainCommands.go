@@ -37,6 +37,8 @@ import (
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/types"
 )
@@ -456,6 +458,108 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 	}
 }
 
+// ListRoleBindings lists the role bindings of a domain
+func (d *domainCLIImpl) ListRoleBindings(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	bindings := d.getRoleBindings(c, domainName)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Principal", "Role", "Workflow Types", "Task Lists"})
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, binding := range bindings {
+		table.Append([]string{
+			binding.Principal,
+			binding.Role,
+			strings.Join(binding.WorkflowTypes, ","),
+			strings.Join(binding.TaskLists, ","),
+		})
+	}
+	table.Render()
+}
+
+// AddRoleBinding binds a role to a principal in a domain
+func (d *domainCLIImpl) AddRoleBinding(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	binding := config.RBACRoleBinding{
+		Principal:     getRequiredOption(c, FlagPrincipal),
+		Role:          getRequiredOption(c, FlagRole),
+		WorkflowTypes: splitCommaSeparated(c.String(FlagWorkflowType)),
+		TaskLists:     splitCommaSeparated(c.String(FlagTaskList)),
+	}
+	if err := authorization.ValidateRoleBinding(binding); err != nil {
+		ErrorAndExit("Invalid role binding.", err)
+	}
+
+	bindings := append(d.getRoleBindings(c, domainName), binding)
+	d.updateRoleBindings(c, domainName, bindings)
+	fmt.Printf("Role %s successfully bound to %s in domain %s.\n", binding.Role, binding.Principal, domainName)
+}
+
+// RemoveRoleBinding removes the role bindings of a principal in a domain
+func (d *domainCLIImpl) RemoveRoleBinding(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	principal := getRequiredOption(c, FlagPrincipal)
+	role := c.String(FlagRole)
+
+	bindings := []config.RBACRoleBinding{}
+	for _, binding := range d.getRoleBindings(c, domainName) {
+		if binding.Principal == principal && (role == "" || binding.Role == role) {
+			continue
+		}
+		bindings = append(bindings, binding)
+	}
+	d.updateRoleBindings(c, domainName, bindings)
+	fmt.Printf("Role bindings of %s successfully removed from domain %s.\n", principal, domainName)
+}
+
+func (d *domainCLIImpl) getRoleBindings(c *cli.Context, domainName string) []config.RBACRoleBinding {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			ErrorAndExit("Operation DescribeDomain failed.", err)
+		}
+		ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domainName), err)
+	}
+	bindings, err := authorization.ParseRoleBindings(resp.DomainInfo.GetData())
+	if err != nil {
+		ErrorAndExit("Failed to parse role bindings of the domain.", err)
+	}
+	return bindings
+}
+
+func (d *domainCLIImpl) updateRoleBindings(c *cli.Context, domainName string, bindings []config.RBACRoleBinding) {
+	encoded, err := authorization.EncodeRoleBindings(bindings)
+	if err != nil {
+		ErrorAndExit("Failed to encode role bindings.", err)
+	}
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = d.updateDomain(ctx, &types.UpdateDomainRequest{
+		Name:          domainName,
+		Data:          map[string]string{common.DomainDataKeyForRoleBindings: encoded},
+		SecurityToken: c.String(FlagSecurityToken),
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateDomain failed.", err)
+	}
+}
+
+func splitCommaSeparated(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 func (d *domainCLIImpl) ListDomains(c *cli.Context) {
 	pageSize := c.Int(FlagPageSize)
 	prefix := c.String(FlagPrefix)
@@ -197,6 +197,44 @@ var (
 		},
 	}
 
+	addRoleBindingFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagPrincipal,
+			Usage: "Principal to bind the role to, in user:<name> or group:<name> format",
+		},
+		cli.StringFlag{
+			Name:  FlagRole,
+			Usage: "Role to bind, either one of the built-in roles reader, writer and admin, or a role defined in the server config",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "Optional comma separated workflow types the binding is restricted to",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "Optional comma separated task lists the binding is restricted to",
+		},
+		cli.StringFlag{
+			Name:  FlagSecurityTokenWithAlias,
+			Usage: "Optional token for security check",
+		},
+	}
+
+	removeRoleBindingFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagPrincipal,
+			Usage: "Principal to remove the role bindings of",
+		},
+		cli.StringFlag{
+			Name:  FlagRole,
+			Usage: "Optional role to remove, all the roles of the principal are removed if not set",
+		},
+		cli.StringFlag{
+			Name:  FlagSecurityTokenWithAlias,
+			Usage: "Optional token for security check",
+		},
+	}
+
 	adminDomainCommonFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagServiceConfigDirWithAlias,
@@ -256,7 +294,7 @@ func initializeAdminDomainHandler(
 		metricsClient,
 		logger,
 	)
-	dynamicConfig := initializeDynamicConfig(configuration, logger)
+	dynamicConfig := initializeDynamicConfig(configuration, logger, metricsClient)
 	return initializeDomainHandler(
 		logger,
 		metadataMgr,
@@ -417,6 +455,7 @@ func initializeDomainReplicator(
 func initializeDynamicConfig(
 	serviceConfig *config.Config,
 	logger log.Logger,
+	metricsClient metrics.Client,
 ) *dynamicconfig.Collection {
 
 	// the done channel is used by dynamic config to stop refreshing
@@ -426,6 +465,7 @@ func initializeDynamicConfig(
 	dynamicConfigClient, err := dynamicconfig.NewFileBasedClient(
 		&serviceConfig.DynamicConfig.FileBased,
 		logger,
+		metricsClient,
 		doneChan,
 	)
 	if err != nil {
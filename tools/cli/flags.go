@@ -175,6 +175,8 @@ const (
 	FlagIsGlobalDomainWithAlias           = FlagIsGlobalDomain + ", gd"
 	FlagDomainData                        = "domain_data"
 	FlagDomainDataWithAlias               = FlagDomainData + ", dmd"
+	FlagPrincipal                         = "principal"
+	FlagRole                              = "role"
 	FlagEventID                           = "event_id"
 	FlagEventIDWithAlias                  = FlagEventID + ", eid"
 	FlagActivityID                        = "activity_id"
@@ -208,6 +210,8 @@ const (
 	FlagResetPointsOnly                   = "reset_points_only"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
 	FlagSkipSignalReapply                 = "skip_signal_reapply"
+	FlagResetChildWorkflowPolicy          = "child_workflow_policy"
+	FlagResetReapplyEventTypes            = "reapply_event_types"
 	FlagListQuery                         = "query"
 	FlagListQueryWithAlias                = FlagListQuery + ", q"
 	FlagBatchType                         = "batch_type"
@@ -223,6 +227,13 @@ const (
 	FlagRPSScaleUpSeconds                 = "rps_scale_up_seconds"
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sid"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", op"
+	FlagCatchupWindow                     = "catchup_window"
+	FlagStartTime                         = "start_time"
+	FlagEndTime                           = "end_time"
 	FlagYes                               = "yes"
 	FlagServiceConfigDir                  = "service_config_dir"
 	FlagServiceConfigDirWithAlias         = FlagServiceConfigDir + ", scd"
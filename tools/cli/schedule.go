@@ -0,0 +1,222 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule which starts a workflow at the times of a cron schedule",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			}, getFlagsForSchedule()...),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe a schedule",
+			Flags:   []cli.Flag{getScheduleIDFlag()},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update a schedule, options which are not provided are left unchanged",
+			Flags:   append([]cli.Flag{getScheduleIDFlag()}, getFlagsForSchedule()...),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, actions due while it is paused are not taken",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to pause the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to unpause the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:  "trigger",
+			Usage: "Take an action of a schedule immediately",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy overriding the one of the schedule: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+				},
+			},
+			Action: func(c *cli.Context) {
+				TriggerSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take the actions a schedule would have taken in a past time range",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the time range, in UTC format '2006-01-02T15:04:05Z', time range or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "End of the time range, in UTC format '2006-01-02T15:04:05Z', time range or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy overriding the one of the schedule: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, workflows already started are not affected",
+			Flags: []cli.Flag{getScheduleIDFlag()},
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a domain",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+	}
+}
+
+func getScheduleIDFlag() cli.Flag {
+	return cli.StringFlag{
+		Name:  FlagScheduleIDWithAlias,
+		Usage: "ScheduleID",
+	}
+}
+
+func getFlagsForSchedule() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name: FlagCronSchedule,
+			Usage: "Cron schedule of the actions, evaluated in UTC. Cron spec is as following: \n" +
+				"\t┌───────────── minute (0 - 59) \n" +
+				"\t│ ┌───────────── hour (0 - 23) \n" +
+				"\t│ │ ┌───────────── day of the month (1 - 31) \n" +
+				"\t│ │ │ ┌───────────── month (1 - 12) \n" +
+				"\t│ │ │ │ ┌───────────── day of the week (0 - 6) (Sunday to Saturday) \n" +
+				"\t│ │ │ │ │ \n" +
+				"\t* * * * *",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Usage: "Optional policy when an action is due while the previous workflow is running: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+		},
+		cli.IntFlag{
+			Name:  FlagCatchupWindow,
+			Usage: "Optional time in seconds an action can be late and still be taken, e.g. after an outage",
+		},
+		cli.StringFlag{
+			Name:  FlagStartTime,
+			Usage: "Optional time before which no action is taken, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagEndTime,
+			Usage: "Optional time after which no action is taken, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList of the started workflows",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName of the started workflows",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "Optional prefix of the WorkflowID of the started workflows, default to the ScheduleID",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeoutWithAlias,
+			Usage: "Execution start to close timeout of the started workflows in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagDecisionTimeoutWithAlias,
+			Value: defaultDecisionTimeoutInSeconds,
+			Usage: "Decision task start to close timeout of the started workflows in seconds",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the started workflows, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		cli.StringFlag{
+			Name: FlagInputFileWithAlias,
+			Usage: "Optional input for the started workflows from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+				"Input from file will be overwrite by input from command line",
+		},
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	request := &types.CreateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       &types.ScheduleSpec{},
+		Action:     &types.ScheduleStartWorkflowAction{},
+	}
+	applyScheduleFlags(c, request.Spec, request.Action)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := frontendClient.CreateSchedule(tcCtx, request); err != nil {
+		ErrorAndExit("Failed to create schedule", err)
+	}
+	output := map[string]interface{}{
+		"msg":        "schedule is created",
+		"scheduleID": scheduleID,
+	}
+	prettyPrintJSONObject(output)
+}
+
+// DescribeSchedule describes a schedule
+func DescribeSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	response, err := frontendClient.DescribeSchedule(tcCtx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe schedule", err)
+	}
+	prettyPrintJSONObject(response)
+}
+
+// UpdateSchedule updates the spec and action of a schedule
+func UpdateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	response, err := frontendClient.DescribeSchedule(tcCtx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe schedule", err)
+	}
+	request := &types.UpdateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       response.Spec,
+		Action:     response.Action,
+	}
+	if request.Spec == nil {
+		request.Spec = &types.ScheduleSpec{}
+	}
+	if request.Action == nil {
+		request.Action = &types.ScheduleStartWorkflowAction{}
+	}
+	applyScheduleFlags(c, request.Spec, request.Action)
+
+	tcCtx, cancel = newContext(c)
+	defer cancel()
+	if err := frontendClient.UpdateSchedule(tcCtx, request); err != nil {
+		ErrorAndExit("Failed to update schedule", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{"msg": "schedule is updated"})
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseSchedule(tcCtx, &types.PauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to pause schedule", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{"msg": "schedule is paused"})
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UnpauseSchedule(tcCtx, &types.UnpauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to unpause schedule", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{"msg": "schedule is unpaused"})
+}
+
+// TriggerSchedule takes an action of a schedule immediately
+func TriggerSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.TriggerSchedule(tcCtx, &types.TriggerScheduleRequest{
+		Domain:        domain,
+		ScheduleID:    scheduleID,
+		OverlapPolicy: getOverlapPolicy(c),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to trigger schedule", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{"msg": "schedule is triggered"})
+}
+
+// BackfillSchedule takes the actions of a schedule in a past time range
+func BackfillSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	startTime := parseTime(getRequiredOption(c, FlagStartTime), 0)
+	endTime := parseTime(getRequiredOption(c, FlagEndTime), 0)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.BackfillSchedule(tcCtx, &types.BackfillScheduleRequest{
+		Domain:        domain,
+		ScheduleID:    scheduleID,
+		StartTimeNano: common.Int64Ptr(startTime),
+		EndTimeNano:   common.Int64Ptr(endTime),
+		OverlapPolicy: getOverlapPolicy(c),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to backfill schedule", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{"msg": "schedule backfill is requested"})
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.DeleteSchedule(tcCtx, &types.DeleteScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete schedule", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{"msg": "schedule is deleted"})
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	pageSize := c.Int(FlagPageSize)
+
+	scheduleIDs := []string{}
+	var nextPageToken []byte
+	for {
+		tcCtx, cancel := newContext(c)
+		response, err := frontendClient.ListSchedules(tcCtx, &types.ListSchedulesRequest{
+			Domain:        domain,
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list schedules", err)
+		}
+		scheduleIDs = append(scheduleIDs, response.GetScheduleIDs()...)
+		if len(response.GetNextPageToken()) == 0 {
+			break
+		}
+		nextPageToken = response.GetNextPageToken()
+	}
+	prettyPrintJSONObject(scheduleIDs)
+}
+
+// applyScheduleFlags overrides the spec and action with the flags which are set
+func applyScheduleFlags(c *cli.Context, spec *types.ScheduleSpec, action *types.ScheduleStartWorkflowAction) {
+	if c.IsSet(FlagCronSchedule) {
+		spec.CronSchedule = c.String(FlagCronSchedule)
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		spec.OverlapPolicy = getOverlapPolicy(c)
+	}
+	if c.IsSet(FlagCatchupWindow) {
+		spec.CatchupWindowSeconds = common.Int32Ptr(int32(c.Int(FlagCatchupWindow)))
+	}
+	if c.IsSet(FlagStartTime) {
+		spec.StartTimeNano = common.Int64Ptr(parseTime(c.String(FlagStartTime), 0))
+	}
+	if c.IsSet(FlagEndTime) {
+		spec.EndTimeNano = common.Int64Ptr(parseTime(c.String(FlagEndTime), 0))
+	}
+	if c.IsSet(FlagTaskList) {
+		action.TaskList = &types.TaskList{Name: c.String(FlagTaskList)}
+	}
+	if c.IsSet(FlagWorkflowType) {
+		action.WorkflowType = &types.WorkflowType{Name: c.String(FlagWorkflowType)}
+	}
+	if c.IsSet(FlagWorkflowID) {
+		action.WorkflowIDPrefix = c.String(FlagWorkflowID)
+	}
+	if c.IsSet(FlagExecutionTimeout) {
+		action.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagExecutionTimeout)))
+	}
+	if c.IsSet(FlagDecisionTimeout) || action.GetTaskStartToCloseTimeoutSeconds() == 0 {
+		action.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagDecisionTimeout)))
+	}
+	if c.IsSet(FlagInput) || c.IsSet(FlagInputFile) {
+		action.Input = []byte(processJSONInput(c))
+	}
+}
+
+// getOverlapPolicy returns the overlap policy of the flag, or nil when it is not set
+func getOverlapPolicy(c *cli.Context) *types.ScheduleOverlapPolicy {
+	if !c.IsSet(FlagOverlapPolicy) {
+		return nil
+	}
+	var policy types.ScheduleOverlapPolicy
+	if err := policy.UnmarshalText([]byte(strings.ReplaceAll(c.String(FlagOverlapPolicy), "-", "_"))); err != nil {
+		ErrorAndExit("Invalid overlap policy", err)
+	}
+	return &policy
+}
@@ -246,6 +246,16 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagSkipSignalReapply,
 					Usage: "whether or not skipping signals reapply after the reset point",
 				},
+				cli.StringFlag{
+					Name: FlagResetChildWorkflowPolicy,
+					Usage: "Optional policy for child workflows still running at the reset point, one of: REJECT, TERMINATE, REATTACH. " +
+						"Default to REJECT. Children started after the reset point are terminated unless REJECT",
+				},
+				cli.StringFlag{
+					Name: FlagResetReapplyEventTypes,
+					Usage: "Optional event types after the reset point to reapply in addition to signals, separated by comma. " +
+						"Supported: WorkflowExecutionSignaled, WorkflowExecutionCancelRequested",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetWorkflow(c)
@@ -314,6 +324,16 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagSkipSignalReapply,
 					Usage: "whether or not skipping signals reapply after the reset point",
 				},
+				cli.StringFlag{
+					Name: FlagResetChildWorkflowPolicy,
+					Usage: "Optional policy for child workflows still running at the reset point, one of: REJECT, TERMINATE, REATTACH. " +
+						"Default to REJECT. Children started after the reset point are terminated unless REJECT",
+				},
+				cli.StringFlag{
+					Name: FlagResetReapplyEventTypes,
+					Usage: "Optional event types after the reset point to reapply in addition to signals, separated by comma. " +
+						"Supported: WorkflowExecutionSignaled, WorkflowExecutionCancelRequested",
+				},
 				cli.StringFlag{
 					Name: FlagEarliestTimeWithAlias,
 					Usage: "EarliestTime of decision start time, required for resetType of DecisionCompletedTime." +
@@ -420,17 +440,14 @@ func newBatchCommands() []cli.Command {
 			},
 		},
 		{
-			Name:  "terminate",
-			Usage: "terminate a batch operation job",
+			Name:    "terminate",
+			Aliases: []string{"cancel"},
+			Usage:   "cancel a batch operation job, workflows already processed are not reverted",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagJobIDWithAlias,
 					Usage: "Batch Job ID",
 				},
-				cli.StringFlag{
-					Name:  FlagReasonWithAlias,
-					Usage: "Reason to stop this batch job",
-				},
 			},
 			Action: func(c *cli.Context) {
 				TerminateBatchJob(c)
@@ -476,6 +493,55 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, one of: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for batch reset type of BadBinary",
+				},
+				cli.StringFlag{
+					Name: FlagEarliestTimeWithAlias,
+					Usage: "For batch reset type of DecisionCompletedTime. EarliestTime of decision. " +
+						"Either a timestamp or time range (e.g. 3d, 2h).",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipCurrentOpen,
+					Usage: "For batch reset, skip the workflow if the current run is open",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipBaseIsNotCurrent,
+					Usage: "For batch reset, skip the workflow if the base run is not the current run",
+				},
+				cli.BoolFlag{
+					Name:  FlagNonDeterministicOnly,
+					Usage: "For batch reset, only reset the workflow if its last decision failed with non-determinism",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipSignalReapply,
+					Usage: "For batch reset, skip reapplying the signals after the reset point",
+				},
+				cli.StringFlag{
+					Name:  FlagResetChildWorkflowPolicy,
+					Usage: "For batch reset, policy for child workflows still running at the reset point, one of: REJECT, TERMINATE, REATTACH",
+				},
+				cli.StringFlag{
+					Name:  FlagResetReapplyEventTypes,
+					Usage: "For batch reset, event types after the reset point to reapply in addition to signals, separated by comma",
+				},
+				cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Required for batch upsert_search_attributes, search attributes keys separated by |",
+				},
+				cli.StringFlag{
+					Name:  FlagSearchAttributesVal,
+					Usage: "Required for batch upsert_search_attributes, search attributes values separated by |",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only log the workflows that would be processed without changing them",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
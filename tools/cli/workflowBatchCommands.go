@@ -22,17 +22,16 @@ package cli
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/urfave/cli"
-	"go.uber.org/cadence/.gen/go/shared"
 	cclient "go.uber.org/cadence/client"
 
 	"github.com/uber/cadence/common"
 	cc "github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/batcher"
 )
 
@@ -42,54 +41,53 @@ var (
 	}
 )
 
-// TerminateBatchJob stops abatch job
+// TerminateBatchJob stops a batch job
 func TerminateBatchJob(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 	jobID := getRequiredOption(c, FlagJobID)
-	reason := getRequiredOption(c, FlagReason)
-	svcClient := cFactory.ClientFrontendClient(c)
-	client := cclient.NewClient(svcClient, common.BatcherLocalDomainName, &DefaultClientOptions)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	err := client.TerminateWorkflow(tcCtx, jobID, "", reason, nil)
+	err := frontendClient.CancelBatchOperation(tcCtx, &types.CancelBatchOperationRequest{
+		Domain: domain,
+		JobID:  jobID,
+	})
 	if err != nil {
-		ErrorAndExit("Failed to terminate batch job", err)
+		ErrorAndExit("Failed to cancel batch job", err)
 	}
 	output := map[string]interface{}{
-		"msg": "batch job is terminated",
+		"msg": "batch job cancellation is requested",
 	}
 	prettyPrintJSONObject(output)
 }
 
 // DescribeBatchJob describe the status of the batch job
 func DescribeBatchJob(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 	jobID := getRequiredOption(c, FlagJobID)
 
-	svcClient := cFactory.ClientFrontendClient(c)
-	client := cclient.NewClient(svcClient, common.BatcherLocalDomainName, &DefaultClientOptions)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	wf, err := client.DescribeWorkflowExecution(tcCtx, jobID, "")
+	resp, err := frontendClient.DescribeBatchOperation(tcCtx, &types.DescribeBatchOperationRequest{
+		Domain: domain,
+		JobID:  jobID,
+	})
 	if err != nil {
 		ErrorAndExit("Failed to describe batch job", err)
 	}
 
 	output := map[string]interface{}{}
-	if wf.WorkflowExecutionInfo.CloseStatus != nil {
-		if wf.WorkflowExecutionInfo.GetCloseStatus() != shared.WorkflowExecutionCloseStatusCompleted {
-			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetCloseStatus().String()
+	if closeStatus := resp.GetInfo().CloseStatus; closeStatus != nil {
+		if *closeStatus != types.WorkflowExecutionCloseStatusCompleted {
+			output["msg"] = "batch job stopped status: " + closeStatus.String()
 		} else {
 			output["msg"] = "batch job is finished successfully"
 		}
 	} else {
 		output["msg"] = "batch job is running"
-		if len(wf.PendingActivities) > 0 {
-			hbdBinary := wf.PendingActivities[0].HeartbeatDetails
-			hbd := batcher.HeartBeatDetails{}
-			err := json.Unmarshal(hbdBinary, &hbd)
-			if err != nil {
-				ErrorAndExit("Failed to describe batch job", err)
-			}
-			output["progress"] = hbd
+		if resp.Progress != nil {
+			output["progress"] = resp.Progress
 		}
 	}
 	prettyPrintJSONObject(output)
@@ -97,34 +95,30 @@ func DescribeBatchJob(c *cli.Context) {
 
 // ListBatchJobs list the started batch jobs
 func ListBatchJobs(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
 	domain := getRequiredGlobalOption(c, FlagDomain)
 	pageSize := c.Int(FlagPageSize)
-	svcClient := cFactory.ClientFrontendClient(c)
-	client := cclient.NewClient(svcClient, common.BatcherLocalDomainName, &DefaultClientOptions)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	resp, err := client.ListWorkflow(tcCtx, &shared.ListWorkflowExecutionsRequest{
-		Domain:   common.StringPtr(common.BatcherLocalDomainName),
-		PageSize: common.Int32Ptr(int32(pageSize)),
-		Query:    common.StringPtr(fmt.Sprintf("CustomDomain = '%v'", domain)),
+	resp, err := frontendClient.ListBatchOperations(tcCtx, &types.ListBatchOperationsRequest{
+		Domain:   domain,
+		PageSize: int32(pageSize),
 	})
 	if err != nil {
 		ErrorAndExit("Failed to list batch jobs", err)
 	}
-	output := make([]interface{}, 0, len(resp.Executions))
-	for _, wf := range resp.Executions {
+	output := make([]interface{}, 0, len(resp.GetOperations()))
+	for _, operation := range resp.GetOperations() {
 		job := map[string]string{
-			"jobID":     wf.Execution.GetWorkflowId(),
-			"startTime": convertTime(wf.GetStartTime(), false),
-			"reason":    string(wf.Memo.Fields["Reason"]),
-			"operator":  string(wf.SearchAttributes.IndexedFields["Operator"]),
+			"jobID":     operation.GetJobID(),
+			"startTime": convertTime(operation.GetStartTimeNano(), false),
+			"reason":    operation.GetReason(),
+			"operator":  operation.GetIdentity(),
+			"status":    "RUNNING",
 		}
-
-		if wf.CloseStatus != nil {
-			job["status"] = wf.CloseStatus.String()
-			job["closeTime"] = convertTime(wf.GetCloseTime(), false)
-		} else {
-			job["status"] = "RUNNING"
+		if operation.CloseStatus != nil {
+			job["status"] = operation.CloseStatus.String()
+			job["closeTime"] = convertTime(operation.GetCloseTimeNano(), false)
 		}
 
 		output = append(output, job)
@@ -141,21 +135,46 @@ func StartBatchJob(c *cli.Context) {
 	if !validateBatchType(batchType) {
 		ErrorAndExit("batchType is not valid, supported:"+strings.Join(batcher.AllBatchTypes, ","), nil)
 	}
-	operator := getCurrentUserFromEnv()
-	var sigName, sigVal string
-	if batchType == batcher.BatchTypeSignal {
-		sigName = getRequiredOption(c, FlagSignalName)
-		sigVal = getRequiredOption(c, FlagInput)
+	request := &types.StartBatchOperationRequest{
+		Domain:   domain,
+		Query:    query,
+		Reason:   reason,
+		DryRun:   c.Bool(FlagDryRun),
+		RPS:      int32(c.Int(FlagRPS)),
+		Identity: getCurrentUserFromEnv(),
+	}
+	// the batch types of the CLI are the lower case names of the ones of the API
+	var operationType types.BatchOperationType
+	if err := operationType.UnmarshalText([]byte(batchType)); err != nil {
+		ErrorAndExit("batchType is not valid", err)
+	}
+	request.BatchType = &operationType
+	switch batchType {
+	case batcher.BatchTypeSignal:
+		request.SignalName = getRequiredOption(c, FlagSignalName)
+		request.SignalInput = []byte(getRequiredOption(c, FlagInput))
+	case batcher.BatchTypeReset:
+		request.ResetParams = &types.BatchOperationResetParams{
+			ResetType:            getRequiredOption(c, FlagResetType),
+			BadBinaryChecksum:    c.String(FlagResetBadBinaryChecksum),
+			EarliestTimeNano:     common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0)),
+			SkipCurrentOpen:      c.Bool(FlagSkipCurrentOpen),
+			SkipBaseIsNotCurrent: c.Bool(FlagSkipBaseIsNotCurrent),
+			NonDeterministicOnly: c.Bool(FlagNonDeterministicOnly),
+			SkipSignalReapply:    c.Bool(FlagSkipSignalReapply),
+			ChildWorkflowPolicy:  parseResetChildWorkflowPolicy(c),
+			ReapplyEventTypes:    parseResetReapplyEventTypes(c),
+		}
+	case batcher.BatchTypeUpsertSearchAttributes:
+		request.SearchAttributes = &types.SearchAttributes{IndexedFields: processSearchAttr(c)}
 	}
-	rps := c.Int(FlagRPS)
 
-	svcClient := cFactory.ClientFrontendClient(c)
-	client := cclient.NewClient(svcClient, common.BatcherLocalDomainName, &DefaultClientOptions)
+	frontendClient := cFactory.ServerFrontendClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	resp, err := client.CountWorkflow(tcCtx, &shared.CountWorkflowExecutionsRequest{
-		Domain: common.StringPtr(domain),
-		Query:  common.StringPtr(query),
+	resp, err := frontendClient.CountWorkflowExecutions(tcCtx, &types.CountWorkflowExecutionsRequest{
+		Domain: domain,
+		Query:  query,
 	})
 	if err != nil {
 		ErrorAndExit("Failed to count impacting workflows for starting a batch job", err)
@@ -180,35 +199,13 @@ func StartBatchJob(c *cli.Context) {
 	}
 	tcCtx, cancel = newContext(c)
 	defer cancel()
-	options := cclient.StartWorkflowOptions{
-		TaskList:                     batcher.BatcherTaskListName,
-		ExecutionStartToCloseTimeout: batcher.InfiniteDuration,
-		Memo: map[string]interface{}{
-			"Reason": reason,
-		},
-		SearchAttributes: map[string]interface{}{
-			"CustomDomain": domain,
-			"Operator":     operator,
-		},
-	}
-	params := batcher.BatchParams{
-		DomainName: domain,
-		Query:      query,
-		Reason:     reason,
-		BatchType:  batchType,
-		SignalParams: batcher.SignalParams{
-			SignalName: sigName,
-			Input:      sigVal,
-		},
-		RPS: rps,
-	}
-	wf, err := client.StartWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
+	startResp, err := frontendClient.StartBatchOperation(tcCtx, request)
 	if err != nil {
 		ErrorAndExit("Failed to start batch job", err)
 	}
 	output := map[string]interface{}{
 		"msg":   "batch job is started",
-		"jobID": wf.ID,
+		"jobID": startResp.GetJobID(),
 	}
 	prettyPrintJSONObject(output)
 }
@@ -1563,6 +1563,8 @@ func ResetWorkflow(c *cli.Context) {
 		DecisionFinishEventID: decisionFinishID,
 		RequestID:             uuid.New(),
 		SkipSignalReapply:     c.Bool(FlagSkipSignalReapply),
+		ChildWorkflowPolicy:   parseResetChildWorkflowPolicy(c),
+		ReapplyEventTypes:     parseResetReapplyEventTypes(c),
 	})
 	if err != nil {
 		ErrorAndExit("reset failed", err)
@@ -1570,6 +1572,32 @@ func ResetWorkflow(c *cli.Context) {
 	prettyPrintJSONObject(resp)
 }
 
+func parseResetChildWorkflowPolicy(c *cli.Context) *types.ResetChildWorkflowPolicy {
+	if !c.IsSet(FlagResetChildWorkflowPolicy) {
+		return nil
+	}
+	var policy types.ResetChildWorkflowPolicy
+	if err := policy.UnmarshalText([]byte(c.String(FlagResetChildWorkflowPolicy))); err != nil {
+		ErrorAndExit("Invalid child workflow policy", err)
+	}
+	return &policy
+}
+
+func parseResetReapplyEventTypes(c *cli.Context) []types.EventType {
+	var eventTypes []types.EventType
+	for _, name := range trimSpace(strings.Split(c.String(FlagResetReapplyEventTypes), ",")) {
+		if name == "" {
+			continue
+		}
+		var eventType types.EventType
+		if err := eventType.UnmarshalText([]byte(name)); err != nil {
+			ErrorAndExit("Invalid reapply event type", err)
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes
+}
+
 func processResets(c *cli.Context, domain string, wes chan types.WorkflowExecution, done chan bool, wg *sync.WaitGroup, params batchResetParamsType) {
 	for {
 		select {
@@ -1608,6 +1636,8 @@ type batchResetParamsType struct {
 	dryRun               bool
 	resetType            string
 	skipSignalReapply    bool
+	childWorkflowPolicy  *types.ResetChildWorkflowPolicy
+	reapplyEventTypes    []types.EventType
 }
 
 // ResetInBatch resets workflow in batch
@@ -1636,6 +1666,8 @@ func ResetInBatch(c *cli.Context) {
 		dryRun:               c.Bool(FlagDryRun),
 		resetType:            resetType,
 		skipSignalReapply:    c.Bool(FlagSkipSignalReapply),
+		childWorkflowPolicy:  parseResetChildWorkflowPolicy(c),
+		reapplyEventTypes:    parseResetReapplyEventTypes(c),
 	}
 
 	if inFileName == "" && query == "" {
@@ -1831,6 +1863,8 @@ func doReset(c *cli.Context, domain, wid, rid string, params batchResetParamsTyp
 			RequestID:             uuid.New(),
 			Reason:                fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), params.reason),
 			SkipSignalReapply:     params.skipSignalReapply,
+			ChildWorkflowPolicy:   params.childWorkflowPolicy,
+			ReapplyEventTypes:     params.reapplyEventTypes,
 		})
 
 		if err != nil {